@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/database/boltdb"
+	"github.com/FactomProject/factomd/database/leveldb"
+	"github.com/FactomProject/factomd/database/migrator"
+)
+
+const level string = "level"
+const bolt string = "bolt"
+
+func main() {
+	fmt.Println("Usage:")
+	fmt.Println("DatabaseMigrator level/bolt FromDBLocation level/bolt ToDBLocation")
+	fmt.Println("Database will be copied over key by key to the new location, then checked against the original")
+	fmt.Println("If the copy is interrupted, run the same command again to continue it")
+
+	if len(os.Args) < 5 {
+		fmt.Println("\nNot enough arguments passed")
+		os.Exit(1)
+	}
+	if len(os.Args) > 5 {
+		fmt.Println("\nToo many arguments passed")
+		os.Exit(1)
+	}
+
+	fromType, fromPath := os.Args[1], os.Args[2]
+	toType, toPath := os.Args[3], os.Args[4]
+	for _, t := range []string{fromType, toType} {
+		if t != level && t != bolt {
+			fmt.Println("\nDatabase types should be `level` or `bolt`")
+			os.Exit(1)
+		}
+	}
+	if _, err := os.Stat(fromPath); err != nil {
+		fmt.Println("\nCan't find the database to copy:", err)
+		os.Exit(1)
+	}
+
+	from, err := open(fromType, fromPath, false)
+	if err != nil {
+		panic(err)
+	}
+	to, err := open(toType, toPath, true)
+	if err != nil {
+		panic(err)
+	}
+
+	m := migrator.NewMigrator(from, to, toPath+".migration", os.Stdout)
+	if err := m.Copy(); err != nil {
+		fmt.Println("\nCopy failed:", err)
+		fmt.Println("Run the same command again to continue from where it stopped")
+		os.Exit(1)
+	}
+
+	fmt.Println("\nVerifying the copy")
+	bad, err := m.Verify()
+	if err != nil {
+		fmt.Println("\nVerify failed:", err)
+		os.Exit(1)
+	}
+
+	from.Close()
+	to.Close()
+
+	if bad > 0 {
+		fmt.Printf("\nThe copy has %d differences from the original. Delete %s and %s.migration to start over.\n", bad, toPath, toPath)
+		os.Exit(1)
+	}
+	os.Remove(toPath + ".migration")
+	fmt.Printf("\n%s is a complete copy of %s\n", toPath, fromPath)
+}
+
+func open(dbType string, path string, create bool) (interfaces.IDatabase, error) {
+	if dbType == bolt {
+		return boltdb.NewBoltDB(nil, path), nil
+	}
+	return leveldb.NewLevelDB(path, create)
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	. "github.com/FactomProject/factomd/chainExport"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/util"
+)
+
+const level string = "level"
+const bolt string = "bolt"
+
+func usage() {
+	fmt.Println("Usage:")
+	fmt.Println("ChainExportTool export level/bolt [ChainID] [File]")
+	fmt.Println("ChainExportTool verify [File] [level/bolt]")
+	fmt.Println("ChainExportTool dump [File]")
+	fmt.Println("Files ending in .gz are compressed.  Give verify a database to check the proofs against it")
+	os.Exit(1)
+}
+
+func main() {
+	if len(os.Args) < 3 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "export":
+		if len(os.Args) != 5 {
+			usage()
+		}
+		chainID, err := primitives.HexToHash(os.Args[3])
+		if err != nil {
+			panic(err)
+		}
+		dbo, unlock := openDB(os.Args[2])
+		defer unlock()
+		count, err := ExportFile(dbo, chainID, LatestVersion, os.Args[4], strings.HasSuffix(os.Args[4], ".gz"))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Exported %d entries of chain %s\n", count, chainID.String())
+	case "verify":
+		if len(os.Args) > 4 {
+			usage()
+		}
+		var dbo interfaces.DBOverlaySimple
+		if len(os.Args) == 4 {
+			var unlock func()
+			dbo, unlock = openDB(os.Args[3])
+			defer unlock()
+		}
+		f, err := Open(os.Args[2])
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		summary, err := Verify(f, dbo)
+		out, _ := json.MarshalIndent(summary, "", "\t")
+		fmt.Println(string(out))
+		if err != nil {
+			fmt.Println("\nThe export is not valid:", err.Error())
+			os.Exit(1)
+		}
+		fmt.Println("\nThe export is valid")
+	case "dump":
+		f, err := Open(os.Args[2])
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		_, err = Read(f, &Handler{Entry: func(entry interfaces.IEBEntry) error {
+			out, err := entry.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		}})
+		if err != nil {
+			fmt.Println("\nThe export is not valid:", err.Error())
+			os.Exit(1)
+		}
+	default:
+		usage()
+	}
+}
+
+func openDB(levelBolt string) (interfaces.DBOverlaySimple, func()) {
+	if levelBolt != level && levelBolt != bolt {
+		fmt.Println("\nThe database should be `level` or `bolt`")
+		os.Exit(1)
+	}
+
+	state := new(state.State)
+	state.Cfg = util.ReadConfig("")
+	if levelBolt == level {
+		err := state.InitLevelDB()
+		if err != nil {
+			panic(err)
+		}
+	}
+	if levelBolt == bolt {
+		err := state.InitBoltDB()
+		if err != nil {
+			panic(err)
+		}
+	}
+	return state.GetAndLockDB(), state.UnlockDB
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package chainExport writes and reads chain exports: every entry of a chain, oldest first, in a
+// file other programs, such as token daemons and DID resolvers, can take a chain from instead of
+// asking a node's API for it block by block.  The node serves them at /v2/export/<chainid>.
+//
+// An export starts with its magic, which gives its version, and the chain ID (32 bytes).
+// Numbers are big endian.  Version 1 ("FCTEXPT1") holds just the entries:
+//
+//	each entry: its length (4 bytes), and the entry, marshalled
+//	a length of 0
+//
+// Version 2 ("FCTEXPT2") holds the entry blocks as well, and proof they are in the directory
+// block chain, in records of a type (1 byte), a length (4 bytes), and the data:
+//
+//	'B' an entry block, marshalled; the first has no previous key MR, and each other one has the
+//	    key MR of the one before it
+//	'P' a receipt (see receipts/), in JSON, for an entry of the block just before it, leading
+//	    from the entry through the block's key MR to a directory block's key MR
+//	'E' an entry of that block, marshalled, in the order of the block
+//	 0  with a length of 0, the end of the records
+//
+// Both versions end with a trailer: the number of entries (8 bytes), and the sha256 of
+// everything before it.  An export that broke off (or was cut short on the way) is one without
+// the trailer.  Read checks the trailer; Verify also checks that the entries are those of the
+// blocks, that the blocks make up the chain, and the proofs, and if given a database, that the
+// directory blocks proven to are its own.
+package chainExport
+
+import (
+	"fmt"
+	"io"
+)
+
+const (
+	Version1      = 1 // Entries
+	Version2      = 2 // Entry blocks, proofs, and entries
+	LatestVersion = Version2
+)
+
+var Magics = map[int][]byte{
+	Version1: []byte("FCTEXPT1"),
+	Version2: []byte("FCTEXPT2"),
+}
+
+const MagicLength = 8
+
+// Records larger than this are not read from an export
+const MaxRecordSize = 1 << 20
+
+// The types of the records of a version 2 export
+const (
+	RecordEnd        byte = 0
+	RecordEntryBlock byte = 'B'
+	RecordProof      byte = 'P'
+	RecordEntry      byte = 'E'
+)
+
+func magic(version int) ([]byte, error) {
+	m, ok := Magics[version]
+	if !ok {
+		return nil, fmt.Errorf("There is no version %d of the chain export format", version)
+	}
+	return m, nil
+}
+
+func versionOf(m []byte) (int, error) {
+	for version, mv := range Magics {
+		if string(mv) == string(m) {
+			return version, nil
+		}
+	}
+	return 0, fmt.Errorf("Not a chain export")
+}
+
+// Writes fail once one has failed, and the first error is kept
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) Write(p []byte) (int, error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+	n, err := ew.w.Write(p)
+	ew.err = err
+	return n, err
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package chainExport_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/FactomProject/factomd/chainExport"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestExport(t *testing.T) {
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	chainID := testHelper.GetChainID()
+	expected, err := dbo.FetchAllEntryIDsByChainID(chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, version := range []int{Version1, Version2} {
+		var export bytes.Buffer
+		count, err := Export(dbo, chainID, version, &export)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != int64(len(expected)) {
+			t.Errorf("Version %d exported %d entries, expected %d", version, count, len(expected))
+		}
+
+		seen := make(map[[32]byte]bool)
+		blocks := 0
+		header, err := Read(bytes.NewReader(export.Bytes()), &Handler{
+			EntryBlock: func(interfaces.IEntryBlock) error {
+				blocks++
+				return nil
+			},
+			Entry: func(entry interfaces.IEBEntry) error {
+				seen[entry.GetHash().Fixed()] = true
+				return nil
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Version != version {
+			t.Errorf("Read version %d, expected %d", header.Version, version)
+		}
+		if !header.ChainID.IsSameAs(chainID) {
+			t.Errorf("Read chain %s, expected %s", header.ChainID.String(), chainID.String())
+		}
+		if version == Version1 && blocks != 0 || version == Version2 && blocks == 0 {
+			t.Errorf("Version %d has %d entry blocks", version, blocks)
+		}
+		for _, hash := range expected {
+			if !seen[hash.Fixed()] {
+				t.Errorf("Entry %s is missing from the version %d export", hash.String(), version)
+			}
+		}
+
+		summary, err := Verify(bytes.NewReader(export.Bytes()), dbo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.Entries != count {
+			t.Errorf("Verified %d entries, expected %d", summary.Entries, count)
+		}
+		if summary.Proven != (version >= Version2) {
+			t.Errorf("Version %d is proven: %v", version, summary.Proven)
+		}
+	}
+}
+
+func TestVerifyDamagedExport(t *testing.T) {
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	var export bytes.Buffer
+	if _, err := Export(dbo, testHelper.GetChainID(), LatestVersion, &export); err != nil {
+		t.Fatal(err)
+	}
+
+	data := export.Bytes()
+	if _, err := Verify(bytes.NewReader(data[:len(data)-1]), dbo); err == nil {
+		t.Errorf("A truncated export was verified")
+	}
+	damaged := append([]byte{}, data...)
+	damaged[MagicLength+40] ^= 1
+	if _, err := Verify(bytes.NewReader(damaged), dbo); err == nil {
+		t.Errorf("A damaged export was verified")
+	}
+	if _, err := Export(dbo, testHelper.GetChainID(), 3, &export); err == nil {
+		t.Errorf("An export was written in version 3")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package chainExport
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts"
+)
+
+// Export writes the export of a chain to w, in the given version of the format, and returns the
+// number of entries in it.  Only the key MRs of the chain's entry blocks are kept while it is
+// written, to walk the chain forwards, so a chain of many gigabytes is streamed straight from the
+// database without being held in memory.
+func Export(dbase interfaces.DBOverlaySimple, chainID interfaces.IHash, version int, w io.Writer) (int64, error) {
+	m, err := magic(version)
+	if err != nil {
+		return 0, err
+	}
+	keyMRs, err := chainKeyMRs(dbase, chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := sha256.New()
+	out := &errWriter{w: io.MultiWriter(w, sum)}
+	out.Write(m)
+	out.Write(chainID.Bytes())
+
+	var count int64
+	for i := len(keyMRs) - 1; i >= 0; i-- {
+		eblk, err := dbase.FetchEBlock(primitives.NewHash(keyMRs[i][:]))
+		if err != nil {
+			return count, err
+		}
+		if eblk == nil {
+			return count, fmt.Errorf("Entry block %x of chain %s is missing", keyMRs[i], chainID.String())
+		}
+		if version >= Version2 {
+			if err := writeBlock(out, dbase, eblk); err != nil {
+				return count, err
+			}
+		}
+		for _, entryHash := range eblk.GetEntryHashes() {
+			if entryHash.IsMinuteMarker() {
+				continue
+			}
+			entry, err := dbase.FetchEntry(entryHash)
+			if err != nil {
+				return count, err
+			}
+			if entry == nil {
+				return count, fmt.Errorf("Entry %s of chain %s is missing", entryHash.String(), chainID.String())
+			}
+			data, err := entry.MarshalBinary()
+			if err != nil {
+				return count, err
+			}
+			if version >= Version2 {
+				writeRecord(out, RecordEntry, data)
+			} else {
+				writeLength(out, len(data))
+				out.Write(data)
+			}
+			count++
+		}
+		if out.err != nil {
+			return count, out.err
+		}
+	}
+
+	if version >= Version2 {
+		writeRecord(out, RecordEnd, nil)
+	} else {
+		writeLength(out, 0)
+	}
+	n := make([]byte, 8)
+	binary.BigEndian.PutUint64(n, uint64(count))
+	out.Write(n)
+	if out.err != nil {
+		return count, out.err
+	}
+	if _, err := w.Write(sum.Sum(nil)); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// The key MRs of a chain's entry blocks, newest first
+func chainKeyMRs(dbase interfaces.DBOverlaySimple, chainID interfaces.IHash) ([][32]byte, error) {
+	head, err := dbase.FetchEBlockHead(chainID)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, fmt.Errorf("Chain %s not found", chainID.String())
+	}
+
+	var keyMRs [][32]byte
+	for eblk := head; ; {
+		keyMR, err := eblk.KeyMR()
+		if err != nil {
+			return nil, err
+		}
+		keyMRs = append(keyMRs, keyMR.Fixed())
+		prev := eblk.GetHeader().GetPrevKeyMR()
+		if prev == nil || prev.IsZero() {
+			return keyMRs, nil
+		}
+		eblk, err = dbase.FetchEBlock(prev)
+		if err != nil {
+			return nil, err
+		}
+		if eblk == nil {
+			return nil, fmt.Errorf("Entry block %s of chain %s is missing", prev.String(), chainID.String())
+		}
+	}
+}
+
+// Writes an entry block, and the proof it is in a directory block
+func writeBlock(out *errWriter, dbase interfaces.DBOverlaySimple, eblk interfaces.IEntryBlock) error {
+	data, err := eblk.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	writeRecord(out, RecordEntryBlock, data)
+
+	proof, err := blockProof(dbase, eblk)
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(proof)
+	if err != nil {
+		return err
+	}
+	writeRecord(out, RecordProof, data)
+	return out.err
+}
+
+// blockProof returns a receipt for one of a block's entries, leading through the block.  An
+// entry can be in more than one block, and its receipt is for the first, so the block's first
+// entry may not do.
+func blockProof(dbase interfaces.DBOverlaySimple, eblk interfaces.IEntryBlock) (*receipts.Receipt, error) {
+	keyMR, err := eblk.KeyMR()
+	if err != nil {
+		return nil, err
+	}
+	for _, entryHash := range eblk.GetEntryHashes() {
+		if entryHash.IsMinuteMarker() {
+			continue
+		}
+		receipt, err := receipts.CreateFullReceipt(dbase, entryHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt.EntryBlockKeyMR.IsSameAs(keyMR) {
+			return receipt, nil
+		}
+	}
+	return nil, fmt.Errorf("No entry of entry block %s has a receipt through it", keyMR.String())
+}
+
+func writeLength(out *errWriter, n int) {
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(n))
+	out.Write(length)
+}
+
+func writeRecord(out *errWriter, recordType byte, data []byte) {
+	out.Write([]byte{recordType})
+	writeLength(out, len(data))
+	out.Write(data)
+}
+
+// ExportFile writes the export of a chain to a new file, compressed if compress is set.
+func ExportFile(dbase interfaces.DBOverlaySimple, chainID interfaces.IHash, version int, path string, compress bool) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	buffered := bufio.NewWriter(f)
+	var w io.Writer = buffered
+	var zw *gzip.Writer
+	if compress {
+		zw = gzip.NewWriter(buffered)
+		w = zw
+	}
+	count, err := Export(dbase, chainID, version, w)
+	if err != nil {
+		return count, err
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return count, err
+		}
+	}
+	if err := buffered.Flush(); err != nil {
+		return count, err
+	}
+	return count, f.Close()
+}
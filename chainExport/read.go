@@ -0,0 +1,180 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package chainExport
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts"
+)
+
+type Header struct {
+	Version int
+	ChainID interfaces.IHash
+}
+
+// What Read does with what it reads.  Nil functions are skipped, and an error from one stops
+// the read.
+type Handler struct {
+	Header     func(*Header) error
+	EntryBlock func(interfaces.IEntryBlock) error
+	Proof      func(*receipts.Receipt) error
+	Entry      func(interfaces.IEBEntry) error
+}
+
+// Read reads an export, uncompressed, passing what is in it to h, and checks its trailer.  It
+// returns the header, and an error if the export is damaged or incomplete, or h returns one.
+func Read(r io.Reader, h *Handler) (*Header, error) {
+	if h == nil {
+		h = new(Handler)
+	}
+	sum := sha256.New()
+	in := io.TeeReader(bufio.NewReader(r), sum)
+
+	data := make([]byte, MagicLength+32)
+	if _, err := io.ReadFull(in, data); err != nil {
+		return nil, fmt.Errorf("Reading the header: %s", err.Error())
+	}
+	version, err := versionOf(data[:MagicLength])
+	if err != nil {
+		return nil, err
+	}
+	header := &Header{Version: version, ChainID: primitives.NewHash(data[MagicLength:])}
+	if h.Header != nil {
+		if err := h.Header(header); err != nil {
+			return header, err
+		}
+	}
+
+	var count uint64
+	for {
+		recordType := RecordEntry
+		if version >= Version2 {
+			t := make([]byte, 1)
+			if _, err := io.ReadFull(in, t); err != nil {
+				return header, fmt.Errorf("Reading record %d: %s", count, err.Error())
+			}
+			recordType = t[0]
+		}
+		data, err := readRecord(in)
+		if err != nil {
+			return header, fmt.Errorf("Reading record %d: %s", count, err.Error())
+		}
+		if len(data) == 0 && (version == Version1 || recordType == RecordEnd) {
+			break
+		}
+
+		switch recordType {
+		case RecordEntryBlock:
+			eblk := entryBlock.NewEBlock()
+			if err := eblk.UnmarshalBinary(data); err != nil {
+				return header, fmt.Errorf("Entry block after entry %d: %s", count, err.Error())
+			}
+			if h.EntryBlock != nil {
+				if err := h.EntryBlock(eblk); err != nil {
+					return header, err
+				}
+			}
+		case RecordProof:
+			receipt, err := receipts.DecodeReceiptString(string(data))
+			if err != nil {
+				return header, fmt.Errorf("Proof after entry %d: %s", count, err.Error())
+			}
+			if h.Proof != nil {
+				if err := h.Proof(receipt); err != nil {
+					return header, err
+				}
+			}
+		case RecordEntry:
+			entry := entryBlock.NewEntry()
+			if err := entry.UnmarshalBinary(data); err != nil {
+				return header, fmt.Errorf("Entry %d: %s", count, err.Error())
+			}
+			if h.Entry != nil {
+				if err := h.Entry(entry); err != nil {
+					return header, err
+				}
+			}
+			count++
+		default:
+			return header, fmt.Errorf("Record after entry %d is of unknown type %d", count, recordType)
+		}
+	}
+	return header, readTrailer(in, sum, count)
+}
+
+func readRecord(in io.Reader) ([]byte, error) {
+	length := make([]byte, 4)
+	if _, err := io.ReadFull(in, length); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(length)
+	if n > MaxRecordSize {
+		return nil, fmt.Errorf("It is %d bytes long", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(in, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func readTrailer(in io.Reader, sum hash.Hash, count uint64) error {
+	n := make([]byte, 8)
+	if _, err := io.ReadFull(in, n); err != nil {
+		return fmt.Errorf("Reading the trailer: %s", err.Error())
+	}
+	if binary.BigEndian.Uint64(n) != count {
+		return fmt.Errorf("The export has %d entries, its trailer says %d", count, binary.BigEndian.Uint64(n))
+	}
+	expected := sum.Sum(nil) // Of everything before the checksum
+	checksum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(in, checksum); err != nil {
+		return fmt.Errorf("Reading the trailer: %s", err.Error())
+	}
+	if !bytes.Equal(checksum, expected) {
+		return fmt.Errorf("Bad checksum")
+	}
+	return nil
+}
+
+// Open opens an export file for Read, uncompressing it if its name ends in .gz.
+func Open(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, ".gz") {
+		return f, nil
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &gzipFile{zr, f}, nil
+}
+
+type gzipFile struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g *gzipFile) Close() error {
+	g.Reader.Close()
+	return g.f.Close()
+}
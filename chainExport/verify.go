@@ -0,0 +1,159 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package chainExport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/receipts"
+)
+
+type Summary struct {
+	Version     int
+	ChainID     string
+	EntryBlocks int64
+	Entries     int64
+	Proven      bool // Every entry block was proven to be in a directory block
+}
+
+type verifier struct {
+	header  *Header
+	dbase   interfaces.DBOverlaySimple
+	summary *Summary
+
+	prevKeyMR interfaces.IHash
+	keyMR     interfaces.IHash
+	expected  []interfaces.IHash // The entries of the current block
+	next      int                // The next of them expected
+	unproven  bool               // The current block has no proof yet
+}
+
+// Verify reads an export, uncompressed, and checks it: its trailer, that its entries are of its
+// chain, and from version 2 on, that they are those of its entry blocks, in order, that the blocks
+// make up the chain from its first block, and that each block is proven to be in a directory
+// block.  Given a database, it also checks those directory blocks are in it.  It returns what the
+// export holds, and an error for the first thing wrong with it.
+func Verify(r io.Reader, dbase interfaces.DBOverlaySimple) (*Summary, error) {
+	v := &verifier{dbase: dbase, summary: new(Summary)}
+	h := &Handler{Header: v.setHeader, EntryBlock: v.entryBlock, Proof: v.proof, Entry: v.entry}
+	header, err := Read(r, h)
+	if header != nil {
+		v.summary.Version = header.Version
+		v.summary.ChainID = header.ChainID.String()
+	}
+	if err != nil {
+		return v.summary, err
+	}
+	if header.Version >= Version2 {
+		if err := v.blockDone(); err != nil {
+			return v.summary, err
+		}
+		v.summary.Proven = true
+	}
+	return v.summary, nil
+}
+
+func (v *verifier) setHeader(header *Header) error {
+	v.header = header
+	return nil
+}
+
+func (v *verifier) blockDone() error {
+	if v.keyMR == nil {
+		return nil
+	}
+	if v.unproven {
+		return fmt.Errorf("Entry block %s has no proof", v.keyMR.String())
+	}
+	if v.next < len(v.expected) {
+		return fmt.Errorf("Entry block %s has %d entries, the export %d", v.keyMR.String(), len(v.expected), v.next)
+	}
+	return nil
+}
+
+func (v *verifier) entryBlock(eblk interfaces.IEntryBlock) error {
+	if err := v.blockDone(); err != nil {
+		return err
+	}
+	keyMR, err := eblk.KeyMR()
+	if err != nil {
+		return err
+	}
+	header := eblk.GetHeader()
+	if !header.GetChainID().IsSameAs(v.header.ChainID) {
+		return fmt.Errorf("Entry block %s is of chain %s", keyMR.String(), header.GetChainID().String())
+	}
+	prev := header.GetPrevKeyMR()
+	if v.prevKeyMR == nil {
+		if prev != nil && !prev.IsZero() {
+			return fmt.Errorf("The export starts at entry block %s, not the first of the chain", keyMR.String())
+		}
+	} else if prev == nil || !prev.IsSameAs(v.prevKeyMR) {
+		return fmt.Errorf("Entry block %s doesn't follow entry block %s", keyMR.String(), v.prevKeyMR.String())
+	}
+
+	v.prevKeyMR, v.keyMR = keyMR, keyMR
+	v.expected = v.expected[:0]
+	for _, entryHash := range eblk.GetEntryHashes() {
+		if !entryHash.IsMinuteMarker() {
+			v.expected = append(v.expected, entryHash)
+		}
+	}
+	v.next = 0
+	v.unproven = true
+	v.summary.EntryBlocks++
+	return nil
+}
+
+func (v *verifier) proof(receipt *receipts.Receipt) error {
+	if v.keyMR == nil || !v.unproven || v.next > 0 {
+		return fmt.Errorf("A proof is out of place after entry block %v", v.keyMR)
+	}
+	if err := receipt.Validate(); err != nil {
+		return fmt.Errorf("The proof of entry block %s: %s", v.keyMR.String(), err.Error())
+	}
+	if !receipt.EntryBlockKeyMR.IsSameAs(v.keyMR) {
+		return fmt.Errorf("The proof of entry block %s is through entry block %s", v.keyMR.String(), receipt.EntryBlockKeyMR.String())
+	}
+	inBlock := false
+	for _, entryHash := range v.expected {
+		if entryHash.String() == receipt.Entry.EntryHash {
+			inBlock = true
+		}
+	}
+	if !inBlock {
+		return fmt.Errorf("The proof of entry block %s is for entry %s, which isn't in it", v.keyMR.String(), receipt.Entry.EntryHash)
+	}
+	if v.dbase != nil {
+		dblk, err := v.dbase.FetchDBlock(receipt.DirectoryBlockKeyMR)
+		if err != nil {
+			return err
+		}
+		if dblk == nil {
+			return fmt.Errorf("Entry block %s is proven to be in directory block %s, which isn't in the database", v.keyMR.String(), receipt.DirectoryBlockKeyMR.String())
+		}
+	}
+	v.unproven = false
+	return nil
+}
+
+func (v *verifier) entry(entry interfaces.IEBEntry) error {
+	if !entry.GetChainID().IsSameAs(v.header.ChainID) {
+		return fmt.Errorf("Entry %s is of chain %s", entry.GetHash().String(), entry.GetChainID().String())
+	}
+	if v.header.Version >= Version2 {
+		if v.keyMR == nil || v.unproven {
+			return fmt.Errorf("Entry %s isn't in a proven entry block", entry.GetHash().String())
+		}
+		if v.next >= len(v.expected) || !entry.GetHash().IsSameAs(v.expected[v.next]) {
+			return fmt.Errorf("Entry %s isn't the next entry of entry block %s", entry.GetHash().String(), v.keyMR.String())
+		}
+		v.next++
+	}
+	v.summary.Entries++
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package clock keeps a node's time honest.  Messages carry timestamps that every node judges
+// against its own clock, so a node whose clock has drifted rejects good messages, and has its own
+// rejected, with nothing in the logs but the rejections.  A Clock measures our offset from a set
+// of NTP servers, so the node can say so, and can correct for it when the operator asks it to.
+package clock
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxOffset = 10 * time.Second // Half the 20 seconds a full server fault is good for
+	DefaultTimeout   = 5 * time.Second
+)
+
+type Clock struct {
+	Servers   []string
+	Timeout   time.Duration // For each query
+	MaxOffset time.Duration // An offset beyond this is reported, and corrected if Correct is set
+	Correct   bool
+
+	mutex    sync.RWMutex
+	offset   time.Duration // As last measured
+	measured time.Time
+	applied  time.Duration // What Now adds to the system clock
+	err      error
+}
+
+// Status is what we know about the clock, for the control panel and the API.
+type Status struct {
+	Servers  []string
+	Offset   time.Duration // How far NTP time is ahead of the system clock
+	Applied  time.Duration // The correction made to the system clock
+	Measured time.Time     // Zero if never measured
+	Error    string        // Why the last measurement failed
+}
+
+func New(servers []string) *Clock {
+	c := new(Clock)
+	c.Servers = servers
+	c.Timeout = DefaultTimeout
+	c.MaxOffset = DefaultMaxOffset
+	return c
+}
+
+// ParseServers splits a comma separated list of NTP servers.
+func ParseServers(list string) []string {
+	var servers []string
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			servers = append(servers, s)
+		}
+	}
+	return servers
+}
+
+// Now returns the system time, corrected if the clock is correcting.
+func (c *Clock) Now() time.Time {
+	c.mutex.RLock()
+	applied := c.applied
+	c.mutex.RUnlock()
+	return time.Now().Add(applied)
+}
+
+// Exceeds is true if offset is too far off for messages to be judged fairly.
+func (c *Clock) Exceeds(offset time.Duration) bool {
+	return offset > c.MaxOffset || offset < -c.MaxOffset
+}
+
+// Measure queries every server and returns the median of their offsets.  If the offset exceeds
+// MaxOffset and Correct is set, Now corrects for it from here on; otherwise Now is the system
+// clock.  Fails only if no server answers.
+func (c *Clock) Measure() (time.Duration, error) {
+	var offsets []float64
+	var failures []string
+	for _, server := range c.Servers {
+		offset, _, err := Query(server, c.Timeout)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", server, err))
+			continue
+		}
+		offsets = append(offsets, float64(offset))
+	}
+	c.record(offsets, failures)
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.offset, c.err
+}
+
+// record keeps the median of offsets, or an error built from failures if there are none.
+func (c *Clock) record(offsets []float64, failures []string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(offsets) == 0 {
+		if len(failures) == 0 {
+			c.err = fmt.Errorf("no NTP servers configured")
+		} else {
+			c.err = fmt.Errorf("no NTP server answered (%s)", strings.Join(failures, "; "))
+		}
+		return
+	}
+
+	sort.Float64s(offsets)
+	c.offset = time.Duration(offsets[len(offsets)/2])
+	c.measured = time.Now()
+	c.err = nil
+	c.applied = 0
+	if c.Correct && c.Exceeds(c.offset) {
+		c.applied = c.offset
+	}
+}
+
+func (c *Clock) Status() Status {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	st := Status{Servers: c.Servers, Offset: c.offset, Applied: c.applied, Measured: c.measured}
+	if c.err != nil {
+		st.Error = c.err.Error()
+	}
+	return st
+}
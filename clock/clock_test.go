@@ -0,0 +1,107 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package clock_test
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/clock"
+)
+
+// fakeServer answers NTP queries with a clock ahead of ours by offset.  If echo is false it
+// doesn't echo the query's transmit timestamp.
+func fakeServer(t *testing.T, offset time.Duration, echo bool) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ntp := func(t time.Time) uint64 {
+		return uint64(t.Unix()+2208988800)<<32 | (uint64(t.Nanosecond())<<32)/1e9
+	}
+	go func() {
+		defer conn.Close()
+		buf := make([]byte, 48)
+		for {
+			_, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			resp := make([]byte, 48)
+			resp[0] = 0x24 // Version 4, mode 4 (server)
+			resp[1] = 2
+			if echo {
+				copy(resp[24:32], buf[40:48])
+			}
+			binary.BigEndian.PutUint64(resp[32:], ntp(time.Now().Add(offset)))
+			binary.BigEndian.PutUint64(resp[40:], ntp(time.Now().Add(offset)))
+			conn.WriteTo(resp, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func near(a time.Duration, b time.Duration) bool {
+	d := a - b
+	return d < 100*time.Millisecond && d > -100*time.Millisecond
+}
+
+func TestQuery(t *testing.T) {
+	offset, rtt, err := Query(fakeServer(t, time.Hour, true), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(offset, time.Hour) || rtt < 0 || rtt > time.Second {
+		t.Errorf("Expected an hour's offset, got %v with a round trip of %v", offset, rtt)
+	}
+
+	if _, _, err := Query(fakeServer(t, 0, false), time.Second); err == nil {
+		t.Error("A reply that doesn't echo our timestamp should be refused")
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	c := New([]string{fakeServer(t, -time.Minute, true), fakeServer(t, time.Minute, true), fakeServer(t, 2*time.Minute, true)})
+	c.Timeout = time.Second
+	offset, err := c.Measure()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(offset, time.Minute) || !c.Exceeds(offset) {
+		t.Errorf("Expected the median offset of a minute, got %v", offset)
+	}
+	if d := c.Now().Sub(time.Now()); !near(d, 0) {
+		t.Errorf("The clock should not correct unless asked to, but is off by %v", d)
+	}
+
+	c.Correct = true
+	c.Measure()
+	if d := c.Now().Sub(time.Now()); !near(d, time.Minute) {
+		t.Errorf("Expected the clock to correct by a minute, got %v", d)
+	}
+	if st := c.Status(); !near(st.Applied, time.Minute) || st.Error != "" {
+		t.Errorf("Unexpected status %+v", st)
+	}
+
+	// A small offset is left alone
+	c.Servers = []string{fakeServer(t, time.Second, true)}
+	c.Measure()
+	if d := c.Now().Sub(time.Now()); !near(d, 0) {
+		t.Errorf("A second's offset should not be corrected, but the clock is off by %v", d)
+	}
+}
+
+func TestParseServers(t *testing.T) {
+	servers := ParseServers(" pool.ntp.org, ,time.google.com:123,")
+	if len(servers) != 2 || servers[0] != "pool.ntp.org" || servers[1] != "time.google.com:123" {
+		t.Errorf("Unexpected servers %v", servers)
+	}
+	c := New(nil)
+	if _, err := c.Measure(); err == nil {
+		t.Error("Expected an error with no servers")
+	}
+}
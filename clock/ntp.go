@@ -0,0 +1,97 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+)
+
+// A minimal SNTP client (RFC 4330), enough to measure how far our clock is from a server's.
+
+const (
+	ntpPacketSize = 48
+	ntpEpochDelta = 2208988800 // Seconds from 1900, the NTP epoch, to 1970
+	ntpPort       = "123"
+)
+
+// toNTP encodes t as a 64 bit NTP timestamp: seconds since 1900 and a binary fraction.
+func toNTP(t time.Time) uint64 {
+	secs := uint64(t.Unix() + ntpEpochDelta)
+	frac := (uint64(t.Nanosecond()) << 32) / 1e9
+	return secs<<32 | frac
+}
+
+// fromNTP decodes a 64 bit NTP timestamp.
+func fromNTP(ts uint64) time.Time {
+	secs := int64(ts>>32) - ntpEpochDelta
+	nanos := int64(((ts & 0xffffffff) * 1e9) >> 32)
+	return time.Unix(secs, nanos)
+}
+
+// withPort adds the NTP port to server if it doesn't name one.
+func withPort(server string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(server, ntpPort)
+}
+
+// Query asks server for the time, and returns how far its clock is ahead of ours (negative if
+// behind) and the round trip time of the query.
+func Query(server string, timeout time.Duration) (time.Duration, time.Duration, error) {
+	conn, err := net.DialTimeout("udp", withPort(server), timeout)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = 0x23 // Leap indicator 0, version 4, mode 3 (client)
+	sent := time.Now()
+	origin := toNTP(sent)
+	binary.BigEndian.PutUint64(req[40:], origin)
+	if _, err := conn.Write(req); err != nil {
+		return 0, 0, err
+	}
+
+	resp := make([]byte, ntpPacketSize)
+	n, err := conn.Read(resp)
+	received := time.Now()
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseResponse(resp[:n], origin, sent, received)
+}
+
+// parseResponse works out the offset and round trip from a server's reply to a request sent at
+// sent, with the transmit timestamp origin, and received at received.
+func parseResponse(resp []byte, origin uint64, sent time.Time, received time.Time) (time.Duration, time.Duration, error) {
+	if len(resp) < ntpPacketSize {
+		return 0, 0, fmt.Errorf("short NTP reply of %d bytes", len(resp))
+	}
+	if mode := resp[0] & 0x7; mode != 4 {
+		return 0, 0, fmt.Errorf("NTP reply is mode %d, not a server reply", mode)
+	}
+	if resp[0]>>6 == 3 {
+		return 0, 0, fmt.Errorf("NTP server's clock is not synchronized")
+	}
+	if stratum := resp[1]; stratum == 0 || stratum > 15 {
+		return 0, 0, fmt.Errorf("NTP server refused the query (stratum %d, code %q)", stratum, resp[12:16])
+	}
+	// The server echoes our transmit timestamp, so a reply that doesn't isn't for us
+	if binary.BigEndian.Uint64(resp[24:]) != origin {
+		return 0, 0, fmt.Errorf("NTP reply is not for our request")
+	}
+
+	serverReceived := fromNTP(binary.BigEndian.Uint64(resp[32:]))
+	serverSent := fromNTP(binary.BigEndian.Uint64(resp[40:]))
+	offset := (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2
+	rtt := received.Sub(sent) - serverSent.Sub(serverReceived)
+	return offset, rtt, nil
+}
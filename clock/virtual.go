@@ -0,0 +1,57 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"time"
+)
+
+// A Virtual clock runs Rate times as fast as the system clock, from the moment it was made, so
+// that tests spanning many blocks run in seconds rather than minutes.  Nodes that must agree on
+// the time share one Virtual clock.  Its rate never changes, so it needs no locking.
+type Virtual struct {
+	rate  float64
+	start time.Time // System time it was made at
+}
+
+// NewVirtual returns a clock starting at the system time and running rate times as fast.
+func NewVirtual(rate float64) *Virtual {
+	if rate <= 0 {
+		rate = 1
+	}
+	v := new(Virtual)
+	v.rate = rate
+	v.start = time.Now()
+	return v
+}
+
+func (v *Virtual) Rate() float64 {
+	return v.rate
+}
+
+// Now returns the virtual time.
+func (v *Virtual) Now() time.Time {
+	return v.start.Add(v.Virtual(time.Since(v.start)))
+}
+
+// Real returns how long a virtual duration takes on the system clock.
+func (v *Virtual) Real(d time.Duration) time.Duration {
+	return time.Duration(float64(d) / v.rate)
+}
+
+// Virtual returns how long a duration on the system clock is in virtual time.
+func (v *Virtual) Virtual(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * v.rate)
+}
+
+// Sleep sleeps for a virtual duration.
+func (v *Virtual) Sleep(d time.Duration) {
+	time.Sleep(v.Real(d))
+}
+
+// Since returns the virtual time passed since t.
+func (v *Virtual) Since(t time.Time) time.Duration {
+	return v.Now().Sub(t)
+}
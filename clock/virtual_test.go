@@ -0,0 +1,36 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/clock"
+)
+
+func TestVirtual(t *testing.T) {
+	v := NewVirtual(60)
+	start := v.Now()
+	if !near(start.Sub(time.Now()), 0) {
+		t.Errorf("A virtual clock should start at the system time, not %v", start)
+	}
+
+	before := time.Now()
+	v.Sleep(6 * time.Second)
+	if real := time.Since(before); real < 100*time.Millisecond || real > 500*time.Millisecond {
+		t.Errorf("Sleeping 6 virtual seconds at 60 times should take a tenth of a second, took %v", real)
+	}
+	if passed := v.Since(start); passed < 6*time.Second || passed > 30*time.Second {
+		t.Errorf("Expected about 6 virtual seconds to pass, got %v", passed)
+	}
+
+	if v.Real(time.Minute) != time.Second || v.Virtual(time.Second) != time.Minute {
+		t.Errorf("Conversions are wrong at a rate of %v", v.Rate())
+	}
+	if NewVirtual(0).Rate() != 1 {
+		t.Error("A rate of 0 should run at the system clock's rate")
+	}
+}
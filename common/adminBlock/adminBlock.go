@@ -359,6 +359,10 @@ func (b *AdminBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
 	}
 	b.Header = h
 
+	// Every entry takes at least a byte, so a count past what is left is a lie
+	if int(b.GetHeader().GetMessageCount()) > buf.Len() {
+		return nil, fmt.Errorf("Admin block claims %d entries in %d bytes", b.GetHeader().GetMessageCount(), buf.Len())
+	}
 	b.ABEntries = make([]interfaces.IABEntry, int(b.GetHeader().GetMessageCount()))
 	for i := uint32(0); i < b.GetHeader().GetMessageCount(); i++ {
 		t, err := buf.PeekByte()
@@ -389,8 +393,7 @@ func (b *AdminBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
 		case constants.TYPE_SERVER_FAULT:
 			b.ABEntries[i] = new(ServerFault)
 		default:
-			fmt.Printf("AB UNDEFINED ENTRY %x for block %v\n", t, b.GetHeader().GetDBHeight())
-			panic("Undefined Admin Block Entry Type")
+			return nil, fmt.Errorf("Undefined Admin Block Entry Type %x for block %v", t, b.GetHeader().GetDBHeight())
 		}
 		err = buf.PopBinaryMarshallable(b.ABEntries[i])
 		if err != nil {
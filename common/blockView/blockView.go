@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package blockView describes blocks in plain typed structs, built straight from the block
+// interfaces, for the API and the control panel to show.  Neither has to marshal a block to json
+// and unmarshal it again into a struct of its own to get at its fields.
+package blockView
+
+import (
+	"encoding/hex"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+type DBlockHeader struct {
+	Version      byte
+	NetworkID    uint32
+	BodyMR       string
+	PrevKeyMR    string
+	PrevFullHash string
+	Timestamp    int64  // Seconds since the epoch
+	Time         string // Timestamp, formatted
+	DBHeight     uint32
+	BlockCount   uint32
+	ChainID      string
+}
+
+type DBEntry struct {
+	ChainID string
+	KeyMR   string
+}
+
+type DBlock struct {
+	Header    DBlockHeader
+	DBEntries []DBEntry
+	KeyMR     string
+	FullHash  string
+}
+
+// NewDBlock describes a directory block.
+func NewDBlock(b interfaces.IDirectoryBlock) *DBlock {
+	v := new(DBlock)
+	h := b.GetHeader()
+	v.Header.Version = h.GetVersion()
+	v.Header.NetworkID = h.GetNetworkID()
+	v.Header.BodyMR = hashString(h.GetBodyMR())
+	v.Header.PrevKeyMR = hashString(h.GetPrevKeyMR())
+	v.Header.PrevFullHash = hashString(h.GetPrevFullHash())
+	if ts := h.GetTimestamp(); ts != nil {
+		v.Header.Timestamp = ts.GetTimeSeconds()
+		v.Header.Time = ts.String()
+	}
+	v.Header.DBHeight = h.GetDBHeight()
+	v.Header.BlockCount = h.GetBlockCount()
+	v.Header.ChainID = hashString(b.GetChainID())
+
+	v.DBEntries = make([]DBEntry, 0, len(b.GetDBEntries()))
+	for _, e := range b.GetDBEntries() {
+		v.DBEntries = append(v.DBEntries, DBEntry{hashString(e.GetChainID()), hashString(e.GetKeyMR())})
+	}
+	v.KeyMR = hashString(b.GetKeyMR())
+	v.FullHash = hashString(b.GetFullHash())
+	return v
+}
+
+type EBlockHeader struct {
+	ChainID      string
+	BodyMR       string
+	PrevKeyMR    string
+	PrevFullHash string
+	EBSequence   uint32
+	DBHeight     uint32
+	EntryCount   uint32 // Entries and minute markers
+}
+
+type EBEntry struct {
+	Hash   string
+	Minute int // The minute this marker ends, or 0 for an entry
+}
+
+type EBlock struct {
+	Header   EBlockHeader
+	Entries  []EBEntry // In the order of the body
+	KeyMR    string
+	FullHash string
+}
+
+// NewEBlock describes an entry block.
+func NewEBlock(b interfaces.IEntryBlock) *EBlock {
+	v := new(EBlock)
+	h := b.GetHeader()
+	v.Header.ChainID = hashString(h.GetChainID())
+	v.Header.BodyMR = hashString(h.GetBodyMR())
+	v.Header.PrevKeyMR = hashString(h.GetPrevKeyMR())
+	v.Header.PrevFullHash = hashString(h.GetPrevFullHash())
+	v.Header.EBSequence = h.GetEBSequence()
+	v.Header.DBHeight = h.GetDBHeight()
+	v.Header.EntryCount = h.GetEntryCount()
+
+	hashes := b.GetBody().GetEBEntries()
+	v.Entries = make([]EBEntry, 0, len(hashes))
+	for _, e := range hashes {
+		v.Entries = append(v.Entries, EBEntry{hashString(e), MinuteMarker(e)})
+	}
+	if keyMR, err := b.KeyMR(); err == nil {
+		v.KeyMR = keyMR.String()
+	}
+	v.FullHash = hashString(b.GetHash())
+	return v
+}
+
+// MinuteMarker returns the minute an entry block's entry ends, if it is a minute marker, and 0
+// if it is the hash of an entry.
+func MinuteMarker(h interfaces.IHash) int {
+	if h == nil {
+		return 0
+	}
+	b := h.Bytes()
+	if len(b) != 32 {
+		return 0
+	}
+	for _, c := range b[:31] {
+		if c != 0 {
+			return 0
+		}
+	}
+	if b[31] < 1 || b[31] > 10 {
+		return 0
+	}
+	return int(b[31])
+}
+
+type ABlockHeader struct {
+	PrevBackRefHash     string
+	DBHeight            uint32
+	HeaderExpansionArea string // Hex
+	MessageCount        uint32
+	BodySize            uint32
+	AdminChainID        string
+}
+
+type ABlock struct {
+	Header            ABlockHeader
+	BackReferenceHash string
+	LookupHash        string
+}
+
+// NewABlock describes an admin block, but not its entries, whose meaning the caller knows best
+// how to show.
+func NewABlock(b interfaces.IAdminBlock) *ABlock {
+	v := new(ABlock)
+	h := b.GetHeader()
+	v.Header.PrevBackRefHash = hashString(h.GetPrevBackRefHash())
+	v.Header.DBHeight = h.GetDBHeight()
+	v.Header.HeaderExpansionArea = hex.EncodeToString(h.GetHeaderExpansionArea())
+	v.Header.MessageCount = h.GetMessageCount()
+	v.Header.BodySize = h.GetBodySize()
+	v.Header.AdminChainID = hashString(h.GetAdminChainID())
+	if hash, err := b.BackReferenceHash(); err == nil {
+		v.BackReferenceHash = hash.String()
+	}
+	if hash, err := b.LookupHash(); err == nil {
+		v.LookupHash = hash.String()
+	}
+	return v
+}
+
+// Blocks being built, or read from a bad database, may be missing hashes
+func hashString(h interfaces.IHash) string {
+	if h == nil {
+		return ""
+	}
+	return h.String()
+}
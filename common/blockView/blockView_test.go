@@ -0,0 +1,94 @@
+package blockView_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/blockView"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestNewEBlock(t *testing.T) {
+	eb := entryBlock.NewEBlock()
+	eb.GetHeader().SetChainID(primitives.Sha([]byte("chain")))
+	eb.GetHeader().SetDBHeight(12)
+	var entries []*entryBlock.Entry
+	for i, minute := range []byte{3, 10} {
+		e := entryBlock.NewEntry()
+		e.ChainID = eb.GetHeader().GetChainID()
+		e.Content = primitives.ByteSlice{Bytes: []byte{byte(i)}}
+		if err := eb.AddEBEntry(e); err != nil {
+			t.Fatal(err)
+		}
+		eb.AddEndOfMinuteMarker(minute)
+		entries = append(entries, e)
+	}
+
+	v := NewEBlock(eb)
+	if v.Header.ChainID != eb.GetHeader().GetChainID().String() || v.Header.DBHeight != 12 {
+		t.Errorf("Wrong header %+v", v.Header)
+	}
+	keyMR, _ := eb.KeyMR()
+	if v.KeyMR != keyMR.String() || v.FullHash != eb.GetHash().String() {
+		t.Errorf("Wrong hashes %s %s", v.KeyMR, v.FullHash)
+	}
+	if len(v.Entries) != 4 {
+		t.Fatalf("Got %d entries, expected 4", len(v.Entries))
+	}
+	for i, e := range entries {
+		if v.Entries[2*i].Hash != e.GetHash().String() || v.Entries[2*i].Minute != 0 {
+			t.Errorf("Wrong entry %+v", v.Entries[2*i])
+		}
+	}
+	if v.Entries[1].Minute != 3 || v.Entries[3].Minute != 10 {
+		t.Errorf("Wrong minute markers %+v %+v", v.Entries[1], v.Entries[3])
+	}
+}
+
+func TestMinuteMarker(t *testing.T) {
+	h := primitives.NewZeroHash()
+	if MinuteMarker(h) != 0 {
+		t.Errorf("The zero hash is not a minute marker")
+	}
+	h.Bytes()[31] = 11
+	if MinuteMarker(h) != 0 {
+		t.Errorf("There is no minute 11")
+	}
+	h.Bytes()[31] = 1
+	h.Bytes()[0] = 1
+	if MinuteMarker(h) != 0 {
+		t.Errorf("An entry hash is not a minute marker")
+	}
+	if MinuteMarker(nil) != 0 {
+		t.Errorf("Nothing is not a minute marker")
+	}
+}
+
+func TestNewDBlock(t *testing.T) {
+	db := directoryBlock.NewDirectoryBlock(nil)
+	db.GetHeader().SetDBHeight(5)
+	db.GetHeader().SetTimestamp(primitives.NewTimestampFromSeconds(1500000000))
+	chainID := primitives.Sha([]byte("chain"))
+	keyMR := primitives.Sha([]byte("keymr"))
+	if err := db.(*directoryBlock.DirectoryBlock).AddEntry(chainID, keyMR); err != nil {
+		t.Fatal(err)
+	}
+
+	v := NewDBlock(db)
+	if v.Header.DBHeight != 5 || v.Header.Timestamp != 1500000000 || v.Header.Time == "" {
+		t.Errorf("Wrong header %+v", v.Header)
+	}
+	if v.KeyMR != db.GetKeyMR().String() || v.FullHash != db.GetFullHash().String() {
+		t.Errorf("Wrong hashes %s %s", v.KeyMR, v.FullHash)
+	}
+	found := false
+	for _, e := range v.DBEntries {
+		if e.ChainID == chainID.String() && e.KeyMR == keyMR.String() {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Entry block missing from %+v", v.DBEntries)
+	}
+}
@@ -43,9 +43,23 @@ const (
 
 	MISSING_ENTRY_BLOCKS //27
 	ENTRY_BLOCK_RESPONSE //28
+
+	DBLOCK_HEADERS_REQUEST  // 29
+	DBLOCK_HEADERS_RESPONSE // 30
+
+	MISSING_MSG_RANGE // 31
+	MISSING_MSG_BATCH // 32
+
+	BATCH_COMMIT_ENTRY_MSG // 33
+
+	ACK_BATCH_MSG // 34
+
+	PROBE_MSG // 35
+
+	BOUND_COMMIT_CHAIN_MSG // 36
 )
 
-const NUM_MESSAGES = 29
+const NUM_MESSAGES = 37
 
 const (
 	// Limits for keeping inputs from flooding our execution
@@ -53,9 +67,29 @@ const (
 	INMSGQUEUE_MED  = 500
 	INMSGQUEUE_LOW  = 100
 
+	// Caps on the messages we hold; past these, the lowest priority and oldest are dropped
+	HOLDING_MAX = 20000
+	ACKS_MAX    = 20000
+
 	DBSTATE_REQUEST_LIM_HIGH = 200
 	DBSTATE_REQUEST_LIM_MED  = 50
 
+	// Directory block timestamps can be no earlier than the median of this many blocks before
+	// them, and no more than this many seconds ahead of our clock
+	BLOCK_TIMESTAMP_MEDIAN_BLOCKS = 11
+	BLOCK_TIMESTAMP_MAX_DRIFT     = 2 * 60 * 60
+
+	// A DBState from the network needs signatures from more than this percent of the federated
+	// servers
+	DBSTATE_SIG_THRESHOLD = 50
+
+	// Consensus timing: seconds a VM can be quiet before we ask for its next message, seconds
+	// between resends of a message we hold, and milliseconds we wait on a missing EOM before
+	// asking for it, beyond the wait every request for a missing message has
+	ACK_TIMEOUT     = 20
+	RESEND_INTERVAL = 20
+	EOM_WAIT        = 0
+
 	// Replay
 	INTERNAL_REPLAY = 1
 	NETWORK_REPLAY  = 2
@@ -120,6 +154,26 @@ const (
 	NETWORK_CUSTOM            // 3
 )
 
+// ACTIVATIONS: changes to the protocol that every node of a network makes from the same
+// directory block height.  Each network's heights are set in the [Activation "<name>"] sections
+// of the config file; none is active by default.  See state/activations.go
+const (
+	ACTIVATION_BLOCK_TIMESTAMP = "BlockTimestamp" // The directory block timestamp rules
+	ACTIVATION_CHAIN_BINDING   = "ChainBinding"   // Commit chains bound to their first entry
+	ACTIVATION_BATCH_COMMIT    = "BatchCommit"    // Entry commits sent in batches
+	ACTIVATION_ACK_BATCH       = "AckBatch"       // Leaders' acks sent in batches
+
+	ACTIVATION_COMMIT_REPLACEMENT = "CommitReplacement" // Only a commit paying more replaces a pending one
+)
+
+var ACTIVATIONS = []string{
+	ACTIVATION_BLOCK_TIMESTAMP,
+	ACTIVATION_CHAIN_BINDING,
+	ACTIVATION_BATCH_COMMIT,
+	ACTIVATION_ACK_BATCH,
+	ACTIVATION_COMMIT_REPLACEMENT,
+}
+
 // Slices and arrays that should not ever be modified:
 //===================================================
 // Used as a key in the wallet to find the current seed value.
@@ -261,7 +261,7 @@ func (b *DBlockHeader) UnmarshalBinaryData(data []byte) ([]byte, error) {
 	}
 
 	if b.BlockCount > 100000 {
-		panic("Receive: Blockcount too great in directory block" + fmt.Sprintf(":::: %d", b.BlockCount))
+		return nil, fmt.Errorf("Receive: Blockcount too great in directory block: %d", b.BlockCount)
 	}
 
 	return buf.DeepCopyBytes(), nil
@@ -297,3 +297,15 @@ func NewDBlockHeader() *DBlockHeader {
 
 	return d
 }
+
+// KeyMRFromHeader computes the KeyMR of the directory block with this header.  The header holds the
+// BodyMR and the BlockCount, so the body itself is not needed.
+func KeyMRFromHeader(h interfaces.IDirectoryBlockHeader) (interfaces.IHash, error) {
+	data, err := h.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	hashes := []interfaces.IHash{primitives.Sha(data), h.GetBodyMR()}
+	merkle := primitives.BuildMerkleTreeStore(hashes)
+	return primitives.NewHash(merkle[len(merkle)-1].Bytes()), nil
+}
@@ -127,6 +127,16 @@ func NewChainID(e interfaces.IEBEntry) interfaces.IHash {
 	return id
 }
 
+// ExtIDMerkleRoot returns the merkle root of the hashes of the entry's external IDs, which a commit
+// chain can be bound to (see messages.CommitChainMsg).
+func ExtIDMerkleRoot(e interfaces.IEBEntry) interfaces.IHash {
+	hashes := []interfaces.IHash{}
+	for _, v := range e.ExternalIDs() {
+		hashes = append(hashes, primitives.Sha(v))
+	}
+	return primitives.ComputeMerkleRoot(hashes)
+}
+
 func (e *Entry) GetContent() []byte {
 	return e.Content.Bytes
 }
@@ -228,3 +228,22 @@ func TestMarshalUnmarshalEntryList(t *testing.T) {
 		}
 	}
 }
+
+func TestExtIDMerkleRoot(t *testing.T) {
+	entry := new(Entry)
+	if !ExtIDMerkleRoot(entry).IsZero() {
+		t.Errorf("An entry without external IDs should commit to the zero hash")
+	}
+
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("a")}, {Bytes: []byte("b")}}
+	root := ExtIDMerkleRoot(entry)
+	want := primitives.ComputeMerkleRoot([]interfaces.IHash{primitives.Sha([]byte("a")), primitives.Sha([]byte("b"))})
+	if !root.IsSameAs(want) {
+		t.Errorf("Merkle root %x, expected %x", root.Bytes(), want.Bytes())
+	}
+
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("b")}, {Bytes: []byte("a")}}
+	if ExtIDMerkleRoot(entry).IsSameAs(root) {
+		t.Errorf("The order of the external IDs should change the merkle root")
+	}
+}
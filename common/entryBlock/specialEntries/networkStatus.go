@@ -0,0 +1,111 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package specialEntries
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A network status entry is a NetworkStatus co-signed by the federated servers, on the chain
+// named by the single ExtID NetworkStatusChainName.  Its content is the JSON of the status, and
+// its ExtIDs are:
+//
+//	[0]      "NetworkStatus"
+//	[1+2i]   identity chain ID of signer i (32 bytes)
+//	[2+2i]   ed25519 signature of the content by signer i's block signing key (64 bytes)
+//
+// Every node checks the signatures against the federated servers, and takes a status signed by
+// most of them as the network's.
+
+const NetworkStatusChainName = "Factom Network Status"
+const NetworkStatusTag = "NetworkStatus"
+
+// NetworkStatusChainID returns the ID of the chain network status entries are published on.
+func NetworkStatusChainID() interfaces.IHash {
+	e := entryBlock.NewEntry()
+	e.ExtIDs = []primitives.ByteSlice{{Bytes: []byte(NetworkStatusChainName)}}
+	return entryBlock.NewChainID(e)
+}
+
+type NetworkActivation struct {
+	Name        string `json:"name"`
+	Height      uint32 `json:"height"`
+	MinProtocol uint16 `json:"minprotocol,omitempty"` // Earliest p2p protocol version with the change; 0 if not a p2p change
+}
+
+type NetworkStatus struct {
+	Height          uint32              `json:"height"`          // Height the status was signed at; each must be above the last
+	ProtocolVersion string              `json:"protocolversion"` // The version every node should run
+	Activations     []NetworkActivation `json:"activations"`     // Planned activation heights
+	Message         string              `json:"message,omitempty"`
+}
+
+var _ interfaces.BinaryMarshallable = (*NetworkStatus)(nil)
+
+// MarshalBinary returns the content that is signed.  The status always marshals to the same
+// bytes, so each authority signs the same content.  JSON starts with '{', so the content can never
+// be mistaken for a signed consensus message.
+func (ns *NetworkStatus) MarshalBinary() ([]byte, error) {
+	return json.Marshal(ns)
+}
+
+func (ns *NetworkStatus) UnmarshalBinaryData(data []byte) ([]byte, error) {
+	return nil, ns.UnmarshalBinary(data)
+}
+
+func (ns *NetworkStatus) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, ns)
+}
+
+type NetworkStatusSignature struct {
+	IdentityChainID interfaces.IHash
+	Signature       [constants.SIGNATURE_LENGTH]byte
+}
+
+// NewNetworkStatusEntry returns the entry publishing content with its signatures.
+func NewNetworkStatusEntry(content []byte, sigs []NetworkStatusSignature) *entryBlock.Entry {
+	e := entryBlock.NewEntry()
+	e.ChainID = NetworkStatusChainID()
+	e.ExtIDs = []primitives.ByteSlice{{Bytes: []byte(NetworkStatusTag)}}
+	for _, sig := range sigs {
+		s := sig.Signature
+		e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: sig.IdentityChainID.Bytes()}, primitives.ByteSlice{Bytes: s[:]})
+	}
+	e.Content = primitives.ByteSlice{Bytes: content}
+	return e
+}
+
+// ParseNetworkStatusEntry returns the status of a network status entry and its signatures,
+// which are not checked.
+func ParseNetworkStatusEntry(entry interfaces.IEBEntry) (*NetworkStatus, []NetworkStatusSignature, error) {
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) < 1 || string(extIDs[0]) != NetworkStatusTag {
+		return nil, nil, fmt.Errorf("Not a network status entry")
+	}
+	if len(extIDs)%2 != 1 {
+		return nil, nil, fmt.Errorf("A signer is missing its signature")
+	}
+	var sigs []NetworkStatusSignature
+	for i := 1; i < len(extIDs); i += 2 {
+		if len(extIDs[i]) != constants.HASH_LENGTH || len(extIDs[i+1]) != constants.SIGNATURE_LENGTH {
+			return nil, nil, fmt.Errorf("Signature %d is malformed", i/2)
+		}
+		sig := NetworkStatusSignature{IdentityChainID: primitives.NewHash(extIDs[i])}
+		copy(sig.Signature[:], extIDs[i+1])
+		sigs = append(sigs, sig)
+	}
+
+	status := new(NetworkStatus)
+	if err := status.UnmarshalBinary(entry.GetContent()); err != nil {
+		return nil, nil, err
+	}
+	return status, sigs, nil
+}
@@ -0,0 +1,63 @@
+package specialEntries_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestNetworkStatusEntry(t *testing.T) {
+	status := new(NetworkStatus)
+	status.Height = 1000
+	status.ProtocolVersion = "5.0.0"
+	status.Activations = []NetworkActivation{{Name: "fast-boot", Height: 1200}}
+
+	content, err := status.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sigs []NetworkStatusSignature
+	for i := 0; i < 3; i++ {
+		key := primitives.RandomPrivateKey()
+		sig := NetworkStatusSignature{IdentityChainID: primitives.Sha([]byte{byte(i)})}
+		sig.Signature = *key.Sign(content).GetSignature()
+		sigs = append(sigs, sig)
+	}
+
+	e := NewNetworkStatusEntry(content, sigs)
+	if !e.GetChainID().IsSameAs(NetworkStatusChainID()) {
+		t.Error("The entry is not on the network status chain")
+	}
+
+	status2, sigs2, err := ParseNetworkStatusEntry(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content2, _ := status2.MarshalBinary()
+	if string(content) != string(content2) {
+		t.Errorf("Status %s came back as %s", content, content2)
+	}
+	if len(sigs2) != len(sigs) {
+		t.Fatalf("Expected %d signatures, got %d", len(sigs), len(sigs2))
+	}
+	for i := range sigs {
+		if !sigs[i].IdentityChainID.IsSameAs(sigs2[i].IdentityChainID) || sigs[i].Signature != sigs2[i].Signature {
+			t.Errorf("Signature %d did not round trip", i)
+		}
+	}
+
+	// A signer without its signature
+	e.ExtIDs = e.ExtIDs[:len(e.ExtIDs)-1]
+	if _, _, err := ParseNetworkStatusEntry(e); err == nil {
+		t.Error("Parsed an entry missing a signature")
+	}
+
+	// Not a network status
+	e.ExtIDs = e.ExtIDs[:1]
+	e.ExtIDs[0].Bytes = []byte("Something else")
+	if _, _, err := ParseNetworkStatusEntry(e); err == nil {
+		t.Error("Parsed an entry without the tag")
+	}
+}
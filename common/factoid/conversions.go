@@ -104,3 +104,22 @@ func PrivateKeyStringToEverythingString(private string) (string, string, string,
 	}
 	return private, pub, add, nil
 }
+
+/******************************************************************************/
+/****************************Entry Credits*************************************/
+/******************************************************************************/
+
+// FactoshisToECs returns the entry credits an EC output of factoshis buys at the exchange rate,
+// in factoshis per entry credit.  Like the factoid state, it rounds down.  A rate of 0 buys none.
+func FactoshisToECs(factoshis uint64, factoshisPerEC uint64) uint64 {
+	if factoshisPerEC == 0 {
+		return 0
+	}
+	return factoshis / factoshisPerEC
+}
+
+// ECsToFactoshis returns the factoshis an EC output needs to buy ecs entry credits at the
+// exchange rate.
+func ECsToFactoshis(ecs uint64, factoshisPerEC uint64) uint64 {
+	return ecs * factoshisPerEC
+}
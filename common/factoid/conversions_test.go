@@ -85,3 +85,21 @@ func TestECAddress(t *testing.T) {
 		t.Errorf("Wrong address returned - %v", add)
 	}
 }
+
+func TestECConversions(t *testing.T) {
+	if FactoshisToECs(10000, 1000) != 10 {
+		t.Errorf("Expected 10 ECs for 10000 factoshis at 1000")
+	}
+	if FactoshisToECs(10999, 1000) != 10 {
+		t.Errorf("Expected a part of an EC to be rounded down")
+	}
+	if FactoshisToECs(10000, 0) != 0 {
+		t.Errorf("Expected no ECs at a rate of 0")
+	}
+	if ECsToFactoshis(10, 1000) != 10000 {
+		t.Errorf("Expected 10000 factoshis for 10 ECs at 1000")
+	}
+	if FactoshisToECs(ECsToFactoshis(123, 6666), 6666) != 123 {
+		t.Errorf("Expected converting there and back to give the same ECs")
+	}
+}
@@ -316,6 +316,10 @@ func (b *FBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	// Every transaction takes at least a byte, so a count past what is left is a lie
+	if int(cnt) > buf.Len() {
+		return nil, fmt.Errorf("Factoid block claims %d transactions in %d bytes", cnt, buf.Len())
+	}
 	b.Transactions = make([]interfaces.ITransaction, int(cnt), int(cnt))
 	for i, _ := range b.endOfPeriod {
 		b.endOfPeriod[i] = 0
@@ -332,6 +336,9 @@ func (b *FBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
 			if err != nil {
 				return nil, err
 			}
+			if periodMark >= len(b.endOfPeriod) {
+				return nil, fmt.Errorf("Too many minute markers in factoid block")
+			}
 			b.endOfPeriod[periodMark] = int(i)
 			periodMark++
 
@@ -0,0 +1,58 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A signed message proves control of an address: its key signs the message behind
+// SignedMessagePrefix, so that the signature can never pass for one of a transaction or of
+// anything else the key signs.  An EC address is its public key, while an FA address is the hash
+// of an RCD-1 holding it, so the public key of an FA address has to be given with the signature.
+
+const SignedMessagePrefix = "Factom Signed Message:\n"
+
+// SignedMessageData returns what an address's key signs for a message.
+func SignedMessageData(message string) []byte {
+	return []byte(SignedMessagePrefix + message)
+}
+
+// VerifyAddressSignature returns an error unless signature is that of the message by the key of
+// address, an FA or EC address.  The public key can be nil for an EC address.
+func VerifyAddressSignature(address string, publicKey []byte, message string, signature []byte) error {
+	switch {
+	case primitives.ValidateFUserStr(address):
+		if len(publicKey) != constants.ADDRESS_LENGTH {
+			return fmt.Errorf("The public key of an FA address is needed")
+		}
+		adr, err := PublicKeyToFactoidAddress(publicKey)
+		if err != nil {
+			return err
+		}
+		if primitives.ConvertFctAddressToUserStr(adr) != address {
+			return fmt.Errorf("The public key is not that of %s", address)
+		}
+	case primitives.ValidateECUserStr(address):
+		key := primitives.ConvertUserStrToAddress(address)
+		if publicKey != nil && string(publicKey) != string(key) {
+			return fmt.Errorf("The public key is not that of %s", address)
+		}
+		publicKey = key
+	default:
+		return fmt.Errorf("Invalid address %q", address)
+	}
+
+	if len(signature) != constants.SIGNATURE_LENGTH {
+		return fmt.Errorf("A signature is %d bytes", constants.SIGNATURE_LENGTH)
+	}
+	if !primitives.VerifySlice(publicKey, SignedMessageData(message), signature) {
+		return fmt.Errorf("The signature is not that of the message by %s", address)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestVerifyAddressSignature(t *testing.T) {
+	key := primitives.RandomPrivateKey()
+	pub := key.Pub[:]
+	fa, _ := PublicKeyToFactoidAddress(pub)
+	ec, _ := PublicKeyToECAddress(pub)
+	faStr := primitives.ConvertFctAddressToUserStr(fa)
+	ecStr := primitives.ConvertECAddressToUserStr(ec)
+
+	message := "I control this address"
+	sig := key.Sign(SignedMessageData(message)).Bytes()
+
+	if err := VerifyAddressSignature(faStr, pub, message, sig); err != nil {
+		t.Errorf("FA address: %v", err)
+	}
+	if err := VerifyAddressSignature(ecStr, nil, message, sig); err != nil {
+		t.Errorf("EC address: %v", err)
+	}
+
+	if err := VerifyAddressSignature(faStr, nil, message, sig); err == nil {
+		t.Error("Verified an FA address without its public key")
+	}
+	other := primitives.RandomPrivateKey()
+	if err := VerifyAddressSignature(faStr, other.Pub[:], message, sig); err == nil {
+		t.Error("Verified with the public key of another address")
+	}
+	if err := VerifyAddressSignature(faStr, pub, message+".", sig); err == nil {
+		t.Error("Verified the signature of another message")
+	}
+	if err := VerifyAddressSignature(ecStr, nil, message, key.Sign([]byte(message)).Bytes()); err == nil {
+		t.Error("Verified a signature of the message without its prefix")
+	}
+	if err := VerifyAddressSignature("FA123", pub, message, sig); err == nil {
+		t.Error("Verified a bad address")
+	}
+}
@@ -110,7 +110,7 @@ func (t *Transaction) GetBlockHeight() (height uint32) {
 
 // Clears caches if they are no long valid.
 func (t *Transaction) clearCaches() {
-	return
+	t.sigValid = false
 }
 
 func (*Transaction) GetVersion() uint64 {
@@ -160,6 +160,7 @@ func (t *Transaction) GetTimestamp() interfaces.Timestamp {
 
 func (t *Transaction) SetTimestamp(ts interfaces.Timestamp) {
 	t.MilliTimestamp = ts.GetTimeMilliUInt64()
+	t.clearCaches()
 }
 
 func (t *Transaction) SetSignatureBlock(i int, sig interfaces.ISignatureBlock) {
@@ -167,6 +168,7 @@ func (t *Transaction) SetSignatureBlock(i int, sig interfaces.ISignatureBlock) {
 		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
 	}
 	t.SigBlocks[i] = sig
+	t.clearCaches()
 }
 
 func (t *Transaction) GetSignatureBlock(i int) interfaces.ISignatureBlock {
@@ -358,12 +360,12 @@ func (t Transaction) Validate(index int) error {
 // This call ONLY checks signatures.  Call interfaces.ITransaction.Validate() to check the structure of the
 // transaction.
 //
-func (t Transaction) ValidateSignatures() error {
+func (t *Transaction) ValidateSignatures() error {
 	if !t.sigValid {
 		missingCnt := 0
 		sigBlks := t.GetSignatureBlocks()
 		for i, rcd := range t.RCDs {
-			if !rcd.CheckSig(&t, sigBlks[i]) {
+			if !rcd.CheckSig(t, sigBlks[i]) {
 				missingCnt++
 			}
 		}
@@ -425,6 +427,7 @@ func (t *Transaction) GetRCD(i int) (interfaces.IRCD, error) {
 // UnmarshalBinary assumes that the Binary is all good.  We do error
 // out if there isn't enough data, or the transaction is too large.
 func (t *Transaction) UnmarshalBinaryData(data []byte) ([]byte, error) {
+	t.clearCaches()
 	buf := primitives.NewBuffer(data)
 
 	v, err := buf.PopVarInt()
@@ -492,6 +495,9 @@ func (t *Transaction) UnmarshalBinaryData(data []byte) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		if b != 1 && b != 2 {
+			return nil, fmt.Errorf("Unknown RCD type %d", b)
+		}
 		t.RCDs[i] = CreateRCD([]byte{b})
 		err = buf.PopBinaryMarshallable(t.RCDs[i])
 		if err != nil {
@@ -701,6 +707,7 @@ func (t *Transaction) AddAuthorization(auth interfaces.IRCD) {
 		t.RCDs = make([]interfaces.IRCD, 0, 5)
 	}
 	t.RCDs = append(t.RCDs, auth)
+	t.clearCaches()
 }
 
 func (e *Transaction) JSONByte() ([]byte, error) {
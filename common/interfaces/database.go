@@ -20,12 +20,32 @@ type IDatabase interface {
 	DoesKeyExist(bucket, key []byte) (bool, error)
 }
 
+// A Compacter is a database that can be compacted to reclaim space, as LevelDB can.
+type Compacter interface {
+	Compact() error
+}
+
 type Record struct {
 	Bucket []byte
 	Key    []byte
 	Data   BinaryMarshallable
 }
 
+// An ExchangeRateChange is the EC exchange rate, in factoshis per entry credit, from Height on.
+type ExchangeRateChange struct {
+	Height uint32
+	Rate   uint64
+}
+
+// An EntryBloomStatus describes the bloom filter over saved entry hashes.
+type EntryBloomStatus struct {
+	Entries       uint64  `json:"entries"`       // Entry hashes in the filter
+	Bits          uint64  `json:"bits"`          // Size of the filter
+	Ready         bool    `json:"ready"`         // Every saved entry is in the filter
+	FalsePositive float64 `json:"falsepositive"` // Estimated chance the filter can't rule out a missing entry
+	Spared        uint64  `json:"spared"`        // Database lookups the filter has spared
+}
+
 type DatabaseBatchable interface {
 	BinaryMarshallableAndCopyable
 	GetDatabaseHeight() uint32
@@ -12,8 +12,13 @@ import ()
 
 //A simplified DBOverlay to make sure we are not calling functions that could cause problems
 type DBOverlaySimple interface {
+	BuildExchangeRateIndex() error
+	BuildEntryBloom(bits uint64) error
+	BuildTimeIndex() error
 	Close() error
 	DoesKeyExist(bucket, key []byte) (bool, error)
+	EntryBloomStatus() *EntryBloomStatus
+	EntryExists(hash IHash) (bool, error)
 	ExecuteMultiBatch() error
 	FetchABlock(IHash) (IAdminBlock, error)
 	FetchABlockByHeight(blockHeight uint32) (IAdminBlock, error)
@@ -21,18 +26,32 @@ type DBOverlaySimple interface {
 	FetchDBlock(IHash) (IDirectoryBlock, error)
 	FetchDBlockByHeight(uint32) (IDirectoryBlock, error)
 	FetchDBlockHead() (IDirectoryBlock, error)
+	FetchDBlockHeightByTimestamp(seconds int64) (uint32, bool, error)
+	FetchDBlockTimestampByHeight(height uint32) (int64, bool, error)
 	FetchEBlock(IHash) (IEntryBlock, error)
 	FetchEBlockHead(chainID IHash) (IEntryBlock, error)
 	FetchECBlock(IHash) (IEntryCreditBlock, error)
 	FetchECBlockByHeight(blockHeight uint32) (IEntryCreditBlock, error)
 	FetchECTransaction(hash IHash) (IECBlockEntry, error)
 	FetchEntry(IHash) (IEBEntry, error)
+	FetchExchangeRateByHeight(height uint32) (uint64, uint32, bool, error)
+	FetchExchangeRateChanges() ([]ExchangeRateChange, error)
 	FetchFBlock(IHash) (IFBlock, error)
 	FetchFBlockByHeight(blockHeight uint32) (IFBlock, error)
 	FetchFactoidTransaction(hash IHash) (ITransaction, error)
 	FetchHeadIndexByChainID(chainID IHash) (IHash, error)
 	FetchIncludedIn(hash IHash) (IHash, error)
 	FetchPaidFor(hash IHash) (IHash, error)
+	SaveReplayFilter(filter BinaryMarshallable) error
+	FetchReplayFilter(dst BinaryMarshallable) (BinaryMarshallable, error)
+	SaveHeldCommits(commits BinaryMarshallable) error
+	FetchHeldCommits(dst BinaryMarshallable) (BinaryMarshallable, error)
+	SaveBlockTimeline(height uint32, timeline BinaryMarshallable) error
+	FetchBlockTimeline(height uint32, dst BinaryMarshallable) (BinaryMarshallable, error)
+	DeleteBlockTimeline(height uint32) error
+	SaveProcessListLog(height uint32, log BinaryMarshallable) error
+	FetchProcessListLog(height uint32, dst BinaryMarshallable) (BinaryMarshallable, error)
+	DeleteProcessListLog(height uint32) error
 	FetchAllEBlocksByChain(IHash) ([]IEntryBlock, error)
 	InsertEntryMultiBatch(entry IEBEntry) error
 	ProcessABlockMultiBatch(block DatabaseBatchable) error
@@ -42,9 +61,12 @@ type DBOverlaySimple interface {
 	ProcessEBlockMultiBatchWithoutHead(eblock DatabaseBlockWithEntries, checkForDuplicateEntries bool) error
 	ProcessECBlockMultiBatch(IEntryCreditBlock, bool) (err error)
 	ProcessFBlockMultiBatch(DatabaseBlockWithEntries) error
+	DeleteFBlock(keyMR IHash) error
 	FetchDirBlockInfoByKeyMR(hash IHash) (IDirBlockInfo, error)
 	SetExportData(path string)
 	StartMultiBatch()
+	TakeMultiBatch() []Record
+	PutInBatch(records []Record) error
 	Trim()
 	FetchAllEntriesByChainID(chainID IHash) ([]IEBEntry, error)
 }
@@ -60,6 +82,7 @@ type DBOverlay interface {
 	StartMultiBatch()
 	PutInMultiBatch(records []Record)
 	ExecuteMultiBatch() error
+	TakeMultiBatch() []Record
 	GetEntryType(hash IHash) (IHash, error)
 
 	//**********************************Entry**********************************//
@@ -71,6 +94,13 @@ type DBOverlay interface {
 	// FetchEntry gets an entry by hash from the database.
 	FetchEntry(IHash) (IEBEntry, error)
 
+	// EntryExists is true if an entry is in the database, asking the entry bloom first if built.
+	EntryExists(hash IHash) (bool, error)
+
+	// BuildEntryBloom makes a bloom filter of the given number of bits over the saved entry hashes.
+	BuildEntryBloom(bits uint64) error
+	EntryBloomStatus() *EntryBloomStatus
+
 	FetchAllEntriesByChainID(chainID IHash) ([]IEBEntry, error)
 
 	FetchAllEntryIDsByChainID(chainID IHash) ([]IHash, error)
@@ -135,6 +165,15 @@ type DBOverlay interface {
 
 	FetchDBlockHead() (IDirectoryBlock, error)
 
+	// FetchDBlockHeightByTimestamp gets the height of the first directory block at or after a time in seconds.
+	FetchDBlockHeightByTimestamp(seconds int64) (uint32, bool, error)
+
+	// FetchDBlockTimestampByHeight gets the timestamp in seconds of a directory block.
+	FetchDBlockTimestampByHeight(height uint32) (int64, bool, error)
+
+	// BuildTimeIndex records the timestamps of the directory blocks saved before they were recorded.
+	BuildTimeIndex() error
+
 	// FetchDBKeyMRByHeight gets a dBlock KeyMR from the database.
 	FetchDBKeyMRByHeight(dBlockHeight uint32) (dBlockKeyMR IHash, err error)
 
@@ -204,6 +243,9 @@ type DBOverlay interface {
 	ProcessFBlockBatchWithoutHead(DatabaseBlockWithEntries) error
 	ProcessFBlockMultiBatch(DatabaseBlockWithEntries) error
 
+	// DeleteFBlock removes a factoid block and its indexes, for pruned nodes
+	DeleteFBlock(keyMR IHash) error
+
 	FetchFBlock(IHash) (IFBlock, error)
 
 	// FetchFBlockByHash gets a factoid block by hash from the database.
@@ -211,6 +253,13 @@ type DBOverlay interface {
 	FetchFBlockBySecondary(IHash) (IFBlock, error)
 	FetchFBlockByHeight(blockHeight uint32) (IFBlock, error)
 
+	// FetchExchangeRateByHeight gets the EC exchange rate at a height, and the height it took effect at.
+	FetchExchangeRateByHeight(height uint32) (uint64, uint32, bool, error)
+	FetchExchangeRateChanges() ([]ExchangeRateChange, error)
+
+	// BuildExchangeRateIndex records the exchange rate changes of the factoid blocks saved before they were recorded.
+	BuildExchangeRateIndex() error
+
 	// FetchAllFBlocks gets all of the factoid blocks
 	FetchAllFBlocks() ([]IFBlock, error)
 	FetchAllFBlockKeys() ([]IHash, error)
@@ -252,6 +301,44 @@ type DBOverlay interface {
 
 	FetchPaidFor(hash IHash) (IHash, error)
 
+	//******************************ReplayFilter**********************************//
+
+	// SaveReplayFilter keeps the State's replay filter across restarts.
+	SaveReplayFilter(filter BinaryMarshallable) error
+
+	// FetchReplayFilter loads the saved replay filter into dst; returns nil if there is none.
+	FetchReplayFilter(dst BinaryMarshallable) (BinaryMarshallable, error)
+
+	//******************************HeldCommits**********************************//
+
+	// SaveHeldCommits keeps the commits waiting on their reveals across restarts.
+	SaveHeldCommits(commits BinaryMarshallable) error
+
+	// FetchHeldCommits loads the saved commits into dst; returns nil if there are none.
+	FetchHeldCommits(dst BinaryMarshallable) (BinaryMarshallable, error)
+
+	//******************************BlockTimelines**********************************//
+
+	// SaveBlockTimeline keeps the timeline of the block at height.
+	SaveBlockTimeline(height uint32, timeline BinaryMarshallable) error
+
+	// FetchBlockTimeline loads the timeline of the block at height into dst; returns nil if there is none.
+	FetchBlockTimeline(height uint32, dst BinaryMarshallable) (BinaryMarshallable, error)
+
+	// DeleteBlockTimeline drops the timeline of the block at height.
+	DeleteBlockTimeline(height uint32) error
+
+	//******************************ProcessListLogs*********************************//
+
+	// SaveProcessListLog keeps the process list log of the block at height.
+	SaveProcessListLog(height uint32, log BinaryMarshallable) error
+
+	// FetchProcessListLog loads the process list log of the block at height into dst; returns nil if there is none.
+	FetchProcessListLog(height uint32, dst BinaryMarshallable) (BinaryMarshallable, error)
+
+	// DeleteProcessListLog drops the process list log of the block at height.
+	DeleteProcessListLog(height uint32) error
+
 	FetchFactoidTransaction(hash IHash) (ITransaction, error)
 	FetchECTransaction(hash IHash) (IECBlockEntry, error)
 }
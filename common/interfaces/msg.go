@@ -33,6 +33,22 @@ type IMsg interface {
 	GetNetworkOrigin() string
 	SetNetworkOrigin(string)
 
+	// The ID given to this message where it entered the node, used to follow it through
+	// the logs.  Not marshaled with the message.
+	GetCorrelationID() string
+	SetCorrelationID(string)
+
+	// The correlation ID the message was given where it was submitted, which goes with it to
+	// the nodes it is sent to, so it can be followed through the logs of them all.  Not
+	// marshaled with the message; p2p sends it beside it.
+	GetTraceID() string
+	SetTraceID(string)
+
+	// A priority message was submitted to our API by our operator, and gets their share of the
+	// room for user traffic.  Not marshaled with the message.
+	IsPriority() bool
+	SetPriority(bool)
+
 	// Returns the timestamp for a message
 	GetTimestamp() Timestamp
 
@@ -62,7 +78,8 @@ type IMsg interface {
 	//  < 0 -- Message is invalid.  Discard
 	//  0   -- Cannot tell if message is Valid
 	//  1   -- Message is valid
-	Validate(IState) int
+	// with the reason it isn't valid, if it isn't; see ValidationResult
+	Validate(IState) ValidationResult
 
 	//Set the VMIndex for a message
 	ComputeVMIndex(IState)
@@ -100,6 +117,13 @@ type IMsg interface {
 	GetMinute() byte
 	SetMinute(byte)
 
+	// MarshalBinary caches its result on the message.  Signing or unmarshaling a message resets
+	// the cache; anything else that changes a message once it may have been marshaled must
+	// reset it too.
+	GetMarshalCache() []byte
+	SetMarshalCache([]byte)
+	ResetMarshalCache()
+
 	// Stall handling
 	MarkSentInvalid(bool)
 	SentInvlaid() bool
@@ -4,6 +4,8 @@
 
 package interfaces
 
+import "time"
+
 type DBStateSent struct {
 	DBHeight uint32
 	Sent     Timestamp
@@ -73,6 +75,26 @@ type IState interface {
 	GetRpcAuthHash() []byte
 	GetTlsInfo() (bool, string, string)
 	GetFactomdLocations() string
+	GetAPISecurityHeaders() map[string]string
+	GetPriorityLaneShare() int
+	GetPriorityAPIKey() string
+	IsExplorerMode() bool
+	IsShuttingDown() bool
+	IsStandby() bool
+	PromoteStandby(force bool) (uint32, error)
+	GetNetworkStatus() ([]byte, []IHash, IHash, uint32)
+	SignNetworkStatus(content []byte) (IHash, IFullSignature, error)
+	GetUpgradeStatus() *UpgradeStatus
+	GetResendInterval() time.Duration
+	PutNodeHealth(health NodeHealth)
+	GetNodeHealth() []NodeHealth
+	GetChainStats(from uint32, to uint32) ([]ChainStats, int)
+	GetBlockTimelines(from uint32, to uint32) []BlockTimeline
+	GetProcessListLogs(from uint32, to uint32, hash string) []ProcessListLog
+	GetBurnedCredits(ecPubKey IHash) []BurnedCredits
+	GetSupersededCommits(entryHash IHash) []IHash
+	SendProbe(target IHash) (uint32, error)
+	GetProbeResults() []ProbeResult
 
 	// Routine for handling the syncroniztion of the leader and follower processes
 	// and how they process messages.
@@ -96,6 +118,22 @@ type IState interface {
 
 	// Find a Directory Block by height
 	GetDirectoryBlockByHeight(dbheight uint32) IDirectoryBlock
+	// Returns 1 if the timestamp of a directory block is good, 0 if we can't check it yet, and -1
+	// if it breaks a rule; with the reason for anything but 1
+	ValidateBlockTimestamp(IDirectoryBlock) (int, string)
+	// The number of signatures a DBState needs, out of this many federated servers
+	DBStateSigsNeeded(fedCount int) int
+	// Records the signatures counted on a DBState, for diagnostics
+	SetDBStateSigTally(DBStateSigTally)
+	GetDBStateSigTally() DBStateSigTally
+	// How the pass that fills in missing entries is going
+	GetEntrySyncProgress() EntrySyncProgress
+	// Header sync; adds directory block headers from a peer to the headers past our saved blocks
+	AddDBlockHeaders([]IDirectoryBlockHeader)
+	// Height of the highest directory block we have a header for, block or not
+	GetHighestHeader() uint32
+	// Header and KeyMR of the directory block at a height, from the block or just its header
+	GetDBlockHeaderByHeight(dbheight uint32) (IDirectoryBlockHeader, IHash)
 	// Channels
 	//==========
 
@@ -109,12 +147,22 @@ type IState interface {
 	JournalMessage(IMsg)
 	GetJournalMessages() [][]byte
 
+	// Diagnostics
+	WriteStateDump() (filename string, dump string, err error)
+	GetRoutineStatus() []RoutineStatus
+	Supervise(name string, run func()) // Runs a long-lived goroutine, restarted if it panics
+
 	// Consensus
 	APIQueue() chan IMsg // Input Queue from the API
 	InMsgQueue() IQueue  // Read by Validate
 	AckQueue() chan IMsg // Leader Queue
 	MsgQueue() chan IMsg // Follower Queue
 
+	// Injecting and draining messages, for tests and tooling (see state/inject.go)
+	InjectMessage(queue string, msg IMsg) error
+	DrainQueue(queue string, max int) ([]IMsg, error)
+	QueueLength(queue string) (int, error)
+
 	// Lists and Maps
 	// =====
 	GetAuditHeartBeats() []IMsg // The checklist of HeartBeats for this period
@@ -125,6 +173,7 @@ type IState interface {
 
 	GetPendingEntries(interface{}) []IPendingEntry
 	NextCommit(hash IHash) IMsg
+	CheckRevealCost(entry IEBEntry, commit IMsg) ValidationResult
 	PutCommit(hash IHash, msg IMsg)
 
 	IncEntryChains()
@@ -185,6 +234,8 @@ type IState interface {
 	UpdateState() bool
 	GetSystemHeight(dbheight uint32) int
 	GetFactoidState() IFactoidState
+	GetF(rt bool, adr [32]byte) int64 // Factoid balance; the temp balance if rt, else as of the last saved block
+	GetE(rt bool, adr [32]byte) int64 // Entry credit balance; the temp balance if rt, else as of the last saved block
 
 	SetFactoidState(dbheight uint32, fs IFactoidState)
 	GetFactoshisPerEC() uint64
@@ -203,6 +254,7 @@ type IState interface {
 	FollowerExecuteMsg(IMsg)          // Messages that go into the process list
 	FollowerExecuteEOM(IMsg)          // Messages that go into the process list
 	FollowerExecuteAck(IMsg)          // Ack Msg calls this function.
+	FollowerExecuteAckBatch(IMsg)     // Ack Batch Msg calls this function.
 	FollowerExecuteDBState(IMsg)      // Add the given DBState to this server
 	FollowerExecuteSFault(IMsg)       // Handling of Server Fault Messages
 	FollowerExecuteFullFault(IMsg)    // Handle Server Full-Fault Messages
@@ -211,13 +263,16 @@ type IState interface {
 	FollowerExecuteMissingMsg(IMsg)   // Handle requests for missing messages
 	FollowerExecuteCommitChain(IMsg)  // CommitChain needs to look for a Reveal Entry
 	FollowerExecuteCommitEntry(IMsg)  // CommitEntry needs to look for a Reveal Entry
+	FollowerExecuteBatchCommitEntry(IMsg)
 	FollowerExecuteRevealEntry(IMsg)
+	FollowerExecuteProbe(IMsg) // Stamp a probe, and echo it if it is to us
 
 	ProcessAddServer(dbheight uint32, addServerMsg IMsg) bool
 	ProcessRemoveServer(dbheight uint32, removeServerMsg IMsg) bool
 	ProcessChangeServerKey(dbheight uint32, changeServerKeyMsg IMsg) bool
 	ProcessCommitChain(dbheight uint32, commitChain IMsg) bool
 	ProcessCommitEntry(dbheight uint32, commitChain IMsg) bool
+	ProcessBatchCommitEntry(dbheight uint32, batch IMsg) bool
 	ProcessDBSig(dbheight uint32, commitChain IMsg) bool
 	ProcessEOM(dbheight uint32, eom IMsg) bool
 	ProcessRevealEntry(dbheight uint32, m IMsg) bool
@@ -229,6 +284,7 @@ type IState interface {
 	LeaderExecuteRevealEntry(IMsg)
 	LeaderExecuteCommitChain(IMsg)
 	LeaderExecuteCommitEntry(IMsg)
+	LeaderExecuteBatchCommitEntry(IMsg)
 
 	GetNetStateOff() bool //	If true, all network communications are disabled
 	SetNetStateOff(bool)
@@ -245,9 +301,12 @@ type IState interface {
 	UpdateECs(IEntryCreditBlock)
 	SetIsReplaying()
 	SetIsDoneReplaying()
+	ReplayMessage(msg IMsg, delivered Timestamp, local bool) // Hands a journaled message to the State
 	// No Entry Yet returns true if no Entry Hash is found in the Replay structs.
 	// Returns false if we have seen an Entry Replay in the current period.
 	NoEntryYet(IHash, Timestamp) bool
+	// True if the activation (see constants.ACTIVATIONS) has taken effect at dbheight
+	IsActive(activation string, dbheight uint32) bool
 
 	// Calculates the transaction rate this node is seeing.
 	//		totalTPS	: Total transactions / total time node running
@@ -258,6 +317,8 @@ type IState interface {
 	//For ACK
 	GetACKStatus(hash IHash) (int, IHash, Timestamp, Timestamp, error)
 	GetSpecificACKStatus(hash IHash) (int, IHash, Timestamp, Timestamp, error)
+	GetInvalidReason(hash IHash) string
+	GetPendingReason(hash IHash) string
 	FetchPaidFor(hash IHash) (IHash, error)
 	FetchFactoidTransactionByHash(hash IHash) (ITransaction, error)
 	FetchECTransactionByHash(hash IHash) (IECBlockEntry, error)
@@ -291,8 +352,151 @@ type IState interface {
 	SetDelay(int64)
 	GetDropRate() int
 	SetDropRate(int)
+	GetSpamThreshold() int
+	SetSpamThreshold(int)
 
 	// Access to Holding Queue
 	LoadHoldingMap() map[[32]byte]IMsg
+	GetHoldingReasons() []HeldMessage
 	LoadAcksMap() map[[32]byte]IMsg
 }
+
+// The entries, commits and entry credits spent on a chain over some blocks
+type ChainStats struct {
+	ChainID string `json:"chainid"` // Empty for commits not revealed in the same block
+	Entries int    `json:"entries"`
+	Commits int    `json:"commits"`
+	ECs     uint64 `json:"ecs"` // Entry credits spent by the commits
+}
+
+// The entry credits a key paid for commits whose reveals never came
+type BurnedCredits struct {
+	ECPubKey      string `json:"ecpubkey"`
+	Commits       int    `json:"commits"`
+	Credits       int64  `json:"credits"`
+	LastEntryHash string `json:"lastentryhash"` // The entry of the last commit to expire
+	LastExpired   int64  `json:"lastexpired"`   // When it expired, in milliseconds since the epoch
+}
+
+// The signatures counted on a DBState from the network
+type DBStateSigTally struct {
+	DBHeight   uint32 `json:"dbheight"`
+	Signatures int    `json:"signatures"` // Valid signatures from the federated servers
+	Needed     int    `json:"needed"`
+	FedServers int    `json:"fedservers"`
+	Threshold  int    `json:"threshold"` // The percent of the federated servers needed, see Needed
+}
+
+// How the pass that fills in missing entries is going
+type EntrySyncProgress struct {
+	Scanning    uint32 `json:"scanning"`    // The directory block being scanned for missing entries
+	Complete    uint32 `json:"complete"`    // Every entry up to this block is in the database
+	Highest     uint32 `json:"highest"`     // The highest block saved
+	Missing     int    `json:"missing"`     // Entries found missing, and not yet in the database
+	Asking      int    `json:"asking"`      // Of them, those being asked for from peers
+	Found       int    `json:"found"`       // Entries asked for that arrived, since we started
+	Requests    int64  `json:"requests"`    // Requests sent to peers, since we started
+	RequestRate int    `json:"requestrate"` // The most requests a second; 0 is no limit
+	ReadRate    int    `json:"readrate"`    // The most database reads a second; 0 is no limit
+	Throttled   int64  `json:"throttledms"` // Milliseconds spent waiting on the limits
+}
+
+// Why a message in Holding is held
+type HeldMessage struct {
+	Hash      string `json:"hash"`
+	Type      string `json:"type"`
+	Reason    string `json:"reason,omitempty"`    // Empty if it was held without one
+	WaitingOn string `json:"waitingon,omitempty"` // The hash it is released on
+	Since     int64  `json:"since,omitempty"`     // When it was held, in milliseconds since the epoch
+}
+
+// The status of one of a node's long-lived goroutines, see supervisor/
+type RoutineStatus struct {
+	Name      string    `json:"name"`
+	State     string    `json:"state"` // running, backoff, crashed or finished
+	Starts    int       `json:"starts"`
+	Panics    int       `json:"panics"`
+	LastPanic string    `json:"lastpanic,omitempty"`
+	LastStart time.Time `json:"laststart"`
+	LastExit  time.Time `json:"lastexit"`
+	NextStart time.Time `json:"nextstart"` // When a routine in backoff is restarted
+}
+
+// When a block reached each step of its making, as this node saw it
+type BlockTimeline struct {
+	DBHeight uint32          `json:"dbheight"`
+	Events   []TimelineEvent `json:"events"` // In the order they happened
+}
+
+type TimelineEvent struct {
+	Event string `json:"event"`
+	Time  int64  `json:"time"` // In milliseconds since the epoch
+}
+
+// What the VMs of a block's process list held when it was saved, as this node built it
+type ProcessListLog struct {
+	DBHeight uint32                `json:"dbheight"`
+	Entries  []ProcessListLogEntry `json:"entries"` // By VM, in the order acked
+}
+
+type ProcessListLogEntry struct {
+	VM        int    `json:"vm"`
+	Height    int    `json:"height"` // In the VM
+	Minute    int    `json:"minute"`
+	Type      string `json:"type"`
+	MsgHash   string `json:"msghash"`
+	Hash      string `json:"hash"`      // The entry hash of commits and reveals, the ID of transactions
+	Acked     int64  `json:"acked"`     // When the leader acked it, in milliseconds since the epoch
+	Processed int64  `json:"processed"` // When this node processed it, in milliseconds since the epoch; 0 if never
+}
+
+// The health of a node, as it last reported it in its heartbeat
+type NodeHealth struct {
+	IdentityChainID  string `json:"identitychainid"`
+	DBHeight         uint32 `json:"dbheight"`    // The block the node is building
+	SavedHeight      uint32 `json:"savedheight"` // The highest block it saved
+	LastMinuteMillis uint32 `json:"lastminutemillis"`
+	InMsgQueue       uint32 `json:"inmsgqueue"`
+	AckQueue         uint32 `json:"ackqueue"`
+	MsgQueue         uint32 `json:"msgqueue"`
+	Holding          uint32 `json:"holding"`
+	FactomdVersion   int    `json:"factomdversion"`
+	Timestamp        int64  `json:"timestamp"` // When the heartbeat was sent, in milliseconds since the epoch
+}
+
+// What a probe we sent to another authority found, see common/messages/probe.go.  Times are in
+// milliseconds since the epoch; the stamps are by the clocks of the nodes that made them.
+type ProbeResult struct {
+	Target          string  `json:"target"` // Identity chain ID of the authority probed
+	Number          uint32  `json:"number"`
+	Sent            int64   `json:"sent"`            // When we sent the probe
+	Outbound        []int64 `json:"outbound"`        // When each node that passed the probe on saw it, the target last
+	Echoed          int64   `json:"echoed"`          // When the target echoed it; 0 until it has
+	Return          []int64 `json:"return"`          // When each node that passed the echo on saw it, us last
+	Received        int64   `json:"received"`        // When we got the echo; 0 until we have
+	RoundTripMillis int64   `json:"roundtripmillis"` // From Sent to Received, both by our clock
+}
+
+// How ready we and our peers are for the activations the network status plans
+type UpgradeStatus struct {
+	DBHeight        uint32              `json:"dbheight"`        // Our current height
+	FactomdVersion  string              `json:"factomdversion"`  // The version we run
+	ProtocolVersion string              `json:"protocolversion"` // The version the network status asks for; "" if there's no status
+	StatusHeight    uint32              `json:"statusheight"`    // Height the network status was signed at
+	VersionReady    bool                `json:"versionready"`    // True if we run at least the version asked for
+	P2PVersion      uint16              `json:"p2pversion"`      // The p2p protocol version we speak
+	Peers           int                 `json:"peers"`
+	PeerVersions    map[uint16]int      `json:"peerversions"` // Peers by the p2p protocol version they send; 0 if none yet
+	Activations     []UpgradeActivation `json:"activations"`  // The activations not yet reached, soonest first
+	Ready           bool                `json:"ready"`        // True if we are ready for all of them
+}
+
+type UpgradeActivation struct {
+	Name            string  `json:"name"`
+	Height          uint32  `json:"height"`
+	BlocksToGo      uint32  `json:"blockstogo"`
+	MinProtocol     uint16  `json:"minprotocol"`     // 0 if not a p2p change
+	CompatiblePeers int     `json:"compatiblepeers"` // Peers on MinProtocol or later; -1 if not a p2p change
+	PercentPeers    float64 `json:"percentpeers"`    // Percentage of the peers that are compatible; -1 if not a p2p change
+	Ready           bool    `json:"ready"`           // True if we are ready for it
+}
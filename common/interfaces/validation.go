@@ -0,0 +1,89 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// A ValidationReason says why a message isn't valid, or can't be found valid yet.
+type ValidationReason int
+
+const (
+	ReasonNone                ValidationReason = iota // Valid, or no reason given
+	ReasonMalformed                                   // Fields missing, out of range, or inconsistent
+	ReasonBadSignature                                // A signature doesn't verify
+	ReasonNotAuthority                                // Not from, or about, a server it has to be
+	ReasonInsufficientBalance                         // The paying address can't cover it (yet)
+	ReasonExpired                                     // Its timestamp or height has passed
+	ReasonReplay                                      // Seen before
+	ReasonWrongNetwork                                // Made for another network
+	ReasonBadTimestamp                                // Its timestamp is out of range
+	ReasonBadBlock                                    // The blocks don't match, or miss a checkpoint
+	ReasonUnderpaid                                   // A reveal its commit doesn't pay for
+	ReasonNoCommit                                    // A reveal with no commit, yet
+	ReasonNoChain                                     // An entry in a chain that doesn't exist, yet
+	ReasonNotYet                                      // Can't be checked until we know more
+)
+
+var validationReasonStrings = map[ValidationReason]string{
+	ReasonNone:                "",
+	ReasonMalformed:           "malformed",
+	ReasonBadSignature:        "bad signature",
+	ReasonNotAuthority:        "not an authority",
+	ReasonInsufficientBalance: "insufficient balance",
+	ReasonExpired:             "expired",
+	ReasonReplay:              "replay",
+	ReasonWrongNetwork:        "wrong network",
+	ReasonBadTimestamp:        "bad timestamp",
+	ReasonBadBlock:            "bad block",
+	ReasonUnderpaid:           "underpaid",
+	ReasonNoCommit:            "no commit",
+	ReasonNoChain:             "no chain",
+	ReasonNotYet:              "not yet",
+}
+
+func (r ValidationReason) String() string {
+	if s, ok := validationReasonStrings[r]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// A ValidationResult is what IMsg.Validate returns: a code, and if the message isn't valid, why.
+type ValidationResult struct {
+	// Three possible codes:
+	//  < 0 -- Message is invalid.  Discard
+	//  0   -- Cannot tell if message is Valid
+	//  1   -- Message is valid
+	Code   int
+	Reason ValidationReason
+}
+
+// Valid is the result of a valid message.
+func Valid() ValidationResult {
+	return ValidationResult{Code: 1}
+}
+
+// Pending is the result of a message that can't be found valid yet, for the given reason.
+func Pending(reason ValidationReason) ValidationResult {
+	return ValidationResult{Code: 0, Reason: reason}
+}
+
+// Invalid is the result of an invalid message, for the given reason.
+func Invalid(reason ValidationReason) ValidationResult {
+	return ValidationResult{Code: -1, Reason: reason}
+}
+
+func (v ValidationResult) String() string {
+	switch {
+	case v.Code > 0:
+		return "valid"
+	case v.Code == 0 && v.Reason == ReasonNone:
+		return "pending"
+	case v.Code == 0:
+		return "pending: " + v.Reason.String()
+	case v.Reason == ReasonNone:
+		return "invalid"
+	default:
+		return "invalid: " + v.Reason.String()
+	}
+}
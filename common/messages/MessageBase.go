@@ -18,6 +18,9 @@ type MessageBase struct {
 	NetworkOrigin string // Hash of the network peer/connection where the message is from
 	Peer2Peer     bool   // The nature of this message type, not marshaled with the message
 	LocalOnly     bool   // This message is only a local message, is not broadcasted and may skip verification
+	CorrelationID string // Ties together the logs for this message, not marshaled with the message
+	TraceID       string // Ties together the logs of every node for this message, sent beside it
+	Priority      bool   // Submitted by the node's operator, not marshaled with the message
 
 	NoResend  bool // Don't resend this message if true.
 	ResendCnt int  // Put a limit on resends
@@ -28,8 +31,9 @@ type MessageBase struct {
 	VMIndex       int              // The Index of the VM responsible for this message.
 	VMHash        []byte           // Basis for selecting a VMIndex
 	Minute        byte
-	resend        int64 // Time to resend (milliseconds)
-	expire        int64 // Time to expire (milliseconds)
+	resend        int64  // Time to resend (milliseconds)
+	expire        int64  // Time to expire (milliseconds)
+	marshalCache  []byte // Cache of the marshaled message, see GetMarshalCache
 
 	Ack interfaces.IMsg
 
@@ -113,7 +117,7 @@ func (m *MessageBase) Resend(s interfaces.IState) (rtn bool) {
 		m.resend = now
 		return false
 	}
-	if now-m.resend > 20000 && s.NetworkOutMsgQueue().Length() < 1000 {
+	if now-m.resend > int64(s.GetResendInterval()/time.Millisecond) && s.NetworkOutMsgQueue().Length() < 1000 {
 		m.resend = now
 		return true
 	}
@@ -158,6 +162,30 @@ func (m *MessageBase) SetOrigin(o int) {
 	m.Origin = o
 }
 
+func (m *MessageBase) GetCorrelationID() string {
+	return m.CorrelationID
+}
+
+func (m *MessageBase) SetCorrelationID(id string) {
+	m.CorrelationID = id
+}
+
+func (m *MessageBase) GetTraceID() string {
+	return m.TraceID
+}
+
+func (m *MessageBase) SetTraceID(id string) {
+	m.TraceID = id
+}
+
+func (m *MessageBase) IsPriority() bool {
+	return m.Priority
+}
+
+func (m *MessageBase) SetPriority(v bool) {
+	m.Priority = v
+}
+
 func (m *MessageBase) GetNetworkOrigin() string {
 	return m.NetworkOrigin
 }
@@ -212,6 +240,38 @@ func (m *MessageBase) SetVMHash(vmhash []byte) {
 	m.VMHash = vmhash
 }
 
+// GetMarshalCache returns what MarshalBinary last returned, or nil.  A message is validated,
+// hashed, sent to each peer and journaled, so MarshalBinary keeps its result here rather than
+// marshal the message again each time.  Sign and UnmarshalBinary reset it; code that changes a
+// message in any other way after it may have been marshaled must call ResetMarshalCache.
+func (m *MessageBase) GetMarshalCache() []byte {
+	return m.marshalCache
+}
+
+// SetMarshalCache keeps data as the marshaled message.  Its capacity is cut to its length, so a
+// caller that appends to it gets a copy rather than writing past it into the cache.
+func (m *MessageBase) SetMarshalCache(data []byte) {
+	m.marshalCache = data[:len(data):len(data)]
+}
+
+func (m *MessageBase) ResetMarshalCache() {
+	m.marshalCache = nil
+}
+
+// marshalCached returns the cached marshaled message, or else the result of marshal, which it
+// caches if there is no error.
+func (m *MessageBase) marshalCached(marshal func() ([]byte, error)) ([]byte, error) {
+	if m.marshalCache != nil {
+		return m.marshalCache, nil
+	}
+	data, err := marshal()
+	if err != nil {
+		return data, err
+	}
+	m.SetMarshalCache(data)
+	return data, nil
+}
+
 func (m *MessageBase) GetMinute() byte {
 	return m.Minute
 }
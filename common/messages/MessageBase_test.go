@@ -47,3 +47,50 @@ func TestMessageBase(t *testing.T) {
 		}
 	}
 }
+
+func TestMarshalCache(t *testing.T) {
+	ack := newSignedAck()
+	data, err := ack.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primitives.AreBytesEqual(ack.GetMarshalCache(), data) == false {
+		t.Errorf("MarshalBinary wasn't cached")
+	}
+
+	// A change the cache doesn't know of
+	ack.DBHeight++
+	again, _ := ack.MarshalBinary()
+	if primitives.AreBytesEqual(again, data) == false {
+		t.Errorf("MarshalBinary didn't use the cache")
+	}
+
+	// Appending to what MarshalBinary returned mustn't change the cache
+	_ = append(again, 0xFF)
+	if len(ack.GetMarshalCache()) != len(data) {
+		t.Errorf("The cache was changed")
+	}
+
+	// Signing again resets it
+	key, _ := primitives.NewPrivateKeyFromHex("07c0d52cb74f4ca3106d80c4a70488426886bccc6ebc10c6bafb37bf8a65f4c38cee85c62a9e48039d4ac294da97943c2001be1539809ea5f54721f0c5477a0a")
+	ack.Sign(key)
+	signed, _ := ack.MarshalBinary()
+	ack2 := new(Ack)
+	if err := ack2.UnmarshalBinary(signed); err != nil {
+		t.Fatal(err)
+	}
+	if ack2.DBHeight != ack.DBHeight {
+		t.Errorf("Got DBHeight %d after signing, expected %d", ack2.DBHeight, ack.DBHeight)
+	}
+
+	// So does unmarshaling
+	if err := ack.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if ack.GetMarshalCache() != nil {
+		t.Errorf("Unmarshaling didn't reset the cache")
+	}
+	if again, _ := ack.MarshalBinary(); primitives.AreBytesEqual(again, data) == false {
+		t.Errorf("Got %x after unmarshaling, expected %x", again, data)
+	}
+}
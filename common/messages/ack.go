@@ -39,6 +39,9 @@ var _ interfaces.IMsg = (*Ack)(nil)
 var _ Signable = (*Ack)(nil)
 var AckBalanceHash = true
 
+// Largest data area an Ack may carry; today it holds just the balance hash
+const MaxAckDataArea = 1024
+
 func (m *Ack) GetRepeatHash() interfaces.IHash {
 	return m.GetMsgHash()
 }
@@ -75,24 +78,28 @@ func (m *Ack) VerifySignature() (bool, error) {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *Ack) Validate(state interfaces.IState) int {
+func (m *Ack) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// If too old, it isn't valid.
 	if m.DBHeight <= state.GetHighestSavedBlk() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	// Only new acks are valid. Of course, the VMIndex has to be valid too.
 	_, err := state.GetMsg(m.VMIndex, int(m.DBHeight), int(m.Height))
 	if err != nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
 	if !m.authvalid {
+		// Acks from an AckBatch have no signature of their own
+		if m.Signature == nil {
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
+		}
 		// Check signature
 		bytes, err := m.MarshalForSignature()
 		if err != nil {
 			//fmt.Println("Err is not nil on Ack sig check: ", err)
-			return -1
+			return interfaces.Invalid(interfaces.ReasonMalformed)
 		}
 		sig := m.Signature.GetSignature()
 		ackSigned, err := state.VerifyAuthoritySignature(bytes, sig, m.DBHeight)
@@ -100,15 +107,15 @@ func (m *Ack) Validate(state interfaces.IState) int {
 		//ackSigned, err := m.VerifySignature()
 		if err != nil {
 			//fmt.Println("Err is not nil on Ack sig check: ", err)
-			return -1
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
 		}
 		if ackSigned <= 0 {
-			return -1
+			return interfaces.Invalid(interfaces.ReasonNotAuthority)
 		}
 	}
 
 	m.authvalid = true
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -132,11 +139,15 @@ func (e *Ack) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *Ack) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *Ack) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *Ack) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *Ack) Sign(key interfaces.Signer) error {
@@ -145,6 +156,7 @@ func (m *Ack) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -153,18 +165,18 @@ func (m *Ack) GetSignature() interfaces.IFullSignature {
 }
 
 func (m *Ack) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
-	m.VMIndex, newData = int(newData[0]), newData[1:]
+	var vmIndex byte
+	vmIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.VMIndex = int(vmIndex)
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -172,10 +184,17 @@ func (m *Ack) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 		return nil, err
 	}
 
-	copy(m.Salt[:], newData[:8])
-	newData = newData[8:]
+	var salt []byte
+	salt, newData, err = popLen(newData, 8, "Salt")
+	if err != nil {
+		return nil, err
+	}
+	copy(m.Salt[:], salt)
 
-	m.SaltNumber, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.SaltNumber, newData, err = popUInt32(newData, "SaltNumber")
+	if err != nil {
+		return nil, err
+	}
 
 	m.MessageHash = new(primitives.Hash)
 	newData, err = m.MessageHash.UnmarshalBinaryData(newData)
@@ -194,9 +213,18 @@ func (m *Ack) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 		return nil, err
 	}
 
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.Height, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.Minute, newData = newData[0], newData[1:]
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.Height, newData, err = popUInt32(newData, "Height")
+	if err != nil {
+		return nil, err
+	}
+	m.Minute, newData, err = popByte(newData, "Minute")
+	if err != nil {
+		return nil, err
+	}
 
 	if m.SerialHash == nil {
 		m.SerialHash = primitives.NewHash(constants.ZERO_HASH)
@@ -208,21 +236,33 @@ func (m *Ack) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 
 	if AckBalanceHash {
 		m.DataAreaSize, newData = primitives.DecodeVarInt(newData)
+		if m.DataAreaSize > MaxAckDataArea {
+			return nil, fmt.Errorf("Ack data area too large: %d", m.DataAreaSize)
+		}
 		if m.DataAreaSize > 0 {
-			das := newData[:int(m.DataAreaSize)]
+			var das []byte
+			das, newData, err = popLen(newData, int(m.DataAreaSize), "DataArea")
+			if err != nil {
+				return nil, err
+			}
+			m.DataArea = append(m.DataArea[:0], das...)
 
 			lenb := uint64(0)
 			for len(das) > 0 {
 				typeb := das[0]
 				lenb, das = primitives.DecodeVarInt(das[1:])
+				if lenb > uint64(len(das)) {
+					return nil, fmt.Errorf("Ack data area entry runs past the data area")
+				}
 				switch typeb {
 				case 1:
+					if lenb < 32 {
+						return nil, fmt.Errorf("Ack balance hash too short")
+					}
 					m.BalanceHash = primitives.NewHash(das[:32])
 				}
 				das = das[lenb:]
 			}
-			m.DataArea = append(m.DataArea[:0], newData[:m.DataAreaSize]...)
-			newData = newData[int(m.DataAreaSize):]
 		}
 	}
 
@@ -307,7 +347,11 @@ func (m *Ack) MarshalForSignature() ([]byte, error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *Ack) MarshalBinary() (data []byte, err error) {
+func (m *Ack) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *Ack) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,328 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Most acks a leader puts in one AckBatch
+const MaxAckBatch = 500
+
+//A leader's acks for the messages of a short window, all under one signature.  The acks are sent
+//without their own signatures, so a follower checks one signature rather than one for each
+//message.  Each ack in the batch is then taken as if it had come alone.
+
+type AckBatch struct {
+	MessageBase
+	Timestamp interfaces.Timestamp // Timestamp of the batch by the leader
+	Acks      []*Ack               // All of one leader and one directory block height, unsigned
+
+	Signature interfaces.IFullSignature
+}
+
+var _ interfaces.IMsg = (*AckBatch)(nil)
+var _ Signable = (*AckBatch)(nil)
+
+func (a *AckBatch) IsSameAs(b *AckBatch) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if !a.LeaderChainID.IsSameAs(b.LeaderChainID) {
+		return false
+	}
+	if len(a.Acks) != len(b.Acks) {
+		return false
+	}
+	for i := range a.Acks {
+		if !a.Acks[i].IsSameAs(b.Acks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *AckBatch) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *AckBatch) GetHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *AckBatch) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalForSignature()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *AckBatch) Type() byte {
+	return constants.ACK_BATCH_MSG
+}
+
+func (m *AckBatch) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+func (m *AckBatch) VerifySignature() (bool, error) {
+	return VerifyMessage(m)
+}
+
+// Validate the message, given the state.  Three possible results:
+//
+//	< 0 -- Message is invalid.  Discard
+//	0   -- Cannot tell if message is Valid
+//	1   -- Message is valid
+//
+// Once the batch is signed by an authority, its acks are taken as signed, and each is checked
+// as it is executed.
+func (m *AckBatch) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if len(m.Acks) == 0 || len(m.Acks) > MaxAckBatch || m.LeaderChainID == nil {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	dbheight := m.Acks[0].DBHeight
+	for _, ack := range m.Acks {
+		if ack == nil || ack.DBHeight != dbheight || !m.LeaderChainID.IsSameAs(ack.LeaderChainID) {
+			return interfaces.Invalid(interfaces.ReasonMalformed)
+		}
+	}
+	// If too old, it isn't valid.
+	if dbheight <= state.GetHighestSavedBlk() {
+		return interfaces.Invalid(interfaces.ReasonExpired)
+	}
+
+	if !m.IsValid() {
+		if m.Signature == nil {
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
+		}
+		bytes, err := m.MarshalForSignature()
+		if err != nil {
+			return interfaces.Invalid(interfaces.ReasonMalformed)
+		}
+		signed, err := state.VerifyAuthoritySignature(bytes, m.Signature.GetSignature(), dbheight)
+		if err != nil {
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
+		}
+		if signed <= 0 {
+			return interfaces.Invalid(interfaces.ReasonNotAuthority)
+		}
+		m.SetValid()
+	}
+
+	for _, ack := range m.Acks {
+		ack.authvalid = true
+	}
+	return interfaces.Valid()
+}
+
+func (m *AckBatch) ComputeVMIndex(state interfaces.IState) {
+}
+
+// Execute the leader functions of the given message
+// Leader, follower, do the same thing.
+func (m *AckBatch) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+func (m *AckBatch) FollowerExecute(state interfaces.IState) {
+	state.FollowerExecuteAckBatch(m)
+}
+
+// Acknowledgements do not go into the process list.
+func (e *AckBatch) Process(dbheight uint32, state interfaces.IState) bool {
+	panic("AckBatch object should never have its Process() method called")
+}
+
+func (e *AckBatch) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *AckBatch) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *AckBatch) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (m *AckBatch) Sign(key interfaces.Signer) error {
+	signature, err := SignSignable(m, key)
+	if err != nil {
+		return err
+	}
+	m.Signature = signature
+	m.ResetMarshalCache()
+	return nil
+}
+
+func (m *AckBatch) GetSignature() interfaces.IFullSignature {
+	return m.Signature
+}
+
+func (m *AckBatch) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.LeaderChainID = new(primitives.Hash)
+	newData, err = m.LeaderChainID.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	var count uint32
+	count, newData, err = popUInt32(newData, "Acks")
+	if err != nil {
+		return nil, err
+	}
+
+	// Each ack is prefixed with its length
+	if err = checkCount(newData, count, MaxAckBatch, 4, "Acks"); err != nil {
+		return nil, err
+	}
+	m.Acks = nil
+	for i := 0; i < int(count); i++ {
+		var l uint32
+		l, newData, err = popUInt32(newData, "Ack length")
+		if err != nil {
+			return nil, err
+		}
+		var ackData []byte
+		ackData, newData, err = popLen(newData, int(l), "Ack")
+		if err != nil {
+			return nil, err
+		}
+		ack := new(Ack)
+		err = ack.UnmarshalBinary(ackData)
+		if err != nil {
+			return nil, err
+		}
+		if ack.Signature != nil {
+			return nil, fmt.Errorf("An ack in an AckBatch carries its own signature")
+		}
+		m.Acks = append(m.Acks, ack)
+	}
+
+	if len(newData) > 0 {
+		m.Signature = new(primitives.Signature)
+		newData, err = m.Signature.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newData, nil
+}
+
+func (m *AckBatch) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *AckBatch) MarshalForSignature() ([]byte, error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	t := m.GetTimestamp()
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	data, err = m.LeaderChainID.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	binary.Write(&buf, binary.BigEndian, uint32(len(m.Acks)))
+	for _, ack := range m.Acks {
+		data, err = ack.MarshalForSignature()
+		if err != nil {
+			return nil, err
+		}
+		binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+		buf.Write(data)
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *AckBatch) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *AckBatch) marshalBinary() ([]byte, error) {
+	resp, err := m.MarshalForSignature()
+	if err != nil {
+		return nil, err
+	}
+	sig := m.GetSignature()
+
+	if sig != nil {
+		sigBytes, err := sig.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append(resp, sigBytes...), nil
+	}
+	return resp, nil
+}
+
+func (m *AckBatch) String() string {
+	return fmt.Sprintf("%6s: %d acks -- Leader[:3]=%x hash[:3]=%x",
+		"ACKS",
+		len(m.Acks),
+		m.LeaderChainID.Bytes()[:3],
+		m.GetMsgHash().Bytes()[:3])
+}
+
+func (m *AckBatch) ChainID() []byte {
+	return nil
+}
+
+func (m *AckBatch) ListHeight() int {
+	return 0
+}
+
+// NewAckBatch returns a leader's batch of the given acks, signed.  The acks keep their own
+// signatures, but they are not sent.
+func NewAckBatch(state interfaces.IState, acks []*Ack) (*AckBatch, error) {
+	m := new(AckBatch)
+	m.Timestamp = state.GetTimestamp()
+	m.LeaderChainID = state.GetIdentityChainID()
+	for _, ack := range acks {
+		unsigned := *ack
+		unsigned.Signature = nil
+		unsigned.ResetMarshalCache()
+		m.Acks = append(m.Acks, &unsigned)
+	}
+	if err := m.Sign(state); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilAckBatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(AckBatch)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalAckBatch(t *testing.T) {
+	msg := newSignedAckBatch()
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := msg2.String()
+	t.Logf("str - %v", str)
+
+	if msg2.Type() != constants.ACK_BATCH_MSG {
+		t.Error("Invalid message type unmarshalled")
+	}
+
+	batch := msg2.(*AckBatch)
+	if msg.IsSameAs(batch) != true {
+		t.Errorf("AckBatch messages are not identical")
+	}
+	for i, ack := range batch.Acks {
+		if ack.Signature != nil {
+			t.Errorf("Ack %d came back with a signature", i)
+		}
+		if !ack.GetMsgHash().IsSameAs(msg.Acks[i].GetMsgHash()) {
+			t.Errorf("Ack %d came back with a different hash", i)
+		}
+	}
+
+	valid, err := batch.VerifySignature()
+	if err != nil {
+		t.Error(err)
+	}
+	if !valid {
+		t.Errorf("Signature is not valid")
+	}
+
+	// A change to any ack breaks the signature
+	hex[len(hex)-200]++
+	msg3, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, _ = msg3.(*AckBatch).VerifySignature()
+	if valid {
+		t.Errorf("Signature is valid over a changed ack")
+	}
+}
+
+func newSignedAckBatch() *AckBatch {
+	msg := new(AckBatch)
+	msg.Timestamp = primitives.NewTimestampNow()
+	for i := 0; i < 3; i++ {
+		ack := newAck()
+		ack.Height += uint32(i)
+		msg.LeaderChainID = ack.LeaderChainID
+		msg.Acks = append(msg.Acks, ack)
+	}
+
+	key, err := primitives.NewPrivateKeyFromHex("07c0d52cb74f4ca3106d80c4a70488426886bccc6ebc10c6bafb37bf8a65f4c38cee85c62a9e48039d4ac294da97943c2001be1539809ea5f54721f0c5477a0a")
+	if err != nil {
+		panic(err)
+	}
+	err = msg.Sign(key)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
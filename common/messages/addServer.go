@@ -55,13 +55,13 @@ func (m *AddServerMsg) GetTimestamp() interfaces.Timestamp {
 	return m.Timestamp
 }
 
-func (m *AddServerMsg) Validate(state interfaces.IState) int {
+func (m *AddServerMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	//return 1
 	authoritativeKey := state.GetNetworkSkeletonKey().Bytes()
 	if m.GetSignature() == nil || bytes.Compare(m.GetSignature().GetKey(), authoritativeKey) != 0 {
 		// the message was not signed with the proper authoritative signing key (from conf file)
 		// it is therefore considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	isVer, err := m.VerifySignature()
@@ -69,10 +69,10 @@ func (m *AddServerMsg) Validate(state interfaces.IState) int {
 		// if there is an error during signature verification
 		// or if the signature is invalid
 		// the message is considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -96,11 +96,15 @@ func (e *AddServerMsg) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *AddServerMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *AddServerMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *AddServerMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *AddServerMsg) Sign(key interfaces.Signer) error {
@@ -109,6 +113,7 @@ func (m *AddServerMsg) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -121,16 +126,11 @@ func (m *AddServerMsg) VerifySignature() (bool, error) {
 }
 
 func (m *AddServerMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Add Server Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -144,8 +144,12 @@ func (m *AddServerMsg) UnmarshalBinaryData(data []byte) (newData []byte, err err
 		return nil, err
 	}
 
-	m.ServerType = int(newData[0])
-	newData = newData[1:]
+	var serverType byte
+	serverType, newData, err = popByte(newData, "ServerType")
+	if err != nil {
+		return nil, err
+	}
+	m.ServerType = int(serverType)
 
 	if len(newData) > 32 {
 		m.Signature = new(primitives.Signature)
@@ -186,6 +190,10 @@ func (m *AddServerMsg) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *AddServerMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *AddServerMsg) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	data, err := m.MarshalForSignature()
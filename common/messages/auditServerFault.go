@@ -5,8 +5,6 @@
 package messages
 
 import (
-	"fmt"
-
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -58,6 +56,7 @@ func (m *AuditServerFault) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -97,16 +96,11 @@ func (m *AuditServerFault) Type() byte {
 }
 
 func (m *AuditServerFault) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling AuditServerFault: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -146,7 +140,11 @@ func (m *AuditServerFault) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *AuditServerFault) MarshalBinary() (data []byte, err error) {
+func (m *AuditServerFault) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *AuditServerFault) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -187,8 +185,8 @@ func (m *AuditServerFault) SerialHash() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *AuditServerFault) Validate(state interfaces.IState) int {
-	return 0
+func (m *AuditServerFault) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Pending(interfaces.ReasonNotYet)
 }
 
 // Returns true if this is a message for this server to execute as
@@ -204,9 +202,13 @@ func (m *AuditServerFault) FollowerExecute(interfaces.IState) {
 }
 
 func (e *AuditServerFault) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *AuditServerFault) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *AuditServerFault) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
@@ -0,0 +1,288 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Most commits in one BatchCommitEntryMsg
+const MaxBatchCommits = 100
+
+//Carries many entry commits in one message, so that a bulk writer's commits take one ack, and one
+//place in the process list, rather than one each.  Each commit is still signed by its own EC key,
+//as the entry credit block keeps them one by one; the batch as a whole is signed, if at all, with
+//one signature, as a CommitEntryMsg is.  A batch is valid only if every commit in it is, and is
+//processed all at once, once every key can pay for all of its commits.  Its timestamp is that of
+//its first commit.
+
+type BatchCommitEntryMsg struct {
+	MessageBase
+
+	Commits []*entryCreditBlock.CommitEntry
+
+	Signature interfaces.IFullSignature
+
+	// Not marshaled... Just used by the leader
+	validsig bool
+}
+
+var _ interfaces.IMsg = (*BatchCommitEntryMsg)(nil)
+var _ Signable = (*BatchCommitEntryMsg)(nil)
+
+func (a *BatchCommitEntryMsg) IsSameAs(b *BatchCommitEntryMsg) bool {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return true
+		}
+		return false
+	}
+
+	if len(a.Commits) != len(b.Commits) {
+		return false
+	}
+	for i := range a.Commits {
+		if a.Commits[i].IsSameAs(b.Commits[i]) == false {
+			return false
+		}
+	}
+
+	if a.Signature == nil && b.Signature != nil {
+		return false
+	}
+	if a.Signature != nil {
+		if a.Signature.IsSameAs(b.Signature) == false {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *BatchCommitEntryMsg) Process(dbheight uint32, state interfaces.IState) bool {
+	return state.ProcessBatchCommitEntry(dbheight, m)
+}
+
+func (m *BatchCommitEntryMsg) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *BatchCommitEntryMsg) GetHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *BatchCommitEntryMsg) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalForSignature()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *BatchCommitEntryMsg) GetTimestamp() interfaces.Timestamp {
+	if len(m.Commits) == 0 {
+		return primitives.NewTimestampFromMilliseconds(0)
+	}
+	return m.Commits[0].GetTimestamp()
+}
+
+func (m *BatchCommitEntryMsg) Type() byte {
+	return constants.BATCH_COMMIT_ENTRY_MSG
+}
+
+func (m *BatchCommitEntryMsg) Sign(key interfaces.Signer) error {
+	signature, err := SignSignable(m, key)
+	if err != nil {
+		return err
+	}
+	m.Signature = signature
+	m.ResetMarshalCache()
+	return nil
+}
+
+func (m *BatchCommitEntryMsg) GetSignature() interfaces.IFullSignature {
+	return m.Signature
+}
+
+func (m *BatchCommitEntryMsg) VerifySignature() (bool, error) {
+	return VerifyMessage(m)
+}
+
+func (m *BatchCommitEntryMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	var count uint32
+	count, newData, err = popUInt32(newData, "Commits")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, count, MaxBatchCommits, entryCreditBlock.CommitEntrySize, "Commits"); err != nil {
+		return nil, err
+	}
+	m.Commits = nil
+	for i := 0; i < int(count); i++ {
+		ce := entryCreditBlock.NewCommitEntry()
+		newData, err = ce.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+		m.Commits = append(m.Commits, ce)
+	}
+
+	if len(newData) > 0 {
+		m.Signature = new(primitives.Signature)
+		newData, err = m.Signature.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newData, nil
+}
+
+func (m *BatchCommitEntryMsg) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *BatchCommitEntryMsg) MarshalForSignature() (data []byte, err error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	binary.Write(&buf, binary.BigEndian, uint32(len(m.Commits)))
+	for _, ce := range m.Commits {
+		data, err = ce.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *BatchCommitEntryMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *BatchCommitEntryMsg) marshalBinary() (data []byte, err error) {
+	resp, err := m.MarshalForSignature()
+	if err != nil {
+		return nil, err
+	}
+	sig := m.GetSignature()
+
+	if sig != nil {
+		sigBytes, err := sig.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append(resp, sigBytes...), nil
+	}
+	return resp, nil
+}
+
+func (m *BatchCommitEntryMsg) String() string {
+	if m.LeaderChainID == nil {
+		m.LeaderChainID = primitives.NewZeroHash()
+	}
+	str := fmt.Sprintf("%6s-VM%3d:                 -- %d commits Hash[%x]",
+		"BEntry",
+		m.VMIndex,
+		len(m.Commits),
+		m.GetHash().Bytes()[:3])
+	return str
+}
+
+// Credits returns the entry credits the batch spends, by EC key.
+func (m *BatchCommitEntryMsg) Credits() map[[32]byte]int64 {
+	credits := make(map[[32]byte]int64)
+	for _, ce := range m.Commits {
+		credits[*ce.ECPubKey] += int64(ce.Credits)
+	}
+	return credits
+}
+
+// Validate the message, given the state.  Three possible results:
+//  < 0 -- Message is invalid.  Discard
+//  0   -- Cannot tell if message is Valid
+//  1   -- Message is valid
+func (m *BatchCommitEntryMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if len(m.Commits) == 0 || len(m.Commits) > MaxBatchCommits {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	if !m.validsig {
+		entries := make(map[[32]byte]bool)
+		for _, ce := range m.Commits {
+			if ce == nil || entries[ce.EntryHash.Fixed()] {
+				return interfaces.Invalid(interfaces.ReasonMalformed)
+			}
+			if !ce.IsValid() {
+				return interfaces.Invalid(interfaces.ReasonBadSignature)
+			}
+			entries[ce.EntryHash.Fixed()] = true
+		}
+		if m.Signature != nil {
+			if valid, _ := m.VerifySignature(); !valid {
+				return interfaces.Invalid(interfaces.ReasonBadSignature)
+			}
+		}
+	}
+	m.validsig = true
+
+	// Nodes before batches can't decode them, so once one is acked they would stall on its VM
+	if !state.IsActive(constants.ACTIVATION_BATCH_COMMIT, state.GetLeaderHeight()) {
+		return interfaces.Invalid(interfaces.ReasonNotYet)
+	}
+	for key, credits := range m.Credits() {
+		if credits > state.GetFactoidState().GetECBalance(key) {
+			return interfaces.Pending(interfaces.ReasonInsufficientBalance)
+		}
+	}
+	return interfaces.Valid()
+}
+
+func (m *BatchCommitEntryMsg) ComputeVMIndex(state interfaces.IState) {
+	m.VMIndex = state.ComputeVMIndex(constants.EC_CHAINID)
+}
+
+// Execute the leader functions of the given message
+func (m *BatchCommitEntryMsg) LeaderExecute(state interfaces.IState) {
+	state.LeaderExecuteBatchCommitEntry(m)
+}
+
+func (m *BatchCommitEntryMsg) FollowerExecute(state interfaces.IState) {
+	state.FollowerExecuteBatchCommitEntry(m)
+}
+
+func (e *BatchCommitEntryMsg) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *BatchCommitEntryMsg) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *BatchCommitEntryMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func NewBatchCommitEntryMsg() *BatchCommitEntryMsg {
+	return new(BatchCommitEntryMsg)
+}
@@ -0,0 +1,139 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	ed "github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilBatchCommitEntryMsg(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(BatchCommitEntryMsg)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalBatchCommitEntry(t *testing.T) {
+	msg := newBatchCommitEntry(3)
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("str - %v", msg2.String())
+
+	if msg2.Type() != constants.BATCH_COMMIT_ENTRY_MSG {
+		t.Error("Invalid message type unmarshalled")
+	}
+	if msg.IsSameAs(msg2.(*BatchCommitEntryMsg)) != true {
+		t.Errorf("BatchCommitEntryMsg messages are not identical")
+	}
+	if msg.GetMsgHash().IsSameAs(msg2.GetMsgHash()) == false {
+		t.Errorf("The hashes of the messages differ")
+	}
+
+	credits := msg.Credits()
+	if len(credits) != 1 || credits[*msg.Commits[0].ECPubKey] != 3 {
+		t.Errorf("Wrong credits %v", credits)
+	}
+}
+
+func TestMarshalUnmarshalSignedBatchCommitEntry(t *testing.T) {
+	msg := newBatchCommitEntry(2)
+	key, err := primitives.NewPrivateKeyFromHex("07c0d52cb74f4ca3106d80c4a70488426886bccc6ebc10c6bafb37bf8a65f4c38cee85c62a9e48039d4ac294da97943c2001be1539809ea5f54721f0c5477a0a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = msg.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.IsSameAs(msg2.(*BatchCommitEntryMsg)) != true {
+		t.Errorf("BatchCommitEntryMsg messages are not identical")
+	}
+
+	valid, err := msg2.(*BatchCommitEntryMsg).VerifySignature()
+	if err != nil {
+		t.Error(err)
+	}
+	if valid == false {
+		t.Error("Signature is not valid")
+	}
+}
+
+func TestValidateBatchCommitEntry(t *testing.T) {
+	if NewBatchCommitEntryMsg().Validate(nil).Code >= 0 {
+		t.Error("An empty batch is valid")
+	}
+	if newBatchCommitEntry(MaxBatchCommits+1).Validate(nil).Code >= 0 {
+		t.Error("An oversized batch is valid")
+	}
+
+	msg := newBatchCommitEntry(2)
+	msg.Commits[1] = msg.Commits[0]
+	if v := msg.Validate(nil); v.Code >= 0 || v.Reason != interfaces.ReasonMalformed {
+		t.Errorf("A batch committing one entry twice is %s", v)
+	}
+
+	msg = newBatchCommitEntry(2)
+	msg.Commits[1].Credits = 2
+	if v := msg.Validate(nil); v.Code >= 0 || v.Reason != interfaces.ReasonBadSignature {
+		t.Errorf("A batch with a badly signed commit is %s", v)
+	}
+}
+
+// newBatchCommitEntry makes a batch of count commits, of different entries, all paid by one key.
+func newBatchCommitEntry(count int) *BatchCommitEntryMsg {
+	pub, privkey, err := ed.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	msg := NewBatchCommitEntryMsg()
+	for i := 0; i < count; i++ {
+		ce := entryCreditBlock.NewCommitEntry()
+		ce.Version = 0
+		ce.MilliTime = (*primitives.ByteSlice6)(&[6]byte{1, 1, 1, 1, 1, 1})
+		ce.EntryHash = primitives.Sha([]byte{byte(i), byte(i >> 8)})
+		ce.Credits = 1
+		ce.ECPubKey = (*primitives.ByteSlice32)(pub)
+		ce.Sig = (*primitives.ByteSlice64)(ed.Sign(privkey, ce.CommitMsg()))
+		msg.Commits = append(msg.Commits, ce)
+	}
+	return msg
+}
@@ -6,7 +6,6 @@ package messages
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -29,11 +28,15 @@ type Bounce struct {
 
 var _ interfaces.IMsg = (*Bounce)(nil)
 
+// Most time stamps a Bounce or BounceReply can collect
+const MaxBounceStamps = 1000
+
 func (m *Bounce) AddData(dataSize int) {
 	m.Data = make([]byte, dataSize)
 	for i, _ := range m.Data {
 		m.Data[i] = byte(rand.Int())
 	}
+	m.ResetMarshalCache()
 }
 
 func (m *Bounce) GetRepeatHash() interfaces.IHash {
@@ -78,8 +81,8 @@ func (m *Bounce) VerifySignature() (bool, error) {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *Bounce) Validate(state interfaces.IState) int {
-	return 1
+func (m *Bounce) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -101,11 +104,15 @@ func (e *Bounce) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *Bounce) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *Bounce) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *Bounce) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *Bounce) Sign(key interfaces.Signer) error {
@@ -117,21 +124,25 @@ func (m *Bounce) GetSignature() interfaces.IFullSignature {
 }
 
 func (m *Bounce) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, errors.New("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
-	m.Name = string(newData[:32])
-	newData = newData[32:]
+	var name []byte
+	name, newData, err = popLen(newData, 32, "Name")
+	if err != nil {
+		return nil, err
+	}
+	m.Name = string(name)
 
-	m.Number, newData = int32(binary.BigEndian.Uint32(newData[0:4])), newData[4:]
+	var number uint32
+	number, newData, err = popUInt32(newData, "Number")
+	if err != nil {
+		return nil, err
+	}
+	m.Number = int32(number)
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -139,7 +150,14 @@ func (m *Bounce) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 		return nil, err
 	}
 
-	numTS, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var numTS uint32
+	numTS, newData, err = popUInt32(newData, "Stamps")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, numTS, MaxBounceStamps, 6, "Stamps"); err != nil {
+		return nil, err
+	}
 
 	for i := uint32(0); i < numTS; i++ {
 		ts := new(primitives.Timestamp)
@@ -150,11 +168,17 @@ func (m *Bounce) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 		m.Stamps = append(m.Stamps, ts)
 	}
 
-	lenData, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-
-	m.Data = make([]byte, lenData)
-	copy(m.Data, newData)
-	newData = newData[lenData:]
+	var lenData uint32
+	lenData, newData, err = popUInt32(newData, "Data")
+	if err != nil {
+		return nil, err
+	}
+	var bounceData []byte
+	bounceData, newData, err = popLen(newData, int(lenData), "Data")
+	if err != nil {
+		return nil, err
+	}
+	m.Data = append([]byte{}, bounceData...)
 
 	return
 }
@@ -199,7 +223,11 @@ func (m *Bounce) MarshalForSignature() ([]byte, error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *Bounce) MarshalBinary() (data []byte, err error) {
+func (m *Bounce) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *Bounce) marshalBinary() (data []byte, err error) {
 	return m.MarshalForSignature()
 }
 
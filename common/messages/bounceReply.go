@@ -6,7 +6,6 @@ package messages
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -69,8 +68,8 @@ func (m *BounceReply) VerifySignature() (bool, error) {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *BounceReply) Validate(state interfaces.IState) int {
-	return 1
+func (m *BounceReply) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -92,11 +91,15 @@ func (e *BounceReply) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *BounceReply) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *BounceReply) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *BounceReply) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *BounceReply) Sign(key interfaces.Signer) error {
@@ -108,25 +111,27 @@ func (m *BounceReply) GetSignature() interfaces.IFullSignature {
 }
 
 func (m *BounceReply) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-
+	m.ResetMarshalCache()
 	m.SetPeer2Peer(true)
 
-	newData = data
-
-	if newData[0] != m.Type() {
-		return nil, errors.New("Invalid Message type")
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
-	m.Name = string(newData[:32])
-	newData = newData[32:]
+	var name []byte
+	name, newData, err = popLen(newData, 32, "Name")
+	if err != nil {
+		return nil, err
+	}
+	m.Name = string(name)
 
-	m.Number, newData = int32(binary.BigEndian.Uint32(newData[0:4])), newData[4:]
+	var number uint32
+	number, newData, err = popUInt32(newData, "Number")
+	if err != nil {
+		return nil, err
+	}
+	m.Number = int32(number)
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -134,7 +139,14 @@ func (m *BounceReply) UnmarshalBinaryData(data []byte) (newData []byte, err erro
 		return nil, err
 	}
 
-	numTS, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var numTS uint32
+	numTS, newData, err = popUInt32(newData, "Stamps")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, numTS, MaxBounceStamps, 6, "Stamps"); err != nil {
+		return nil, err
+	}
 
 	for i := uint32(0); i < numTS; i++ {
 		ts := new(primitives.Timestamp)
@@ -184,7 +196,11 @@ func (m *BounceReply) MarshalForSignature() ([]byte, error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *BounceReply) MarshalBinary() (data []byte, err error) {
+func (m *BounceReply) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *BounceReply) marshalBinary() (data []byte, err error) {
 	return m.MarshalForSignature()
 }
 
@@ -57,11 +57,11 @@ func (m *ChangeServerKeyMsg) GetTimestamp() interfaces.Timestamp {
 	return m.Timestamp
 }
 
-func (m *ChangeServerKeyMsg) Validate(state interfaces.IState) int {
+func (m *ChangeServerKeyMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// Check to see if identity exists and is audit or fed server
 	if !state.VerifyIsAuthority(m.IdentityChainID) {
 		fmt.Println("ChangeServerKey Error. Server is not an authority")
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	// Should only be 20 bytes in the hash if btc key add
@@ -69,7 +69,7 @@ func (m *ChangeServerKeyMsg) Validate(state interfaces.IState) int {
 		for _, b := range m.Key.Bytes()[21:] {
 			if b != 0 {
 				fmt.Println("ChangeServerKey Error. Newkey is invalid length")
-				return -1
+				return interfaces.Invalid(interfaces.ReasonMalformed)
 			}
 		}
 	}
@@ -77,12 +77,12 @@ func (m *ChangeServerKeyMsg) Validate(state interfaces.IState) int {
 	// Check signatures
 	bytes, err := m.MarshalForSignature()
 	if err != nil || m.Signature == nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	sig := m.Signature.GetSignature()
 	authSigned, err := state.VerifyAuthoritySignature(bytes, sig, state.GetLeaderHeight())
 	if err != nil || authSigned != 1 { // authSigned = 1 for fed signed
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	isVer, err := m.VerifySignature()
@@ -90,10 +90,10 @@ func (m *ChangeServerKeyMsg) Validate(state interfaces.IState) int {
 		// if there is an error during signature verification
 		// or if the signature is invalid
 		// the message is considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -117,11 +117,15 @@ func (e *ChangeServerKeyMsg) Process(dbheight uint32, state interfaces.IState) b
 }
 
 func (e *ChangeServerKeyMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *ChangeServerKeyMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *ChangeServerKeyMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *ChangeServerKeyMsg) Sign(key interfaces.Signer) error {
@@ -130,6 +134,7 @@ func (m *ChangeServerKeyMsg) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -142,16 +147,11 @@ func (m *ChangeServerKeyMsg) VerifySignature() (bool, error) {
 }
 
 func (m *ChangeServerKeyMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Add Server Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -165,14 +165,20 @@ func (m *ChangeServerKeyMsg) UnmarshalBinaryData(data []byte) (newData []byte, e
 		return nil, err
 	}
 
-	m.AdminBlockChange = newData[0]
-	newData = newData[1:]
+	m.AdminBlockChange, newData, err = popByte(newData, "AdminBlockChange")
+	if err != nil {
+		return nil, err
+	}
 
-	m.KeyType = newData[0]
-	newData = newData[1:]
+	m.KeyType, newData, err = popByte(newData, "KeyType")
+	if err != nil {
+		return nil, err
+	}
 
-	m.KeyPriority = newData[0]
-	newData = newData[1:]
+	m.KeyPriority, newData, err = popByte(newData, "KeyPriority")
+	if err != nil {
+		return nil, err
+	}
 
 	m.Key = new(primitives.Hash)
 	newData, err = m.Key.UnmarshalBinaryData(newData)
@@ -227,6 +233,10 @@ func (m *ChangeServerKeyMsg) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *ChangeServerKeyMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *ChangeServerKeyMsg) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	data, err := m.MarshalForSignature()
@@ -9,16 +9,27 @@ import (
 	"fmt"
 
 	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
 
+// A commit chain can be bound to its first entry: it carries the merkle root of the entry's
+// external IDs (see entryBlock.ExtIDMerkleRoot), signed by the commit's entry credit key.  A bound
+// commit is sent as a BOUND_COMMIT_CHAIN_MSG, which a network only takes from its chain binding
+// height on, and reserves its chain for its entry until it is revealed (see state/chainBinding.go).
+// What goes in the entry credit block is the same either way.
+
 //A placeholder structure for messages
 type CommitChainMsg struct {
 	MessageBase
 	CommitChain *entryCreditBlock.CommitChain
 
+	// The first entry binding, and its signature by the entry credit key; nil if not bound
+	Binding    interfaces.IHash
+	BindingSig *primitives.ByteSlice64
+
 	Signature interfaces.IFullSignature
 
 	// Not marshaled... Just used by the leader
@@ -46,6 +57,15 @@ func (a *CommitChainMsg) IsSameAs(b *CommitChainMsg) bool {
 		}
 	}
 
+	if a.IsBound() != b.IsBound() {
+		return false
+	}
+	if a.IsBound() {
+		if !a.Binding.IsSameAs(b.Binding) || !a.BindingSig.IsSameAs(b.BindingSig) {
+			return false
+		}
+	}
+
 	if a.Signature == nil && b.Signature != nil {
 		return false
 	}
@@ -58,6 +78,54 @@ func (a *CommitChainMsg) IsSameAs(b *CommitChainMsg) bool {
 	return true
 }
 
+// IsBound is true if the commit is bound to its first entry.
+func (m *CommitChainMsg) IsBound() bool {
+	return m.Binding != nil
+}
+
+// Bind binds the commit to its first entry, signing the binding with the entry credit key the
+// commit is signed with.
+func (m *CommitChainMsg) Bind(entry interfaces.IEBEntry, privateKey []byte) error {
+	binding := entryBlock.ExtIDMerkleRoot(entry)
+	data, err := bindingForSignature(m.CommitChain, binding)
+	if err != nil {
+		return err
+	}
+	m.Binding = binding
+	m.BindingSig = new(primitives.ByteSlice64)
+	if err := m.BindingSig.UnmarshalBinary(primitives.Sign(privateKey, data)); err != nil {
+		return err
+	}
+	m.MsgHash = nil
+	m.ResetMarshalCache()
+	return nil
+}
+
+// VerifyBinding is true if the commit isn't bound, or its binding is signed by its entry credit key.
+func (m *CommitChainMsg) VerifyBinding() bool {
+	if !m.IsBound() {
+		return true
+	}
+	if m.BindingSig == nil || m.CommitChain.ECPubKey == nil {
+		return false
+	}
+	data, err := bindingForSignature(m.CommitChain, m.Binding)
+	if err != nil {
+		return false
+	}
+	return primitives.VerifySignature(data, m.CommitChain.ECPubKey[:], m.BindingSig[:]) == nil
+}
+
+// bindingForSignature returns what the entry credit key signs to bind a commit: the commit, as it
+// is signed, and the binding.
+func bindingForSignature(commit *entryCreditBlock.CommitChain, binding interfaces.IHash) ([]byte, error) {
+	data, err := commit.MarshalBinarySig()
+	if err != nil {
+		return nil, err
+	}
+	return append(data, binding.Bytes()...), nil
+}
+
 func (m *CommitChainMsg) GetCount() int {
 	return m.count
 }
@@ -79,12 +147,17 @@ func (m *CommitChainMsg) GetRepeatHash() interfaces.IHash {
 }
 
 func (m *CommitChainMsg) GetHash() interfaces.IHash {
-	return m.GetMsgHash()
+	return m.CommitChain.GetSigHash()
 }
 
+// A bound commit is a different message than the same commit unbound, but the same transaction
 func (m *CommitChainMsg) GetMsgHash() interfaces.IHash {
 	if m.MsgHash == nil {
-		m.MsgHash = m.CommitChain.GetSigHash()
+		if !m.IsBound() {
+			m.MsgHash = m.CommitChain.GetSigHash()
+		} else if data, err := m.MarshalForSignature(); err == nil {
+			m.MsgHash = primitives.Sha(data)
+		}
 	}
 	return m.MsgHash
 }
@@ -94,6 +167,9 @@ func (m *CommitChainMsg) GetTimestamp() interfaces.Timestamp {
 }
 
 func (m *CommitChainMsg) Type() byte {
+	if m.IsBound() {
+		return constants.BOUND_COMMIT_CHAIN_MSG
+	}
 	return constants.COMMIT_CHAIN_MSG
 }
 
@@ -101,19 +177,22 @@ func (m *CommitChainMsg) Type() byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *CommitChainMsg) Validate(state interfaces.IState) int {
-	if !m.validsig && !m.CommitChain.IsValid() {
-		return -1
+func (m *CommitChainMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if m.IsBound() && !state.IsActive(constants.ACTIVATION_CHAIN_BINDING, state.GetLeaderHeight()) {
+		return interfaces.Invalid(interfaces.ReasonNotYet)
+	}
+	if !m.validsig && (!m.CommitChain.IsValid() || !m.VerifyBinding()) {
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	m.validsig = true
 
 	ebal := state.GetFactoidState().GetECBalance(*m.CommitChain.ECPubKey)
 	v := int(ebal) - int(m.CommitChain.Credits)
 	if v < 0 {
-		return 0
+		return interfaces.Pending(interfaces.ReasonInsufficientBalance)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *CommitChainMsg) ComputeVMIndex(state interfaces.IState) {
@@ -136,11 +215,15 @@ func (m *CommitChainMsg) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *CommitChainMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *CommitChainMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *CommitChainMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *CommitChainMsg) Sign(key interfaces.Signer) error {
@@ -149,6 +232,7 @@ func (m *CommitChainMsg) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -161,16 +245,16 @@ func (m *CommitChainMsg) VerifySignature() (bool, error) {
 }
 
 func (m *CommitChainMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Commit Chain Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if len(data) > 0 && data[0] == constants.BOUND_COMMIT_CHAIN_MSG {
+		m.Binding = new(primitives.Hash)
+	} else {
+		m.Binding = nil
+	}
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	cc := entryCreditBlock.NewCommitChain()
 	newData, err = cc.UnmarshalBinaryData(newData)
@@ -179,6 +263,18 @@ func (m *CommitChainMsg) UnmarshalBinaryData(data []byte) (newData []byte, err e
 	}
 	m.CommitChain = cc
 
+	if m.IsBound() {
+		newData, err = m.Binding.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+		m.BindingSig = new(primitives.ByteSlice64)
+		newData, err = m.BindingSig.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if len(newData) > 0 {
 		m.Signature = new(primitives.Signature)
 		newData, err = m.Signature.UnmarshalBinaryData(newData)
@@ -206,10 +302,22 @@ func (m *CommitChainMsg) MarshalForSignature() (data []byte, err error) {
 	}
 	buf.Write(data)
 
+	if m.IsBound() {
+		if m.BindingSig == nil {
+			return nil, fmt.Errorf("Binding is not signed")
+		}
+		buf.Write(m.Binding.Bytes())
+		buf.Write(m.BindingSig[:])
+	}
+
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *CommitChainMsg) MarshalBinary() (data []byte, err error) {
+func (m *CommitChainMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *CommitChainMsg) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
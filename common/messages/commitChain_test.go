@@ -11,6 +11,7 @@ import (
 
 	ed "github.com/FactomProject/ed25519"
 	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
 	. "github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -161,3 +162,58 @@ func newSignedCommitChain() *CommitChainMsg {
 
 	return msg
 }
+
+func TestBindCommitChain(t *testing.T) {
+	msg := newBoundCommitChain()
+	unbound := new(CommitChainMsg)
+	unbound.CommitChain = msg.CommitChain
+
+	if !msg.VerifyBinding() {
+		t.Error("Binding is not valid")
+	}
+	if msg.Type() != constants.BOUND_COMMIT_CHAIN_MSG {
+		t.Error("Bound commit has the wrong type")
+	}
+	if msg.GetMsgHash().IsSameAs(unbound.GetMsgHash()) {
+		t.Error("Bound commit has the message hash of the unbound one")
+	}
+
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg2, err := UnmarshalMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc2 := msg2.(*CommitChainMsg)
+	if !msg.IsSameAs(cc2) || !cc2.VerifyBinding() {
+		t.Error("Bound commit didn't survive a round trip")
+	}
+	if !cc2.GetRepeatHash().IsSameAs(msg.GetRepeatHash()) {
+		t.Error("Bound and unbound copies of a commit are not the same transaction")
+	}
+
+	// A binding swapped on the way doesn't verify
+	cc2.Binding = primitives.Sha([]byte("other"))
+	if cc2.VerifyBinding() {
+		t.Error("Altered binding is valid")
+	}
+}
+
+func newBoundCommitChain() *CommitChainMsg {
+	msg := newCommitChain()
+	pub, privkey, err := ed.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	msg.CommitChain.ECPubKey = (*primitives.ByteSlice32)(pub)
+	msg.CommitChain.Sig = (*primitives.ByteSlice64)(ed.Sign(privkey, msg.CommitChain.CommitMsg()))
+
+	entry := entryBlock.NewEntry()
+	entry.ExtIDs = []primitives.ByteSlice{primitives.ByteSlice{Bytes: []byte("first")}}
+	if err := msg.Bind(entry, privkey[:]); err != nil {
+		panic(err)
+	}
+	return msg
+}
@@ -107,6 +107,7 @@ func (m *CommitEntryMsg) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -119,16 +120,11 @@ func (m *CommitEntryMsg) VerifySignature() (bool, error) {
 }
 
 func (m *CommitEntryMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Commit entry Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	ce := entryCreditBlock.NewCommitEntry()
 	newData, err = ce.UnmarshalBinaryData(newData)
@@ -167,7 +163,11 @@ func (m *CommitEntryMsg) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *CommitEntryMsg) MarshalBinary() (data []byte, err error) {
+func (m *CommitEntryMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *CommitEntryMsg) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -200,17 +200,17 @@ func (m *CommitEntryMsg) String() string {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *CommitEntryMsg) Validate(state interfaces.IState) int {
+func (m *CommitEntryMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if !m.validsig && !m.CommitEntry.IsValid() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	m.validsig = true
 
 	ebal := state.GetFactoidState().GetECBalance(*m.CommitEntry.ECPubKey)
 	if int(m.CommitEntry.Credits) > int(ebal) {
-		return 0
+		return interfaces.Pending(interfaces.ReasonInsufficientBalance)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *CommitEntryMsg) ComputeVMIndex(state interfaces.IState) {
@@ -233,11 +233,15 @@ func (m *CommitEntryMsg) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *CommitEntryMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *CommitEntryMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *CommitEntryMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func NewCommitEntryMsg() *CommitEntryMsg {
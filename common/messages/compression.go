@@ -0,0 +1,85 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+// Reveals of large entries and DBStates of full blocks are most of what a node sends, and compress
+// well, so they can be sent compressed.  A compressed message is its type with CompressedFlag set,
+// followed by the rest of the message deflated with zlib.  No message type has that bit set, so
+// UnmarshalMessageData can tell the two apart, and takes either.
+//
+// Peers that don't know the flag can't read a compressed message, so p2p only passes one on to a
+// peer that has said it can (see ProtocolVersionCompression in p2p/protocol.go), and inflates it
+// for any other.
+
+const (
+	CompressedFlag      byte = 0x80    // Set in the type byte of a compressed message
+	CompressMinimum          = 1024    // Messages smaller than this are not worth compressing
+	MaxDecompressedSize      = 1 << 28 // Largest a compressed message may inflate to
+)
+
+// IsCompressed is true if data is a compressed message.
+func IsCompressed(data []byte) bool {
+	return len(data) > 0 && data[0]&CompressedFlag != 0
+}
+
+// CompressMessage returns the compressed form of a marshaled message, if it is a reveal or a
+// DBState that compressing makes smaller, and the message as is otherwise.
+func CompressMessage(data []byte) []byte {
+	if len(data) < CompressMinimum || IsCompressed(data) {
+		return data
+	}
+	switch data[0] {
+	case constants.REVEAL_ENTRY_MSG, constants.DBSTATE_MSG:
+	default:
+		return data
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(data[0] | CompressedFlag)
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data[1:]); err != nil {
+		return data
+	}
+	if err := w.Close(); err != nil {
+		return data
+	}
+	if buf.Len() >= len(data) {
+		return data
+	}
+	return buf.Bytes()
+}
+
+// DecompressMessage returns the marshaled message a compressed message holds, and data as is if it
+// isn't compressed.
+func DecompressMessage(data []byte) ([]byte, error) {
+	if !IsCompressed(data) {
+		return data, nil
+	}
+	r, err := zlib.NewReader(bytes.NewReader(data[1:]))
+	if err != nil {
+		return nil, fmt.Errorf("Error decompressing message: %v", err)
+	}
+	defer r.Close()
+
+	// Read one byte past the limit, to tell a message at the limit from one over it
+	body, err := ioutil.ReadAll(io.LimitReader(r, MaxDecompressedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("Error decompressing message: %v", err)
+	}
+	if len(body) > MaxDecompressedSize {
+		return nil, fmt.Errorf("Compressed message inflates past %d bytes", MaxDecompressedSize)
+	}
+	return append([]byte{data[0] &^ CompressedFlag}, body...), nil
+}
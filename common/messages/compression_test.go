@@ -0,0 +1,65 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestCompressRevealEntry(t *testing.T) {
+	entry := new(entryBlock.Entry)
+	entry.ChainID = new(primitives.Hash)
+	entry.ChainID.SetBytes(constants.EC_CHAINID)
+	entry.Content = primitives.ByteSlice{Bytes: bytes.Repeat([]byte("some very repetitive content "), 300)}
+	re := new(RevealEntryMsg)
+	re.Entry = entry
+
+	data, err := re.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed := CompressMessage(data)
+	if !IsCompressed(compressed) || len(compressed) >= len(data) {
+		t.Fatalf("Expected the reveal to compress, got %d bytes from %d", len(compressed), len(data))
+	}
+
+	decompressed, err := DecompressMessage(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Error("The message decompressed is not the one compressed")
+	}
+
+	msg, err := UnmarshalMessage(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Type() != constants.REVEAL_ENTRY_MSG || !msg.GetHash().IsSameAs(re.GetHash()) {
+		t.Error("A compressed reveal did not unmarshal to the reveal")
+	}
+}
+
+func TestCompressLeavesOthersAlone(t *testing.T) {
+	small := []byte{constants.REVEAL_ENTRY_MSG, 1, 2, 3}
+	if !bytes.Equal(CompressMessage(small), small) {
+		t.Error("A small message was compressed")
+	}
+
+	other := append([]byte{constants.EOM_MSG}, make([]byte, 4096)...)
+	if !bytes.Equal(CompressMessage(other), other) {
+		t.Error("Only reveals and DBStates are compressed")
+	}
+
+	if _, err := DecompressMessage([]byte{constants.REVEAL_ENTRY_MSG | CompressedFlag, 1, 2, 3}); err == nil {
+		t.Error("Decompressed garbage")
+	}
+}
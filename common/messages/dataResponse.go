@@ -99,35 +99,35 @@ func (m *DataResponse) GetTimestamp() interfaces.Timestamp {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *DataResponse) Validate(state interfaces.IState) int {
+func (m *DataResponse) Validate(state interfaces.IState) interfaces.ValidationResult {
 	var dataHash interfaces.IHash
 	var err error
 	switch m.DataType {
 	case 0: // DataType = entry
 		dataObject, ok := m.DataObject.(interfaces.IEBEntry)
 		if !ok {
-			return -1
+			return interfaces.Invalid(interfaces.ReasonMalformed)
 		}
 		dataHash = dataObject.GetHash()
 	case 1: // DataType = eblock
 		dataObject, ok := m.DataObject.(interfaces.IEntryBlock)
 		if !ok {
-			return -1
+			return interfaces.Invalid(interfaces.ReasonMalformed)
 		}
 		dataHash, err = dataObject.KeyMR()
 		if err != nil {
-			return -1
+			return interfaces.Invalid(interfaces.ReasonMalformed)
 		}
 	default:
 		// DataType currently not supported, treat as invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
 	if dataHash.IsSameAs(m.DataHash) {
-		return 1
+		return interfaces.Valid()
 	}
 
-	return -1
+	return interfaces.Invalid(interfaces.ReasonMalformed)
 }
 
 func (m *DataResponse) ComputeVMIndex(state interfaces.IState) {}
@@ -147,24 +147,23 @@ func (e *DataResponse) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *DataResponse) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *DataResponse) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *DataResponse) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *DataResponse) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -172,8 +171,12 @@ func (m *DataResponse) UnmarshalBinaryData(data []byte) (newData []byte, err err
 		return nil, err
 	}
 
-	m.DataType = int(newData[0])
-	newData = newData[1:]
+	var dataType byte
+	dataType, newData, err = popByte(newData, "DataType")
+	if err != nil {
+		return nil, err
+	}
+	m.DataType = int(dataType)
 
 	m.DataHash = primitives.NewHash(constants.ZERO_HASH)
 	newData, err = m.DataHash.UnmarshalBinaryData(newData)
@@ -209,12 +212,6 @@ func (m *DataResponse) UnmarshalBinary(data []byte) error {
 }
 
 func attemptEntryUnmarshal(data []byte) (entry interfaces.IEBEntry, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Bytes do not represent an entry")
-		}
-	}()
-
 	entry, err = entryBlock.UnmarshalEntry(data)
 	if err != nil {
 		return nil, err
@@ -224,12 +221,6 @@ func attemptEntryUnmarshal(data []byte) (entry interfaces.IEBEntry, err error) {
 }
 
 func attemptEBlockUnmarshal(data []byte) (eblock interfaces.IEntryBlock, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Bytes do not represent an eblock: %v\n", r)
-		}
-	}()
-
 	eblock, err = entryBlock.UnmarshalEBlock(data)
 	if err != nil {
 		return nil, err
@@ -239,6 +230,10 @@ func attemptEBlockUnmarshal(data []byte) (eblock interfaces.IEntryBlock, err err
 }
 
 func (m *DataResponse) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DataResponse) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 	buf.Write([]byte{m.Type()})
 	if d, err := m.Timestamp.MarshalBinary(); err != nil {
@@ -0,0 +1,213 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Most headers asked for, or sent, in one message
+const MaxDBlockHeaders = 1000
+
+//Requests the headers of a range of DBlocks
+
+type DBlockHeadersRequest struct {
+	MessageBase
+	Timestamp interfaces.Timestamp
+
+	DBHeightStart uint32 // First header wanted
+	Count         uint32 // Number of headers wanted
+
+	//Not signed!
+}
+
+var _ interfaces.IMsg = (*DBlockHeadersRequest)(nil)
+
+func (a *DBlockHeadersRequest) IsSameAs(b *DBlockHeadersRequest) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if a.DBHeightStart != b.DBHeightStart {
+		return false
+	}
+	if a.Count != b.Count {
+		return false
+	}
+
+	return true
+}
+
+func (m *DBlockHeadersRequest) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *DBlockHeadersRequest) GetHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *DBlockHeadersRequest) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *DBlockHeadersRequest) Type() byte {
+	return constants.DBLOCK_HEADERS_REQUEST
+}
+
+func (m *DBlockHeadersRequest) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+// Validate the message, given the state.  Three possible results:
+//  < 0 -- Message is invalid.  Discard
+//  0   -- Cannot tell if message is Valid
+//  1   -- Message is valid
+func (m *DBlockHeadersRequest) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if m.Count == 0 || m.Count > MaxDBlockHeaders {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	return interfaces.Valid()
+}
+
+func (m *DBlockHeadersRequest) ComputeVMIndex(state interfaces.IState) {
+}
+
+// Execute the leader functions of the given message
+func (m *DBlockHeadersRequest) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+// Sends back the headers we have of the ones asked for, stopping at the first we don't have.
+func (m *DBlockHeadersRequest) FollowerExecute(state interfaces.IState) {
+	if state.NetworkOutMsgQueue().Length() > 1000 {
+		return
+	}
+	db := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	resp := NewDBlockHeadersResponse(state).(*DBlockHeadersResponse)
+
+	for i := m.DBHeightStart; i < m.DBHeightStart+m.Count; i++ {
+		dblk, err := db.FetchDBlockByHeight(i)
+		if err != nil || dblk == nil {
+			break
+		}
+		resp.Headers = append(resp.Headers, dblk.GetHeader())
+	}
+	if len(resp.Headers) == 0 {
+		return
+	}
+
+	resp.SetOrigin(m.GetOrigin())
+	resp.SetNetworkOrigin(m.GetNetworkOrigin())
+	resp.SendOut(state, resp)
+	state.IncDBStateAnswerCnt()
+
+	return
+}
+
+// Requests do not go into the process list.
+func (e *DBlockHeadersRequest) Process(dbheight uint32, state interfaces.IState) bool {
+	panic("DBlockHeadersRequest should never have its Process() method called")
+}
+
+func (e *DBlockHeadersRequest) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *DBlockHeadersRequest) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *DBlockHeadersRequest) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (m *DBlockHeadersRequest) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Peer2Peer = true // This is always a Peer2peer message
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.DBHeightStart, newData, err = popUInt32(newData, "DBHeightStart")
+	if err != nil {
+		return nil, err
+	}
+	m.Count, newData, err = popUInt32(newData, "Count")
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func (m *DBlockHeadersRequest) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *DBlockHeadersRequest) MarshalForSignature() ([]byte, error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	t := m.GetTimestamp()
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	binary.Write(&buf, binary.BigEndian, m.DBHeightStart)
+	binary.Write(&buf, binary.BigEndian, m.Count)
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *DBlockHeadersRequest) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DBlockHeadersRequest) marshalBinary() ([]byte, error) {
+	return m.MarshalForSignature()
+}
+
+func (m *DBlockHeadersRequest) String() string {
+	return fmt.Sprintf("DBlockHeadersRequest: %d+%d", m.DBHeightStart, m.Count)
+}
+
+func NewDBlockHeadersRequest(state interfaces.IState, dbheightStart uint32, count uint32) interfaces.IMsg {
+	msg := new(DBlockHeadersRequest)
+
+	msg.Peer2Peer = true // Always a peer2peer request.
+	msg.Timestamp = state.GetTimestamp()
+	msg.DBHeightStart = dbheightStart
+	msg.Count = count
+
+	return msg
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilDBlockHeadersRequest(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(DBlockHeadersRequest)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalDBlockHeadersRequest(t *testing.T) {
+	msg := newDBlockHeadersRequest()
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Error(err)
+	}
+	str := msg2.String()
+	t.Logf("str - %v", str)
+
+	if msg2.Type() != constants.DBLOCK_HEADERS_REQUEST {
+		t.Error("Invalid message type unmarshalled")
+	}
+
+	if msg.IsSameAs(msg2.(*DBlockHeadersRequest)) != true {
+		t.Errorf("DBlockHeadersRequest messages are not identical")
+	}
+}
+
+func TestValidateDBlockHeadersRequest(t *testing.T) {
+	msg := newDBlockHeadersRequest()
+	if msg.Validate(nil).Code != 1 {
+		t.Error("Valid request failed to validate")
+	}
+	msg.Count = 0
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Request for no headers validated")
+	}
+	msg.Count = MaxDBlockHeaders + 1
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Request for too many headers validated")
+	}
+}
+
+func newDBlockHeadersRequest() *DBlockHeadersRequest {
+	msg := new(DBlockHeadersRequest)
+	msg.Timestamp = primitives.NewTimestampNow()
+
+	msg.DBHeightStart = 0x01234567
+	msg.Count = 500
+
+	return msg
+}
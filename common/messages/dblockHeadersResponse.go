@@ -0,0 +1,213 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+//Responds with the headers of a run of consecutive DBlocks
+
+type DBlockHeadersResponse struct {
+	MessageBase
+	Timestamp interfaces.Timestamp
+
+	HeaderCount uint32
+	Headers     []interfaces.IDirectoryBlockHeader
+
+	//Not signed!
+}
+
+var _ interfaces.IMsg = (*DBlockHeadersResponse)(nil)
+
+func (a *DBlockHeadersResponse) IsSameAs(b *DBlockHeadersResponse) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if len(a.Headers) != len(b.Headers) {
+		return false
+	}
+	for i := range a.Headers {
+		if a.Headers[i].IsSameAs(b.Headers[i]) == false {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *DBlockHeadersResponse) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *DBlockHeadersResponse) GetHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *DBlockHeadersResponse) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *DBlockHeadersResponse) Type() byte {
+	return constants.DBLOCK_HEADERS_RESPONSE
+}
+
+func (m *DBlockHeadersResponse) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+// Validate the message, given the state.  Three possible results:
+//  < 0 -- Message is invalid.  Discard
+//  0   -- Cannot tell if message is Valid
+//  1   -- Message is valid
+//
+// Only checks that the headers are a run of heights.  Whether they are the right headers is up
+// to the State, when it adds them to the header chain.
+func (m *DBlockHeadersResponse) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if len(m.Headers) == 0 || len(m.Headers) > MaxDBlockHeaders {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	for i := 1; i < len(m.Headers); i++ {
+		if m.Headers[i].GetDBHeight() != m.Headers[i-1].GetDBHeight()+1 {
+			return interfaces.Invalid(interfaces.ReasonMalformed)
+		}
+	}
+	return interfaces.Valid()
+}
+
+func (m *DBlockHeadersResponse) ComputeVMIndex(state interfaces.IState) {
+}
+
+// Execute the leader functions of the given message
+func (m *DBlockHeadersResponse) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+func (m *DBlockHeadersResponse) FollowerExecute(state interfaces.IState) {
+	state.AddDBlockHeaders(m.Headers)
+}
+
+// Responses do not go into the process list.
+func (e *DBlockHeadersResponse) Process(dbheight uint32, state interfaces.IState) bool {
+	panic("DBlockHeadersResponse should never have its Process() method called")
+}
+
+func (e *DBlockHeadersResponse) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *DBlockHeadersResponse) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *DBlockHeadersResponse) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (m *DBlockHeadersResponse) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Peer2Peer = true // This is always a Peer2peer message
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.HeaderCount, newData, err = popUInt32(newData, "HeaderCount")
+	if err != nil {
+		return nil, err
+	}
+	if m.HeaderCount > MaxDBlockHeaders {
+		return nil, fmt.Errorf("Too many headers: %d", m.HeaderCount)
+	}
+
+	m.Headers = nil
+	for i := 0; i < int(m.HeaderCount); i++ {
+		header := directoryBlock.NewDBlockHeader()
+		newData, err = header.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, err
+		}
+		m.Headers = append(m.Headers, header)
+	}
+
+	return
+}
+
+func (m *DBlockHeadersResponse) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *DBlockHeadersResponse) MarshalForSignature() ([]byte, error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	t := m.GetTimestamp()
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	m.HeaderCount = uint32(len(m.Headers))
+	binary.Write(&buf, binary.BigEndian, m.HeaderCount)
+	for _, h := range m.Headers {
+		bin, err := h.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(bin)
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *DBlockHeadersResponse) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DBlockHeadersResponse) marshalBinary() ([]byte, error) {
+	return m.MarshalForSignature()
+}
+
+func (m *DBlockHeadersResponse) String() string {
+	if len(m.Headers) == 0 {
+		return "DBlockHeadersResponse: none"
+	}
+	return fmt.Sprintf("DBlockHeadersResponse: %d-%d", m.Headers[0].GetDBHeight(), m.Headers[len(m.Headers)-1].GetDBHeight())
+}
+
+func NewDBlockHeadersResponse(state interfaces.IState) interfaces.IMsg {
+	msg := new(DBlockHeadersResponse)
+
+	msg.Peer2Peer = true // Always a peer2peer response.
+	msg.Timestamp = state.GetTimestamp()
+
+	return msg
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilDBlockHeadersResponse(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(DBlockHeadersResponse)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalDBlockHeadersResponse(t *testing.T) {
+	msg := newDBlockHeadersResponse()
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Error(err)
+	}
+	str := msg2.String()
+	t.Logf("str - %v", str)
+
+	if msg2.Type() != constants.DBLOCK_HEADERS_RESPONSE {
+		t.Error("Invalid message type unmarshalled")
+	}
+
+	if msg.IsSameAs(msg2.(*DBlockHeadersResponse)) != true {
+		t.Errorf("DBlockHeadersResponse messages are not identical")
+	}
+}
+
+func TestValidateDBlockHeadersResponse(t *testing.T) {
+	msg := newDBlockHeadersResponse()
+	if msg.Validate(nil).Code != 1 {
+		t.Error("Valid response failed to validate")
+	}
+	msg.Headers[2].SetDBHeight(10)
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Response with a gap in its heights validated")
+	}
+	msg.Headers = nil
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Empty response validated")
+	}
+}
+
+func newDBlockHeadersResponse() *DBlockHeadersResponse {
+	msg := new(DBlockHeadersResponse)
+	msg.Timestamp = primitives.NewTimestampNow()
+
+	for i := uint32(0); i < 3; i++ {
+		h := directoryBlock.NewDBlockHeader()
+		h.SetDBHeight(i + 5)
+		h.SetNetworkID(constants.MAIN_NETWORK_ID)
+		h.SetBodyMR(primitives.Sha([]byte{byte(i)}))
+		h.SetTimestamp(primitives.NewTimestampFromMinutes(1234 + i))
+		msg.Headers = append(msg.Headers, h)
+	}
+
+	return msg
+}
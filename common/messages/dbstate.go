@@ -41,10 +41,22 @@ type DBStateMsg struct {
 	IgnoreSigs bool
 	Sent       interfaces.Timestamp
 	IsInDB     bool
+
+	// Set by PreValidate.  PreValidate must be done before the message is handed to the State.
+	preValidated bool
+	dataValid    int
+	sigVerified  []bool
 }
 
 var _ interfaces.IMsg = (*DBStateMsg)(nil)
 
+// Most entry blocks and entries a DBStateMsg (or an EntryBlockResponse) can carry; far more than
+// any block holds
+const (
+	MaxDBStateEBlocks = 100000
+	MaxDBStateEntries = 1000000
+)
+
 func (a *DBStateMsg) IsSameAs(b *DBStateMsg) bool {
 	defer func() {
 		if r := recover(); r != nil {
@@ -136,30 +148,30 @@ func (m *DBStateMsg) GetTimestamp() interfaces.Timestamp {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *DBStateMsg) Validate(state interfaces.IState) int {
+func (m *DBStateMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// No matter what, a block has to have what a block has to have.
 	if m.DirectoryBlock == nil || m.AdminBlock == nil || m.FactoidBlock == nil || m.EntryCreditBlock == nil {
 		state.AddStatus(fmt.Sprintf("DBStateMsg.Validate() Fail  Doesn't have all the blocks"))
 		//We need the basic block types
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
 	if m.IsInDB {
-		return 1
+		return interfaces.Valid()
 	}
 
 	dbheight := m.DirectoryBlock.GetHeader().GetDBHeight()
 
 	// Just accept the genesis block
 	if dbheight == 0 {
-		return 1
+		return interfaces.Valid()
 	}
 
 	if state.GetNetworkID() != m.DirectoryBlock.GetHeader().GetNetworkID() {
 		state.AddStatus(fmt.Sprintf("DBStateMsg.Validate() Fail  ht: %d Expecting NetworkID %x and found %x",
 			dbheight, state.GetNetworkID(), m.DirectoryBlock.GetHeader().GetNetworkID()))
 		//Wrong network ID
-		return -1
+		return interfaces.Invalid(interfaces.ReasonWrongNetwork)
 	}
 
 	diff := int(dbheight) - (int(state.GetEntryDBHeightComplete())) // Difference from the working height (completed+1)
@@ -168,7 +180,7 @@ func (m *DBStateMsg) Validate(state interfaces.IState) int {
 	if diff < -1 {
 		state.AddStatus(fmt.Sprintf("DBStateMsg.Validate() Fail dbstate dbht: %d Highest Saved %d diff %d",
 			dbheight, state.GetEntryDBHeightComplete(), diff))
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	if m.DirectoryBlock.GetHeader().GetNetworkID() == constants.MAIN_NETWORK_ID {
@@ -178,22 +190,27 @@ func (m *DBStateMsg) Validate(state interfaces.IState) int {
 				state.AddStatus(fmt.Sprintf("DBStateMsg.Validate() Fail  ht: %d checkpoint failure. Had %s Expected %s",
 					dbheight, m.DirectoryBlock.DatabasePrimaryIndex().String(), key))
 				//Key does not match checkpoint
-				return -1
+				return interfaces.Invalid(interfaces.ReasonBadBlock)
 			}
 		}
 	}
 
+	if v, reason := state.ValidateBlockTimestamp(m.DirectoryBlock); v != 1 {
+		state.AddStatus(fmt.Sprintf("DBStateMsg.Validate() ht: %d %s", dbheight, reason))
+		return interfaces.ValidationResult{Code: v, Reason: interfaces.ReasonBadTimestamp}
+	}
+
 	// Check the signatures on the DBstate
 	if v := m.ValidateSignatures(state); v != 1 {
-		return v
+		return interfaces.ValidationResult{Code: v, Reason: interfaces.ReasonBadSignature}
 	}
 
 	// Ensure the data matches the DBlock it sends us
 	if v := m.ValidateData(state); v != 1 {
-		return v
+		return interfaces.ValidationResult{Code: v, Reason: interfaces.ReasonBadBlock}
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *DBStateMsg) ValidateSignatures(state interfaces.IState) int {
@@ -206,7 +223,12 @@ func (m *DBStateMsg) ValidateSignatures(state interfaces.IState) int {
 		// Fed count of this height -1, as we may not have the height itself
 		fedCount := len(state.GetFedServers(m.DirectoryBlock.GetDatabaseHeight()))
 		tally := m.SigTally(state)
-		if tally >= (fedCount/2 + 1) {
+		needed := state.DBStateSigsNeeded(fedCount)
+		defer func() {
+			state.SetDBStateSigTally(interfaces.DBStateSigTally{DBHeight: m.DirectoryBlock.GetDatabaseHeight(),
+				Signatures: tally, Needed: needed, FedServers: fedCount})
+		}()
+		if tally >= needed {
 			// This has all the signatures it needs
 			goto ValidSignatures
 		} else {
@@ -225,7 +247,8 @@ func (m *DBStateMsg) ValidateSignatures(state interfaces.IState) int {
 					fedCount--
 				}
 			}
-			if tally >= (fedCount/2 + 1) {
+			needed = state.DBStateSigsNeeded(fedCount)
+			if tally >= needed {
 				// This has all the signatures it needs
 				goto ValidSignatures
 			}
@@ -256,6 +279,13 @@ ValidSignatures: // Goto here if signatures pass
 // ValidateData will check the data attached to the DBState against the directory block it contains.
 // This is ensure no additional junk is attached to a valid DBState
 func (m *DBStateMsg) ValidateData(state interfaces.IState) int {
+	if m.preValidated {
+		return m.dataValid
+	}
+	return m.validateData()
+}
+
+func (m *DBStateMsg) validateData() int {
 	// Checking the content of the DBState against the directoryblock contained
 	// Map of Entries and Eblocks in this DBState dblock
 	// A value of true indicates a repeat. Repeats are not enforce though
@@ -337,7 +367,7 @@ func (m *DBStateMsg) SigTally(state interfaces.IState) int {
 
 	// If there is a repeat signature, we do not count it twice
 	sigmap := make(map[string]bool)
-	for _, sig := range m.SignatureList.List {
+	for i, sig := range m.SignatureList.List {
 		if sigmap[fmt.Sprintf("%x", sig.GetSignature()[:])] {
 			continue // Toss duplicate signatures
 		}
@@ -346,7 +376,7 @@ func (m *DBStateMsg) SigTally(state interfaces.IState) int {
 		authoritativeKey := state.GetNetworkBootStrapKey()
 		if authoritativeKey != nil {
 			if bytes.Compare(sig.GetKey(), authoritativeKey.Bytes()) == 0 {
-				if sig.Verify(data) {
+				if m.verifySig(i, sig, data) {
 					validSigCount++
 					continue
 				}
@@ -359,7 +389,7 @@ func (m *DBStateMsg) SigTally(state interfaces.IState) int {
 			continue
 		}
 
-		if sig.Verify(data) {
+		if m.verifySig(i, sig, data) {
 			remainingSig = append(remainingSig, sig)
 		}
 	}
@@ -455,6 +485,45 @@ func (m *DBStateMsg) SigTally(state interfaces.IState) int {
 	return validSigCount
 }
 
+// Uses the result from PreValidate if we have one
+func (m *DBStateMsg) verifySig(i int, sig interfaces.IFullSignature, data []byte) bool {
+	if m.preValidated && i < len(m.sigVerified) {
+		return m.sigVerified[i]
+	}
+	return sig.Verify(data)
+}
+
+// PreValidate does the checks on a DBState that don't depend on the State: the merkle roots of
+// the blocks, the directory block signatures, and the signatures of the factoid transactions.
+// None of these need the blocks before it, so DBStates can be PreValidated in parallel while we
+// catch up, leaving Validate() with only the checks against the authority set.
+func (m *DBStateMsg) PreValidate() {
+	if m.DirectoryBlock == nil || m.AdminBlock == nil || m.FactoidBlock == nil || m.EntryCreditBlock == nil {
+		return
+	}
+
+	m.dataValid = m.validateData()
+
+	data, err := m.DirectoryBlock.GetHeader().MarshalBinary()
+	if err != nil {
+		return
+	}
+	m.sigVerified = make([]bool, len(m.SignatureList.List))
+	for i, sig := range m.SignatureList.List {
+		m.sigVerified[i] = sig.Verify(data)
+	}
+
+	// The transactions remember their signatures are good, so the FactoidState doesn't check them again.
+	for i, trans := range m.FactoidBlock.GetTransactions() {
+		if i == 0 {
+			continue // The coinbase has no signatures
+		}
+		trans.ValidateSignatures()
+	}
+
+	m.preValidated = true
+}
+
 func (m *DBStateMsg) checkpointFix() int {
 	returnAmt := 0
 	dbheight := m.DirectoryBlock.GetDatabaseHeight()
@@ -504,25 +573,23 @@ func (e *DBStateMsg) Process(dbheight uint32, state interfaces.IState) bool {
 }
 
 func (e *DBStateMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *DBStateMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
 }
 
-func (m *DBStateMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Directory Block State Message: %v", r)
-		}
-	}()
+func (e *DBStateMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
 
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+func (m *DBStateMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Peer2Peer = true
 
@@ -556,26 +623,49 @@ func (m *DBStateMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error
 		return nil, err
 	}
 
-	eBlockCount, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var eBlockCount uint32
+	eBlockCount, newData, err = popUInt32(newData, "EBlocks")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, eBlockCount, MaxDBStateEBlocks, 1, "EBlocks"); err != nil {
+		return nil, err
+	}
 
 	for i := uint32(0); i < eBlockCount; i++ {
 		eBlock := entryBlock.NewEBlock()
 		newData, err = eBlock.UnmarshalBinaryData(newData)
 		if err != nil {
-			panic(err.Error())
+			return nil, err
 		}
 		m.EBlocks = append(m.EBlocks, eBlock)
 	}
 
-	entryCount, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var entryCount uint32
+	entryCount, newData, err = popUInt32(newData, "Entries")
+	if err != nil {
+		return nil, err
+	}
+	// Each entry is prefixed with its size
+	if err = checkCount(newData, entryCount, MaxDBStateEntries, 4, "Entries"); err != nil {
+		return nil, err
+	}
 
 	for i := uint32(0); i < entryCount; i++ {
 		var entrySize uint32
-		entrySize, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+		entrySize, newData, err = popUInt32(newData, "Entry size")
+		if err != nil {
+			return nil, err
+		}
+		var entryData []byte
+		entryData, newData, err = popLen(newData, int(entrySize), "Entry")
+		if err != nil {
+			return nil, err
+		}
 		entry := entryBlock.NewEntry()
-		newData, err = newData[int(entrySize):], entry.UnmarshalBinary(newData[:int(entrySize)])
+		err = entry.UnmarshalBinary(entryData)
 		if err != nil {
-			panic(err.Error())
+			return nil, err
 		}
 		m.Entries = append(m.Entries, entry)
 	}
@@ -594,6 +684,10 @@ func (m *DBStateMsg) UnmarshalBinary(data []byte) error {
 }
 
 func (m *DBStateMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DBStateMsg) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	binary.Write(&buf, binary.BigEndian, m.Type())
@@ -75,11 +75,11 @@ func (m *DBStateMissing) GetTimestamp() interfaces.Timestamp {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *DBStateMissing) Validate(state interfaces.IState) int {
+func (m *DBStateMissing) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.DBHeightStart > m.DBHeightEnd {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *DBStateMissing) ComputeVMIndex(state interfaces.IState) {
@@ -173,24 +173,23 @@ func (e *DBStateMissing) Process(dbheight uint32, state interfaces.IState) bool
 }
 
 func (e *DBStateMissing) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *DBStateMissing) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *DBStateMissing) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *DBStateMissing) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Directory Block State Missing Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Peer2Peer = true // This is always a Peer2peer message
 
@@ -200,8 +199,14 @@ func (m *DBStateMissing) UnmarshalBinaryData(data []byte) (newData []byte, err e
 		return nil, err
 	}
 
-	m.DBHeightStart, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.DBHeightEnd, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.DBHeightStart, newData, err = popUInt32(newData, "DBHeightStart")
+	if err != nil {
+		return nil, err
+	}
+	m.DBHeightEnd, newData, err = popUInt32(newData, "DBHeightEnd")
+	if err != nil {
+		return nil, err
+	}
 
 	return
 }
@@ -230,6 +235,10 @@ func (m *DBStateMissing) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *DBStateMissing) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DBStateMissing) marshalBinary() ([]byte, error) {
 	return m.MarshalForSignature()
 }
 
@@ -123,13 +123,13 @@ func TestSimpleDBStateMsgValidate(t *testing.T) {
 	state := testHelper.CreateAndPopulateTestState()
 
 	msg := new(DBStateMsg)
-	if msg.Validate(state) >= 0 {
+	if msg.Validate(state).Code >= 0 {
 		t.Errorf("Empty DBState validated")
 	}
 
 	msg = newDBStateMsg()
 	msg.DirectoryBlock.GetHeader().SetNetworkID(0x00)
-	if msg.Validate(state) >= 0 {
+	if msg.Validate(state).Code >= 0 {
 		t.Errorf("Wrong network ID validated")
 	}
 
@@ -137,7 +137,7 @@ func TestSimpleDBStateMsgValidate(t *testing.T) {
 	msg.DirectoryBlock.GetHeader().SetNetworkID(constants.MAIN_NETWORK_ID)
 	msg.DirectoryBlock.GetHeader().SetDBHeight(state.GetHighestSavedBlk() + 1)
 	constants.CheckPoints[state.GetHighestSavedBlk()+1] = "123"
-	if msg.Validate(state) >= 0 {
+	if msg.Validate(state).Code >= 0 {
 		t.Errorf("Wrong checkpoint validated")
 	}
 
@@ -168,6 +168,32 @@ func TestDBStateDataValidate(t *testing.T) {
 
 }
 
+func TestDBStatePreValidate(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	msg := newDBStateMsg()
+	msg.PreValidate()
+	if v := msg.ValidateData(state); v != 1 {
+		t.Errorf("Validate data should be 1, found %d", v)
+	}
+
+	// The result is taken from PreValidate, so it has to see the bad eblock
+	msg = newDBStateMsg()
+	eblock, _ := testHelper.CreateTestEntryBlock(nil)
+	msg.EBlocks = append(msg.EBlocks, eblock)
+	msg.PreValidate()
+	if v := msg.ValidateData(state); v != -1 {
+		t.Errorf("Should be -1, found %d", v)
+	}
+
+	// Missing blocks are left to Validate
+	msg = new(DBStateMsg)
+	msg.PreValidate()
+	if msg.Validate(state).Code >= 0 {
+		t.Errorf("Empty DBState validated")
+	}
+}
+
 // Test known conditions
 //		All sign
 //		Half + 1 Sign
@@ -202,7 +228,7 @@ func TestSignedDBStateValidate(t *testing.T) {
 	prev.FBlock = fblk
 	prev.ECBlock = ecblk
 	genDBState := NewDBStateMsg(state.GetTimestamp(), prev.DBlock, prev.ABlock, prev.FBlock, prev.ECBlock, nil, nil, nil)
-	if genDBState.Validate(state) != 1 {
+	if genDBState.Validate(state).Code != 1 {
 		t.Error("Genesis should always be valid")
 	}
 
@@ -277,17 +303,17 @@ func TestSignedDBStateValidate(t *testing.T) {
 		m.IgnoreSigs = true
 		if i%2 == 0 {
 			if i%6 == 0 {
-				if msg.Validate(state) < 0 {
-					t.Errorf("Should be valid, found %d", msg.Validate(state))
+				if msg.Validate(state).Code < 0 {
+					t.Errorf("Should be valid, found %d", msg.Validate(state).Code)
 				}
 			} else {
-				if msg.Validate(state) != 0 {
+				if msg.Validate(state).Code != 0 {
 					t.Errorf("Should be invalid")
 				}
 			}
 		} else {
-			if msg.Validate(state) < 0 {
-				t.Errorf("Should be valid, found %d", msg.Validate(state))
+			if msg.Validate(state).Code < 0 {
+				t.Errorf("Should be valid, found %d", msg.Validate(state).Code)
 			}
 		}
 
@@ -392,7 +418,7 @@ func TestPropSignedDBStateValidate(t *testing.T) {
 		msg := NewDBStateMsg(timestamp, set.DBlock, set.ABlock, set.FBlock, set.ECBlock, nil, nil, dbSigList)
 		m := msg.(*DBStateMsg)
 
-		v := msg.Validate(state)
+		v := msg.Validate(state).Code
 		need := ((totalFed - totalRemove) / 2) + 1
 		if len(signers) > need {
 			if v < 0 {
@@ -125,16 +125,16 @@ func (m *DirectoryBlockSignature) Type() byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *DirectoryBlockSignature) Validate(state interfaces.IState) int {
+func (m *DirectoryBlockSignature) Validate(state interfaces.IState) interfaces.ValidationResult {
 
 	if m.IsValid() {
-		return 1
+		return interfaces.Valid()
 	}
 
 	raw, _ := m.MarshalBinary()
 	if m.DBHeight <= state.GetHighestSavedBlk() {
 		state.Logf("error", "DirectoryBlockSignature: Fail dbstate ht: %v < dbht: %v  %s\n  [%s] RAW: %x", m.DBHeight, state.GetHighestSavedBlk(), m.String(), m.GetMsgHash().String(), raw)
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	found, _ := state.GetVirtualServers(m.DBHeight, 9, m.ServerIdentityChainID)
@@ -144,12 +144,12 @@ func (m *DirectoryBlockSignature) Validate(state interfaces.IState) int {
 			state.GetLLeaderHeight(),
 			m.ServerIdentityChainID.Bytes()[3:5],
 			m.String()))
-		return 0
+		return interfaces.Pending(interfaces.ReasonNotAuthority)
 	}
 
 	if m.IsLocal() {
 		m.SetValid()
-		return 1
+		return interfaces.Valid()
 	}
 
 	isVer, err := m.VerifySignature()
@@ -158,7 +158,7 @@ func (m *DirectoryBlockSignature) Validate(state interfaces.IState) int {
 		// if there is an error during signature verification
 		// or if the signature is invalid
 		// the message is considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 
 	marshalledMsg, _ := m.MarshalForSignature()
@@ -167,12 +167,12 @@ func (m *DirectoryBlockSignature) Validate(state interfaces.IState) int {
 		//This authority is not a Fed Server (it's either an Audit or not an Authority at all)
 		state.Logf("error", "DirectoryBlockSignature: Fail to Verify Sig (not from a Fed Server) dbht: %v %s\n  [%s] RAW: %x", state.GetLLeaderHeight(), m.String(), m.GetMsgHash().String(), raw)
 		state.AddStatus(fmt.Sprintf("DirectoryBlockSignature: Fail to Verify Sig (not from a Fed Server) dbht: %v %s", state.GetLLeaderHeight(), m.String()))
-		return authorityLevel
+		return interfaces.ValidationResult{Code: authorityLevel, Reason: interfaces.ReasonNotAuthority}
 	}
 
 	state.Logf("info", "DirectoryBlockSignature: VALID  dbht: %v %s. MsgHash: %s\n [%s] RAW: %x ", state.GetLLeaderHeight(), m.String(), m.GetMsgHash().String(), m.GetMsgHash().String(), raw)
 	m.SetValid()
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -201,6 +201,7 @@ func (m *DirectoryBlockSignature) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -222,17 +223,11 @@ func (m *DirectoryBlockSignature) VerifySignature() (bool, error) {
 }
 
 func (m *DirectoryBlockSignature) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	// TimeStamp
 	m.Timestamp = new(primitives.Timestamp)
@@ -241,7 +236,10 @@ func (m *DirectoryBlockSignature) UnmarshalBinaryData(data []byte) (newData []by
 		return nil, err
 	}
 
-	m.SysHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.SysHeight, newData, err = popUInt32(newData, "SysHeight")
+	if err != nil {
+		return nil, err
+	}
 	hash := new(primitives.Hash)
 	newData, err = hash.UnmarshalBinaryData(newData)
 	if err != nil {
@@ -249,8 +247,16 @@ func (m *DirectoryBlockSignature) UnmarshalBinaryData(data []byte) (newData []by
 	}
 	m.SysHash = hash
 
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.VMIndex, newData = int(newData[0]), newData[1:]
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	var vmIndex byte
+	vmIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.VMIndex = int(vmIndex)
 
 	header := directoryBlock.NewDBlockHeader()
 	newData, err = header.UnmarshalBinaryData(newData)
@@ -347,7 +353,11 @@ func (m *DirectoryBlockSignature) MarshalForSignature() ([]byte, error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *DirectoryBlockSignature) MarshalBinary() (data []byte, err error) {
+func (m *DirectoryBlockSignature) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *DirectoryBlockSignature) marshalBinary() (data []byte, err error) {
 	var sig interfaces.IFullSignature
 	resp, err := m.MarshalForSignature()
 	if err == nil {
@@ -376,9 +386,13 @@ func (m *DirectoryBlockSignature) String() string {
 }
 
 func (e *DirectoryBlockSignature) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *DirectoryBlockSignature) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *DirectoryBlockSignature) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
@@ -6,7 +6,6 @@ package messages
 
 import (
 	"encoding/binary"
-	"fmt"
 
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/entryBlock"
@@ -88,15 +87,15 @@ func (m *EntryBlockResponse) GetTimestamp() interfaces.Timestamp {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *EntryBlockResponse) Validate(state interfaces.IState) int {
+func (m *EntryBlockResponse) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.EBlockCount != uint32(len(m.EBlocks)) {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 	if m.EntryCount != uint32(len(m.Entries)) {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *EntryBlockResponse) ComputeVMIndex(state interfaces.IState) {
@@ -142,24 +141,23 @@ func (e *EntryBlockResponse) Process(dbheight uint32, state interfaces.IState) b
 }
 
 func (e *EntryBlockResponse) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *EntryBlockResponse) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *EntryBlockResponse) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *EntryBlockResponse) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Directory Block State Missing Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Peer2Peer = true // This is always a Peer2peer message
 
@@ -169,7 +167,13 @@ func (m *EntryBlockResponse) UnmarshalBinaryData(data []byte) (newData []byte, e
 		return nil, err
 	}
 
-	m.EBlockCount, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.EBlockCount, newData, err = popUInt32(newData, "EBlockCount")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, m.EBlockCount, MaxDBStateEBlocks, 1, "EBlocks"); err != nil {
+		return nil, err
+	}
 
 	for i := 0; i < int(m.EBlockCount); i++ {
 		eBlock := entryBlock.NewEBlock()
@@ -180,7 +184,13 @@ func (m *EntryBlockResponse) UnmarshalBinaryData(data []byte) (newData []byte, e
 		m.EBlocks = append(m.EBlocks, eBlock)
 	}
 
-	m.EntryCount, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.EntryCount, newData, err = popUInt32(newData, "EntryCount")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, m.EntryCount, MaxDBStateEntries, 1, "Entries"); err != nil {
+		return nil, err
+	}
 
 	for i := 0; i < int(m.EntryCount); i++ {
 		entry := entryBlock.NewEntry()
@@ -235,6 +245,10 @@ func (m *EntryBlockResponse) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *EntryBlockResponse) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *EntryBlockResponse) marshalBinary() ([]byte, error) {
 	return m.MarshalForSignature()
 }
 
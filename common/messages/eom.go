@@ -117,30 +117,30 @@ func (m *EOM) Type() byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *EOM) Validate(state interfaces.IState) int {
+func (m *EOM) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.IsLocal() {
-		return 1
+		return interfaces.Valid()
 	}
 
 	// Ignore old EOM
 	if m.DBHeight <= state.GetHighestSavedBlk() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	found, _ := state.GetVirtualServers(m.DBHeight, int(m.Minute), m.ChainID)
 	if !found { // Only EOM from federated servers are valid.
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	// Check signature
 	eomSigned, err := m.VerifySignature()
 	if err != nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	if !eomSigned {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -158,11 +158,15 @@ func (m *EOM) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *EOM) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *EOM) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *EOM) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *EOM) Sign(key interfaces.Signer) error {
@@ -171,6 +175,7 @@ func (m *EOM) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -183,16 +188,11 @@ func (m *EOM) VerifySignature() (bool, error) {
 }
 
 func (m *EOM) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling EOM message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -206,22 +206,41 @@ func (m *EOM) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 		return nil, err
 	}
 
-	m.Minute, newData = newData[0], newData[1:]
+	m.Minute, newData, err = popByte(newData, "Minute")
+	if err != nil {
+		return nil, err
+	}
 
 	if m.Minute < 0 || m.Minute >= 10 {
 		return nil, fmt.Errorf("Minute number is out of range")
 	}
 
-	m.VMIndex = int(newData[0])
-	newData = newData[1:]
-	m.FactoidVM = uint8(newData[0]) == 1
-	newData = newData[1:]
+	var vmIndex, factoidVM byte
+	vmIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.VMIndex = int(vmIndex)
+	factoidVM, newData, err = popByte(newData, "FactoidVM")
+	if err != nil {
+		return nil, err
+	}
+	m.FactoidVM = factoidVM == 1
 
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.SysHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.SysHeight, newData, err = popUInt32(newData, "SysHeight")
+	if err != nil {
+		return nil, err
+	}
 
 	m.SysHash = primitives.NewHash(constants.ZERO_HASH)
 	newData, err = m.SysHash.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(newData) > 0 {
 		sig := new(primitives.Signature)
@@ -265,7 +284,11 @@ func (m *EOM) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *EOM) MarshalBinary() (data []byte, err error) {
+func (m *EOM) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *EOM) marshalBinary() (data []byte, err error) {
 	var buf primitives.Buffer
 	resp, err := m.MarshalForSignature()
 	if err != nil {
@@ -5,8 +5,6 @@
 package messages
 
 import (
-	"fmt"
-
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -51,6 +49,7 @@ func (m *EOMTimeout) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -94,16 +93,11 @@ func (m *EOMTimeout) Type() byte {
 }
 
 func (m *EOMTimeout) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Eom Timeout: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -143,7 +137,11 @@ func (m *EOMTimeout) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *EOMTimeout) MarshalBinary() (data []byte, err error) {
+func (m *EOMTimeout) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *EOMTimeout) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -184,8 +182,8 @@ func (m *EOMTimeout) SerialHash() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *EOMTimeout) Validate(state interfaces.IState) int {
-	return 0
+func (m *EOMTimeout) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Pending(interfaces.ReasonNotYet)
 }
 
 func (m *EOMTimeout) ComputeVMIndex(state interfaces.IState) {
@@ -199,9 +197,13 @@ func (m *EOMTimeout) FollowerExecute(interfaces.IState) {
 }
 
 func (e *EOMTimeout) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *EOMTimeout) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *EOMTimeout) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
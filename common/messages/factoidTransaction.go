@@ -79,6 +79,7 @@ func (m *FactoidTransaction) GetTransaction() interfaces.ITransaction {
 
 func (m *FactoidTransaction) SetTransaction(transaction interfaces.ITransaction) {
 	m.Transaction = transaction
+	m.ResetMarshalCache()
 }
 
 func (m *FactoidTransaction) Type() byte {
@@ -89,25 +90,25 @@ func (m *FactoidTransaction) Type() byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *FactoidTransaction) Validate(state interfaces.IState) int {
+func (m *FactoidTransaction) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// Is the transaction well formed?
 	err := m.Transaction.Validate(1)
 	if err != nil {
-		return -1 // No, object!
+		return interfaces.Invalid(interfaces.ReasonMalformed) // No, object!
 	}
 
 	// Is the transaction properly signed?
 	err = m.Transaction.ValidateSignatures()
 	if err != nil {
-		return -1 // No, object!
+		return interfaces.Invalid(interfaces.ReasonBadSignature) // No, object!
 	}
 
 	// Is the transaction valid at this point in time?
 	err = state.GetFactoidState().Validate(1, m.Transaction)
 	if err != nil {
-		return 0 // Well, mumble.  Might be out of order.
+		return interfaces.Pending(interfaces.ReasonInsufficientBalance) // Well, mumble.  Might be out of order.
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *FactoidTransaction) ComputeVMIndex(state interfaces.IState) {
@@ -142,12 +143,6 @@ func (m *FactoidTransaction) Process(dbheight uint32, state interfaces.IState) b
 
 func (m *FactoidTransaction) UnmarshalTransData(datax []byte) (newData []byte, err error) {
 	newData = datax
-	defer func() {
-		return
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Transaction Factoid: %v", r)
-		}
-	}()
 
 	m.Transaction = new(factoid.Transaction)
 	newData, err = m.Transaction.UnmarshalBinaryData(newData)
@@ -156,17 +151,11 @@ func (m *FactoidTransaction) UnmarshalTransData(datax []byte) (newData []byte, e
 }
 
 func (m *FactoidTransaction) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	newData = data
-
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Factoid: %v", r)
-		}
-	}()
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Transaction = new(factoid.Transaction)
 	newData, err = m.Transaction.UnmarshalBinaryData(newData)
@@ -178,7 +167,11 @@ func (m *FactoidTransaction) UnmarshalBinary(data []byte) error {
 	return err
 }
 
-func (m *FactoidTransaction) MarshalBinary() (data []byte, err error) {
+func (m *FactoidTransaction) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *FactoidTransaction) marshalBinary() (data []byte, err error) {
 	var buf primitives.Buffer
 	buf.Write([]byte{m.Type()})
 
@@ -199,9 +192,13 @@ func (m *FactoidTransaction) String() string {
 }
 
 func (e *FactoidTransaction) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *FactoidTransaction) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *FactoidTransaction) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
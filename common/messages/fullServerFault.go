@@ -53,6 +53,9 @@ type SigList struct {
 	List   []interfaces.IFullSignature
 }
 
+// Most signatures in one SigList
+const MaxSigListLength = 1000
+
 var _ interfaces.IMsg = (*FullServerFault)(nil)
 var _ Signable = (*FullServerFault)(nil)
 
@@ -318,14 +321,13 @@ func (sl *SigList) MarshalBinary() (data []byte, err error) {
 }
 
 func (sl *SigList) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling SigList in Full Server Fault: %v", r)
-		}
-	}()
-
-	newData = data
-	sl.Length, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	sl.Length, newData, err = popUInt32(data, "SigList")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, sl.Length, MaxSigListLength, constants.SIGNATURE_LENGTH+constants.HASH_LENGTH, "Signatures"); err != nil {
+		return nil, err
+	}
 
 	for i := sl.Length; i > 0; i-- {
 		tempSig := new(primitives.Signature)
@@ -338,7 +340,11 @@ func (sl *SigList) UnmarshalBinaryData(data []byte) (newData []byte, err error)
 	return newData, nil
 }
 
-func (m *FullServerFault) MarshalBinary() (data []byte, err error) {
+func (m *FullServerFault) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *FullServerFault) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -369,21 +375,18 @@ func Unmarshall(thing interfaces.BinaryMarshallable, err error, data []byte) ([]
 //                               UnmarshalBinaryData for FullServerFault
 //
 func (m *FullServerFault) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling With Signatures Invalid Server Fault: %v", r)
-		}
-	}()
-
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
-	m.ClearFault = uint8(newData[0]) == 1
-
-	newData = newData[1:]
+	var clearFault byte
+	clearFault, newData, err = popByte(newData, "ClearFault")
+	if err != nil {
+		return nil, err
+	}
+	m.ClearFault = clearFault == 1
 
 	if m.ServerID == nil {
 		m.ServerID = primitives.NewZeroHash()
@@ -399,10 +402,22 @@ func (m *FullServerFault) UnmarshalBinaryData(data []byte) (newData []byte, err
 		return nil, err
 	}
 
-	m.VMIndex, newData = newData[0], newData[1:]
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.Height, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.SystemHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.VMIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.Height, newData, err = popUInt32(newData, "Height")
+	if err != nil {
+		return nil, err
+	}
+	m.SystemHeight, newData, err = popUInt32(newData, "SystemHeight")
+	if err != nil {
+		return nil, err
+	}
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -451,6 +466,7 @@ func (m *FullServerFault) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -499,47 +515,47 @@ func (m *FullServerFault) GetDBHeight() uint32 {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *FullServerFault) Validate(state interfaces.IState) int {
+func (m *FullServerFault) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// Ignore old faults
 	if m.DBHeight <= state.GetHighestSavedBlk() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	if m.alreadyValidated {
-		return 1
+		return interfaces.Valid()
 	}
 
 	if m.DBHeight < state.GetLLeaderHeight() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	if m.ServerID.IsZero() || m.AuditServerID.IsZero() {
 		state.AddStatus("FULL FAULT Validate Fake Fault.  Ignore")
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
 	// Check main signature
 	bytes, err := m.MarshalForSignature()
 	if err != nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 	//sig := m.Signature.GetSignature()
 
 	//sfSigned, err := state.VerifyAuthoritySignature(bytes, sig, m.DBHeight)
 	sfSigned, err := state.FastVerifyAuthoritySignature(bytes, m.Signature, m.DBHeight)
 	if err != nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	if sfSigned < 1 {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 	_, err = m.MarshalCore()
 	if err != nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
 	m.alreadyValidated = true
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *FullServerFault) SetAlreadyProcessed() {
@@ -600,11 +616,15 @@ func (m *FullServerFault) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *FullServerFault) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *FullServerFault) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *FullServerFault) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (a *FullServerFault) IsSameAs(b *FullServerFault) bool {
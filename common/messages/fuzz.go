@@ -0,0 +1,23 @@
+//go:build gofuzz
+// +build gofuzz
+
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+// Fuzz is the entry point for go-fuzz (github.com/dvyukov/go-fuzz), which builds it with the gofuzz
+// tag:
+//
+//	go-fuzz-build github.com/FactomProject/factomd/common/messages
+//	go-fuzz -bin=messages-fuzz.zip -workdir=common/messages/testdata/fuzz
+//
+// The workdir holds the corpus to start from; a panic is a crash go-fuzz saves there too.  Add any
+// crasher it finds to the corpus once fixed, so TestUnmarshalFuzzCorpus keeps it fixed.
+func Fuzz(data []byte) int {
+	if _, err := UnmarshalMessage(data); err != nil {
+		return 0
+	}
+	return 1
+}
@@ -26,6 +26,14 @@ func UnmarshalMessageData(data []byte) (newdata []byte, msg interfaces.IMsg, err
 	if len(data) == 0 {
 		return nil, nil, fmt.Errorf("No data provided")
 	}
+	data, err = DecompressMessage(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err = ProtobufToBinary(data)
+	if err != nil {
+		return nil, nil, err
+	}
 	messageType := data[0]
 
 	switch messageType {
@@ -39,7 +47,7 @@ func UnmarshalMessageData(data []byte) (newdata []byte, msg interfaces.IMsg, err
 		msg = new(ServerFault)
 	case constants.FULL_SERVER_FAULT_MSG:
 		msg = new(FullServerFault)
-	case constants.COMMIT_CHAIN_MSG:
+	case constants.COMMIT_CHAIN_MSG, constants.BOUND_COMMIT_CHAIN_MSG:
 		msg = new(CommitChainMsg)
 	case constants.COMMIT_ENTRY_MSG:
 		msg = new(CommitEntryMsg)
@@ -81,6 +89,20 @@ func UnmarshalMessageData(data []byte) (newdata []byte, msg interfaces.IMsg, err
 		msg = new(Bounce)
 	case constants.BOUNCEREPLY_MSG:
 		msg = new(BounceReply)
+	case constants.DBLOCK_HEADERS_REQUEST:
+		msg = new(DBlockHeadersRequest)
+	case constants.DBLOCK_HEADERS_RESPONSE:
+		msg = new(DBlockHeadersResponse)
+	case constants.MISSING_MSG_RANGE:
+		msg = new(MissingMsgRange)
+	case constants.MISSING_MSG_BATCH:
+		msg = new(MissingMsgBatch)
+	case constants.BATCH_COMMIT_ENTRY_MSG:
+		msg = new(BatchCommitEntryMsg)
+	case constants.ACK_BATCH_MSG:
+		msg = new(AckBatch)
+	case constants.PROBE_MSG:
+		msg = new(Probe)
 	default:
 		fmt.Sprintf("Transaction Failed to Validate %x", data[0])
 		return data, nil, fmt.Errorf("Unknown message type %d %x", messageType, data[0])
@@ -142,10 +164,36 @@ func MessageName(Type byte) string {
 		return "DBState Missing"
 	case constants.DBSTATE_MSG:
 		return "DBState"
+	case constants.ADDSERVER_MSG:
+		return "Add Server"
+	case constants.CHANGESERVER_KEY_MSG:
+		return "Change Server Key"
+	case constants.REMOVESERVER_MSG:
+		return "Remove Server"
 	case constants.BOUNCE_MSG:
 		return "Bounce Message"
 	case constants.BOUNCEREPLY_MSG:
 		return "Bounce Reply Message"
+	case constants.MISSING_ENTRY_BLOCKS:
+		return "Missing Entry Blocks"
+	case constants.ENTRY_BLOCK_RESPONSE:
+		return "Entry Block Response"
+	case constants.DBLOCK_HEADERS_REQUEST:
+		return "DBlock Headers Request"
+	case constants.DBLOCK_HEADERS_RESPONSE:
+		return "DBlock Headers Response"
+	case constants.MISSING_MSG_RANGE:
+		return "Missing Msg Range"
+	case constants.MISSING_MSG_BATCH:
+		return "Missing Msg Batch"
+	case constants.BATCH_COMMIT_ENTRY_MSG:
+		return "Batch Commit Entry"
+	case constants.ACK_BATCH_MSG:
+		return "Ack Batch"
+	case constants.PROBE_MSG:
+		return "Probe"
+	case constants.BOUND_COMMIT_CHAIN_MSG:
+		return "Bound Commit Chain"
 	default:
 		return "Unknown:" + fmt.Sprintf(" %d", Type)
 	}
@@ -24,6 +24,10 @@ type Heartbeat struct {
 
 	Signature interfaces.IFullSignature
 
+	// The health of the sending node, and its signature; see heartbeatHealth.go
+	Health          *HeartbeatHealth
+	HealthSignature interfaces.IFullSignature
+
 	//Not marshalled
 	hash     interfaces.IHash
 	sigvalid bool
@@ -67,6 +71,18 @@ func (a *Heartbeat) IsSameAs(b *Heartbeat) bool {
 		}
 	}
 
+	if !a.Health.IsSameAs(b.Health) {
+		return false
+	}
+	if a.HealthSignature == nil && b.HealthSignature != nil {
+		return false
+	}
+	if a.HealthSignature != nil {
+		if a.HealthSignature.IsSameAs(b.HealthSignature) == false {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -109,16 +125,11 @@ func (m *Heartbeat) Type() byte {
 }
 
 func (m *Heartbeat) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling HeartBeat: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -126,8 +137,14 @@ func (m *Heartbeat) UnmarshalBinaryData(data []byte) (newData []byte, err error)
 		return nil, err
 	}
 
-	m.SecretNumber, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.SecretNumber, newData, err = popUInt32(newData, "SecretNumber")
+	if err != nil {
+		return nil, err
+	}
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
 
 	hash := new(primitives.Hash)
 
@@ -151,6 +168,20 @@ func (m *Heartbeat) UnmarshalBinaryData(data []byte) (newData []byte, err error)
 			return nil, err
 		}
 		m.Signature = sig
+
+		if len(newData) > 0 {
+			m.Health = new(HeartbeatHealth)
+			newData, err = m.Health.UnmarshalBinaryData(newData)
+			if err != nil {
+				return nil, err
+			}
+			sig := new(primitives.Signature)
+			newData, err = sig.UnmarshalBinaryData(newData)
+			if err != nil {
+				return nil, err
+			}
+			m.HealthSignature = sig
+		}
 	}
 
 	return nil, nil
@@ -192,7 +223,11 @@ func (m *Heartbeat) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *Heartbeat) MarshalBinary() (data []byte, err error) {
+func (m *Heartbeat) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *Heartbeat) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -203,7 +238,20 @@ func (m *Heartbeat) MarshalBinary() (data []byte, err error) {
 		if err != nil {
 			return nil, err
 		}
-		return append(resp, sigBytes...), nil
+		resp = append(resp, sigBytes...)
+
+		// Health is only sent signed
+		if m.Health != nil && m.HealthSignature != nil {
+			health, err := m.Health.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			healthSig, err := m.HealthSignature.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			resp = append(append(resp, health...), healthSig...)
+		}
 	}
 	return resp, nil
 }
@@ -228,21 +276,21 @@ func (m *Heartbeat) SerialHash() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *Heartbeat) Validate(state interfaces.IState) int {
+func (m *Heartbeat) Validate(state interfaces.IState) interfaces.ValidationResult {
 	now := state.GetTimestamp()
 
 	if now.GetTimeSeconds()-m.Timestamp.GetTimeSeconds() > 60 {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	if m.GetSignature() == nil {
 		// the message has no signature (and so is invalid)
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 
 	// Ignore old heartbeats
 	if m.DBHeight <= state.GetHighestSavedBlk() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonExpired)
 	}
 
 	if !m.sigvalid {
@@ -251,12 +299,12 @@ func (m *Heartbeat) Validate(state interfaces.IState) int {
 			// if there is an error during signature verification
 			// or if the signature is invalid
 			// the message is considered invalid
-			return -1
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
 		}
 		m.sigvalid = true
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -278,16 +326,23 @@ func (m *Heartbeat) FollowerExecute(state interfaces.IState) {
 				}
 			}
 			auditServer.SetOnline(true)
+			if health, ok := m.GetNodeHealth(); ok {
+				state.PutNodeHealth(health)
+			}
 		}
 	}
 }
 
 func (e *Heartbeat) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *Heartbeat) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *Heartbeat) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *Heartbeat) Sign(key interfaces.Signer) error {
@@ -296,6 +351,15 @@ func (m *Heartbeat) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.HealthSignature = nil
+	if m.Health != nil {
+		data, err := m.healthForSignature()
+		if err != nil {
+			return err
+		}
+		m.HealthSignature = key.Sign(data)
+	}
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -304,5 +368,12 @@ func (m *Heartbeat) GetSignature() interfaces.IFullSignature {
 }
 
 func (m *Heartbeat) VerifySignature() (bool, error) {
-	return VerifyMessage(m)
+	valid, err := VerifyMessage(m)
+	if err != nil || !valid {
+		return valid, err
+	}
+	if !m.verifyHealth() {
+		return false, fmt.Errorf("Heartbeat health signature is invalid")
+	}
+	return true, nil
 }
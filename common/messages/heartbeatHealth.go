@@ -0,0 +1,123 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// An audit server's heartbeat can carry the health of the node that sent it, so the network
+// reports its own health without a monitor on every node.  The health follows the heartbeat's
+// signature, with a signature of its own by the same key, over the heartbeat's hash and the
+// health.  Nodes that don't know about it verify the heartbeat as before, and ignore the rest.
+//
+// The health is its length as a varint, then its fields.  Fields are only ever added at the end,
+// so a node reads the fields it knows of, and skips any that follow.
+
+// The health of a node, as sent in its heartbeat
+type HeartbeatHealth struct {
+	SavedHeight      uint32 // Highest block saved
+	LastMinuteMillis uint32 // How long the last minute took
+	InMsgQueue       uint32
+	AckQueue         uint32
+	MsgQueue         uint32
+	Holding          uint32
+	FactomdVersion   uint32 // In the form of State.FactomdVersion
+}
+
+// Longest health a heartbeat may carry
+const MaxHeartbeatHealth = 1024
+
+func (h *HeartbeatHealth) IsSameAs(b *HeartbeatHealth) bool {
+	if h == nil || b == nil {
+		return h == b
+	}
+	return *h == *b
+}
+
+func (h *HeartbeatHealth) MarshalBinary() ([]byte, error) {
+	var fields bytes.Buffer
+	binary.Write(&fields, binary.BigEndian, h.SavedHeight)
+	binary.Write(&fields, binary.BigEndian, h.LastMinuteMillis)
+	binary.Write(&fields, binary.BigEndian, h.InMsgQueue)
+	binary.Write(&fields, binary.BigEndian, h.AckQueue)
+	binary.Write(&fields, binary.BigEndian, h.MsgQueue)
+	binary.Write(&fields, binary.BigEndian, h.Holding)
+	binary.Write(&fields, binary.BigEndian, h.FactomdVersion)
+
+	var buf primitives.Buffer
+	primitives.EncodeVarInt(&buf, uint64(fields.Len()))
+	buf.Write(fields.Bytes())
+	return buf.DeepCopyBytes(), nil
+}
+
+func (h *HeartbeatHealth) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	size, newData := primitives.DecodeVarInt(data)
+	if size > MaxHeartbeatHealth {
+		return nil, fmt.Errorf("Heartbeat health too large: %d", size)
+	}
+	fields, newData, err := popLen(newData, int(size), "Health")
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range []*uint32{&h.SavedHeight, &h.LastMinuteMillis, &h.InMsgQueue, &h.AckQueue, &h.MsgQueue, &h.Holding, &h.FactomdVersion} {
+		if *f, fields, err = popUInt32(fields, "Health"); err != nil {
+			return nil, err
+		}
+	}
+	return newData, nil
+}
+
+func (h *HeartbeatHealth) UnmarshalBinary(data []byte) error {
+	_, err := h.UnmarshalBinaryData(data)
+	return err
+}
+
+// healthForSignature returns what the health signature of a heartbeat signs.
+func (m *Heartbeat) healthForSignature() ([]byte, error) {
+	health, err := m.Health.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, m.GetHash().Bytes()...), health...), nil
+}
+
+// verifyHealth is true if the heartbeat carries no health, or health signed by the key that
+// signed the heartbeat.
+func (m *Heartbeat) verifyHealth() bool {
+	if m.Health == nil {
+		return true
+	}
+	if m.HealthSignature == nil || m.Signature == nil || !bytes.Equal(m.HealthSignature.GetKey(), m.Signature.GetKey()) {
+		return false
+	}
+	data, err := m.healthForSignature()
+	return err == nil && m.HealthSignature.Verify(data)
+}
+
+// GetNodeHealth returns the health the heartbeat carries, with who sent it and when; false if it
+// carries none.
+func (m *Heartbeat) GetNodeHealth() (interfaces.NodeHealth, bool) {
+	if m.Health == nil {
+		return interfaces.NodeHealth{}, false
+	}
+	return interfaces.NodeHealth{
+		IdentityChainID:  m.IdentityChainID.String(),
+		DBHeight:         m.DBHeight,
+		SavedHeight:      m.Health.SavedHeight,
+		LastMinuteMillis: m.Health.LastMinuteMillis,
+		InMsgQueue:       m.Health.InMsgQueue,
+		AckQueue:         m.Health.AckQueue,
+		MsgQueue:         m.Health.MsgQueue,
+		Holding:          m.Health.Holding,
+		FactomdVersion:   int(m.Health.FactomdVersion),
+		Timestamp:        m.Timestamp.GetTimeMilli(),
+	}, true
+}
@@ -108,6 +108,45 @@ func TestSignAndVerifyHeartbeat(t *testing.T) {
 	}
 }
 
+func TestHeartbeatHealth(t *testing.T) {
+	msg := newSignedHeartbeatWithHealth()
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg2, err := UnmarshalMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hb := msg2.(*Heartbeat)
+	if !hb.IsSameAs(msg) || hb.Health == nil || hb.Health.Holding != 4 {
+		t.Errorf("The health did not unmarshal")
+	}
+	if valid, err := hb.VerifySignature(); !valid || err != nil {
+		t.Errorf("The heartbeat with health didn't verify: %v", err)
+	}
+
+	// Nodes that don't know about health read the heartbeat before it
+	old, err := newSignedHeartbeat().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) >= len(data) {
+		t.Errorf("Expected the health to follow the heartbeat")
+	}
+
+	// Health changed on the way is caught
+	data[len(data)-100]++
+	msg3, err := UnmarshalMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid, _ := msg3.(*Heartbeat).VerifySignature(); valid {
+		t.Errorf("Changed health verified")
+	}
+}
+
 func newHeartbeat() *Heartbeat {
 	eom := new(Heartbeat)
 	eom.Timestamp = primitives.NewTimestampNow()
@@ -139,3 +178,19 @@ func newSignedHeartbeat() *Heartbeat {
 
 	return ack
 }
+
+func newSignedHeartbeatWithHealth() *Heartbeat {
+	hb := newHeartbeat()
+	hb.Health = &HeartbeatHealth{SavedHeight: 1, LastMinuteMillis: 60000, InMsgQueue: 2, AckQueue: 3, Holding: 4, FactomdVersion: 5000000}
+
+	key, err := primitives.NewPrivateKeyFromHex("07c0d52cb74f4ca3106d80c4a70488426886bccc6ebc10c6bafb37bf8a65f4c38cee85c62a9e48039d4ac294da97943c2001be1539809ea5f54721f0c5477a0a")
+	if err != nil {
+		panic(err)
+	}
+	err = hb.Sign(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return hb
+}
@@ -54,6 +54,7 @@ func (m *InvalidDirectoryBlock) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -102,16 +103,11 @@ func (m *InvalidDirectoryBlock) Type() byte {
 }
 
 func (m *InvalidDirectoryBlock) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -137,7 +133,11 @@ func (m *InvalidDirectoryBlock) UnmarshalBinary(data []byte) error {
 	return err
 }
 
-func (m *InvalidDirectoryBlock) MarshalBinary() (data []byte, err error) {
+func (m *InvalidDirectoryBlock) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *InvalidDirectoryBlock) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -192,8 +192,8 @@ func (m *InvalidDirectoryBlock) SerialHash() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *InvalidDirectoryBlock) Validate(state interfaces.IState) int {
-	return 0
+func (m *InvalidDirectoryBlock) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Pending(interfaces.ReasonNotYet)
 }
 
 // Returns true if this is a message for this server to execute as
@@ -209,9 +209,13 @@ func (m *InvalidDirectoryBlock) FollowerExecute(interfaces.IState) {
 }
 
 func (e *InvalidDirectoryBlock) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *InvalidDirectoryBlock) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *InvalidDirectoryBlock) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
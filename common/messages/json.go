@@ -0,0 +1,123 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The fields of MessageBase that messages of each type marshal with their own
+var wireBaseFields = map[byte][]string{
+	constants.ACK_MSG:                       {"LeaderChainID", "VMIndex", "Minute"},
+	constants.ACK_BATCH_MSG:                 {"LeaderChainID"},
+	constants.EOM_MSG:                       {"VMIndex"},
+	constants.DIRECTORY_BLOCK_SIGNATURE_MSG: {"VMIndex"},
+}
+
+// Every message has the same JSON wherever it is shown (the journal, the APIs, the control
+// panel), on every node.  It is an object with, in this order:
+//
+//	"type"     the name of the message type, as MessageName gives it
+//	"typeid"   the message type, as a number
+//	"msghash"  the hash of the message, in hex; null if the message is incomplete
+//	"message"  the fields of the message, sorted by name
+//
+// In the fields, hashes, keys, signatures and bytes are lowercase hex, and timestamps are
+// milliseconds since the epoch.  A message held in a field has the same JSON.  Of the fields of
+// MessageBase, only those the message marshals (see wireBaseFields) are there, among its own;
+// the rest are a node's own bookkeeping, not part of the message.
+
+// EncodeMsgJSON returns the JSON of a message.
+func EncodeMsgJSON(m interfaces.IMsg) ([]byte, error) {
+	// Some messages fill in their counts as they marshal, so hash first
+	hash := m.GetMsgHash()
+	fields, err := msgFieldsJSON(m)
+	if err != nil {
+		return nil, err
+	}
+	name, err := json.Marshal(MessageName(m.Type()))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"type":`)
+	buf.Write(name)
+	fmt.Fprintf(&buf, `,"typeid":%d,"msghash":`, m.Type())
+	if hash != nil {
+		fmt.Fprintf(&buf, `"%x"`, hash.Bytes())
+	} else {
+		buf.WriteString("null")
+	}
+	buf.WriteString(`,"message":`)
+	buf.Write(fields)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// EncodeMsgJSONString returns the JSON of a message as a string.
+func EncodeMsgJSONString(m interfaces.IMsg) (string, error) {
+	data, err := EncodeMsgJSON(m)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// msgFieldsJSON returns the exported fields of a message, and those of the MessageBase it embeds
+// that it marshals, as a JSON object.  Maps are marshalled with their keys sorted, which sorts the
+// fields by name.
+func msgFieldsJSON(m interfaces.IMsg) ([]byte, error) {
+	v := reflect.Indirect(reflect.ValueOf(m))
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", v.Type())
+	}
+
+	fields := map[string]json.RawMessage{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Anonymous {
+			continue
+		}
+		data, err := fieldJSON(v.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", t.Name(), f.Name, err)
+		}
+		fields[f.Name] = data
+	}
+
+	if base := v.FieldByName("MessageBase"); base.IsValid() {
+		for _, name := range wireBaseFields[m.Type()] {
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			data, err := fieldJSON(base.FieldByName(name))
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", t.Name(), name, err)
+			}
+			fields[name] = data
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// fieldJSON marshals a field of a message; bytes are hex rather than base64, or arrays of numbers.
+func fieldJSON(v reflect.Value) (json.RawMessage, error) {
+	k := v.Kind()
+	if (k == reflect.Slice || k == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return json.Marshal(hex.EncodeToString(b))
+	}
+	return json.Marshal(v.Interface())
+}
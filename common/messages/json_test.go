@@ -0,0 +1,108 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/common/messages"
+)
+
+func TestEncodeMsgJSON(t *testing.T) {
+	samples := fuzzSamples(t)
+	names := []string{}
+	for name := range samples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		msg := samples[name]
+		msg.SetOrigin(3)
+		msg.SetNetworkOrigin("peer")
+		js, err := EncodeMsgJSON(msg)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !json.Valid(js) {
+			t.Errorf("%s: invalid JSON %s", name, js)
+			continue
+		}
+		if !bytes.HasPrefix(js, []byte(`{"type":"`+MessageName(msg.Type())+`","typeid":`)) {
+			t.Errorf("%s: JSON doesn't start with the type: %s", name, js)
+		}
+		if strings.Contains(string(js), "NetworkOrigin") {
+			t.Errorf("%s: JSON has the fields of MessageBase: %s", name, js)
+		}
+
+		// The same through json.Marshal, as in the journal and the APIs
+		marshalled, err := json.Marshal(msg)
+		if err != nil || !bytes.Equal(marshalled, js) {
+			t.Errorf("%s: json.Marshal gives %s, expected %s (%v)", name, marshalled, js, err)
+		}
+
+		// The same on every node that got the message from the network
+		received := receive(t, name, msg)
+		js1, err1 := EncodeMsgJSON(received)
+		js2, err2 := EncodeMsgJSON(receive(t, name, received))
+		if err1 != nil || err2 != nil || !bytes.Equal(js1, js2) {
+			t.Errorf("%s: JSON of the received message differs\n%s\n%s (%v, %v)", name, js1, js2, err1, err2)
+		}
+	}
+}
+
+// receive returns msg as a node that got it from the network has it
+func receive(t *testing.T, name string, msg interfaces.IMsg) interfaces.IMsg {
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	received := newMsgLike(msg)
+	if err := received.UnmarshalBinary(data); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return received
+}
+
+func TestEncodeMsgJSONHex(t *testing.T) {
+	ack := newSignedAck()
+	ack.Salt = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	js, err := ack.JSONString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(js, `"Salt":"0102030405060708"`) {
+		t.Errorf("Bytes are not hex: %s", js)
+	}
+	if !strings.Contains(js, `"msghash":"`+ack.GetMsgHash().String()+`"`) {
+		t.Errorf("Message hash missing: %s", js)
+	}
+}
+
+func TestEncodeMsgJSONBaseFields(t *testing.T) {
+	ack := newSignedAck()
+	ack.VMIndex = 4
+	ack.Minute = 7
+	js, err := ack.JSONString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{`"LeaderChainID":"` + ack.LeaderChainID.String() + `"`, `"VMIndex":4`, `"Minute":7`} {
+		if !strings.Contains(js, field) {
+			t.Errorf("Expected %s in %s", field, js)
+		}
+	}
+	for _, field := range []string{"Origin", "Peer2Peer", "LocalOnly", "MsgHash\""} {
+		if strings.Contains(js, `"`+field) {
+			t.Errorf("Didn't expect %s in %s", field, js)
+		}
+	}
+}
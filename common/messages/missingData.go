@@ -76,16 +76,11 @@ func (m *MissingData) Type() byte {
 }
 
 func (m *MissingData) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -110,6 +105,10 @@ func (m *MissingData) UnmarshalBinary(data []byte) error {
 }
 
 func (m *MissingData) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingData) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 	buf.Write([]byte{m.Type()})
 	if d, err := m.Timestamp.MarshalBinary(); err != nil {
@@ -135,8 +134,8 @@ func (m *MissingData) String() string {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *MissingData) Validate(state interfaces.IState) int {
-	return 1
+func (m *MissingData) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Valid()
 }
 
 func (m *MissingData) ComputeVMIndex(state interfaces.IState) {
@@ -173,11 +172,15 @@ func (m *MissingData) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *MissingData) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *MissingData) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *MissingData) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func NewMissingData(state interfaces.IState, requestHash interfaces.IHash) interfaces.IMsg {
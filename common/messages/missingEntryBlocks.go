@@ -75,11 +75,11 @@ func (m *MissingEntryBlocks) GetTimestamp() interfaces.Timestamp {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *MissingEntryBlocks) Validate(state interfaces.IState) int {
+func (m *MissingEntryBlocks) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.DBHeightStart > m.DBHeightEnd {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *MissingEntryBlocks) ComputeVMIndex(state interfaces.IState) {
@@ -145,24 +145,23 @@ func (e *MissingEntryBlocks) Process(dbheight uint32, state interfaces.IState) b
 }
 
 func (e *MissingEntryBlocks) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *MissingEntryBlocks) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *MissingEntryBlocks) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *MissingEntryBlocks) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Directory Block State Missing Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Peer2Peer = true // This is always a Peer2peer message
 
@@ -172,8 +171,14 @@ func (m *MissingEntryBlocks) UnmarshalBinaryData(data []byte) (newData []byte, e
 		return nil, err
 	}
 
-	m.DBHeightStart, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.DBHeightEnd, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.DBHeightStart, newData, err = popUInt32(newData, "DBHeightStart")
+	if err != nil {
+		return nil, err
+	}
+	m.DBHeightEnd, newData, err = popUInt32(newData, "DBHeightEnd")
+	if err != nil {
+		return nil, err
+	}
 
 	return
 }
@@ -202,6 +207,10 @@ func (m *MissingEntryBlocks) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *MissingEntryBlocks) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingEntryBlocks) marshalBinary() ([]byte, error) {
 	return m.MarshalForSignature()
 }
 
@@ -31,6 +31,9 @@ type MissingMsg struct {
 
 var _ interfaces.IMsg = (*MissingMsg)(nil)
 
+// Most heights asked for in one MissingMsg
+const MaxMissingMsgHeights = 10000
+
 func (a *MissingMsg) IsSameAs(b *MissingMsg) bool {
 	if b == nil {
 		return false
@@ -98,16 +101,11 @@ func (m *MissingMsg) Type() byte {
 }
 
 func (m *MissingMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("%s", "Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -121,15 +119,33 @@ func (m *MissingMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error
 		return nil, err
 	}
 
-	m.VMIndex, newData = int(newData[0]), newData[1:]
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.SystemHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var vmIndex byte
+	vmIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.VMIndex = int(vmIndex)
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.SystemHeight, newData, err = popUInt32(newData, "SystemHeight")
+	if err != nil {
+		return nil, err
+	}
 
 	// Get all the missing messages...
-	lenl, newData := binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	var lenl uint32
+	lenl, newData, err = popUInt32(newData, "ProcessListHeight")
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCount(newData, lenl, MaxMissingMsgHeights, 4, "ProcessListHeight"); err != nil {
+		return nil, err
+	}
 	for i := 0; i < int(lenl); i++ {
 		var height uint32
-		height, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+		height, newData, _ = popUInt32(newData, "ProcessListHeight")
 		m.ProcessListHeight = append(m.ProcessListHeight, height)
 	}
 
@@ -144,6 +160,10 @@ func (m *MissingMsg) UnmarshalBinary(data []byte) error {
 }
 
 func (m *MissingMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingMsg) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	binary.Write(&buf, binary.BigEndian, m.Type())
@@ -204,14 +224,14 @@ func (m *MissingMsg) ListHeight() int {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *MissingMsg) Validate(state interfaces.IState) int {
+func (m *MissingMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.Asking == nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 	if m.Asking.IsZero() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *MissingMsg) ComputeVMIndex(state interfaces.IState) {
@@ -226,16 +246,24 @@ func (m *MissingMsg) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *MissingMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *MissingMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
 }
 
-// AddHeight: Add a Missing Message Height to the request
+func (e *MissingMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+// AddHeight: Add a Missing Message Height to the request, up to MaxMissingMsgHeights of them
 func (e *MissingMsg) AddHeight(h uint32) {
+	if len(e.ProcessListHeight) >= MaxMissingMsgHeights {
+		return
+	}
 	e.ProcessListHeight = append(e.ProcessListHeight, h)
+	e.ResetMarshalCache()
 }
 
 // NewMissingMsg: Build a missing Message request, and add the first Height
@@ -0,0 +1,241 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Most messages sent back in one MissingMsgBatch
+const MaxMissingMsgBatch = 100
+
+//Answers a MissingMsgRange with a batch of missing message responses, each a message and its ack
+//(or a system message with no ack).
+
+type MissingMsgBatch struct {
+	MessageBase
+
+	Timestamp interfaces.Timestamp
+	Responses []*MissingMsgResponse
+
+	//No signature!
+
+	//Not marshalled
+	hash interfaces.IHash
+}
+
+var _ interfaces.IMsg = (*MissingMsgBatch)(nil)
+
+func (a *MissingMsgBatch) IsSameAs(b *MissingMsgBatch) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if len(a.Responses) != len(b.Responses) {
+		return false
+	}
+	for i := range a.Responses {
+		if !a.Responses[i].GetMsgHash().IsSameAs(b.Responses[i].GetMsgHash()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *MissingMsgBatch) Process(uint32, interfaces.IState) bool {
+	return true
+}
+
+func (m *MissingMsgBatch) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *MissingMsgBatch) GetHash() interfaces.IHash {
+	if m.hash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			panic(fmt.Sprintf("Error in MissingMsgBatch.GetHash(): %s", err.Error()))
+		}
+		m.hash = primitives.Sha(data)
+	}
+	return m.hash
+}
+
+func (m *MissingMsgBatch) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *MissingMsgBatch) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+func (m *MissingMsgBatch) Type() byte {
+	return constants.MISSING_MSG_BATCH
+}
+
+func (m *MissingMsgBatch) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	var count uint32
+	count, newData, err = popUInt32(newData, "Responses")
+	if err != nil {
+		return nil, err
+	}
+
+	// Each response is prefixed with its length
+	if err = checkCount(newData, count, MaxMissingMsgBatch, 4, "Responses"); err != nil {
+		return nil, err
+	}
+	m.Responses = nil
+	for i := 0; i < int(count); i++ {
+		var l uint32
+		l, newData, err = popUInt32(newData, "Response length")
+		if err != nil {
+			return nil, err
+		}
+		var respData []byte
+		respData, newData, err = popLen(newData, int(l), "Response")
+		if err != nil {
+			return nil, err
+		}
+		resp := new(MissingMsgResponse)
+		err = resp.UnmarshalBinary(respData)
+		if err != nil {
+			return nil, err
+		}
+		m.Responses = append(m.Responses, resp)
+	}
+
+	m.Peer2Peer = true // Always a peer2peer response.
+
+	return newData, nil
+}
+
+func (m *MissingMsgBatch) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *MissingMsgBatch) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingMsgBatch) marshalBinary() ([]byte, error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	t := m.GetTimestamp()
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	binary.Write(&buf, binary.BigEndian, uint32(len(m.Responses)))
+	for _, resp := range m.Responses {
+		data, err = resp.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+		buf.Write(data)
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *MissingMsgBatch) String() string {
+	return fmt.Sprintf("MissingMsgBatch <-- %d responses msgHash[%x]", len(m.Responses), m.GetMsgHash().Bytes()[:3])
+}
+
+func (m *MissingMsgBatch) ChainID() []byte {
+	return nil
+}
+
+func (m *MissingMsgBatch) ListHeight() int {
+	return 0
+}
+
+// Validate the message, given the state.  Three possible results:
+//  < 0 -- Message is invalid.  Discard
+//  0   -- Cannot tell if message is Valid
+//  1   -- Message is valid
+//
+// The responses in the batch are checked one by one as they are applied.
+func (m *MissingMsgBatch) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if len(m.Responses) == 0 || len(m.Responses) > MaxMissingMsgBatch {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	for _, resp := range m.Responses {
+		if resp == nil || resp.MsgResponse == nil {
+			return interfaces.Invalid(interfaces.ReasonMalformed)
+		}
+	}
+	return interfaces.Valid()
+}
+
+func (m *MissingMsgBatch) ComputeVMIndex(state interfaces.IState) {
+}
+
+func (m *MissingMsgBatch) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+func (m *MissingMsgBatch) FollowerExecute(state interfaces.IState) {
+	state.FollowerExecuteMMR(m)
+}
+
+func (e *MissingMsgBatch) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *MissingMsgBatch) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *MissingMsgBatch) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+// AddResponse: Add a message and its ack (nil for a system message) to the batch
+func (e *MissingMsgBatch) AddResponse(state interfaces.IState, msgResponse interfaces.IMsg, ackResponse interfaces.IMsg) {
+	e.Responses = append(e.Responses, NewMissingMsgResponse(state, msgResponse, ackResponse).(*MissingMsgResponse))
+	e.ResetMarshalCache()
+}
+
+func NewMissingMsgBatch(state interfaces.IState) *MissingMsgBatch {
+	msg := new(MissingMsgBatch)
+
+	msg.Peer2Peer = true // Always a peer2peer response.
+	msg.Timestamp = state.GetTimestamp()
+
+	return msg
+}
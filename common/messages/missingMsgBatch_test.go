@@ -0,0 +1,91 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilMissingMsgBatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(MissingMsgBatch)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalMissingMsgBatch(t *testing.T) {
+	msg := newMissingMsgBatch()
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Error(err)
+	}
+	str := msg2.String()
+	t.Logf("str - %v", str)
+
+	if msg2.Type() != constants.MISSING_MSG_BATCH {
+		t.Error("Invalid message type unmarshalled")
+	}
+
+	batch := msg2.(*MissingMsgBatch)
+	if msg.IsSameAs(batch) != true {
+		t.Errorf("MissingMsgBatch messages are not identical")
+	}
+	if batch.Responses[1].AckResponse != nil {
+		t.Errorf("A response without an ack came back with one")
+	}
+}
+
+func TestValidateMissingMsgBatch(t *testing.T) {
+	msg := newMissingMsgBatch()
+	if msg.Validate(nil).Code != 1 {
+		t.Error("Valid batch failed to validate")
+	}
+	msg.Responses = nil
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Empty batch validated")
+	}
+}
+
+func newMissingMsgBatch() *MissingMsgBatch {
+	msg := new(MissingMsgBatch)
+	msg.Timestamp = primitives.NewTimestampNow()
+
+	resp := new(MissingMsgResponse)
+	resp.Timestamp = primitives.NewTimestampNow()
+	resp.AckResponse = newSignedAck()
+	resp.MsgResponse = newSignedEOM()
+	msg.Responses = append(msg.Responses, resp)
+
+	// A system message goes without an ack
+	resp = new(MissingMsgResponse)
+	resp.Timestamp = primitives.NewTimestampNow()
+	resp.MsgResponse = newSignedHeartbeat()
+	msg.Responses = append(msg.Responses, resp)
+
+	return msg
+}
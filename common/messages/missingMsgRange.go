@@ -0,0 +1,257 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Most process list heights asked for in one MissingMsgRange
+const MaxMissingMsgRange = 500
+
+//Requests all the messages and acks of a VM's process list from Start to End, inclusive.
+//Answered with MissingMsgBatch messages, rather than one MissingMsgResponse per height, so
+//a node that falls a few minutes behind gets caught up in a few round trips.
+
+type MissingMsgRange struct {
+	MessageBase
+
+	Timestamp    interfaces.Timestamp
+	Asking       interfaces.IHash
+	DBHeight     uint32
+	SystemHeight uint32 // Might as well check for a missing Server Fault
+	Start        uint32 // First process list height wanted
+	End          uint32 // Last process list height wanted
+
+	//No signature!
+
+	//Not marshalled
+	hash interfaces.IHash
+}
+
+var _ interfaces.IMsg = (*MissingMsgRange)(nil)
+
+func (a *MissingMsgRange) IsSameAs(b *MissingMsgRange) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if a.DBHeight != b.DBHeight {
+		return false
+	}
+	if a.SystemHeight != b.SystemHeight {
+		return false
+	}
+	if a.VMIndex != b.VMIndex {
+		return false
+	}
+	if a.Start != b.Start || a.End != b.End {
+		return false
+	}
+
+	return true
+}
+
+func (m *MissingMsgRange) Process(uint32, interfaces.IState) bool {
+	panic("MissingMsgRange should not have its Process() method called")
+}
+
+func (m *MissingMsgRange) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *MissingMsgRange) GetHash() interfaces.IHash {
+	if m.hash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			panic(fmt.Sprintf("Error in MissingMsgRange.GetHash(): %s", err.Error()))
+		}
+		m.hash = primitives.Sha(data)
+	}
+	return m.hash
+}
+
+func (m *MissingMsgRange) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *MissingMsgRange) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+func (m *MissingMsgRange) Type() byte {
+	return constants.MISSING_MSG_RANGE
+}
+
+func (m *MissingMsgRange) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Asking = new(primitives.Hash)
+	newData, err = m.Asking.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmIndex byte
+	vmIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.VMIndex = int(vmIndex)
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.SystemHeight, newData, err = popUInt32(newData, "SystemHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.Start, newData, err = popUInt32(newData, "Start")
+	if err != nil {
+		return nil, err
+	}
+	m.End, newData, err = popUInt32(newData, "End")
+	if err != nil {
+		return nil, err
+	}
+
+	m.Peer2Peer = true // Always a peer2peer request.
+
+	return newData, nil
+}
+
+func (m *MissingMsgRange) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *MissingMsgRange) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingMsgRange) marshalBinary() ([]byte, error) {
+	var buf primitives.Buffer
+
+	binary.Write(&buf, binary.BigEndian, m.Type())
+
+	t := m.GetTimestamp()
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	if m.Asking == nil {
+		m.Asking = primitives.NewHash(constants.ZERO_HASH)
+	}
+	data, err = m.Asking.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data)
+
+	buf.WriteByte(uint8(m.VMIndex))
+	binary.Write(&buf, binary.BigEndian, m.DBHeight)
+	binary.Write(&buf, binary.BigEndian, m.SystemHeight)
+	binary.Write(&buf, binary.BigEndian, m.Start)
+	binary.Write(&buf, binary.BigEndian, m.End)
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *MissingMsgRange) String() string {
+	return fmt.Sprintf("MissingMsgRange --> Asking %x DBHeight:%3d vm=%3d Hts::[%d-%d] Sys: %d msgHash[%x]",
+		m.Asking.Bytes()[:8],
+		m.DBHeight,
+		m.VMIndex,
+		m.Start,
+		m.End,
+		m.SystemHeight,
+		m.GetMsgHash().Bytes()[:3])
+}
+
+func (m *MissingMsgRange) ChainID() []byte {
+	return nil
+}
+
+func (m *MissingMsgRange) ListHeight() int {
+	return 0
+}
+
+// Validate the message, given the state.  Three possible results:
+//  < 0 -- Message is invalid.  Discard
+//  0   -- Cannot tell if message is Valid
+//  1   -- Message is valid
+func (m *MissingMsgRange) Validate(state interfaces.IState) interfaces.ValidationResult {
+	if m.Asking == nil || m.Asking.IsZero() {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	if m.End < m.Start || m.End-m.Start >= MaxMissingMsgRange {
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+	return interfaces.Valid()
+}
+
+func (m *MissingMsgRange) ComputeVMIndex(state interfaces.IState) {
+}
+
+func (m *MissingMsgRange) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+func (m *MissingMsgRange) FollowerExecute(state interfaces.IState) {
+	state.FollowerExecuteMissingMsg(m)
+}
+
+func (e *MissingMsgRange) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *MissingMsgRange) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *MissingMsgRange) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+// NewMissingMsgRange: Build a request for the process list heights start to end of a VM
+func NewMissingMsgRange(state interfaces.IState, vm int, dbHeight uint32, start uint32, end uint32) *MissingMsgRange {
+	msg := new(MissingMsgRange)
+
+	msg.Asking = state.GetIdentityChainID()
+	msg.Peer2Peer = true // Always a peer2peer request.
+	msg.VMIndex = vm
+	msg.Timestamp = state.GetTimestamp()
+	msg.DBHeight = dbHeight
+	msg.Start = start
+	msg.End = end
+	msg.SystemHeight = uint32(state.GetSystemHeight(dbHeight))
+	return msg
+}
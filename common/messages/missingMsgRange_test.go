@@ -0,0 +1,85 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilMissingMsgRange(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(MissingMsgRange)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalMissingMsgRange(t *testing.T) {
+	msg := newMissingMsgRange()
+
+	hex, err := msg.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("Marshalled - %x", hex)
+
+	msg2, err := UnmarshalMessage(hex)
+	if err != nil {
+		t.Error(err)
+	}
+	str := msg2.String()
+	t.Logf("str - %v", str)
+
+	if msg2.Type() != constants.MISSING_MSG_RANGE {
+		t.Error("Invalid message type unmarshalled")
+	}
+
+	if msg.IsSameAs(msg2.(*MissingMsgRange)) != true {
+		t.Errorf("MissingMsgRange messages are not identical")
+	}
+}
+
+func TestValidateMissingMsgRange(t *testing.T) {
+	msg := newMissingMsgRange()
+	if msg.Validate(nil).Code != 1 {
+		t.Error("Valid request failed to validate")
+	}
+	msg.End = msg.Start - 1
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Request ending before it starts validated")
+	}
+	msg.End = msg.Start + MaxMissingMsgRange
+	if msg.Validate(nil).Code != -1 {
+		t.Error("Request for too many heights validated")
+	}
+}
+
+func newMissingMsgRange() *MissingMsgRange {
+	msg := new(MissingMsgRange)
+	msg.Timestamp = primitives.NewTimestampNow()
+	msg.Asking = primitives.Sha([]byte("asking"))
+	msg.VMIndex = 3
+	msg.DBHeight = 0x01234567
+	msg.SystemHeight = 2
+	msg.Start = 10
+	msg.End = 40
+
+	return msg
+}
@@ -89,16 +89,11 @@ func (m *MissingMsgResponse) Type() byte {
 }
 
 func (m *MissingMsgResponse) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("%s", "Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -106,7 +101,11 @@ func (m *MissingMsgResponse) UnmarshalBinaryData(data []byte) (newData []byte, e
 		return nil, err
 	}
 
-	b, newData := newData[0], newData[1:]
+	var b byte
+	b, newData, err = popByte(newData, "AckResponse")
+	if err != nil {
+		return nil, err
+	}
 
 	if b == 1 {
 		m.AckResponse = new(Ack)
@@ -117,6 +116,16 @@ func (m *MissingMsgResponse) UnmarshalBinaryData(data []byte) (newData []byte, e
 		}
 	}
 
+	// A response holds the message asked for, never another response, so a message can't nest
+	// responses to make us recurse
+	if err = checkLen(newData, 1, "MsgResponse"); err != nil {
+		return nil, err
+	}
+	switch newData[0] &^ CompressedFlag {
+	case constants.MISSING_MSG_RESPONSE, constants.MISSING_MSG_BATCH:
+		return nil, fmt.Errorf("A missing message response can't hold another response")
+	}
+
 	mr, err := UnmarshalMessage(newData)
 
 	if err != nil {
@@ -135,6 +144,10 @@ func (m *MissingMsgResponse) UnmarshalBinary(data []byte) error {
 }
 
 func (m *MissingMsgResponse) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *MissingMsgResponse) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	binary.Write(&buf, binary.BigEndian, m.Type())
@@ -197,14 +210,14 @@ func (m *MissingMsgResponse) ListHeight() int {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *MissingMsgResponse) Validate(state interfaces.IState) int {
+func (m *MissingMsgResponse) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.AckResponse == nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 	if m.MsgResponse == nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *MissingMsgResponse) ComputeVMIndex(state interfaces.IState) {
@@ -221,11 +234,15 @@ func (m *MissingMsgResponse) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *MissingMsgResponse) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *MissingMsgResponse) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *MissingMsgResponse) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func NewMissingMsgResponse(state interfaces.IState, msgResponse interfaces.IMsg, ackResponse interfaces.IMsg) interfaces.IMsg {
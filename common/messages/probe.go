@@ -0,0 +1,398 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A Probe measures how long a message takes to gossip from one authority to another, and back.
+// The origin signs a probe to a target, and sends it out.  Every node that passes the probe on
+// stamps it with the time it saw it, by its own clock, and the target answers with an echo it
+// signs, carrying the stamps the probe collected.  The echo is stamped on its way back to the
+// origin the same way (see state/probe.go).
+//
+// The stamps follow the signature, and are not part of the hash, so a probe is the same message
+// to the replay filters however many nodes stamped it, and each node passes it on only once.
+
+type Probe struct {
+	MessageBase
+	Timestamp interfaces.Timestamp // When the probe, or the echo, was sent
+	Origin    interfaces.IHash     // Identity chain of the authority that sent the probe
+	Target    interfaces.IHash     // Identity chain of the authority to echo it
+	Number    uint32               // Picked by the origin, to match the echo to the probe
+	Echo      bool                 // True for the target's echo of the probe
+	Outbound  []interfaces.Timestamp
+	Signature interfaces.IFullSignature
+
+	// Not signed
+	Stamps []interfaces.Timestamp
+
+	//Not marshalled
+	sigvalid bool
+}
+
+var _ interfaces.IMsg = (*Probe)(nil)
+var _ Signable = (*Probe)(nil)
+
+// Most time stamps a Probe collects; nodes past the last don't stamp it
+const MaxProbeStamps = 64
+
+// AddStamp notes that we saw the message at ts, unless it has all the stamps it can hold.
+func (m *Probe) AddStamp(ts interfaces.Timestamp) {
+	if len(m.Stamps) >= MaxProbeStamps {
+		return
+	}
+	m.Stamps = append(m.Stamps, ts)
+	m.ResetMarshalCache()
+}
+
+// NewEcho returns the target's echo of the probe, to be sent at ts.
+func (m *Probe) NewEcho(ts interfaces.Timestamp) *Probe {
+	echo := new(Probe)
+	echo.Timestamp = ts
+	echo.Origin = m.Origin
+	echo.Target = m.Target
+	echo.Number = m.Number
+	echo.Echo = true
+	echo.Outbound = append([]interfaces.Timestamp{}, m.Stamps...)
+	return echo
+}
+
+func (a *Probe) IsSameAs(b *Probe) bool {
+	if b == nil {
+		return false
+	}
+	if a.Timestamp.GetTimeMilli() != b.Timestamp.GetTimeMilli() {
+		return false
+	}
+	if !a.Origin.IsSameAs(b.Origin) || !a.Target.IsSameAs(b.Target) {
+		return false
+	}
+	if a.Number != b.Number || a.Echo != b.Echo {
+		return false
+	}
+	if !sameStamps(a.Outbound, b.Outbound) || !sameStamps(a.Stamps, b.Stamps) {
+		return false
+	}
+
+	if a.Signature == nil && b.Signature != nil {
+		return false
+	}
+	if a.Signature != nil {
+		if a.Signature.IsSameAs(b.Signature) == false {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameStamps(a []interfaces.Timestamp, b []interfaces.Timestamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].GetTimeMilli() != b[i].GetTimeMilli() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Probe) Process(uint32, interfaces.IState) bool {
+	return true
+}
+
+func (m *Probe) GetRepeatHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+func (m *Probe) GetHash() interfaces.IHash {
+	return m.GetMsgHash()
+}
+
+// The hash leaves out the stamps, which change on the way
+func (m *Probe) GetMsgHash() interfaces.IHash {
+	if m.MsgHash == nil {
+		data, err := m.MarshalForSignature()
+		if err != nil {
+			return nil
+		}
+		m.MsgHash = primitives.Sha(data)
+	}
+	return m.MsgHash
+}
+
+func (m *Probe) GetTimestamp() interfaces.Timestamp {
+	return m.Timestamp
+}
+
+func (m *Probe) Type() byte {
+	return constants.PROBE_MSG
+}
+
+func (m *Probe) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
+	}
+	newData = data[1:]
+
+	m.Timestamp = new(primitives.Timestamp)
+	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Origin = new(primitives.Hash)
+	newData, err = m.Origin.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Target = new(primitives.Hash)
+	newData, err = m.Target.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Number, newData, err = popUInt32(newData, "Number")
+	if err != nil {
+		return nil, err
+	}
+
+	var echo byte
+	echo, newData, err = popByte(newData, "Echo")
+	if err != nil {
+		return nil, err
+	}
+	m.Echo = echo != 0
+
+	m.Outbound, newData, err = unmarshalProbeStamps(newData, "Outbound")
+	if err != nil {
+		return nil, err
+	}
+
+	sig := new(primitives.Signature)
+	newData, err = sig.UnmarshalBinaryData(newData)
+	if err != nil {
+		return nil, err
+	}
+	m.Signature = sig
+
+	m.Stamps, newData, err = unmarshalProbeStamps(newData, "Stamps")
+	if err != nil {
+		return nil, err
+	}
+
+	return newData, nil
+}
+
+func unmarshalProbeStamps(data []byte, field string) ([]interfaces.Timestamp, []byte, error) {
+	num, newData, err := popUInt32(data, field)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = checkCount(newData, num, MaxProbeStamps, 6, field); err != nil {
+		return nil, nil, err
+	}
+
+	var stamps []interfaces.Timestamp
+	for i := uint32(0); i < num; i++ {
+		ts := new(primitives.Timestamp)
+		newData, err = ts.UnmarshalBinaryData(newData)
+		if err != nil {
+			return nil, nil, err
+		}
+		stamps = append(stamps, ts)
+	}
+	return stamps, newData, nil
+}
+
+func (m *Probe) UnmarshalBinary(data []byte) error {
+	_, err := m.UnmarshalBinaryData(data)
+	return err
+}
+
+func (m *Probe) MarshalForSignature() (data []byte, err error) {
+	if m.Timestamp == nil || m.Origin == nil || m.Target == nil {
+		return nil, fmt.Errorf("Message is incomplete")
+	}
+
+	var buf primitives.Buffer
+	buf.Write([]byte{m.Type()})
+	if d, err := m.Timestamp.MarshalBinary(); err != nil {
+		return nil, err
+	} else {
+		buf.Write(d)
+	}
+
+	if d, err := m.Origin.MarshalBinary(); err != nil {
+		return nil, err
+	} else {
+		buf.Write(d)
+	}
+
+	if d, err := m.Target.MarshalBinary(); err != nil {
+		return nil, err
+	} else {
+		buf.Write(d)
+	}
+
+	binary.Write(&buf, binary.BigEndian, m.Number)
+	if m.Echo {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+
+	if err := marshalProbeStamps(&buf, m.Outbound); err != nil {
+		return nil, err
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func marshalProbeStamps(buf *primitives.Buffer, stamps []interfaces.Timestamp) error {
+	binary.Write(buf, binary.BigEndian, uint32(len(stamps)))
+	for _, ts := range stamps {
+		d, err := ts.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		buf.Write(d)
+	}
+	return nil
+}
+
+func (m *Probe) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *Probe) marshalBinary() (data []byte, err error) {
+	resp, err := m.MarshalForSignature()
+	if err != nil {
+		return nil, err
+	}
+	if m.Signature == nil {
+		return nil, fmt.Errorf("Message is not signed")
+	}
+	sigBytes, err := m.Signature.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf primitives.Buffer
+	buf.Write(resp)
+	buf.Write(sigBytes)
+	if err := marshalProbeStamps(&buf, m.Stamps); err != nil {
+		return nil, err
+	}
+	return buf.DeepCopyBytes(), nil
+}
+
+func (m *Probe) String() string {
+	kind := "Probe"
+	if m.Echo {
+		kind = "Probe Echo"
+	}
+	return fmt.Sprintf("%s %d from[%x] to[%x] ts %d stamps %d", kind, m.Number,
+		m.Origin.Bytes()[3:6], m.Target.Bytes()[3:6], m.Timestamp.GetTimeSeconds(), len(m.Stamps))
+}
+
+func (m *Probe) ChainID() []byte {
+	return nil
+}
+
+func (m *Probe) ListHeight() int {
+	return 0
+}
+
+func (m *Probe) SerialHash() []byte {
+	return nil
+}
+
+// A probe is valid if it is recent, between two authorities, and signed by an authority.
+func (m *Probe) Validate(state interfaces.IState) interfaces.ValidationResult {
+	now := state.GetTimestamp()
+
+	if now.GetTimeSeconds()-m.Timestamp.GetTimeSeconds() > 60 {
+		return interfaces.Invalid(interfaces.ReasonExpired)
+	}
+
+	if m.GetSignature() == nil {
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
+	}
+
+	// Only authorities probe each other
+	if !state.VerifyIsAuthority(m.Origin) || !state.VerifyIsAuthority(m.Target) {
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
+	}
+
+	if !m.sigvalid {
+		isVer, err := m.VerifySignature()
+		if err != nil || !isVer {
+			return interfaces.Invalid(interfaces.ReasonBadSignature)
+		}
+		data, err := m.MarshalForSignature()
+		if err != nil {
+			return interfaces.Invalid(interfaces.ReasonMalformed)
+		}
+		if level, err := state.FastVerifyAuthoritySignature(data, m.Signature, state.GetLeaderHeight()); err != nil || level < 0 {
+			return interfaces.Invalid(interfaces.ReasonNotAuthority)
+		}
+		m.sigvalid = true
+	}
+
+	return interfaces.Valid()
+}
+
+func (m *Probe) ComputeVMIndex(state interfaces.IState) {
+}
+
+// Leader, follower, do the same thing.
+func (m *Probe) LeaderExecute(state interfaces.IState) {
+	m.FollowerExecute(state)
+}
+
+func (m *Probe) FollowerExecute(state interfaces.IState) {
+	state.FollowerExecuteProbe(m)
+}
+
+func (e *Probe) JSONByte() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (e *Probe) JSONString() (string, error) {
+	return EncodeMsgJSONString(e)
+}
+
+func (e *Probe) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
+}
+
+func (m *Probe) Sign(key interfaces.Signer) error {
+	signature, err := SignSignable(m, key)
+	if err != nil {
+		return err
+	}
+	m.Signature = signature
+	m.ResetMarshalCache()
+	return nil
+}
+
+func (m *Probe) GetSignature() interfaces.IFullSignature {
+	return m.Signature
+}
+
+func (m *Probe) VerifySignature() (bool, error) {
+	return VerifyMessage(m)
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestUnmarshalNilProbe(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(Probe)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestMarshalUnmarshalProbe(t *testing.T) {
+	msg := newSignedProbe()
+	msg.AddStamp(primitives.NewTimestampNow())
+
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg2, err := UnmarshalMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg2.Type() != constants.PROBE_MSG {
+		t.Error("Invalid message type unmarshalled")
+	}
+	if !msg.IsSameAs(msg2.(*Probe)) {
+		t.Errorf("Probe messages are not identical")
+	}
+	if valid, err := msg2.(*Probe).VerifySignature(); !valid || err != nil {
+		t.Errorf("The probe didn't verify: %v", err)
+	}
+}
+
+func TestProbeStamps(t *testing.T) {
+	msg := newSignedProbe()
+	hash := msg.GetRepeatHash()
+	data, _ := msg.MarshalBinary()
+
+	// Stamps don't change the hash, or the signature
+	for i := 0; i < MaxProbeStamps+1; i++ {
+		msg.AddStamp(primitives.NewTimestampNow())
+	}
+	if len(msg.Stamps) != MaxProbeStamps {
+		t.Errorf("Expected %d stamps, got %d", MaxProbeStamps, len(msg.Stamps))
+	}
+	data2, _ := msg.MarshalBinary()
+	if len(data2) <= len(data) {
+		t.Errorf("The stamps were not marshalled")
+	}
+	msg2, err := UnmarshalMessage(data2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !msg2.GetRepeatHash().IsSameAs(hash) || !msg2.GetMsgHash().IsSameAs(hash) {
+		t.Errorf("The stamps changed the hash of the probe")
+	}
+	if valid, _ := msg2.(*Probe).VerifySignature(); !valid {
+		t.Errorf("The stamps broke the signature")
+	}
+
+	// The echo carries the stamps as signed, and is a message of its own
+	echo := msg.NewEcho(primitives.NewTimestampNow())
+	if err := echo.Sign(probeKey()); err != nil {
+		t.Fatal(err)
+	}
+	if !echo.Echo || len(echo.Outbound) != MaxProbeStamps || len(echo.Stamps) != 0 {
+		t.Errorf("The echo did not carry the probe's stamps")
+	}
+	if echo.GetRepeatHash().IsSameAs(hash) {
+		t.Errorf("The echo has the hash of the probe")
+	}
+	echo.Outbound[0] = primitives.NewTimestampFromMilliseconds(1)
+	echo.ResetMarshalCache()
+	if valid, _ := echo.VerifySignature(); valid {
+		t.Errorf("Changed outbound stamps verified")
+	}
+}
+
+func newSignedProbe() *Probe {
+	probe := new(Probe)
+	probe.Timestamp = primitives.NewTimestampNow()
+	probe.Origin = primitives.Sha([]byte("origin"))
+	probe.Target = primitives.Sha([]byte("target"))
+	probe.Number = 7
+	if err := probe.Sign(probeKey()); err != nil {
+		panic(err)
+	}
+	return probe
+}
+
+func probeKey() *primitives.PrivateKey {
+	key, err := primitives.NewPrivateKeyFromHex("07c0d52cb74f4ca3106d80c4a70488426886bccc6ebc10c6bafb37bf8a65f4c38cee85c62a9e48039d4ac294da97943c2001be1539809ea5f54721f0c5477a0a")
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
@@ -0,0 +1,386 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Messages can be sent in a protobuf encoding, described by messages.proto, as a path to nodes
+// written in other languages.  A protobuf message is its type with ProtobufFlag set, followed by
+// the protobuf message of that type.  The messages sent most, acks, EOMs, heartbeats and entry
+// reveals, have their fields in protobuf; the rest are sent whole in the binary format, inside an
+// Opaque message.  No message type has the flag set, so UnmarshalMessageData can tell the
+// encodings apart, and takes either.
+//
+// The hash and the signature of a message are still those of its binary form, so a protobuf
+// message is read by building its binary form, and unmarshalling that.
+//
+// Peers that don't know the flag can't read a protobuf message, so p2p only passes one on to a
+// peer that has said it can (see ProtocolVersionProtobuf in p2p/protocol.go), and turns it back
+// into the binary format for any other.
+
+const ProtobufFlag byte = 0x40 // Set in the type byte of a protobuf message
+
+// IsProtobuf is true if data is a protobuf message.
+func IsProtobuf(data []byte) bool {
+	return len(data) > 0 && !IsCompressed(data) && data[0]&ProtobufFlag != 0
+}
+
+// MarshalProtobuf returns the protobuf form of a message.
+func MarshalProtobuf(msg interfaces.IMsg) ([]byte, error) {
+	b := new(protoBuffer)
+	b.buf = append(b.buf, msg.Type()|ProtobufFlag)
+
+	var err error
+	switch m := msg.(type) {
+	case *Ack:
+		err = marshalAckProtobuf(b, m)
+	case *EOM:
+		err = marshalEOMProtobuf(b, m)
+	case *Heartbeat:
+		err = marshalHeartbeatProtobuf(b, m)
+	case *RevealEntryMsg:
+		if _, ok := m.Entry.(*entryBlock.Entry); !ok {
+			err = marshalOpaqueProtobuf(b, m)
+			break
+		}
+		err = marshalRevealEntryProtobuf(b, m)
+	default:
+		err = marshalOpaqueProtobuf(b, m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return b.buf, nil
+}
+
+// ProtobufToBinary returns the binary form of a protobuf message, and data as is if it isn't a
+// protobuf message.
+func ProtobufToBinary(data []byte) ([]byte, error) {
+	if !IsProtobuf(data) {
+		return data, nil
+	}
+	msgType := data[0] &^ ProtobufFlag
+	fields, err := readProtoFields(data[1:])
+	if err != nil {
+		return nil, fmt.Errorf("Error reading protobuf message: %v", err)
+	}
+
+	var msg interfaces.IMsg
+	switch msgType {
+	case constants.ACK_MSG:
+		msg, err = unmarshalAckProtobuf(fields)
+	case constants.EOM_MSG:
+		msg, err = unmarshalEOMProtobuf(fields)
+	case constants.HEARTBEAT_MSG:
+		msg, err = unmarshalHeartbeatProtobuf(fields)
+	case constants.REVEAL_ENTRY_MSG:
+		if isOpaqueProtobuf(fields) {
+			return unmarshalOpaqueProtobuf(msgType, fields)
+		}
+		msg, err = unmarshalRevealEntryProtobuf(fields)
+	default:
+		return unmarshalOpaqueProtobuf(msgType, fields)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading protobuf message: %v", err)
+	}
+	return msg.MarshalBinary()
+}
+
+// Opaque: the binary form of any message
+
+func marshalOpaqueProtobuf(b *protoBuffer, msg interfaces.IMsg) error {
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	b.bytes(1, data)
+	return nil
+}
+
+// isOpaqueProtobuf is true if a message of a type that has its own protobuf message was sent as
+// Opaque anyway.  Only Opaque has a field 1 of bytes.
+func isOpaqueProtobuf(fields []protoField) bool {
+	for _, f := range fields {
+		if f.Number == 1 && f.WireType == protoBytes {
+			return true
+		}
+	}
+	return false
+}
+
+func unmarshalOpaqueProtobuf(msgType byte, fields []protoField) (data []byte, err error) {
+	for _, f := range fields {
+		if f.Number == 1 {
+			if data, err = f.bytes("binary"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(data) == 0 || data[0] != msgType {
+		return nil, fmt.Errorf("Opaque protobuf message of type %d doesn't hold a message of that type", msgType)
+	}
+	return data, nil
+}
+
+// Ack
+
+func marshalAckProtobuf(b *protoBuffer, m *Ack) error {
+	b.timestamp(1, m.Timestamp)
+	b.uint(2, uint64(m.VMIndex))
+	b.bytes(3, m.Salt[:])
+	b.uint(4, uint64(m.SaltNumber))
+	b.hash(5, m.MessageHash)
+	b.hash(6, m.GetFullMsgHash())
+	b.hash(7, m.LeaderChainID)
+	b.uint(8, uint64(m.DBHeight))
+	b.uint(9, uint64(m.Height))
+	b.uint(10, uint64(m.Minute))
+	b.hash(11, m.SerialHash)
+	b.hash(12, m.BalanceHash)
+	return b.signature(13, m.Signature)
+}
+
+func unmarshalAckProtobuf(fields []protoField) (m *Ack, err error) {
+	m = new(Ack)
+	m.Timestamp = primitives.NewTimestampFromMilliseconds(0)
+	m.MessageHash = primitives.NewZeroHash()
+	m.LeaderChainID = primitives.NewZeroHash()
+	m.SerialHash = primitives.NewZeroHash()
+	for _, f := range fields {
+		switch f.Number {
+		case 1:
+			m.Timestamp, err = f.timestamp("timestamp")
+		case 2:
+			var vmIndex byte
+			vmIndex, err = f.byte("vm_index")
+			m.VMIndex = int(vmIndex)
+		case 3:
+			var salt []byte
+			salt, err = f.bytes("salt")
+			if err == nil && len(salt) != len(m.Salt) {
+				err = fmt.Errorf("Salt is %d bytes", len(salt))
+			}
+			copy(m.Salt[:], salt)
+		case 4:
+			m.SaltNumber, err = f.uint32("salt_number")
+		case 5:
+			m.MessageHash, err = f.hash("message_hash")
+		case 6:
+			m.FullMsgHash, err = f.hash("full_msg_hash")
+		case 7:
+			m.LeaderChainID, err = f.hash("leader_chain_id")
+		case 8:
+			m.DBHeight, err = f.uint32("db_height")
+		case 9:
+			m.Height, err = f.uint32("height")
+		case 10:
+			m.Minute, err = f.byte("minute")
+		case 11:
+			m.SerialHash, err = f.hash("serial_hash")
+		case 12:
+			m.BalanceHash, err = f.hash("balance_hash")
+		case 13:
+			m.Signature, err = f.signature("signature")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// EOM
+
+func marshalEOMProtobuf(b *protoBuffer, m *EOM) error {
+	b.timestamp(1, m.Timestamp)
+	b.hash(2, m.ChainID)
+	b.uint(3, uint64(m.Minute))
+	b.uint(4, uint64(m.VMIndex))
+	b.bool(5, m.FactoidVM)
+	b.uint(6, uint64(m.DBHeight))
+	b.uint(7, uint64(m.SysHeight))
+	b.hash(8, m.SysHash)
+	return b.signature(9, m.Signature)
+}
+
+func unmarshalEOMProtobuf(fields []protoField) (m *EOM, err error) {
+	m = new(EOM)
+	m.Timestamp = primitives.NewTimestampFromMilliseconds(0)
+	m.ChainID = primitives.NewZeroHash()
+	m.SysHash = primitives.NewZeroHash()
+	for _, f := range fields {
+		switch f.Number {
+		case 1:
+			m.Timestamp, err = f.timestamp("timestamp")
+		case 2:
+			m.ChainID, err = f.hash("chain_id")
+		case 3:
+			m.Minute, err = f.byte("minute")
+		case 4:
+			var vmIndex byte
+			vmIndex, err = f.byte("vm_index")
+			m.VMIndex = int(vmIndex)
+		case 5:
+			m.FactoidVM, err = f.bool("factoid_vm")
+		case 6:
+			m.DBHeight, err = f.uint32("db_height")
+		case 7:
+			m.SysHeight, err = f.uint32("sys_height")
+		case 8:
+			m.SysHash, err = f.hash("sys_hash")
+		case 9:
+			m.Signature, err = f.signature("signature")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Heartbeat
+
+func marshalHeartbeatProtobuf(b *protoBuffer, m *Heartbeat) error {
+	b.timestamp(1, m.Timestamp)
+	b.uint(2, uint64(m.SecretNumber))
+	b.uint(3, uint64(m.DBHeight))
+	b.hash(4, m.DBlockHash)
+	b.hash(5, m.IdentityChainID)
+	if err := b.signature(6, m.Signature); err != nil {
+		return err
+	}
+	if m.Health == nil || m.HealthSignature == nil {
+		return nil
+	}
+	health := new(protoBuffer)
+	health.uint(1, uint64(m.Health.SavedHeight))
+	health.uint(2, uint64(m.Health.LastMinuteMillis))
+	health.uint(3, uint64(m.Health.InMsgQueue))
+	health.uint(4, uint64(m.Health.AckQueue))
+	health.uint(5, uint64(m.Health.MsgQueue))
+	health.uint(6, uint64(m.Health.Holding))
+	health.uint(7, uint64(m.Health.FactomdVersion))
+	b.bytes(7, health.buf)
+	return b.signature(8, m.HealthSignature)
+}
+
+func unmarshalHeartbeatProtobuf(fields []protoField) (m *Heartbeat, err error) {
+	m = new(Heartbeat)
+	m.Timestamp = primitives.NewTimestampFromMilliseconds(0)
+	m.DBlockHash = primitives.NewZeroHash()
+	m.IdentityChainID = primitives.NewZeroHash()
+	for _, f := range fields {
+		switch f.Number {
+		case 1:
+			m.Timestamp, err = f.timestamp("timestamp")
+		case 2:
+			m.SecretNumber, err = f.uint32("secret_number")
+		case 3:
+			m.DBHeight, err = f.uint32("db_height")
+		case 4:
+			m.DBlockHash, err = f.hash("dblock_hash")
+		case 5:
+			m.IdentityChainID, err = f.hash("identity_chain_id")
+		case 6:
+			m.Signature, err = f.signature("signature")
+		case 7:
+			m.Health, err = unmarshalHeartbeatHealthProtobuf(f)
+		case 8:
+			m.HealthSignature, err = f.signature("health_signature")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func unmarshalHeartbeatHealthProtobuf(f protoField) (*HeartbeatHealth, error) {
+	data, err := f.bytes("health")
+	if err != nil {
+		return nil, err
+	}
+	fields, err := readProtoFields(data)
+	if err != nil {
+		return nil, err
+	}
+	h := new(HeartbeatHealth)
+	for _, f := range fields {
+		switch f.Number {
+		case 1:
+			h.SavedHeight, err = f.uint32("saved_height")
+		case 2:
+			h.LastMinuteMillis, err = f.uint32("last_minute_millis")
+		case 3:
+			h.InMsgQueue, err = f.uint32("in_msg_queue")
+		case 4:
+			h.AckQueue, err = f.uint32("ack_queue")
+		case 5:
+			h.MsgQueue, err = f.uint32("msg_queue")
+		case 6:
+			h.Holding, err = f.uint32("holding")
+		case 7:
+			h.FactomdVersion, err = f.uint32("factomd_version")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// RevealEntry
+
+func marshalRevealEntryProtobuf(b *protoBuffer, m *RevealEntryMsg) error {
+	e := m.Entry.(*entryBlock.Entry)
+	b.timestamp(1, m.Timestamp)
+	b.uint(2, uint64(e.Version))
+	b.hash(3, e.ChainID)
+	for _, extID := range e.ExtIDs {
+		b.bytes(4, extID.Bytes)
+	}
+	if len(e.Content.Bytes) > 0 {
+		b.bytes(5, e.Content.Bytes)
+	}
+	return nil
+}
+
+func unmarshalRevealEntryProtobuf(fields []protoField) (m *RevealEntryMsg, err error) {
+	m = new(RevealEntryMsg)
+	m.Timestamp = primitives.NewTimestampFromMilliseconds(0)
+	e := entryBlock.NewEntry()
+	e.ChainID = primitives.NewZeroHash()
+	for _, f := range fields {
+		switch f.Number {
+		case 1:
+			m.Timestamp, err = f.timestamp("timestamp")
+		case 2:
+			e.Version, err = f.byte("version")
+		case 3:
+			e.ChainID, err = f.hash("chain_id")
+		case 4:
+			var extID []byte
+			extID, err = f.bytes("ext_ids")
+			e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: extID})
+		case 5:
+			var content []byte
+			content, err = f.bytes("content")
+			e.Content = primitives.ByteSlice{Bytes: content}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	m.Entry = e
+	return m, nil
+}
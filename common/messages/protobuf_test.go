@@ -0,0 +1,116 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/messages"
+)
+
+func TestProtobufRoundTrip(t *testing.T) {
+	samples := fuzzSamples(t)
+	names := []string{}
+	for name := range samples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		msg := samples[name]
+		data, err := msg.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, err := UnmarshalMessage(data); err != nil {
+			continue // Not sent alone, so not one UnmarshalMessage takes
+		}
+		pb, err := MarshalProtobuf(msg)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !IsProtobuf(pb) || IsProtobuf(data) {
+			t.Errorf("%s: IsProtobuf can't tell the encodings apart", name)
+		}
+
+		binary, err := ProtobufToBinary(pb)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(binary, data) {
+			t.Errorf("%s: the binary form of the protobuf message is not the message", name)
+		}
+
+		msg2, err := UnmarshalMessage(pb)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if msg2.Type() != msg.Type() || !msg2.GetMsgHash().IsSameAs(msg.GetMsgHash()) {
+			t.Errorf("%s: the protobuf message did not unmarshal to the message", name)
+		}
+	}
+}
+
+func TestProtobufFields(t *testing.T) {
+	ack := newSignedAck()
+	pb, err := MarshalProtobuf(ack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pb) < 2 || pb[0] != constants.ACK_MSG|ProtobufFlag || pb[1] == 0x0a {
+		t.Errorf("An ack was not sent with its own fields") // 0x0a is the key of Opaque's binary
+	}
+
+	// Fields a later version adds are skipped
+	later := append(append([]byte{}, pb...), 0xa2, 0x06, 0x03, 'n', 'e', 'w')
+	binary, err := ProtobufToBinary(later)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ack.MarshalBinary()
+	if !bytes.Equal(binary, data) {
+		t.Errorf("An unknown field changed the message")
+	}
+
+	// An opaque message has to hold a message of its type
+	eom, _ := newSignedEOM().MarshalBinary()
+	wrong := append([]byte{constants.HEARTBEAT_MSG | ProtobufFlag, 0x0a, byte(len(eom))}, eom...)
+	if _, err := ProtobufToBinary(wrong); err == nil {
+		t.Errorf("An EOM was taken for a heartbeat")
+	}
+}
+
+func TestUnmarshalProtobufFuzz(t *testing.T) {
+	samples := fuzzSamples(t)
+	names := []string{}
+	for name := range samples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := rand.New(rand.NewSource(1))
+	for _, name := range names {
+		data, err := MarshalProtobuf(samples[name])
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		for i := 0; i < len(data); i++ {
+			unmarshalNoPanic(t, name, nil, data[:i])
+		}
+		for i := 0; i < 200; i++ {
+			mutated := append([]byte{}, data...)
+			for j := r.Intn(4); j >= 0; j-- {
+				mutated[1+r.Intn(len(mutated)-1)] = byte(r.Int())
+			}
+			unmarshalNoPanic(t, name, nil, mutated)
+		}
+	}
+}
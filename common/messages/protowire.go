@@ -0,0 +1,207 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The few parts of protobuf's wire format the messages of messages.proto use: varints and
+// length delimited fields.  See https://developers.google.com/protocol-buffers/docs/encoding
+
+const (
+	protoVarint  = 0
+	protoFixed64 = 1
+	protoBytes   = 2
+	protoFixed32 = 5
+)
+
+// protoBuffer builds a message in protobuf's wire format.  As in proto3, fields of zero value
+// are left out.
+type protoBuffer struct {
+	buf []byte
+}
+
+func (b *protoBuffer) varint(v uint64) {
+	for v >= 0x80 {
+		b.buf = append(b.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	b.buf = append(b.buf, byte(v))
+}
+
+func (b *protoBuffer) tag(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protoBuffer) uint(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	b.tag(field, protoVarint)
+	b.varint(v)
+}
+
+func (b *protoBuffer) bool(field int, v bool) {
+	if v {
+		b.uint(field, 1)
+	}
+}
+
+// bytes writes v even if it is empty, so a repeated field keeps its empty entries
+func (b *protoBuffer) bytes(field int, v []byte) {
+	b.tag(field, protoBytes)
+	b.varint(uint64(len(v)))
+	b.buf = append(b.buf, v...)
+}
+
+func (b *protoBuffer) hash(field int, h interfaces.IHash) {
+	if h != nil {
+		b.bytes(field, h.Bytes())
+	}
+}
+
+func (b *protoBuffer) timestamp(field int, t interfaces.Timestamp) {
+	if t != nil {
+		b.uint(field, t.GetTimeMilliUInt64())
+	}
+}
+
+func (b *protoBuffer) signature(field int, sig interfaces.IFullSignature) error {
+	if sig == nil {
+		return nil
+	}
+	data, err := sig.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	b.bytes(field, data)
+	return nil
+}
+
+// protoField is one field of a message in protobuf's wire format.  Varint holds the value of a
+// varint field, Bytes that of a length delimited one.
+type protoField struct {
+	Number   int
+	WireType int
+	Varint   uint64
+	Bytes    []byte
+}
+
+func popVarint(data []byte, field string) (uint64, []byte, error) {
+	var v uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		v |= uint64(data[i]&0x7f) << (7 * uint(i))
+		if data[i] < 0x80 {
+			return v, data[i+1:], nil
+		}
+	}
+	return 0, nil, fmt.Errorf("Bad varint reading %s", field)
+}
+
+// readProtoFields splits a message in protobuf's wire format into its fields, in order.  Fixed
+// width fields, which no message uses yet, are skipped, as a reader of a later version would.
+func readProtoFields(data []byte) ([]protoField, error) {
+	fields := []protoField{}
+	for len(data) > 0 {
+		key, rest, err := popVarint(data, "field key")
+		if err != nil {
+			return nil, err
+		}
+		f := protoField{Number: int(key >> 3), WireType: int(key & 7)}
+		if f.Number == 0 {
+			return nil, fmt.Errorf("Field number 0 is not allowed")
+		}
+		switch f.WireType {
+		case protoVarint:
+			f.Varint, rest, err = popVarint(rest, "varint field")
+		case protoBytes:
+			var l uint64
+			l, rest, err = popVarint(rest, "field length")
+			if err == nil {
+				if l > uint64(len(rest)) {
+					return nil, fmt.Errorf("Field %d is %d bytes, only %d left", f.Number, l, len(rest))
+				}
+				f.Bytes, rest = rest[:l], rest[l:]
+			}
+		case protoFixed64:
+			_, rest, err = popLen(rest, 8, "fixed64 field")
+		case protoFixed32:
+			_, rest, err = popLen(rest, 4, "fixed32 field")
+		default:
+			return nil, fmt.Errorf("Wire type %d of field %d is not supported", f.WireType, f.Number)
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = rest
+		if f.WireType == protoVarint || f.WireType == protoBytes {
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// The values of fields, checked against what the message expects
+
+func (f protoField) uint32(name string) (uint32, error) {
+	if f.WireType != protoVarint || f.Varint > 0xffffffff {
+		return 0, fmt.Errorf("Field %s is not a uint32", name)
+	}
+	return uint32(f.Varint), nil
+}
+
+func (f protoField) byte(name string) (byte, error) {
+	if f.WireType != protoVarint || f.Varint > 0xff {
+		return 0, fmt.Errorf("Field %s is not a byte", name)
+	}
+	return byte(f.Varint), nil
+}
+
+func (f protoField) bool(name string) (bool, error) {
+	if f.WireType != protoVarint || f.Varint > 1 {
+		return false, fmt.Errorf("Field %s is not a bool", name)
+	}
+	return f.Varint == 1, nil
+}
+
+func (f protoField) bytes(name string) ([]byte, error) {
+	if f.WireType != protoBytes {
+		return nil, fmt.Errorf("Field %s is not bytes", name)
+	}
+	return f.Bytes, nil
+}
+
+func (f protoField) hash(name string) (interfaces.IHash, error) {
+	if f.WireType != protoBytes || len(f.Bytes) != 32 {
+		return nil, fmt.Errorf("Field %s is not a hash", name)
+	}
+	return primitives.NewHash(f.Bytes), nil
+}
+
+func (f protoField) timestamp(name string) (interfaces.Timestamp, error) {
+	if f.WireType != protoVarint {
+		return nil, fmt.Errorf("Field %s is not a timestamp", name)
+	}
+	return primitives.NewTimestampFromMilliseconds(f.Varint), nil
+}
+
+func (f protoField) signature(name string) (interfaces.IFullSignature, error) {
+	if f.WireType != protoBytes {
+		return nil, fmt.Errorf("Field %s is not a signature", name)
+	}
+	sig := new(primitives.Signature)
+	rest, err := sig.UnmarshalBinaryData(f.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("Field %s is too long for a signature", name)
+	}
+	return sig, nil
+}
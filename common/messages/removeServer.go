@@ -55,18 +55,18 @@ func (m *RemoveServerMsg) GetTimestamp() interfaces.Timestamp {
 	return m.Timestamp
 }
 
-func (m *RemoveServerMsg) Validate(state interfaces.IState) int {
+func (m *RemoveServerMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	// Check to see if identity exists and is audit or fed server
 	if !state.VerifyIsAuthority(m.ServerChainID) {
 		//fmt.Printf("RemoveServerMsg Error: [%s] is not a server, cannot be removed\n", m.ServerChainID.String()[:8])
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	authoritativeKey := state.GetNetworkSkeletonKey().Bytes()
 	if m.GetSignature() == nil || bytes.Compare(m.GetSignature().GetKey(), authoritativeKey) != 0 {
 		// the message was not signed with the proper authoritative signing key (from conf file)
 		// it is therefore considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonNotAuthority)
 	}
 
 	isVer, err := m.VerifySignature()
@@ -74,10 +74,10 @@ func (m *RemoveServerMsg) Validate(state interfaces.IState) int {
 		// if there is an error during signature verification
 		// or if the signature is invalid
 		// the message is considered invalid
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -101,11 +101,15 @@ func (e *RemoveServerMsg) Process(dbheight uint32, state interfaces.IState) bool
 }
 
 func (e *RemoveServerMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *RemoveServerMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *RemoveServerMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (m *RemoveServerMsg) Sign(key interfaces.Signer) error {
@@ -114,6 +118,7 @@ func (m *RemoveServerMsg) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -126,17 +131,11 @@ func (m *RemoveServerMsg) VerifySignature() (bool, error) {
 }
 
 func (m *RemoveServerMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		return
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling Add Server Message: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -150,8 +149,12 @@ func (m *RemoveServerMsg) UnmarshalBinaryData(data []byte) (newData []byte, err
 		return nil, err
 	}
 
-	m.ServerType = int(newData[0])
-	newData = newData[1:]
+	var serverType byte
+	serverType, newData, err = popByte(newData, "ServerType")
+	if err != nil {
+		return nil, err
+	}
+	m.ServerType = int(serverType)
 
 	if len(newData) > 32 {
 		m.Signature = new(primitives.Signature)
@@ -192,6 +195,10 @@ func (m *RemoveServerMsg) MarshalForSignature() ([]byte, error) {
 }
 
 func (m *RemoveServerMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *RemoveServerMsg) marshalBinary() ([]byte, error) {
 	var buf primitives.Buffer
 
 	data, err := m.MarshalForSignature()
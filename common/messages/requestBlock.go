@@ -75,16 +75,11 @@ func (m *RequestBlock) Type() byte {
 }
 
 func (m *RequestBlock) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling RequestBlock: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -116,7 +111,11 @@ func (m *RequestBlock) MarshalForSignature() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *RequestBlock) MarshalBinary() (data []byte, err error) {
+func (m *RequestBlock) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *RequestBlock) marshalBinary() (data []byte, err error) {
 	//TODO: sign or delete
 	return m.MarshalForSignature()
 }
@@ -149,8 +148,8 @@ func (m *RequestBlock) Signature() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *RequestBlock) Validate(state interfaces.IState) int {
-	return 0
+func (m *RequestBlock) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Pending(interfaces.ReasonNotYet)
 }
 
 func (m *RequestBlock) ComputeVMIndex(state interfaces.IState) {
@@ -163,9 +162,13 @@ func (m *RequestBlock) FollowerExecute(interfaces.IState) {
 }
 
 func (e *RequestBlock) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *RequestBlock) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *RequestBlock) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
@@ -82,11 +82,11 @@ func (m *RevealEntryMsg) Type() byte {
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
 // Also return the matching commit, if 1 (Don't put it back into the Commit List)
-func (m *RevealEntryMsg) Validate(state interfaces.IState) int {
+func (m *RevealEntryMsg) Validate(state interfaces.IState) interfaces.ValidationResult {
 	commit := state.NextCommit(m.Entry.GetHash())
 
 	if commit == nil {
-		return 0
+		return interfaces.Pending(interfaces.ReasonNoCommit)
 	}
 	//
 	// Make sure one of the two proper commits got us here.
@@ -94,22 +94,19 @@ func (m *RevealEntryMsg) Validate(state interfaces.IState) int {
 	m.commitChain, okChain = commit.(*CommitChainMsg)
 	m.commitEntry, okEntry = commit.(*CommitEntryMsg)
 	if !okChain && !okEntry { // What is this trash doing here?  Not a commit at all!
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
+	}
+
+	// Now make sure the proper amount of credits were paid to record the entry.  Any entry
+	// over 10240 bytes is rejected; one not paid for waits on a commit that pays more.  The
+	// state records why.
+	if v := state.CheckRevealCost(m.Entry, commit); v.Code != 1 {
+		return v
 	}
 
-	// Now make sure the proper amount of credits were paid to record the entry.
 	// The chain must exist
 	if okEntry {
 		m.IsEntry = true
-		ECs := int(m.commitEntry.CommitEntry.Credits)
-		// Any entry over 10240 bytes will be rejected
-		if m.Entry.KSize() > 10 {
-			return -1
-		}
-
-		if m.Entry.KSize() > ECs {
-			return 0 // not enough payments on the EC to reveal this entry.  Return 0 to wait on another commit
-		}
 
 		// Make sure we have a chain.  If we don't, then bad things happen.
 		db := state.GetAndLockDB()
@@ -129,18 +126,13 @@ func (m *RevealEntryMsg) Validate(state interfaces.IState) int {
 
 		if eb == nil {
 			// No chain, we have to leave it be and maybe one will be made.
-			return 0
+			return interfaces.Pending(interfaces.ReasonNoChain)
 		}
-		return 1
+		return interfaces.Valid()
 	}
 
 	m.IsEntry = false
-	ECs := int(m.commitChain.CommitChain.Credits)
-	if m.Entry.KSize()+10 > ECs {
-		return 0 // Wait for a commit that might fund us properly
-	}
-
-	return 1
+	return interfaces.Valid()
 }
 
 // Returns true if this is a message for this server to execute as
@@ -159,11 +151,15 @@ func (m *RevealEntryMsg) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *RevealEntryMsg) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *RevealEntryMsg) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *RevealEntryMsg) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func NewRevealEntryMsg() *RevealEntryMsg {
@@ -171,16 +167,11 @@ func NewRevealEntryMsg() *RevealEntryMsg {
 }
 
 func (m *RevealEntryMsg) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("%s", "Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	t := new(primitives.Timestamp)
 	newData, err = t.UnmarshalBinaryData(newData)
@@ -204,7 +195,11 @@ func (m *RevealEntryMsg) UnmarshalBinary(data []byte) error {
 	return err
 }
 
-func (m *RevealEntryMsg) MarshalBinary() (data []byte, err error) {
+func (m *RevealEntryMsg) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *RevealEntryMsg) marshalBinary() (data []byte, err error) {
 	var buf primitives.Buffer
 
 	binary.Write(&buf, binary.BigEndian, m.Type())
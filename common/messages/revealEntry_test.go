@@ -116,7 +116,7 @@ func testValid(ecs uint8, dataSize int, s *state.State) int {
 	com.CommitEntry.EntryHash = m.Entry.GetHash()
 	s.PutCommit(m.Entry.GetHash(), com)
 
-	return m.Validate(s)
+	return m.Validate(s).Code
 }
 
 func newRevealEntryWithContentSizeX(size int) *RevealEntryMsg {
@@ -141,7 +141,11 @@ func (m *ServerFault) PreMarshalBinary() (data []byte, err error) {
 	return buf.DeepCopyBytes(), nil
 }
 
-func (m *ServerFault) MarshalBinary() (data []byte, err error) {
+func (m *ServerFault) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *ServerFault) marshalBinary() (data []byte, err error) {
 	resp, err := m.PreMarshalBinary()
 	if err != nil {
 		return nil, err
@@ -160,16 +164,11 @@ func (m *ServerFault) MarshalBinary() (data []byte, err error) {
 }
 
 func (m *ServerFault) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling With Signatures Invalid Server Fault: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	if m.ServerID == nil {
 		m.ServerID = primitives.NewZeroHash()
@@ -186,10 +185,22 @@ func (m *ServerFault) UnmarshalBinaryData(data []byte) (newData []byte, err erro
 		return nil, err
 	}
 
-	m.VMIndex, newData = newData[0], newData[1:]
-	m.DBHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.Height, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
-	m.SystemHeight, newData = binary.BigEndian.Uint32(newData[0:4]), newData[4:]
+	m.VMIndex, newData, err = popByte(newData, "VMIndex")
+	if err != nil {
+		return nil, err
+	}
+	m.DBHeight, newData, err = popUInt32(newData, "DBHeight")
+	if err != nil {
+		return nil, err
+	}
+	m.Height, newData, err = popUInt32(newData, "Height")
+	if err != nil {
+		return nil, err
+	}
+	m.SystemHeight, newData, err = popUInt32(newData, "SystemHeight")
+	if err != nil {
+		return nil, err
+	}
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -227,6 +238,7 @@ func (m *ServerFault) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -258,18 +270,18 @@ func (m *ServerFault) GetDBHeight() uint32 {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *ServerFault) Validate(state interfaces.IState) int {
+func (m *ServerFault) Validate(state interfaces.IState) interfaces.ValidationResult {
 	if m.Signature == nil {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonBadSignature)
 	}
 	if m.ServerID == nil || m.ServerID.IsZero() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 	if m.AuditServerID == nil || m.AuditServerID.IsZero() {
-		return -1
+		return interfaces.Invalid(interfaces.ReasonMalformed)
 	}
 
-	return 1
+	return interfaces.Valid()
 }
 
 func (m *ServerFault) ComputeVMIndex(state interfaces.IState) {
@@ -285,11 +297,15 @@ func (m *ServerFault) FollowerExecute(state interfaces.IState) {
 }
 
 func (e *ServerFault) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *ServerFault) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *ServerFault) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
 
 func (a *ServerFault) IsSameAs(b *ServerFault) bool {
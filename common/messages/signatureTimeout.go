@@ -84,16 +84,11 @@ func (m *SignatureTimeout) Type() byte {
 }
 
 func (m *SignatureTimeout) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error unmarshalling: %v", r)
-		}
-	}()
-	newData = data
-	if newData[0] != m.Type() {
-		return nil, fmt.Errorf("Invalid Message type")
+	m.ResetMarshalCache()
+	if err = checkType(data, m.Type()); err != nil {
+		return nil, err
 	}
-	newData = newData[1:]
+	newData = data[1:]
 
 	m.Timestamp = new(primitives.Timestamp)
 	newData, err = m.Timestamp.UnmarshalBinaryData(newData)
@@ -130,7 +125,11 @@ func (m *SignatureTimeout) MarshalForSignature() (data []byte, err error) {
 
 	return buf.DeepCopyBytes(), nil
 }
-func (m *SignatureTimeout) MarshalBinary() (data []byte, err error) {
+func (m *SignatureTimeout) MarshalBinary() ([]byte, error) {
+	return m.marshalCached(m.marshalBinary)
+}
+
+func (m *SignatureTimeout) marshalBinary() (data []byte, err error) {
 	resp, err := m.MarshalForSignature()
 	if err != nil {
 		return nil, err
@@ -161,6 +160,7 @@ func (m *SignatureTimeout) Sign(key interfaces.Signer) error {
 		return err
 	}
 	m.Signature = signature
+	m.ResetMarshalCache()
 	return nil
 }
 
@@ -188,8 +188,8 @@ func (m *SignatureTimeout) SerialHash() []byte {
 //  < 0 -- Message is invalid.  Discard
 //  0   -- Cannot tell if message is Valid
 //  1   -- Message is valid
-func (m *SignatureTimeout) Validate(state interfaces.IState) int {
-	return 0
+func (m *SignatureTimeout) Validate(state interfaces.IState) interfaces.ValidationResult {
+	return interfaces.Pending(interfaces.ReasonNotYet)
 }
 
 func (m *SignatureTimeout) ComputeVMIndex(state interfaces.IState) {
@@ -202,9 +202,13 @@ func (m *SignatureTimeout) FollowerExecute(interfaces.IState) {
 }
 
 func (e *SignatureTimeout) JSONByte() ([]byte, error) {
-	return primitives.EncodeJSON(e)
+	return EncodeMsgJSON(e)
 }
 
 func (e *SignatureTimeout) JSONString() (string, error) {
-	return primitives.EncodeJSONString(e)
+	return EncodeMsgJSONString(e)
+}
+
+func (e *SignatureTimeout) MarshalJSON() ([]byte, error) {
+	return EncodeMsgJSON(e)
 }
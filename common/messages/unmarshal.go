@@ -0,0 +1,70 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Messages come off the network, so an unmarshaller can't trust the lengths and counts a message
+// gives.  It checks that each field is really there before it takes it, and caps each count before
+// it loops or allocates on it, so malformed data is an error and never a panic.  The helpers below
+// do the checking; the fuzz harness (fuzz.go and unmarshalFuzz_test.go) keeps them honest.
+
+// checkType returns an error unless data is a message of type t.
+func checkType(data []byte, t byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("No data provided")
+	}
+	if data[0] != t {
+		return fmt.Errorf("Invalid Message type")
+	}
+	return nil
+}
+
+// checkLen returns an error unless data holds at least n bytes for the named field.
+func checkLen(data []byte, n int, field string) error {
+	if n < 0 || len(data) < n {
+		return fmt.Errorf("Not enough data to unmarshal %s: need %d bytes, have %d", field, n, len(data))
+	}
+	return nil
+}
+
+// checkCount returns an error if a count of the named field is past max, or if data is too short to
+// hold that many of them when each takes at least size bytes.
+func checkCount(data []byte, count uint32, max uint32, size int, field string) error {
+	if count > max {
+		return fmt.Errorf("Too many %s: %d, the most is %d", field, count, max)
+	}
+	if uint64(count)*uint64(size) > uint64(len(data)) {
+		return fmt.Errorf("Not enough data to unmarshal %d %s", count, field)
+	}
+	return nil
+}
+
+// popByte returns the first byte of data, and the rest.
+func popByte(data []byte, field string) (byte, []byte, error) {
+	if err := checkLen(data, 1, field); err != nil {
+		return 0, nil, err
+	}
+	return data[0], data[1:], nil
+}
+
+// popUInt32 returns the big endian uint32 at the start of data, and the rest.
+func popUInt32(data []byte, field string) (uint32, []byte, error) {
+	if err := checkLen(data, 4, field); err != nil {
+		return 0, nil, err
+	}
+	return binary.BigEndian.Uint32(data[0:4]), data[4:], nil
+}
+
+// popLen returns the first n bytes of data, and the rest.
+func popLen(data []byte, n int, field string) ([]byte, []byte, error) {
+	if err := checkLen(data, n, field); err != nil {
+		return nil, nil, err
+	}
+	return data[:n], data[n:], nil
+}
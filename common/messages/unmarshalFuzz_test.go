@@ -0,0 +1,186 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package messages_test
+
+import (
+	"crypto/sha1"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The corpus go-fuzz starts from (see fuzz.go).  Run the tests with -writecorpus to add the samples
+// below to it.
+const fuzzCorpus = "testdata/fuzz/corpus"
+
+var writeCorpus = flag.Bool("writecorpus", false, "write the sample messages to the fuzz corpus")
+
+// fuzzSamples returns a sample of every message type, to mutate
+func fuzzSamples(t *testing.T) map[string]interfaces.IMsg {
+	ts := primitives.NewTimestampNow()
+	sf := NewServerFault(primitives.NewHash([]byte("a test")), primitives.NewHash([]byte("a test2")), 1, 10, 100, 0, ts)
+
+	bounce := new(Bounce)
+	bounce.Name = "bounce"
+	bounce.Timestamp = ts
+	bounce.Stamps = append(bounce.Stamps, ts)
+	bounce.AddData(64)
+
+	bounceReply := new(BounceReply)
+	bounceReply.Name = "bounce"
+	bounceReply.Timestamp = ts
+	bounceReply.Stamps = append(bounceReply.Stamps, ts)
+
+	missingEntryBlocks := new(MissingEntryBlocks)
+	missingEntryBlocks.Timestamp = ts
+	missingEntryBlocks.DBHeightStart = 1
+	missingEntryBlocks.DBHeightEnd = 2
+
+	entryBlockResponse := new(EntryBlockResponse)
+	entryBlockResponse.Timestamp = ts
+	entryBlockResponse.EBlocks = append(entryBlockResponse.EBlocks, newDataResponseEntryBlock().DataObject.(interfaces.IEntryBlock))
+	entryBlockResponse.Entries = append(entryBlockResponse.Entries, newRevealEntry().Entry)
+
+	return map[string]interfaces.IMsg{
+		"ack":                   newSignedAck(),
+		"ackBatch":              newSignedAckBatch(),
+		"addServer":             newSignedAddServer(),
+		"auditServerFault":      newSignedAuditServerFault(),
+		"batchCommitEntry":      newBatchCommitEntry(3),
+		"boundCommitChain":      newBoundCommitChain(),
+		"bounce":                bounce,
+		"bounceReply":           bounceReply,
+		"changeServerKey":       newSignedChangeServerKey(),
+		"commitChain":           newSignedCommitChain(),
+		"commitEntry":           newSignedCommitEntry(),
+		"dataResponseEntry":     newDataResponseEntry(),
+		"dataResponseEBlock":    newDataResponseEntryBlock(),
+		"dblockHeadersRequest":  newDBlockHeadersRequest(),
+		"dblockHeadersResponse": newDBlockHeadersResponse(),
+		"dbstate":               newDBStateMsg(),
+		"dbstateMissing":        newDBStateMissing(),
+		"dbSignature":           newSignedDirectoryBlockSignature(),
+		"entryBlockResponse":    entryBlockResponse,
+		"eom":                   newSignedEOM(),
+		"eomTimeout":            newSignedEOMTimeout(),
+		"factoidTransaction":    newFactoidTransaction(),
+		"fullServerFault":       NewFullServerFault(nil, sf, coupleOfSigs(t), 0),
+		"heartbeat":             newSignedHeartbeat(),
+		"heartbeatHealth":       newSignedHeartbeatWithHealth(),
+		"invalidDirectoryBlock": newSignedInvalidDirectoryBlock(),
+		"missingData":           newMissingData(),
+		"missingEntryBlocks":    missingEntryBlocks,
+		"missingMsg":            newMissingMsg(),
+		"missingMsgBatch":       newMissingMsgBatch(),
+		"missingMsgRange":       newMissingMsgRange(),
+		"missingMsgResponse":    newMissingMsgBatch().Responses[0],
+		"probe":                 newSignedProbe(),
+		"requestBlock":          newRequestBlock(),
+		"revealEntry":           newRevealEntry(),
+		"serverFault":           sf,
+		"signatureTimeout":      newSignedSignatureTimeout(),
+	}
+}
+
+// unmarshalNoPanic fails the test if data makes UnmarshalMessage panic, or the UnmarshalBinary of
+// a new message of the same type as msg, if msg isn't nil
+func unmarshalNoPanic(t *testing.T, name string, msg interfaces.IMsg, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("%s: panic unmarshalling %x: %v", name, data, r)
+		}
+	}()
+	UnmarshalMessage(data)
+	if msg != nil {
+		newMsgLike(msg).UnmarshalBinary(data)
+	}
+}
+
+// newMsgLike returns a new, empty message of the same type as msg
+func newMsgLike(msg interfaces.IMsg) interfaces.IMsg {
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(interfaces.IMsg)
+}
+
+func TestUnmarshalFuzz(t *testing.T) {
+	samples := fuzzSamples(t)
+	names := []string{}
+	for name := range samples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := rand.New(rand.NewSource(1))
+	for _, name := range names {
+		msg := samples[name]
+		data, err := msg.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if err := newMsgLike(msg).UnmarshalBinary(data); err != nil {
+			t.Errorf("%s: the sample doesn't unmarshal: %v", name, err)
+		}
+		if *writeCorpus {
+			writeCorpusFile(t, data)
+		}
+
+		// Every truncation of the message
+		for i := 0; i < len(data); i++ {
+			unmarshalNoPanic(t, name, msg, data[:i])
+		}
+
+		// Random bytes changed, favouring the values that make counts and lengths large
+		for i := 0; i < 500; i++ {
+			mutated := append([]byte{}, data...)
+			for j := r.Intn(4); j >= 0; j-- {
+				k := 1 + r.Intn(len(mutated)-1)
+				switch r.Intn(3) {
+				case 0:
+					mutated[k] = 0xFF
+				case 1:
+					mutated[k] = 0
+				default:
+					mutated[k] = byte(r.Int())
+				}
+			}
+			unmarshalNoPanic(t, name, msg, mutated)
+			unmarshalNoPanic(t, name, msg, mutated[:r.Intn(len(mutated))])
+		}
+	}
+}
+
+func TestUnmarshalFuzzCorpus(t *testing.T) {
+	files, err := ioutil.ReadDir(fuzzCorpus)
+	if err != nil {
+		t.Skipf("No fuzz corpus: %v", err)
+	}
+	for _, f := range files {
+		data, err := ioutil.ReadFile(filepath.Join(fuzzCorpus, f.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		unmarshalNoPanic(t, f.Name(), nil, data)
+	}
+}
+
+// writeCorpusFile adds data to the fuzz corpus, named by its hash as go-fuzz names its inputs
+func writeCorpusFile(t *testing.T, data []byte) {
+	if err := os.MkdirAll(fuzzCorpus, 0755); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(fuzzCorpus, fmt.Sprintf("%x", sha1.Sum(data)))
+	if err := ioutil.WriteFile(name, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -50,7 +50,10 @@ func (h *Hash) MarshalText() ([]byte, error) {
 }
 
 func (h *Hash) IsZero() bool {
-	return h.String() == "0000000000000000000000000000000000000000000000000000000000000000"
+	if h == nil {
+		return false
+	}
+	return *h == (Hash{})
 }
 
 // NewShaHashFromStr creates a ShaHash from a hash string.  The string should be
@@ -191,6 +194,14 @@ func (a *Hash) IsSameAs(b interfaces.IHash) bool {
 		return false
 	}
 
+	// Comparing two of our own hashes needn't copy either
+	if h, ok := b.(*Hash); ok {
+		if h == nil {
+			return false
+		}
+		return *a == *h
+	}
+
 	if bytes.Compare(a[:], b.Bytes()) == 0 {
 		return true
 	}
@@ -0,0 +1,271 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/ed25519/edwards25519"
+)
+
+// Hierarchical deterministic keys, derived BIP32 style along BIP44 paths like
+// m/44'/131'/account'/chain/index, so that one secret stands for any number of addresses.
+//
+// BIP32 itself works on secp256k1, and plain ed25519 keys can't be derived from a public key, so
+// these follow BIP32-Ed25519 (Khovratovich and Law): an extended private key is the scalar kL
+// and the nonce key kR of an ed25519 key, and a child's kL is its parent's plus a multiple of the
+// base point, so that the child's public key can also be derived from its parent's.  Anyone
+// holding an account's extended public key can list its addresses, as an exchange watching its
+// deposit addresses needs to, while only the holder of the private key can spend from them.
+// Hardened children can only be derived from a private key.
+//
+// These are not the keys of the mnemonic wallets of factom-walletd, which derive secp256k1 keys.
+
+const (
+	HDHardened = uint32(0x80000000) // Indexes from this one are of hardened children
+
+	HDPurpose         = uint32(44)  // BIP44
+	HDCoinFactoid     = uint32(131) // SLIP-0044 coin type of factoids
+	HDCoinEntryCredit = uint32(132) // SLIP-0044 coin type of entry credits
+)
+
+// HDAccountPath returns the path of an account's keys, m/44'/coin'/account'.  Its chains are 0
+// for receiving and 1 for change.
+func HDAccountPath(coin uint32, account uint32) []uint32 {
+	return []uint32{HDPurpose + HDHardened, coin + HDHardened, account + HDHardened}
+}
+
+// ParseHDPath parses a path like m/44'/131'/0'/0/7, with ' or h marking hardened indexes.  A
+// path without the leading m is relative to whatever key it is applied to.
+func ParseHDPath(path string) ([]uint32, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "m"), "/")
+	indexes := []uint32{}
+	if path == "" {
+		return indexes, nil
+	}
+	for _, step := range strings.Split(path, "/") {
+		hardened := strings.HasSuffix(step, "'") || strings.HasSuffix(step, "h")
+		if hardened {
+			step = step[:len(step)-1]
+		}
+		i, err := strconv.ParseUint(step, 10, 32)
+		if err != nil || uint32(i) >= HDHardened {
+			return nil, fmt.Errorf("Invalid step %q in path", step)
+		}
+		if hardened {
+			i += uint64(HDHardened)
+		}
+		indexes = append(indexes, uint32(i))
+	}
+	return indexes, nil
+}
+
+// An HDPrivateKey is an extended private key: kL, then kR, and the chain code.
+type HDPrivateKey struct {
+	Key       [64]byte
+	ChainCode [32]byte
+}
+
+// An HDPublicKey is an extended public key: the ed25519 public key and the chain code.
+type HDPublicKey struct {
+	Key       [32]byte
+	ChainCode [32]byte
+}
+
+// NewHDMasterKey returns the master key of a seed, such as that of a BIP39 mnemonic.  A quarter
+// of seeds give no key, and have to be replaced.
+func NewHDMasterKey(seed []byte) (*HDPrivateKey, error) {
+	k := new(HDPrivateKey)
+	k.Key = sha512.Sum512(seed)
+	if k.Key[31]&0x20 != 0 {
+		return nil, fmt.Errorf("The seed gives no master key")
+	}
+	k.Key[0] &= 248
+	k.Key[31] &= 127
+	k.Key[31] |= 64
+	k.ChainCode = sha256.Sum256(append([]byte{1}, seed...))
+	return k, nil
+}
+
+// Public returns the extended public key of the key.
+func (k *HDPrivateKey) Public() *HDPublicKey {
+	var kL [32]byte
+	copy(kL[:], k.Key[:32])
+	var A edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&A, &kL)
+
+	p := new(HDPublicKey)
+	A.ToBytes(&p.Key)
+	p.ChainCode = k.ChainCode
+	return p
+}
+
+// Child returns the child key at an index.
+func (k *HDPrivateKey) Child(index uint32) (*HDPrivateKey, error) {
+	var z, c []byte
+	if index >= HDHardened {
+		z = hdHMAC(k.ChainCode, 0, k.Key[:], index)
+		c = hdHMAC(k.ChainCode, 1, k.Key[:], index)
+	} else {
+		A := k.Public().Key
+		z = hdHMAC(k.ChainCode, 2, A[:], index)
+		c = hdHMAC(k.ChainCode, 3, A[:], index)
+	}
+
+	child := new(HDPrivateKey)
+	zL8 := hdTimes8(z[:28])
+	hdAdd(child.Key[:32], k.Key[:32], zL8[:])
+	hdAdd(child.Key[32:], k.Key[32:], z[32:])
+	if child.Key[31]&0x80 != 0 {
+		// Only reachable at depths far beyond any wallet's
+		return nil, fmt.Errorf("No child key at index %d", index)
+	}
+	copy(child.ChainCode[:], c[32:])
+	return child, nil
+}
+
+// Derive returns the key at a path relative to the key.
+func (k *HDPrivateKey) Derive(path []uint32) (*HDPrivateKey, error) {
+	var err error
+	for _, index := range path {
+		k, err = k.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// Sign signs a message so that it verifies, as any ed25519 signature, with the public key.
+func (k *HDPrivateKey) Sign(msg []byte) *[64]byte {
+	var kL [32]byte
+	copy(kL[:], k.Key[:32])
+	A := k.Public().Key
+
+	h := sha512.New()
+	h.Write(k.Key[32:])
+	h.Write(msg)
+	var digest [64]byte
+	h.Sum(digest[:0])
+	var r [32]byte
+	edwards25519.ScReduce(&r, &digest)
+
+	var R edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&R, &r)
+	sig := new([64]byte)
+	var encodedR [32]byte
+	R.ToBytes(&encodedR)
+	copy(sig[:32], encodedR[:])
+
+	h.Reset()
+	h.Write(encodedR[:])
+	h.Write(A[:])
+	h.Write(msg)
+	h.Sum(digest[:0])
+	var hram [32]byte
+	edwards25519.ScReduce(&hram, &digest)
+
+	var s [32]byte
+	edwards25519.ScMulAdd(&s, &hram, &kL, &r)
+	copy(sig[32:], s[:])
+	return sig
+}
+
+// NewHDPublicKeyFromString parses an extended public key as given by String.
+func NewHDPublicKeyFromString(s string) (*HDPublicKey, error) {
+	data, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(data) != 64 {
+		return nil, fmt.Errorf("An extended public key is 64 bytes of hex")
+	}
+	p := new(HDPublicKey)
+	copy(p.Key[:], data[:32])
+	copy(p.ChainCode[:], data[32:])
+	var A edwards25519.ExtendedGroupElement
+	if !A.FromBytes(&p.Key) {
+		return nil, fmt.Errorf("Invalid public key")
+	}
+	return p, nil
+}
+
+// String returns the hex of the public key, then the chain code.
+func (p *HDPublicKey) String() string {
+	return hex.EncodeToString(p.Key[:]) + hex.EncodeToString(p.ChainCode[:])
+}
+
+// Child returns the child key at an index, which can't be hardened.
+func (p *HDPublicKey) Child(index uint32) (*HDPublicKey, error) {
+	if index >= HDHardened {
+		return nil, fmt.Errorf("Hardened keys can't be derived from a public key")
+	}
+	var A edwards25519.ExtendedGroupElement
+	if !A.FromBytes(&p.Key) {
+		return nil, fmt.Errorf("Invalid public key")
+	}
+	z := hdHMAC(p.ChainCode, 2, p.Key[:], index)
+	c := hdHMAC(p.ChainCode, 3, p.Key[:], index)
+
+	// The child is A + 8zL·B
+	one := [32]byte{1}
+	zL8 := hdTimes8(z[:28])
+	var sum edwards25519.ProjectiveGroupElement
+	edwards25519.GeDoubleScalarMultVartime(&sum, &one, &A, &zL8)
+
+	child := new(HDPublicKey)
+	sum.ToBytes(&child.Key)
+	copy(child.ChainCode[:], c[32:])
+	return child, nil
+}
+
+// Derive returns the key at a path relative to the key.
+func (p *HDPublicKey) Derive(path []uint32) (*HDPublicKey, error) {
+	var err error
+	for _, index := range path {
+		p, err = p.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// hdHMAC returns the HMAC-SHA512, keyed by the chain code, of the prefix, the data and the index.
+func hdHMAC(chainCode [32]byte, prefix byte, data []byte, index uint32) []byte {
+	mac := hmac.New(sha512.New, chainCode[:])
+	mac.Write([]byte{prefix})
+	mac.Write(data)
+	var i [4]byte
+	binary.LittleEndian.PutUint32(i[:], index)
+	mac.Write(i[:])
+	return mac.Sum(nil)
+}
+
+// hdTimes8 returns 8 times a little endian number of 28 bytes, in 32.
+func hdTimes8(n []byte) [32]byte {
+	var r [32]byte
+	var carry byte
+	for i, b := range n {
+		r[i] = b<<3 | carry
+		carry = b >> 5
+	}
+	r[len(n)] = carry
+	return r
+}
+
+// hdAdd sets dst to the sum of two little endian numbers of 32 bytes, modulo 2^256.
+func hdAdd(dst, a, b []byte) {
+	var carry uint16
+	for i := 0; i < 32; i++ {
+		sum := uint16(a[i]) + uint16(b[i]) + carry
+		dst[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
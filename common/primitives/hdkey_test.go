@@ -0,0 +1,92 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/primitives"
+)
+
+func testMasterKey(t *testing.T) *HDPrivateKey {
+	// Not every seed gives a master key
+	for i := byte(0); ; i++ {
+		master, err := NewHDMasterKey([]byte{i, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+		if err == nil {
+			return master
+		}
+		if i == 255 {
+			t.Fatal("No seed gave a master key")
+		}
+	}
+}
+
+func TestHDPublicDerivation(t *testing.T) {
+	master := testMasterKey(t)
+	account, err := master.Derive(HDAccountPath(HDCoinFactoid, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := NewHDPublicKeyFromString(account.Public().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[[32]byte]bool)
+	for _, path := range [][]uint32{{0, 0}, {0, 1}, {1, 0}, {0, 1000}} {
+		private, err := account.Derive(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		public, err := xpub.Derive(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *private.Public() != *public {
+			t.Errorf("At %v the public key derived is %s, but the private key's is %s", path, public, private.Public())
+		}
+		if seen[public.Key] {
+			t.Errorf("The key at %v is repeated", path)
+		}
+		seen[public.Key] = true
+
+		msg := []byte("a transaction")
+		if !Verify(&public.Key, msg, private.Sign(msg)) {
+			t.Errorf("The signature of the key at %v doesn't verify", path)
+		}
+	}
+
+	if _, err := xpub.Child(HDHardened); err == nil {
+		t.Error("Derived a hardened key from a public key")
+	}
+	if _, err := NewHDPublicKeyFromString("1234"); err == nil {
+		t.Error("Parsed a short extended public key")
+	}
+}
+
+func TestParseHDPath(t *testing.T) {
+	path, err := ParseHDPath("m/44'/131'/0h/1/7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := append(HDAccountPath(HDCoinFactoid, 0), 1, 7)
+	if len(path) != len(expected) {
+		t.Fatalf("Parsed %v, expected %v", path, expected)
+	}
+	for i := range path {
+		if path[i] != expected[i] {
+			t.Errorf("Parsed %v, expected %v", path, expected)
+		}
+	}
+
+	for _, bad := range []string{"m/x", "m/1//2", "m/2147483648", "m/-1"} {
+		if _, err := ParseHDPath(bad); err == nil {
+			t.Errorf("Parsed %q", bad)
+		}
+	}
+	if path, err := ParseHDPath("m"); err != nil || len(path) != 0 {
+		t.Errorf("The master key's path parsed as %v, %v", path, err)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives
+
+import (
+	"sync"
+)
+
+// Message processing makes millions of timestamps a minute, most of them only to compare a
+// message's time with, and gone straight after.  The pool lets such short-lived timestamps be
+// reused instead of left for the garbage collector.  A timestamp taken from the pool must be put
+// back only by its taker, once nothing refers to it any more; a timestamp that is stored, or
+// handed to code that might keep it, must not come from the pool.
+//
+// Hashes aren't pooled: the hashes of message processing are unmarshalled into, and kept by, the
+// messages and blocks they belong to, and comparing them no longer allocates (see IsSameAs).
+
+var timestampPool = sync.Pool{New: func() interface{} { return new(Timestamp) }}
+
+// GetPooledTimestamp returns a timestamp of now from the pool.
+func GetPooledTimestamp() *Timestamp {
+	t := timestampPool.Get().(*Timestamp)
+	t.SetTimeNow()
+	return t
+}
+
+// PutPooledTimestamp returns a timestamp to the pool.
+func PutPooledTimestamp(t *Timestamp) {
+	if t != nil {
+		timestampPool.Put(t)
+	}
+}
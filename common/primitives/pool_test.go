@@ -0,0 +1,67 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestPooledTimestamp(t *testing.T) {
+	ts := GetPooledTimestamp()
+	if ts.GetTimeMilli() == 0 {
+		t.Errorf("A pooled timestamp isn't now")
+	}
+	PutPooledTimestamp(ts)
+}
+
+func TestHashIsSameAs(t *testing.T) {
+	a := Sha([]byte("a"))
+	if !a.IsSameAs(a.Copy()) || a.IsSameAs(Sha([]byte("b"))) {
+		t.Errorf("IsSameAs got it wrong")
+	}
+	var none *Hash
+	if a.IsSameAs(none) || !NewZeroHash().IsZero() || a.IsZero() || none.IsZero() {
+		t.Errorf("IsZero or IsSameAs got it wrong")
+	}
+}
+
+// Comparing hashes used to copy the second one, and IsZero to hex encode it.  Run with
+// -benchmem; both should make no allocations.
+func BenchmarkHashIsSameAs(b *testing.B) {
+	b.ReportAllocs()
+	x, y := Sha([]byte("x")), Sha([]byte("x"))
+	for i := 0; i < b.N; i++ {
+		x.IsSameAs(y)
+	}
+}
+
+func BenchmarkHashIsZero(b *testing.B) {
+	b.ReportAllocs()
+	x := Sha([]byte("x"))
+	for i := 0; i < b.N; i++ {
+		x.IsZero()
+	}
+}
+
+var sink interfaces.Timestamp
+
+func BenchmarkNewTimestampNow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = NewTimestampNow()
+	}
+}
+
+func BenchmarkPooledTimestamp(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ts := GetPooledTimestamp()
+		sink = ts
+		PutPooledTimestamp(ts)
+	}
+}
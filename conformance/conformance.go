@@ -0,0 +1,191 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package conformance replays a corpus of messages, each with the outcome the protocol says it
+// must have, through unmarshalling and validation.  A release that changes any of the outcomes
+// has changed consensus, whether it meant to or not.
+//
+// Every case is judged by a new node on its network that has only the genesis block.  The
+// built in corpus is made up of cases that can be built from that; cases recorded from a live
+// network can be added as JSON files in a corpus directory, see LoadCorpus.
+package conformance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/state"
+)
+
+// Outcomes of a case
+const (
+	Malformed = "malformed" // Doesn't unmarshal into a message
+	Invalid   = "invalid"   // Validate() < 0
+	Undecided = "undecided" // Validate() == 0; might be valid once we know more
+	Valid     = "valid"     // Validate() == 1
+)
+
+type Case struct {
+	Name    string `json:"name"`
+	Network string `json:"network,omitempty"` // MAIN, TEST or LOCAL (the default)
+	Message string `json:"message"`           // The marshalled message, in hex
+	Expect  string `json:"expect"`            // The outcome the protocol requires
+
+	// Activation heights on the case's network, by name (see constants.ACTIVATIONS); none is
+	// active otherwise
+	Activations map[string]int `json:"activations,omitempty"`
+}
+
+type Result struct {
+	Case    *Case
+	Outcome string
+	Detail  string // Why the outcome, or why the case failed
+	Passed  bool
+}
+
+// NewState returns a node on network with just the genesis block in its database.
+func NewState(network string) *state.State {
+	if network == "" {
+		network = "LOCAL"
+	}
+	s := new(state.State)
+	s.LoadConfig("", "")
+	s.Network = network
+	s.DBType = "Map"
+	s.LogPath = "stdout"
+	s.Init()
+
+	dblk, _, _, _ := state.GenerateGenesisBlocks(s.GetNetworkID())
+	s.DB.StartMultiBatch()
+	if err := s.DB.ProcessDBlockMultiBatch(dblk); err != nil {
+		panic(err)
+	}
+	if err := s.DB.ExecuteMultiBatch(); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// RunCase judges one case.
+func RunCase(c *Case) (r *Result) {
+	r = &Result{Case: c}
+	defer func() {
+		if p := recover(); p != nil {
+			r.Outcome = "panic"
+			r.Detail = fmt.Sprint(p)
+			r.Passed = false
+		}
+	}()
+
+	data, err := hex.DecodeString(c.Message)
+	if err != nil {
+		r.Detail = "bad hex in the corpus: " + err.Error()
+		return
+	}
+
+	s := NewState(c.Network)
+	for name, height := range c.Activations {
+		s.SetActivationHeight(name, s.NetworkNumber, height)
+	}
+
+	rest, msg, err := messages.UnmarshalMessageData(data)
+	if err != nil || msg == nil {
+		r.Outcome = Malformed
+		if err != nil {
+			r.Detail = err.Error()
+		}
+		r.Passed = c.Expect == Malformed
+		return
+	}
+
+	// Hashes of messages are hashes of their bytes, so what we read must be what we would write.
+	remarshalled, err := msg.MarshalBinary()
+	if err != nil {
+		r.Detail = "does not marshal again: " + err.Error()
+		return
+	}
+	if !bytes.Equal(remarshalled, data[:len(data)-len(rest)]) {
+		r.Detail = "does not marshal back to the same bytes"
+		return
+	}
+
+	last := s.GetLastStatus()
+	v := msg.Validate(s)
+	switch {
+	case v.Code < 0:
+		r.Outcome = Invalid
+	case v.Code == 0:
+		r.Outcome = Undecided
+	default:
+		r.Outcome = Valid
+	}
+	if status := s.GetLastStatus(); status != last {
+		r.Detail = status
+	} else if v.Reason != interfaces.ReasonNone {
+		r.Detail = v.Reason.String()
+	}
+	r.Passed = r.Outcome == c.Expect
+	return
+}
+
+// LoadCorpus reads every .json file in dir, each holding a list of cases.
+func LoadCorpus(dir string) ([]*Case, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var cases []*Case
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var fileCases []*Case
+		if err := json.Unmarshal(data, &fileCases); err != nil {
+			return nil, fmt.Errorf("%s: %s", f.Name(), err.Error())
+		}
+		for _, c := range fileCases {
+			c.Name = f.Name() + ": " + c.Name
+		}
+		cases = append(cases, fileCases...)
+	}
+	return cases, nil
+}
+
+// Run judges the built in corpus, and the corpus in dir if there is one, writing a line for each
+// case to out.  Returns the number of cases that failed.
+func Run(dir string, out io.Writer) (int, error) {
+	cases := BuiltInCorpus()
+	if dir != "" {
+		more, err := LoadCorpus(dir)
+		if err != nil {
+			return 0, err
+		}
+		cases = append(cases, more...)
+	}
+
+	failed := 0
+	for _, c := range cases {
+		r := RunCase(c)
+		if r.Passed {
+			fmt.Fprintf(out, "PASS %-55s %s\n", c.Name, r.Outcome)
+			continue
+		}
+		failed++
+		fmt.Fprintf(out, "FAIL %-55s expected %s, got %s: %s\n", c.Name, c.Expect, r.Outcome, r.Detail)
+	}
+	fmt.Fprintf(out, "%d cases, %d passed, %d failed\n", len(cases), len(cases)-failed, failed)
+	return failed, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package conformance_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/FactomProject/factomd/conformance"
+)
+
+func TestBuiltInCorpus(t *testing.T) {
+	for _, c := range BuiltInCorpus() {
+		r := RunCase(c)
+		if !r.Passed {
+			t.Errorf("%s: expected %s, got %s: %s", c.Name, c.Expect, r.Outcome, r.Detail)
+		}
+	}
+}
+
+func TestLoadCorpus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "conformance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	corpus := `[{"name": "unknown type", "message": "ff00", "expect": "malformed"},
+		{"name": "wrong expectation", "message": "ff00", "expect": "valid"}]`
+	if err := ioutil.WriteFile(filepath.Join(dir, "recorded.json"), []byte(corpus), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a corpus"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases, err := LoadCorpus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 2 {
+		t.Fatalf("Expected 2 cases, loaded %d", len(cases))
+	}
+	if !RunCase(cases[0]).Passed {
+		t.Errorf("%s should have passed", cases[0].Name)
+	}
+	if RunCase(cases[1]).Passed {
+		t.Errorf("%s should have failed", cases[1].Name)
+	}
+}
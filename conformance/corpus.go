@@ -0,0 +1,115 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package conformance
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/state"
+)
+
+// The minute of the genesis block on every network
+const genesisMinutes = 24018960
+
+// BuiltInCorpus returns the cases that ship with factomd.  They are built fresh on each call,
+// as some of them depend on the time.
+func BuiltInCorpus() []*Case {
+	var cases []*Case
+	add := func(name string, network string, msg string, expect string) *Case {
+		c := &Case{Name: name, Network: network, Message: msg, Expect: expect}
+		cases = append(cases, c)
+		return c
+	}
+
+	// Framing
+	add("empty message", "", "", Malformed)
+	add("unknown message type", "", "ff00000000", Malformed)
+	request := marshal(newHeadersRequest(0, 10))
+	add("truncated message", "", request[:20], Malformed)
+
+	// Requests between peers
+	add("dblock headers request", "", request, Valid)
+	add("dblock headers request for no headers", "", marshal(newHeadersRequest(0, 0)), Invalid)
+	add("dblock headers request for the most allowed", "", marshal(newHeadersRequest(0, messages.MaxDBlockHeaders)), Valid)
+	add("dblock headers request for too many", "", marshal(newHeadersRequest(0, messages.MaxDBlockHeaders+1)), Invalid)
+	add("dblock headers response", "", marshal(newHeadersResponse(1, 2, 3)), Valid)
+	add("dblock headers response with a gap", "", marshal(newHeadersResponse(1, 2, 4)), Invalid)
+	add("missing entry blocks, which nodes don't decode", "", marshal(newMissingEntryBlocks(1, 5)), Malformed)
+
+	// Blocks
+	add("genesis DBState", "", marshal(newDBState(constants.LOCAL_NETWORK_ID, 0, genesisMinutes)), Valid)
+	add("main net genesis DBState", "MAIN", marshal(newDBState(constants.MAIN_NETWORK_ID, 0, genesisMinutes)), Valid)
+	add("DBState from another network", "", marshal(newDBState(constants.TEST_NETWORK_ID, 1, genesisMinutes+10)), Invalid)
+	add("DBState failing a main net checkpoint", "MAIN", marshal(newDBState(constants.MAIN_NETWORK_ID, 10, genesisMinutes+100)), Invalid)
+	older := marshal(newDBState(constants.LOCAL_NETWORK_ID, 1, genesisMinutes-1))
+	future := marshal(newDBState(constants.LOCAL_NETWORK_ID, 1, uint32(time.Now().Add(3*time.Hour).Unix()/60)))
+	add("DBState older than the median timestamp", "", older, Invalid).Activations = timestampRules
+	add("DBState too far in the future", "", future, Invalid).Activations = timestampRules
+	add("DBState older than the median timestamp, before the timestamp rules", "", older, Undecided)
+
+	return cases
+}
+
+// The directory block timestamp rules, from the first block on
+var timestampRules = map[string]int{constants.ACTIVATION_BLOCK_TIMESTAMP: 1}
+
+func marshal(msg interfaces.IMsg) string {
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(data)
+}
+
+func newHeadersRequest(start uint32, count uint32) interfaces.IMsg {
+	msg := new(messages.DBlockHeadersRequest)
+	msg.Peer2Peer = true
+	msg.Timestamp = primitives.NewTimestampNow()
+	msg.DBHeightStart = start
+	msg.Count = count
+	return msg
+}
+
+func newHeadersResponse(heights ...uint32) interfaces.IMsg {
+	msg := new(messages.DBlockHeadersResponse)
+	msg.Peer2Peer = true
+	msg.Timestamp = primitives.NewTimestampNow()
+	for _, h := range heights {
+		header := directoryBlock.NewDBlockHeader()
+		header.SetDBHeight(h)
+		header.SetNetworkID(constants.LOCAL_NETWORK_ID)
+		header.SetTimestamp(primitives.NewTimestampFromMinutes(genesisMinutes + h*10))
+		msg.Headers = append(msg.Headers, header)
+	}
+	return msg
+}
+
+func newMissingEntryBlocks(start uint32, end uint32) interfaces.IMsg {
+	msg := new(messages.MissingEntryBlocks)
+	msg.Peer2Peer = true
+	msg.Timestamp = primitives.NewTimestampNow()
+	msg.DBHeightStart = start
+	msg.DBHeightEnd = end
+	return msg
+}
+
+// newDBState returns the genesis blocks of a network, moved to dbheight with the given timestamp.
+// Past genesis the blocks don't hold together, so these can only test rules that are checked
+// before the blocks are.
+func newDBState(networkID uint32, dbheight uint32, minutes uint32) interfaces.IMsg {
+	dblk, ablk, fblk, ecblk := state.GenerateGenesisBlocks(networkID)
+	if dbheight > 0 {
+		dblk.GetHeader().SetPrevKeyMR(dblk.GetKeyMR())
+		dblk.GetHeader().SetDBHeight(dbheight)
+	}
+	dblk.GetHeader().SetTimestamp(primitives.NewTimestampFromMinutes(minutes))
+	return messages.NewDBStateMsg(primitives.NewTimestampNow(), dblk, ablk, fblk, ecblk, nil, nil, nil)
+}
@@ -8,6 +8,7 @@ var skipInterval = false
 
 $(window).load(
     function() {
+      openDashboardSocket()
       updateHTML()
       setTimeout(function () {
             updateHTML()
@@ -16,6 +17,11 @@ $(window).load(
 );
 
 function updateHTML() {
+  // Pushed over the dashboard socket while it is open
+  if (dashboardSocketOpen()) {
+    return
+  }
+
   $.ajax('./', {
     success: function(){
       serverOnline = true
@@ -55,10 +61,86 @@ $(".tabs-control-panel li a").click(function(){
   setTimeout(
   function() 
   {
+    subscribeDashboard()
     updateHTML()
   }, 300)
 })
 
+// The node pushes the items that changed over a websocket. Polling is only
+// used while the socket is closed.
+var dashboardSocket = null
+var dashboardData = {}
+var heightItems = ["myHeight", "leaderHeight", "completeHeight", "servercount", "channelLength"]
+var peerItems = ["peerTotals", "peers"]
+
+function dashboardSocketOpen() {
+  return dashboardSocket != null && dashboardSocket.readyState == WebSocket.OPEN
+}
+
+function openDashboardSocket() {
+  if (!window.WebSocket) {
+    return
+  }
+  var scheme = location.protocol == "https:" ? "wss://" : "ws://"
+  dashboardSocket = new WebSocket(scheme + location.host + "/ws/dashboard")
+  dashboardSocket.onopen = function() {
+    serverOnline = true
+    $("#server-status").text("Factomd Running")
+    subscribeDashboard()
+  }
+  dashboardSocket.onmessage = function(e) {
+    applyDashboardDiff(JSON.parse(e.data))
+  }
+  dashboardSocket.onclose = function() {
+    dashboardSocket = null
+    setTimeout(openDashboardSocket, 5000)
+  }
+}
+
+// Only the items shown on the open tab are pushed
+function subscribeDashboard() {
+  if (!dashboardSocketOpen()) {
+    return
+  }
+  var items = heightItems.concat(peerItems, ["recentTransactions"])
+  if ($("#indexnav-more").hasClass("is-active")) {
+    items = ["dataDump"]
+  }
+  dashboardSocket.send(JSON.stringify({Items: items}))
+}
+
+// Renders the parts of the page whose items are in the diff, once every item
+// they need has been received
+function applyDashboardDiff(diff) {
+  $.extend(dashboardData, diff)
+  var changed = function(items) {
+    var found = false
+    for (var i = 0; i < items.length; i++) {
+      if (dashboardData[items[i]] == null) {
+        return false
+      }
+      found = found || items[i] in diff
+    }
+    return found
+  }
+  var values = function(items) {
+    return items.map(function(item) { return dashboardData[item] })
+  }
+
+  if (changed(heightItems)) {
+    renderHeight(values(heightItems))
+  }
+  if (changed(peerItems)) {
+    renderAllPeers(values(peerItems))
+  }
+  if (changed(["recentTransactions"])) {
+    renderTransactions(dashboardData.recentTransactions)
+  }
+  if (changed(["dataDump"])) {
+    renderDataDumps(dashboardData.dataDump)
+  }
+}
+
 $("#dump-container #fullscreen-option").click( function(){
   txtArea = jQuery(this).siblings(".is-active")
   txtArea.toggleClass("fullscreen")
@@ -89,130 +171,142 @@ $("#indexnav-more > a").click(function() {
 function updataDataDumps() {
   resp = queryState("dataDump", "",function(resp){
     obj = JSON.parse(resp)
-    $("#dump1 #dumpShort").text(obj.DataDump1.ShortDump)
-    $("#dump1 #dumpRaw").text(obj.DataDump1.RawDump)
+    renderDataDumps(obj)
+  })
+}
 
-    $("#dump2 #dumpRaw").text(obj.DataDump2.RawDump)
+function renderDataDumps(obj) {
+  $("#dump1 #dumpShort").text(obj.DataDump1.ShortDump)
+  $("#dump1 #dumpRaw").text(obj.DataDump1.RawDump)
 
-    $("#dump3 #dumpRaw").text(obj.DataDump3.RawDump)
+  $("#dump2 #dumpRaw").text(obj.DataDump2.RawDump)
 
-    $("#dump4 #dumpAuth").text(obj.DataDump4.Authorities)
-    $("#dump4 #dumpIdent").text(obj.DataDump4.Identities)
-    $("#dump4 #dumpMyNode").text(obj.DataDump4.MyNode)
+  $("#dump3 #dumpRaw").text(obj.DataDump3.RawDump)
 
-    $("#dump5 #dumpConRaw").text(obj.DataDump5.RawDump)
-    $("#dump5 #dumpSort").text(obj.DataDump5.SortedDump)
-  })
+  $("#dump4 #dumpAuth").text(obj.DataDump4.Authorities)
+  $("#dump4 #dumpIdent").text(obj.DataDump4.Identities)
+  $("#dump4 #dumpMyNode").text(obj.DataDump4.MyNode)
+
+  $("#dump5 #dumpConRaw").text(obj.DataDump5.RawDump)
+  $("#dump5 #dumpSort").text(obj.DataDump5.SortedDump)
 }
 
 function updateTransactions() {
   resp = queryState("recentTransactions","",function(resp){
     obj = JSON.parse(resp)
-    //if($("#DBBlockHeight").text() != obj.DirectoryBlock.DBHeight) {
-      $("#DBKeyMR > a").text(obj.DirectoryBlock.KeyMR)
-      $("#DBBodyKeyMR").text(obj.DirectoryBlock.BodyKeyMR)
-      $("#DBFullHash").text(obj.DirectoryBlock.FullHash)
-      $("#DBBlockTimestamp").text(obj.DirectoryBlock.Timestamp)
-      $("#DBBlockHeight").text(obj.DirectoryBlock.DBHeight)
-      $("#recent-directory-block").text(obj.DirectoryBlock.DBHeight)
-
-      if(obj.FactoidTransactions != null){
-        obj.FactoidTransactions.forEach(function(trans) {
-          if(trans.TotalInput > 0.0001) {
-            if($("#panFactoids > #traxList > tbody #" + trans.TxID).length > 0) {
-            } else {
-              $("#panFactoids > #traxList > tbody").prepend("\
-              <tr id='" + trans.TxID + "'>\
-                  <td><a id='factom-search-link' type='factoidack'>" + trans.TxID + "</a></td>\
-                  <td>" + trans.TotalInput + "</td>\
-                  <td>" + trans.TotalInputs + "</td>\
-                  <td>" + trans.TotalOutputs + "</td>\
-              </tr>")
-              if ($("#panFactoids > #traxList > tbody > tr").length > 100) {
-                $("#panFactoids > #traxList > tbody >tr").last().remove();
-              } 
-            }
-          }
-        })
-      }
-      if(obj.Entries != null){
-        obj.Entries.forEach(function(entry) {
-          // Total
-          $("#recent-entry-total").text("(" + $("#panEntries > #traxList > tbody > tr").length + ")")
-          if ($("#panEntries #" + entry.Hash).length > 0) {
-            if($("#"+entry.Hash + " #chainID a").text() != entry.ChainID) {
-              $("#"+entry.Hash + " #chainID a").text(entry.ChainID)
-            }
-            if ($("#"+entry.Hash + " #chainID a").text() != "Processing") {
-              $("#"+entry.Hash + " #entry-entryhash a").attr("type", "entry")
-            }
-            if($("#"+entry.Hash + " #eccost").text() != entry.ECCost) {
-              $("#"+entry.Hash + " #eccost").text(entry.ECCost)
-            }
+    renderTransactions(obj)
+  })
+}
+
+function renderTransactions(obj) {
+  //if($("#DBBlockHeight").text() != obj.DirectoryBlock.DBHeight) {
+    $("#DBKeyMR > a").text(obj.DirectoryBlock.KeyMR)
+    $("#DBBodyKeyMR").text(obj.DirectoryBlock.BodyKeyMR)
+    $("#DBFullHash").text(obj.DirectoryBlock.FullHash)
+    $("#DBBlockTimestamp").text(obj.DirectoryBlock.Timestamp)
+    $("#DBBlockHeight").text(obj.DirectoryBlock.DBHeight)
+    $("#recent-directory-block").text(obj.DirectoryBlock.DBHeight)
+
+    if(obj.FactoidTransactions != null){
+      obj.FactoidTransactions.forEach(function(trans) {
+        if(trans.TotalInput > 0.0001) {
+          if($("#panFactoids > #traxList > tbody #" + trans.TxID).length > 0) {
           } else {
-            $("#panEntries > #traxList > tbody").prepend("\
-            <tr id='" + entry.Hash + "'>\
-                <td id='entry-entryhash'><a id='factom-search-link' type='entryack'>" + entry.Hash + "</a></td>\
-                <td id='chainID'><a id='factom-search-link' type='chainhead'>" + entry.ChainID  + "</a></td>\
-                <td id='eccost'>" + entry.ECCost + "</td>\
+            $("#panFactoids > #traxList > tbody").prepend("\
+            <tr id='" + trans.TxID + "'>\
+                <td><a id='factom-search-link' type='factoidack'>" + trans.TxID + "</a></td>\
+                <td>" + trans.TotalInput + "</td>\
+                <td>" + trans.TotalInputs + "</td>\
+                <td>" + trans.TotalOutputs + "</td>\
             </tr>")
-            if ($("#panEntries > #traxList > tbody > tr").length > 100) {
-              $("#panEntries > #traxList > tbody >tr").last().remove();
-            }
+            if ($("#panFactoids > #traxList > tbody > tr").length > 100) {
+              $("#panFactoids > #traxList > tbody >tr").last().remove();
+            } 
           }
-        })
-      }
+        }
+      })
+    }
+    if(obj.Entries != null){
+      obj.Entries.forEach(function(entry) {
+        // Total
+        $("#recent-entry-total").text("(" + $("#panEntries > #traxList > tbody > tr").length + ")")
+        if ($("#panEntries #" + entry.Hash).length > 0) {
+          if($("#"+entry.Hash + " #chainID a").text() != entry.ChainID) {
+            $("#"+entry.Hash + " #chainID a").text(entry.ChainID)
+          }
+          if ($("#"+entry.Hash + " #chainID a").text() != "Processing") {
+            $("#"+entry.Hash + " #entry-entryhash a").attr("type", "entry")
+          }
+          if($("#"+entry.Hash + " #eccost").text() != entry.ECCost) {
+            $("#"+entry.Hash + " #eccost").text(entry.ECCost)
+          }
+        } else {
+          $("#panEntries > #traxList > tbody").prepend("\
+          <tr id='" + entry.Hash + "'>\
+              <td id='entry-entryhash'><a id='factom-search-link' type='entryack'>" + entry.Hash + "</a></td>\
+              <td id='chainID'><a id='factom-search-link' type='chainhead'>" + entry.ChainID  + "</a></td>\
+              <td id='eccost'>" + entry.ECCost + "</td>\
+          </tr>")
+          if ($("#panEntries > #traxList > tbody > tr").length > 100) {
+            $("#panEntries > #traxList > tbody >tr").last().remove();
+          }
+        }
+      })
+    }
 
-      // Total
-      $("#recent-factoid-total").text("(" + $("#panFactoids > #traxList > tbody > tr").length + ")")
-  })
+    // Total
+    $("#recent-factoid-total").text("(" + $("#panFactoids > #traxList > tbody > tr").length + ")")
 }
 
 // 3 Queriers in Batch
 function updateHeight() {
   resp = batchQueryState("myHeight,leaderHeight,completeHeight,servercount,channelLength",function(resp){
     obj = JSON.parse(resp)
-    myHeight = obj[0].Height
-    lHeight = obj[1].Height
-    compHeight = obj[2].Height
-    feds = obj[3].fed
-    auds = obj[3].aud
-    respFive = obj[4].length
-
-    $("#serverfedcount").val(feds)
-    $("#serveraudcount").val(auds)
-
-    currentHeight = parseInt(myHeight)
-    $("#nodeHeight").val(myHeight)
-
-    leaderHeight = parseInt(lHeight)
-    updateProgressBar("#syncFirst > .progress-meter", currentHeight, leaderHeight)
-    percent = 0
-    if(leaderHeight == 0) {
-      percent = 100
-    } else {
-      percent = (currentHeight/leaderHeight) * 100
-      percent = Math.floor(percent)
-    }
-    $('#syncFirst > .progress-meter > .progress-meter-text').text(percent + "% Synced (" + currentHeight + " of " + leaderHeight + ")")
-
-    //$("#nodeHeight").val(resp)
-    completeHeight = parseInt(compHeight)
-    updateProgressBar("#syncSecond > .progress-meter", completeHeight, leaderHeight)
-    percentSecond = 0
-    if(leaderHeight == 0) {
-      percent = 100
-    } else {
-      percentSecond = (completeHeight/leaderHeight) * 100
-      percentSecond = Math.floor(percentSecond)
-    }
-    $('#syncSecond > .progress-meter > .progress-meter-text').text(percentSecond + "% Synced (" + completeHeight + " of " + leaderHeight +")")
-
-    // DisplayState Channel length
-    // console.log("Chan Length:", respFive)
+    renderHeight(obj)
   })
 }
 
+function renderHeight(obj) {
+  myHeight = obj[0].Height
+  lHeight = obj[1].Height
+  compHeight = obj[2].Height
+  feds = obj[3].fed
+  auds = obj[3].aud
+  respFive = obj[4].length
+
+  $("#serverfedcount").val(feds)
+  $("#serveraudcount").val(auds)
+
+  currentHeight = parseInt(myHeight)
+  $("#nodeHeight").val(myHeight)
+
+  leaderHeight = parseInt(lHeight)
+  updateProgressBar("#syncFirst > .progress-meter", currentHeight, leaderHeight)
+  percent = 0
+  if(leaderHeight == 0) {
+    percent = 100
+  } else {
+    percent = (currentHeight/leaderHeight) * 100
+    percent = Math.floor(percent)
+  }
+  $('#syncFirst > .progress-meter > .progress-meter-text').text(percent + "% Synced (" + currentHeight + " of " + leaderHeight + ")")
+
+  //$("#nodeHeight").val(resp)
+  completeHeight = parseInt(compHeight)
+  updateProgressBar("#syncSecond > .progress-meter", completeHeight, leaderHeight)
+  percentSecond = 0
+  if(leaderHeight == 0) {
+    percent = 100
+  } else {
+    percentSecond = (completeHeight/leaderHeight) * 100
+    percentSecond = Math.floor(percentSecond)
+  }
+  $('#syncSecond > .progress-meter > .progress-meter-text').text(percentSecond + "% Synced (" + completeHeight + " of " + leaderHeight +")")
+
+  // DisplayState Channel length
+  // console.log("Chan Length:", respFive)
+}
+
 function updateProgressBar(id, current, max) {
   if(max == 0) {
     percent = (current/max) * 100
@@ -229,123 +323,127 @@ var peerHashes = [""]
 function updateAllPeers() {
   batchQueryState("peerTotals,peers", function(respRaw){
     obj = JSON.parse(respRaw)
-    respOne = obj[0]
-    resp = obj[1]
-    $("#totalPeerCount").text(resp.length)
+    renderAllPeers(obj)
+  })
+}
 
-    // Totals
-    if(respOne.length == 0) {
-      return
-    }
-    if (typeof respOne == "undefined") {
-      //$("#peerList > tfoot > tr > #peerquality").text("0")
-    } else {
-      //$("#peerList > tfoot > tr > #peerquality").text(formatQuality(obj.PeerQualityAvg))
-      $("#peerList > tfoot > tr > #up").text(formatBytes(respOne.BytesSentTotal, respOne.MessagesSent))
-      $("#peerList > tfoot > tr > #down").text(formatBytes(respOne.BytesReceivedTotal, respOne.MessagesReceived))
-    }
-    // Table Body
-    if(resp.length == 0) {
-        $("#peerList tbody tr").each(function(){
-          jQuery(this).remove()
-        })
-      return
-    }
-    peerHashes = [""]
-
-    // To avoid hundreds of new html elements updated in a quick span, it will be limited.
-    newPeers = 0
-    for (index in resp) {
-      peer = resp[index]
-      peerHashes.push(peer.PeerHash)
-      if($("#" + peer.Hash).length > 0) {
-        con = peer.Connection
-        if ($("#" + peer.Hash).find("#ip").val() != peer.PeerHash) {
-          $("#" + peer.Hash).find("#ip span").text(con.PeerAddress)
-          $("#" + peer.Hash).find("#ip").val(peer.PeerHash) // Value
-          $("#" + peer.Hash).find("#disconnect").attr("value", peer.PeerHash)
-
-          $("#" + peer.Hash).foundation()
-        }
-        if ($("#" + peer.Hash).find("#ip span").attr("title") != con.ConnectionNotes) {
-          element = $("#" + peer.Hash).find("#ip span") 
-          wich = $("has-tip").index(element); 
-          $(".tooltip").eq(wich).html(con.ConnectionNotes); 
+function renderAllPeers(obj) {
+  respOne = obj[0]
+  resp = obj[1]
+  $("#totalPeerCount").text(resp.length)
 
-        }
-        if ($("#" + peer.Hash).find("#connected").val() != con.ConnectionState) {
-          $("#" + peer.Hash).find("#connected").val(con.ConnectionState) // Value
-          $("#" + peer.Hash).find("#connected").text(con.ConnectionState)
+  // Totals
+  if(respOne.length == 0) {
+    return
+  }
+  if (typeof respOne == "undefined") {
+    //$("#peerList > tfoot > tr > #peerquality").text("0")
+  } else {
+    //$("#peerList > tfoot > tr > #peerquality").text(formatQuality(obj.PeerQualityAvg))
+    $("#peerList > tfoot > tr > #up").text(formatBytes(respOne.BytesSentTotal, respOne.MessagesSent))
+    $("#peerList > tfoot > tr > #down").text(formatBytes(respOne.BytesReceivedTotal, respOne.MessagesReceived))
+  }
+  // Table Body
+  if(resp.length == 0) {
+      $("#peerList tbody tr").each(function(){
+        jQuery(this).remove()
+      })
+    return
+  }
+  peerHashes = [""]
+
+  // To avoid hundreds of new html elements updated in a quick span, it will be limited.
+  newPeers = 0
+  for (index in resp) {
+    peer = resp[index]
+    peerHashes.push(peer.PeerHash)
+    if($("#" + peer.Hash).length > 0) {
+      con = peer.Connection
+      if ($("#" + peer.Hash).find("#ip").val() != peer.PeerHash) {
+        $("#" + peer.Hash).find("#ip span").text(con.PeerAddress)
+        $("#" + peer.Hash).find("#ip").val(peer.PeerHash) // Value
+        $("#" + peer.Hash).find("#disconnect").attr("value", peer.PeerHash)
+
+        $("#" + peer.Hash).foundation()
+      }
+      if ($("#" + peer.Hash).find("#ip span").attr("title") != con.ConnectionNotes) {
+        element = $("#" + peer.Hash).find("#ip span") 
+        wich = $("has-tip").index(element); 
+        $(".tooltip").eq(wich).html(con.ConnectionNotes); 
 
-          if(peer.Connected == false) { // Need to move to end
-            $("#peerList > tbody").find(("#" + peer.Hash)).remove()
-          }
-        }
-        if ($("#" + peer.Hash).find("#peerquality").val() != con.PeerQuality) {
-          $("#" + peer.Hash).find("#peerquality").val(con.PeerQuality) // Value
-          if(!($("#" + peer.Hash).hasClass(formatQuality(con.PeerQuality)))){
-            $("#" + peer.Hash).removeClass()
-            $("#" + peer.Hash).addClass(formatQuality(con.PeerQuality))
-          }
-        }
+      }
+      if ($("#" + peer.Hash).find("#connected").val() != con.ConnectionState) {
+        $("#" + peer.Hash).find("#connected").val(con.ConnectionState) // Value
+        $("#" + peer.Hash).find("#connected").text(con.ConnectionState)
 
-        if ($("#" + peer.Hash).find("#sent").val().length == 0 || $("#" + peer.Hash).find("#sent").val() != con.BytesSent) {
-          $("#" + peer.Hash).find("#sent").val(con.BytesSent) // Value
-          $("#" + peer.Hash).find("#sent").text(formatBytes(con.BytesSent, con.MessagesSent))
+        if(peer.Connected == false) { // Need to move to end
+          $("#peerList > tbody").find(("#" + peer.Hash)).remove()
         }
-        if ($("#" + peer.Hash).find("#received").val().length == 0 || $("#" + peer.Hash).find("#received").val() != con.BytesReceived) {
-          $("#" + peer.Hash).find("#received").val(con.BytesReceived) // Value
-          $("#" + peer.Hash).find("#received").text(formatBytes(con.BytesReceived, con.MessagesReceived))
-        }
-        if ($("#" + peer.Hash).find("#momentconnected").val() != peer.ConnectionTimeFormatted) {
-          $("#" + peer.Hash).find("#momentconnected").val(peer.ConnectionTimeFormatted) // Value
-          $("#" + peer.Hash).find("#momentconnected").text(peer.ConnectionTimeFormatted)
-        }
-      } else {
-        newPeers = newPeers + 1
-        if (newPeers < 20) { // If over 20 new peers, only load 20. Will get remaining next pass.
-            if(PeerAddFromTopToggle == false) {
-              $("#peerList > tbody").prepend("\
-              <tr id='" + peer.Hash + "'>\
-                  <td id='ip'><span data-tooltip class='has-tip top' title=''>Loading...</span></td>\
-                  <td id='connected'></td>\
-                  <td id='peerquality'></td>\
-                  <td id='momentconnected'></td>\
-                  <td id='sent' value='-10'></td>\
-                  <td id='received' value='-10'></td>\
-                  <td><a id='disconnect' class='button tiny alert'>Disconnect</a></td>\
-              </tr>")
-            } else {
-              $("#peerList > tbody").append("\
-              <tr id='" + peer.Hash + "'>\
-                  <td id='ip'><span data-tooltip class='has-tip top' title=''>Loading...</span></td>\
-                  <td id='connected'></td>\
-                  <td id='peerquality'></td>\
-                  <td id='momentconnected'></td>\
-                  <td id='sent' value='-10'></td>\
-                  <td id='received' value='-10'></td>\
-                  <td><a id='disconnect' class='button tiny alert'>Disconnect</a></td>\
-              </tr>")
-            }
+      }
+      if ($("#" + peer.Hash).find("#peerquality").val() != con.PeerQuality) {
+        $("#" + peer.Hash).find("#peerquality").val(con.PeerQuality) // Value
+        if(!($("#" + peer.Hash).hasClass(formatQuality(con.PeerQuality)))){
+          $("#" + peer.Hash).removeClass()
+          $("#" + peer.Hash).addClass(formatQuality(con.PeerQuality))
         }
+      }
 
+      if ($("#" + peer.Hash).find("#sent").val().length == 0 || $("#" + peer.Hash).find("#sent").val() != con.BytesSent) {
+        $("#" + peer.Hash).find("#sent").val(con.BytesSent) // Value
+        $("#" + peer.Hash).find("#sent").text(formatBytes(con.BytesSent, con.MessagesSent))
+      }
+      if ($("#" + peer.Hash).find("#received").val().length == 0 || $("#" + peer.Hash).find("#received").val() != con.BytesReceived) {
+        $("#" + peer.Hash).find("#received").val(con.BytesReceived) // Value
+        $("#" + peer.Hash).find("#received").text(formatBytes(con.BytesReceived, con.MessagesReceived))
+      }
+      if ($("#" + peer.Hash).find("#momentconnected").val() != peer.ConnectionTimeFormatted) {
+        $("#" + peer.Hash).find("#momentconnected").val(peer.ConnectionTimeFormatted) // Value
+        $("#" + peer.Hash).find("#momentconnected").text(peer.ConnectionTimeFormatted)
+      }
+    } else {
+      newPeers = newPeers + 1
+      if (newPeers < 20) { // If over 20 new peers, only load 20. Will get remaining next pass.
+          if(PeerAddFromTopToggle == false) {
+            $("#peerList > tbody").prepend("\
+            <tr id='" + peer.Hash + "'>\
+                <td id='ip'><span data-tooltip class='has-tip top' title=''>Loading...</span></td>\
+                <td id='connected'></td>\
+                <td id='peerquality'></td>\
+                <td id='momentconnected'></td>\
+                <td id='sent' value='-10'></td>\
+                <td id='received' value='-10'></td>\
+                <td><a id='disconnect' class='button tiny alert'>Disconnect</a></td>\
+            </tr>")
+          } else {
+            $("#peerList > tbody").append("\
+            <tr id='" + peer.Hash + "'>\
+                <td id='ip'><span data-tooltip class='has-tip top' title=''>Loading...</span></td>\
+                <td id='connected'></td>\
+                <td id='peerquality'></td>\
+                <td id='momentconnected'></td>\
+                <td id='sent' value='-10'></td>\
+                <td id='received' value='-10'></td>\
+                <td><a id='disconnect' class='button tiny alert'>Disconnect</a></td>\
+            </tr>")
+          }
       }
+
     }
-    // Cleanup Routine
-    $("#peerList tbody tr").each(function(){
-      if(!jQuery(this).find("#ip span").text().includes("Loading")){
-        if(!contains(peerHashes, jQuery(this).find("#ip").val())) {
-         jQuery(this).remove()
-        }
+  }
+  // Cleanup Routine
+  $("#peerList tbody tr").each(function(){
+    if(!jQuery(this).find("#ip span").text().includes("Loading")){
+      if(!contains(peerHashes, jQuery(this).find("#ip").val())) {
+       jQuery(this).remove()
+      }
+    } else {
+      if(jQuery(this).find("#ip span").text() == "Loading...."){
+        jQuery(this).remove()
       } else {
-        if(jQuery(this).find("#ip span").text() == "Loading...."){
-          jQuery(this).remove()
-        } else {
-          jQuery(this).find("#ip span").text("Loading....")
-        }
+        jQuery(this).find("#ip span").text("Loading....")
       }
-    })
-  }) 
+    }
+  })
 }
 
 // Add listeners to disconnect buttons
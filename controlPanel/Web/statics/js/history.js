@@ -0,0 +1,93 @@
+// Charts the metrics history from /api/history
+var historyRange = "day"
+
+var historyTitles = {
+  "height": "Saved Height",
+  "peers": "Peers",
+  "inqueue": "Incoming Queue",
+  "ackqueue": "Ack Queue",
+  "msgqueue": "Message Queue",
+  "tps": "Transactions a Second"
+}
+
+function historyQuery() {
+  $.getJSON("/api/history?range=" + historyRange, function(resp) {
+    var charts = $("#history-charts")
+    charts.empty()
+    $("#history-error").text(resp.Error || "")
+    $("#history-step").text(resp.Step ? "a point every " + historyStep(resp.Step) : "")
+    if (resp.Series == null) {
+      return
+    }
+    resp.Series.forEach(function(series) {
+      charts.append($("<h4>").text(historyTitles[series.Name] || series.Name))
+      var canvas = $("<canvas>").attr("width", charts.width()).attr("height", 160)
+      charts.append(canvas)
+      historyDraw(canvas[0], series.Points || [])
+    })
+  })
+}
+
+function historyStep(seconds) {
+  if (seconds % 3600 == 0) {
+    return (seconds / 3600) + "h"
+  }
+  if (seconds % 60 == 0) {
+    return (seconds / 60) + "m"
+  }
+  return seconds + "s"
+}
+
+// Draws the points as a line, with the range of the values at the left
+function historyDraw(canvas, points) {
+  var ctx = canvas.getContext("2d")
+  var left = 70, top = 10, width = canvas.width - left - 10, height = canvas.height - 30
+  ctx.font = "12px sans-serif"
+  ctx.fillStyle = "#939598"
+  if (points.length == 0) {
+    ctx.fillText("Nothing kept yet", left, top + height / 2)
+    return
+  }
+
+  var minT = points[0].time, maxT = points[points.length - 1].time
+  var minV = points[0].value, maxV = points[0].value
+  points.forEach(function(p) {
+    minV = Math.min(minV, p.value)
+    maxV = Math.max(maxV, p.value)
+  })
+  if (maxT == minT) {
+    maxT = minT + 1
+  }
+  if (maxV == minV) {
+    maxV = minV + 1
+  }
+  var x = function(t) { return left + width * (t - minT) / (maxT - minT) }
+  var y = function(v) { return top + height - height * (v - minV) / (maxV - minV) }
+
+  ctx.fillText(+maxV.toFixed(2), 0, top + 10)
+  ctx.fillText(+minV.toFixed(2), 0, top + height)
+  ctx.fillText(new Date(minT * 1000).toLocaleString(), left, canvas.height - 5)
+  var end = new Date(maxT * 1000).toLocaleString()
+  ctx.fillText(end, left + width - ctx.measureText(end).width, canvas.height - 5)
+
+  ctx.strokeStyle = "#2f2f2f"
+  ctx.strokeRect(left, top, width, height)
+  ctx.strokeStyle = "#e8b521"
+  ctx.beginPath()
+  points.forEach(function(p, i) {
+    if (i == 0) {
+      ctx.moveTo(x(p.time), y(p.value))
+    } else {
+      ctx.lineTo(x(p.time), y(p.value))
+    }
+  })
+  ctx.stroke()
+}
+
+$("#history-ranges a").click(function() {
+  historyRange = $(this).data("range")
+  historyQuery()
+})
+
+setInterval(historyQuery, 60000)
+historyQuery()
@@ -0,0 +1,38 @@
+// Polls the log ring buffer of the node and adds new lines to the table
+var logsLastSeq = 0
+var logsMaxRows = 500
+
+function logsQuery(reset) {
+  if (reset) {
+    logsLastSeq = 0
+    $("#logs-table tbody").empty()
+  }
+  $.getJSON("/logs", {
+    format: "json",
+    subsystem: $("#logs-subsystem").val(),
+    level: $("#logs-level").val(),
+    since: logsLastSeq
+  }, function(resp) {
+    logsLastSeq = resp.LastSeq
+    resp.Records.forEach(function(rec) {
+      var row = $("<tr>")
+      row.append($("<td>").text(rec.Time))
+      row.append($("<td>").text(rec.LevelName))
+      row.append($("<td>").text(rec.Subsystem))
+      row.append($("<td>").text(rec.Message))
+      $("#logs-table tbody").prepend(row)
+    })
+    $("#logs-table tbody tr").slice(logsMaxRows).remove()
+  })
+}
+
+$("#logs-subsystem, #logs-level").change(function() {
+  logsQuery(true)
+})
+
+setInterval(function() {
+  if ($("#logs-follow").is(":checked")) {
+    logsQuery(false)
+  }
+}, 2000)
+logsQuery(true)
@@ -0,0 +1,5 @@
+// Switches the entry content between the decoded, hex and base64 views
+$("#entry-content-toggles > a").click(function() {
+  $(".entry-content-view").hide()
+  $("#entry-content-" + $(this).attr("data-view")).show()
+})
@@ -0,0 +1,29 @@
+// Refreshes the node grid from /api/siblings
+function siblingsQuery() {
+  $.getJSON("/api/siblings", function(resp) {
+    var body = $("#siblings-table tbody")
+    body.empty()
+    resp.Nodes.forEach(function(node) {
+      var row = $("<tr>")
+      if (node.Status == null) {
+        row.append($("<td>").text("-"))
+        row.append($("<td>").text(node.Url))
+        row.append($("<td colspan=\"4\">").text(node.Error))
+        row.append($("<td>").text("Unreachable"))
+      } else {
+        var st = node.Status
+        row.append($("<td>").text(st.nodename + (node.Local ? " (this node)" : "")))
+        row.append($("<td>").text(node.Url))
+        row.append($("<td>").text(st.role))
+        row.append($("<td>").text(st.directoryblockheight))
+        row.append($("<td>").text(st.leaderheight))
+        row.append($("<td>").text(st.knownheight))
+        row.append($("<td>").text(st.syncing ? "Syncing" : "Synced"))
+      }
+      body.append(row)
+    })
+  })
+}
+
+setInterval(siblingsQuery, 5000)
+siblingsQuery()
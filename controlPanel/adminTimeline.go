@@ -0,0 +1,107 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// The admin timeline walks the admin blocks of a height range and lists every
+// change to the authority set: servers added, removed and faulted, and changes
+// to their keys. Minute markers and directory block signatures are left out.
+
+// Heights shown when no range is given
+var AdminTimelineDefaultRange uint32 = 1000
+
+// Maximum number of admin blocks walked for one timeline
+var AdminTimelineMaxBlocks uint32 = 10000
+
+type AdminTimelineEvent struct {
+	DBHeight   uint32
+	LookupHash string
+	ABDisplayHolder
+}
+
+type AdminTimeline struct {
+	Start  uint32
+	End    uint32
+	Events []AdminTimelineEvent
+}
+
+func (cp *ControlPanel) adminTimelineHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in AdminTimelineHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	timeline := cp.getAdminTimeline(r.FormValue("start"), r.FormValue("end"))
+
+	err := cp.templates.ExecuteTemplate(w, "adminTimelinePage", timeline)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/admintimeline?start=<height>&end=<height>
+func (cp *ControlPanel) apiAdminTimelineHandler(w http.ResponseWriter, r *http.Request) {
+	writeApiResponse(w, cp.getAdminTimeline(r.FormValue("start"), r.FormValue("end")))
+}
+
+// Parses the range from the request. Missing or invalid heights fall back to
+// the last AdminTimelineDefaultRange blocks.
+func (cp *ControlPanel) getAdminTimeline(startStr string, endStr string) *AdminTimeline {
+	top := cp.view().Display.CurrentNodeHeight
+
+	end, ok := ParseHeight(CleanSearchInput(endStr))
+	if !ok || end > top {
+		end = top
+	}
+	start, ok := ParseHeight(CleanSearchInput(startStr))
+	if !ok || start > end {
+		start = 0
+		if end > AdminTimelineDefaultRange {
+			start = end - AdminTimelineDefaultRange
+		}
+	}
+	if end-start >= AdminTimelineMaxBlocks {
+		start = end - AdminTimelineMaxBlocks + 1
+	}
+	return cp.GetAdminTimeline(start, end)
+}
+
+// GetAdminTimeline returns the authority set changes between the heights, inclusive
+func (cp *ControlPanel) GetAdminTimeline(start uint32, end uint32) *AdminTimeline {
+	st := cp.view().State
+	timeline := new(AdminTimeline)
+	timeline.Start = start
+	timeline.End = end
+
+	for height := start; height <= end; height++ {
+		dbase := st.GetAndLockDB()
+		ablk, err := dbase.FetchABlockByHeight(height)
+		st.UnlockDB()
+		if err != nil || ablk == nil {
+			continue
+		}
+
+		lookup := ""
+		if hash, err := ablk.LookupHash(); err == nil {
+			lookup = hash.String()
+		}
+		for _, entry := range ablk.GetABEntries() {
+			disp, err := describeABEntry(entry)
+			if err != nil {
+				continue
+			}
+			if disp.Category == "minute" || disp.Category == "signature" {
+				continue
+			}
+			timeline.Events = append(timeline.Events, AdminTimelineEvent{height, lookup, *disp})
+		}
+	}
+	return timeline
+}
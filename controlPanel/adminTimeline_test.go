@@ -0,0 +1,53 @@
+package controlPanel_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/controlPanel"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestGetAdminTimeline(t *testing.T) {
+	st := testHelper.CreateAndPopulateTestState()
+	cp := NewControlPanel(nil, st, nil, "")
+
+	identity := primitives.Sha([]byte("timeline identity"))
+	ablk := testHelper.CreateTestAdminBlock(nil)
+	ablk.GetHeader().SetDBHeight(1000)
+	ablk.AddFedServer(identity)
+	ablk.AddAuditServer(identity)
+	ablk.AddFederatedServerSigningKey(identity, [32]byte{1, 2, 3})
+	ablk.RemoveFederatedServer(identity)
+	st.DB.StartMultiBatch()
+	if err := st.DB.ProcessABlockMultiBatch(ablk); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.DB.ExecuteMultiBatch(); err != nil {
+		t.Fatal(err)
+	}
+
+	timeline := cp.GetAdminTimeline(0, 1000)
+	if timeline.Start != 0 || timeline.End != 1000 {
+		t.Errorf("Wrong range %d to %d", timeline.Start, timeline.End)
+	}
+	if len(timeline.Events) != 4 {
+		t.Fatalf("Expected 4 events, found %d", len(timeline.Events))
+	}
+	types := []string{"Add Federated Server", "Add Audit Server", "Add Server Key", "Remove Server"}
+	for i, e := range timeline.Events {
+		if e.DBHeight != 1000 {
+			t.Errorf("Event %d has height %d", i, e.DBHeight)
+		}
+		if e.Type != types[i] {
+			t.Errorf("Event %d is a %v, expected %v", i, e.Type, types[i])
+		}
+		if e.IdentityChainID != identity.String() {
+			t.Errorf("Event %d has identity %v", i, e.IdentityChainID)
+		}
+	}
+
+	if len(cp.GetAdminTimeline(0, uint32(testHelper.BlockCount)).Events) != 0 {
+		t.Error("Expected no events in blocks without authority changes")
+	}
+}
@@ -0,0 +1,126 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Json api for the control panel. Every page's data is available here so other
+// frontends do not need to scrape the templates. See README.md for the endpoints.
+
+// Data shown on the main page of the control panel
+type DashboardResponse struct {
+	MyHeight           json.RawMessage
+	LeaderHeight       json.RawMessage
+	CompleteHeight     json.RawMessage
+	ServerCount        json.RawMessage
+	Peers              json.RawMessage
+	PeerTotals         json.RawMessage
+	RecentTransactions json.RawMessage
+	DataDump           json.RawMessage
+	Version            *GitBuildAndVersion
+}
+
+type SearchResponse struct {
+	Type  string
+	Input string
+	Item  interface{}
+}
+
+func (cp *ControlPanel) apiHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				fmt.Println("Control Panel has encountered a panic in ApiHandler.\n", rec)
+			}
+		}()
+		if false == cp.checkControlPanelPassword(w, r) {
+			return
+		}
+		if r.Method != "GET" {
+			http.Error(w, "405 Method Not Allowed.", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		h.ServeHTTP(w, r)
+	}
+}
+
+// GET /api/dashboard
+// The dashboard is answered from a single view, with a single request for fresh data, so its
+// parts agree with each other however many dashboards are being served at once.
+func (cp *ControlPanel) apiDashboardHandler(w http.ResponseWriter, r *http.Request) {
+	cp.requestData()
+	v := cp.view()
+
+	resp := new(DashboardResponse)
+	resp.MyHeight = apiRawJson(cp.queryView(v, "myHeight", ""))
+	resp.LeaderHeight = apiRawJson(cp.queryView(v, "leaderHeight", ""))
+	resp.CompleteHeight = apiRawJson(cp.queryView(v, "completeHeight", ""))
+	resp.ServerCount = apiRawJson(cp.queryView(v, "servercount", ""))
+	resp.Peers = apiRawJson(cp.queryView(v, "peers", ""))
+	resp.PeerTotals = apiRawJson(cp.queryView(v, "peerTotals", ""))
+	resp.RecentTransactions = apiRawJson(cp.queryView(v, "recentTransactions", ""))
+	resp.DataDump = apiRawJson(cp.queryView(v, "dataDump", ""))
+	resp.Version = cp.GitAndVer
+
+	writeApiResponse(w, resp)
+}
+
+// GET /api/search?type=<type>&input=<input>
+// If type is omitted, the type is found by searching the database for input
+func (cp *ControlPanel) apiSearchHandler(w http.ResponseWriter, r *http.Request) {
+	content := new(SearchedStruct)
+	content.Type = r.FormValue("type")
+	content.Input = r.FormValue("input")
+
+	if content.Type == "" {
+		content.Input = CleanSearchInput(content.Input)
+		found, result := cp.searchDB(content.Input)
+		if !found {
+			writeApiResponse(w, SearchResponse{"None", content.Input, nil})
+			return
+		}
+		json.Unmarshal([]byte(result), content)
+		if content.Type == "dblockHeight" {
+			// The item of a height search is the keymr of the directory block
+			keymr, ok := content.Content.(string)
+			if !ok {
+				writeApiResponse(w, SearchResponse{"None", content.Input, nil})
+				return
+			}
+			content.Type = "dblock"
+			content.Input = keymr
+		}
+	}
+
+	if !SanitizeSearch(content) {
+		writeApiResponse(w, SearchResponse{"None", content.Input, nil})
+		return
+	}
+	data := cp.getSearchResultData(content)
+	if data == nil {
+		writeApiResponse(w, SearchResponse{"None", content.Input, nil})
+		return
+	}
+	writeApiResponse(w, SearchResponse{content.Type, content.Input, data})
+}
+
+func writeApiResponse(w http.ResponseWriter, resp interface{}) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, `{"Error":"`+err.Error()+`"}`, http.StatusInternalServerError)
+		return
+	}
+	w.Write(data)
+}
+
+// Some queries return the string 'error' rather than json
+func apiRawJson(data []byte) json.RawMessage {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return json.RawMessage(`null`)
+	}
+	return json.RawMessage(data)
+}
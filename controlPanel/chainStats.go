@@ -0,0 +1,83 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The chain statistics page ranks the chains by the entry credits spent on
+// them over the last blocks saved, with their entries and commits, so the
+// chains behind a burst of load are easy to find.
+
+// Blocks counted when no number is given
+var ChainStatsDefaultBlocks uint32 = 10
+
+// Chains shown on the page
+var ChainStatsMaxChains = 100
+
+type ChainStatsPage struct {
+	From        uint32
+	To          uint32
+	Blocks      uint32 // Blocks asked for
+	BlocksKept  int    // Blocks whose statistics state still has
+	TotalChains int
+	TotalECs    uint64
+	Chains      []interfaces.ChainStats
+}
+
+func (cp *ControlPanel) chainStatsHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in ChainStatsHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	page := cp.getChainStats(r.FormValue("blocks"))
+
+	err := cp.templates.ExecuteTemplate(w, "chainStatsPage", page)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/chainstats?blocks=<number>
+func (cp *ControlPanel) apiChainStatsHandler(w http.ResponseWriter, r *http.Request) {
+	writeApiResponse(w, cp.getChainStats(r.FormValue("blocks")))
+}
+
+// Counts the last blocks saved. A missing or invalid number falls back to
+// ChainStatsDefaultBlocks.
+func (cp *ControlPanel) getChainStats(blocksStr string) *ChainStatsPage {
+	st := cp.view().State
+	blocks64, err := strconv.ParseUint(CleanSearchInput(blocksStr), 10, 32)
+	blocks := uint32(blocks64)
+	if err != nil || blocks == 0 {
+		blocks = ChainStatsDefaultBlocks
+	}
+
+	page := new(ChainStatsPage)
+	page.Blocks = blocks
+	page.To = st.GetHighestSavedBlk()
+	if page.To+1 > blocks {
+		page.From = page.To + 1 - blocks
+	}
+
+	chains, kept := st.GetChainStats(page.From, page.To)
+	page.BlocksKept = kept
+	page.TotalChains = len(chains)
+	for _, cs := range chains {
+		page.TotalECs += cs.ECs
+	}
+	if len(chains) > ChainStatsMaxChains {
+		chains = chains[:ChainStatsMaxChains]
+	}
+	page.Chains = chains
+	return page
+}
@@ -10,8 +10,6 @@ import (
 	"github.com/FactomProject/factomd/p2p"
 )
 
-var AllConnections *ConnectionsMap
-
 type AllConnectionsTotals struct {
 	PeerQualityAvg     int32
 	BytesSentTotal     uint32
@@ -276,15 +274,15 @@ func FormatDuration(initial time.Time) string {
 }
 
 // map[string]p2p.ConnectionMetrics
-func manageConnections(connections chan interface{}) {
+func (cp *ControlPanel) manageConnections(connections chan interface{}) {
 	for {
 		select {
 		case connectionsMessage := <-connections:
 			switch connectionsMessage.(type) {
 			case map[string]p2p.ConnectionMetrics:
 				newConnections := connectionsMessage.(map[string]p2p.ConnectionMetrics)
-				AllConnections.UpdateConnections(newConnections)
-				AllConnections.TallyTotals()
+				cp.AllConnections.UpdateConnections(newConnections)
+				cp.AllConnections.TallyTotals()
 
 			default: // drop that garbage
 				fmt.Printf("Got garbage data on metrics channel: %+v", connectionsMessage)
@@ -29,32 +29,67 @@ import (
 type GitBuildAndVersion struct {
 	GitBuild string
 	Version  string
+	Explorer bool // Read only public explorer, see NodeMode
 }
 
 var (
-	UpdateTimeValue int = 5 // in seconds. How long to update the state and recent transactions
+	UpdateTimeValue int     = 5 // in seconds. How long to update the state and recent transactions
+	TimeRequestHold float64 = 3 // Amount of time in seconds before can request data again
+)
 
-	//FILES_PATH string
-	templates *template.Template
+// A ControlPanel serves the control panel for one node.  Everything a page needs is kept here
+// rather than in package variables, so a process embedding several nodes can serve each of them.
+type ControlPanel struct {
+	Controller *p2p.Controller // Used for Disconnect
+	GitAndVer  *GitBuildAndVersion
 
-	//INDEX_HTML []byte
-	mux   *http.ServeMux
-	index int = 0
+	RecentTransactions *LastDirectoryBlockTransactions
+	AllConnections     *ConnectionsMap
 
-	DisplayState state.DisplayState
-	StatePointer *state.State
-	Controller   *p2p.Controller // Used for Disconnect
-	GitAndVer    *GitBuildAndVersion
+	// Sync Mutex
+	RecentTransactionsMutex sync.Mutex
 
-	LastRequest     time.Time
-	TimeRequestHold float64 = 3 // Amount of time in seconds before can request data again
+	current     *view
+	viewMutex   sync.RWMutex // Guards current, but not what it points to
+	templates   *template.Template
+	mux         *http.ServeMux // For static files
+	lastRequest time.Time
+	requestLock sync.Mutex // Guards lastRequest
 
-	DisplayStateChannel chan state.DisplayState
+	doingRecentTransactions bool // Flag to tell if RecentTransactions is already being built
+}
 
-	// Sync Mutex
-	TemplateMutex     sync.Mutex
-	DisplayStateMutex sync.RWMutex
-)
+// A view is the node the panel shows and the last display state it sent.  Handlers run
+// concurrently with the drain and with Show, so a view is never changed once made: a new one
+// replaces it.  A handler takes one at the start and reads from it without any lock.
+type view struct {
+	State   *state.State
+	Display *state.DisplayState
+	channel chan state.DisplayState
+}
+
+// NewControlPanel returns a control panel for statePointer, which takes its display state from
+// displayStateChannel and disconnects peers through controller.
+func NewControlPanel(displayStateChannel chan state.DisplayState, statePointer *state.State, controller *p2p.Controller, gitBuild string) *ControlPanel {
+	cp := new(ControlPanel)
+	cp.current = &view{statePointer, state.NewDisplayState(), displayStateChannel}
+	cp.Controller = controller
+	cp.GitAndVer = new(GitBuildAndVersion)
+	cp.GitAndVer.GitBuild = gitBuild
+	if len(cp.GitAndVer.GitBuild) == 0 {
+		cp.GitAndVer.GitBuild = "Unknown (Must install with script)"
+	}
+	cp.RecentTransactions = new(LastDirectoryBlockTransactions)
+	cp.AllConnections = NewConnectionsMap()
+	return cp
+}
+
+// view returns what the panel is showing.  It must not be modified.
+func (cp *ControlPanel) view() *view {
+	cp.viewMutex.RLock()
+	defer cp.viewMutex.RUnlock()
+	return cp.current
+}
 
 func directoryExists(path string) bool {
 	if _, err := os.Stat(path); err != nil {
@@ -67,51 +102,54 @@ func directoryExists(path string) bool {
 	return true
 }
 
-func DisplayStateDrain(channel chan state.DisplayState) {
+func (cp *ControlPanel) DisplayStateDrain() {
 	for {
+		v := cp.view()
 		select {
-		case ds := <-channel:
-			DisplayStateMutex.Lock()
-			DisplayState = ds
-			DisplayStateMutex.Unlock()
+		case ds := <-v.channel:
+			cp.viewMutex.Lock()
+			// Show may have switched nodes since, in which case ds is for the old one
+			if cp.current.channel == v.channel {
+				cp.current = &view{v.State, &ds, v.channel}
+			}
+			cp.viewMutex.Unlock()
 		default:
-			requestData()
+			cp.requestData()
 			time.Sleep(1000 * time.Millisecond)
 		}
 	}
 }
 
+// Show switches the control panel to another node, as the simulator does when told to listen to it.
+func (cp *ControlPanel) Show(displayStateChannel chan state.DisplayState, statePointer *state.State) {
+	cp.viewMutex.Lock()
+	cp.current = &view{statePointer, cp.current.Display, displayStateChannel}
+	cp.viewMutex.Unlock()
+	statePointer.RequestControlPanelData()
+}
+
 // Main function. This intiates appropriate variables and starts the control panel serving
 func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer *state.State, connections chan interface{}, controller *p2p.Controller, gitBuild string) {
-	defer func() {
-		if r := recover(); r != nil {
-			// The following recover string indicates an overwrite of existing http.ListenAndServe goroutine
-			if r != "http: multiple registrations for /" {
-				fmt.Println("Control Panel has encountered a panic in ServeControlPanel.\n", r)
-			}
-		}
-	}()
-	StatePointer = statePointer
-	StatePointer.ControlPanelDataRequest = true // Request initial State
-	// Wait for initial State
-	select {
-	case DisplayState = <-displayStateChannel:
-	}
+	NewControlPanel(displayStateChannel, statePointer, controller, gitBuild).Serve(connections)
+}
 
-	DisplayStateMutex.RLock()
-	controlPanelSetting := DisplayState.ControlPanelSetting
-	port := DisplayState.ControlPanelPort
-	DisplayStateMutex.RUnlock()
+// Serve serves the control panel until the process exits, tracking peers from connections.
+func (cp *ControlPanel) Serve(connections chan interface{}) {
+	v := cp.view()
+	v.State.RequestControlPanelData() // Request initial State
+	// Wait for initial State
+	ds := <-v.channel
+	cp.viewMutex.Lock()
+	cp.current = &view{v.State, &ds, v.channel}
+	cp.viewMutex.Unlock()
 
-	if controlPanelSetting == 0 { // 0 = Disabled
+	if ds.ControlPanelSetting == 0 { // 0 = Disabled
 		fmt.Println("Control Panel has been disabled withing the config file and will not be served. This is recommended for any public server, if you wish to renable it, check your config file.")
 		return
 	}
 
-	go DisplayStateDrain(displayStateChannel)
+	v.State.Supervise("control panel display", cp.DisplayStateDrain)
 
-	GitAndVer = new(GitBuildAndVersion)
-	GitAndVer.GitBuild = gitBuild
 	vtos := func(f int) string {
 		v0 := f / 1000000000
 		v1 := (f % 1000000000) / 1000000
@@ -120,32 +158,47 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 
 		return fmt.Sprintf("%d.%d.%d.%d", v0, v1, v2, v3)
 	}
-	GitAndVer.Version = vtos(statePointer.GetFactomdVersion())
-	portStr := ":" + strconv.Itoa(port)
-	Controller = controller
-	TemplateMutex.Lock()
-	templates = files.CustomParseGlob(nil, "templates/general/*.html")
-	templates = template.Must(templates, nil)
-	TemplateMutex.Unlock()
-
-	// Updated Globals. A seperate GoRoutine updates these, we just initialize
-	RecentTransactions = new(LastDirectoryBlockTransactions)
-	AllConnections = NewConnectionsMap()
+	cp.GitAndVer.Version = vtos(v.State.GetFactomdVersion())
+	cp.GitAndVer.Explorer = v.State.IsExplorerMode()
+	portStr := ":" + strconv.Itoa(ds.ControlPanelPort)
+	cp.templates = parseTemplates()
 
 	// Mux for static files
-	mux = http.NewServeMux()
-	mux.Handle("/", files.StaticServer)
-
-	go doEvery(10*time.Second, getRecentTransactions)
-	go manageConnections(connections)
-
-	http.HandleFunc("/", static(indexHandler))
-	http.HandleFunc("/search", searchHandler)
-	http.HandleFunc("/post", postHandler)
-	http.HandleFunc("/factomd", factomdHandler)
-	http.HandleFunc("/factomdBatch", factomdBatchHandler)
-
-	tlsIsEnabled, tlsPrivate, tlsPublic := StatePointer.GetTlsInfo()
+	cp.mux = http.NewServeMux()
+	cp.mux.Handle("/", files.StaticServer)
+
+	v.State.Supervise("control panel transactions", func() { doEvery(10*time.Second, cp.getRecentTransactions) })
+	v.State.Supervise("control panel connections", func() { cp.manageConnections(connections) })
+
+	handlers := http.NewServeMux()
+	handlers.HandleFunc("/", cp.static(cp.indexHandler))
+	handlers.HandleFunc("/search", cp.searchHandler)
+	handlers.HandleFunc("/post", cp.postHandler)
+	handlers.HandleFunc("/factomd", cp.factomdHandler)
+	handlers.HandleFunc("/factomdBatch", cp.factomdBatchHandler)
+	handlers.HandleFunc("/ws/dashboard", cp.dashboardSocket)
+	handlers.HandleFunc("/admintimeline", cp.adminTimelineHandler)
+	handlers.HandleFunc("/dblockminutes", cp.dblockMinutesHandler)
+	handlers.HandleFunc("/chainstats", cp.chainStatsHandler)
+	handlers.HandleFunc("/upgrade", cp.upgradeHandler)
+	handlers.HandleFunc("/api/dashboard", cp.apiHandler(cp.apiDashboardHandler))
+	handlers.HandleFunc("/api/search", cp.apiHandler(cp.apiSearchHandler))
+	handlers.HandleFunc("/api/admintimeline", cp.apiHandler(cp.apiAdminTimelineHandler))
+	handlers.HandleFunc("/api/dblockminutes", cp.apiHandler(cp.apiDBlockMinutesHandler))
+	handlers.HandleFunc("/api/chainstats", cp.apiHandler(cp.apiChainStatsHandler))
+	handlers.HandleFunc("/api/upgrade", cp.apiHandler(cp.apiUpgradeHandler))
+	// The node logs, the sibling nodes, the schedule and the metrics history are not shown to the public
+	if !cp.GitAndVer.Explorer {
+		handlers.HandleFunc("/logs", cp.logsHandler)
+		handlers.HandleFunc("/siblings", cp.siblingsHandler)
+		handlers.HandleFunc("/api/siblings", cp.apiHandler(cp.apiSiblingsHandler))
+		handlers.HandleFunc("/schedule", cp.scheduleHandler)
+		handlers.HandleFunc("/api/schedule", cp.apiHandler(cp.apiScheduleHandler))
+		handlers.HandleFunc("/history", cp.historyHandler)
+		handlers.HandleFunc("/api/history", cp.apiHandler(cp.apiHistoryHandler))
+	}
+
+	tlsIsEnabled, tlsPrivate, tlsPublic := v.State.GetTlsInfo()
 	if tlsIsEnabled {
 	waitfortls:
 		for {
@@ -159,50 +212,77 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 			time.Sleep(100 * time.Millisecond)
 		}
 		fmt.Println("Starting encrypted Control Panel on https://localhost" + portStr + "/  Please note the HTTPS in the browser.")
-		http.ListenAndServeTLS(portStr, tlsPublic, tlsPrivate, nil)
+		http.ListenAndServeTLS(portStr, tlsPublic, tlsPrivate, cp.securityHeaders(handlers))
 	} else {
 		fmt.Println("Starting Control Panel on http://localhost" + portStr + "/")
-		http.ListenAndServe(portStr, nil)
+		http.ListenAndServe(portStr, cp.securityHeaders(handlers))
 	}
 }
 
-func noStaticFilesFoundHandler(w http.ResponseWriter, r *http.Request) {
-	DisplayStateMutex.RLock()
-	DisplayStateMutex.RUnlock()
+// Every page's templates, parsed once when serving starts.  A template is only executed after
+// that, which is safe from any number of handlers at once.
+var templateGlobs = []string{
+	"templates/general/*.html",
+	"templates/index/*.html",
+	"templates/searchresults/*.html",
+	"templates/searchresults/type/*.html",
+	"templates/admintimeline/*.html",
+	"templates/dblockminutes/*.html",
+	"templates/chainstats/*.html",
+	"templates/upgrade/*.html",
+	"templates/logs/*.html",
+	"templates/siblings/*.html",
+	"templates/schedule/*.html",
+	"templates/history/*.html",
+}
+
+func parseTemplates() *template.Template {
+	templates := template.New("controlPanel").Funcs(searchFuncs)
+	for _, glob := range templateGlobs {
+		templates = files.CustomParseGlob(templates, glob)
+	}
+	return templates
+}
+
+func (cp *ControlPanel) noStaticFilesFoundHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "The control panel was not able to be correctly loaded because the Web files were not found. \n")
 }
 
+// Adds the configured security headers to every response. The templates keep
+// all scripts in static files so the default policy can forbid inline scripts.
+func (cp *ControlPanel) securityHeaders(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name, value := range cp.view().State.GetControlPanelSecurityHeaders() {
+			w.Header().Set(name, value)
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 // For all static files. (CSS, JS, IMG, etc...)
-func static(h http.HandlerFunc) http.HandlerFunc {
+func (cp *ControlPanel) static(h http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if false == checkControlPanelPassword(w, r) {
+		if false == cp.checkControlPanelPassword(w, r) {
 			return
 		}
 		if strings.ContainsRune(r.URL.Path, '.') {
-			mux.ServeHTTP(w, r)
+			cp.mux.ServeHTTP(w, r)
 			return
 		}
 		h.ServeHTTP(w, r)
 	}
 }
 
-func indexHandler(w http.ResponseWriter, r *http.Request) {
+func (cp *ControlPanel) indexHandler(w http.ResponseWriter, r *http.Request) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("Control Panel has encountered a panic in IndexHandler.\n", r)
 		}
 	}()
-	TemplateMutex.Lock()
-	defer TemplateMutex.Unlock()
-	if false == checkControlPanelPassword(w, r) {
+	if false == cp.checkControlPanelPassword(w, r) {
 		return
 	}
-	//templates.ParseGlob(FILES_PATH + "templates/index/*.html")
-	files.CustomParseGlob(templates, "templates/index/*.html")
-	if len(GitAndVer.GitBuild) == 0 {
-		GitAndVer.GitBuild = "Unknown (Must install with script)"
-	}
-	err := templates.ExecuteTemplate(w, "indexPage", GitAndVer)
+	err := cp.templates.ExecuteTemplate(w, "indexPage", cp.GitAndVer)
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -210,13 +290,13 @@ func indexHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func postHandler(w http.ResponseWriter, r *http.Request) {
+func (cp *ControlPanel) postHandler(w http.ResponseWriter, r *http.Request) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("Control Panel has encountered a panic in PostHandler.\n", r)
 		}
 	}()
-	if false == checkControlPanelPassword(w, r) {
+	if false == cp.checkControlPanelPassword(w, r) {
 		return
 	}
 	if r.Method != "POST" {
@@ -226,7 +306,7 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 	method := r.FormValue("method")
 	switch method {
 	case "search":
-		found, respose := searchDB(r.FormValue("search"), *StatePointer)
+		found, respose := cp.searchDB(r.FormValue("search"))
 		if found {
 			w.Write([]byte(respose))
 			return
@@ -247,13 +327,13 @@ type SearchedStruct struct {
 	Input string
 }
 
-func searchHandler(w http.ResponseWriter, r *http.Request) {
+func (cp *ControlPanel) searchHandler(w http.ResponseWriter, r *http.Request) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("Control Panel has encountered a panic in SearchHandler.\n", r)
 		}
 	}()
-	if false == checkControlPanelPassword(w, r) {
+	if false == cp.checkControlPanelPassword(w, r) {
 		return
 	}
 	searchResult := new(SearchedStruct)
@@ -264,18 +344,15 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 		searchResult.Type = r.FormValue("type")
 	}
 	searchResult.Input = r.FormValue("input")
-	handleSearchResult(searchResult, w)
+	cp.handleSearchResult(searchResult, w)
 }
 
-var batchQueried = false
-
 // Batches Json in []byte form to an array of json []byte objects
-func factomdBatchHandler(w http.ResponseWriter, r *http.Request) {
-	if false == checkControlPanelPassword(w, r) {
+func (cp *ControlPanel) factomdBatchHandler(w http.ResponseWriter, r *http.Request) {
+	if false == cp.checkControlPanelPassword(w, r) {
 		return
 	}
-	requestData()
-	batchQueried = true
+	cp.requestData()
 	if r.Method != "GET" {
 		return
 	}
@@ -285,25 +362,23 @@ func factomdBatchHandler(w http.ResponseWriter, r *http.Request) {
 
 	items := strings.Split(batch, ",")
 	for _, item := range items {
-		data := factomdQuery(item, "")
+		data := cp.factomdQuery(item, "")
 		batchData = append(batchData, data...)
 		batchData = append(batchData, []byte(`,`)...)
 	}
 
-	batchQueried = false
-
 	batchData = batchData[:len(batchData)-1]
 	batchData = append(batchData, []byte(`]`)...)
 	w.Write(batchData)
 }
 
-func factomdHandler(w http.ResponseWriter, r *http.Request) {
+func (cp *ControlPanel) factomdHandler(w http.ResponseWriter, r *http.Request) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("Control Panel has encountered a panic in FactomdHandler.\n", r)
 		}
 	}()
-	if false == checkControlPanelPassword(w, r) {
+	if false == cp.checkControlPanelPassword(w, r) {
 		return
 	}
 	if r.Method != "GET" {
@@ -311,53 +386,43 @@ func factomdHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	item := r.FormValue("item")   // Item wanted
 	value := r.FormValue("value") // Optional argument
-	data := factomdQuery(item, value)
+	data := cp.factomdQuery(item, value)
 	w.Write([]byte(data))
 }
 
-// Flag to tell if data is already being requested
-var requestMutex bool = false
-
-func requestData() {
-	if requestMutex {
-		return
-	}
-	requestMutex = true
-	if (time.Since(LastRequest)).Seconds() < TimeRequestHold {
-		requestMutex = false
+// Asks the node for a new display state, at most once every TimeRequestHold seconds
+func (cp *ControlPanel) requestData() {
+	cp.requestLock.Lock()
+	defer cp.requestLock.Unlock()
+	if (time.Since(cp.lastRequest)).Seconds() < TimeRequestHold {
 		return
 	}
-	LastRequest = time.Now()
-	StatePointer.ControlPanelDataRequest = true
-	requestMutex = false
+	cp.lastRequest = time.Now()
+	cp.view().State.RequestControlPanelData()
 }
 
-func factomdQuery(item string, value string) []byte {
-	if !batchQueried {
-		requestData()
-	}
+func (cp *ControlPanel) factomdQuery(item string, value string) []byte {
+	cp.requestData()
+	return cp.queryView(cp.view(), item, value)
+}
+
+// queryView answers a query from the view v, so that the answers to several queries can all
+// come from the same display state.
+func (cp *ControlPanel) queryView(v *view, item string, value string) []byte {
 	switch item {
 	case "myHeight":
-		DisplayStateMutex.RLock()
-		h := DisplayState.CurrentNodeHeight
-		DisplayStateMutex.RUnlock()
-		return HeightToJsonStruct(h)
+		return HeightToJsonStruct(v.Display.CurrentNodeHeight)
 	case "leaderHeight":
-		DisplayStateMutex.RLock()
-		h := DisplayState.LeaderHeight
-		if DisplayState.CurrentNodeHeight > DisplayState.LeaderHeight {
-			h = DisplayState.CurrentNodeHeight
+		h := v.Display.LeaderHeight
+		if v.Display.CurrentNodeHeight > v.Display.LeaderHeight {
+			h = v.Display.CurrentNodeHeight
 		}
-		DisplayStateMutex.RUnlock()
 		return HeightToJsonStruct(h)
 	case "completeHeight": // Second Pass Sync info
-		DisplayStateMutex.RLock()
-		h := DisplayState.CurrentEBDBHeight
-		DisplayStateMutex.RUnlock()
-		return HeightToJsonStruct(h)
+		return HeightToJsonStruct(v.Display.CurrentEBDBHeight)
 	case "connections":
 	case "dataDump":
-		data := GetDataDumps()
+		data := cp.dataDumps(v.Display)
 		return data
 	case "nextNode":
 		// Disabled
@@ -369,35 +434,33 @@ func factomdQuery(item string, value string) []byte {
 		DisplayState = Fnodes[index]*/
 		return []byte(fmt.Sprintf("%d", index))
 	case "servercount": // TODO
-		DisplayStateMutex.RLock()
 		feds := 0
 		auds := 0
-		for _, a := range DisplayState.Authorities {
+		for _, a := range v.Display.Authorities {
 			if a.Status == 1 {
 				feds++
 			} else if a.Status == 2 {
 				auds++
 			}
 		}
-		DisplayStateMutex.RUnlock()
 		return []byte(fmt.Sprintf(`{"fed":%d,"aud":%d}`, feds, auds))
 	case "channelLength":
-		return []byte(fmt.Sprintf(`{"length":%d}`, len(DisplayStateChannel)))
+		return []byte(fmt.Sprintf(`{"length":%d}`, len(v.channel)))
 	case "peers":
-		data := getPeers()
+		data := cp.getPeers()
 		return data
 	case "peerTotals":
-		data := getPeetTotals()
+		data := cp.getPeetTotals()
 		return data
 	case "recentTransactions":
-		RecentTransactionsMutex.Lock()
-		defer RecentTransactionsMutex.Unlock()
+		cp.RecentTransactionsMutex.Lock()
+		defer cp.RecentTransactionsMutex.Unlock()
 		data := []byte(`{"list":"none"}`)
 		var err error
-		if RecentTransactions == nil {
+		if cp.RecentTransactions == nil {
 			data = []byte(`{"list":"none"}`)
 		} else {
-			data, err = json.Marshal(RecentTransactions)
+			data, err = json.Marshal(cp.RecentTransactions)
 			if err != nil {
 				data = []byte(`{"list":"none"}`)
 			}
@@ -408,11 +471,8 @@ func factomdQuery(item string, value string) []byte {
 		if len(value) > 0 {
 			hash = hashPeerAddress(value)
 		}
-		DisplayStateMutex.RLock()
-		CPS := DisplayState.ControlPanelSetting
-		DisplayStateMutex.RUnlock()
-		if CPS == 2 {
-			disconnectPeer(value)
+		if v.Display.ControlPanelSetting == 2 {
+			cp.disconnectPeer(value)
 			return []byte(`{"Access":"granted", "Id":"` + hash + `"}`)
 		} else {
 			return []byte(`{"Access":"denied", "Id":"` + hash + `"}`)
@@ -421,15 +481,15 @@ func factomdQuery(item string, value string) []byte {
 	return []byte("")
 }
 
-func disconnectPeer(hash string) {
-	if Controller != nil {
+func (cp *ControlPanel) disconnectPeer(hash string) {
+	if cp.Controller != nil {
 		fmt.Println("ControlPanel: Sent a disconnect signal.")
-		Controller.Disconnect(hash)
+		cp.Controller.Disconnect(hash)
 	}
 }
 
-func getPeers() []byte {
-	data, err := json.Marshal(AllConnections.SortedConnections())
+func (cp *ControlPanel) getPeers() []byte {
+	data, err := json.Marshal(cp.AllConnections.SortedConnections())
 	if err != nil {
 		return []byte(`error`)
 	}
@@ -437,10 +497,10 @@ func getPeers() []byte {
 }
 
 // Returns the total and average statistics for the peer table
-func getPeetTotals() []byte {
-	AllConnections.Lock.Lock()
-	data, err := json.Marshal(AllConnections.Totals)
-	AllConnections.Lock.Unlock()
+func (cp *ControlPanel) getPeetTotals() []byte {
+	cp.AllConnections.Lock.Lock()
+	data, err := json.Marshal(cp.AllConnections.Totals)
+	cp.AllConnections.Lock.Unlock()
 	if err != nil {
 		return []byte(`error`)
 	}
@@ -489,69 +549,55 @@ func (d *LastDirectoryBlockTransactions) ContainsTrans(txid interfaces.IHash) bo
 	return false
 }
 
-var RecentTransactions *LastDirectoryBlockTransactions
-
-// Flag to tell if RecentTransactions is already being built
-var DoingRecentTransactions bool
-var RecentTransactionsMutex sync.Mutex
-
-func toggleDCT() {
-	if DoingRecentTransactions {
-		DoingRecentTransactions = false
+func (cp *ControlPanel) toggleDCT() {
+	if cp.doingRecentTransactions {
+		cp.doingRecentTransactions = false
 	} else {
-		DoingRecentTransactions = true
+		cp.doingRecentTransactions = true
 	}
 }
 
 // Gets all the recent transctions. Will only keep the most recent 100.
-func getRecentTransactions(time.Time) {
+func (cp *ControlPanel) getRecentTransactions(time.Time) {
 	/*defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("Control Panel has encountered a panic in GetRecentTransactions.\n", r)
 		}
 	}()*/
 
-	if DoingRecentTransactions {
-		return
-	}
-	toggleDCT()
-	defer toggleDCT()
-
-	if StatePointer == nil {
+	if cp.doingRecentTransactions {
 		return
 	}
+	cp.toggleDCT()
+	defer cp.toggleDCT()
 
-	DisplayStateMutex.RLock()
-	if DisplayState.LastDirectoryBlock == nil {
-		DisplayStateMutex.RUnlock()
+	v := cp.view()
+	if v.State == nil || v.Display.LastDirectoryBlock == nil {
 		return
 	}
-	data, err := DisplayState.LastDirectoryBlock.MarshalBinary()
+	data, err := v.Display.LastDirectoryBlock.MarshalBinary()
 	if err != nil {
-		DisplayStateMutex.RUnlock()
 		return
 	}
 	last, err := directoryBlock.UnmarshalDBlock(data)
 	err = last.UnmarshalBinary(data)
 	if err != nil {
-		DisplayStateMutex.RUnlock()
 		return
 	}
 	//last := DisplayState.LastDirectoryBlock
-	DisplayStateMutex.RUnlock()
 
 	if last == nil {
 		return
 	}
 
-	RecentTransactionsMutex.Lock()
-	defer RecentTransactionsMutex.Unlock()
+	cp.RecentTransactionsMutex.Lock()
+	defer cp.RecentTransactionsMutex.Unlock()
 
-	if RecentTransactions == nil {
+	if cp.RecentTransactions == nil {
 		return
 	}
 
-	RecentTransactions.DirectoryBlock = struct {
+	cp.RecentTransactions.DirectoryBlock = struct {
 		KeyMR     string
 		BodyKeyMR string
 		FullHash  string
@@ -562,31 +608,30 @@ func getRecentTransactions(time.Time) {
 		PrevKeyMR    string
 	}{last.GetKeyMR().String(), last.BodyKeyMR().String(), last.GetFullHash().String(), fmt.Sprintf("%d", last.GetDatabaseHeight()), last.GetTimestamp().String(), last.GetHeader().GetPrevFullHash().String(), last.GetHeader().GetPrevKeyMR().String()}
 	// Process list items
-	DisplayStateMutex.RLock()
-	for _, entry := range DisplayState.PLEntry {
+	for _, entry := range v.Display.PLEntry {
 		e := new(EntryHolder)
 		e.Hash = entry.EntryHash
 		e.ChainID = "Processing"
 		has := false
-		for _, ent := range RecentTransactions.Entries {
+		for _, ent := range cp.RecentTransactions.Entries {
 			if ent.Hash == e.Hash {
 				has = true
 				break
 			}
 		}
 		if !has {
-			RecentTransactions.Entries = append(RecentTransactions.Entries, *e)
+			cp.RecentTransactions.Entries = append(cp.RecentTransactions.Entries, *e)
 		}
 	}
 
-	for _, fTrans := range DisplayState.PLFactoid {
+	for _, fTrans := range v.Display.PLFactoid {
 		if fTrans.TotalInputs == 0 {
 			continue
 		}
 		txhash, err := primitives.HexToHash(fTrans.TxID)
 		if err == nil {
-			if !RecentTransactions.ContainsTrans(txhash) {
-				RecentTransactions.FactoidTransactions = append(RecentTransactions.FactoidTransactions, struct {
+			if !cp.RecentTransactions.ContainsTrans(txhash) {
+				cp.RecentTransactions.FactoidTransactions = append(cp.RecentTransactions.FactoidTransactions, struct {
 					TxID         string
 					Hash         string
 					TotalInput   string
@@ -597,7 +642,6 @@ func getRecentTransactions(time.Time) {
 			}
 		}
 	}
-	DisplayStateMutex.RUnlock()
 
 	entries := last.GetDBEntries()
 	for _, entry := range entries {
@@ -606,9 +650,9 @@ func getRecentTransactions(time.Time) {
 		}
 		if entry.GetChainID().String() == "000000000000000000000000000000000000000000000000000000000000000f" {
 			mr := entry.GetKeyMR()
-			dbase := StatePointer.GetAndLockDB()
+			dbase := v.State.GetAndLockDB()
 			fblock, err := dbase.FetchFBlock(mr)
-			StatePointer.UnlockDB()
+			v.State.UnlockDB()
 			if err != nil || fblock == nil {
 				continue
 			}
@@ -622,8 +666,8 @@ func getRecentTransactions(time.Time) {
 				totalOutputs := len(trans.GetECOutputs())
 				totalOutputs = totalOutputs + len(trans.GetOutputs())
 				inputStr := fmt.Sprintf("%f", float64(input)/1e8)
-				if !RecentTransactions.ContainsTrans(trans.GetHash()) {
-					RecentTransactions.FactoidTransactions = append(RecentTransactions.FactoidTransactions, struct {
+				if !cp.RecentTransactions.ContainsTrans(trans.GetHash()) {
+					cp.RecentTransactions.FactoidTransactions = append(cp.RecentTransactions.FactoidTransactions, struct {
 						TxID         string
 						Hash         string
 						TotalInput   string
@@ -636,27 +680,27 @@ func getRecentTransactions(time.Time) {
 		} else if entry.GetChainID().String() == "000000000000000000000000000000000000000000000000000000000000000c" {
 			mr := entry.GetKeyMR()
 
-			dbase := StatePointer.GetAndLockDB()
+			dbase := v.State.GetAndLockDB()
 			ecblock, err := dbase.FetchECBlock(mr)
-			StatePointer.UnlockDB()
+			v.State.UnlockDB()
 			if err != nil || ecblock == nil {
 				continue
 			}
 			ents := ecblock.GetEntries()
 			for _, entry := range ents {
 				if entry.GetEntryHash() != nil {
-					e := getEntry(entry.GetEntryHash().String())
+					e := cp.getEntry(entry.GetEntryHash().String())
 					if e != nil {
 						has := false
-						for i, ent := range RecentTransactions.Entries {
+						for i, ent := range cp.RecentTransactions.Entries {
 							if ent.Hash == e.Hash {
-								RecentTransactions.Entries[i] = *e
+								cp.RecentTransactions.Entries[i] = *e
 								has = true
 								break
 							}
 						}
 						if !has {
-							RecentTransactions.Entries = append(RecentTransactions.Entries, *e)
+							cp.RecentTransactions.Entries = append(cp.RecentTransactions.Entries, *e)
 						}
 					}
 				}
@@ -664,36 +708,36 @@ func getRecentTransactions(time.Time) {
 		}
 	}
 
-	if last.GetHeader().GetDBHeight() > RecentTransactions.LastHeightChecked {
-		entriesNeeded := 100 - len(RecentTransactions.Entries)
-		factoidsNeeded := 100 - len(RecentTransactions.FactoidTransactions)
+	if last.GetHeader().GetDBHeight() > cp.RecentTransactions.LastHeightChecked {
+		entriesNeeded := 100 - len(cp.RecentTransactions.Entries)
+		factoidsNeeded := 100 - len(cp.RecentTransactions.FactoidTransactions)
 		// If we do not have 100 of each transaction, we will look into the past to get 100
 		if (entriesNeeded + factoidsNeeded) > 0 {
-			getPastEntries(last, entriesNeeded, factoidsNeeded)
+			cp.getPastEntries(v, last, entriesNeeded, factoidsNeeded)
 		} else {
-			RecentTransactions.LastHeightChecked = last.GetHeader().GetDBHeight()
+			cp.RecentTransactions.LastHeightChecked = last.GetHeader().GetDBHeight()
 		}
 	}
 
-	if len(RecentTransactions.Entries) > 100 {
-		overflow := len(RecentTransactions.Entries) - 100
+	if len(cp.RecentTransactions.Entries) > 100 {
+		overflow := len(cp.RecentTransactions.Entries) - 100
 		if overflow > 0 {
-			RecentTransactions.Entries = RecentTransactions.Entries[overflow:]
+			cp.RecentTransactions.Entries = cp.RecentTransactions.Entries[overflow:]
 		}
 	}
-	if len(RecentTransactions.FactoidTransactions) > 100 {
-		overflow := len(RecentTransactions.FactoidTransactions) - 100
+	if len(cp.RecentTransactions.FactoidTransactions) > 100 {
+		overflow := len(cp.RecentTransactions.FactoidTransactions) - 100
 		if overflow > 0 {
-			RecentTransactions.FactoidTransactions = RecentTransactions.FactoidTransactions[overflow:]
+			cp.RecentTransactions.FactoidTransactions = cp.RecentTransactions.FactoidTransactions[overflow:]
 		}
 	}
 
 	// Check if we missed any processing
-	for i, e := range RecentTransactions.Entries {
+	for i, e := range cp.RecentTransactions.Entries {
 		if e.ChainID == "Processing" {
-			entry := getEntry(e.Hash)
+			entry := cp.getEntry(e.Hash)
 			if entry != nil {
-				RecentTransactions.Entries[i] = *entry
+				cp.RecentTransactions.Entries[i] = *entry
 			}
 		}
 	}
@@ -702,7 +746,7 @@ func getRecentTransactions(time.Time) {
 // Control Panel shows the last 100 entry and factoid transactions. This will look into the past if we do not
 // currently have 100 of each transaction type. A checkpoint is set each time we check a new height, so we will
 // not check a directory block in the past twice.
-func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
+func (cp *ControlPanel) getPastEntries(v *view, last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 	height := last.GetHeader().GetDBHeight()
 
 	next := last.GetHeader().GetPrevKeyMR()
@@ -710,13 +754,13 @@ func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 
 	newCheckpoint := height
 
-	for height > RecentTransactions.LastHeightChecked && (eNeeded > 0 || fNeeded > 0) {
+	for height > cp.RecentTransactions.LastHeightChecked && (eNeeded > 0 || fNeeded > 0) {
 		if next.IsSameAs(zero) {
 			break
 		}
-		dbase := StatePointer.GetAndLockDB()
+		dbase := v.State.GetAndLockDB()
 		dblk, err := dbase.FetchDBlock(next)
-		StatePointer.UnlockDB()
+		v.State.UnlockDB()
 		if err != nil || dblk == nil {
 			break
 		}
@@ -724,20 +768,20 @@ func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 		ents := dblk.GetDBEntries()
 		if len(ents) > 3 && eNeeded > 0 {
 			for _, eblock := range ents[3:] {
-				dbase := StatePointer.GetAndLockDB()
+				dbase := v.State.GetAndLockDB()
 				eblk, err := dbase.FetchEBlock(eblock.GetKeyMR())
-				StatePointer.UnlockDB()
+				v.State.UnlockDB()
 				if err != nil || eblk == nil {
 					break
 				}
 				for _, hash := range eblk.GetEntryHashes() {
-					if RecentTransactions.ContainsEntry(hash) {
+					if cp.RecentTransactions.ContainsEntry(hash) {
 						continue
 					}
-					e := getEntry(hash.String())
+					e := cp.getEntry(hash.String())
 					if e != nil && eNeeded > 0 {
 						eNeeded--
-						RecentTransactions.Entries = append(RecentTransactions.Entries, *e)
+						cp.RecentTransactions.Entries = append(cp.RecentTransactions.Entries, *e)
 						//RecentTransactions.Entries = append([]EntryHolder{*e}, RecentTransactions.Entries...)
 					}
 				}
@@ -747,15 +791,15 @@ func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 			fChain := primitives.NewHash(constants.FACTOID_CHAINID)
 			for _, entry := range ents {
 				if entry.GetChainID().IsSameAs(fChain) {
-					dbase := StatePointer.GetAndLockDB()
+					dbase := v.State.GetAndLockDB()
 					fblk, err := dbase.FetchFBlock(entry.GetKeyMR())
-					StatePointer.UnlockDB()
+					v.State.UnlockDB()
 					if err != nil || fblk == nil {
 						break
 					}
 					transList := fblk.GetTransactions()
 					for _, trans := range transList {
-						if RecentTransactions.ContainsTrans(trans.GetSigHash()) {
+						if cp.RecentTransactions.ContainsTrans(trans.GetSigHash()) {
 							continue
 						}
 						if trans != nil {
@@ -768,7 +812,7 @@ func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 							totalOutputs = totalOutputs + len(trans.GetOutputs())
 							inputStr := fmt.Sprintf("%f", float64(input)/1e8)
 							fNeeded--
-							RecentTransactions.FactoidTransactions = append(RecentTransactions.FactoidTransactions, struct {
+							cp.RecentTransactions.FactoidTransactions = append(cp.RecentTransactions.FactoidTransactions, struct {
 								TxID         string
 								Hash         string
 								TotalInput   string
@@ -784,11 +828,9 @@ func getPastEntries(last interfaces.IDirectoryBlock, eNeeded int, fNeeded int) {
 		next = dblk.GetHeader().GetPrevKeyMR()
 	}
 
-	DisplayStateMutex.Lock()
-	if newCheckpoint < DisplayState.CurrentEBDBHeight && newCheckpoint > RecentTransactions.LastHeightChecked {
-		RecentTransactions.LastHeightChecked = newCheckpoint
+	if newCheckpoint < v.Display.CurrentEBDBHeight && newCheckpoint > cp.RecentTransactions.LastHeightChecked {
+		cp.RecentTransactions.LastHeightChecked = newCheckpoint
 	}
-	DisplayStateMutex.Unlock()
 }
 
 // For go routines. Calls function once each duration.
@@ -798,8 +840,8 @@ func doEvery(d time.Duration, f func(time.Time)) {
 	}
 }
 
-func checkControlPanelPassword(response http.ResponseWriter, request *http.Request) bool {
-	if false == checkAuthHeader(request) {
+func (cp *ControlPanel) checkControlPanelPassword(response http.ResponseWriter, request *http.Request) bool {
+	if false == cp.checkAuthHeader(request) {
 		remoteIP := ""
 		remoteIP += strings.Split(request.RemoteAddr, ":")[0]
 		fmt.Printf("Unauthorized Control Panel client connection attempt from %s\n", remoteIP)
@@ -810,8 +852,9 @@ func checkControlPanelPassword(response http.ResponseWriter, request *http.Reque
 	return true
 }
 
-func checkAuthHeader(r *http.Request) bool {
-	if "" == StatePointer.GetRpcUser() {
+func (cp *ControlPanel) checkAuthHeader(r *http.Request) bool {
+	st := cp.view().State
+	if "" == st.GetRpcUser() {
 		//no username was specified in the config file or command line, meaning factomd control panel is open access
 		return true
 	}
@@ -821,7 +864,7 @@ func checkAuthHeader(r *http.Request) bool {
 		return false
 	}
 
-	correctAuth := StatePointer.GetRpcAuthHash()
+	correctAuth := st.GetRpcAuthHash()
 
 	h := sha256.New()
 	h.Write([]byte(authhdr[0]))
@@ -0,0 +1,134 @@
+package controlPanel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// The dashboard socket pushes the main page data to the browser, in place of
+// the page polling /factomd and /factomdBatch. Every interval the items the page
+// subscribed to are queried, and only the items that changed since the last
+// push are sent, as a json object of item name to value.
+
+// Time between checks for changed items
+var DashboardPushInterval time.Duration = 1 * time.Second
+
+// Items a page can subscribe to. These are the item names of factomdQuery.
+var DashboardItems = map[string]bool{
+	"myHeight":           true,
+	"leaderHeight":       true,
+	"completeHeight":     true,
+	"servercount":        true,
+	"channelLength":      true,
+	"peers":              true,
+	"peerTotals":         true,
+	"recentTransactions": true,
+	"dataDump":           true,
+}
+
+// Items pushed until the page subscribes to others
+var DefaultDashboardItems = []string{"myHeight", "leaderHeight", "completeHeight", "servercount", "channelLength", "peers", "peerTotals", "recentTransactions"}
+
+// Sent by the page to replace the items it is pushed
+type DashboardSubscription struct {
+	Items []string
+}
+
+func (cp *ControlPanel) dashboardSocket(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in DashboardSocket.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+	server := websocket.Server{
+		Handshake: checkSocketOrigin,
+		Handler:   cp.dashboardSocketHandler,
+	}
+	server.ServeHTTP(w, r)
+}
+
+// Browsers open websockets across origins, so only the panel's own pages may connect
+func checkSocketOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	if origin == nil || origin.Host != r.Host {
+		return fmt.Errorf("websocket origin %v is not allowed", origin)
+	}
+	config.Origin = origin
+	return nil
+}
+
+func (cp *ControlPanel) dashboardSocketHandler(ws *websocket.Conn) {
+	defer ws.Close()
+
+	subscriptions := make(chan []string, 1)
+	go readDashboardSubscriptions(ws, subscriptions)
+
+	items := DefaultDashboardItems
+	sent := make(map[string][]byte)
+	ticker := time.NewTicker(DashboardPushInterval)
+	defer ticker.Stop()
+	for {
+		if diff := cp.dashboardDiff(items, sent); len(diff) > 0 {
+			if err := websocket.JSON.Send(ws, diff); err != nil {
+				return
+			}
+		}
+		select {
+		case s, ok := <-subscriptions:
+			if !ok {
+				return
+			}
+			items = s
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reads subscriptions from the page until the socket is closed
+func readDashboardSubscriptions(ws *websocket.Conn, subscriptions chan []string) {
+	defer close(subscriptions)
+	for {
+		sub := new(DashboardSubscription)
+		if err := websocket.JSON.Receive(ws, sub); err != nil {
+			return
+		}
+		items := make([]string, 0, len(sub.Items))
+		for _, item := range sub.Items {
+			if DashboardItems[item] {
+				items = append(items, item)
+			}
+		}
+		// Only the latest subscription matters
+		select {
+		case <-subscriptions:
+		default:
+		}
+		subscriptions <- items
+	}
+}
+
+// Returns the items whose value differs from the value last sent, and records
+// them as sent
+func (cp *ControlPanel) dashboardDiff(items []string, sent map[string][]byte) map[string]json.RawMessage {
+	diff := make(map[string]json.RawMessage)
+	for _, item := range items {
+		data := apiRawJson(cp.factomdQuery(item, ""))
+		if last, ok := sent[item]; ok && bytes.Equal(last, data) {
+			continue
+		}
+		sent[item] = data
+		diff[item] = data
+	}
+	return diff
+}
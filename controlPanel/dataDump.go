@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dd "github.com/FactomProject/factomd/controlPanel/dataDumpFormatting"
+	"github.com/FactomProject/factomd/state"
 )
 
 type DataDump struct {
@@ -30,26 +31,28 @@ type DataDump struct {
 	}
 }
 
-func GetDataDumps() []byte {
+func (cp *ControlPanel) GetDataDumps() []byte {
+	return cp.dataDumps(cp.view().Display)
+}
+
+// The display state is shared, not copied, as nothing changes it once it is shown
+func (cp *ControlPanel) dataDumps(ds *state.DisplayState) []byte {
 	holder := new(DataDump)
-	DisplayStateMutex.RLock()
-	DsCopy := DisplayState.Clone()
-	DisplayStateMutex.RUnlock()
 
 	holder.DataDump1.ShortDump = "Currently disabled"
-	holder.DataDump1.RawDump = DsCopy.RawSummary
+	holder.DataDump1.RawDump = ds.RawSummary
 
-	holder.DataDump2.RawDump = DsCopy.ProcessList
-	holder.DataDump2.PrevDump = DsCopy.ProcessList2
+	holder.DataDump2.RawDump = ds.ProcessList
+	holder.DataDump2.PrevDump = ds.ProcessList2
 
-	holder.DataDump3.RawDump = DsCopy.PrintMap
+	holder.DataDump3.RawDump = ds.PrintMap
 
-	holder.DataDump4.Authorities = dd.Authorities(*DsCopy)
-	holder.DataDump4.Identities = dd.Identities(*DsCopy)
-	holder.DataDump4.MyNode = dd.MyNodeInfo(*DsCopy)
+	holder.DataDump4.Authorities = dd.Authorities(*ds)
+	holder.DataDump4.Identities = dd.Identities(*ds)
+	holder.DataDump4.MyNode = dd.MyNodeInfo(*ds)
 
-	holder.DataDump5.RawDump = AllConnectionsString()
-	holder.DataDump5.SortedDump = SortedConnectionString()
+	holder.DataDump5.RawDump = cp.AllConnectionsString()
+	holder.DataDump5.SortedDump = cp.SortedConnectionString()
 
 	ret, err := json.Marshal(holder)
 	if err != nil {
@@ -58,8 +61,8 @@ func GetDataDumps() []byte {
 	return ret
 }
 
-func SortedConnectionString() string {
-	arr := AllConnections.SortedConnections()
+func (cp *ControlPanel) SortedConnectionString() string {
+	arr := cp.AllConnections.SortedConnections()
 	str := ""
 	for _, con := range arr {
 		str += fmt.Sprintf("Connected: %v, Hash:%s, State: %s\n", con.Connected, con.Hash[:8], con.Connection.ConnectionState)
@@ -67,10 +70,10 @@ func SortedConnectionString() string {
 	return str
 }
 
-func AllConnectionsString() string {
+func (cp *ControlPanel) AllConnectionsString() string {
 	str := ""
-	con := AllConnections.GetConnectedCopy()
-	dis := AllConnections.GetDisconnectedCopy()
+	con := cp.AllConnections.GetConnectedCopy()
+	dis := cp.AllConnections.GetDisconnectedCopy()
 	for key := range con {
 		str += fmt.Sprintf("   Connected - IP:%s, ST:%s\n", con[key].PeerAddress, con[key].ConnectionState)
 	}
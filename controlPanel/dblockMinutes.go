@@ -0,0 +1,158 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/state"
+)
+
+// The minute breakdown of a directory block lists what was processed in each
+// minute and by which VM, with the time the leader acknowledged it and the EOM
+// that ended the minute. The VMs are only known while state keeps a summary of
+// the block's process list; for older blocks the entries are grouped by the
+// minute markers of their entry blocks instead.
+
+type DBlockMinuteItem struct {
+	VM         int // -1 if not known
+	Type       string
+	Hash       string
+	SearchType string // Search type of Hash, if it can be searched for
+	Time       string // When the leader acknowledged it
+}
+
+type DBlockMinute struct {
+	Minute int
+	Items  []DBlockMinuteItem
+	EOMs   []DBlockMinuteItem
+}
+
+type DBlockMinutes struct {
+	DBHeight        uint32
+	KeyMR           string
+	FromProcessList bool // False if only the entry blocks were available
+	VMCount         int
+	Minutes         [10]DBlockMinute
+}
+
+func (cp *ControlPanel) dblockMinutesHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in DBlockMinutesHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	height, ok := ParseHeight(CleanSearchInput(r.FormValue("height")))
+	var minutes *DBlockMinutes
+	if ok {
+		minutes = cp.GetDBlockMinutes(height)
+	}
+
+	var err error
+	if minutes == nil {
+		err = cp.templates.ExecuteTemplate(w, "dblockMinutesNotFound", EscapeHTML(r.FormValue("height")))
+	} else {
+		err = cp.templates.ExecuteTemplate(w, "dblockMinutesPage", minutes)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/dblockminutes?height=<height>
+func (cp *ControlPanel) apiDBlockMinutesHandler(w http.ResponseWriter, r *http.Request) {
+	height, ok := ParseHeight(CleanSearchInput(r.FormValue("height")))
+	if !ok {
+		http.Error(w, "invalid height", http.StatusBadRequest)
+		return
+	}
+	minutes := cp.GetDBlockMinutes(height)
+	if minutes == nil {
+		http.NotFound(w, r)
+		return
+	}
+	writeApiResponse(w, minutes)
+}
+
+// GetDBlockMinutes returns the minute breakdown of the saved directory block
+// at the height, or nil if there is none
+func (cp *ControlPanel) GetDBlockMinutes(height uint32) *DBlockMinutes {
+	st := cp.view().State
+	dbase := st.GetAndLockDB()
+	dblk, err := dbase.FetchDBlockByHeight(height)
+	st.UnlockDB()
+	if err != nil || dblk == nil {
+		return nil
+	}
+
+	minutes := new(DBlockMinutes)
+	minutes.DBHeight = height
+	minutes.KeyMR = dblk.GetKeyMR().String()
+	for i := range minutes.Minutes {
+		minutes.Minutes[i].Minute = i
+	}
+
+	if bm := st.GetBlockMinutes(height); bm != nil {
+		minutes.FromProcessList = true
+		minutes.VMCount = bm.VMCount
+		for i, m := range bm.Minutes {
+			for _, msg := range m.Messages {
+				minutes.Minutes[i].Items = append(minutes.Minutes[i].Items, processedMessageItem(msg))
+			}
+			for _, eom := range m.EOMs {
+				minutes.Minutes[i].EOMs = append(minutes.Minutes[i].EOMs, processedMessageItem(eom))
+			}
+		}
+		return minutes
+	}
+
+	// The first three are the admin, entry credit and factoid blocks
+	ents := dblk.GetDBEntries()
+	if len(ents) <= 3 {
+		return minutes
+	}
+	for _, ent := range ents[3:] {
+		dbase := st.GetAndLockDB()
+		eblk, err := dbase.FetchEBlock(ent.GetKeyMR())
+		st.UnlockDB()
+		if err != nil || eblk == nil {
+			continue
+		}
+		var pending []DBlockMinuteItem
+		for _, hash := range eblk.GetBody().GetEBEntries() {
+			if !hash.IsMinuteMarker() {
+				pending = append(pending, DBlockMinuteItem{VM: -1, Type: "Entry", Hash: hash.String(), SearchType: "entry"})
+				continue
+			}
+			// Marker n ends minute n-1
+			minute := int(hash.Bytes()[31]) - 1
+			if minute < 0 || minute >= len(minutes.Minutes) {
+				continue
+			}
+			minutes.Minutes[minute].Items = append(minutes.Minutes[minute].Items, pending...)
+			pending = nil
+		}
+	}
+	return minutes
+}
+
+func processedMessageItem(msg state.ProcessedMessage) DBlockMinuteItem {
+	item := DBlockMinuteItem{VM: msg.VM, Type: msg.Type, Hash: msg.Hash}
+	switch {
+	case msg.Type == "Reveal Entry":
+		item.Hash, item.SearchType = msg.EntryHash, "entry"
+	case msg.EntryHash != "":
+		item.Hash, item.SearchType = msg.EntryHash, "entryack"
+	case msg.TxID != "":
+		item.Hash, item.SearchType = msg.TxID, "facttransaction"
+	}
+	if msg.Timestamp > 0 {
+		item.Time = time.Unix(0, msg.Timestamp*int64(time.Millisecond)).Format("15:04:05.000")
+	}
+	return item
+}
@@ -0,0 +1,176 @@
+package controlPanel
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+)
+
+// Decodes entry content for the search results, so known chain formats are
+// shown as typed fields rather than escaped bytes.
+// The strings in an EntryContentView are not html escaped; the json api serves
+// them as they are, and the entry template escapes them.
+
+const anchorChainID = "df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604"
+
+// Names found in the second external id of identity chain entries
+var identityEntryTypes = []string{
+	"Identity Chain",
+	"Register Factom Identity",
+	"Server Management",
+	"Register Server Management",
+	"New Block Signing Key",
+	"New Bitcoin Key",
+	"New Matryoshka Hash",
+}
+
+type EntryField struct {
+	Name  string
+	Value string
+}
+
+type EntryContentView struct {
+	Format string // json, anchor, fer, identity, text or binary
+	Pretty string
+	Fields []EntryField
+	Hex    string
+	Base64 string
+}
+
+// DecodeEntryContent detects the format of an entry and returns its decoded views
+func (cp *ControlPanel) DecodeEntryContent(chainID string, extIDs [][]byte, content []byte) *EntryContentView {
+	view := new(EntryContentView)
+	view.Hex = hex.EncodeToString(content)
+	view.Base64 = base64.StdEncoding.EncodeToString(content)
+
+	node := cp.view()
+	switch {
+	case chainID == anchorChainID && decodeAnchorEntry(view, content):
+	case node != nil && node.State != nil && chainID == node.State.FERChainId && decodeFEREntry(view, content):
+	case decodeIdentityEntry(view, extIDs):
+		view.Pretty = string(content)
+	case decodeJSONEntry(view, content):
+	case isPrintable(content):
+		view.Format = "text"
+		view.Pretty = string(content)
+	default:
+		view.Format = "binary"
+		view.Pretty = view.Hex
+	}
+	return view
+}
+
+func decodeJSONEntry(view *EntryContentView, content []byte) bool {
+	var out bytes.Buffer
+	if err := json.Indent(&out, bytes.TrimSpace(content), "", "    "); err != nil {
+		return false
+	}
+	view.Format = "json"
+	view.Pretty = out.String()
+	return true
+}
+
+// Anchor records are json followed by the hex signature of the json
+func decodeAnchorEntry(view *EntryContentView, content []byte) bool {
+	end := bytes.LastIndex(content, []byte("}"))
+	if end < 0 {
+		return false
+	}
+	ar, err := anchor.UnmarshalAnchorRecord(content[:end+1])
+	if err != nil {
+		return false
+	}
+	view.Format = "anchor"
+	view.Fields = append(view.Fields,
+		EntryField{"Anchor Record Version", fmt.Sprintf("%d", ar.AnchorRecordVer)},
+		EntryField{"Directory Block Height", fmt.Sprintf("%d", ar.DBHeight)},
+		EntryField{"Directory Block KeyMR", ar.KeyMR},
+		EntryField{"Record Height", fmt.Sprintf("%d", ar.RecordHeight)})
+	if ar.Bitcoin != nil {
+		view.Fields = append(view.Fields,
+			EntryField{"Bitcoin Address", ar.Bitcoin.Address},
+			EntryField{"Bitcoin TXID", ar.Bitcoin.TXID},
+			EntryField{"Bitcoin Block Height", fmt.Sprintf("%d", ar.Bitcoin.BlockHeight)},
+			EntryField{"Bitcoin Block Hash", ar.Bitcoin.BlockHash})
+	}
+	if ar.Ethereum != nil {
+		view.Fields = append(view.Fields,
+			EntryField{"Ethereum Address", ar.Ethereum.Address},
+			EntryField{"Ethereum TXID", ar.Ethereum.TXID},
+			EntryField{"Ethereum Block Height", fmt.Sprintf("%d", ar.Ethereum.BlockHeight)},
+			EntryField{"Ethereum Block Hash", ar.Ethereum.BlockHash})
+	}
+	if end+1 < len(content) {
+		view.Fields = append(view.Fields, EntryField{"Signature", string(content[end+1:])})
+	}
+	if !decodeJSONEntry(view, content[:end+1]) {
+		view.Pretty = string(content)
+	}
+	view.Format = "anchor"
+	return true
+}
+
+func decodeFEREntry(view *EntryContentView, content []byte) bool {
+	fer := new(specialEntries.FEREntry)
+	if err := json.Unmarshal(content, fer); err != nil {
+		return false
+	}
+	view.Fields = append(view.Fields,
+		EntryField{"Version", fer.Version},
+		EntryField{"Expiration Height", fmt.Sprintf("%d", fer.ExpirationHeight)},
+		EntryField{"Resident Height", fmt.Sprintf("%d", fer.ResidentHeight)},
+		EntryField{"Target Activation Height", fmt.Sprintf("%d", fer.TargetActivationHeight)},
+		EntryField{"Priority", fmt.Sprintf("%d", fer.Priority)},
+		EntryField{"Target Price", fmt.Sprintf("%d factoshis per EC", fer.TargetPrice)})
+	decodeJSONEntry(view, content)
+	view.Format = "fer"
+	return true
+}
+
+// Identity entries are identified by their external ids: a single 0x00 byte
+// followed by the type of the entry
+func decodeIdentityEntry(view *EntryContentView, extIDs [][]byte) bool {
+	if len(extIDs) < 2 || !bytes.Equal(extIDs[0], []byte{0x00}) {
+		return false
+	}
+	entryType := string(extIDs[1])
+	known := false
+	for _, t := range identityEntryTypes {
+		if t == entryType {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return false
+	}
+	view.Format = "identity"
+	view.Fields = append(view.Fields, EntryField{"Identity Entry Type", entryType})
+	for i, ext := range extIDs[2:] {
+		value := hex.EncodeToString(ext)
+		if isPrintable(ext) {
+			value = string(ext)
+		}
+		view.Fields = append(view.Fields, EntryField{fmt.Sprintf("External ID %d", i+2), value})
+	}
+	return true
+}
+
+func isPrintable(data []byte) bool {
+	for _, r := range string(data) {
+		if r == utf8.RuneError {
+			return false
+		}
+		if r < 0x20 && !strings.ContainsRune("\n\r\t", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package controlPanel_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/FactomProject/factomd/controlPanel"
+)
+
+func TestDecodeEntryContentJSON(t *testing.T) {
+	view := new(ControlPanel).DecodeEntryContent("", nil, []byte(`{"a":1,"b":"<script>"}`))
+	if view.Format != "json" {
+		t.Errorf("Expected json, found %s", view.Format)
+	}
+	if !strings.Contains(view.Pretty, "\n") {
+		t.Error("Json content was not indented")
+	}
+	if !strings.Contains(view.Pretty, "<script>") {
+		t.Error("Json content should not be escaped until it is put in a page")
+	}
+}
+
+func TestDecodeEntryContentTextAndBinary(t *testing.T) {
+	view := new(ControlPanel).DecodeEntryContent("", nil, []byte("hello <world>"))
+	if view.Format != "text" {
+		t.Errorf("Expected text, found %s", view.Format)
+	}
+	if view.Pretty != "hello <world>" {
+		t.Errorf("Text content should be as it is - %s", view.Pretty)
+	}
+
+	view = new(ControlPanel).DecodeEntryContent("", nil, []byte{0x00, 0xff, 0x10})
+	if view.Format != "binary" {
+		t.Errorf("Expected binary, found %s", view.Format)
+	}
+	if view.Hex != "00ff10" || view.Pretty != "00ff10" {
+		t.Errorf("Binary content should be shown as hex - %s", view.Pretty)
+	}
+	if view.Base64 != "AP8Q" {
+		t.Errorf("Wrong base64 - %s", view.Base64)
+	}
+}
+
+func TestDecodeEntryContentAnchor(t *testing.T) {
+	content := `{"AnchorRecordVer":1,"DBHeight":5,"KeyMR":"abcd","RecordHeight":6,"Bitcoin":{"Address":"1HLo","TXID":"9b0f","BlockHeight":345678,"BlockHash":"0000","Offset":87}}` + "a1b2c3"
+	view := new(ControlPanel).DecodeEntryContent("df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604", nil, []byte(content))
+	if view.Format != "anchor" {
+		t.Fatalf("Expected anchor, found %s", view.Format)
+	}
+	found := false
+	for _, f := range view.Fields {
+		if f.Name == "Bitcoin Block Height" && f.Value == "345678" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Anchor fields were not decoded")
+	}
+}
+
+func TestDecodeEntryContentIdentity(t *testing.T) {
+	extIDs := [][]byte{{0x00}, []byte("New Block Signing Key"), {0x01, 0x02}}
+	view := new(ControlPanel).DecodeEntryContent("", extIDs, []byte{})
+	if view.Format != "identity" {
+		t.Fatalf("Expected identity, found %s", view.Format)
+	}
+	if len(view.Fields) != 2 || view.Fields[1].Value != "0102" {
+		t.Errorf("Identity external ids were not decoded - %v", view.Fields)
+	}
+}
@@ -143,11 +143,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  0,
 	},
 	"js/controlPanel.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\\[s\xdb8\xb2~\u05ef\xe8a\xb2+r,Qr2\x93:gm\xb9ʎ';\xde\xc9mb\x9f=\x0f9~\x80HHBB\x02\f\x00\xdaVM\xfc\xdfO\xe1B\x12\xa0H]ffS\xfb\xb0S5\xb1\x05t\u007f\xdd\xe8n4\x1a\x17\xf9\x0eqHJ\xce1\x95?c\xb2\\I\x98\xc1t\xa0Z3\x8cR̝Ɓ\xc0\xf2\x8aJ\xcc\xefP\x16\x96E\x8a$\xfe\xf9\xe6\xcd\xeb\xd1\xf3\xe9t\x1a\x9dh\x1e\x81\xf9\x1d\xe6\xefhF(\x86\x19,P&\xf0`2\x81\xff\x118\x05\xc9\xc0p\x81`9\x06\xb9\"t) \xc3B\xc0\x82\xe3/%\xa62[\x1b\x98Ϥ\xa8$\xd50\x83\xa7\xe1=\xa1)\xbb\x8f⌡4\x1c\x00\x00,J\x9aH\xc2h\x18\xc1o\xba\x01\xa0\xd1,\x8cl\x93\xc0\xf2\x86䘕2\xac\x18\xc0\xe1\xe8\xe5{\x1c\xc1\xb1\x19\x9c\xfe4\x88N\x06\x83\x1a\xc0\xa5\xd7POc\xf4\t=\x84\xc3x2\x1cYlQ&\t\x16\xe2o\x8e\x9e\xbf\xd5:y\xa6\x92\xbc\xc4F\xcaH\xff\xc0\x9c3\xbe\a\x9f\xb1\x8dQ\x0f\xe0Qi\b@\x16\x10~\xe7\x12Vc}\x1a\x06OL\xfbXH$K\x11D\xb1\xc4\x0f2\f^\xa1D\xb2<\x85\xb7L\u0087\x92RB\x97\x811\x03ǲ\xe4T\x81\x03\xce\x04\xde\x1b\xc9Ey\xac\xb4Rl\x84\xa6\xf8\x81\xa2\xbbq\x8e\b\r\xa2x\x85\xc4\xcb\f\t\x11\x06D\x8cQ\"\xc9\x1d\x0e\xa2J\xe3\xc9\x04\xde B\xe1\x06\xcd\a\x8e\x97tT\x86\x118m\xe7Y\xf6\x1ec.\xac\xf7&\x13\xb8dX\x00\xbe\xc3|\r\x882\xb9\xc2\x1c\x92u\x92\x19s\x91E\xf8\x9d\x1bg\x91\x1f?7\x1cQ\x81\xb4\xedE\x13G~\\6>s-\x03\xdd\xe1[\xbb\xc8ВE\xcb\x16\x8c\xe3=lq\x89%\"\x19N}{\xa0K\xf5\u007f\x99\x17F\xd5\xc7\xc1\xe0q\xa0\xa7\x9d\x99n\xf7+L\xe1\x1e\x83\xb8'2Y\x81Ds1x\x1a\x06\xb1\xfae\x9c0*9\xcb\xc6\x05\xa28\x83\x8c\x00\n\xa28\xc9H\xf29\xf4\x83ϙD\x03o\xe2\r\xc0\xeaךA\x8f#x>\x9dF\x83\xc7H\xcd\xdd\xe0IZ\xe6\x85\x16\x87\b\xc5\x1c\x9e,\xca,\x13\tǘ\x8eY\xa1\xb0j\xc1\xad\xb0\x97\x0f\xf2\x9cc\x043\xf8\xf4k\x89\xf9:\x94+\"\xa2X\x90y\xa6RH\x18Ď\xb1\x1a\xfaX\xb2\xe52\xc3֞\x8d4M\xe3!y\x84h.XVJ<\xee\xd0o+\xe3\x82<ഓKY@\xf9\xe3\x86\x15\xda\xfa\xc0(h\xcf\x0f6\xe6\x03\x9cu:@[XM O|o\xb4t\xcdV\xe9Dt\x10\xc5\x1c\xe7\xec\xaeR}ERl\xa7\xbb\"\xcdX\x82\xb2\x1d4\xa9\r\xb9 \x8aQ\x9a\xb6i\x1ek\xaf{\x11\xfe\xcdFס\x92?\xb4^\x02g\\\xdd\xc37C\xf3\x97\x01w\x02j\xa58\x16\x05\xcc\xe0\x8b\x1aεD\x12\x87A\r<\x82 \x18ՃW\x946\xf7\xb0\xf9'\x98\xc1?\xae\u07fd\x8d\v\xc4\x056}\x8dfe^\x1c\x83\xfeq\xbdb\\V\x19\x97\xcd?ŕ\xfc\xe3Xw\xa9_;\x19?\xa0\xfbn\xb6\x0f\xe8\xde0y\\϶r=\xeb\xe1z\xbe\x95\xeby\x0f\xd7\x0f\x86뼔\xab.\xb6\x1fb\xd5\xc38\x91\x04\x8b\xa8\x8b\xf3*\xc5Tv\xb3\xea\xae~\xce7\xeb\xb7Ly\xb7\x8b\xd5\xf4\xb5t\xfd\xd1\xf0\xbdd\xb4g\x90?6\x83\xec\xe0\xbb\xee\xf1ޏ\xb1\xea\xc1i\xc5\xf8\x18\xa9Dު8\xfc\x95\xa9/\xda8N0\x95.m0:<\xec&\x13\xbbN]^\\d,\xf9l\xd6\xddJ\xf5\b\xbe\x9b\x81֟p\x9cH\xc6ך(\xbe\xbc0tM\x85e ~\xc1\xeb7\x1fl\nh\xc6\xee\xf3j\x9a\xc8c\xbb`\xe9Z7oa\xabi|\xd6We\x96\xfd\x8c\xc4j\vgEҒ\xa9\xfa\xd4r'$ҹ\xa0\x8f\xbd\xa6\xe9\xe0\xf7\xad\xb5\xcdP\x0e\xafq\xdc8\xad(\xc7sE\xba\x17\x88E!\vM\xa6\xeb0\x92\xba!\xa0\xfcEˬ\xa9u\xb4\xf3\xbb(\xe3\x05\xe3?\xa1d\xd5$i\x9d`\xfd\x9a\x99,Lk|\xc3$ʮhQJ8\x83i<\x9dN\x8f\xdbյ\x8d\xa3\x02Q+M\xc0\x19\xa8\xac\xfd\xf0\x9a\b\xc5&\xe7,]Ó\x00\x8e\xc0\x82>\\]Fq\x86\xe9R\xae\x14\xac\xcd\xfc\xcd\u007f\xad\xaa\xab\xfao\x0f1A\x14\x17\x1c\x17\x98\xa6a\xf0\u007f-\xf6SɁ\xa4\xb3\xa1\xaf\b\x1cA0<k\xd3\x1a\xfa\xf4\xec\x14i\x96\x85\xae|\xc7\x02#\x9e\xac\xc6\x19\xa1\x9f\x87 \xd7\x05\xb6=$E\xc9\xe7\xe1\xd9&\xf0\xe9\x04\x9d\x9dNdڋ\xef\xb04\x96\u058c\a2\x89C\xb9ޕr+\xdb\xe9D\xf2\xb3 j\xb5V\x95\xfe.o\x9f\x81\xe4\x81\xe3\xe3\xe3\xe94\xda\xf0\xe7^\x1e\x853\x83\x84\x84\f\xab\x85;\xb4\xfb67b\xfc\x00\x1at\xfd\xfeX\xef\xfe\xfc\xf9\xf4\x13\x95\x9c\xe0\xbe9d{7\xe7\r\xa6\x92\xaf\xfdQ\xe9JP\xa2l\xe0\x0f\xd1\xce|\xcd0\x96\x8a\xa0\xdeS\x85\xca-\xd6\f\x95\x1e\xbb\rz\x04A\xe4\xf9\xc6\xf1K\x85\xa2'\x9c\x16\x19\xeb,ؚp\x1dS88jȕ\bx\x92\xac\x10\xa1W\x97M^\xd7\v\x83\xa1zi\xfa\xa2\xcei\xba\a\x94\x8f\xd2\xeb\xbefl\xfb\xaa\x17\xbc\xe7L\xed\xcd\xf5\x16uO\xed\x8ck\xf4\xbf+ը\x10\x91\x94<\f\xd44W\x85\x9d\xee\v\xb6\xeb٣&N\x12&d\x87\t\u007fz\xf9\x92\t\xb9\xb7\x8e\x1e\x8c\x87\xd0\x1f\xfc]\x99tw\xb8\xf5\xa7Q7\x89\xfa\nv%\xd1S\x99j\xea\x96y\x87\xbb\U000ea9ad\xb3\xaa/iKV\xad\x04\xda\xc8\xd8C\x90\xa6\\a\x94\xba\x92lT\u009eҌg\\\x00㗞\xe4ڕZ;&\xf0\xefΫ\xfb\xe0\xecN\xaa;s\xe8\xa03\xe79\xf9ή\x8d[2\xde\x01kH\x9d\xf2L\xe9<\x99\xc0sP{J\x82\xb9\x00B\xe1\x02\xc9d\xb5q\x86W\x9d&9\xb5\xf4\\\x11\xfe\xea\x14\xd4\xf9ڐ\x8d\xdcs\xd1Q\xc2\xf2\"\xc3\x15\xc4Ȝ\x88%\xac\xa4r\x94\xac\x10\xa58{\xad\x15;\xb8\xf2\xaeā\xae\xb0?Noc\xf3Ywf^߱ק4\xf2\xba\x9fy\xdd\v\x9c\n\xdb\xf1\xfc6^\xe0T\xb7\xa2\xd2mEejO\xfeD\xf1\x8a\xdca\xdb\xf3í\xb5\xf2\xa0u\x02\xb8\xc0\xa9\x1er\x10\xc5w(\v\x95\x88\xa8E\x82J\x8fDɳ\xf5j\xfb\xf0Y\x1b\xe2\x8aʰ\xb2@\x03EY\x8a\xeb\x9aZ\xc14$\xc6,\xfe\x89u\x8d\x94\xb9@\xc6\xe5\xef9[r,\xc4\x05\xe2J\xc75M^\x11\xae\xa3*.l\xd78\xc7\x12\xf3`\xe4k8\xf2\xa4\x18\xc8\x02s\x15\xc9\xfa\x90ܦx_\x95\x99\xbb\x986\xd4\xc7\xd3i\xd7\xf1aC\x10z\xa2'\x9ed\xf8\xbe\xe6wY\xde \xb9\x8a\x17\x19c<\xb4\x8dѠ\x99\x9bO\xc3\xe1\xb6\xc1n\xb6\x8c\xd5l\x1c\xdaIYI9\x82\xe0/p\xbd\xa6\tNA\xcfS߇j\x19b\vP\x1d\x9e\x19\xecܴ\x1b\xcbN\x876\xf1\xefO,כM\x80ow\xe85N\x18M\xbb=\xea\xcf\xda~\x97Z\x8c?ױ5h\xe8\xeb\xb1ۿ5禗MW\x97\xaf\xfb찟\xb3-\xf7\xa6\xcb}\xff\xf4\xfa\xdcu9\\\x12Qd\xc8dTxi\xf2#\u061cbI\x12F\x05\xcbp\x9c\xb1e\x18(\x120\t\xf4o\xc1\xa8\xceG\xbdG#n\x10\x90\xb4\x9e\xb9#\xc8\xd1Cu\xc8\x18\xe6\xe8\xc1s\xdc\xe6t\x9bh\xf2\xc6\xfeOC\x92F\xf1=I\xe5*\f\x8e\xa7ӿ\x98\x15\xc6u\xeea \x96Z\x19\xb5:R\x1c苯\x02c\xae\xea\x17\xac\xb2\xf1\xc7 \xb8\xd5K\xd83\xbb\x84\xf5\xaf`\xcd݇\xd6gc\xf1R\xb8z\xf9\x15#\xf5\xab\bF\xe0\xadG\x1f\xd0\xfd\xb6%Iu\xd7+\xc2;\x8a\xebE\xa9n\xac\x97\xa2\xe6@V\x89SJ\xbd\xb4y_G\x95\xa2\xb5\xabH\x13\x16F\xb3j\x86Y\x19Պ\xeeϱ\xfa2\xaa\x8arU\x11\xa9\x12\x8d-\x1a\xe5f\x10\x944\xc5\vBq\xea\xd4\xf6&\xe7\xa8\xf1W\x05Ă1\xfdS\xcd\x05\xdd\xf1\xa5D\x19\x91\xeb\xba\n\x99\xda\xfa\xab5\x91\x0fGZ0\x9e#\xf9\xabi\xd4\xdbIe\x1a\xfb\xf9\xfcn\x19\xb9G@\xbd\xc0e\xe1\xe3]\xac%\x16\xb5\xc1\xf4\xa7kL\xa5\xb6稲G\xfc\x06\v\x81\x96\xa6k?9)\xbb\xa7;%}\xc0\t&w8\xed\x91VuGnNR\xceF\xf3\f\xc3\x05K\u05eeû\xbd\xddR\xd3\x14|\xba\xdc\xc3\xde\x1e;rk[\xef\x0e\xa1*Z7+ӍH\xea\x98{u|\x02\xbac$\x85UIS\xae\n(\xb6\x00\x8a\xefa%\xf3\fp\x86sL\xa5\xb0S1U\x93\x14\xc1\x97\x92$\x9fA\x14\x88\x8e\x80H\xb8'Y\x06s\f\x19ɉ\xc4i\xac\xa1)\xbe׳\xb6^_\x16\x8cC\xa8/L\x14\x88^\f\x9d\xc5\x03s\x98\xe9Ə\x9a\xe4\xd6\xe90z\xc7E)Tr\xc1\\G\x97{\xaaY\xed:\xe1H\xd3o\xdd\xeb'\x8c\xaa\xf5V\x91\xbdd\x94bm\xe3\xc1\xc6>ۇZ\x10\xb5\v|B\n\xbb\x92\xeb\xfd\xab\xaf\x8a\xb7\x03\xd9\x06\xa1\xcdV\xc5_¨\x868OS\x95ڣ=1\xac\x1a-\r&\x13\xf8'\xca\xec\xad\xed.\x90\x94\x88Č\xbf\xde\xe6\xdf)\xe6`\xd4\x1a\xd8`\a\x1c+i\x8aL\xa0\x0e6\xf7J\xbb\fZY\xc3\x1e4\x10\x99\xe1@[WY\xa6q\xd0[&q\xeb|\xd6F&\xcc\xf6\xb1\xb6{4vO\x92\x95\xe1Z!1\x96ښ:\xe6B\v\x19\x9d\x80?\xe6X2\x96\x19B\xfc%T\xfcQ\xacfGإ\xe4\t\f\x0e\xb6\x83\xf5\x84J\xe8M|\xf9\xe0z\xad\xdb7\xca\xdax\x9dP\a\x85\x8b\x8bXGn\x1br\xe0\x1f\x9f\xbb\x93\f\xa7*\xfb\xe9\xc7\x02\x11\xfc\xa6d\xbf\xc5\xe6ՌJa\xea'\xa6\xe9\xe6\t\x8d\x93\xc3홌\xd6gC͎\\\xe8\xed\xd8\xf7\U00103ff6y\x9epV\xb4}\xbd\xb0\x89\xb6\x01\xd4\xe1\x03\xb2\b\xbf\xebR\xb1\xbe0\xf6W\xdb6d\x14E\x9b']-(\xf7\xea7\xdaE\\\xdf%\xef\x90\xdbc\xf8=-/p\xbd}\x8e\xdc\xe5\x12\xbe~\x85\xfd\xb8*GՅ¾nr@Z\xfc\a\xcd\x10\xe1\xdcϺe\x85\x879\xd2*v\xd6,\xfbG)\xb7\xc5\xc7\xe1\xf6jsz6\xabk\x9a=\xed\xd6\x02\xeb\xc09\xc8~\x0e\\\xbf\r+lߎ\xadj\xec\x10[\xe6Le\xfc\xce\xfc۪\x11nH\x8e_i\x9d\xe4\xfe6\xea\x86ߎ|\x90\xd56\x05\xd8\xfd\xed\x16\t\x1bF\xda8!wj\xb7\xfa\xd7#8\xf6lZw\x9c³\xa9\xcd\xe9W\v`w\x98ó\xa9\xae \xf5nl\x04\x8cfk\xc8\x18J\xe1\xd94\x86\xffU\xc5\xe2\x12K\xe08G\x84\x12\xba\x04\x8a\x1f$\x14H\x88\xb8}\x99`k\xa3W\x9c\xe57\xac\xb8\xd1\x0f\x92܅\xa4\xeb\xd4ws\xcd\xd8\xeb:\xb4\xb6\xed\xd6\xdbPMN\x8a\xe1٩*, E\x12\x8dmu\x00\x89J\x93\xb3\xa1\xad*@\xb2b\b\xba\xa2\x99\r\x87g\xaf\x19J\t]\xc6q|:Q\xac[\xefD\xcd\xf9}\xe5\xd4\xe1nZg\xa9ك\xba\x154{p\xa8\xe46\x04] Ά\xe3\xe3\xe9\x1e,\xd5|ޟ\xad\xba\xa8hJ\xd3ae\xd3y)%\xa3 \t]\x03\xca0\x97ó˚\xaa\xf7v\xa2\xeb\x92a۽\xfaf\xe4\xa0\xe2?\x81\xf3\x9f\xc0\xe9+j\x1e\xfd\xcd\xff\xcb\f#Z\x16\xf0\x81\x95\x92P<\xf8\x1d[|U\xfcy[\xfc\xeem\xa3ڰ$Y\x99b\x11\x066>\x02\xb7\xeeS0\xf6-\xaa\b\x9b-\xf4\b\xba\xb1\xabU/\xf22ꎣ\x86\xbe\xb5\x83,\xc2}F\xa0\x0f\xb2\x9a؎\x83C\x8e96\xe7\xf0\x1e\"}i\x9b\x03\x19\xd4'(\x8f\x11\xd8\xfb\xb5\xf34\x85\x8c\b\x89\xa9Z\xe9$\x83&\xc4\xc0\x84\x96~ll\xb3\x05\xa3\xe10g\xa5\xc0e1\x1c9~\aw\xb7\xddܕ\xd9\x05\xcc{F\xe9\xd0\xf9cr\xb7\xe8Q\xeb\x80s\xf7\x85\x9b}\xe5q\xae\x1f\xeckӧ\x98\x12\xef\x00\xb1\xaa1\x14\xddU\xdasNP\xbf+M\x89@\xf3L\x01\x1c\xc0n\xdcpi%\x0f:]\xf9\a\xd58D\x91s)q^\xc8\xe6\xcb\x00\x8f\xf6\xe8=\x1a\f\xae\x19\x97U\xb9a\x9e\xc2w\x97\"\xa6o2\x01\xc5@\xe8\xb2\xfa\x15\xe6k\xb8,\xb9>\x18\x19TI`\x9cږv\xac\x80\x13\x13\xfa\xcb\x16a\x10\v\x038&\xf9\xb2\xfbE/Y\x84\xae\x96F\x15\xf7\xbb\x10\x9eȱ³`\xdb^>\xf72\x99\x00\x14<\tF\x01ɗ\x93\xb2\x88\x8b\xea\v\x10\xed\xf7\xca\xffZ\xc9)\xbb\xa7\x8dl\x95\x8c\x11\xe7hmO\x82\xba\xb2\xed\x12K\x9d>\xeePv\xbe\x83\xb4\xb7\xae6\x18\x8e\xb0\xa5J\n(S>\b+\xb5\xaf\xc4k,\xc4\xcd\nё\xa1\x1b\xd525\xef\xa6\xd4\xc0\x1e%\xa1\x9a\xa6'\xd0\x1a_\xeb\x00u\xe2\xec\xea}\x13a:\x99\u007f\xab\xd8\"\xc5A\xbe\xf5\xc9\x0f\x8e\xa7?Uڷ\x88\xa1f\xfd\xd9\x1a;\xa4\xf8\xc3Q\xd3\n\x88kLe\x13\x12\xf6`\xe2[\x05\x85\x12w\x90\xa3\xda\f\a\aƟ.\xf1[\x04\x87\xf5\xca\xd6\xc8\xc8\xc5\xf2\x0f\x87\xc6\xef\xc8'\xd5qJ\x13B\xce\xd9̷\n\xa3J\xe4A\x8e\xedb:8\x9c\xfee\x92\xbfEX9\x9e\xfa\xb7\t\xad\xfa:\xdfU \xb3\xb2_\xd9NW\x87\x12\x1b-\xaaG\r\xfd\xe1\xe2\x95i\xd5W\x11=\xb7n\x16r\xa6\xce\xd3#\xc3\x14f\x8e\xc0\xb8~\xad\xb1`\xdc^U\xce`zb\xbe\xce\x06\xa7\x15\x93m8:\xaaԐy\xf1O\xfdm\xc8\x06˽Ɣy\x0133\xbe\xaa\xb9\xaa\xca\xfb\x87f\x94P\x15\xbd\x91>\x86\xe3\x13\xf8\x04g0>\x86\xbf\xfe\x15\xbek\x1b0td\u007f\xba\x8d\t\xa5\x98\xdf\xe0\a9\xb2\xda5-\xd1\t|\x1a\x8fݽ\x9e\xcbzt|\xeb\x0f\xe4\xd3mM\x87\\\x12\xe4\xf7>v\xd5\xf3[\x87\xf0o:\x02\xe3\x9bM@\xa3\xc4`\x03E慍)s\xebnz\xbd\a<\xdel\v\xd1\b\xe6ul\xdb\xe7\x1dH\xbf\xda\x16\x92\xab\xdd\b|\xfd\n\xb6}\xee\xb6W\x03\xb6r\xa6V,Y\x84\xa8}\a0\xefzs\xe0\xf1\xa9\xc4p]dD*C\xc4B\xfd\x16\x06\xa1\xceM\xfa\v\u007f\xaaY\xf7\u007f<\xbe\xad\xba\xc1t\x9bXO\x18\xbd\xc3*z\xcd\x19\xbdf\xfa8\xbd\x1d\x19\xf6\x8fǷ:\x8b\xcc+\x19s_\xc6\xdcʘw˘wʘ\xd72\xe6\xae\fe\x00E\u007f\xaa\xd9Z\xa3=\xf6\x9d3\x1dx\x9eq\x8b\xaf?\xcd1\xe3JfmWs\xe00w?\xaan\x93\x80P\x93w\xe6\xa6e\u07b4\xa8\xb1\xa9\xc6S\xdd\xd79\xb6*_\xd9\\\x05\xa7\x1a\xf8\x04\xc8ё=\x19 \x8b\xf0m\x99\xcf1\x0f\xe7\x1fɭ9{y\x8b\xde\x06\xed\xa7G\xf6\x88\xbf\xf9\x16\xb7\xe5B>W\x8bi\xeaΛ6\xd3)\xb8\x92\x0f\x13x\xe6\xf3\xf6\x88\xb5/\xccj\x97n\xee\xc5\x1cǢk\x9c\xb8qe^\x00\x8a\x10i\xff\xf4tΣA\xfb\xf5\x1e\x1ai\xa8Q\xf05\x18\xcdG\x9a\xd3\xd66F\xc2L\xe585\x0f\xebO}1R\xb1\x9c\xce\fJ\xcb\xc3\xfa\x89\x8e]\xb6\xdc\xdcZ\x19A\xf5\xbf\xaa\x16>\xcf\x0e\x1bÐ$\xc7\xed\xf0Vm\xfbD\xb2\xf9\xfb\x17\x1aG\xa5<\x92cg\xbe\x9e\x18H\xdb_e\x9eSx֍6\x00{W$W\x98c \x02\x10L!'t\xb2\xe2\x93T\xd5\x00D\x82X\xb12KAH}]\xc41\x92\x98\x1bF\xb9B\x142v\x8f9\xa4\x98\xb2\x9cP\xed\xee\x18\xceӔ\xd0%\x1cC\xc2\xee\xf4q\xdd\n\xc3\x14\x12\xa4mc\x95\xfb8\xbd=:\xf2\xd4U\xa9\xa79M\x158i\xfe$\x81U\xbba\x85\xef͔\xabõ\x13#'t;Ƌ\xe9n\x90\x15ߎ\xf1\xfc\xc5t\x0f\x94\x14\xad\xb7\xc3\xfc\u05cb\x1f\fNw蘴K\xf5,\x1c\x81\x89\x91:\x84\xccGG\xda/\x17\x1b\xc2\f\xaby)\xdaҷ\xcd\xfdf\a\xf7N\x80\xbf\xef\a\xd0\x1e\xa99%_\xa1\xb5\x90(\xf9<\x02\x8aq\x9a\xd5e\x98\n|\x95Y\xab~\x1b\xdd\xe6{\"\xf7+\x92a\b\x89W\x8b\x90\x05\xd4h\x1f\xc9-\xccf\xb3\x16&xyL\x15}\xf5\xd7N\xdc+\x05ۯ\xcb\xda\x13O\xeb%\x96W\xef\xf5\xd3S\xbe\x0e\x91};\xf6\xdb\x00&\xdf\xc3SU\xf7\xff\xe3\xfa\xdd\xdbp\xb8\x92\xb2\xf8\xdbdB\nB\x17,&l2\x84#\xb0\xd4p\x04Cw\xff\x96\"\x89l\x82uӜj\x8e\x13#\xc8\xfd\x83)\x10\\]\xbf\xd7O\xa55\x05\xe3K\xfd\x00\x1e\xdeq\xb2$\xb4鰬\xe6u\xbc>]\xfd~R\xfd\xf9\x0e\xa1&\xab\xbcg\x90\xb1%\x11\x92$\xb56\xa2\x19\xa9\xff\xe8\xe4\x8b\xfb\x00\x87,\xaa\xcfp6s\xbf\x05T\xa9\xc8\x11\xfd<^\xea?\x8a\xe1\x05\x8e\xc35\xfe\xb1\x87\x8beiГr\r\x05ǆ\xc0\xf3\x8b\xfbfa\xae\xfe\x1dAn\xdf(4\u007f\x06Bw\xa85\xa1~ƫ\x16\x8a\x8a\xce\xebh\xeb6\x85p\n\xbf̍)Ղ\x05\xb3z\x89Ԩ\x138\xc6GϣX\xb2W\xe4\x01\xa7\xe1qT\t\x85S\xd7D\x8aq\xaeߵ\xffr\xe1\x19\aB\x17\xe9E\xb4ɶ)\xefEK\x9e\v\xff\xe6bÌ}0\xff\xbd\x05\xe6\xef\x17Րs\x98ն\xb2c\xcb͗\xc0j-\xf3\x06\xbe\xb6\xea\xc4P\xb4%h4c\x87\xc0\xaf\x13u\xab\x0e乍\xde\xc7\xff\x0f\x00\x00\xff\xff*F*3NJ\x00\x00",
-		hash:  "08a577994b5b1f40cdfef3101d90678d885f1260255c157155d7793482f5b213",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec|\xebs۶\x96\xf8w\xfe\x15\xa7l\xee\x15YK\x94\x9c\xb4\x9d\xdf/\xb1\xbc\x13\xc7ͭ\xb7y5\xc9\xde\xfb!\xeb\x0f \t\x89H(\x80!@ۚ\xd4\xff\xfb\x0e^$@\x91\xb2\xdcG\xe6\xce\xecv\xa6\xb1\b\x9c\x17\xce\v\a\x0f\xf2\nՐ5u\x8d\xa9\xf8\x19\x93u!`\t\x8b@\xb6\x96\x18\xe5\xb8v\x1a\x03\x8e\xc5\x05\x15\xb8\xbeBe\xd4T9\x12\xf8\xe7\xf7/_L\x1f-\x16\x8b\xf8\x89\xc2Ḿ\xc2\xf5kZ\x12\x8aa\t+Tr\x1c\xcc\xe7\xf0_\x1c\xe7 \x18h,\xe0l\x83A\x14\x84\xae9\x94\x98sX\xd5\xf8s\x83\xa9(\xb7\x9a\xcc'RYN-\x99\xe0AtMhή\xe3\xa4d(\x8f\x02\x00\x80UC3A\x18\x8db\xf8\xa2\x1a\x00X\x85\xe99\xe2E\xcaP\x9d\xbfc\xd9',\xa2\xd8\xf4uR\xb7M\x1c\x8b\xf7d\x83Y#\"K\f\x1cj\xa3x\xb7S8\xd6\x03WOA\xfc$\bZ\x02.\xbc\"5\x9fÛ\x86\x178\av\x85k\x10\x05\x86\xdc\xca\b\\\t\t\xd7\x05)1\x10\x01\x84\xabA\x04\x00d\x05Q\xee\x8f\xe5u\x85i\x14[\x01k,\x9aZB\xde\x06\x01\xc0\x83\x04}D7\xd1$\x99O\xa6\x06\x807Y\x869\x7f\xech\xeaK;r\xcfX\xa2n\xb0\x1e\xcbT\xfd\xc1u\xcd\xea\x03\xf0\xb4u\xb4\x12\x00n\xa5\x1e\xb4\xe0߸\x80V\xe0\aQ\xf8\xadn\x9fq\x81D\xc3\xc38\x11\xf8FD\xe1s\x94\t\xb6\xc9\xe1\x15\x13\U00036854\xd0u\x18\xf7\x06\t\xb8\xe4\xf8`J.\x95[+\x95D#4\xc77\x14]\xcd6\x88\xd00N\nğ\x95\x88\xf3($|\x862A\xaepتx>\x87\x97\x88Px\x8f\xd2\xc0\xf1\x05\x15\x17Q\fN\xdbӲ|\x83q͍\x8f\xcc\xe7p\xce0\a|\x85\xeb- \xcaD\x81kȶY\xa9\xd5EV\xd17\xae\xa7\xb7\n\xd6\xd4\xde\u05c8r\xa4t\xcf;o\xf5#\xa3\xb3\x99\xab\x19\x18\x0e\xa0\xd6D\x1a\x96\xacz\xba`5>@\x17\xe7X R\xe2\xdc\xd7\a:\x97\xff7\x9bJ\x8bz\x1b\x04\xb7\x81\n|5\x14\xb8.0\x85k\f\xfc\x9a\x88\xac\x00\x81R\x1e<\x88\xc2D\xfe\x98e\x8c\x8a\x9a\x95\xb3\nQ\\BI\x00\x85q\x92\x95$\xfb\x14\xf9\xce\xe7\x84j\xe0\x85~\x00\xad\xb7\xa7<\xabI\x8a\xdb\x04\x10ŝ\x90]\x00\xdfN\xe1\xd1b\x11\a\xb7\xb1\x92\xf2}\x81\x81\xb2\x1cC%c\x94\xab\xf0$\x02o\xe4/$ +\x10]\xdb\xd0Ep\x8dS\x1d\xb1\t\xbcaeI\xe8ZE,-\xb7\x92V\xc3qnBY\x92ѐ\x12 +\x19\xc7y\xa2\x12\\/\xa6a\t\xb4)K\xbfKj\x14\x96\xf0\xe5V5\x17\xca\xdf.\x94PK\xf8\x10n\xb6\xda\x03\xc3)\x84n\xa6\x96\xcf\x19\xdbT%\x16\xb8k\xd1q\x92\xb1\x86j\x80\x02Q\x8a\xcb\x17\x98\xaeE\x11^*\x06\x15\xc6uG^>\xbdg\x02\x95\\\xc2\xcb'\x1e^:\tn0))#\xe8`\xed\x03\xc07z\x88\xf0\xf7\xbf\xf7\xbb\x92\x1a\xa3|\xfbN \x81a\xb9\x84\x7f\xe1Դ\xbf~\xf3ӫ\xe0\xd6a:\x98\xd9\xe1\x8b\t\xeco\xf4쐴\x04\x06r$\x80\x9a^\xb2\x02o0,\xa1d\x19\x92\x94\x93\xaaf\x82e\xac\x94\x02\x84\x85\x10\x15\x7f\x1c\xc2\x7f@x\xcd\xf9\xe3\xf9<\x84\xc7\xf2\xa7\xfc\x15\xc0\x90\xed\xf0u'vd\xa8\x1fu\xd4\v\xc6\x05\x1cA8\xbf\xe6\xf3\x16[e\xa5\xbe*\x18\x95c\x94\x11۟\xd7ƒ\xf5\xfd\xb2\xe0h\x84\xdc\x0e\n\xb3\xc1\x9c\xa35v\xe5i\x139\xaa\xaar\xdb\x129'\xabU\xf4\x9f\xef^\xbfJ*Ts\x1c\xe1D&\x85x\x9c\xb4\x8a\x86\xa1\x81\x8e\x84Fo\xaa\x1ep\x85)\xfc \xe7c\xc5Q'\x9f״\xdc:\xb1\xcc\vvM\x81Q\xd5$\t\xc8<\x04\xa86a\x9fw\x8e6\xa4\xa4\xce\xcf\x0e\x9d\x90\xb5\xb3\x11\x13SN\x00'\x19\xa3\x19\x12Q\x1brS\xf8\x10\xd68\xc3T\xb8I?\xbc\x8c\a\xe7\xac\x03\xf24i\x0397y9\xbc\x1c1\x05\xc74ז\xe3\xa2&tMV\xdb苒\xea\xb1&s\x1b\xc7F\x9fo1\xcdq\xad\xd3c\x85j\xc1\x81\xad\xcc\xc3Z\xe6xiQ\xcdY*\x95hE\xe7d\xb5\x9a\x02\xa3\x196\x13\xa1\x84\x90\xd4D\x81\xb7@1Ρ@\x1cR\x8c)H\x1d\x90+\xd7\x12\x03^&\t\xeaq>H\xf0\x8d\x90\xe2{9s\xaaX\xc6F\xfd6w;\x9e\xa6D\xb4\x9a\x920+\xd6\xd0ܛ&W\xac\x86HYOV\xbeO\x80\xc0\x89\x1eYR\xaa\x9c\xf9\x04\xc8ё%\xd1+Ӥ\f\x1f\x14\xf0\ary\tK\xed\xc0\x1dp\x9b\";v\xda2\x9a\xb1\x11E\xfd\xfd\xed7\xb0\x84\x80P5\xae\xa0\x83\xb6d$\xa8\xe3pW\xa8l0\x1f\x1d\xb0\xc1ң٠*\xf2\xc0b\xf8\xb2\x93\xc1\xdb\x01]\u00adWJ\x19\xddF\x8ek;q@\xdb9)\xd2\"\xf9pFb\x97N\x1b\x0f=*m]e\xe88p\x03TFB\xc9'\xe9v\xfa\xa6Kv\xb1\x87\xb9t\x91գݕB>a\x8bЦ(\x19\xd6y\xb3\xa9T\x05\x84\b\xc55|\xbbjʒg5\xc6t\xc6*ɽ\xad\x85z\x95\xb8\xb8\x11Ok\x8c`\t\x1f\x7fmp\xbd\x8dDAx\x9cp\x92ʊ\x84Ga\xe2\xe4\x85\x0e>\x11l\xbd.\xb1I\x1d\x1d7\x05\xe3Q\xf2\x00Q\xcaY\xd9\b<\x1b\x90o/\xe2\x8a\xdc\xe0|\x10K\xd6^\xaa\xf8b\x95*\b\x81QPI.\xd8)\xd1\xe1t\xb0&lS\xb2\xc7~Ob\xdcY?\b\xd7G\xe2\xa4\xc6\x1bve%/H\x8eø\x05\x95\xb3yy\aL\xeb\x12q\x82\xf2\xbc\x0fs\xab\x86\xbc\x93˿\xd6\xe0\x06$\xf2G6\n\xe0\fkx\xf4zd\xfe\xea\xd7]\x11\x98\u0090W\xb0\x84\xcfr4\xaaڋ\xba\x10\x9aB\x18N۱KH\xb3\x18b\xe9GX\x82SX\xa8\xbe\xc1`c\xe9G%K\xecՌCPz\xf20\xc1w\f\xeaϻ\x82\xd5\xc2\xd6N,\xfd\x98X\x94\xe3Du\xd9\xc0\xed\xa1\xbdE\xd7\xc3HoѵFqp\x1e\xee\xc5y8\x88\xf3h/ΣA\x9c\xef5\xce\xd3F\x14CH\xdf'\xb2\x87\xd5D\x10\xcc\xe3]\xbc\x8b\x1cS1\x8c\xa8\xba\xc6\xf0^n_\xb1\x1c\x0f#\xea>O\xca\x1f4\xd63FG\x06\xf7C7\xb8\x1d\xacw#\xd6\xfa!\x91=8\xd7h\xb7;[2\xfe\xa2z\xcc/\a&\x90\xe9\xefuP\x8f\xe3>\x1f\xdd\x014\x1bGf\x9d~~vV\xb2\xec\x93Yә\xa1\xc7r]\xa5\xc6Oj\x9c\tVo\x15Pr~\xa6\xe1\xdc]\x97\xf3\xb3_\xf0\xf6\xe5[\x93n:\xcd\xf9\x98\n&v\x90\xceX\xbeU\x8d{\x90Z\x18\x17\xf1yS\x96?#^\xec\xc1\xb3 \x1e?\xd9#\xcb|.Цڃ\xdc\xc2\xec`\xfb:ڧ\x9e\x16S[|\x96[\xb8Y*\x01\x0f\"a\xf7r$\x90Zs\x91\xdc5\xa5]\xf9\xb6\xbb;#pɊ\xd5?\xa1\xac\xe8\xa6\x00\x95\xbfݺ\x91\xact[\xa2V\xe6\x17\xb4j\x04\x9c\xc2\"Y,\x16\xc7.\x1cؽ\x9d\nQÉ\xc3)\xc8\t\xe1\xe6\x05\xe1\x12I\xa4,\xdf·!\x1c\x81!ysq\x1e\x9b\xeaV\x12\xf5\xe9\xf5\xb6\x97\xf4\x7f\ap\b㤪q%k\xf4\xf0\xbf=\xe4\x13Q\x03ɗ\x13_\x028\x82pr\xeaCj\xe8\xfc\xf4\x04)\x84\x95Z\xd6\xce8FuV\xccJB?M@l+lzH\x8e\xb2O\x93\xd3]\xb2'stz2\x17\xf9\bu\a\xa1S\xaeB\xbb\x17\n\xbf\x1f\xce\xebF\xecA:\x99\x8b\xfa4\x8c\xbd6\xbb\x1c\xbc˴\xa7 \xea\xd01\xe8\xf1\xa2g\xd2\x03-\b\xa7\x9a\x0e\xe2\"\xb2\xd3\x7fd6\xbd;\xefp]%\xe8\xff\xba\x8d\x9dE\x8b\t\x94\x9f\xa8\xa8\t\x1e\x0e\x0eӷ\x1b\x10\x98\x8az\xeb\x8eBU\x8f\x02\x95\x81; \x13\xca\nx&dw\xbb%\x12I\xf5\x9bA[\t\xeeV\xde\x11\x84\xb1c\x05\xc7\x02\x96\x86\x8a#\xc50Q\xe9l4\x8eL\\\x86G\x1d\xb0$\x0f\xdff\x05\"\xf4\xe2\x1c\x90\x97\xd95\xd43\xdd\x17\x0f\x84\xdf\x01\x84|\x1a\x83\x86\xea\xc6t\xa8`ᛚ\xc9c\x05\xb5\xaft\x90\\\xda\x1c\xea\xdfB6JzH\x88:\ne\xf0\xca\x02P\xf5\x85\xe3\x12\x8e\b\x88\xb3\x8cq1\xa0\xb6\x9f\x9e=c\\\x1c(\x9dG\xc4\xc3\x1fv\xed\xdd|x\xb7[\x8d%C7\x15\xfa\x82\xed\xa6\xc2\x13\x91+؞:'wgG\x05\xdb\xe6F\x9f\xcfhn\xb4\xec\x8c\x17\x1c\xc0FA\x16\x18\xe5.\x1f\xe3}p\x10/m\v\x17][b0I\xee\xa6ȁ\xf0\xfc\x9d\xf9\xf1\x10*w%\xc7=\xd90\xd8\xc9`N\xf62sٞ\xfcu\x8f\xeco\x12\x98\xde\xc4{\x04r=IṕP8C\"+v\xce-\xedٖS\x1e\xa7\x12\xf0W\xa7F\xb6\a\x10S\xf7\xf4a\xea\x1f=L\x9ds\x87\xa9\x7f\xe8\xf0\xfb\x8ai#پ2\xda\x01Q\xf2[9A\x15\xca\x1f\x16\x97\x89~\x0e\x00J\xaf\xe7\xd8\xe9\x91\xc3\xf0:\x1f:\x9d+\x9cs\xd3\xfc\xe82Y\xe1<\x00@\x8dۆ\x9a\xdch\xee9\xb9¦\xfd\xfbKc\x0f\xbb\b\xd2\xcaY\xe1\\\x9f\xcbĉ<W\x97\xc4c\x0f\x005\x1e\x80䤊\xce\xfe\xa9\xbd\xd2\xd8\x05\x15\x91\x1d\xb1%#O\xb6ڒX\x92\xe8\x00\xa4\x12\xfcc\xfe\x96J\xd9\x11\xd1^\xf1\xa6f\xeb\x1as~\x86j)ۖf\xcfI\xad|.\xa9L\xd7l\x83\x05\xaeé/\xdb\xd4\xe3!\tV\xb8\x96^\xaen\x15\xa8\xd4\xee\v\xb1\xec\xa6\xcd\x0e\xf2x\xb1\xe8ort\x9d\x91\xc7p\xee\xf1\x83\xef\f\xae\x8b\xf0\x12\x89\"Y\x95\x8cՑi\xb4\x9b|\x0f\xa2ɾ\xc1\xed\xb6\xccdlNL\x88Z\x0eG\x10\xfe\r\xdemi\x86sPQ\xeb[KN:l\x05\xb2\xc3\x1b\xba\x89T\xb5\xee\x1b4\x9d\r\b?\xce\\\xbbu\xae\xbb\xcft\xefp\xc6h>l;?\x84ǌg(\xfc9&l\x89E>\xf7\xbb,\xd9\xe2\xed\xdaSw\xf5\xad:6\xee\xc3\xccj\xb0w\x8d\xeb[cԺ\x9dq\xe1\x9c\xf0\xaaD:\x99\xc23\x9d\x1a\xc1\xe4\a\x05\x901\xcaY\x89\x93\x92\xad\xa3P\x02\x80Μ\x8f\xc3i\x9bY\x86v8\\[\x93\xbc\r\xc5)lЍ\xddT\x8c6\xe8f\xc4F6\x92\xe6\n\xbcS\xf8\x83\x88\xe4qrMrQD\xe1\xf1b\xf1\xb70\x1e\xb1\xe5\x81D\f\xb4\xd4e\xd8\xee\x88ۃhY\x98`}\x80%\x8f\x9d\xe7sxh\xa6\xad\xf1Y\xab\xbb}\xa1\xe4ٙ\xb0\xba#\xed\xa9>Ϟ\x827\a\xbdE\xd7\xfb\xa6!\xd9=t&\xb1o.\xf2\x80\xda\xd9\xf45\xc5\xedd\xd4M\xb0z\n2)[M\xfb\x12\xf5\x99\xc9\xfc\xca\v%\xa4\x99C\xac\x1b\xe9\x01i\xa3\x1a\xda\x06³\xafw*)+$Y\xac\xb1U'\xce\x12\u0086\xe6xE(\xce\xc3\ued87\xaa50\xaemq\xb1bL\xfd\x95\xf1\xa2:>7\xa8$b\xdbV(\x8b]\xb7\xb8?\x95\x15\xab7H\xfc\xaa\x1b\xd5rQ\xaa\xc2<?\xbdZ\xc7ݾ\xcd(٦\xf2\xa9\x9dm\x05歊\xd4\xd3;\xb9\xc7'\xf57\xb5zH^\xea\x03o\xd5u\b\x97\x9c]\xd3;\xf9\xbc5\xa7\x9a#\xbclw{\x98%͊\xd2\x12\x83\xdcV\xebL;dמx\xba\xf8S\xa5\x1f\xf6V\xce\xed\xfa\xbawTcKV\xbf6\xf5\xbce \x1c\x8d\xe7\x01\xbab$\x87\xa2\xa1y\x8dsu\x18,oB\x14bS\x02.\xf1\x06S\xc1Ml\xe6@( \xf8ܐ\xec\x13\xf0\n\xd1)\x10\x01פ,!\xc5P\x92\r\x11\xf2j\fH\x02*b̼\xa2\xcea\xd5i\x89$\xa0&\xbf6\xd9\xe0\x1a\x96\xaa\xe9\x83\x02\xb8l\x9b\xb5\xb4\x89<\xd4WG\x85\xc9\x1b\xd3\x18\a\xeeJ\x12\x8e\x14\xf4\x9e\x15{\xc6(,5\xd03F)V\xba\fz+f\x9f̊\xc8\xd5ݷ\xa42\xf3\xb5Z\x8f\xfaB\xc0\x17o\xbbb\x8c\x80R\x93\xf5\xad\x8cQE\xe0i\x9e\xcb\xdc\x1e\x1fD\xc1\x88\xd0\xe3>\x9f\xc3?\xe51\xea\x01$r\xc23=\xeev\xb1\xaeN`\xc3ioH\xc1^b\xf2|\x1ai7\xec\x9dvߥD\xab\x03\xcd[\x10Q\xe2PiT\xea\xa33\xc9+&\xb0\xb7Qj\xbc\x0f\x96\x87h\xb8ۼ\xba&Y\xa1q\n\xc4gBiP\xf9Vd\b\xc6O\xc0\x1di\"\x18+5\x18\xfe\x1cI\xec8\x91\xde\x1f\r\x89\xf7\x04\x82{\x8d\xdeh\x1e\xe7ƌ\x03\xe3V\x13\xdba\xfeԧ6H\xe8\x1e\xae\xe1\xd2k=\xb4O0pw\xae\xdd0¹\xccb\xea\xfeC\f_$\xdfWX_\x10\x96\xe9H\xfe\xc54\xef\xef\xad89\xd8\xec\xa6(YvD\xdc\xc9j\xce\xda\xfb \xcd\xfb3\x92\xa7{g\x1e:Lﻴv\xc8\xech]\xde\v\x1d\x12\xaf=\xfb\xf5\xe7\xc7>\xc18\x8e\xfb\xfbR=B\xee!n\xbc\x1f\xb4=\x13\xbe\x83瀪\x0f\xd25\xc7\xed\xc26v'8y\xf5\xe50,k\x9avJ?\xcc0\x0e\x89\x1e\xf6=\xa2\x80;\a\xa5\xee\xf4\xefQ\x9c*\xf1\x06*\x8bC\xfd\xd1ފ\xba\xbf\x9e\xfa\x98\x9e\xaeں\xe3 }\xf5H\rP\xb9\x87\xde\x1cb㺳\x94}\xfdy\xd5\xd2\xe1:\xdc0\x99\xbf\asjo~\x97Ǌϕ<\xe2P\xdd\f\x13\xdfO\xf7\x1e\xda\xda%o֤{\xe8{\xca\xe9\xedV;5V\xfb\xf3\b\x8e\x1d=\xb6\xcd'\xf0pa2\xf4\xc5J\xdf\x7f~\xb8\x90XJL>U\xb7\x9eA\xbe\x8e\x01\x0f\x17\t\xfcK\x16tk,\xa0\xc6\xf2\xda\x0e\xa1k\xa0\xf8F@\x858O\xfc\xcd|S\xcd<\xaf\xd9\xe6=\xabޫ+C\ue930\xbb\x13\xbb\x9b\xff\x0f8Zl\xb5\xb9\xe7dQ\x01\x93jrz\"\v\x02ȑ@33\xb3C&\x93\xdfrb\xea\x01\x10\xac\x9a\x80\xaaC\x96\x93\xc9\xe9\v\x86rB\xd7I\x92\x9c\xcc%\xea\x9e\xf3Eţ5\xe1\xe4.Hgڸ\x13\xb6\xe7\x1ew\xc2ˤ5ѷ\x04\x97\x93\xd9\xf1\xe2N\x04\x1b\xad\x87\"\xd9C\x82\xae|\x9cX=\xa6\x8d\x10\x8c\x82 t\v\xa8ĵ\x98\x9c\x9e\xb7P#'\x03\xbb[\xfc\xe3\xa7ѻ>\x82\xaa\xffs\x91\xff\x8d.\xe2\x97!\xb7\xdd\xd2\xfaY\x89\x11m*x\xcb\x1aA(\x0e\uee44\x96ř\xb7\x84\x1e^\xb0ɅCV69\xe6Qh| \xec\xea2I\xc4\xdc\xf9ԷY\xf5\x92u\nÔ\xedl\x15;yq\xef2~(\xeb\x93Ut\x88\xdcj+\xa8\xf3\xda$<t\xeb\xa0\x1f\x93\a0\xf3\xf9\xf8·[j\xf39<\xcds(\t\x17\x98\xaa\xdb\xe7\f:\xb7\x01\xed.\xeam\"\x13\xf1\x8cF\x93\rk8n\xaa\xc9Ա,\xb8\xeb\xd9i\xff\x85\x06\xefZ\xa2\x03\xe7\x8f\xc3]\x04ǽ\xfdûϰ\xcc݇\xa7\xea\x8d<\xa5\xe8\x1cS\xe2l\xb8uU\x80\x84\xbb\xc8GV\xe2\xed=͜p\xb9Q\x94\x87\xf1=е\xea\xcf\r\xe7`\xd0x\x7fP\x8c\xfb\b\xf2T\b\xbc\xa9D\xf7\x86\x8a5}\x1c\x04\xf2.\x9f-\r\xf4+/\xc3e\x83\xee\x9b\xcfA\"\x10\xba\xb6?!\xdd\xc2yS\xab͇\xc0\x86\xf9,7-}_q_r17D\x13\xae\t\xce\xc8f=|C\x96\xac\"WJ-\x8a{\xed\xcec9\x93\xf4\f\xb1}\x17\x89G\x91\xb4\x03\xf2:\v\xa7!٬\xe7M\x95T\xf6\r\xc7\xfe\xfd߿\x96\xb3\xdc\xfa\xecx\a\x01\x00\xaak\xb4\x85eK\xa6\x9fO\xd7\xe6E\xdc+T>\xbd\x03t\xb4\xf2\xd54\x1cfk\x99\x14P)m\x10Y\xb1/\xf8\v\xcc\xf9\xfbBn3*\xb8i˳\xbd\x81\xeas\r\xcd\xc6\rjaF\x1c\xad\xb3\xb5}w\xd0\xfa\xd9ś\xce\xc3H\xf5\x15}\x8bT\xf7\xb2-\xa9\xf6Y\xf5N\x7f\xfaS\xb9}\r\x1f\xea朽\xbeC\xaa?\xec5=\x87\x90+\xfd\xce%\xccV\xc1\xd7r\n\xc9\xee^\x86\xea#\xdc\xdb1\xfet\x8e_\xc39\x8cU\xf6zƆ\xaf\xff\xb0k\xfc\x8e|b7::\x17rvM\xbe\x96\x1bY\x96\xf72\xec\x10ҽ\xdd\xe9/\xe3\xfc5\xdcʱԿ\x8dkY'\xf1\x04(\r\xef\xe7\xa6ӕ\xa1\xc1Z\n{g`\xdc]ܞ\xf6\xadFϬ\xbb\x85\\w\xb8\xf8B\xbd\xf0\xdc1L\xda[\x10+V\x9b\xc3?\xfd\x12\xa6\xfayb\x91LC\xf7\x1e\xa6\xd8T\xffD\xa5G\xcb=\x1a\x14\x9b\n\x96\x80\xdcf[\x95\x8f\x0fM\v!+z\xcd}\x06\xc7O\xe0#\x9c\xc2\xecX\xbe\xca\xfeM_\x81\x91\xc3\xfb\xe3eB(\xc5\xf5{|#\xa6F\xba\xae%~\x02\x1fg\xb3\x8e\x0f\xb8b\x7f<:\xbe\xf4\a\xf2\xf1\xb2\x85C.\b\xf2{\a\x97~{\x87\xf0o:\x82 \x18&\xa8\x85\bv\xa8\x88Me|ʼ\xbe\xaaz\xbd;\x19^\xb4Eh\ni\xeb\xdb\xe6:\x04Rw\x9b\xf5{С\xdcZ7\xed\xa9\xdb\xde{\x91v\xd1ު\x88P\x7fg>\x1d\xbf\x8ba\xf0\x02\x00\xf4\xae*\x89\x90\x8aH\xb8\xfc%\xafyƲ\xdd|\x00B\xf7\xcb뉦\x1bt\xb7\xf6\xf5\x8c\xd1+,\xbdW\xef\x9e+\xa4\x0f\x8b˩F\xffp|\xa9\xb2Hjy\xa4>\x8f\xd4\xf0H\x87y\xa4\x83<ҖG\xea\xf2\x90\n\x90\xf0'\n\xad7\xdac\xdf8\x8b\xc0\xb3\f\xa9\xfe\x02\xc3\xcc,\xcfV\xafz\x93!u\x1fe\xb7N@\xa8\xcb;\xa9nI\xbb\x1696\xd9x\xa2\xfa\x06\xc7f\xf3U\xf7\xc28\xd2I\xea\xe8\xa8\xdb:z\xd5lR\\G\xe9\ar\xa9wZ^\xa1Wa\x17A\x0eA\xf73-\x06\v\xf9X=\xa4\x85\x1b7}\xa4\x13p9ߏᩏ;\xc2\xd6\\\xe0jM\xba\xbb\x16s\f\x8b\xde\xe1\xcc\xf5+}\xaf\x8eGH\xd9g\xa43\x8d\x83\xfe\xbd84U\xa4\xa6\xe1o\xe14\x9d*LS\xdbh\x0eK\x99\xe3d\x1c\xb6Oc>bQN\x96\x9aJ\xcf\xc2\xea\u008b\x99\xb6\xdc\xdcj\x95 \xfb\x9fۉ\xcf\xd3\xc3\xce0\x04\xd9\xe0\xbe{˶C<Y\x7fbKс\xa5\xc2r\xe2\xf5\x89&i\xfam\xe69\x81\x87\xc3\xd4\xf4n\xe8\x85\xfa\x80C\x8d\x81p@\xb0\x80\r\xa1\xf3\xa2\x9e\xe7\xb2\x06 \x02x\xc1\x9a2\a.\xd4\xc1N\x8d\x91\xc0\xb5F\x14\x05\xa2P\xb2k\\C\x8e)\xdb\x10\xaa̝\xc8\xcd:y\xees\f\x19\xbb\xb2\x1f\x8bX@\x86\x94n\x8cp\x1f\x16\x97GG\x9e\xb82\xf5t;\xa6\x1cga\xef\x8b\x1a\x0e\xaa\xbcP\xe8}\xc6h\x90Ɔ\xd0\xfd4~\\\xdcM\xa4\xa8\xf7\xd3x\xf4\xe3\xe2\x00*9\xda\xee'\xf3\xff~\xfc~\xb1\x18w\x1d\x9dv\xa9\x8a\xc2)h\x1fi]H?:\xdc~9\xdba\xa6Q\xf5E̞\xbc}\xec\x97w`\xdfI\xe0\x1f\x87\x11\xe8\x8fT\xef\x85\x17h\xcb\x05\xca>M\x81b\x9c\x97\xd8\xfdJ\a\x81%\xd8~\xfb\x19\x0eթ?\xb6\x14\x11\xaf\x16\x91ǘ\x16Z~>c\xb9\\\xf6h\xfa\x1f\xe3\x90Eߓ\x00\xfa{\xd0\xfe\xc7:\x9exR\xaf\xb1\xb8x\xa3.h\xd6\xdb\b\x99\x9bY_\x02\x98\x7f\a\x0fd\xdd/\xf7\x80\xa3I!D\xf5x>'\x15\xa1+\x96\x106\x9f\xc0\x11\x18h8\x82\x89\xbb~S\xdf\xcc\xd1ҹiN6'\x99f\xe4\xdeЃ\xf0\xe2\xdd\x1bu\x01YA\xb0z\xad.\x90\xc3뚬\t\xed:\f\xaa\xea\f\xd5\xee\xeaws\xfb}.\xf9\n\x17\x88k\x06%[\x13.H\xd6Jû\x91\xfa\x97?>\xbb\x17`\xc8\xca>\xc3\xe9\xd2}\x8bƊX#\xfai\xb6V\x9f\x98\xf0\x1c\xc7\xc1\x9a\xfd0\x82\xc5\xca<\x1cI\xb9\x1a\xa2\xc6\x1a\xc0\xb3\x8b{\x9b \x95\xffN\xc1|Ȉ[\x99Aw\xc89\xa1\xbd\xf6*'\n\v\xe7u\xf4e[@\xb4\x80_R\xad\xca\x00 \x85e;E*\xaas8\xc6G\x8f\xe2D\xb0\xe7\xf2\xeb\x17ѱ\xfd\x8eO\n'\xae\x8a$b*\xad\x02\xbf\x9cyʁȥ\xf4c\xbc\x8b\xb6\xcb\xef\xc7\x1e?\x97\xfc˳\x1d5\x8e\x91\xf9\xff{\xc8\xfc\xe3\xcc\x0ey\x03\xcbVWfl\x1b\xfd\x12U+\xe5\xa6#o!a\xae!\xfa\x1c\x145\xad\x87Я\x13U\xabr\xe4\xd4x\xef\xed\xff\f\x00\xe8\x9bӎ\xb1R\x00\x00",
+		hash:  "3b208f8cf537936544d925348acc5771b0997ccb44ec2865ad0b50732a6a9547",
 		mime:  "application/javascript",
-		mtime: time.Unix(1483995499, 0),
-		size:  19022,
+		mtime: time.Unix(1792124752, 0),
+		size:  21169,
 	},
 	"js/factomd-ajax.js": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW]o\xdb6\x14}\u05ef\xb8劅Be)[\xf6\xd4T\r\xd0u[1t\xe9Vw\xc0^i\xe9:b,\x93\nI\xc56V\xff\xf7\x81\x1f\xb2$\xc7N\xe3\x15\xd8\xc3\x1e\x02$\xe2\xe1\xb9_\xe7\x1e)\xf3V\x14\x86K\x01w-\xaa\xcd\xd40\x83\x94\x1b\\&p\xcf\xea\x16\x13\xb0\x80\x18\xfe\x8e\x00\xee\x99\x02\x85w\x90\x83\xc0\x15\xfc\xf5\xdb\xfbw\xc64\x1f\xf1\xaeEmh\x1cE`OS)\x14\xb2r\xa3-SQ1q\x83\x90C\x17\x85z&\x00>\xa7\x16\xec\xa0.(\xe49\xfcН\x02dY!\x85\x965\xa6\xb5\xbcq\t\xc1\v 0\x01\x02/\xc0\xdfԍ\x14\x1a\xe3p\xc1F\xa0\x0f\x0f\xb6\x91\xffq\x995((\xf9\xe5\xa7O$\x01\x92fsV\x18\xb9,\xaf,yni\xbb(ߺ\xcaݣ\xd0\x03\xa3Z\xc7gY4\x8a\x92\xc6\xd16\x8av\xad\x9b1ST\u007f\xec\xf7\xef\xff\u07b87\xb6\xea+W\xfb\xae}\xc7Z\xf5\x9c\x92o\xfc\xb5\x89F\xa6\x8a\x8a\xc4iQ\xf3bA\xf7\n|NI:\x02NP)\xa9H\x9cꚗ\xf8gC\xe1\xe2\xfc\x1c\xe2h\x1b\x1f`\x9d\xe8v\xb6\xe4\xe6\x18\xb9\a\xbdaj\xea`Ա<\x8cXHa\x18\x17h\xa3.p\xd3(Ժ\xa7\xc2~\xa6\v\xdc@\x0e\x98\xae*^T\xf0\xf93\xa0\xc5\xff(K\xbc\x8c준>\xa3\x0e\x93\xc3w\x17q7#\x85\xa6U\"\xb4\xf7`J\xbd\xb2\x1e\x1c\xefb\xaf\x8f\xa9\t`\xfdt)\xad\x8f\vi(\xa3\xf5\x03\xd5\xc8\xd9-\xe4\xf0\xeb\xf4\xc3u\xda0\xa5\xf1\x00\xc4\xd6/g\xb7\xe9\xa7M\xe3\xb8I9\xabe\xb1x\x87\xfc\xa62\xa4\x0f\x04\xb0⢔\xab\xb4\x96\x05sU\xe7@|\xe1W\\4\xadq\xea\xb2L\xbb\x055\x9b\x06sOG\x02\xcb\x16\xb0\xd68\x0e\xfa,\ar-\x05\x9e\x1c\xec\x90\\\xefYM\xe3>z\x97\x93\r\xd4qg\x99\u0092+,\f\xfdj\xce\x04H#\xb5!\t\f:\vY\x06S\xb9DSqq\x03sيr\\~_\xe6\x17\x16\xe9\xad\\\tzq~\x1e\xef.<>\xef\xed\xc8\x14\xac\x00\xe7R-\xdf2Â\x0e\u007f\x0e\u007f\xd2\xd8j\xbf;LY\xd3X\x13 6gYZ\xff\xe8\x8a?\x84\ng\xc9\xf1f9\xb7\\\aG\xfa\xfd\xc34X\x92k\x95\u05fe3\x9d\x8e\xb93\x9f\x99,7$N\xa5\xa0gK\xd9jl\x9b\xb3\x84h\xf4K\xb6\xe7!5\x17\v\x92\xec\xef\xbbq*\x86[g\xf3\xd4T\\\xc7)3FQbO\\\xec\x8a\xe9j\x1fbp\xed\x97\xf2?\xd9\xd9S\xb7\xf2\xe0\x82\xf09\xed,\xcd\x1aWܟ<my\\\x1bF\x9a6\x83\x1d\xe9\x17u\x18\xe5\xfba\x02\xbb0~\xca\xd9\x13#8\xe1\r\xd5\xfaŕ<\xcc\xf3\xef6\x8f\xcf\xc7f\xa7\x1b,8\xab'\xcc\xcdo2głħ\xb9У\x8d\xfc\xfa\x85\x1f\u007f)\x9c\xb2\xf2\xef\xb9X<\xba\xf6\x16\xf0\xb4\xd5\x1f!w\xebo+?\x8aZ\b\xb9\x12$\xf13?\xcd\x0e,\x8f\u007f\xc3f\x19|\f\u0080\x157\x15\xd8+\xd6\x03\r\nӿ\u007fw\xe2iU\x9d\x80\xaf$\xe9`\xfd\xcb\xd8\xcd\fr;\x83W\xee\xf7\xd7d\xe4\x0e\t\x90\x8a\x97%\x8a`c\x1dA\xc0\b\xb6t\x98\xf0\x98\f\xfd\xe29={e\xd3\u007f}\x96\xec\x86\xed\xf3x\xd9\xe5\x13\x9ez\xa5\xbd\x84V\xd5vf\xbe\xfc\xd04\x97ThH\xf8\x92\xb8\x8c\xb6\x97\xd1\xe0SC\xe0\xda\\\xcb\x12\x83\xd5X5@>\xfc\xaf\x80t\b\x92\x90\x81?Z`\x10\xb6u\xed\xa2U\n\x85\x99\bY\xe2D\xb4˙\xfb\x8cr6\xe8\x90>\xb5m\xf4O\x00\x00\x00\xff\xff\xe0\xe4EHx\f\x00\x00",
@@ -156,6 +156,20 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1483995492, 0),
 		size:  3192,
 	},
+	"js/logs.js": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8cR\xcbn\xdb0\x10\xbc\xf3+\x16l\x0e\"\xa0\xd2B\x81^\x8c:\xb7\x1eZ$i\x1b\xf7\ahi%\xab\xa5H\x95\xbb\xb2b\x14\xfa\xf7\x82Rl)I\r\xf8ș\xe1\xeckV+\xf8\xee\xad%\xe0=\x82\xf5\x15\x84\xdaU\xb0\xeb\xca\x12\x03\xf8r\x84\x9d/\x10\x8c+\xc0\x14\x05\x81\xc3\x1el퐀\xfdH\xb3\xd9Y\x14\a\x13\xe2\x7f\xba3\xc4[\xfc\x03\x1b\xc8\xceؽyz\xf4=\xc1\x06>f\x99\x10e\xe7r\xae\xbd\x1b\xb9\x1f\x1d\x86c\x12\x90\x90\x15\xfc\x15\x00u\t\xcb'\xbcq\x8d\xd8M\"\xdfE\xfc\xfdX\x1cx狣T\x1a\x9b\x96\x8f\x89\x12\x00\x83\x00\xb8\xd1\x15\xf2\xd7\xed\xb7\x87D\xae\xa2X\xa6ώ\xa5\x0f\x8d\xe15\xc8_\xe4\x9dLG\x8c\xba\x1d\x1d\x89\xb1Y\xcf\xe6gL*}06Q\x93\xd4\xe2\x01\xedB6\xbe_J\xa8v9\xae\x97\xadǞR8\x8d\x1e'l\xff?`d\xf4\xfc\t&\xe0\x11s\x1f\nҥ\x0f\x9fM\xbeO\x16F\xf9\xc9\a .<\xf8\x1e6\xb1\xb9O\x1cn\xa5zf\x82\xef\xb5i[tE2RŭT\x9a\U000498c1\xfeY7\xa8\xae\x93\xde\xc5a\x1f\xcc\xd5\xfa\xedi\x87W\xea\xef\x91\xc8T\xb3\xfb\x85K\xb7\x01G\x87\xe0\xfbI9\xa8\x8b\xc1\x00\x0eRi\xb2u\x8e\xc9\"\x8eJ\al\xfc\x01\xa7\xbc(1\b\xf1\xf6\xf2)\xbc\xbcq\xbe7\xae\xc2y\xfd\xd3\xee\xe7 s\xe8P\x89A\tA\xc8_\x1cc\x88\xa9x%\x8f\x11?\x97*\xbd\xb5\xbe\x97Jה\xc8u\xbe\xc7\xfc7\x16R-\xc31Y\x97\xc6\x12N\xd9\x1eR\xf8\x90e\x99\x12\xaf\v\xff\x1b\x00\x97Чb\xcf\x03\x00\x00",
+		hash:  "90db22bd1992a526b3d25d7a8d52b70379641ebbdd023fe56374b3efd13f4503",
+		mime:  "application/javascript",
+		mtime: time.Unix(1792124669, 0),
+		size:  975,
+	},
+	"js/searches/entry.js": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\\\xccAN\xc30\x10\x85\xe1\xbdO\xf1d\xb2\x98\x11\xc4\xd9 \x96\\\x82\x138\xf6\x10[D\xb6\x14\x0f\x98\xaa\xeaݫ\xa6ٴ\xeb\xf7\xbe\x7f\x9a\xf0ճ\x86$\r\x9a\x04Rt;!ԢR\x14\xb3h\x17)\xfb\x12%\xd4(\xf1\rI\xfe\xe1K\xc4\xec\x9b|\xbc\xe3/Kof \xfb\xb2\xdb\xf1\xb0\xa3\xd6eY\xa5\xe1\x13\u07b2\vk\x0e?\xf4\xfd[\x82\xe6Z\x88q6\xc0@\xd6=\xa2[̲K9\n\xf1\xfd\xf1\x94\xb5x\xc5@\x9arc\xe7U7\xb2ѫ? \xbb\x96j'6\x176\xd7\x01\x00\x1e\xfa\x7fw\xda\x00\x00\x00",
+		hash:  "522d5c122e6d14a70aace9a448f285d152f51cb299b4d2c50da5b7c5995f133d",
+		mime:  "application/javascript",
+		mtime: time.Unix(1792124669, 0),
+		size:  218,
+	},
 	"js/searches/tools.js": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xb4S\xcbn\xdb0\x10<[_\xb1\x90\x03\x84\x84b\xd9\xce!\x87:2\x10\xa4(\xdcSQ\xb4?\xa0\x88+k[\x9b\f\x96\xebDF\xe1\u007f/\xa8\x87\x1d'qR\xa0\b\x0f\x82D\xcd\xcc\xce,\xb9g*\x1e\xa2\x15ގ\xb0\x16d\x9b\xafFd`\x0eC\xb4\x853d\x97\xb1N\x8b\x15\x15\xbfU\xb9\xb1\x85\x90\xb3Jß\b\xa0\xff\x0f\x19\xfc\xfa\xbeA\xde*\xa9\xc8\xebT\xb0\x16\xa5#\x00*U\x8fI\xc9\x1a\xac\xbf\x95*^`\x1dk\x98\xc3h\xaa\x83\xc8\xe0\x88Z\x925*\xce\xe3N$\xbe\xf1\x05\xd1'\x88\x83\xda\xc0\vC\x06\x85\xb3\x0f\xc8\xf2\x85\xddz\x81\xb5:\xa2\xdf\xe7\x8cVT\xaf34\xb9쵴\x8e\x06\xff\f\xf6¡\xe4\x0ep\xe5\x11\xa8\x84W\x824\xde\x0eQ\xde\x0e\xb2\xc0\x1a\x9a\x1c\xc7)~\xba\x8f\xcf\x10\xedt\x14\xf5G\xf7\xbc}\x15\xd6\xeda\x02<\xe4\f\x15\u0590\x85g*\xee\x870٥ҳ\xf1\xb8t\\`G\xf5\xe4\xec\x1e߆9?\x9f5;\xa5cPa\x9b \x83\xc9\f\b\xae\x1b\xad\x15ڥT\xe1;\xc9\xe0R7ذ\x02;ɠ-\x94\x96\xecַUηΠ\xba\xcf\xd9\xe3W+\xc1`\xea7w^X\xd1\x05\\\xea\v\x98^i\xdd\xd6c\x94\r\xdb 3\x8bv/3\xb6\xcd\rmx\x91\xf0\x89\xe3\xd6p6\x99ѵ\x17\xee\xbdR\x92\xf4\xac\xb0\x02+\t\xb4$`\x8a\xce\xe5\x8d(҇NM\xaf:_\xbb\xa7\xee*\xac\x1bwg*\x96n\xac¬\x15\xce\nZ\x19\xf9\xcdz\x9d\xf3\x16搟\x9a31\xcf'l\u007f\xec\xfdK\x83\xea/\xc1\xab\x05b\x9dVd\xf0M\xe8\x9d3\x01\xe7+\xf7\xa8\xf4x\xecWd\xf0\xb3{\xb4j:\x99\xe8\xe6\x1a\x9d\b\x11\x88\xff\x9f\xe0]c\xef\x068d=\x99\xe1o\x00\x00\x00\xff\xff+jE\xfe\xee\x04\x00\x00",
 		hash:  "5ee175433a0e7150d2075be21daeb3c073cb13e0ac90ae3f7348274dc6445795",
@@ -163,6 +177,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1483995189, 0),
 		size:  1262,
 	},
+	"js/siblings.js": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac\x931o\xdb0\x10\x85w\xfd\x8a\a6\x03\x85&\xb2\x87v)\xeat\xcaТH\xd1\x1aٲ\xd0\xe2I\"B\x93\xc2\xf1\x1cW(\xfc\xdf\vJvcw(\x14\xa0\x93\x0e\xe4\xfb\xee\xf1\x9ep\x8b\x05~PÔ:J\x90\x8e\x10\xa2%\xb4\xec,\x1a\x8e[,L\xef\x16\xc9m\xbc\vm*\x9a]\xa8\xc5ŀ\xd3\xc9\xf7\x1d\xf1\xa0K\xfc*\x80\xab\xaa%\xf9\xb2\xfev\xaf\xd5\x05\xa5\xaeq\xe24S\xea'5\xf0l\x18\x9bh\a\xacp\xa5՛\x93\xfcF\xcc\xc6\x13$_\xa9rT沢m/\x83\x9e\x0er\x9b\xea>ZJU\x13\xf9\xceԝ\xfec\x91\a8YL&\x1c\xf7\x93\xc7G\xe1\xdbcK\xc05\x18\xb5\xd5Z\x8c\xec\x12V+\x84\x9d\xf7/(2X\x99\xbe\xa7`\xf5H\xdb[UVB?E\xab\x1bU\x963t\xa3\xc1\x03\xfb\x7f\x89QG\x9fz\x13V\x8f\xeaݣ\xbaD\xef\x98#\xcfrR\x0f\x81\xc9\xd4]\x0e\xef\xe5m\a\x90Ot6R\xce#\tV8\x1b}F\xf7$U\x06\x82\xd9\x12\xde\x1es\xfb\x1ak\xe3\xf1\t\nZ:\x97Ǝ\xa5\xc2\a(U\xfe\xb7p\xce_\xc0\xd1\xd3\\\xaduL\xb5D\x1e6>\xd6O\x1d\xb9\xb6\x93\xb9\xac'c\x89_\xc7<\x85\xb8\x0f\xafC\xd2\x10j\x17ڜ\xe0z*\xc7\xf0rM\xf6\xec\x17\x1e\xbf\xe3\x12\x1c\x9bq\xdcOׇ\xfc9\x94š(\x12\xc9\xe7 \xc4\xcf\xc6\xeb\x8b\xf5\xbc\xc6\xfb\xe5rY\x16\x7f\xedl\xf1{\x00\x9e՞\xfb\xfb\x03\x00\x00",
+		hash:  "bd55c3962efd4387e3017459d56f5cfcc513e8b87cf4c250188ec648126c1155",
+		mime:  "application/javascript",
+		mtime: time.Unix(1792124669, 0),
+		size:  1019,
+	},
 	"js/vendor/foundation.min.js": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xfd}\x97۶\x958\x8e\xff\xffy\x15\x1a4+\x13\x16\xa4\x91\xec&m(\xd3ZǱ\xb7>M\xe2l\xecn7\xabQ\xfd\xe3\x88\xd0\b1\x05*\x044\xe3Ɉ\xfb\xda\u007f\a\x8f\x04HP\xd28i\xb7\xdf\x1e\x9f\x93\xe3\x8c@<^\\\\\xdc{q\x1fV;\xba䤠\xbdw\xcb<e\xecy\x9a\xe7\xcf\xd7x\xf9>\xe2\b\xc3;\xb2\x8a\xce\"\xde#\x94\xf1\x94.q\xb1\xeaa\b\xf9\xba,nz\x14\xdf\xf4\xde\xden\xf1\x8b\xb2,\xca\b<O)-xo\x99\xe6y/\xedɾz)\xeb\xa5=3\x00\x80է\xb1>\x8d\xf5i\xacOc}\x1a\xeb\xd3X\x9f\xc6\xfa\xe7\x19\xeb\xcc\xfc\x1dqx\av\f\xf7\x18/ɒ\x83\xa9\x9d\x05\x16\x9f\xc8*\xba.H\xd6\x1b'I\xf2R\u007f\x19m˂\x17\xfcv\x8bG4\xdd`xw\x9d\x96=\x9c\x9c\x9b\x96\x17,\x9a\xff-Z\xdcMP\x05/\xa2sD\x12<\xc2\x1f\xf02\xe2#^\xbc\xe1%\xa1W\x11\x84\xd3\x12\xf3]I{\xa4\xdf'\xa3\x1c\xd3+\xbe~:\x99\x91\xf9d1\xe2%\xd9D0\x06\xa0\xd2u\xec\x14x=\xf6\x8c\x8f\x96\x05e\xbc\xdc-yQʙ\xc4\xce\xe7\xd6\xc7\x1a\xbcD,L\xf5|\xce\xcb\x1d>\x1fq\xccx\xc4\xe1\xecl\x1c\x9f\xafҜ\xb9E\x93\x98\xb0\xef\xd2\xef\xa2\xc9C\x0eg<ަ%\xc3/\xf3\"\x15_\xeb>i\xddg\x8f\x8fJ\xbc\xcd\xd3%\x8eΣy:\xfce\x01\xa3\xf9\xb3\xe1\xff,\xe0\xf9\x15\x02\x9fM\x86\x9f=\x02pċo\x8a\x1b\\>O\x19\x8e`% \xc8\x12\xf0\xc5\xe8\xd1\xe8\x11@Erw\x8dKF\n\x1a3\xf4n\x9b\xef\xae\be\xf1]\x85\xde\xedv$c\xf1|\x81J\x9e\xc7v\v\xcdȠ\xe49\x10P\x8a\xc0\x9aor\x00G)\xe7e\x042R\x02X!\xd5Q\u074c#\xa2\xf6\x8e%d\xbf\x17ۍ\x8a\x84F\fN\xf9\x9a\xb0\x91\x19x^,\x12Q0g\x8b\x84W\xa8\xc4W\x84q\\~\u007f\xa0\xb7\x19\x8d\b\x8cq\xe4mQc\xcdS>\x12\xab\x91]\x8f\xfe\x03\xf3\x1f\x8b\xaf\xc9\x15\xe1,\xfa\x021\x88\xf8\xe83\x9c\xe3\r\xa6ܬ!\xe5\xe9\x10\f\x18\xdc\xef;\xbf!ե\xd7Z|\x8b\xc0/+5]\xe07o|D\xdck\xcaKru\x85\xcb\b\x10J\xf8\xe8\x97\xd5H\f\x8f\x14l\xe4F\x8c\xb6;\xb6\x8e\xf4\xa0\x15\xda\xd1N\xd8(Ȑ\x84F\x02(\x9d\x93\xf3\xc0e\xb6A\rŶ9Y\xe2\xc8-\"4\xc3\x1f^\xaf\xcc\x04\xd0ě}\x897\xc55~\xe6\x02\x88@]\xfaus\\\xbb\xd4\f3^\x16\xb78S\xeb%p\xba*\xcaHnk\x8f\xd0\x1e\x87\\\xa0\x01\xdd\xe5\xb9\xc0\x84W\x94\xf0z\x95ج\x12\xbbT\x8aOyy+\xe8\b\x81x\x84\xd3\xe5:r\xf0\x96\xcb\x05\xc16 \xde\t\x98G\xb0\x82S\x9c3\xac\xf1J\x9ckA\xf7P!\xb1\x06\xa5\xc9]q\xf9\x13^\xfas\xc0\xa3UQ\xbe\xf0\x06\x12\xa5\x02\xf6\x10\xf1\b\xcc54\xf0\x00,\x00\x1c\xad\x8a\x1d\xcdRY\r\xc8Q\x01\xac`\x85\x98\xa4S\xee\x19\xbbW\x0fhG3\xbc\"\x14gn\x17r\xf7Ԕ\xa3\xd7\xf2\u007f\xa3\xf7\xf8\x96E\x85=l\x10V\xd54\x9d\xb3E\x84aU-S\xbe\\G%\xbc\x13xQ\xe4x\x84%\x85/a\xb5\"4\xcd\xf3[CrpU!\xe7\f\xb9\xa7\x12\xd7t)\xe1\xfb\xfd\x17\xe8۔\xafG\xa5\x98s$\xff\xdc\x167\xd1\xe3/\x10\x1fL\xe0P}KiVl\"\xf8\xd0\xfb\faM\xbd\x1f\u007f\x01GL\xe2\xe3\x04\x0e\"<\x03\x02\x181\x10\xeb.\xf1*/n\x9c\rAT\\0\x06\x1a 1\xbbHg4qa\xe0\xd1\x1c\x18\x03\xb5\x01N\xfd~?\xa2ɜ.\xe0TcÚ\xb0)W(E\x91\x1d\x90\xa2B\xe1a\x9ax4\f\x95\t\x8f0\x1c\xad\b\xcd\xea-,\xd4\x16\xa6Y\xf6U\xba|\xdf,\x9f\x96-\x8cUw\x9d\xc6[D\x93\xbbjJV\x11na0t\xaf.\xb3}7iI#\xf0\xb6$8\xeb\xf1\xa2'p\x85\xa49\xf9\x05\xf7䈽\x82\xf6R\xda\xd3'\xb8\xc7\xd7)\xef\xa5y\x89\xd3춷\x967\xf8K\x8bj=\xb5\xb2\x11\x80j|\x87\x12\x16[Q\x81\x01(\xceB\xa8\\\x12\x13\x1e\x81\xa9D\xde\xc6Y\x91\x18\xa3!l*\xc6\x00\x8e6\xe96r\t\x9a\xbd\xec\xd4m]\xc1)\x9b\x8f\x17b\x93\xe6\xe2\x8fEB\"6\x9f,\xa08M\x82\x02\xb4@\x84\x04ےF\x16\x94\x10j|gM|gM|\xaf*yF\xaf0\u007fI\xbf\x13\x17?F\xbcL)#br\x98f-ʋ\x11I\xee\xea*1\xf0\xaa\x03\xf4W|\xf9\x9e\xf0\xb7N\x85\x9bF\xc9\vQ\xed\xdb◷ݝ\xbcv\xbf\x15\xfe\xc7\n\xd1$+\x96;I\x9a\x97%N9~\xa1\xb6Y\\\xcfנAg\tt\x8e̙=\x02#\xc6os<g\x02\xcc8!s\xb6\xb0l\x14\x9e\xe18\xc2\t\xc3\xfc-\xd9\xe0b\xc7=2k\b\xfc\x9fR\x9a\xe5\x82\xce7\xa6>\xe7\vX\x89\v\xa4\xf1AP\xa3b\xb4\xe3$O\xee\x04\xaf\xc9y\x8e\xe36\xaa\x10y#\x98\xa94N\vUĚ%iy%\xd7Ϧ\xa2v\x92$\xa4ߏH\xe7\x94\xd3\xed6\xbf\x8d\xa8\xe0\x06\x88\xbeq\xb0 \x8f\xd5T\x9dn[\x99\xd8q\x14\x98\bb\x82\x05\xda`\x9e:\xb4y\xb8\xf9\x19@\x94\x8a/#Z\f\u007fb\xea\xe40\xcd{\xee\xf7<z\xf0D\xb4Q\x1cs\x02\xfc\xa6O\x1f@1#L\xb3\xb7Edwr\x8d\xd3\f\xa2T\xf7\xd1\xef\xa7\xfa\x82}.\xba\x88\x80\x1e\ay\xf4/\xa1\xb0\x18}\x8b3\x92\xfe\xe7\x0e\x97\xb7\xe6\xaaC\xc5H\xd1Nu\x1eԽGV\x91!\x84g\xa2a\x90\xdf\xff+~P\xe2\x1e+\xca\xf2\x16\xf5\xc0\x80\x0e@\x8f\xb0\x9e\x10\x01\xd2\xdeu\x9a\x93\xac\xb7M\xcbt\x839.G\xbd\x1f\x8b]o\xb3c\xbc'x\xfe\xb4\xa7:\xef\x95x[b\x86)\x17?\xf8\x1a\xf76\x98\xaf\x8b\xacw[\xecz7\x84\xad\x15\xb5\xba.\xdecAp\x04\xb4\xcb\xe4YY\xa6\xb7\x0e\xc3-/\x84\x91\x90:\"\xbb\xd3\x02\xa5r\xc5\xe25\t\xe4\xd4\x15-\xf2\xfd\xbe\xfe{N\x16\xceB\u007f\xc0+\\b\xba\f\xae\xf6\x01\x18\x90\x01x`\xd7K{\xe9uJ\xf2\xf42\xb7KX\x15e\x0f\f\xa2|\x86\xa3\x1c\xc6@\xcc\xc5PX\x00\a@\xacg\"xf1G\xb5\x8d31\x03\x8d~9*a,\xbf\xf9\x17\x81\xbc֜z<\xa2m.\x06\x95\x82L\x19B\xb9&\xac\x9a\xde\x10\x9a\x157\xa3\x9a\x8e'\x05\xe2\xa3\x15u\x105I\x91s\x0e\xbeN9\x1e\xd1\xe2\xa6\xdf\xd7MM\xc1~\x1f5J\x12\xfbGK2\x88\x04$\xc5g8\xbaR\xc7MRlCsx2\xd7\x04\x0f \xb0)~\x01\v\x84\x93\xf1\x14?\xe1\x16\xb3\xcf\xf4`%\xfey\x87\x19\u007fF\xc9F\xce\xf6\xa5\xc0\xac\xe9``)\x01\x9f\xe3\xc5\xf4P\xe5D}\x9c\x93\x01\xf8!\xf4\x1d,\x90n\xbe\x14,d\xde\xd9\xfay\xe03X\xec\xf7\xcd\x1a\x1d\xa3Td\x15\x9d\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf\xe9}\xa1\x85?=\t\x9a^\x93\xabTH\x91;\x86\xcbgW\x98r\xb8\xdf\x1f\x04G\xfd9\xb4\x02C\xadƇ\xa1Ժ\xc2\xec\xf6F\x82 J\xdel\x93~\x88\xe8`\xf2\x05\xc2\xf6\x1e蠦\x11M\b\xac\x10\x19bX\x1d\x04\xef2\xc7i\xa9{\xa8t\xc5-.WE\xb9\x11\xd5-\x1a:e>6:\x1f\x92;\xc6Ӓ\xc7\xceĩ\xcb\x1bZ&\xa2\xae0\x94gM6\x13\x97|\x04Q@\xebpIh\xb6\xdfG\x1d_<\xc8\t\x02j\xd5\x1e\xf6\"\x95\x146HK;\xfa\xec\r{7\x82\x1b#\xac\xc7\xcb[I$\x8b\xde%\xee]\x8aSk\xa8\x8f }\x82\xf2h\n\x89O\xa6\x90Dݐ\xd4=\xb7\x15b\xedc\xdc#\x86\xdc\b*\xe6\bYt&Jb\x8e\xa4\xdec\x99\xf2蔱!\x84\xd5\xd4!Ouu\xc1\xc6տ\x12\xff#DΏD\x00\x90\"&6\xeb'y\xa1A\xe4)\x97|}\x92\x02̝\x19\xb6\xbe\xdc\xcc\xce\b6\x86'\x86\xab4R\x06\x1aN \x9c\xe1\x9a#\xed\x03!\xcbf\xbb%\x8eB\xec\b\xaeU0\x17\x83\xf3+\x04z5כ\x00\xc1\xb7\x93\xf9x\x81XB擅\x01\x01M2\xbc,2\xfc\x97\x1f^=/6[\xc1\x01\t\xb2\x8eXb\xef&6\x13\x8cH\x1c\xa8'u\x16딽\xbe\xa1ߗ\xc5\x16\x97\xfc6\xa2p\xa6\xb6\x810\xf9\xff\x88\v\xf1e&\xfeU\xaa\x03\x06c\xf1#\x99\x8b\u007f\x11[\xa8_\f\xf1\n\xddU0ƕZ\xcd\xdd\xcf;\\\x12,\x15?\xcb]Yb\xcac\x00\x90d\x1c\xe2\x06\xb7E\x90\xe5\xb7\x04\xa7\xd3\xe0\u007fFK\xc1\x96\xac\nʇ\xabtC\xf2[q\x0f'8b\xf5]P(\xfe\x934\x17S\xc0~\x9f\x8e\xf4L\xd4\xf4\xef\xa4ҭ@\xd7i\xbe\xc31(h~\xdbc\xcb\x12c!\xc6d\xbdhC\xe8\xf0\x86d|\x1d\xf7\xc0\x80̋\xc5\x00@Pi\xbd\x86^\x88\u00adwW\x98?W\x05o\xc8/82j\x96\x1b!\x10\xe02\x82\x15J\xf978e\xbc\xcdګ\x1e\xae0\x8f\xb8\xc3\rkj\xb4\x11\x1dHfK\xc8~\xf2\x17f\xf1\xd9D\n\x0f^_f\xfdX\xea9D\x97z\xad\x90\xac\"\xf7w\x130\x18ګϩ%nA\xd1P\xb0\xb9JMj\x8f\xb0\x04\x97a\v\x14c\xdbX\xbf\xbb\xa9\xf6\x8e6W\xb2;\x15u;O\xf1`\xd05\x876\x1c\xf4\x04,4\xfa\xfd\x88\x8b\xcbA\x9fH\xa5\x9e\xa8\xe5n>\xe3Z\xb9Z!\xb3\x1fq[ \x96¸\xbe\x03ਠ\x11(1#\xbf\xe0\xd1/\xab\xd1F\x8c+\xe6t\vP\x13]\x13\x1c\xd8|\x9a`\x83\x1fS\"\x18_!\xefX\x8c!\xa8\x1e\xc8*\xae\x96\xeb\x94^)\xb5\x95;ܜ \xaad\xd0jZ3]\x0e\xfb\x9d\x10\xd4\x02Q}\x99\xd5e.1\xf6\xf4\xe5\x8a\u007f\xd2\xf5\xa5|\xa6\xfb0]\x88_\x02\x15\xce,\xc2v\t\x82\xb25\x10\xf7\x81\xadq\x85\xb9\xfe̾\xba}\x9b^\ti7\x02lY\x92-\aP\xd00\xaad/<\x92\xd4\x18p\xfc\x81\x9f/\x19\x03\b\x8fH\x96\x00\xb9\x029\x87\x9f\xd8P\xf05\x00\x91\xd16\x15\x80\xfc\xae\xc8\xf0\x88P\x86K\xfe\x15^\x15%\x8e0\"\x02\xf8@lH\xb1\xd9\xee8\xce\xde\xc89\x11\xdaS˱\xf7\u007f\xb3\x8a\xe0\x88wy\x0e\xf7{\xbbQ\xb2\x1c\xf1\xe4\xceBq\xb7\t\xe8D\xc1\xbf\xcb\xe9\xf5\xc0\x80\x0f\xc0]\xefw\xad\x19\xf7\xeez\x9a\x8aL\xb6\x1f\xa6\xbd\xaaW\x01{\xce\x15\xc4߬1\xe63\xf7\x87 to\xf1\a\x9e\x90\x18\x8f\x04L\x9e\x17\x94+\xe4\x01\x93\xed\a)\x86\x8dd\xafUU5\xe5V\xab2\xbb3\x04\x83\x8f\x9c5Dx\xbf\ai\x9e\x03\x88\xe44c\U000fbaaa\bBԁg\xf7\xbb\x1fͱ'J\xed\x8a\xe7|N\x16\x8bD\xfck\x17o\xae\x87/c\xf0\xf6\xd9W\x00M\x1e\xc7\xe0\xc5wo_\xfc\x00У?\xc4\xe0ś\xe7Ͼ\u007f\x01\xd0\xe3G1x\xf3\xfd\xb3\xe7\xe2\xcf?\xc4\xe0\xd9\x0f?\xbc\xfe\xeb\xbbo^\xbc|\v\xd0\xe3?\x9a\xdf\u007f\xf9\x1e\xa0\xc7_\x9a_?\xbc\xfa\x8f?\xbd\x05\xe8\xf7cS\xf0\xf5\xeb\xbf~'\x95\x18\x921\xb9{\x8foY\x8c#\x02\x91|\x0f\xf93\xbe\r\xd0e2磛5Y\n\xd9z\xf4\x1e\xdf>/2\xbc\xd8\xef\x95\x0eq\xb4*\x8b\xcd\xf3uZ\x8a\xd2HW\x84#^\xfce\xbb\xb5O\x04V\xc7\xc4\xd6d\xc5\xff\x8co\xa5\xde\x03\xbc\xf9ӫ\x97o߁\x01\x16w\ue497\xb9\xfd\xf2\xfc\xed\x0fߘ\x0fi^\xb7x\xf6\x8d\xae\x8f+\xb4\x96:\x10o\xca\x18\x11\xc4Դ\v\x94\xa2\x12\xe5\t\x9d\x93\x05\xdai\xceG\xaf1\xc2Rj=\xcb\r-\xf7\xf5z\x96\x19\x90ܠ\x16\xf8ϔ\xa4[$!\x1dh>\xcay9\xcbc\acJ\x9eGp\xc6G\xf8\x03\xc74\x8b\xee*$+\xa1\\|\x81\xb1\xff\xa1\xe4\xb9\xfa\fQ\x9a\x14\xf3\xdd\x02\x95\t\x9b\xa7\vT\xf6\xfb5\xdbk\x87+\xd5\x12\xb3\xa4\xd4l$\x9cF\xe2:\x13\xf0\xc8\xf6\xfb@\v\xfb\x15\xf6\xfb\xf6\xef(\x83\x15\xce\x19\x8e\xd8hG\x0f\xb7\xb6\xdfe{\xfbK\xdc\xe7+B\xb3\x97\xc5r\xc7\x04\xcb\x1c\xb7\xcf^\x0fkUm:_\x97x\xb5@\xbd\xb4\xc4\xf6oB\xb7;\x1eӂG\xf3\x8c\xc8.\xb2\x05D=\x86s\xbc\f\x94\v\n \x9a\xb7\xbf\\\xee8/h\xbb\x9c\xac\x848\x84z\xea:D=\xbc\xb9\xc4\x19\xea=\x9c\xf3\xf4R>\xbc,ď\xa5\xa2*8#\\\xb4\x94\xaf\x01$縌ڼ\xbby\xeb ,\x02\xf15aD\x8a\n\xfd\xfe\x99Q\x81j]\xad\x19\x00\xc0'c\xa9\xe64\xafJ\r\xc5\x1b\x9d\xf3E\x82\xfd{\xed\xcf\xf8\xf6\xb2H\xcb,a'P\x1b$\xc8\xfd\x9d\xf3\x84Y\xc2;q\xf7\xa5IX\xc6\x13\xb4\xadH\xcaa\x8a\x8c\x18\"\xce\xd9\xd3bƒC\x92lD\x11\x86qt@\xe8\x14\xcc3\xae\xaf\xf2\x15\xa1\x84\xad\xd5]\x9eʊ\x18\xa09^\xc0\x96\x16\xb3\xb3\xa6~QE\x05J\xd5\xf5xl\x8a\xd0}\x1e\x16\xe4\xa0@\xa9\x03\x9b2\x82wx\xbf'\xa35\xc9\x04\x83\x92G\x10\xa5\xfd~\xaa\xe1@\x9c\xe6y\x04\xef\x84p\xa1.\xa3Q\xadO\xfdzW*\xfd\xce\x18\x11OM\xb8\x1b\x80\x1e\x18d\xf2\xdf\x02Vd\x15\x91\x84G\x04\x8e\xf0\xcf\xd1\x18\"\xf3:\xae\x0e\xef.\xc13:\x1f/b:\x9f,P\x96\xe0\x19\x13\xbf\x98\x90cĬ\xc8(\xcd2\xd5o\xa1\xd9\xfdz\n\x00\x01ZP\xc1b\x84\xc1\xe0 \xad\xd3\xcf\x0e\"\xdc\xef\x93\x11[\v\x1c\xa8Ni,\xd6_\xacV\f\xf3\xbf\x8a;\x16\x91\xc0LԳ\x8b\x1a\"\x13ݒQAq\xe4`\xb3\xa7\x8b\x16\x97M\t\xabJ)O\xe6`\xb3#Cq\xf4J\x80\xe4\xdf9N\xaf1\x102\x9d\xf3m\x98.9\xb9\xc6n\x15S\xb4@Er\xa7\x91\xe6\x95\xf7\x8e-u{$:\x1b#\xf5w\x85t\xb5\xd7;\x1e\xaa71\xf5|\x16\xb3\xb8\xc6\t\xf6\x98\x81B)\xfc:\x8e\xa6\xbe\xfa_\xe2\x94{,\xb6\x15j\xadܮ\x11\xe2I2qԦ\xf6\xeb|\xb2\x98\x81_V vK\xa6\xe6%\xa8,r\t\x0eLw\x97i\xa9U\x15\x82\xdfV\xc46'\xc6j\xe0NԌeE\xc2\xf1\x06TB\x10\x06\x84\r\xa5\xcau\xc8v\x97\xe2\x13@E\xc2\x06`(\xab\xa0\xb4]a\xa8xM0\xb5\xe4<^\x91\x92q\xd0\"wh\f\x11=\xf2\xde&ą\xe5\x9a\xe4Y\x89i\x04v\xb9\xb8\\\xad\x8a2\xc25B\xa5f\x15 -I:\\\xa7l[lw[\x10\x9f\x8d\x91*\xc2\x1f\xb6)\xcdp\x06ⳉ.\xca\xd3K\x9c\x83\xd8\x1d\xa2\x9e\xad\xb8B\"\x85\xa6v\x14\xa0\x17\xd9\x03\x03f\a\x94om\x06<BN\xd7S Y\x86\xa9\x9c@\rYPAA\xfa\x14\x8c\xcc\xeb\xa3n+.\x13\xb34ge\x02\xc2\x06\xb6\x02\xea\x8ah\xc0\n}\xb5+i\xf0m&\xe2\xee\xe6\xbav\x01\xf5U\x83\xd4\xc6agg\xa5\x96\xc4n\xad\xd9{\x1c\xd8Z\xd3\xffC۽\x9a*\x91$\x8d\xca\u007f\x99|\x95\xf0gn\xa0G\x989\x94\xb0m\xb6`\xa7\xa3HHF\xd86Oo%\xfd\xf0\x0f\xdcw\x98\xf1\x04\x9fv\xef\xd1\x06\xbb\x87v\t\x89\xb8zJ5L\x12\x11\xec^\x9a\xec4!\x1b\xf1b;؍֘\\\xad\xf9\x93$\xd3\u007f\r2\xe7;*\xbc\xeaO\x13\xefcY\u007f\xcc\xf1\x8a;_\xc5O\x94\xfb\x9f\a;%\x9f\x88\xa1\xe4\x1f\x03\xaf\xba\xe4\xc0z\x9d\xd3\x13\x8d\xc5}\xf75\xd903S\xaf\xec\xc0\xa4\xbb\xea\xb5\xe6\x1f\xaaxx)^\vY&\xe9\xcf:\x99\xa7\xa8\x10\x1bQ+\xe3fe\x92$y\x92\x9c\x8dc6+\x04\x81\x93\u007f\x0f'I\x92\xac\xad\xed\xcb\xd9Ļ\xb9\x8d\xa5\x1cO\xcc\xcbŌ\x8b\xeb\x91#\x9e$\x9a\x05\xd8\xef\xc5\xdfF\xc2v\xd4\xc0Z\x05\xfc\xea\xc1\xc6</}\x9d^\xe3QO\x14\xa4\xab2%Y\xefUo\x99\xd2\a\xbc\x97\x15\xf2u\xde<\x86\x91DJ\xea_\td$\xf4\xeayN0\xe5?\xe0%\x97\xba\f\xee\xca\xdb]\xd5X-\xf3_\x16\xd9mg\xbd\xc22g\xe9\x15\xfe\xf1\xb5\x042J\xdd\xc2\xffV\x85\x1a\x90wJt&\n\xdaH\xa1BL4N \xb5K\xf1\x1d/\xb61\x918T \xb1e1Q;\x97VHM^\xecX\xac;\xa3~g4\xd4\x19u;\xa3\xa63\xf3\xf6\xe0\xf6\xc6\xfc\xdeX\xa87\xddOZU\x95gs\xa7\x8e\xb2b\xd6ԫ\xa48\xc6(O\xf0L\x1c\xdfX\xf1~7\x84/\xd7\x11\x85w˔a\xc0\x8b-\x885pd\xaf-\xe9+w\x91wX\xea㗫\xff\xc7\xdeW$f\x97;\xa7d\x18\x95\xe6\xbc1XM刢\xa2?\xa4?Bd\x86(`\xab?\xddE)z<Ї\x99ݠ\xe8\xeaa)\x19\xa2^k\xf5\xc1n\xce\x1f\x99e\x9f?:i\x89\xba\xf7˂\xf3b\xe3\x0f\x90Ί\x8f\x18d\x90\xdb1\xfc!~\r0m\xa7rh\xf3\xa7\xdf\xff\xe9\x90\x1eL>f\x00\xbf벃\x84\x0e\xca\x16\xa5l\x81\xab\f\xd3i\xbfi\xf7tJ|\x8d\xd3ܟN\xd4\x1e\xd6\f\n\x8f\x8cʼn{\xab]\x9e\x9f\xb6\xd4C\xbd:\a(\x88[\x1f\xb1\xf5\xee\x81:\xdeg\xbd\xdb\x06\x0e\x87z\xce\xf0*\xdd\xe5\xfc\xefG]\xdcSQUN\xd7_\x15\x1f\x92\xbbW\x9b\xef\n\xfe\xb6\xd8-ׄ^\xfdX\xecb\x8c\xfe\x03\vʍ)#\x05e1\x11\xbf\xd5\xf5\xc0bZ\x1d\xe7\x95\xe0]!\xae0q?\t\xd92\xf2\xc5d\xfb\xe6`8\xd7[\xcc\xc9\xf0\xb2\xf8\xb0\x90\xcc\xe3\x1cde\xb1͊\x1b!\xed\xf1\xa2\xc89\xd9\x02d\xf0N>\x8f\xe0~?jY\xfa\xe1\x19U\xefK\x18ƭ\xe7\b\xdc\xef\xb7\x1b\xccǋ\x195/\x9f\x18ƾ\xf9\x18P\xf6\x18=\x9an0S\xc6/\x97X[\xbe0\x00\x9b\x126Kh\x87\xb1\x1bX\xe6\x05\xc3\x1b\xaclcy\x05G?\x15\x84F\xa0\a\xa0\xfb\xf6\xb1ZEL>\x810\xe4j\x18ͻ\xbf\xb2\x9ag\xdbt\x89͓\xe4H\xa9\xf2Y\xe2\x18\x97Re\x99H\v\x1e=𡛀\a\x032x\x00\x16\x0f\xe0\x94\x1d\x16\x98\xa6\xb8\xa53\x91k\x10+\xd0\x1f\x94\xc2D\x1aԹW\xab\xdd\\%`\n6\xc6\xccM=\xec,\x00\x9cR+\xa0\xf8\xcbw\xde~\xcc(\xcd7!;\xba\x9d:\x83w\xa4\xdfw\xed\x0f\x84\xc8\xdfe\x1f\x96\xee\xf7\xb4Ӝ\xb8\xb9f5\xecƟP%\xc4M\xc7<\x12_\vYY\"\xa8\xa8\r`\x85\xf0~?\x91:8\v\x18v\b0lY\x16y~\x100\xaaJ\x1b0\xed\xf2\u007f\b`\u0530\xa7\x02F\xd5\x0e\x03\xa6\x88\x94\x8bJ\xaau\xe3g\x93i\xe3\xb1K\xbeȽ\x91\nڢ|\x96\xe7\rtB=\x0f\x88\xe6\xe7f\xc7S.\x90\r\x91\xa4\xad\x12\xe1\x918\xfb#\x9e\x96W\x98C\xc3\xe9\x91\xc0\xec\xa1\xe6\xfe\xf4\xe6\nf\xf7\x04$As\xb2\x80\xd3\xcb\x12\xa7\xefյ\xa1A\x10h\x1e\x02%\x9a\xdb\xd7E\x87]\xb7=\xfa\xd7\xc5٤ҶĚ\x1a\x99' \x9a\x8c\xa7\xf4Ibʇ\x93)5\xef\xbd,QF\xb4DP\x82\xe2\x92\xe1\xf2\x1aGxN\x17\xe8N\x80\x80\\\xee8f\xf1\xd9\x18I\x8d\xc67\x84\xf1\xf8l\x82\x96\xeb\xb4L\x97\x1c\x97_\xa7<\x15\x05lw\xc9K\x8cş\xb6\xd9K\xa9ǎ\xe7\x1e\x14\x17\x95\xd6\xc0y\uf86e\x19\xd9_\xf1埥\x19ٷ\xc5/\x00\x81\xd7\xe2/\x81;-\x932\xf9h-D\xb59^\f\xc0\xb7b\x9bIA_\xab5\x94\xf5\x8b\xa3yk\xd1V]\x1dՍ\xdcx6\xa9\"\x88\xca\xc4'\xbc\xda\xf6\x98X\x1b\x90^\xc8\xf2\x99\x8as\x02~\a\x06\x14\xce\x15\x99\x04I\x92\x90\x190\xdb\x19\x03\u007f\xd3\xc1\"\"\x03\x10\xa2\xa5SnmD\xd5\xf9^\xe6d\xf9ޫ\xa9\xb1\xbf\xd8b\xba\xf0\x9e\xc5Kk\x12\r\xc4Gy\x1eO\xeeNN{\xd1zf\xb7\x17\x82\xb6QT\xab\x83S<sFӅq\x83X\x04\xae\x8c{M\x89\x17WW9\xee\\\xa3\xfa\xdc\xd1e\xf3\xaarV\xa9\xde[\x90\xef\xf9\xc1x\xb1\xfd\xbe,\xb6\xe9\x95\xf2ǰ\xe2yk\xf5\xdaP\v\x803\xb1\xc5--\xed\xa8V\xf9ک\x12\xd4MQ\xf5d\xb3\xd1/+\xb1\x14\v\xc3U\x9a\xc9>\xba*\xb6ּ*\x96;欹w\x99\xef\xcan\xb0\x0ee\xfd\xe3\x1b\xee֖܊\xc0sܾ\x10T=\x1f\xa5uO\v5]s\x9d\xe5E\x9a\xb9W\x8c\xb4(u\xd5ݯ\xfe\x84\xd3\xf2\xc7bw\xaaN\xce0HB\x9e7\xfe=x\x94\xe9W\x13T\xfa\xde\"j]P\xf0M\xfb=\xe0d#\xa6\x9a'É\xb2.\"\xec\xfbt\xc7p\x96\x9cM\x94)Q\x89\xa5Q\xa1K\xb6Dm\xe4]\xabƽKV\x8d`\xe5\xfcJ\x9an<\xee\b\xcdN\xf2d\xfc4\xc9gi\x9c#\xe3䵕\x95\x01:\x9b\b\xd6ر\x88d\x1d\xf78\x1e\x11\xba\"\x94p\xdc\xef\x17f\xfa\x9a\tG9D\x8e\u007f\x9a\\\xbd\xfc\xae8\xd3\xd2\xcc\\\x0ez`\xe6\xe3\xf6\xf2\x9b\xd3\x1d\x1bc\xc6z\xca\xd3|\x98\xe0!m\xcd@\xb5\xb1Sh\xbc\xa8y/\x8d\xf0\x8e\r\x87HZ\xd4\xf5\xfb\xc4:@\x9a+nZ\u007fA\x01\xa71i>Vl\xb69\xe6xF#\x18\x87\x9c%d-\x9a\xf2]\x99\xe6\xf2\x11\xaa\xdfo\x15=\x1d\xcb\xe6\xe6\xa8\x14\x14G@\xe0\xb5w\x9ch\xa4\xb8c\a\xb5\x05\xc0J\xffq\xa7\xa0\xaf6\xe9\x15f\xdf\x14i\x863\xd7ڣS\xb4\xd2x\xb9)\xae\xf1\vq\xbd\x8a\xeb\x19Su\nw˵(\a\x88@t\xa4\x9et\xe0\xc0\x10풳\x89\vs\xa24\xa1#\xb6-\xf8\x9b\x1b\xb2ţm)\xaf\xf1\xaf\x15\xdb\xd1\xef\x93FI\x04\xd1\xce\x1e\u0084\x8cT\xf7LpXR\xb1\x88\xd2$j\x95\xfe\x88\x8a!\x83\xd3<\t٘\x0fK\xe5n\x96^\xb2(\x85O\x13w6b=o\xd7%f\xeb\"\xcf\xfa\xfd\xfc\x89\xf7U`\x94\xf35\xa2I\xfat<Ӫ4\xad\x0e\x83\x88\xf6\xfbQ`\x15\xda\xd0U\x91\xed\x16\xa5fb\x00\x80h\xabh@\xa1/\x04qx7I\x12n\x96\\\xbf4\x15u\xa1\v\x1d\xde\x06\xceN\x9c\xb22\b\x1c\xb5\xb1i\x96\x1d\xd8}I/\x0e\xd5S\xbb/\xaayR\x8aƮf#}\x06:\xfa\x92$\x04 *z\xab\x9cͨݑ\xc1d4\x1e\x8d\x01\xc2T\x1aJĠ\xa0NKB{\x96\xdb7\u007fh\xeb5\xe4\xef\x91\xe04\xbd\xfd\x8f\xff\xf09\xf2\xb6<~4\x1e+O\x9f\xfa\x89\xe6l2\xe5#\xd9͈m\xf1\x92\xa4\xf9\x88\xa9\xf91\xccwۘUH\xfb\x03\xce\x15\xa2 \xb0\xdb\x02\x04\xb4\nB!\xcd»\xc5\xfd\xee\xe6\x06\x11\x04\x94\x16\xa6\xdb\xc0\xad/E6\x85F|D\x8bb\v\xab\xaa\xd3\x02Z\xfaw\xa4Y&53-j\xecS7\x82$\x17J\xa14;w\xf7\xa5gѢg6^\xfd\xa1,*<\x92\x85#\xb9,I\xb74\xf9\x0ey\xc4\U00051d9a|\xab\xb0\xb66\x8b\xbe\xabǍ\xc1\xa6\x10t]\xc2\xd0\xceA\x97*,5\xf3х\xbb-\xa8P\x91\xb09\x976\x89\x8b)\xf8V\x14K\x8cs-\t\x03\x16CZX\xaa\xebϔ\x81y\xab<*\xd0\xdd\xe5\xee\xf22\xd7⍄\x81\xb4$:\x1b#e\x83\xfc\xdf1\x1d\xe9\xbftɏ\xb6\xe4G\xb4\x94\x8f*\xa2\x8e\xfeK\x97\xfchK~\xac`\x1c\xb5\x8d5\xe5\xe8\xee\x8a\x04\xb9\x11\xf7\xb47\xb9\xb3\xb1\xf8OM\x1bMP=\x93z\x06\xf5\xc8vDt6\xa9\xff\x1b+\x83J!\x8c+\xf9v\x94\x11\xb6M\xf9r\xad\x06\xc1\x02\xe7\f\xc6\xc9]~\xa7\xa6(\x1f_=\xf9\xcc\xd0\x05\xae\x1eȬma2\x9e\x92'\xf6\xd2%F\xaa\xa4\t\x9e\x93Ŕ\x8e0\xddmp)\xc0\x9a\xb8?\xf6\xfb3\xb1\xa4eAW\xe4j\xa7\xbe\x9f\x8d\x11\x90v\xc5b\x87\xa5\xaf\xee\xe8\xa6TVQ\xc9\xd9\x18\"ͼ\xa9k\xda\xdaNsD\x05?'\x8d'ZV\x98H\x1e\x86:L\x04\x8fp\xedw -Ue\x19\xa2\x10\u1a8a\xe04`N\x11\x82\x02\xae=\x06\xefaRqW\xf9\x06\x15\xad \x1d\x82cŚX\x1b/\xfc\x84\xa8\xdf\xda\xf56q\xcd\xf7\xf0H\xcb\xff\xcco\xa39[\xb1,e\x04\xaf\xfd\x03]E\xb2\x17g@\r\r\x9e]\x92L\xc8Q\x1a`.2D\x18\xcd\xef\xde\xe3\xdbX\xfb\xa7k\x8b\xfd&)\xfaLZ\xd5)\xcf\xeaz:\xeam_~\xaa\r錩\x1d0\x93T\n\x82\bV\x15\xd2#\x19\xb5Qk,\xd7b\xdc\x1bIj\xc8F\xa9Z\x87\xd1\x17b\xc9ԪB\x8fƍ\xe4ǗE)\xbd\x8f\x15I\xde]nHG}kU(]\"S\x8eMK\x04V\x04\xe7\xd9sI\n\x81q\x05\xd4;fk\xbf\xa6\xfa\xee\xd4@R\x93U\xf4\xb3\x1eP˭\x8dR亪\xd6\x13x%:\x8a\xb8`\xa9\x94\xfeJ\nX\xee\xe09\xb9\xc6\xff\xa5k\x87\x86\x97\u007f7Go\x14\xdec\xf0z\xef\x94'j\x17\x9e\x98\xe0\f\xa6z\x89\u007fޑ\x12g\xf2 \xb4\x1a)/\xac3\x13\xac\xc3T\xb6.\xf2gc}E\x9d\x8d\x8d\x02\x8fK\xa5\xde\xed\x16k\xa5\xddRt|Y|\x001N\xe47Y\x803O3\xa7\xf01v~\f\v\x8a\xfd\x82\xcd.\xe7d\x9bc\x10\x9bg{\x85\xdd\n౪%\xe6\xe6\x98\x15I\u007f\x89\b\xee\xf7\x11N\xce&M\xdd\x1dW_\xfb}\xfd\x87u'V\xb5+'@\x83\x02\x96\xb2{-7\xd2\xda \x04,}<F\x8c\\\xe6\x84^\xe9\xc8\b\x06%V\xa6\xa9Ѥ\xd6>/\xee\xd0\xc6\xe6 \xd2\x01\x0f\x8e\xf4!)\xa83\xc1o\xa4%T\xf7\xe4\xc4\x1e\x90L;\xae5H\xc5\x03iF5_\x15e\x02\x1e\f\xb0~\xa8\xb0\xde/\xda,\x83\xc4|$5!\x8cG@\x19^Ao\n?\xa4\x19)\xe4<\xda4\x04\xbb\xde.\xd2[\xc4{\xb0\x91\x86y\xe6)\x87d\xa8H\xc8\xc192\u007f\x8eE\r\xc8\"\x91\xbcXs\xa2\x10\x15\xf3\xf1\xa2\xaa\r\xd4#ZO^0\xd7\x02\xb8\x92\xf2bv\x00\x8a\x02v\x16\xda\x117\x8e\x80#\x0fE\"\x0e\xa78d\b\xe6S\n\xd1E=l\xfd\xa6%\xf07\xdc\xc4b\x81n\xc1;\xeaIz\xe2Tt\x95\xeb\x84Jz\xa2\xad\xb1,9\x10l\xa1ܿ{\x00\xa2\xb1Aq)\xda\xcfi\xba\xc1b\x8b\xb8\xda\"\x17B\x0e\x82D\x18j\xb7\xb7\x06\xec\xb0\u007f\x90]\xe3\xb4\xc3\xe0\xab\x1fphw\xab&\x04qw\xd5\x16\x10[vn\x06\x94M8\x1e\x03!YE@\xc2Jpіn\xba^\x9d\x1d\xfb\x11\x19\x8a,`\f\xa0\x11\x14>\x0e+O\x87\xecI\xfb\xd1\xc6\xcd\xdf\x14\xb2\xde%x\x04/\xbd\xcbM\x81Cp\xbf\x82\xe1\x15\xfc\x9cs\x18t\xafE\t *\xc4m&\xd5/\x84\x99\x87.y\x1f\x0f\xc9\x15-J\xbcP\x91\xa8\b\x8b\x1e̕㖶\x0f]<p\xeeŎ\xebPmw\xac\xf7\xc5,FnpkS\x9d\x1b\xb2\xbeEI\x82\u007f\xc5\xd5Y\xb76\x97`c&o\xf1\a\x19\x1d\x8dI\xbd\x8d\xfc$ݧ4+\xa3\xccS\x19\np\x04\x10y\x10\xc5?\xefҜ\x17\x00*\x95K\x80/+J6\x92\xb5\xde\x16\x11\xd78\x9c&\xd2Hp.\x05\bT,\\cAT&Q:S\b\x00b`QC=\xe3(\x8e\xc9u\x89\x9e\xa7\xb3\xd09lS\xf9E\xc4]\x9dl)C\xaa\xa0\xb4\x85r\xe2\x04u\xf2\xc4\xf3\x85>lS\x8f\xd7k*@\xb12\x8f -fN*'\xa0\x11\xfa\xa9\x82\x03v\xd7\xef\xb9{7X|\x17K\xa5\xe5ĢetKgʁ \x06\x97y\xb1|\x0f\x1a\xe2\x8e]~Dg@\x9ca\x03g\xf1w\x10\xd6h\xeeu\xb0\x80\x88\xb6@&Щ}H\x91\f\xea\x85\xc5)R\x18\xb3M9ǥ\x0e\xf1\xa6m\xcbo\xb7\xd81є\f\x9a8\xba\x93\x16;B}\xec\xd2}\x05|}g\xc1zs\xbcPA#\b\x8c\xf1Y\x92\xf8\x13\x98\xa98&W/>l#\fmų\xb1\nX\xb8u\x8f\xc0~\x1fQ)0\xb7\xe1 \x0f\xf8a^\xe8\xc8-\xaa\x991\t\x02\xc4<84Û\x10x'}P\x9aG\xb4ߏ\x98\x98_\x05\x11\xab\xe7\xba\xdfw\xf7\xa0V\xa89v\xd9\x01\xd5\x1d\xd4Kl\x90\x87\xd0n\xd7\x064\xf2\x80\x90\xe4\xec\x8cLͳ\x81\xf3\xac\xebs\x82V\xee\xa3\x01\xca1ǋ\x88#\x82jf\x19Z\x9eN\x1e\x1d\xe6\xd9\x19\xd7\xf7\xb2\x96;\x0fʶv+\xcc\xfd\xa9ǟJ{\x9f\x01iތ\b+c\x1f\xc06ҷԷ\xa4oߣ\xa6\x9b\xc6\xfdw\xa4\x9b\xe6m\x89\x84\x04ؒ\a\x06\xaa\xeb\xc6-\xdc\xe8\xaa\xcd\xfd\x9cLF\x8c\x17\x12\x8f@L\xd4\x1dl\xa6\x1d+\x87<ԋ\x950\x8fz\xb1\x847\xea\xc5\xea\x86\x14\x05\x02\xa9Q/6\x17\x9a\xb1\x15i\\\xb0\xf2\xbc\x03p\x88#\xa8g\xa0:\xad\xe7\x11\xee\xd1\xc7et69\xb1s{\xf5\xfe\xa6\xfd\xbb>sE\xb9i*K\xa4-\x82AZ\x1d\xd8\xf1\xe3\xd51\xf7\xdfܖ\xba\xc2\xf6վ\xd0\xdaw\xac\xbd\xce\xfcW\xe5f\x90MU\xa9\xaa\x16B\x84\x8d\x04Sj\xb4j\xc9]\xad\xbb\x89=\x1d\x0fj\x1c\xa6\x18\x10f\xc0\xaa\x9d\x80P\xe3\xa0xU4ʶ\x8eM\f\xe4\xa1P\x80q\xbe\xd7=\x18\xb7O\xe4jv\xe2\xb3\t2\x17I|\x97\xe6\xdbu\x1a\x9f\xffm\x9e\x0e\u007fy6\xfc\x9f\xc5\xe0\xb3s$\xcb\xdeI\xfd+Y\xd6\xdf\xc6\xc3/\xe5gB9\xbe¥\xf80\x1c,f\x17\x99(\xa4\xbbͥ[\xf60\x9a\xc5\xf3\x8b\xd1\x05Z\\d\x038\xfb\xec\x1c-\xd32\x8b\xcf\xff\x16\xcd\xe2\xdf\xcfEWw\x93G\x95\xa8$\xff~\\\xc1\xd9\xfe\xf3\xf9d\xf8\xf9B\u007f\xfc}\xb5\xff\"\x9a\xc5\xe3\xc9d\xff\xb9,\x92\xff@\xdbt\xffx\xfe\xfb?\x98ʏ\xab\xfdcQy>\x1e~\xbe\xd8Ͽ\xf8\xa3_{R\xed\xa3Y\xfch\xf2x\xb2\x9f\xfcq<\xde?\xfe\xfc\"\x13C^d\xe2\x1b\x14\x93\xbb\xbe\x16sS\xad\xee\x1e\xa3\xdfW\x9f\x9d#\xbcII\xee\xad\u007ft\xf6\xbb\xcf\xfe\xad\xff\xe0\xe1\xe0\xe2<\x99\xfd\xed\xdd\xff\xefn_\xfd\xefp1\xf8w\aBbI\xf6\xd7pq7F_L*\xe7;\x9cE\xb3\xf8bt\xaf\x16P@xW\x8a\xb9Dkηl\xb6_\xf1\xed~Er\xbcgl\r\xe3\x8b\xf3\x8b\xf3(\x8a\"\xb3\x8b\xfb\x8bl?\xdc_\x8c\xf6\xef\xf6\xff\xbb\x9f_\xec\xc6\xe3g\xe3\xe1\xc5\xee\xeb?\xbc|y\xb1{\xf9\xe5X\xfcx\xf9\xf5s\xf1\xe3\xeb\x97\xf2\xc7\xcb\x17/\x17p\x1f\xfd\xdb\xfc\"K\x87\xab\xc5ݣ\n\xee\xe7g\x17\x9f\xf5\x1f\\D\x17\xf0\xe2\xe1\xc5\x00M\x93\xc5>\x86\x0f\xff\x1d\u03a2(\xba\xc8\xf6\xf3\xc9\xf0\xcb\xc5E\xb6\x9f\\d\x17\xd9\xfe\xd1|<\xfc\xbd\xf8\xf9\xe8s\xb9\v\xf0b\xf4\x0f\xad\x04\xf7\x8d\xf5\xdfg\xd9\x1f\xd9\xf0\xd7\xc2\xfb\xe1G\x8e\v\xe1\xc5\b\x0e\x9cY\u007f\xdcZ\xffO\x16z\xbfU\xce`\x14_d\x0f\xe1\fF\x12\xc3\xff\xfe\b\xbe\xffw8\x90c\xfdC\x86z\b\xc5\xdaf\xd1\xc5\xec\x1f\xb58\xd1ዱ\xea\xe3\x8f/_.\xf6\x17\xe7\xfb\x8b\x99\x98Ft\xf1\xbb\u007f\xd8$젟\x9d\xa3\xacؤ\x84J\xf2\xeb\x10\xc5\xfa\xef\x8b M\x14'\xc0\xcc\xf5\xee\x11\xfa\xa3 \xd8\xe2z\xe3d\x835)\u007f\xb4\xb0\x97\xcb\xc5P\x14L\xf4\xb5\xa0~=ֿ\xdeF\x92\x82\xe8Oq\xf7\xaf\xe8\u007f\xf6\xd1\xfcbx1Xx\x9d\xc5\xe31\x84z\xf4\xf8<\x9aœ/\xf7\x8f\xc6\xfa\xeeyT\r\xa3Y,\xef&A\xbf\xf6\x139/8\xf4K\xbe\\H\x82\xf6\xa5\x80\x9f\xa8}6~\x04\x83m\x1e\x8f\xa1\xae\">>\xfe\xfc\x0f\u007f\x94\x9f\xc5\xd7\xc7\x139\v\xb3\xfe\xb1\xea\xd6\xe9\xfd\xf1\x02F\xb1\xb3\x9c\xbbG\x06f\xaf\u07bc\x8e\xcf\xffv\x91\xdd\xfd\xbe\x9a_\x9c_\f\x17\xe2bD\x8f\xfc\x1f\x9f\x9d\xa3MA\xf9\xfa]\x96\u07be\xbb\xc5i\xa9F13\x9c<Z\xc0\xf9\xb0wq>Z\x98\xd2\xf9D\xef\xc0\xfe\xf1|<\xb1\x9f\xe58r\xe4\xdbw\xaa\xc7Fo\x1d\xed\u0083\x99ޖE^\x88N~7\x13\xa8\xb3z6|)\xe1\xffE\xb5w\u007f>\x16\xb7}\x85j\xa1,\xbe\xd3\xfa\x9c\xb8\xe3%X\xdb.\x06\xf5D\x8a\xe7\x87R\xeb!\xff\xaa\xaa\xca\xe5\x1eU\xd0\xe4\b\u05ef\xa5\x9f\x1e\xd0?\xea\x01]t\xfc\xcf\xf7\x02\xbe\\\x16e&\x13\\\xa0@P\x14\xdb(rj\xa2;\x191)6v\xc8HFJ\xaa\u007f\xd6\xe1\x8fb@\xa5\"\xca\xc4K\x8a\xc1\xb6\xc4פ\xd81P\xfd\xaaWw?\x99\x81\x0e\xd0 6\x9e0\xfb\xbe\xfe\x19O/\x9b\x0f\xf3u\x94\x82\x9cX\x11\xd8,Lz\xd8/\xbc\xe6!\r\x8d֨D\x04\"\x96P\xa7Kmd\x9c^\x0eu\x8c\x9b\x85\xd4j3\xf5\xf2\xb7\xdf;\xf0\xf5\x937\x80\xd4م4!\xb2v1\x00Z\xb0\x9a\xd2p\x1c\b\x1d\xa8A\fV\x169\x03q\xa1\xc3$\xf0\xf4\x12 \x92\xc5iw\xdc\x06\xfbl\x1b\x9fM*\x88\x98\x17\xc0\x82\xa7\x97۔\n\x91\xce\t\xf2\x90\xe3\xec\xf2\x16\xd8>\x9d\xd8\f$\x8b\v\xc7l*\xa4'\x1d\xb9\xf1\n\x0eC\xccy#QA\x90\x8b\x1b\x01xq\x9e\u007f\xbd\xd9D`Ok\xbf\x18\x13\x9f\x9e\x1c\x9b!u\xdec\x9c\xb8\x17@;,Y\xb3~\xbb\xad\xbd\xf7\xf8V\xac\xc3+\x04\r'\x80\xfa\x03\xf2M\xe1Z\xb6\x9a2\xd0d\x1d\xda\xc2\x00v\x16a\xab\xb1K\xf3\xbc\xb8y\x96\xe7ϥ\xe5\xfc~O\xea\xb7q\x18n\r\xfb}<\xdam#\nc\xac\x80N\x8d\x8dHp\xf6\xde$C\xa4\xc3\x06\r\x8b8\U000a81e2\x13^\x9e\x88\x91*\x13C\"A2\x9a\x9b\xc8\x132\xa22\x9a\x889\n\xd2\x1be\xb9cR\u007faV-\x1f\\^Hl\xdf\xef\xb9\xeb?Ђ1\xac\x90\xa1E\xa1\xc1ķ\xdft0\x1d\xc6\xcb#e\xed\x9d\xe5mw\f\x15\xb8\xbf\xc6vCe\x83Ϛ\x8e\rCx\x93\x95]\x89\xab\x8f\xf71\xa5\xdf?s\xfa8\x8a3\x8a\x84\xabõ\xdbF\\E9\xeb\xd5\a\x97;\xea4i\x0e\xd9T\xc57u\xd4\xcd\xe99P\xee\xf7\xcfl\xbe\x9c.\xb2~\x1fB\xc3|B\xb3\xdbF\fV\x86Ir\x89\x9cT*\xfa\x01g\xfc\xael2\fX\xc3\u038b>c\xa6\x84dT\xe1\f\u007f-a\xe3\xaeS\x16\xbf\xd9b\x9cy\x86\xf4\x81\xd4:\x81\xa3\xa8\\\xa8\x90\xcf\xe65I7\xf4\xaf\r\xe7R\x18\xb7/\x85\xb1\x83q\xbbm\xf7\v\n\x0e!\x8b\x13O\xb7c+g\x1d$,\xc6G\x10x\x1a\x1d@\xde\xfd\x9eA\x19\xd3H\x02\xf3/ۈ~\x04xw\xdb0pÈ1vv\xdc\xcbc\xe0n\xfa\xc7\xeeK\xf8\xb2>EA\xdd\xfdV\xd9@\\An\"\xb1\n\x03\xb2q h\x10\nug\xaf\xbb\x06\xa9\xfbX\xcds\xbdC\xf1\xa3\xcf\xc7\xc8A\x16\xe9c\xe9m\xb4\x8cF\x1c\x96Q\x1c\x9a\xfbIN\xf9\xa7\x96S\x1aq\xa8F:\x8a[\xe4\xbf\x04z\xbc\xf1ǉ6ߪ\xd0\\'\x8a7\xb2\xf6i\"\x8e\f\xf9\x1a+\x97SG\xc6\x11\xf4ҕ\x81\xd4\xc5Wǐ\x8d\xb5\xdb(R\xd1f\xf5\xcfgy\x0e\xd0\xdbg_\x99\xfa*b\xab,\xd8m\u007f#q\xc9%\x04N\xc84\xf9\xba\xe6ݜ55@\x01\x81\xab\x16\x13\xa4\xbc\xa5\xa9\x94<\xb1\x8aTIOѸM\xfc_o15\x06\xbb\x9f\x89ѿ!\xf4=; 1\x18ɬ\x11>\xad\xd5EWH\a\xe9\xbbwL\xf4\x1aʮsB\xdfK\x97\xf9C\x82@\r4!\xfb\x9d$\xd8\rud\xb8\"\xa1\x1dW\x1a\xe9\x10\xe7XK|k\nx\xb8\x8e;p\xd7\x16\xd5pSTs\x9ak\xf9\x8ed1\xbb\x87\xe8\xe6\xf9\xda;6\x14Ӷ\xc7!\xd1\xfc\x9f}\x91\xac~;\xa3w'4\xdfAY\xee\xc0\xf696\x8e\xed\xca\a$9EK:\x047E:\x8e\boV\xd6!\x92\xaf\xef\x14\xb06~g\xbe\xab\xafF\xd14),_\xba\xcb=\x8eW@\a\x95Iq\b\x06iS\xbd\x11\n\xbc[\xc0YD\x93t\x84\u007f\x8el\x02\x951\xc2\xc3\t\xf4\xc4#R2\xb1\xba\xff\xc7ܪ\xe2N\x1eLl\xb2\xa7\x8e6\xc7v\xab\x8e\xfc[{\x13\xb2\xa4\xb0h\xa0\xb4\"C\xd9\x1c\x04\x06\xa8G\x90q\x84\xbd\xda3jA\xc84J\xe9V\xed~bz\xaf)҄\xd6S\xcc\xd3\xc33\xbc.H\x16y\xd3t[\xe8\x05\x87\xe7)\xe5bS\xd4\xec\x18\xc2X\xf9\xe6T\xe1\v\xb0\x16\xd2\tj݁\xe2b\xf3RϨ\x99iz\xa2Xmy\xcaK\x88J\xf7Tv\x82ЈѰB\xf2\xd2\xf3{\xb7\x89\x93\x1a\x03ͤb\xa2\x84qq<\xecg$\xebvW\x84\xe8\xe4\xfdv&\xeb\xbb4Z\xfb\xa8f8\xd6\xe1\xc46Bg\xe3\n\t\xe0\x04\x1a\xb2\xa3\r\xdb*\x92C\a\xd91*ִ\xe5Pm\x03\xfbgy\xee\xeb`\xd6$\x13\x85QH[!hY\xd0\xfb\x99H\x11\xe2\xd5F\x86\xf5\xe78\xa0\xba0\x84^w\xff\xb1L\x8aaI:\xa4\xe6S\x14$ʲ8\xd6q*\x94\xe5 o\xa0\x9a\xab\xba\x88k\xbd\xc6\x115\x06o\xdfV-\xc6g\xbf\xb7\xdd\x1dӐ\n\x86̨a\xd8_('\xb9\xdfFj\x17Ĭ<\x17\x04\x97\xa9\xf0C\xf9\x1a\x06`R\xd5z\x8c\xc3\fV\xa7\xaa\xa0\x92J*\xab\xc3\xc0G$l|\x8a\x02C\x91\x1b\x99P\xf1\xa0֡\x01e\x8b\x12\x1f3\x89\x86\x98\xefNa\xea;:ub\xe2\xd8\xd5\xfb\x84\x95\x03Sr/x7\xc0\x8d\\\x13ʏ\x11\xf6\x9b\x93\x96[v\u007f\t\xbf\x8b\rj\x89p_\xedJzH~\xfb\rU\x03\xe2D\tt<\xa2\x06P\x13\xfd\xa4\n\xf8\x97P\x05d%\xc9sI\u007f\xef\xa9\n\xf8\xdaixX\rP״*\x00%\xdek\x05\x80+\xebk\xf1\xbf\xf9\xc4y\\\xfc\xb7\x8f\xa0\xbe\x06\xe0\xef&\xfek2\xf0\x8c.\xd7E\x19\x16\xb7s\"\xe8\x93\x05p\x9b>y\xd2\x11\xf2\xfa5=\xfa\xc3X\xc2'\x99\xac\x03\x82t-ʿ\xe2x\xd35=\xab\xa6\xf8ɝ\xe6e\xba|o\x89\xa7\x13\xa7_\x06`wX:\x8d.\xdb\x12\x8bY\t\xa2`\xf3\xa5\xbd\xd7H\xf0\xa2%\x98:\xb5O\x91N\x1b\xcb?\xfe\xcah\x14\xc7Λ\x92*\xfa\x86\xd0\xf7\xf2m1/(\x8e\xa0`\xbat\x16]z\x88\xaf\x1bݔ\xe96z\xf0$'&\x1f\xaf\x13=^\xf5\xac\x82\xc87\x83\xca\aw^\x82\xb0'`\x9a\x80\xba\xe0\xe9\x93\xf3\x9c<} \xf8>\x15\xf5\x88\xa5\xd78\xfbS\x89W\x00\x99\x18\x82k\xf1\v\xfafت\f\x1dҨ\x84Y\x9612\\r\xdc\xc8\x02\x80\xb0\xd5&\x10\xeb\x06nq\xf2\x04\xf8k\xc6+\x80P\xf6\x85w\xbf'\x06\xfa\x0e\x97!*}%m\xee\xe5$\xc4Ow\n\x06u\xc3/\x19\x0e\x11\x13\xec\xa7l!6n\x8bK=;;\x8e.n\xbc%\xb5\xa8`\xdd\xdc\x1b^b\x83W\xc1y\xabX\x1a\x9f\xc0wW\x98\u007f\x9b~\xf8\x9al\x84\xa4s\\-\x83\x1bz\x1f\x9fAp\xe6\xe6\xebH\xea\x0f\xc8\r\x1eHV\x11\x8fL\xb0\x0e\xe8s\xbc`\x97\x03\xa4N~\x18\x80-\"%\xaf\xd3nq\x04і\xf8\"0\xf2\x9dL҂=z%3\xab\xe8]\x90\xb4\xf95}.\x96b\x9f\x1f#pYd\xb7\xf2\x11\xd1>\x82\xdck\xf12\xf6\x9eZ\xb7\xccwh\xb6M\x06g\xe3\x82i\xe0)\xa1,\xf2\xbe \xd3D\xbaև\x84\xb1wV\x88C\xe1\x99I\x16\xdb\xd9f\x9f\xfc\x9dB\xe7,\xa5VBHH\x96i\x1d\xa9\xde\xd3^*HBC\xd9\x15\x02\xccQE\x97Ĉ\x03Z/\xff\xaa\xfa\xd5J.\xa5\x9a9\xa6ֺ\x97\x9e\xc5e2\x9a\xd6\x0e֡\x9e\xb0\b7/\x15i\xe2\xa1\xf0\xb5h\xe0k\x03>\a\xd3\x02\x15\x10y\x9a\x05\xaf\xa5\xda\xc2]\xde\xcbI/\xad\x93sag\xe3[\x1a\x92\n\na\xc7@ hXa#.H\f\x8d\x0elgk-\xde^\xdfca\xe1 \x81\x87:\xef`Y\x02:\xa1\nMԪ;uC'\xe9\x81\xdcJ-\x85\x98\xb9Z`\x85Z\x9a8\x1fKꝙu \x8eT\x8c\xfdSb\x0e\x8c\xa3\u007f-\xa4P\xbb\xf9\xf7Р\xbd\xebV\xa1)\xbb\xa5.\xc5R\xeb\xca\xf4\xb4M\x1ew!\xb0\x90\xd0+\x00\xa7\xfc0X9\xf4.3\xdea\xfe\xd0s\xfb\xac\xba\x9c\x9f\xeb\b\xaf\xee]U/\\\xb2e\xc7\xd4A\x9d\xac\b\xf2,\x84B\x02\xc4\xd1\xeb\x9a\xc0\xbbC\xfb\x84\f\nso\xbf\xcc\xfb\xe4\xc1˕\x87/W\xfb({@(\xfb\x15\xcc\x17\xee:\n\xdc\xe1!*4\xf6\xd6#\xe8GX\xb1z\x88\xad\x0f\x1aBu\xe0\x81 t\x8d)K\xa5\x9cw\x04\xc2S\b\xa3\xf1\xe9H|\"\x0e#>\xba\xccw\xa5\xbc\xf4\x9c\x89\x8by\x1d\x9cx\xcdgw2YcD\xea<ｆ<\xd3b\xb6N\u007f\xfc\x94\x94˄\x10O\xc8S<#\xf2\xe9\x98\xcc\xc1\x86СJ\x8f\x01\x16\t~\xd8@\xc2\xf9xѕU\xc9$\xd5\x00\xdb\x0f@v\x94~P\x89\xcb\xc1\xc2'C\x87\xfaPy<T\x17\xa7*:\x1d\x0e\xf7\x14\xf5c[Z28\xb7\xa3RB\x82\x9dL+\xea\x8d\xc2\x02\xb4\xf5\x8f\x8e\xe0H`\x11l\xe9\xf0US\x8d3\xeaG\xeb\x18\x03x\x1c\xddz\x1d\r\xddQ[\aΕ\xbb\x1da\xbag\x04i)\xd47\x958]j\v\x1b\xbe3 KT\xddz\xe3c)6\x9a\xca\x03i}\xec\xa8\x0fP\xa0\x8aY\xd9\xd7\xcd\x0f\x1f\xef\xee\\K\xf2\xb1\xab7i_\x11O\x9f\xa4\x16~\t\x18\x83\xa7_\xa5\xcb\xf7O\xceS\xad\x0fAZ\u008e\xc1\x93\x8c\\?}r.\xfe\x05\xa8V\xe7\xc8\x1c\x02\x8e \xd9m\xc1\xe6\xe8*?\xa9\xad\xff\x85<m\xbe6\x89}\x8e\xab\xa0M\x06\xa0\x03\x1a\xe8\x90☧\x97\xefVEy\x93\x96\x99\xa7?\x16\xe5\x02\x89减\xd5$\x87\f\x84\xd4y%\x99`\x16\xe5\x87T\x12\x91Ħ\xdfQO\xb1N\x18\x95\xfd\xde~\x13\xebp\x03\xac\xb8=t\x18Da\xa4\xc390\x1d\x95_\x9a\xc8z\x89~\x1c\xab\xa7\xc3\xf9K\x9bq#\xb7\x85\xe2\n\xd4\U000527ee0\xff\xde-5*\xe3e\xb1\xa3\x1c\x97\xc9\xef\xd5\xcf\x1dÙ\xa9ǒ\xf9\"h1\xe7k5\x01/w\x18\x84\xa6\xee\xa4w\xa9\x97\xe2\x1au\xb9`:n\u007f\x96eCU\xb5&\xd6m\v\xac\xe6:O\x13/dLIQ\xfd\xbbt\x83U\xb0\xaa\xe8<\xe2\xc5v\x9f\xe3\x15\xdf\xcb\xc8\xd1{\x95,\f\x9e_\xc1)O\xb8J,\t\x80\xb66;_\xe5Eʇ\xd1ś\x01\xbc`\xe7#\xfc\x01/\xa3\xe6\xfa\xec\x10p\x8a\x13<\xc3\xf3\x89큈\xdf2S*\x8f\xb9\r\xcb\xe3F\xe44\x9b\x1ad\x18[{\xa7BD\xf1\x19\x8f\x81Nr\x06\xd1\x197\xde\x01^M\x13\xe3FfF\x84OƳƞ[VT~\x8f\xe5\xff\x92$9ҙ\x19\xb5՟\x17\xc9\r\xc6*R\xf7\xf1\xfet\xc8\xf7#\xdd9\xb3\xd5\rL\xb0\xf8\xe3#ȉ\xdcc\x00U?>\t\xaaO\x87\x93\x8f\x1a\xbc5\xda=\xa1\xff\xb1\xe3\x1e[\xf4=w\xed\xe84NƖ\xfbm\xe6o6\ue84f\x9a\x90\xca+Z\xc5S\x17,\x86K]\x87CG\xec\xac\tT\x806\x91U\x04Vi\xce\xea@\xc4\xde-\xd2 \xfd\xd0O\xb6\xc5;I=N\xfc\\\x81#//`C\x14C$\x89N\xa8\xae\xe6\x05Q\x8d\n\x8d\xf4\f^\x91<\x05\x88&\x06\u007f\xc9\fhQ-\x06JԚ\x9a\x82$I\xa8\x1ff\xedZ%\xce\xf2\x8d\xb0\xd7:ϭ4\xe3\x95]<Mp+}\xe5~\u007f\xe6\xeeD\xbf\u007f\xd6XZ3ib\x03\x180\x180Oed\f@I'Wl\bn.\xc0P#W*\n\xad\x14\x85V\x8a\xce\xc6P=v\xe9̹\xed\xd5\x0e\x1f=\f\xb6ԹuA\xba\xe3\x05\xa8:0\xf6l2\x15\xec\xf6o\xb7R~\xfa\xda \x9c\xdeocd\x90\xfc1ԧ[\xef\xee\x14*\xae\xa0\xbe,\xed\xf1t\xcfݯ\x8cRN\xa3;\xabڱ\xa9\xc1\xf4\xa20U\x89\x1bܔZ\xedz\xaaح\xd8λ\xa4j\xear\xb7j(O]\xdcZ\xa3Ӥ\xc9\x1d\xae\x8bkq\x10\xbcc\xacDb\x99\xbeAb\xa7\x12\x8e\x15\xefޓ\xc59N\xaf\xb1[\xac\x15s\xe16^\xe8u/ɐJ\xedR츴\x1eW\u007fv\xa6@\x12\x00\x95\nI3M%G\xcb\x15H\x9b4!\xdax\xeb\xfa\x1a\xe7\xe9\xad1E\xef\x98\xf8o37\xb9\x89\a&7\xe9\x9e\\`?\xbeO\xa9\r\xf1\xee\x05`\xfb\xc7\xee\xc9?9\xe0\x9a\x92U[\x8d\xd8z\x94mM݉z\xa0N\x14KB2\xac\nw\xbc\xdc\xc9LqQmu\t\xa7'<\x89Z\xa1\xd7\x11c\xe3\xb0\x11\xa7R3\xc5:3ۈ\xb0\x88\x8d\xf0\xcf\xd1p\x02\xd5;\x97\x81\x03/ӭ\x9c\xcfLU\x18\xd7F\xd6\xedw\x18\x18[0\xc3\n\xb92\xb3;\x8b\xe8\xf84\xc6\x10\xee\xf7N5\xe5t\xa0*\x1d\x9e\x9fk\x05~l\x82\xcd\x17A\xaa\xdf\xfd4\x8b\xa1\xc71\xa4\xc0\x97\xd8\xc3v\xe7\x81\x11\x83\x8f\x92m\\4\xcf_\xfecU\x9ae_\x15٭I\xfc\xd8y]D^\x16\u007fm\xfc\xdc\xe4\xae:\xacM\xbc#\xdc.\xf7|q\x88\x9d/a\x11\xaf\r)\xear\x15\xf6\xdfZ\xa3\xd4&@\xfe\x96\xb7kDāJ\xd7\x1c]\xe0(%N\x88\x95=\xf4>\xb6i\x90\x96.mL\xfb\xc8kID\x91\x8b\xc3\xc6\xdd\xed\x9b\x1fu\xc9W\x84\rU\xba\xcdNks\x9fj\vVJ\xe2\xbbQ(\x1c'\">\"Ly\xed\xb5\xe5\x1f\xe8\xca\x1b\xc8մ\xea\x1b\xa2\x81\x8eQ\xe7\v\x14[\x177>\x98\x9b\xf1\x96\xed.j\xe5[h\x1b\xcf\xfc\xde=\x03&\x05\xb3Z\x0ei\xedz\xf3Y\xe0\x10\x90힙\xdd\xf6\x1a{\x1b\x1e\xb0.os\xb5\x9e\xae\xa7-\x16My\xf3\xc6\r\tvm\\\xe9ֵi\xf6ܰ\xdb\x00)F\x1dt\xf0ܓ\xe3:8\x8d#ɸ\xea\xd8\xe2\xfa\xad\xee\xe8\x16w8\x93\x04\x8ejh\x8b\xebZ\x81[ۏ\x9e\xa1\xe9\x87\x13?CQ\xef{\xdb\xffۇ\x9a:[\xb6|\x99n\xa0\x89\xf3\x9e\x13\xd2K\xdf\xe7\x11\xa5f7\xa4y\xbe\xfc\x19\x9fM\x90e\xd2\xc4\x0f#\x8fN\xd0\xda\xfe\xe5\xe1\x81\xd8z{!J\x97\u007fC-\xee\xf3vb\xd6\xf2\xe9\xe9\xe4_\xc4\xe2\xdf\"\xe7G=\xb6\x9c\xe4\xfa\xefU\xfe?0\xfb\xff5O2\xfc\xa05\xbfZW\xcbndz(zNg\xab#\xf5<K\x0f\xe5c\xcbv\x97\xa7\xd9\xf7?\x98\xd7v\xe6\xf2\x81\xbd~\x10:\x14\xc4\xedH3k\x90vl\xba\xbe\xca\x05\x97\x9c,\xd3\xdc\x04{\x0f\x13y\xaf\x89\xd4ݩ\xfa\xfb\xbd\xa3\xc9\xf3#Ր+*\xfa\xf0\x9ekJ.\xd3d\x85\xec\xc6Y\x04F\xbc\xd8\x0e/\xd3r\xa8U\xc2R\x94x\b\xe0,\nw\x9d\xe8\xb1=\xa7@\x81\xbal\xa8t\xbb0n\u007f1ya\xd5-Ըf?\xce]\x1f\x91\xa4\x95\xe5T\xa9\xdd\xf6\xfbP\xdea\x9d\xaa\xd2m\x82h\x02\x0e #rȸ\xe6&\x8b\x84G\xec\x90%\xf9\b\f\xa8\xb2$\xb6\xbbH!*\x13\xf5\x18\x97$I\xe1\xa5ibC\xc9\xc3\x038-\x8eb\xbe`\xe1RHVQ\xa9\x98?\x1c\xe4D\xf7{\xef\x03Y\xbe\u007f\xbdŴ\xdf?#B^t\xf2\xf8,\xf1\xcb\"ϋ\x9b~\x9f\x18\x1e\x81\x1d2>c\xa1(\x03ڤRs\x14\x81*\xec\x88=\x9b6\x16=\xb6v\x88\n\xa9T(|\x98c\x87\x8aK\xc8\xc3 t\xa5B\xaa\x11\xd4ɂf\xbf'F[\xe9\x18\xc6\x05E=\xf1\xb9W\xa3O\xf3\x13@\xac!\x8bdD\n\x18\u007f\x12<\x8a9\x80\xfe\x18a\x8dP8,\x03\xb3\x9a\x91\"a.~M\x8b~?j\xe8}2\xa9\x99A\xfa\x8fN\x9d\x8f\xda\x00v|\x03:T?\x87\x15S\xf7]\aYEE\xbf\x8f=YN\"\xb7z\x801g\x88\x85ϐ\xdb\xd2no-\x01}$\x80$\x863o\xfd\xdanJԇUպ|\xbat]\x87\x83m\x90\x03d%x\a\xa5\t\xd6W\x90ԳD\x05|:\x9c\xa02Ig\xba<.\xeapi\xca<O\x1e!8-O\xf7V(\x85\x04\x8c\x87\x13론\n\x06\x13\xd7\x19A\xb2\xbfy\xe2\x99:\xb7\xc2K\xec\xf7\x1e\x9a\xd5aF\x0f\xaa\x86v\x89\xa7~\xba_\xfb\neI\x8b\x95q\x89M\u05ed\xed\xa8\x00\xacE;\x174\xc8p>\xbd\xa7\xbd\x13\x17\xb0\x0e͠\xd3\xf4{\xca\x0f.\xb16\x00\x0e\xaev\x99\xa8h\x1aY\xd0\xcc_\xb6t\xf4\xa5ȵ\x99o\x05\u007f=\x04\xd5@\x98\xc9\xc3\xf6\xe5S\x89\x93-\xfe\x06w\xf0C3\xfbt\x89\xdb\xfc\xc7\xcc\n\x01Kt'}\x1er\xb4\xdb\xc6;\x15\xd23\xab\xfdB֕\xe0D\x0e\xd4]\xd7u\xb3F]\xf9=\xaf\xbf\xef\x94wE&Z\x8b~O\x9e`kRșFhPwR~]t\xb2~[\xc9\x18\xcb\xdfBQ\xdaЊ\xea<\xe2\xe1K\x11ӬE\xee\x9c\xdb\xe1>\xcdPS\x96\xa4I\xa7\x82\xd4\U00063334oZ\xad\"\xbd\xff\x84\x8fۢ72{9$\xd8\x13\v\xa4_\x8a\x9f@\xdeIK\xaa\x16\x81\x8dz\xf7鸂PfL\xad\xc9\xc1aA\xc9%\xed'\bb\n.T\b\xeeX\x85f\x90фHN\xf8-@&ˡ+^\xfd\xe4\xf4yzďc\xd3>\x1eH\xc4\xd7\x15\xebTb\xc7ޠ\xb1Lʮ\xa2a\xac\xa23ˮ\xd7F\x10Aaf\x06\xb4h\x12\x03%\xbd\xc8[Ս\xa6\xd1\r\xd6\xd4ׂJA\a\f\n\xd8\x12~\xc0 <x\xe3\x85\xebȺ\x10\xdb\xefCCvwߚ\b\xa1\x14\x97-)l\\\x85\x10\x024X\xd9\xdfRۭ\x8e\xb8\x97\x06\xab\xc3\xe5\x02\x99\xb36%ҰȤ\x05\xe4\x9a\xf78K\x12<sN\x1d-\x1c\xf6\x8d#R\x1f9A\xab\xab\x86\xbd\xd0\xd4P\x96`ȼ\xfd\x9e\x84\xc2\xe6\x98\xf3*\xf0L9\x17\x13O!\xe2z<\xd9D}\xc1\xb0\xab>\xfb\xaa\x8eQgxWR+\x1aN?\x98\xe3f\x8f\xa1\xa6\x1e:ԋ\xf6P\xc6\v\xc7ǎ\x9f*s\xa8d\xbd)9Mcd \x16\xc0q9\x8d\xde}\xf1\x1d\fXK\xe5p\xaa\xba^\xe3(Y\xc8T\x15\xa7i\xc7\x1d\t\xa0\xa9\xf8\xf6|1\x9e\x85\xa4\x97\xd6\xd6K\x18\x0eӲ,nzD)W\xea_j\a\xe5/G\xc3ҫ\xf50\xbdƑo>\x80v\xcc\xf0Dw\x97_\xab\xcdw\x05c\xa3\x86W<\xeb\x99\xd6\xed+U\xff\xe7cd%9\xa3\xa1גW\xfc\xf9x\x8c\xec\xe6kTk\xa8\xf0\xc7\xc8c,c`~\x02T\xab\xd3b {\xd1\x17\x01rT#ݡ\u007f|\xd5\xef\xa7w\x80\u007f!\x17\x8a\x17?\xefҜ\xfc\"N\xcdo\xed\xc4P\xe7\xc4Q\xfd\xef\xf7\x00t\xe4\xea\x9f\xfbu\x877)_\xaeݼ\xfd:\xe6\x86(\xc6Y\xe2\xa6\xef\xef\f\xd4\xd5\xe8m\x01\x82qw=\xef\x00\x84\x0f\x19\xfe㟭\x19\xc0:e\x82\\\xe0,9a\xfc\x85s\x8d\xaa\xe6$\xdc\xdaӄx\x04\f\x9d\xd2\xe7kZ\xeb\x98/\t\xcd4\xa3\x92\xdc\x15\xf4\a\xb9\xbeo\xb1\xf4\x18\xd4Vzu\xa9k\xd7W\xd0\xef\v\xc6\rVd~\x03\xef\x93k\xda7ժ\x9d 8\xc8\xe6ʰ\xe5\xe6\x163\xebxMg&5\xf7;\x99n\xf4\xdb\xff\x94\xc1K\x95\xf6Z\x9b\xa0\xa8\xcbL\x10o)n\xff,h\x8f6\xd40\x8d\x9c\xb9@\xcd\xf5X5;\x8a,\xf3D\xfb}\x9a$\xc9\xd9d\xbfWeI\x92P\x19\t\xd4 \x94\xb3\xfb\x05}\xb5I\xaf0\xfb\xa6H3\x9cE\xfa\xbc\xbd+\xf1*/n\xdc\x01c\xf7C\xe4\xc6{٦;\x86;\x1d\x95\xdb\xdb\xe6=8\xdf\xc9\xfb\xaa>?qkgG\xa1\xcd:h\x99\xe97\xf6\xb0\u0095\x03\xebO\x9d^\x1ev\xb9n+o6a\x8fb%Ş%-\xc7\x17\xc3e\xb7\xfb\xc5\a\x01\xe8\x029j\x1e\xcfY\xcbf\x16l\vƱ\xc5a\x0f\xc2\xe84\b7\xcd\xf0E\xaf!\x98\xa3\xa30\x87\xee\xd1\x1d\xd7+\xd6X\xdd\xf9>\xe9\xda(\u007f+΄\xbc\x8fG)\xff\x06\xa7\xac\x11\xf0\xa8>ju\xd6\xf5\x99\x1d\xd6\xd8߆\xa0hȭ\x12\x97\xb4U<R\xc6\xdb\x06\xebE\x1fZ\xd5_\x1f8\xee\x84\xc2!y\xcen\b_\xae\x03\x8bq݊Ħ\a\xaahuvǊ\xde\xf0\xb4\x16\xcf\b\x93?q\x16\x99Ǽ\x83\v@g\x13\xa5\xd2\r\x83\xeb\xab\xdb\x1f\x8a\x9b\x99\xb1\xd8\xf9\x93l\xcbd\xa1\xaa\x9fn\xb7\xf9\xad*\x96\xa5m\x82P7k\xb5\xf0\xc8U\r\x05\xbb\x82N@\xf4\xbcu\x1dr\x03\xe7\xc5\xd6\x1e2S}r\xb0\xba뻦'\x1e:\u0086\x8f\xc3\xc9|\x81H2։Ij`kƎ>%\x92\xb9\xf3\xa7@\x16#\xc6os\xac]\xdd\x13\xb5\x19\bk?\xb1f]e\xf6\xaff\x03\xa7<\xc200K\t\xffcj+\u007fv3\xbf\xd0\xc4\x1c\xd1^\x06\x12\x1c\xb1X\xd9|\x81X2\x9e\xd29[$\xf3\xc5Ԭ\xbdH\xc6(\r/;}ZL\v\xc1\xd3\xfak)\x82\xeb\x96צ\rQ\xe6T\xf6\xa72-\xcf\x12\"n\xa9\xc1\x00\xe9\xa9 \x92\x94P\xfeP\x90\x9b7{@\xad\xf1]X.`e֒'c\xb4K\x8cRq\xba{\x9aOsÓg\t\x8f\xe8<_4\xd2\xd9{\xd88\x9f,*(\xf0*\x82h\x9d\x98HO\n\xdb#\xa9\xcd\xc9\xe0Tt\xa2&\xba\x86\x95LIe\xb6\xd19\x15\xdd\xc1HB\xbd\xf2\xa6Ʌ\x95m\xb7%\xee \xf0\a\t\x1b\xeeT\xe8\x1c\xb82\x82\v\xe9D\xc8\xfbOx\xea\x88MR_\xda8]ZC!D'\xfd\t\x15\xf2ל\r'\x8b)YE\x8f\x9e&\f\xf2H\x96\x8d\xc5\u007f\r\xe3@\xed\x8b3\xc59çL\xb0Tʭ\xd0\x1c\xd3d\x8cʄ\r'\xd3\xf2i:M\xc5\xe1W\xe3\xa6\xedq\x8b\xaa{\xff\\p\xb7\x87\xab\xaa_\xb3M\xc7\"a\xdc\xf7\x1e\xfcX\x95@\xe3\x12\x13»w\xf5\b\xf1\xbf\xae\x13\x03\xd0!\x9a\xbb\xf2\xdb'\xb9\xfc\x9fB.7\"x\xb9\xcbq\xed\xbc\xbeܕB\xba\xfb>\xe5\xeb\x04\x00_Ho\xf0O\xc7d\xf6W\x94\xe3R\x89E\xe0\xd7\x04\xb1\x95\x1a\xed\x12\xa7\xef\xb7\x05q\xf0\xfd\xdd\x15\xa6\xb8L9\xfeA,\xc0\x96އ1\xf7\x048\xc5\x1b\x8b\x03I\x9c\x89{\a\x87\x93|\xc4\xd7e\xc1\xb9\xb1Oo\xcbY\xe8\xf3\xb1\xcb,u\xb2\x8c\x92E\xb64\t\xf7\x88\xe6\x9a\xe4v@c=,\u007f\tA\xe1\xf5\r\xb5\x18\x88\xa1\xc74\xc8:s\xbc\x98jc*\xe9\x90/\xf9툌\xa4\x18\nU\x19f\x82\x8fN\b\xac\x8c)w\x89\xb7y\xba\xc42\xe2<_7\x1e&k\x90\a\xa6o&\xce\xc5\xc4\xc3|\xbe\x18\x9a\xa8\xb5\x1c\xac\xd0\\\x1e\xb7\xcb;\xd8l\xce\x17S<z\xf3\xfd\x8b篞}\xf3\xee?\xff\xf2\xe2\x87W/\xde\xccɈ\xa6\x1b\xbcH\xc8HN\xb9r\xa309\xe8\xd2u\x89\x13\xc9MM\x89\xafC\x97\x10\x9e\xb5\x8b\xe2\x80n\v\xb8\xd8\x03Mt\x84\x8b\xf9\xe8\xe1\xecbq~U_CL@\x8e@\xf9Tр\x00\x83楌\b։\xe5d\x89\xa3\x89t\xd9aۜ\xf0\b\xa0\x1eP\x06m\xea\xdbX~\xfb\xa9 4\x02*\xf9˼\xb0!*C`*\x17\xfd~T&\xa1\x0f2X\xaa\xe0\x81\xee\x04N\xc4)\x92(\x14\x97&\x8f\x8f\xa2\x17\xd4\x02V\xe3P\x88\x910X\xec\x10\x95\xb3$\xf1X^D\x13\xd3C\xd6<{S\xf0\xea\xdb\xff\xa8]\xbb\x9d\xe8\x13\xb4\xc8\xf0w\xe9\x06\xcfB\xaa2V.\x05\x9b4ʋ4\xf3\xf3\x12\xb9\xc4\rW\xd0\xde\xc92\xf1\xa9٩QtEV\xfb\x9f\xb6W\xfb\x9f\xb6\xf8j\xbf\xa5W{v}\xb5\xe7d\xb5\x82\xd1|\xf6\xbb\xc5\xe8!\x9c\x9d\x13\xd8\x18[r\r\xe02]\xbe\xbf*\x05\xda\x0e\xc9&\xbd\xc2 \x06\xbb2\x8f\xc0\x00\x0f\x00\x04\xfe\x90\\\xb2\xa3\x18\xb9\xe6\x8c\xce{\xfa\x9ao\xf2\x88\xb9-\x10\x17\xbfW\xf6PHC\x90ƚNy\x17\t_\xf5\n\xa3\x05\xd3*\xae\x89\x06^$wyJ3\xb6L\xb78\x06lYbL{)\xcdzQQ\n\xf1LN'\xee\xd9:\x10\xa0mQ\xf22%\xfc@uS\x05\x02TbNh\x1a\x83\x82\xe6\xb7=\xb7\xc1\xf0\x06_\xbe'|\xb8!t\x98\xe1k\xb2\xc4\xc3-\xf9\x80\xf3a):\x89{\x8f \xea\xb5\x1a\x89\xca\xc3M\xf1\xcb\xe9-\x86E\xf7\b瓮1\xee5\xa1\x12\xb3\"ߩ\x95O\xbe|\x94m\xc9)5\x1fe\xdb\xed\a\xd8\xc5V\xf9W\xec'\xc6\xea_\xe7\xc1\xe3\xdb\xf4\n\xe7yJ\u007f\xc3\xf7\x8e\xe31\x836vP\x9b4\xbb\xbc\xc2\\\x06\xbdV\x9a}Sc\xa8\xbeԯ\x15yg:\xbf4\xfc\xa2q\xd7\nx\xa4\x82\xd9-\xcb\"\xcf\xc5o\x9d\xe4N\xbb.\xc9g\xf1\xc4r\xa1\xaa\xda\xf7\x05K\xb6i\xc9\xf0+jx\xb9\xd16\xbd\xc2?\xea\xd8\t\x93\xee\xcc\xe1\xcb4_~\xdf\xc5\xdcx\xf6\xec\xfe\v\a\xad\u007f\x9b?^h\xbb\x059\x92b\x98,;}C\xa8\x12\xb6\x95r@\xde\fu\x9ci=e\xf9\x1c\xac\xaa\x89\x93$\x15^Z\x99\xa4\xe7\x9f\x15\xcb\x03\xdd\x10\r\x0e\xdd\x05\xf1t(\x8d\x1e\x11\xf5+S_yU;Qȍ?\x1aJߙ\x0e\xf1\x94AC\xc7\xd7y]b\xb6.\xf2\fN\x8d9\xad\x84|B\x11\xd6\xf0R\f\a\xf5\xac\xbcN\x8a5\x11\x01qM\xa2\x9e\nɎ\xee\xb2]\xa9.\x17\xc7\x04P\xe6|\"\x05\xfdZ\u007fC8e\x84^\x85\xaa\xbc\x90_\xaa\xa9+\x19\xe0\b\bN\x02\xa0F\xac\x05mL\x8e\xf1\xf6\x1bB\xdf\x13z\xd5\xef\xe7\xc5R\x9d\xabu\xcadN.\x05\xea\xb7\xc57\xc52\xf2>J{/\x8b\x82ʈt\xb7\xcdR\x8e\x9fIL\x8f\xda\x11\x16e$\x8d\xeeG\x93\xb6 \x02\xd4\xe8\xc1\x17\x16w,/\xf8\x85oco\t\x02z\x90\xce\xd7%^\xfd-\x01\xbf\x03\x8b\aG2\x1dN\x1d\xe9\xe4\n\xf3g\x9c\x97\xe4rǱI\x061\xf5!C\xdc}w>t+K\x1d\xb4\xe3\x11\xf6ՐC\x8fO\xb7\xc8w\xfe\xc8\xffp\x99\x96:\x98J\x13\x8b\xea\xcc\xc8:]Xtg&\xb5\x8dI\xd5plnaW\xf8\xb3\xc2,\xe8p\xcd\x1dr\xa1b\x98]\xd4h\xef\x98+ݺ\xe5]b&\xc2\xc7Ȥ\x8c\v4h\x90,\xcdx[\xe2\x03\xb5˙>\xb3F\xbe\x90:\xb9\x9e\xe8\xe0\x89\xf3y>^@\x9e\x8c\x95\xc2\xce\xc1\aK\xb9\x9f\xe0F\xb2p\xddm\xd3F\x93#V_\xfc3>\xa4\xed-|\x92\xe08\xf8\xc1)\xb3\x88\xf0D\xb0\xc9S\x9e\xd8\xc7\xd4z%\xf1\xb8\xaa\xddv\xd5\xd9\xf0|\x9c\xbd\x9d\x95\xdf=W1sI\xd5\x17\xe2\b\xff\xec\x05\x04;\xd2C\x80\xa8X\x0f'g\x04\xfd\xa0\xd2>Vzoׄ\U00062f15D\xf5\rO9\x9eu}P:j\xf9O\x01c]\xcb#TIQ5.\\ܥ\u007fV\x98蓍\xb9;\xef\xc5)\xfa͖wuӫ\xb9\xe7\x8d`\x03\xf06\r\xd0\x1c\xe0\xdeg\x13;\xb7\x80\xdfk\v<\x18\xd6j\x16\x04\x00\xac>R\x15ۢ4\xca\xd6\xca'01\xc8\tũ|\xd5\xd5\xf8\x1e\u007f>F\xce:c\xa0M\f\x91\xb3\xc4\xf8l\x82졉\xbb\xec\xab\x1c\x9e\xf4\x93\xa8\xf1\xcf/j|\x96\xa7\x8c\xbfU\x87\xa6\xe6\x9c?\xd3ǈ\xd5E\x1f\xa9\xdf}\xbdZ=O\xe9u\xca\xc0\xdf'\xb0l\x8b\x12\x04\x12-*\xef\xb3\xc0\xd2\fs\x0e=\xd3-/\xf2,\xea\xa9Bi\x9d\xd8*m\x05\xb0\rG\xd2\xd0\xf1\a\xbd\xaf6l\xad\xdd\xd6`B!\x99\x01I\x06\x1f/V\xab\xe1R\x82r\x88?\x10^[\xf6\xea\xf5\u007f \\na\xb8\xb2{\xc5Z\xe1Dm\x84\x16M\"\x90\x91k\x99\xf9\x9ay4k\xa9b\xbe\x82P\xaf\xc8\xca|\xce'\xb12L\xb9\x8c\xed\xbf\x95\xa9\xb1\x88E\\3I҈\xc8B\xd8\x0f\xf8\x1a\xa7\xb9\xb1#k\x97\xef\xf7\x14\xdf\xf4~\xc0W/>4\x92\xbc\x96\xb2\x86D'\x04\xae\x00\x1cq\xcc\x1a!\x82\xfcH\xb1\xa8c\bc@\xe2w\xfc\x9a&\xc1҆[v8ޭ\xaa<\\\x15\xe5p\x833\xb2\xdb읒\\\x889\xf0\xfc\n\x8a\xb6Zg;\x04p\xfehQ\x87\xd9\xf9\xf6?%\x01\xc0e\x04\x1b\xf3\xae3\x15\xbc%\x1blr\x85\x85\xbf&\x13\xfc\xf8\xa1\xe4\xee^\xe6Ej\xf9\xbb+̟\x17\x9b\xed\x8e\xe3\xec\r\xbf\xcdq\x14\xdaN\x1d\xba|\x01\xa0|\xf8\xac;6W\f<پ\xe9\xf0u]\xacVKM*\xfe\xcf#\x02:>\xa0\xf5\xb4\xb4Xd\x1d\xb7\x94\x1fWwT\xc0\x80\xe3\x85>\x01u\x98\"\xb7P,\xfa\xff\xd5rUs\xe0\xe6\x82<o'\aS\x8eI\xc3'\x18$:M\x0f\x9bg\xe1ֱ\x803\xac\xff\x16\xd2Q\\\xff\x98(\xc1QK\xca\xfe\x02\u007fՀB\x86\xaeG\xac\\\xf1I\x14\x1e\xce\v\x13\xb6w\x95\xa0^\x008\xe5\xda\xfcʄ\xef҆b\x96X\x99\xa8\xb4>f7\x10\xb7\xd7F<!\xcb\xd7Y\xb9\x95\xe3\x1b\x8c\xa3V\xf7\x13\xf4\xf1\x912OF\xf7ʟ\x8d\xf4\x99\x85G\x82\x91\t\x1e\xe7\xb4@V\xfd\xfeYcY6Z\xa0\xc2G\u07fd\xc17\xae\x95&\xfdo\x8b\xad`\xa6\x80\x91\xbc\x8d\x9c\x1d\x8d=\xfe\xe3\xdb\xe2\x1a\x1f\"\x82>(\xbd\xc4.\a\xc9^#\xa2Y]E\xac\xaf\xa7\xd79\x04\x03\xda\n`\x05\x11=\x18\x17\xadj\xf2$\x1d\xec\x83ǿ\x1cbt\f\x9f\xe1\xe5\xcb\xc1\x8d\xc3v:\x95\xf2\xa6,}\xccdb\x10b#\x9d\xba\xcc#\xe9\x8a\xe6֊\xc1y0\xe3\x8e\x1f\xd9\xcfs!o\xea\x8cQ\xefR\xe6\xe6\x00\xb0\x11\xec\xad}Sn\xf5L\xa2c\fK#\x00\"\x18Nll!ۍ<\x1c\x96\xf4\xda\xe2N\x13\xd7\xfb\x86\xb1C8\xd1\xe1\xeb\x10Q\u007f\r'p\xeaܚ\x8d\x9b2|[y\xea\xb7/ϒ\x84\x8fn\xd6d\xb9\xee\xf7Տ\xf7\xf8vYd\x92q\xa8-\x99\x89\xce/\xc9\xd6d\xc5\xff\x8co\xe5\xd7@\xf4\x10\ri\x99\xe2\xc8$\xa9\xc4\xca\xf2ٶ\x15\xd0\x0e\xa533m]R\x1d\x0e\x95\x87O\x8b\xa3\xd6Ea\x88\xd5\xff\x1e:ݭhz\x9d\a\x9c\x04\x0e89\x16\x96\x0f\x9d*\x9e\x84\x92\x9d}̡mN\xa3>\xb7'\xd1\x1aMB:OБ\x03\xe4\xba\xc9\xd9c\xe4\x1c\x97\x8e\x80yR\xf4?\xba\xd13\xe7.\x16-\xea\x8bO\xfet\\R=\x16-\xc4\x01<\xfaC✈\x88K}\xae\x1f\xe3&\x80\xbcm^\xc0%\x81\x16\xb3O\xb5\xd0\xf3{:\x959F>\xd3\x18 \n\x1fk\xc8\xd7t\xc1\xf3\xef\xcfxl#\xf2\x19\x9f=s=\x8b\xca\xf5\xe9\x8b\xcf&\xc8pg\xd2V\xc0\x8d\xd57F\x8e\xbcfx4)\x0e\xf9\xf1\xfd:\xd4K\xae>\xe1\x93~\xe9_\xe7)\xfbuy)\x15\n\x87C\xf1\xa9Z\xe8.\xe7e|\xd7\x19n\xaf\x11N\xafB%\xcfMu\xf5ͭ\xad;\xa8\xab\xff\xbad\xfa\xe1d\xdba\xfd\xb3\xce\xe2\x8cK\uf140\xe5$\xc3\xec\xa4\x0edU\xd5x\xda\x1d\xeeO:\xad!#\xef\xa8\xee\xcd\v\x8a\xe7!?\xc56\x1e\x87WWqW\a\x125\x9a\xf9\xec\x18\xfe\xf6/\xaf\x1a\xad\xebv\xb4\x18n\nQsGd\xa2ģ\x8ej\xdcO\xcc\xff\xb2(%\x06\x04<\xd6\x1a5\xa2\xc6\xc4.wy\x8e\xb9aE\xdf\t\t\xf4+U\xd4Vi\xb6\xb8\xd8\xef\xf3\xf4\xd6\\\xb2zM\xda_ѤB\xb9\xc2ś[\xbal5^.1\x93\xb7\xafi\xae\xb1\xa3\xc5g\x8e\x9d\xcb˙\\'\x96\xe9\xf5\x9c\x84$\x97Ň\xd7+ݡy\t1\x8c\xb3\xe3\x82\"WpB\x0eRN6\xb8L(\xbeq-:\xc5\xfdЌ\xceY\xbf\xb3\xfb\xbc\x84\xa8*}\xc4\x11\xa1+q\x96\xb0$\xf7^\xa2M\xa5\x97x#\x80\xad\x84zT\x8f=\x92!\xdc\xdc\xe7\xcd\xc6柰\x88w\f\U000ffabdPϖ\x91\x9f\xacV|\x97\xa3미\xa9m\xf1\x1aw\xbeA#\x9a\x8c\xa5\u05cd\u007f\x9c\x1a\xf6\x12\xf5#\xf8\x81L\x9a\xc8\x04\xf8r\\}e\u007f2v\x9dd\xcct^OƢ\xbb\xfa\xa2.q\x9e\xea\xa7\x1c¶yz\x1b\x03ZP\f*\x88hB\x9e\xd2\x19\x89)b\x83A\x05\x11\xb3V\x94\x1e\x96[i\xcf \xaf\xeb\xfb@+\x88p\xe4\xe9\x00}\xd0u\xfb\x8et@\xc3]\x87L\x1bZ;\xb5\xdc\xd7\xf2\xc3\n\x0f\x8dS[\x8b\x15\x96\x90ސ-n\x9cq\xc9Y\xc9\x0f\x82ّ,\x96\xc0\xae\x9e,\x92!\al\x99\x96\xc3ڕ\xc1\x11#\b\x84ۨ^w\xd5\x18\xe4\x9e}M`\xd5I\xcc|\xb8x\xe6\x1cͱ\xbc\xd4\n\xcazY\xd6\xc5\xd9k\n\xd0ف\x8fФ\xae(\xe7\x87j̀tT\x011P\xd1@\x17m\t^VxM\xff\xa4Ҭ\xb4]V\xfdX\x8c\xa1\x15(\xca!\xfb\x89\u0091\x0f\xef\xb5n\x99\xc2\xc1\xa7FM\xfd<M\xafU\xeaP\xe6{\xef\x1d&ւ3\x91\xb7\xe5\x00\xa0^\xf3\xa3\xd8q}ۓ\xc0\x15\x12\xd8G/ZVs\x852i\xf5\x11,2\xa71\x10\xf9\xcdNUJ\xf2\a\xae[s[\xddw~\xf2\x98\x9e[f\xe3\xfc\xcay٩_s\xfc\x84]u~c\x9d\x1fV\x92HE\xeb\xf0A\xe6\xa7р%\xd8\xe5\u007f\x88`\x8e\\\xc0P\xc4\x10\xa95x\xf6b\xb7\xf9S.͍\xdb\xd4дw\xe1hx8\xc3\xf7\xca8s~\xb6\x8d\x06\xf5\xa8c\xd0uW\x9b\x84\x03\x01\xba\x81,U\xb2\x10\xb3\x84~\xbf\xfe\x11\x86\x9d\x9f\xe2\xc3ju\xeaQC\x12?\"\xae&\xf8\xf0\xe6H\x16T\x10\xf4\xf3͎\x18L\xa0\xfe\xbb\x9e\x8f\t26\xa7߯\xf4\x8c\xb5ޮ\xe6RHei\x99\xf0\x19\xf8A\a?\xfaFJ\xb7\xb9(\xfaF%[S_\xd0N],,\xc13\x1cs\xdf\xdb\xc3p3\x825\x98Qy<\x0er\xec\x86\xfd=\xa1j\\ħ\xd4:4\x1d\x81\x17'N\xe7h\xd58\x8dO\xa8\x85\x9a\fD\x8b:D$!\raAE\xe3c\xbeA\x9ba\xd5\t\f\xca\x1b3\xcfuH\n\x18\xc6*\xef\x15\x8dXG\xe0\xba\x06\x9f\x94^J{z\f\x90t\x98n\xdc@s :|E_\x96\xc5\x06\fʅK\xa4\xd8\t,\xd7e^,߃ʳ\x00\xc8e\x15\xb0-r\xc2q3K\xb5\xbf\x8c\xd7;\x1e\xd1\xee\bc홾\xde\xf1\xb7\x05\x18\xe4\v_\x83\xdeJ\xfd\x9d\xab\x0ev\x01\x16\xe1l\xa7/8¾\x17\xd7f\xd6\uf6d2\x12ח^\xdc9\xb1\x1eaCBC\t\xc7ը&\xefDp\x83l\xe3n\x80釪.\x16\xe7̑\x18\xea%8\x85v\x15\xdd\xd1\xef\x04N*\"\xe6P\xee9[\xc0\x96me\x97\xc0v\xf0\xb1\xf1\x14Iͣ\x82]\x180\xba\xccwe\x04\x11I\xb0nƶ)\x95A|\xc5ņ\xb9్\xbd\xbe!\xe4\r\xb3C?\xaa\xa31\xdc\xf8\xf8\xfc\"\x861V\xf3y\b`\xfb\x93JS\xaf\xd1\xe0cS\xb4\xab\xd5\xc4gcTs\\\xe2W}`\u007fP\xde\xe1r7\x87Ć\v\xb3\xe7\xc4\xfb^\xec\xb8[A\xf5 .\x01\xa7\x03\x1doҴw\xbf\x8a\xe6\xfa\xb3\xc6D\xa9H\xb52o\xfc9~\x8c\\\xd2,>Kf_\xfc\xe1\xf2\xb9r\rV\x81 ~\xf9\xea\xa2\x18H0\x0em)@5\xf15\x1f\xb5\x84\xe8\"\x96\xf9ti0\xd6\xf2q\xe6\x8bҎYf\xd3\x14\xd7\xf9)\x14\xd9펱\xa6\x95y\x9f\x14\xb4\u007fo\x05-\xb7\xa6U\xca\xe12\xe4jZb\xb6-(#\xd7x\xa8\x03\x05\xba\xee\x96\xdf\xfe\x9c\xa8\x10\x14\xae\v\xa6\xdcH\xa7\xfc#m\xff~\xb0#\xeb`{\x1f\xa5V%B\n\xe7%\xa1W 1\x19!\x1c'h'\x88\xcb]e\x02\xb8(\x8fhmRՓ\xdc\xfcxʞ\xd8\xc8 \xcc S\x91ȸ#\xb5\xf1\x95\xf4\x975z\x82Y!\x13\x92\xb3M\x9a\xe7\x00\x95\xfe\x97\xc9\"\x16\x9f\xa7\x02L\xf2\xe5T\xba\xcb\xe2y\xba\x90\u007f{\x8e\xb0\xb8\xe2#\xc2^l\xb6\xfcV\xa1\x9a\xe3\xb8m\xf2{\xe8HI\xc6\xe4\x85`v浪\xf74\xed\xc1\xe11a3x\x93\xf3\xb9k\x02\xc8Xs(uN\xbd:Ogq$ԓ\xccJ\x98pi\x8fbxF\x0f%{\x842\x9eҥ\x8f\x02s\xbcдG\xbeV\xcb\t\x10\xe4?-\xd2\xf03\xad\f\x9f\xab\xb8\xd5\xeapn2;\x92m\x1082&u\xae[6\xd2\x17g\x04\x83g\f\xdf\x04W\xd2T\xa5V\xf0\x1e\xaf\x8a]\xc3\xd7\xe8a.\xe2\xe6\t\xfd\xd8K\xb8Ҳ\xf7\x9d\x89/\x1a\xdf\x198\xc5u\xccQ\xa4\xd6\x16;\x83\xbcSElnk)\"\xb5\xd8\uf553qV\x92<o\xf7\xa8\v\x0fu9\xb2\xb5L_\xe9rY\x94\x99`ѝ\xbel\xa1\x1a\xf8\xe0\x1c\x1bu\xcd$\xabi\xf0\x16l\x91\xbfO\xd7\xe1?\xec:\xfc-\x9e,?\xfa\xb6{\xabl\x1c~C\x83\xf7\xd6M\xae\xcd(\xe0\x14\xef\xf7˂\xb2\"\xc7#\\\x96E\x19\x81\x1f\x8b]\xd9\xe3\xe9e\xefR`\a\xc6\x19르\xf7\xea\xeb^\xb1\xea\xa5=\x81\x8a\xbd\x94\xf5\xf8\x1a\xf7\xe4ТXٲ\xa7\x97\xc3˴\x1c\x01\u07fdS4Hx\x04~\a\x06\x16\xdej\xf4\xf2\x80\x01\xa5\xaaa=\u007f\xb5\x90t\x8fH\x88\xaa\xc1\xb7?\x9b\xc0\x9fn\xa9k\xe5x\x8f\xf8\x9a\x8d.\x1b\x8b\xf1\x95\x93es3\x91c:)@\xe2>A6e\xc1\xc0\xa2\xee\x11\xe4P\x88C/\x8b\xd2\x06\xfe\xb3\xf60n\x96\xc6zo\x8c\xb9fܨ\xee\n\xc7numk\xda0\xc8Qy'~\x8bi\x1bCtwL5F4\xee\x14\xb4U\x85,\by\xf8+d\xd0vg\xcdM?Tӿ/\xef\x87[\x1f\x9b+SA1\x06\xca[\xa0+\xa4C\x80\xd6|\xba\\\xba.\x17\xe7.1\x9d\x9c\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf9W*e\xed\x19A\xd3kr\x95\xf2\xa2\x1c\xed\x18.\x9f]a\xcaae;\"uG\xcfhV\x16$\xbbOk'\xe8 \x8e\xe0~O\"X\xa9\xd0w\xff_2\xd8Q\xc6^G-vt\xb5Ci3\xfdl\x97\xe8\xed\xb3\xafb\xe0$y\a\xe8͟^\xbd|\xfbΖ\x9b\xbc\xeb\xe0W\x19ꐬө\xccX\xf9+\u007f\xe9:\xa7p*\x03v\xdfm~\x8e\xc3tQ3ޕi\xffmqIāi\xe4\xd6Mxě\xbefzJډLk\xe2\x0fԉ\x1f4\x1dм\x1e\x1a\t\xbe\x9d\x04\x13\xd6\xedLǼ͐6FMٶ\xd8\xee\xb6@\xaa\xab\xf4\xa3\xa2T\x83\xfbd~\xb5\xcb\xf372\x14M3-em\xaf)\xea\xa8\\\xf6\x1dM\xadۄ\xf9T\\\xe32Oo\x13\x9br\xba\xf1\xc1hs?\xb3\xbf#\xef\xb7f\x0f6\xe9{\xfcZ\x95hW\x8a\f\x86㊔E\x8ec\x90\x914/\xae\x00j\xe6\xde\xd0aFn1'\xc3\xcb\xe2\x83\x03+/\x1c\x89.\xb5\x92\xa3\x9eL#\xe6\x93Ѿj\x9d\xea\xdb\u009fz\xeb\xe2\x0e\xd47\xae\x0fݩ\xd6o\b_\x17;>ԝڪ\x1dVMƫ\xb7\xdf\x0f\xbas'\x89`\xf7\xf4\xf2\x04im\x05\xbaPW\xa6:\xda!\xef\x13\x87'r6\xe5@`\xdb\b<\xc9\xc8\xf5\xd3'\xe7\xe2_\xcf\xe7B[\x88ڕ\xd5p\xd1P1\xa1\xa0I\x93\x0f3\x19\xbf;\x95\x16\xc4\x06\xfc\xb5\x9cÎ\xe3\xf2\xaf$\xe3\xeb\b\"\x96\xd4+\xbf\xd1eE\xa8\x816\x12\x92O\x8b\xb6\xc5Z\x17N\xb1\x8e\x90\xdbL\xba\xa2\x13]\xcflԅ\x88\r)<\u007f\x84&c\x18\xdb\xc2P\x13\x19\xb3\x86tt\xab3iϊ\xa7iݵ\n\x03Ch4\x19\x8fQz.\xda{\xa3D鰀\xe7\xbf?0\xf4u3\\\x8e\x1fԌ\x17ۘ\f\xc0\xf6\x03h\x1e\x87~\xff\xe0\xf2-\xbf\xe8u\x97\xe3\x15\x8f\xb1ߟWa\x93\x96BL\acY\xe3\xde\xf9gkK\xb0\x8fr\xadj\xbb\x1cځ\xa8\x13\x89\x82\xd6\xce\x11\xc4u\x14\xdd\xefyD\x9bI#]\xdf3\xc1,)\x87\xcb$I\xd8\fk\xdf?\x152\x18\xdb\xf4\x8d\xf7ri\fF\x85\xf1\"\xdf5\x8eM\xe4d\xc8\xd47\x89\xef\xb6hR\xc5\xfb\xf6\r\x86,\xb8,\xd8\xe4\xb1\xeb\u007f\xf2\xf8Q\xfdK\xaa\xecN\xb2\xbd':\xe3\xfd)n\x96\xad\xeb\xc3\xc3H\x97\xebWLLS\xf8k\xac\x00K\v\xa2\xae\xad4f\xbc,\xf2\xbe \xd3D\xa6\xa32\xf9\xfb;\t\xb1/\xc8n\x8b-\xe3:P\x86\x9aNl\t2r\xddPe\x84\x8e0\xe1u*\x84\x9e%C\x94\xff̣\xfc\xfa\x867\x8c\x90\xeb}\xe1\xba^\x1c\xf1\x14\fZ\xe45\xd7o\xee\x01g\xf4_\x15\xa8\x84t\x04*!6vK\xcdލC\xf4\xa5Ψ\x16\x9b\f{\x95yq\xf6=\x10OA\xb8\xa3]zbd}\x00QH\x05\xd0\xee-@s\xa3#\x13\x10C{\x1a\x85\x00\x1b'_\x8fg~GN\x0ew\xf99\xeej\xcd\xf2\xe2\x06\xc0& \xea\xe6\xea{\x03|\x9b]\xce\xc96ǯ\xa5\xdbb\x93\xc3\xf4\x1d\xa46\xb8ń\x90\fvDVzE\xb5@눆\xc6*8\xecc\x18N\x97X\xb3\xc6\xc3IU\xa7Z5*\xb8\xbc\xb8\x8a\x80,\x04\xb0\x9a\xd2\x04;\xa9\x87\xed\xd6\x1c2V\xc1\x16T\b\xacR\xf9\xda\f\x0e\u0605\xa8\x16\xe6\x95\x00\x87\xd6\xed?\xf8\xac\x8cˡ\x0e\xfa\xc0NpNt\x13\xcfJ\x9b\x8f*\xd2Y\xc3OA}\x89\xdf-\x9eA\xe5\xe5\xf5\f\x87\xd6ōJL=\r\x06\x02<m#:\x10\xc6\\\xeb\x86\xde\xfb\x12\x9aɗ_\x92+BS!\xa3\xe8(F\x810X\xcd0`\x9e\xf9\x84aU\xa5\xb3\x1a\x8ck\x97\xe5\xeeZ\xa83{5\xfe\xc0\xcbT\xeb\x93X\x04+\xcfG\xc0\xfbx\xe4UP\xac\xedc\xf6\xbd\xe1\x94\x1a\xda\xec\xfd\xfe\xac\xf3*֧7(8Z\xc0\x1c\xbew\xa5\x81j\xed_\xday\xefz_\x10\xa9\xef]\xdcu\xefꉾ`\xcb\xe6\xcd{\x84\x939l\xaa\xc9Q\xad\xf0\b$r\x0e\x8d\x1e\xd99\"lY\xaaڑ0\x18\xe2\xef\xe0$k\xa3J\xc5\x11L\x8fZ\x97\xda);Z\x97@\xbe\x16\xdcT\xf5ǚ\xd2)k\xd1\x16\x8ai\xc7e8\xeb\xfaX{l\xa3\xb31\x8c\xc7r\x8f\xdb5\xb56\xe4ll\xd9^W\v\xf4\x1bMt\f%\xba؆2\t\xban6;\xb0\xba\x8f\\\x81L\xf6\x1d65\x0fM\xf9`\x90\n8\xeb\xa2!\rt\xaa\xd0\x04ƴ\x03\b:\a\xbf\xe4\xe7\xd0o\x82\xbbm\x1bc\x0e\xefx\xbf\x1f\xc8K^\x1d\xf7\x05o\xe8q\x89K\xbd\x1b4\xb9\x95\x8e\xd3#\xb8\xa4M\xe8U\\\x80Vx\x89@\xcd`si)\xe3\xd1\xfbc38\xe4\x0e~6\xf6j\x04\xbc\xc2\xcd-V\xd9\xf8\x1b\x86\x995\xf4\xd8Gc\xeb\x01\xde2\x95\xb7\xf7C\x8bqx\xbd3\xa1O\x1a$\u007fv\xd0Hջ\xfb5\vS\xec\xa4\xf3N\xdc0\xf2\xebjl\xb8\x99\xceyٷ\xae\xe6\xcc|\xc6Cr\xb7c3p\x80\x91n=_)\xf6㴛BH\x90m*\f\xd1\xd9\x01\x9e\xa8C`\xad/C\xf9\xccԸ\r\x83\xdeᡑ\x1bA\xaa\x18\xe6b\x88\x82\xe1\xa6Ì\x8c\x19\x1f(\x82\x01\xc57\tH\xaaQC\xfeҁ\v\x83\xb2\x99\xfb-\x02\x00\xd9X/\x9c\xf0\x1c\xa3\xa6\x8c\xb6M\xf9\x9a\xa6\x1b\xdc!\xbd\x01\x00\x8f\x05\x12\xb0\xa9\bO\x95Y\x8f\xbc)\xb6v\xb2\x19n\xaeSI\xeb\xe1\xa1\xda\\C\x18\xda&\xc7n%_\xe5bt\x15 h\xc0\xd3\xd4\r\xdc\xebᑅ\x02G\xbe\xa21\x00\xc8=o\xe2\xb7\xe5\xce\xe31\xb2G%\x1e\xb7\x92\xf6֧E\xfc\xf2\xc59\xc1\xb5k-\xa2NՄ\xd6\xfeϚG\x94\xb1&\xf9F}\xfe~\xc9\xe3\xc9\x18i\x80\xaa\x10\x025z\x8b\xaa\x069;\xe2\x068\xcfU\x9f\xdeIOy'\x95\x8b6\x89\xd1α\xfb\xf2)\xfdv\x9ca\xa4f9\xbdd\x11\xb7QQ\"8ǋ\x01\x9f\xd3E\x04\xcf\x1f\r\x89zޤA\x00\xebHR\xc1\xe7M\xda|\xde\xc4\xddϛ\xf4\xc8\xf3&\xb9\xe7\xf3\xa6tZ*\x8f>o\xeaj\xa1\x88\x04\x84\n\xe4\x12\x9c\x8c*\xfe\xcb\xf7\xed\xb2\xaf_\xff\xf5;A\x82\xfcR\x15\xa3\xa0.U\xef\x9f\xc1\xce\xdfI-\x8cW\xc3\x1d'\xf0\xd9\x1f2P\xc1\x1f]U\b\x84Ph.EO,<k\xbfI`\xd0Fk=j\xc7\x03/=큗nw\x1d\x1e\xf2\xf2\x13\xb0\tV\x05\x97\xca\x0e\x183I#\xfar\xa8*\xd6\xd9\f\xd4\xef\xc4\xedDGmR\xdf\xe5(\x893\x17/a\xa3.\x92\rbe_\xe5v\x1b\f\x15j\xaf\x8d\x15\xc9\xf3\xe3\x13\x16\xb5\x16\xda\xd3\xc9\u007f\xcbѹ\xd4g&\x03\\\f\xe4\xfb\x16@cE\x17\xd5ݯ\xf8Æ\xc2V\xa5\u007f\xcf:_\u007f\x83\xb5\xb5\x9bh\xf3E\xd8|n\xeb`\xc3\xc1\xb0\x1bݡ6l\xcdk\x92\xd9\xfc\b:\x1e\x99\xb2\xb0\x19\x18\x82H\x87{Iqmd\x80W\x94\xc8\b\xa8Q35\x84\xbbC>\xfa\xcc'\x8b\xd6\xea\x8a\xdde\x8eߐ\xcc]\xa0\xaa\xfe\xa8\x855\x13\x0fk\x1e\x05\xd0\xe6餉8\x93\x10\xe2<:\x889\xaa\xf1|\xb2h\x02\xca\xe9\xb9\x01\xb0G\x10\xa2ZK.\xc0\xa3\xf4N\xdf\x17><\x1aQU)\xe1$\xcd\xdf\xf0\xb4\xe4\xe8l\x8c\xfc\x87&\xaf\x13b\xa7\uec1b\xba\xfd\v\x9a!'\xfe\x83\xb79\x93\x03\x9b#'m\f\xeb?n\xca~D\x03\xdbA\xc0\x91\x95 \xf9\xe8w \x1a\xe2!,\xbe#\x89\x13\x99\x95@D\x9e\xf8\x8aQ1\x9fY#٘,\x8c\xc9S?\xdf/ͤOe\xb3P\x9d\xe9\"\xe9\xc2\xce)YE\x05$\xabhl\xdeh\rb*oL\x93k-u'ډs\x12<T*\xf7\xa7$!O\x93t\x96\x0e\x1b\x93\xc7ۘT6\"q\xd9\xd5ow\xb7\xe5ӄ\xcc\xcaA\xa8\xdb \xa5\xeb\xf7\xcfh\x106C\xa2\xa0\xa3ȩ\xf6\xefm5GY\xb2k\x13˵(\xe4\xc5\x16\x98pX˄\x1f\xc8c<\xcf\x16h\xd5ζs\xa0\xf66\xc1\x11\x19\xb67\x1e\xb5VѮ\x03G\xbcxI>\xe0,z\x04\xd1&\x89V\xc3%|\xb8E\xd7I4\x19\x8f\x1f\xe2h\x83V\xb0\xae\xd3x\x94[\x92M\x9a\v8\xbb\x98y\xb8\xb2\x02\xe3UrW\xd9G>qn\xfeKl\x1b\x13\x87\x04\xa2B\xa1\xd1\r\xbaM:1\r]&\xff\xfb\xbfz\x8aK1E\xd1\xdb-\xbc\x9a\xaf\x17\xc9\xf5\x00\xfc\x1b@7\x9dX(\xc3(\xf3\xdb\x1c\xcf\xd7\v8\xbc\x1e\\\"\xd6\xef\x03sPk\xef!\xd6\xef\xb3ȉ\x88\xfdYW\x8f^\x87ӛ\xe4z\x18\x11\xf6]\xfa]\xf4\x19\x9cu\x92\x8e\xf3(:e{\xce'\xe31\x8c?\x83\x83\xcb\xeaj\x0e6\x84\x0e\xc1 [$7b\x8dU;`&X\x11J\xd8Z)\x80\xb4\xce\xc4s\xe6\xc9\x03*#!k\xca\x06L\xb3\xa9s\xbe\x80\x95ک\xe7A\x13\xf3\xacL\xaf\xae\b\xbd\x02p6\xc1\x8fϿ\x18\xfb>\xe5\xa2÷d\x83\xa7Ͱ\xab\xcf\x11o\x04\xf0a,Z#\xb9c\x10\xe5!\xaa3\xcb\x15\x1f#\xab^\xc1\xd8\xfd\x99!\x81\x01[ٺ\x82h\x99\xe3\xb44\x1a\xce\\\xba\xef\x16\xe2&\xb7\u007f&a\rh\b&\x8e\x19\xaf\a\x15g\x92\xba\x8aR\aU\xde5`.\x9f#~\xbe\xf5\x1d͡cXx(\xad\x96\x9e\x8c~\xfdjwpG\xb2\x18\xa3M\xfa!nb\x17ڐF\x88%E$$=lQ\xc8\xca\xe7[\xbc!\x94U\x9c\x01K\xd3`\x10#\x87\x12o\xd2\x0f\xa0=\x13\xb7\x02\xa1 4\xab\x065\x97:s\xde}\x9a\xe2З\x17v('U`c\xb4\x9a\xc75\u007f\x81\x18\xac\x8b\x92\xfcRP._\x8c\x1d#Go\x8f\x15\xc9\n\x87\xa8t\x02\xb9\x84P:H\xd2\xe2qsO\t\x1c]\xa7y$X\xc8\xe0\x15\x17\x88e)\xd38\xb7\xe6D\x113T5E\xa5\xba\xc7ux\x8bwi\xf6ӎ\xa9\xc5D:y\v*\x933\x9dl\x87\x86\xf31\xfdF\xd7\xe0nFիi\xac\xfe\xff\xdfh\x95\xb4(k\xf7\xc5w\xfe\b\xdd㚄h\x9b\xecf\xb5r\xacV\xe0\v\xfe\xd8/\xfd\x06\xaf\x84\xf4\xbfIZJU\x99\x1aj\xbe^LM*\xb4\x1f\x93$ѫ\xe8\xf7\xa3\xe5 \xd9*\x10]\xa3\xabd9\xdc\b`_'\xe3\xa7W\xb3q|\xf5t5[\xc5W\xa80\xea\xaa\x04G\xd7h\x05Q\x9a\x9ct\x17<\xb4\r\a\x81\x13\xe3\xaa*x\x1e\xd9p\xb7m\x1e'J\xdb\xfcM*f\x91\a\xd0!\x95\xe80Ag:>\xd1MB|\xe6x\x8d\xaeQ\x06\xd1m\xa3\xfc\x91\xfe0e\xc9\xed\xd3\xe4f\xe6~\x8b\xbd\x8a:\xbb\xb9\xcf\u007f3\x94\xa2\xd2K\"l\xe7u\xe0\xc8!\x89\xe9(MZ\xf4\fy\xdccz\xfe\xa8\xb6\aM\xb4m\xa5\xbe\xd8;\xb8\xc9\x18#\x9a\x90\u007fK\x11KȐ\xa2\"a\x83\x14\t\xaaDg$\x96\f,\x1b\x94\xb3\"fGm\f\x9d\xa8o\x9e\xb1\x93>\xf8\x8e\x17\x89s\xfb\xb8\x8eK\xee\xa5Զ\x91\xa5\xa6#MX\xb4\xc1ה\x8e\\\x1a\x11aD]\xe2N\xa0\x8d\x1c'hN\xc8|\x8e,\u07ff\xc19^\xf2Vh-\xefA\u009fp\xa3ԛ\xaf5\u007f\xec\xe6.\xce&\xf2-4\xe2\x11v\x83\x0euh\\\xf6\xfb\x88\x06\tns\xed0\x0e@\xc3\b\xec\xed\xb5\xcb\t\taL\xeb\x8c\f\xdc\xd2,{[\xecd\xcc\f\xe5\x99a\xb5\xfbS\xac\xa0\"c\x87\x997\x18\x0f2\xa1/5t\nx\x87}s\x90\x1a(b\xaa\x92\x05:\xf0\xbd\x03\xa0\xea\xd50\xe1Qa\xbc\x10\xde*\xa0\"VOJpo\xc1I\x85\xc3\xc95 \xc9e\xb8\xab\xba\xb7ݶ\xab\xaf@C\x84[o\xa2\x81u\x1f\xaeҹ\xf4\x89\\\xa5\xdd\x15\u007f\x99\xbd\xf6dae\xa3\xecI\xb4\x97d\xd6i!c\x92\xdd\x0fX\x15\xacp\xebi\xceC\x8bvy\xfb\x88K\x8bse\xae[$]\b\xef\xb3\x17\f\xc6c\xe4\t\xe6\xd4a4\ns\xe8\x8f\x18\x81`Tk\xb2\x8d\x0eֳ\rNR'K\x9ed#\x91\xd1\xe66\xea\r\x9a\xf5<\x9dn\xb3\xd3\xc9\xf8aW\xbf\xa1\xfa\x83@\xfd@$3\x1cD\xe6\xc65D\x94\x8eǵ<8\xf2\x16h o_\xdf\xcc\x06\xa3\x16\x99o\u007fm;'\xb2\xc0\x13Á';ZE\x82\xd6\xd7OvJ\a4F\x98f\xf1d<V\xfc\xfe\x04y\xdc\xf3\x18\xd5,\xb3\xac\xa4\xf5\x9d\xf1\xd9\x049\x84?>\x1b#\xc3F\xc8\xf74C\x17\xc5\a\xa3\xaf\x92\x1fjTT\xefnR\xf8\x8f\x1f!#\x19Ə\xc6u\v㮯\u007f\x02D\xa8\x18応\x91\\Y+\xfe|\x1c\x8c(C\xeb\xe7\x98O\xcfw'=\xdf\xd9^\x9a\x19=[\x19\x9f\x1a\x89\x8bwy\x0eG\xab\x82\xf27\xe4\x17\x8c&c\xf8\x90W\nr!\xf0\x129\x99\xf0\xe3\x1d\xb9\xc7\xe3\x1d\xf9\x8d}\x13\xdfp\xb2|\u007f\xdb\xe1\x80O>\xc6\x01_\xf9hX\xf6D\xf6_G^Z\x00\x88\xc8}\xf3h3=I\xc3-\xe2\xc6\x13\xc6M\xcaT\x90\xe1\xac~\x9c\xf8\xcc\x0e\x99\x98\xea3\x1c7<ul\x1d8\xba)\xd3\xed+J\x9b\xa1\x99[\xbdu\xbc\xb7\x84\xe3\x93\x1f~\xa3Q\xcb\xd2!\x05CI\xbcI\xe5\xa5\xe7~^\xec(\xf7\xd9z\x19u\xe6\xc55.o\x8dA\xca\x1b\xbe[\xbe\x97\x8e\x98aW4\rKW\x05\x05\xc0Y\xe2ܡʚbF\x1d'L\xf0;7g\x8f*\x96\xfc\xa3<46\xb3\xae\xba9\xc4y`\x91g*\xfen\x99\xe6K\x1df\x97\xdaW\b\xe2\x04\x13\x92\xe6\x19%\xc3\x11\x1c\xfdT\x10*˼\xf8:\xceH!\x837\x1b\xd9\b\x80\x86\xf3\x14/\xb6\xcfԊ&q\xf8\x03\"\xedV\x97|\xa3[u\xe5'\xf7\xf2~\xc7\xe1ƈ&s\x8c\xc8\x02\xb1\xe4\xaeBE\"\xf8\x0f\x1bY)}Z\xf4\xfbt^,\xa6\x85\xa1\xaeR/\x01\xe8ns\x89\xcbZ\t+\xea\xc02\x91U\xad&6\x97\xbf\r\x04c\x00\x91\x89=\x91\xcf\xc7\v8-\x93\x9d\x97\xc6\x19\xe5\xf2\xdd\r\\\x16\x9c\x17\x1b\x90$\x89(\x18\xf1\xe2\x9b\xe2\x06\x97\xcfS\x01\xfa~?*\a\xc9\xee\x80\x0eA\xbb\xea\xc1\x8a͋ERVn\x8e\xf6ӥ>\xa59\xa1n\x02\xe3o\x04i\x12\x87U'\xac\x16\xa8\n\x06X\xab\x83\xd8kj\xce\xfa2\xa5\x92f\x99'D\xf1M>\xb9y\x16?Tr\x92\xd4cE\xc7\xd2!\xc99J\xb3\xc8\xfb\x99\x90б\"a\x9c&\x16\xa7Q\xdb\xf4]\x05{\xf4:\x1f\x0e\xd1\xe1&uTd\x9f\xfd\t\xf9\xa2)69\xf4\x05\xf9i\x9e\x8fN\x1e\"b!:#\x1a\xd0ĞP\fcU\xd8\xef\x13q\xd8w\f\x9b\x8db*\xbd<\xf4\x8e\xa8\xfb\xbd3\x9a\xb9ڱIc\xc7p\xa7G\x82\xec\xd6!]nЭ4_\x86U!\xc6\"Ǥ1\xb0`0\xb1\xa5̢#\xbc\xdfG8\xe0\xbf\x00\x91\x94\xba\xf1\xd3D\xbb\tn%\xf5\x99\xe1'\xaa@\x1d$U\xe6\xd2^\xe7)U]\xaeƚN\u007f73R\x12\x9c\xaer69\xa5\xd2\x18:\x99\xef\x0e\xd6\x13\x92\x9e\x1ff^\x93\xfd\x03\xe1\xfeM\xda\v\xefzz[\b\xeaȋ\xad\xa0\x18x\x06\x943\xe9[\xa9\xc2T\u007f\u007f\xa5\b\n\xa2n5MebY$\xa9ߔ\xcd\xc9\xc2\x1b`N\x16\x03\x807\x00\xb19^$c\xc4\xe6t\xa1\x1due<ܕI\xd1Q\u07fd\x96\xa0\x89\xaf\x83\xa3\x1c[\xa3\xb9\xa0\x8bs\xb0M3A\xd5T\xe8\xc9E\xed\xa4k\xafΦ\x81E+\x92\xa8$\xed8뉿\xf9\x10\fh\xc3k\x85\x89~\xecW\xac\xacJ\x98\x93\xb5J!\xb2B\xe9\xdd\xf2=/b'b\x90\xeb\xbc\xe0%|\xeb\xdd\xe0\xcb\xf7\x84\xbf\xb5e/h\xd6+\x1a?\xfd\x16\u07fe\xf1>\x03\xff\xe1\xc99\x14\xeeM\xeb\xe2ґǛ\x03\xa8\x82\xa8\xb8\xf2\x98\xd6\xea\xaa+b\xe6泟,\x86~z{u\x00\x14tu\x82|UC@D\x15\xa0\"!\x87p0M\xb8ѯk\f\x9c\x8a[2\x19#\xaaϫA0>\xa3\xe2H'\xe3X\xfd\x9f!\xaaP\x0e\x806'u\x10\x1dZ\xbb퇉m\"K\xaaЁ\x06\xd1aGeo\xab\xb2\xd8Ģ\xaa\u007f\x84\xc5V\x856\xc4#h\xc9=\x02\x11\xa9\x81_\xd3\x06M\xdc\xef\xcd\x1b\aN\xdaG\xa8\x935\x90o\x1c\x88$\xf78\x8d\x88&\xf6\x10\x93\xfah\xaa\xa0\xb2\xf2lN]\v߆7\xb5k#\xe6\xa2M\xe2\xd6:\xce̘\xb48\x1e+\x1b\xf0s\x95\x196\xd4CN\x8c\x87T{\xdc\x1b\xad牏0zL}IX`\xa8\x89OU\xbe\x91$i\x8f\x0et|l \x984\x96\x18\xa2\xd5\xe8 a-q\xc5\xcd@\u008c<Q\x9f(\xd3¿O\x02\x11\x06N$\xc5$D`]\x9b\xa4\xafJ\x9c\xbe\xd7`f\x1e=\x12\x12{3w\x8cS;dM\xe4X\x12\x19\xe4UE\xa4\xf6\xdf01\x83h\xd2\xf0c\xb0dDf0\b}Sd\xc5F\xb2\b\x101C\xb5\x1c\x13\xf4\x9a\xe3N\x93n\xda\x17\x17\x83\x16ޢҜ\x1dB\x1d\x9c\xd0\x145DpgQ1L(J\x87\t\x1fP\x18;\xcc}\xa8v\xbf/\xaa\x97È\x0f\x18\x14\x8dʡ\x91T\rw\x93\x14\xa8\xc9\xdc$)\"\xfd>9\xdd\x0f\xa0͌t\x13\xd0.yx\x00zm\xe2)\x00\x01=\x84\x06*\x04=\x00HMX\xfc\xe5\x1eS\xe9\xbb\xdd\xcdF\xa3\xa3ĥ\x19\xbf\xa1\xf1.e\xf4\x12>+\x1b\x10k\xf4P\xb5\xa2\xa2\x11\xf9fGo\xcatk8E\xe7\x98u\x02\xab\xa1ph\x80\xa5:UUJ\xaa\bN\x89\x9b\xd8\xd0\xf4\x1b\xcb03\xbd\xa0\x16\xc7\x04\x9fA\x1a\xb34\x9f\xa7\xa0\xa4w\xe5\x99\xfda\xc5a\xf1Þ\xbax\x82\xdcS\x16O\x90\xb9\x8elH7\xe4`Dl\xb4@\xad\xd8\xde\xcd\xc9\x01T\x8bo\xf10\xe8\xe5@\x1c\xc5\u05ff\x8c\x9at\xfa\xff>%G\x04o\xd3Kv\xd4\x15AV:\x14g\xad3_\xe2_\xbew\xd2\x1fz\x9e\t\x87\xb2*\xfe\xea\xf0\xa6uz.\x9e^\xbe%<?1\xe9aN\xe8{O!\xc9\xd3\xcb\xe7*\x03mb\x03\xab\xf1\xf4\xd2極\xe1\xd1|\xe5\xac\x17*\xcdN\xa1\x95\x8f\xcc\xcb*\x84hBL\xfai\x19V\x9c\xf8yjmJ\x8e\"\x91\xc9i\xd6)[\x8fXN\x968\x9a@\x94\xaaB\x92\xcd\xf4\xff\xe3b\x00\x86yz\x89exq\xa5\xe9*lr'm\x8f\xb5-1\xb3FNR\xd7\xe8~\xe5\xe9e\xdbT\xab\xd0%\xea}\x13g f\x88dqZAT6[oS*\x86o\x04X`\xba@N.\xc7\xd9\xe5-\x90͙r\x13n\xa5\xf8\xa6]ɷ7)_\xae\xb5\xe4\xe3\xe5\xc1\xaaw\xcdMS9%ǳB\x12\xc7\x14]v\x1c\xc8\ai\xbfE:\x10\xbb\x8d\x84vr\b\xda4\xcb\xfe\x8cou\x90\x05\x1b\xa2E\nBd\xf9\xbeYn\a\xacc\xd6\xd6\x01\xf4\x03\xc00\xaa\xbeP\xc3\xee\xe5\xdd'\xdem\xbbc\xe8\xd9\xddx\xeb8!\x86D\x87Y\b\x97\xc4\xc97\n\x91e\xa8\xfb\xe4\x1e\xcbAFB!\xa1\x9a\x99\xc8\xdc\xf3\xb6\xdfcct\xf06\xbd|.\xc1b-d*\u007f\xd9\xf5\xa6\x1e^t\xcb\xed>'\xb1\xcc!5,VCq\xd3\x00\xe9\xf6ު\x93\xa7n\x95i\x93\xbe4\xad\x04\x1c\xf85K}0\xad\xa2/ϒ\x84\xa8\xb8Y\xfaFF2˕ƍ4)\xecC\xd5.\x17\\\xe5\x9a\xe4Y\x89\xa9\x98\x16\x80ӴA\xdbH\xadQ\u0530%,*\xe0L\xa9\b\xed\xae\t&\xee5\xfd3\xbee\xb3\x88J\xcbm2Kuڬ8\x956FÉ\xa4\x85I\x92\xa4\xfa\xf8\x0e'\xb3\xd4d\xdcҕ\x06\x13\x99-(\x91\xbfT@\xba\xf4C4F\xa2\xb5h\xee\x94\vnf0Aug\x10B\x1b\xf5,|\x11z\x11-\xf4]،\xb2P\xa8Mz0\x17\xc4/\x91\xa4s\xf1\xa0\x0e\xe1\x10@\xa1\xa2#\xa3\x1a\xbdwO\x14V\xa8\x99\xbe\x8dݻ\x17\x16N\xdd\x16:/\xe10\vU˲\xd4v\xde\xfd\xb2\x81\x83\xf3\x14w\xa1\xb9\xe3\x10\xeb\xa0\xea\xb4\x1d2\xf1ȭ>81\xe5Q\x10tn\xb0\xa0\xfa\xf2\xd3I\xed+8\xd5Wl\x87\x8fT\xf7`\xa1 D*o\u007f%\xf6)\x9c\x1a\x99tM\xc74\xecJM\x16\x1cͬ\xa1\xebE\xa1\x96\xe0\x14\xed\x98\xe3\xc5\xff\x9f\xbd\xeb\xebm\xdb\x06\xe2_%҃!mrk\x17ۋTW\xc8P\f\xeb\xcb2\fy\x19\xd2bpb:\x16`[\x86E7\x01b\u007f\xf7\x81w<\xfe\xa7e\xbb\x19Ї\xbe%\x94\xcc?\xc7\x13\x8fw<\xfe~z\xae\xb1\xf1[\xb1W\x88\xc4>+6I[،\x1b4+\xb5\xdc*\x95\xbc`\x98pt3\x17\x12\xccߏ\x80\xab\x03Q\xe1+Ǭ˅NN\xd0b\xcb\xe6b\a\xc6$\xb8-\xadP\xf1m]e\x02\xdci\xddo\x9c\x1bYazYZ\xc2GUd\x9b\xe1\x91j\x8a\xfd\x8f\xe1oR~s\x91\xa6\xf9\t\x9b\xc1\xa09\xaa\xe0\xc0)\x86\xfa\xe6\xd3\u0099\xf1\xb6\xe31\xb6H\xcdF\x9d\xa0\x91\x93\xee\x03\xab\xbb\x92\x1d\x9c1!\xa4\xe6\xf9\x88\xea};q\x9d\xd5$͙\x044@\x86\xaf~\xc1\x9bPt\xc1\xcd\x12\xb2\xec\xc466\x1e4ǹ\x1b\xa3K\xf1\xda\xd5\x0e\xb8Lƅ6\x94\x00~\xa0\xbb/\x1e*Y\xc1\xa6\xbb\x1b\x02\x00FZh!\xc8r\u070eGp\xdf\xd1\x11\xfc\x91\x04\xf5}\xf8\xf6\x8d\xeb\xcf+*Ru\xe0r!\x97\b\xa2\xfao\xbf\x89B$\x04\xec\xc3\xea\xcc9\xe4\x92\xe5&W\x96\f\xe1*<\x90\t\xbb\x1b}qJov|\xc2\xe0\x1a1\xa4\x8c\x95Y\x98\xb1\x84\xd38<\xf9\xbc\x81\xf3\xb4\xbbї\x9a)\xff\xb8tL\x89\xe5\xadW\x1efz#\fJqe\x80ay\xa5\x1a\x1e\xbd\x91\xd6'd\xf8\xbd\\5\xf3-\xf6\xbc\x99\xaegl\x96\x16!h%\xb9\xa2\xe7'{\x95\xb6\x13\xe5\xc3\xf7\xa2#\xe0\x97\x17aX\xdf\x13\xf1p\ue0ba\x90\xfe\x8b?\xba\x96\xd7\tK*\x005\x03\x1en5*\xf3A\xdfЌw]\xb2\xe6J\x9f\xccǮI\x1b/\xf3:\x86\xe7\x88>\x12iW\x19{m>\xb7\u07b3PV\xaf\xff\xfet\x9dqo\x88$\x8ccI\x05\xd5\x11M\xa8\x8f\x81u\x06\x10\xae\"P\x9d\xdc\xea\xa6:o\x88I\xe0\x90\x97\xe7Bk\x99\xdf\xf2\x91\x96\xc7^ˎP\x0f\x1e\xff\x8d\xf8a\xf4H\xb7\xe7\x1bK\xf87н艾Ԝ\xa3\xc4\"0B\xcc\\\x98\u007f\xd8\xe0\xef?\xbe\xeeQx4\x1dļ\xd4\xff'\a\xe0\xdbvɛ\xcdkRz\x19\xea?c\xddö\xb9\x87 \xeb\xd1;\u009c\xbaa\xdbv\xc2]B\xf5\x8b?\xa2$\xaeG\xc6\xff2ˏ\"\xbd\xf2fs˞\x9d\f]Y\xe8\x82\xc0\xe0\x98pgK\xcb\x06[m\x96S\xee\xec:\xa8\xb4vr7\xa8\x9c\xa2\xb8\xf7\xbbf9\xbb\x95\x85*\x9b\x8e\xde\xd2\xe0kV\x16{\xfe\x86\xb3g\xb7b\xecp\x04\xf3\x1a\xfdm\xe87\x1c\xb6z\xb3R2\x8f\x12\x84J\xa4\xddU\xffS\x9a\xb3p\xbd\xec\fi\xb9\x82\x9aG\x17\xbb\x8e\xcdh*\xba\xc9\xdd\x17\xca\u007fح9\xdbN~1vM\xe8\x03ϴ\xe6\xfa\xe1lwZCK0d\x16\xc84\x824\xa5t\x18\x8bF\x1f\xfd\xae\xec\xed\xe7\xfb\x8c\xb7\x9b\xfd\x92\xcd\xf9\x1erW\xf2\xcf\xf7o\x1f\xd5\xedJ6a5\x03z\xd4Tw\xc1\x9a\xafx<ə\x1b\xd4j:\"w\xbdDsH\xfec\xd2\x05x\xcc0\x1fm\x95AR}\x94R\xa4\xb1\xef\xbb\xd3W\x15\xa3\x81\xd1~\xbd\xf0\xe3T\x19\x02\xb4\x8a\xa2f&f\x9b\x04\xb36\x93\xce61\n\x122\x87\x96\x06\x00\x94}\xc6k\xae\x92\x1e\xf2\"\xa1lO\xfbM\x15\x8c\x81\xfc\x81\xf7\xa3\xda\xf92T\\\t\x9e\x97*\xdf\xe2xeԪW\x9fub\x9fˋ\xde\xfd\xf5a\xceS_u&\xd9\v\xfe\xa0\x94\u007f\xf4\xb7\x00\x1d9\xa3\x01|\xbf<I\xaa\x1f\x86\xe3\x8b\x1a\xf7Z;S\xfa\x97\xb6\xdb7\xe83g\xad\xb7\x1b'k\xcby\x93\xf9j\xed\x1e{\x18ZZG\xd6\xf2;\x1cZ\xe1\xc6\x1e2!i #\xb6Uٵ\x82\x99\xa9\x8c\xbf\xb5\xcf\xc2\xc4\u007flVl݉1\xbao7'\xbc\xad\xed\xf6z\xa2g\xb8\xc6?M٫\"\x99\xbeLZ\xb9\xf6 \x1d**\x00\xf6\x99\x10\rP\x19\xa2\xf2\xa9\x9ay\xc60c\xf2\xc3D\xfc\xb1\x9e\xb5O\x1f\x9bU\x87e\x04N,\xe5;\x18$\xce\xe0>\xad\xfel9ܻn֏\xff\xb4;G\x1a9\x1dVY\xd3*\x93\xd3|9a\x9f\x1c\x91چ\xbfH\x1f\x98\xe8ʕԨ\"\xc8x\x14d`JF\xb9LO\x82\xb1\x95\x8d7\xdcở\x82\xbf\xa4t&H\xd9=\xe4E2\xae\x84[\xf0?\x8c*\xfd9\xe3\xfb\xbd6%'\x8f.ϫ3熘\xafqj\xab\x9cr\xe6\x14$(7\xce\xc9M\x82!:\x9fX\xb4O\x11\xee\xf7\xe9r\x99&n\xeeߢ}\xbaY\xdb\x1aԛ\x17\f\xbfq0\xb0M\u007f^\t\x1f\"\xe6:\xba\x9e\x16\x14\xb8w\xd8Z\xac\xa1\x14\xfd\\%j\xa3\xe1\x98\v\x85\x0f\x94\x87\x9e\xbc\xf8\xbb\x90\x91\xbbU\x19\x1f\xf2\x82\xfb\x946\xaa\xa2\x8e\xb7\x9b,7\xf9c\xec\xf1\xa5\xf9\x9b\xf9t\xa6\"\x14\x8a\xb3\x01\xca>\xee\xb6 a3H\x10?\x86\x12\x122\a\xab\xe7X\xb4~BX\xc5\xedt\x90\xff#\xb87;\xe0(T\xbc\xc3\x1c\xc6͎\x87\xc6\xc1m\x1f\xd1r\x10-\xeb0\x18d<bKB\x8b\xbe\xb1j\x19^@xS+\xa6\xcew\x04L/\xc0u\x01\xf2\xb8\xf8\x85\x90\xc3\xe2?Ό\xa6n\xcb8+K2v\x9cMy\x99\xfb\x8f\xf6+ۺ\xfe\x9f\u0086\x80\xf5\xc7Ry\xeb\u0094\x81W/Du\x14Ë\x98\x8c\x0f\x85\x16\xdfB4.\xd1\xdaMH\x8a%\x9b~e\xe1f\x9b\xfc\xc5B\x11\xe3\x1aE,K\xd8~\xaf\xa6~0H\xb8\x8d\x8dr\xb3ak\xb1\xc0\xc9\xcf'\x88\x9f\"ީ#\xc2P)\x1d!Y\xa0\x9a\u007fZ\xc1)\x15g.\v\x9a\xec\x14\xc8I\xe9\xe6\xa80\xbah·\xe8\xa4\xeb\x8cK\x94\xab4\xc71\x92\xdcI\xac\xf9\xc1\x8d\x9e\xbef\xbf'\xc9ȃ\\\xc1\xfe\xfe\xden\xc1\x9a\x84T\x88O7F\xbb\b\xc7\xc1ֳ\x13\xf4\xa9\xa6I*\x95\xda\x04n \xbd\x04\xf8\xfb\x10\xe0A|<:\xb8\x1e\xa4^\x01\xb7/\xaac\xca9\x16K0I\xa1\xce\x02\x1a\x05\x17\xf4\x921\\j\xc3\xcc\x16\xdde\xddP\xbb\xe3ѶBK\x16\xa9\xe8\tw)M\xb9\t\xc5q,\xf3eH\xfb$}H\x16\xc7ќ\x1b\xcd5\x83H\x8e\x19\x83\xb8Nn\x1e6㨮\xa4\x8c`\xc1\x93\x8b\xf3u$\xbef=\xb6c:\x81gĳ\xef?\x91q\x1e\xd7d\x13\xc0\xff\xa5\x11h\xe7\xf3(\x93q\xa1W;\x00װ\x8dr9\xfe\x15\x8b\f\x03\ae\xe6\xba\x00|Yv\xa0\x04\xf3\xd9u\xe0\xc5\b\x83\x98q\xaa2]L\xbb!\x14\xe3\xfe\xa9L\xbb\x95ؑ\xa9\xfa\xa0\"\x8c\xaea\x9d\xbb\x87\x05\xe0\xf2c\xc9\xedT|\xc0W\xf8\xc1\xa5\x85R\u007fa\xc2-+(~L\xce\xc5x\xa4\x98\x01\xc6\uf891x2p*\x12\xff_\x00\x00\x00\xff\xff\x89\xc0T+\x86\xa5\x01\x00",
 		hash:  "529c228570d58abeb9494ee3b66c2938ad5517e0c8415451acde366f904c681a",
@@ -23,6 +23,20 @@ type staticFilesFile struct {
 }
 
 var staticFiles = map[string]*staticFilesFile{
+	"admintimeline/admintimeline.html": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4\x94\xddj\xdbJ\x10ǯ姘\xb3\x84sul\x9d@\xafڕ\xa0iLS(m!y\x81\x95v\x9c]\xb2\x1fbw\xe4\xd4,z\xf7\xa2/[q\\h\v\xbd\x93f\x86\xf9\xff\xe6c'%\x89;\xed\x10\x98\x90V\xbb\am\xd1h\x87\xdf\xc4#\xb2\xae[e)\x11\xda\xc6\bB`\n\x85\xc40\x98\xf9?\xeb5\xdcxy\x80\xf5\xba\\e<bM\xda;в`\xf8\xbd1>``\xe5*˸\xd4{\xa8\x8d\x88\xb1`\xc1?\x0f\xb6\x17\xc6ڛֺ8:2\xae\xae\xcb\xf7=\b\xcc$\xc0\xa3\x15Ɣw\xa8\x1f\x15EHisO\"P\xd7\x01\xf9\xfeo\xebd\xd7\xf1|\x8c\u2e7a\x9e2\xed|\xb0`\x91\x94\x97\x05\xfb\xb8}` \x06Ƃ\xe5C\xa94\tLʗI\xcf\xec\x16\xa5n\xed\xfa\r\xbc\xa4β\x8c\x1bQ\xa1)\a4\x18YgWƵkZ\x02:4X0\xd7\xda\n\x03\x03',\x16,\xf6\xf1\f\xacv\x05\xfb\x9f\xc1^\x98\x16\vv\xaaq!\x90\x8f\n3U.\xf5\xfe\x8f\x10\xb7N\xfe\x06 :y\toh\xfa_\x80\xfb\xd7U\xb1yw\x19,\xb6\x95\xd5\xc4\xe6\\UK\xe4\xdd\x11\xea^\xf9\xe7_\x02Z|\xf3\xbc_\x92\xf1;%\xbd\x83\xcdv\x8f\x8eb\u05cdn\x12\x95\xc1a\xa7#\x8aP\xab\xf5`8.\f\xf5\x0f\xe2(A\xe1\xa4Nj\xdaW\x9e\x93za\xfe\xa0\x84{\xc4W\xe6[$\xa1M|e\x1f\xdf\u008d\xf1\xf5\xd3\xd2\xc7\xf3\xa3\x1aϗ\x18\x9c*/\x0f\xd3O\x96R\xe8\xd5\xe0J\xff\aWh\x10\xde\x16g%\x9es\xcb2\xa5>rs{3\x16\xd0?-\x92\x97\"\x1e\x0e\r\xfe\xdc\xfb\x95\x14\x86On\xe7/\x84p1\xf4t'j\xf2v=\xb5\xd6h\xf7ĦI\x8b\xaa/\x98\u0379>{\xff\xd46w\"\xaa>\x99(\x97\t\x17\x9d\xc8R\xc2~-\x8f}9\xb5\x82\xe7\xc3\xe4\xe6I\xa3\x898ϸ)\xbfx\x10-)\x1f4\x1d \"A=\xcc(\xc23\x06\x84\x9do\x9d\x04퀔\x8e0\xf4s\xc3\xf3\xa6\\\x9d)\x1e\xd7j\xfe\xe0\xf9t\x14\xcb\xe9\\\xf6\x0f\xeft2\x97\x875\xd6A7\x14_\x1dܝ\xf74\x1e\xdcY\xe8\xc7\x00W\x1eE\n\xaf\x05\x00\x00",
+		hash:  "a0d2cd80011c337dd68fbd7f84ccc3df35469fdbb5790692cb65f820b93afdd2",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792124806, 0),
+		size:  1455,
+	},
+	"dblockminutes/dblockminutes.html": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xbcUMo\xdc6\x10=k\x7f\xc5T\xf0\xd1+5HO\x01W@\xdd8H\xd1\xca\t\xd2`\xef\\q$\xb1+\x91\x029\x1bW \xf4\xdf\v\x91\xd4z?\x1c\x1b\xed!'Q3\xc3\xe1\x9b\xc7\x19>\xe7\x04\xd6R!\xa4b\xd7\xe9j_Ju \xb4\x9fy\x83\xe94\xad\x12\xe7\b\xfb\xa1ㄐ\xb6\xc8\x05\x1aof?\xad\xd7p\xa7\xc5\b\xebu\xb1J\x98Ŋ\xa4V \xc5&\xc5\x7f\x86N\x1b4i\xb1J\x12&\xe47\xa8:n\xed&5\xfa\xd1\xdbΌ\x95\xee\x0e\xbd\xb2\xc1\x91\xb0\xf6M\xf1^\x1a\xacH\x9b\x11\xeefD\x10!\x01\xb3=\xef\xba\xe2#ʦ%p.{\x7f\x17\xd6\xd3\xc4\xf2\xe0cy\xfb&&\x1a\xc27\xf9\x03\xc7\xf2\xcb;`\xdcc\xabyE\xba_[\xe4\xa6jםT\xfb\x14h\x1cp\x13\xcbO\v\xe72\xbfeN\xca\v\xb63\x90\xc7L\xce\xc9\x1a\xb2\x0fF\xf7\x9f\x8d\xae\xd0\xda?\xa5\xa5i\n\xce/Xie\xc9\x1c*B\x01\xb5\xd1=P\x8b0\x84H\xe8\xa4%\xd0\xf5\x8cz[\xfe\xa6\x0f\x8a\xa6\t\xb6\xa5͖\xd4\xd8Y\\r}}f#\xb5҂\x87\b҂\xd2\xd0iՠ\x81=\x0et\vV\x03*2\x12-p\x83\xd0\x18}\x18P\xc0n\xf4 zO \xf4\xdc\xec\xd1ؐ\r\xa5\xf1[Ɛ\xd4\x02W\xc2\aoːCi\x82\xbdҏ\xea\t\xa2\x12\x11!\xcb#\xbb\xce\x19\xae\x1a\x84\x1by\v7\xbdT\xf0n\x03Y\xbc\xaf%\xb6}[\x04\v87\xc7D\xff\xcco\xfb6^\x16\xf1]\x87\xfe\x82\xe2\xcdxC쉄\xd1\xdcy\xf1'ad\x96\xe5\xec)\xb6%˩=3}\x1d\a\xbc2~䶽2\xfeZ\xcd5v(\x1a\x14\xa7N\x96\x1fOa\xf9\xe9\xf1\x8cvZ\x8cKر\xfe\xbfo\xe1F\x12\xf63\x01\xbe\xc8\xdf\t\xfb\x85\x82K̢\xf0\xad\xd4QؓmK\xf8y\x9a\xd6K\x138\xb7\x98\xa7)\xb2\xcer\x12\x17\tB\xcc\\\xe9\xb3nY\xc7\xe4\x7fyBc\xdckC\xe0\xdc\xf5\xa6\xf4x\xd8\xcc`\x18\x8bK\xa8\xc1\xf3*X\xd9_\x80=\xa1\xf9\xac\xc1.\x98E\xfdD\xec\xfd\xa7\xf2%^\xe7X\xcf\xdc\x15\nf\xc9h\xd5\x14\xf7\x9fJ\x96\xc7\xf5sPQ/\xf5|\xcf\xfb\xdfꐵ\x9f\xa5Ӯ87\xbeT\x11T\xba\xb3\x03W\x9b\xf4\x97\xb4x\xd0\xd4J\xd5\xc0#\xb7\xcb\xfb\x80\x02\xa4\n\x8fC\x98\xf2\xec\x15Xg\xe8X~\xd2\xcd,\xf7C\xb7\f\xf61\x8c\xe5B~+VO\v\x96\xc7\u05fe\x88:p\xafĉ\x16\x9c*\x86\xad\x8c\x1c\xc8^)I\xad5\x05%Y\x0eZ}G\x88\x1e4}\xd0\a%^\x14\xa3\x1f\xa0>g\xca\xf2\xa0A\x1c\xc3£<\xdfI=\x03\x05N\xd0\x06\x89J\x9d˦)͖\xe7\xf2E&\xff\x1fm\xff\x0e\x00{8?\x03\xbf\a\x00\x00",
+		hash:  "c4fcc3e3e5c31ee23741e4e6dd6902b0195a8b3c0897133298c3f25f5de0c557",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792125483, 0),
+		size:  1983,
+	},
 	"general/footer.html": {
 		data:  "{{define \"footer\"}}\n    </body>\n</html>\n{{end}}\n",
 		hash:  "239cf4d42e3fd75d2fa4395fb31f7c6679f9c248afe058dc90d9b37280bc5aa2",
@@ -59,18 +73,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  3757,
 	},
 	"index/index.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfft\x8fA\xaa\x021\f\x86\xd7\uf762v_O .\x04\xf7\x03z\x81\xd0d\xb4\xd0&\xa5͈C\xe9݅q!:\xcc6\xf9\xbe\xe4\xff[C\x1a\x03\x93\xb1\x81\x91\x9e\x03\xdc\xc8\xf6\xfe\xffךR\xca\x11\x94\x8c\xbd\x13 \x95e|\xd89gN\x82\xb3q\xee\xf8M->\xc3c\xa5G\xf1\x10\xaf\x92\xad\xd9\xff\xae\xb4\x00W\xf0\x1a\x84\xeb\x94\x12\x94ye#(\xe0\x94\xf2\xe7\xfd\x99q#B\xf5%d\xad\xab\x1b^X\x8b\xc4\x01\x98\xe2e\x83\x19E\xf4]\xb25b\xec\xfd\x15\x00\x00\xff\xff)\xb2x\xeb\x1a\x01\x00\x00",
-		hash:  "cc3a3da9da68933167db530679efab736e844d7d525da9b7f55fe484cfc74a3f",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x8fA\xaa\x021\f\x86\xd7\uf762\xaf\xfb\xbe\x13\x88\v\xc1\xfd\x80^ 4\x19-\xb4Ii3\xe2Pzwa\\\x88\x8e\xb3M\xfe|\xf9\xfe\u0590\xc6\xc0dl`\xa4\xfb\x00\x17\xb2\xbd\xff\xfe\xb4\xa6\x94r\x04%c\xaf\x04He\x19\xef\xfe\x9c3\a\xc1\xd98\xb7\x7fO-\xf7\f7k\xfe?\x01Q<ĳ\xe4/+-\xc0\x15\xbc\x06\xe1:\xa5\x04e^\xbdGP\xc0)\xe5\x97\xc0\x91qC\xa2\xfa\x12\xb2\xd6\x15\xc3\vk\x918\x00S<mdF\x11}\xd6l\x8d\x18{\x7f\f\x00\xbe\xa0\xe1\x18\x1c\x01\x00\x00",
+		hash:  "e3e37b21e89c0b005803afa87e4796112a714d4ca5ba5ace30b492634430da01",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  282,
+		mtime: time.Unix(1792125375, 0),
+		size:  284,
 	},
 	"index/indexnav.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x84\x8e\xb1j\xc40\f\x86\xf7<\x85\xd0n2ui\x1dO]:\\)\xf4\t\x94Xw\b\x14;\xd8J\x9a\x12\xf2\xee\xe5B\x0fz]nѠ\x9f\xff\xfb\xfem\x83\xc8gI\f()\xf2\x9ah\xc1}o|\x94\x05\x06\xa5Z;,\xf9\v\xa1ڷr\x87#\x95\x8b$\xd7g\xb3<>\xc3Ӵ\xbe`h\x00\x00\xfc\xac\xb7\x82Q_\xe1zܐ\x93\x95\xacn\xa2Ċ\x10\xc9\xc8\x1d\xa9\xc4\x0ey\xa5qR>\x1e\xbf\x90\x03\xa4\xf2\x17\xe4LL\x19\xa4:\x1aL\x16ƣ{\xdb\xeaF\x92\x84\xc1\x13P\x11r\x95\x95\a\xe3ء\x95\x991\x9cH\x12|\x1a\xd9\\\xe1\x83.\xec[\n\xbeUyd3\xea\xffo\xbf\xb7\xe6\xc2Wk8\xe5\xc2\xf0\xcaF\xa2\x1c\xe1=G\x86\xb7t\xcee$\x93\x9c\xeeu\xbe\x9d54\xbe\x8d\xb2\x84f\xdb8\xc5}\xff\t\x00\x00\xff\xff\xa7\xffC\x19\u007f\x01\x00\x00",
-		hash:  "dbe5cd4fb16f7f214469c016e8613fc72fd7f0bba689d5a13a5a99fac9615dc8",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x8e\xc1\x8a\xd4@\x10\x86\xef\xf3\x14E\xddۜ\xbch\x12\x10\x14\x11\\\x11\xf4\x05*ӕlA\xa5j讌#a\xde]\x92\x9dY\\/\xb2}h\xba\xba\xfb\xff\xbe\x7f]!\xf3(ƀb\x99/Fg\xbc^\x0fm\x963\x1c\x95j\xed\xb0\xf8/\x84\x1a\xbf\x95;\x9c\xa9Lbi\xf0\b\x9f\xdf\xc1\xdb\xd3\xe5=\xf6\a\x00\x80v\xd1{ h\xa8\xb0m\xe9\xe8\x16\xc55\x9d\xc8X\x112\x05\xa5\xfdUr\x87|\xa1\xf9\xa4\xbc_\xdc \xdbjU\xfe\x06\xa5\x90P\x06\xa9\x89\x8e!g\xc6={\xef\x9af\x12þ%\xa0\"\x94*+\x1f\x83s\x87Q\x16\xc6\xfe\x81\xc4\xe0GP,\x15\xbe\xd3\xc4mC}ۨ\xfc\xcf\x164\xfc\xdb\xfd\xa5\xd5\vo\xd6\xfe\xc1\v\xc3G\x0e\x12\xe5\f\xdf<3|\xb1\xd1\xcbL!n/um\xb3\xe8\xd3i]e\x04\xf3\x807\x9f.'\xf5\xc2\xe5z}\xfaB\xf72\xc3\x12\xe1\x06u&U\x18\xd5)R\x91\xe91\x10\x1e\v\x8f\x1d6\xeaS\xc5~7~\xf5\xa9n\xaa\xd7\"\xaa\f*\xf6\x8c\xf9\\$?c֕-\xbf\xbe\x15\xe5Y,df\x15c\xec?l#\xfc\xbc\xcd;\xbcm\xb2\x9c\xfbÍ\xffg\x00\x8d\xa6ҥ\x7f\x02\x00\x00",
+		hash:  "ef467c34fd484c7bc964fd476bb28abb4d1c923826abc2c5d759baa391c7fec9",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  383,
+		mtime: time.Unix(1792125372, 0),
+		size:  639,
 	},
 	"index/localTop.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcX_\x8f\x1a7\x10\u007fϧp,U:\xa4:\v\xe9\xa9=\x91]Kw\x17\xe5Z\xa9\x89\xa2\x12U\xea\xa3Y\x0f`e\xd7\xdeڳ\x1c\b\xf1\xdd+{w\xe1\x8e\x00\xbbp!\x0f\xbd\x97\x03{\xe673\xbf\xf9g\xb1ZI\x98(\r\x84f&\x15\xd9\x17S\xd0\xf5\xfa\x15\xa9\xffb\a)*\xa3\x89\x92I%@\xf9\xe62\bH5'i&\x9cK\xa85\x8f;\xb7\xbb\x12\xa9\xc9\xca\\\xbb=R\x95\xb1\\d\x19\x8f]!*\x83\x0e\xec\x1c,s(\xb0t\x94Ǒ\xbf\xf1\xff\x82\xdc~\x8cـ8\\f\x90\xd0G%q6\x1c\xf4\xfb?\xbd\xa3\xfc\x1fSZ\xf2\xc9Hh\xac\xccW\xab7\u007f\x83u\xca\xe8\xf5\xba\x81\xac\xee\x1a\x80If\x04\x0e\xad\x9a\xce\xf0\x1d\xe5\x0f\n\xc9]\xa929$\xab՛\a\x85\xe1\xcb\x13\xddh6\xe0d\xbfS\xaf\x19\xab\xed\x12\xa3\xd3L\xa5_\x13\xaaa\x81ޡ\xab\x1e\xe5\xb1\xe0\x9f`\x81\xc1\xc18\x12\x9b\x10\u007fn\xbc\xbd/\xad\x05\x8d\xc3-7iu´\x91\xc0t\x99\x8f\xc1R\xdeߡ\x880\xb6'!\x91T\xf3\x9d,\xee9:)\xb1\x99\xb0S`\xbf\x90\x1c\xa4*svM\x82}6xKZR\xfe\x04#\a\xb4*= \x18\x8431\x86\x8cL\x8cM\xa8\x0f\xfbw\xf0\xa9\xa9s{\x97\x99\xf4+\xa9\x8e\x86q\x14D\x8f@)]\x94HpY@B\x11\x16H\x03\xa9OP\x89\x169<?\x91ʉq\x062\xa1hK\xa0d.\xb2\x12\x12\xca\x0e\xc5\xf6-\xa9/\x0e\xdb-u\xfaAY\x87\x94\x87b\x1e-uJF\xa1?\xc8\xd5\xc0!)\x84s\xbd\x0e\xf1{\aB\x8bm\x00\x1b\u007f\nk\xa6\x16\x9c\xa3\xc4\x1a\xdf\x05\xcd\xf7\xb1\xb0\x94\xa0\x18+-a\x91\xd0>%\xc2*\xc1\x02\t\xda<&\xf4\xed\xb3\xa3\\\xe9\x1d!OsB\a\xfd>)\xc0\xa6\xa0\xf1\x99\xb8X\x84\xbb#<T#\xc2\xd7\xff\x8e\xa7,\a\x04K\x9f\xf7=\xf1\x8d߂\x16\x10\x8b\xc0\x03\x82C\xa5\xa7t?6\v%\xc2=d\xa0\x1c$\xb9\xea\x133!\xfd^\x1c\x15-.W-y8\x15G\xca\xe4B\x154\x82\xd4h\xb9\xaf\x84\xdejyV\tՈ?\xa8\x86n\xae\u007fL\t\xdd\\w\xac\xa0\xffeѴ.\x80C\xd2\xd5\xec\xff\xb5e\xf4\x1f,а\xf4' SSj\xa4\xfc\x03H\xb0\x02A\xb6Vd\xcbp\xdf\x01\xae\a\xfc\xee\xe9\x89C\xbe\x03뗢H\x94\rE\xb7\xa5T\xf8}\xe8ـ\xd6\xf4\\\x8a\x90\xd3\v\xf8\xd0\xf17\xaf\x90\x9b\xe6\x15\xf2\xdb\xc5_!\x05\x80\xfdS\xf9m\xbc}\x98\xa1A\x91}\x06\xb0\xf7Ur\xeaV&\xf7F\xeb\xea1\xed:\fW\xf4\x9c\a\xbc\xad\x8d\xe3|\xe3\f\x84\xec\x90}\xb4\xedB5\xe0\xc6>S\x05\xe5\u007f|&\xb1ʧ$\fG?i7\xe3\xde\x19\xeb\x97'\xf3\xb73%\x81>Ud\xfe\xd6_Q\x12\xf18\xc2Yg\xf3\xbc\xdaJ\xa7\xe9\x9c$\xbd\xf5S\x96V\xf8\xdcP\xfe\xbe\xfetF\xb0\rȹ!\xbfḟ\xd0x\x104\xf7\xbd\xe0[\xa3q~5\xf2\x11h<#\n\xaf|~Ҷ8\x16RPs\x90\x94\xffU\u007f:Ù\x06\xe4\x05Ut[5]7\xa58j\xeb\x0f\x8f\xd3\xdai1\x8e\x8d\\\xb6\x02u\x10\u00891\xf8]\xdbZ\U0008f8c7\xd1\xd5\xfb\xdb/\xb7\xbd8B\xd9]\xef$\xe9M\x0e\xff-E\xa6pI\xf9\xa5\x8d\x95\x05\xe5}\xff\xc6\xfax\xd7;][\x9aG}\xa6~G_;\xd5\xd6\xf1t\xc7QX\f/]\x9c;GqT\xff\xcc\xc3_\xadV\xa0\xe5z\xfd_\x00\x00\x00\xff\xff\xa3\xd5h\x9a\x16\x12\x00\x00",
@@ -86,6 +100,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  2945,
 	},
+	"logs/logs.html": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xbcTAo\xdb<\f=ۿB\x1f\xef\xfe\x84\x16;*\x02\xba\xad\x03\nt]\x80\x0e\xe8ٶ\x18[\x9d,\x1a\x96\x9c,0\xf2\xdf\aK\x89\x13/\v\f\xec\xb0\x13\xc5\xc7G\x9a|\x968\f\n7\xda\"\x03C\x95[\xe7\x15\xc2\xe1\x90&\xc3\xe0\xb1iM\xee\x91A\x8d\xb9\xc2.\xc0\xe2\xbf,c\x1fI\xedY\x96\xc94\x11\x0eK\xaf\xc92\xadV!\x1fd\x9a$B\xe9-+M\xee\xdc\n:\xda\x05l\x06\x96d\xfa\xc6Fr\x92\x88\xfaN\xbe\x90B\xf6L\x95\x13\xbc\xbe;\xc2\x7f\xaa2\x87\x1bT\xbao\xb2\x0fl^0I\x84\xc9\v4\xf2\xb5/\xdc\xdeyl\x8e\xf0د\xc1\xd2O\xedf\xeeĘR\x93DP\x1bf\xda\xe6\xa6\xc7\x15\x80|0F\xf0\b\xded\x95d\x1dZ\xd7;\x90\x9fN\xc7Ť\xf6\xbe\x05\xb9\xbe_/\x12w.o5ȷׇ\xf5\xd3\"\x99|\x8d\x1d\xc8o\xa3\xb9\"\v\x1e%\x98\x94\xe2Q\xaa\xf4\xe8)\xbd\xfd\v\xa1\x9fq\x8b\xe6\xa6\xc8f\x8c\xde\x16Xa\xd1W ?\x8ffq8m7\x04\xf2\xc9nh\x91j\xc9\xeb\x12A\xbe\x04\xbb\xacq\xdeYm+`\xb1}T\xf2-\"\x8b\x99\xd8uԁ|\x1c\xcd?\x12\xfc\v\x19C\xbb\xe9\x1bڶ\xbdg~ߎ7\xb1\xc6\xf2GA?\xe1\xfc\x036\x81\r,\x84P-\xb6ry\xf4ya\xf0\\*\xb8\xd3[\xf4\xe3j\x98\xca\xf9\xee<\xb5\xaf\xe5wݠྞ\x81\xe1\xa6\\\xa1\xd3C\xbd\x8a|E\xe7\xf2jVG\xf0\xe9C\x82_v |Aj\x7f\x0e\x9d=\xc1C\xdf2\xbd\x1c\xeet\x10\xfc\xb8\xc5\xe4q\xbf=Zu\xb1\xe3.7\xa1+;\xddz\x17Wat\x98\xeb\xca\x15\xbc;>\xca\xf3\xff\xbb\x03)x\x8c\xfc\x96\xbb!\xf2q\x8b\x0e\x03Zu8\xa4\xbf\x06\x00\xbf3\xc7l{\x05\x00\x00",
+		hash:  "9346c4a1878c09224c0a0e7898c65b4971f2114d99016c9076d77d56f8d893f8",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792124669, 0),
+		size:  1403,
+	},
 	"searchresults/tools.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffD\xcbA\n\xc3 \x10\x05\xd0u<\x85\xcc\x01\xe2\x05Ի\x94\xc9\x0f\x9a\x8a)\xf3\xdd\x14\xf1\xee\x85v\xd1\xfd{s\x1e8k\x87\x97qߍ\xb2\x96\xdb\"\xd5\xeakx\x9a&\xb9\x18\x88\x87i\x01×\xec\x17%\xc7\xf03\xd9m\xb1\xd5\xfe\U00106584\xe3\xdd\xc0\x02\f\xf1\xc5p&Q\xfe\xfb\xae\xa4d7'\xfa\xb1\xd6'\x00\x00\xff\xff\x01\xea%yy\x00\x00\x00",
 		hash:  "a98871ca472c8a4b10c9916ad166c30071ff142d7e9e52290254dd7f836e036a",
@@ -122,11 +143,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  2285,
 	},
 	"searchresults/type/dblock.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xd4W\xcbn\xdb:\x10]\xcb_1W\xc8\xf2\xdaB\x90]@\v\xb8\xb1\x13$\xb8(P\xb4\xf9\x01Z\x1cGD(\xd2 鴂\xe0\u007f/\xf8\x88\xad\xf8!?\x9a\xb8\xa9V\x02\xe7px8g8\xe44\r\xc3)\x97\b)\x9b\bU<\xa7\x8bE/i\x1a\x8b\xd5LP\x8b\x90\x96H\x19j?L\xfe\xe9\xf7\xe1F\xb1\x1a\xfa\xfd\xbc\x97\x001XX\xae$p6L\xf1\xe7L(\x8d:\xcd{\x10?\xc2\xf8\v\x14\x82\x1a3L\xb5\xfaѲ\xac[\v%\xe6\x954i\x0eo \x1eV^\xe6c\xae\xb1\xb0J\xd7p\xe38\x92\xac\xbc\xcc7\x81\x96N\x04n\x8e\a\xdbD\xb1z\xbb-\xd8\xf5nc\x00\xb0\xfc\u007f\xac\xbf|\xbb&\x99e\xfb\xb1M3\xf0\xf0Ţ\x1bO\xb2\xae\x95\x0f\xa2\xe5\x059\x92۽\x17uঞ\x85\xe3\xdd\\\b\xb8\xa7\xa6<\x9c\xa2\x9b\xe2f\x9c\x81\xdd#\xaf\xf0\xbb\xa5\xd5\xec\xe8\x00\xde)]Q\x8bl\xe9\xe1\x1cz\xbb#\x00\xf7ȟJ{4\xe1\xf1M\x98x\x06\x9e_5\xbep57\xb0vz\x0f\xe4\xdc\tp\xdfҿ\xcf}\xb8v\xf3\xa8/ESZXU\xf5\rR]\x94}\xc1\xe5s\n\xb6\x9e\xe1\xf0\xb5Ƶ\x02\xe2\xbc,O*\xcd\xc9DCv\xc4\xda\xcb\xc4\xf6\xeb\xbfu\xbb\xca\xe0\xee͞(\x04\xc9v\x145\x92\xed\xa8\x84\xa4\xbc\n\xd9c`\xa4\xa4\xa5\\\"\x03.\x83,@LE\x85hW\a7<Rsi\x17\x8b\x801$\v \x92\x95W\xdbj\xb5_\xd8k\x10\x83\xef\a\xd2M*ɮ\x8a\x9c$[\x93+q\xe3,\u007f\xacg\xb8#\u007f\"\xe2?V\xbd\xeeh\x17n{H\xbb\x17v)\xa2\xbbWޛ|t\x95|\x9e\xa5'\xe9\xaf\t\x1d\x93\xef8\xc2'$\xc0\xc1\xfa\xbcۥ١\xd8:\xf4VZ]\xc3H#\xe3\xb6K\xc1w\xacQ]\xb2\xaec\xf7\xea\x8b\xc5J`\xbf\x97\xb0\x95\x03e\xfe\x90\x03\xff\xa9\xf5\xbes\x91\xe4\xeco\x94z\xbaR:\xee\xe2\x0f\xaa\xdc4\x9a\xca'\x84\v\xfe/\\\xa0@\xb8\x1e\xc2\xe06\x14\xec\xd6ݓ$\x9f\xa1>\x87C\xfe)\xeb3.EuQ\x1c\xb4_\x05G2\x85.\xaa\xfb\xde\xc2\x11\x16Y\xec{\x06\x9fHbTR.\xe1a\xfc\x9b!+\x9c\x1b\xd7!.\xa3\x16\xdf\x0e\xde\xff\xc3\xf8\xb4\xf0uf\x98\xb2T\x80\xcb\"\x8e\xe6\xa0\bFF\xa1\"\x87\xd7̇]\xb4I\xd24(\xd9\xdb\x17\x1f\xc9\x18\u007f\xc9{\xce}\xf8!Yl\x9c\xf3\xd8S\xdfJ\xd6\xea\xab\xdbݷ)4\x9fY\x93F\x8fm\x93UJ\x98\x8dv}\xaa\x94\r\xedzd\xf2+\x00\x00\xff\xffq\xd0\xe5\xf7\xe1\x0f\x00\x00",
-		hash:  "c0b6677fc67ef5062e5f8d7fedd45e6545285789d84f217496e9902761af4400",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xd4W\xcbn\xdb:\x10]\xcb_1W\xc8\xe2^\xe0\xdaB\x90\x9dA\xab\xa8\xed\x04\t\x8a\x00E\x9b\x1f\xa0\xc5qD\x84\"\r\x92N*\b\xfe\xf7\x82\xa4b+~ȏ<\x9a\xee\x04\xf2\fuf\xce̐SU\f\xa7\\\"\xc4l\"T\xf6\x10/\x16\x9d\xa8\xaa,\x163A-B\x9c#e\xa8\xfd2\xf9\xa7ۅ\xa1b%t\xbbi'\x02b0\xb3\\I\xe0l\x10㯙P\x1au\x9cv\x00\x00\x00\x00\b㏐\tj\xcc \xd6ꩱ\xb3\xbe\x9b)1/\xa4\x89Sx\x01\x01\x00 \xf9y:\xe6\x1a3\xabt\tCǑ$\xf9y\xba\t\xb4t\"ps=\xecM\x14+\xb7\xef\x85}\xbd{3\x00X\xfa\r\xcb\xdb\x1f}\x92X\xb6\x1f[U=\x0f_,\xda\xf1$\xb1\xfa\x95\xb4\xbc Gr\xbb\xf6\xa2\xf6\x9c\xe9\x87p\xbc\x9a\v\x01\xd7\xd4\xe4\x87St&\xce\xe2\x03\xd8\xdd\xf1\x02\x7fZZ̎\x0e\xa0\xb3\xfc\b\x89]\xd6\xc35\xf2\xfb\xdc\x1e\xcdq<\f\x86\x8b\x05\x10\n\xb9\xc6\xe9 NB\xb1\x17\\\xce-\x9a/\xb9\a\f\xb6\xd9\xc4鿷\x1e\x05C\x8d\xf4\x81\xa9'\xf9\x1fIh\xfa\xee>\x7f\xd7\xf8\xc8\xd5\xdc\xc0Z\xf1\x1f\xe8\x7f+\x00\x00`y\xbe/\x1d\xe8\x03\x00\xa1\xbe\x93MifU\xd15Hu\x96w\x05\x97\x0f1\xd8r\x86\x83\xe7\x16\xd9\b\xae;eY\xe84%\x13\r\xc9\x11\xff^\xd6\x05\xf4\x01\xe0屫\x02hw\xf6D!H\xb2\xa3'\x92dG#%\xf9E\xc8D\x03#%-\xe5\x12\x19p\x19d\x01b\n*D\xb3\xb9\xb8呚K\x97y\xc1\x8e$\x01D\x92\xfcb[\xab\xf7?\xf6\x1a\xd4\xc1\xf7\v\xf1&\x95hWC\x8f\xa2\xad\xc9\x15\xb9u\x96ޕ3ܑ?5\xe2++\x9e=څ\xdb\x1e\xd2\xf6\x1f\xbb\x14\xd1\xed\x7fޛ|t\x95|\x9e\xa5'\xe9o\x19]'\xdfq\x84OH\x80\x83\xf5y\xb3;\xb7E\xb1u襴\xba\x84\x91F\xc6m\x9b\x82oأ\xdad]\xc7\xee\xd5\x17\xb3\x95\xc0ޗ\xe0ʁ2\xbfK\xc1\x7fj\xbd\xaf\\$9\xfb\x1b\xa5\x9e\xae\x94\xae\xbd\xf8\x83*W\x95\xa6\xf2\x1e\xe1\x8c\xff\x0fg(\x10\xfa\x03\xe8]\x86\x86ݸ{\xa2\xe83\xf4\xe7P䟲?\xe3RT\x17\xc5^\xf3Up$Sh\xa3\xba\xef)]\xc3j\x16\xfb^\xd1'\x92\x18\xe5\x94K\xb8\x19\xbf2d\x99;\xc6\r\x98˨\xd5o\a\x7f\xfe\xcd\xf8\xb4\xf0\xb5f\x98\xb2T\x80\xcb\"\x8e\xe6\xa0\b\u058cBG\x0e\xaf\x99w\xbbh\xa3\xa8\xaaP\xb2\x97/>\x920\xfe\x98v\xa2\xe8\xf9\x83$\xf5ܝ\xd6#\xf9\xa5d\x8d\xb1\xbc9\xbc\x9bL\xf3\x995q}bs\xcb*%\xccƴ?Uʆi\xbff\xf2{\x003\x1bR& \x10\x00\x00",
+		hash:  "1c21f22228815d10a68665796122a5424be5e5762d02008716c48e09162a15c1",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  4065,
+		mtime: time.Unix(1792142247, 0),
+		size:  4128,
 	},
 	"searchresults/type/eblock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcV\xdbN\xe30\x10}N\xbfb\xd6\xe2q\xd3\b\xf1\xd6u#\x01eE\xb5BZ-_\xe0\xc6Sb\xe1\xda\xdd\xd8\x01\xaa\xa8\xff\xbe\xf2\xa5%-\xe9U\vyj\xed\xf1̙3\xe3\xe3i\x1a\x8eS\xa1\x10\bN\xa4.\x9e\xc9r\xd9K\x9a\xc6\xe2l.\x99E %2\x8e\x95_\xa6\xdf\xd2\x14n4_@\x9a\xe6\xbd\x04\xa8\xc1\xc2\n\xad@\xf0!\xc1\xb7\xb9\xd4\x15V$\xefA\xfc(\x17/PHf̐T\xfa\xb5\xb5\xb3\xbd[hYϔ!9l\x98x\xb3\xf22\xbfS\xb6Z\xc0\x8d\xc3G\xb3\xf22\xffhd\xd9D\xe2\xc7\xf5\xb07\xd1|ѽ\x17\xf6\xabݛ\xc1\x80\xe7\xbfp\xf1\xf0g@3\xcb\x0f\xdb6Mߛ/\x97\xfb\xedi\xb6/\xf2Q\xb0~\xd6R\xc2=3\xe5\xf1\xd0\xdc\x11w\xe2\v\xd0ݖL\xa8\xf1\xe8Hl\x94\xf9>\x9a\xb2\xc2\xeaYj\x90UE\x99J\xa1\x9e\t\xd8\xc5\x1c\x87\xa4p\xee\\;\x12\x97ǽ\xef\xcb~\x8c\xe1\xb2a\xf9\xa7g\xe4[\x10\xeeQ<\x95\xf6x\xca#\xd4\xd1M8\xf8\x05\xcc\xff\xae\xf0E\xe8\xda@\xeb\xe6\x1c\x89w\xaf\x81\xfb־}\x93\xfb\xa5\x81;{\xa8|Q_Z\x848O\xeb\x9b\xc2r:\xa9 ;!\xfe\xba\xf9}\xfcM\xb7\xef]\xbe?\xe13\vA\xb3\x1d\xa2B\xb3\x1dJD\xcb+/c\x02\r\xdcje\x99P\xc8A\xa8P\x1b\xa0fƤlq\xe3\vw\xabke\x97K\x88\ai\x16\xachV^u(e\xd3TL=!\\\x88\xefp\x81\x12a0\x84~<\xda\xc1Cӈ)\xe0_o\xda\xf74\x92\a\xa1j\x8b\xf0\xc0\xaa\xe7 \xf9\xd0-\xb4\xbeұ\xc4~\x81|\xa2\xf4n\x80:\xf6\xce\xf9\xa4Z\xda\xf0Uen\x1a\x94\x06[\xcc%\xc9\t\x9c%\xbb\bK\x92N\xaa\x12\xb7\xce\xe3\xf3\xb8\xe7\x19\x88v\x87\xaf\xa8sDV\xf4\xad^\x89]\xba\x9a$\xdd\xcc\x1d\x00\xfbf\xb1RL\xc2xd\xf6\xc3\xed%\xf1\xa3\xb5\\\xff\t$C\xec\xf4\xf1\xc85\xb9G{\xf7f\xc7#\x03nNy\xb7\xf4\xb5\x92\"\f(.\xb9\x14c\xf8T\xf8g\xe4\"\xb4\x87\x149lE@\xc5[\xceh\xe60t\x01=\x8f\x9a\x95[\xf7JjeQ\xd9\xff%\xcf\xd4̙j%\\\x04\xf7\xa9\xa9g3\xe6\xaa\x1b\xe3\xc1cX\x18\x00ey\x94\x9f\xc7R\xbfµ\x94\xefB\xb3R妱U\xad\n7\x11\x86\xab\x15\x9c8\xee\\\xbc\xf3a\xb9~'`\xecB\xe2\x90pa\xe6\x92-\x06J+\xfcA\xf2k)a\xc5\xce6ʈ\xbe\v\xe9\xe9\x00O,\xe2Y\xb2\xa0\xf8\x86*l\xaf\xf8\xd3\\\xbc\xb8F_\xfd\xa0Y\x1c\xb0\xf38{\xdf)ޚ\xbf\xdbS\xba)*1\xb7f\xa5\xd9\xed-\xab\xb54\x1f\xc6\xfa\xa9\xd66h|D\xf2/\x00\x00\xff\xff+Q\x86\xa5\t\f\x00\x00",
@@ -150,11 +171,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  1147,
 	},
 	"searchresults/type/entry.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xe4U\xc1n\xa3<\x10>ӧ\x98ߪ\xfe\x1bE\xbdR\a\xa9\rH\x8d\xb4\xb7>\x81\x8b'\xc5Zc#{\xd2\x06!\xde}eC\x14Z\x0eA{]\x0e\xd10\x13\xbe\xef\x9b\x19{f\x18$\x1e\x95A`h\xc8\xf5l\x1c\xef\x92a l;-\b\x815($\xba\xe8\xe6\xff\xa5)\xbcX\xd9C\x9a\x16w\t\xf7X\x93\xb2\x06\x94\xdc1<w\xda:t\xac\xb8K\x12.\xd5'\xd4Zx\xbfc\xce~E\xdf7gm\xf5\xa95~\n$\xbcy,\xaa@\x0e\xa5 \xc1\xb3\xe6q\xf6\x93x\xd78\xd9\t\xa7\xf7\xc0\x1c\xb8<\nW7i\x8c\xce\x10!\xee.f\xc2I\u0380\xaf\xc279\xcfH~\x8b\r\xc3C\b\x8c\xe32³+\xc2O\xb0}#\x94\x81C\xb9B\xe2\"*:\x8a\x9al\x9b\xce´2\xbf\x19P\xdf\xe1\x8e\xd5\xe1\xcbPC\x16X#Ρ\fĢ\xd8J^\x9d\t\x9d\x11\x1a\x0e\xa5_\xe7r\xb1\x13~\xd2\xd7\x17\x00\x80a\x00'\xcc\a\xc2\xfd\xa1\x84|\a\x0fՙ\x0e\xa5\x87\xd0\xca\xc5\xdf\xc2õ\x9a\xba\x18\x8a\x96\xe2L\x98\xaa\xa8\xfa~\x12\xacU\x01?\xf1\xd1\xc8%\x1c\xcf\x16\x1a6\xd7\xd6\x1aBC9\u007fw\x90\x15\xeb\xfc\xe0\xc6\xc3}'\xccB}=\xe1\xa5\xfeԶ\xc2\xf5\xecB\x00o\x93#\a.\n\xee[\xa1u\xf1\xd6\xd8/x֚g\xd3{h\xcbm\xc2(\x14Rx\xe9\t}\x0e\xa1\xaf\x13\xc5/4\x1fԌ\xe3v\x88\x8b\xb6xN\x17H\xd3\xf1\u070eS\xedao=AĨ\xf6\xc1\xde\xf2y\x16jW\x00\xc0_W9\\J\x06\x9ez\x8d;&\x95\xef\xb4\xe8sc\r>\xb1\xe2Y\xebK\x82\xf9\xff\xd8\xfa\xeei\xfd\xbbhƫ\x92\xf8/7\xa3q\xb7ӽ\x8a\xda\xde\xde\x1b\x17\x92gq\xb2\xce#7\xbb\xce\\\x9eI\xf5\x19\xc7\xf9l\xf0l\x9e\xf8ż\v*#\x17\xfb`\xb95|\xedTG~\xb5M\xc8Z\xbd\xf6\x1e\xad\xa5i\xc7\f\x03\x1a9\x8e\u007f\x02\x00\x00\xff\xff\x1a\x98\xf9\x91\x95\x06\x00\x00",
-		hash:  "a9b9fe61fc7a2385e955d54519ae2bb7a9da16621e812e7030e44ee0fc63100b",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4VQ\x8b\xe36\x10~\xf6\xfe\x8a\xa98\xfa昃\xd2\a\xafb\xb8\xdd\xe4\xd8@)\x85\x83\xbe+\xd6d\xad;Y2\x92\xb2Ip\xfdߋ$g#'\x9b&\x14\xfa\xd4<\x04Y\xa3\xf9\xe6\x9b\x19\xcdh\xfa\x9e\xe3F(\x04\x82ʙ\x03\x19\x86\x87\xac\xef\x1d\xb6\x9dd\x0e\x814\xc88\x9a\xb0M\x7f\xcasx\xd2\xfc\x00y^=d\xd4b\xed\x84V \xf8\x9cྒྷڠ!\xd5C\x96Q.ޠ\x96\xcc\xda91z\x17\xf6&\x9b\xb5\x96\xdbV\xd9(\xc8h\xf3\xb9Zz\xe3\xb0`\x8eѢ\xf9<\xee;\xb6\x96\x18\xd7\x19uko\xd9۲\xc8L\xdd\xe4A:Bx\xb99.3\xea\xf8\b\xf8\xc2lS\xd2\xc2\xf1\x89\xac\xefg^0\f\xa9\x84\x16\xce\\\x03{n\x98P\xb0Z\\ Q\x16\x18mX\xedt\x9b\x8fĤP?\b\xb8C\x87sR{M\x1fC\xe2\xad\x06\x9c\xd5\xc2\x1bfսƗ{\x87F1\t\xab\x85\xbd\xf4\xe5\xb8\xce\xe8V\x9e>\x00\x00\xfa\x1e\fS\xaf\b\x9fV\v(\xe70[\xee\xddja\xc1\xa729\xe6\x7fT\x8a\x98E\x1f\xb4\x1cG\x83\xb9\b\xac?E\xc2RTp\x8e\x8f\x8a\xa7p\xb4H8\xdc\x1d[\xad\x1c*Wҵ\x81\xa2\xba\xf4\x0fn\xfc\xa8\xed\x98J\xd8\xd7\x11/\xb7۶e\xe6@\x8e\x06\xe0[\xdc(\x81\xb2\x8aږIY}k\xf4\x0e\xbeHI\x8b\xf8\xed\xd3r\xdb` \n9<\x1d\x1c\xda\x12|^\xa3\x89\xdfP\xbd\xbaf\x18\xee\x87\x18\x15\xe3=M\x90\xe2\xf5\xbc\x1fg\xf9\f\xcf\xda:\b\x18\xcbg\xbf\xbeG\xbd\xf0\xb1\xab\x00\xe0_G\xd9\x17%\x01\xeb\x0e\x12\xe7\x84\v\xdbIv(\x95V\xf8H\xaa/R\x1e\x1d,\x7f\xc6\xd6v\x8f\x97\xffI2^\x04\xc7\xffs2\x1as\xdb\xdd\x13\xa9\xfb\xd3{\xab \xfb~'\\\x03\xb3\x05֚#\x1f\x86+\x85:\xca\xe1\xac`c\xb2\xfa~\xf6U\x9b\x969\xdf*\x8e\xf9\xbb֪\xfa^l`\xf6U\xa0\xe46m\x1f\xa1\xa3'\x97\x8cG\x8b\xf9&\x9c$'\x80\xf15H6\xfa~\xecu\xe1lhw\x17\xf8S\x97N\x8fAԙ\xfd\xceZ\x1c\x86i\x87\xbd8\xf4'\x93[\x9c>\x1c\xe7\xf1\flP\xf1\xd42-\xce\b\xd3\"}\xdc>P\xb9VpN\xbf\xbeJ\x9c\x06\x83\x01g\x8e\xe5o\x02ws\xd2\x19t\xee@\x8e\x89\x19\xb3\x96\x16\x15\xfcuM\xb7\xc1\xfd\xbb\xe2\v\xee\xefSZ3\x8b\xbf\xfe\xf2\xae\xf7\x14>'E|rzr!3\xda\x19\xfc\xc0\xc5у\xe3\xb00\x15z\x9b\xe1-\xfd#\x9c\xf2\xa9\xe8\f\xde\xc4\xf4\x9e\xfd\x03\xe0\x87\x1dl\xd7\b\x87\xb9\xedX\x8deg0\xdf\x19\xd6=\xee\xb4\xe1\xf9\xda \xfbQ\x86\xff\x9cI\xf9\x18\b\xbd\xe0\xfe^6c\xc8\xfecB1\x13眮\xb7\x81\xe4\x02N\xeekzWi\xc1\xc5[\xf5pZ\xd0b\x1c\x03\xabq@\\*\x9e\f\x89\xe9(ik#:g/FL\xa7\xb5\x8c\xbb4\x1e\x01k\xea9\xf9n\x8b8P\xa1-B\x84f\xdf-\xa9h\x11Ϝao\xb4vqL\x1d\xfd\xf8{\x00\x1aj~`\xd8\n\x00\x00",
+		hash:  "ad6fd90de6e03e9f95627c978a64da0b853b219b3b288bbc092e3b707bacdd1e",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  1685,
+		mtime: time.Unix(1792124669, 0),
+		size:  2776,
 	},
 	"searchresults/type/entryack.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcTAO\xf30\f=\xa7\xbf\"_\xee]\xb5\xeb'/\a`\x12w\xf8\x03^\x93\xa9\xd1ڤJ\xbcA\x15忣5\x05\x15\xb1\x8e\x1d\x80\x9c*?\xd7\xcf~\xf2s\x8cJ\xef\x8d\xd5\\hK~\xc0\xfa R*X\x8c\xa4\xbb\xbeE\xd2\\4\x1a\x95\xf6c\x18\xfe\x95%\xbfsj\xe0e)\v\x06A\xd7d\x9c\xe5Fm\x84~\xed[\xe7\xb5\x17\xb2`\f\x949\xf1\xba\xc5\x106»\x971\xf6)X\xbb\xf6\xd8ِ\x01\x06\xcdZn\xcf\xfc\xfc٣\r\x98\xab>\x11\xd21@լe\xc1/< ܵ\xfa2ƀvN\r\v \x03\xf2K\x10\x03R\xb9\x99G\f\xcd\u007f\xa8H]M\x05\x1c\xc7\xdfcM\xae+\x83F_7ek\xecAp\x1az\xbd\xc9\xc2\n\x19\xe3\xea\xa3jJP\xa1\xbcV\x1a\xaa\xa5\x0e\xe7\xf3\u007f\x9b2\xe5)y\xef\xba\xce\xd0$镡.\xfczK\xde\xf8b\\e\x9a\a$\\e\xaa\x94n\xa2\xb9\xa1\x9f\x9fV$\xef\xdbo\v2\xb2\xfc\xb1\x1e\xe7\xe5Y^~\xa8&ۜ\xf7\xb7R\xe64\xfau\xfa\x80j\xb2\xb4\x9c̾\xb5jf\xf8\xf9Y\b\xb57=\x05\xf1>\xd1\x1c#\xe7\xda\xf0\xe5\x90읣|Hb\xd4V\xa5\xf4\x16\x00\x00\xff\xff\xe2\x95\b\x0e}\x04\x00\x00",
@@ -191,6 +212,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  633,
 	},
+	"siblings/siblings.html": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x92\xcfn\xdb0\f\xc6\xcf\xf2Sp\xbe;B\xef\x8c\x0f\x1b\x86\r\xd80\x14\v\xf6\x00\x8aEG*\x14ѐ\x98\x18\x85\xe1w\x1f\xfc\xa7\x8dӠ7\xf1\xf7\xd1\x16\xf9\xe9\x1b\x06K\xad\x8f\x04e\xf6\xc7\xe0\xe3)?\x9b\x13\x95\xe3X\xa8a\x10:w\xc1\bA\xe9\xc8XJ3\xc6/U\x05_پBUՅ\xc2L\x8dx\x8e\xe0\xed\xfe\xfd\x1fe](\x85\xd6_\xa1\t&\xe7}\x99\xb8\x9f\xd9\x1dl8\\\xceqiV\n\xddS\xfd\x87-\xc1\x8f\xe4-j\xf7\xb4\xe2\xae>\x90\xc07\x8e\x928<\x9bH\xe1\xb0\xde\x02>Bk\x1a\xe1\xb3\xdd5\x1c[\x10\x06q\x04}6\x9d\x87K\n\x19\xb8\x05\x16G\t\"[\xcaSCv\xdcO]gp\x94h\x87\xba[\xef\x11s\ft\xb7E5\xa3u<\x852\x99\xb0\x16\n%\xbd\x1d'e\x9e\x1c\xb5\xb8;\xf8/\x85\a\xf6\x97\xc3c\xe3\xc1\\\xc9\xc2O\xf2''\x0f\xe2\xef\xd9\xfb\xcf\xd4_\x91\xfb\xf8\x99x\x10#\x97\xbcŨ\xdf\aG\xbd\xdd\b\xe5\xc8\xf6\xf5&\xdd*Գ\x0fs\x81\xda\xfak]\xdc\x0e\xa8\xd7\xf7\xaf\xd7d|\x8fv\x93\x8em\x86r\x93|'y\t\xd1R@N;|\xc9\xfa\xcd\xf2\xddK.kԋ\xfa\xe1\xfb\x96Y\x96\f\x0e\x03E;\x8e\xc5\xff\x01\x00\xa5,Iɽ\x02\x00\x00",
+		hash:  "d6c63138c6b99d4a4e519704ae15676b968156aef61cd6362b4b43988def21c6",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792124669, 0),
+		size:  701,
+	},
 }
 
 // NotFound is called when no asset is found.
@@ -0,0 +1,78 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/FactomProject/factomd/log"
+)
+
+// Serves the log viewer page, and the filtered log ring buffer as json when
+// called with format=json
+
+// Maximum amount of log records returned in a single request
+var LogViewerMaxRecords int = 500
+
+type LogViewerResponse struct {
+	Records []log.LogRecord
+	LastSeq uint64
+}
+
+func (cp *ControlPanel) logsHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in LogsHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	if r.FormValue("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(getLogRecords(r.FormValue("subsystem"), r.FormValue("level"), r.FormValue("since")))
+		return
+	}
+
+	err := cp.templates.ExecuteTemplate(w, "logsPage", cp.GitAndVer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// Returns the records in the log ring buffer matching the subsystem and level.
+// Only records newer than since are returned, so the page can poll for new lines.
+func getLogRecords(subsystem string, levelName string, since string) []byte {
+	switch subsystem {
+	case "", log.SubsystemConsensus, log.SubsystemP2P, log.SubsystemWsapi, log.SubsystemOther:
+	default:
+		subsystem = ""
+	}
+	level, ok := log.ParseLevel(levelName)
+	if !ok {
+		level = log.DebugLvl
+	}
+	sinceSeq, err := strconv.ParseUint(since, 10, 64)
+	if err != nil {
+		sinceSeq = 0
+	}
+
+	resp := new(LogViewerResponse)
+	resp.Records = log.Ring.Filter(subsystem, level, sinceSeq)
+	if len(resp.Records) > LogViewerMaxRecords {
+		resp.Records = resp.Records[len(resp.Records)-LogViewerMaxRecords:]
+	}
+	resp.LastSeq = sinceSeq
+	if len(resp.Records) > 0 {
+		resp.LastSeq = resp.Records[len(resp.Records)-1].Seq
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return []byte(`{"Records":[],"LastSeq":0}`)
+	}
+	return data
+}
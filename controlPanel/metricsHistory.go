@@ -0,0 +1,77 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/metricsHistory"
+	"github.com/FactomProject/factomd/state"
+)
+
+// The history page charts the metrics the node keeps with MetricsHistorySeconds set (see
+// state/metricsHistory.go) over the last hour, day, week, month or year.
+
+// How far back each range of the history page goes
+var HistoryRanges = map[string]time.Duration{
+	"hour":  time.Hour,
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+	"year":  365 * 24 * time.Hour,
+}
+
+type HistorySeries struct {
+	Name   string
+	Points []metricsHistory.Point
+}
+
+type HistoryResponse struct {
+	Range  string
+	Step   int64 // Seconds between points
+	Series []HistorySeries
+	Error  string `json:",omitempty"`
+}
+
+func (cp *ControlPanel) historyHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in HistoryHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	err := cp.templates.ExecuteTemplate(w, "historyPage", cp.GitAndVer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/history?range=<range>
+func (cp *ControlPanel) apiHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	writeApiResponse(w, cp.getHistory(r.FormValue("range")))
+}
+
+// An unknown range is a day
+func (cp *ControlPanel) getHistory(name string) *HistoryResponse {
+	back, ok := HistoryRanges[name]
+	if !ok {
+		name, back = "day", HistoryRanges["day"]
+	}
+	resp := new(HistoryResponse)
+	resp.Range = name
+	from := time.Now().Add(-back)
+	for _, series := range state.MetricsHistorySeries {
+		points, step, err := cp.view().State.GetMetricsHistory(series, from)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp
+		}
+		resp.Step = int64(step / time.Second)
+		resp.Series = append(resp.Series, HistorySeries{series, points})
+	}
+	return resp
+}
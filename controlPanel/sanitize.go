@@ -0,0 +1,129 @@
+package controlPanel
+
+import (
+	"encoding/hex"
+	htemp "html/template"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/btcutil/base58"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// All user input to the control panel is checked here before it reaches the
+// database or a template. The templates are text/template, so anything derived
+// from user input or chain data must be escaped before it is handed to them.
+
+// Search types that have a result page, and whether their input is a hex hash
+var SearchTypes = map[string]bool{
+	"entry":           true,
+	"chainhead":       true,
+	"eblock":          true,
+	"dblock":          true,
+	"ablock":          true,
+	"fblock":          true,
+	"ecblock":         true,
+	"entryack":        true,
+	"factoidack":      true,
+	"facttransaction": true,
+	"ectransaction":   true,
+	"EC":              false,
+	"FA":              false,
+}
+
+// Longest input accepted from the search bar. Addresses are 52 characters,
+// hashes 64.
+const MaxSearchInputLength = 64
+
+// SanitizeSearch trims the search input and checks it is valid for the search
+// type. Returns false if the search should be answered with "not found".
+func SanitizeSearch(content *SearchedStruct) bool {
+	content.Input = CleanSearchInput(content.Input)
+	isHash, ok := SearchTypes[content.Type]
+	if !ok || content.Input == "" {
+		return false
+	}
+	if isHash {
+		return IsHexHash(content.Input)
+	}
+	_, addrType, ok := ParseUserAddress(content.Input)
+	return ok && addrType == content.Type
+}
+
+// CleanSearchInput trims whitespace, and returns "" for input that cannot be a
+// hash, address or height
+func CleanSearchInput(input string) string {
+	input = strings.TrimSpace(input)
+	if len(input) > MaxSearchInputLength {
+		return ""
+	}
+	for _, r := range input {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			return ""
+		}
+	}
+	return input
+}
+
+func IsHexHash(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// ParseHexHash returns the hash for a 64 character hex string
+func ParseHexHash(s string) (interfaces.IHash, bool) {
+	if !IsHexHash(s) {
+		return nil, false
+	}
+	hash, err := primitives.HexToHash(s)
+	if err != nil {
+		return nil, false
+	}
+	return hash, true
+}
+
+// ParseHeight returns the block height for a decimal string
+func ParseHeight(s string) (uint32, bool) {
+	height, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(height), true
+}
+
+// ParseUserAddress decodes a human readable public address. The type is "EC"
+// or "FA".
+func ParseUserAddress(s string) (rcd [32]byte, addrType string, ok bool) {
+	switch {
+	case strings.HasPrefix(s, "EC") && primitives.ValidateECUserStr(s):
+		addrType = "EC"
+	case strings.HasPrefix(s, "FA") && primitives.ValidateFUserStr(s):
+		addrType = "FA"
+	default:
+		return rcd, "", false
+	}
+	data := base58.Decode(s)
+	if len(data) < 34 {
+		return rcd, "", false
+	}
+	copy(rcd[:], data[2:34])
+	return rcd, addrType, true
+}
+
+// EscapeHTML escapes a string for use as html text or a quoted attribute
+func EscapeHTML(s string) string {
+	return htemp.HTMLEscaper(s)
+}
+
+// SearchLink returns the html of a link the control panel scripts turn into a
+// search for the hash
+func SearchLink(searchType string, hash string) string {
+	if _, ok := SearchTypes[searchType]; !ok {
+		return EscapeHTML(hash)
+	}
+	return "<a href='' id='factom-search-link' type='" + searchType + "'>" + EscapeHTML(hash) + "</a>"
+}
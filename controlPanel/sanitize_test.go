@@ -0,0 +1,95 @@
+package controlPanel_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/controlPanel"
+)
+
+func TestSanitizeSearch(t *testing.T) {
+	rcd := make([]byte, 32)
+	for i := range rcd {
+		rcd[i] = byte(i)
+	}
+	fa := primitives.ConvertFctAddressToUserStr(factoid.NewAddress(rcd))
+	ec := primitives.ConvertECAddressToUserStr(factoid.NewAddress(rcd))
+	hash := strings.Repeat("ab", 32)
+
+	toTest := []struct {
+		Type  string
+		Input string
+		Valid bool
+	}{
+		{"entry", hash, true},
+		{"dblock", " " + hash + "\n", true},
+		{"entry", hash[:63], false},
+		{"entry", hash[:62] + "zz", false},
+		{"entry", hash + "00", false},
+		{"FA", fa, true},
+		{"EC", ec, true},
+		{"EC", fa, false},
+		{"FA", fa[:len(fa)-1] + "x", false},
+		{"notfound", hash, false},
+		{"../searchresults/tools", hash, false},
+		{"entry", "<script>alert(1)</script>", false},
+		{"entry", "'><img src=x>", false},
+		{"chainhead", "", false},
+	}
+
+	for _, v := range toTest {
+		content := new(SearchedStruct)
+		content.Type = v.Type
+		content.Input = v.Input
+		if SanitizeSearch(content) != v.Valid {
+			t.Errorf("Search %v %q: expected valid to be %v", v.Type, v.Input, v.Valid)
+		}
+		if v.Valid && content.Input != strings.TrimSpace(v.Input) {
+			t.Errorf("Input was not trimmed, found %q", content.Input)
+		}
+	}
+
+	addr, addrType, ok := ParseUserAddress(fa)
+	if !ok || addrType != "FA" || string(addr[:]) != string(factoid.NewAddress(rcd).Bytes()) {
+		t.Errorf("Failed to parse factoid address %v", fa)
+	}
+}
+
+func TestCleanSearchInput(t *testing.T) {
+	if CleanSearchInput(" 1234 ") != "1234" {
+		t.Error("Expected whitespace to be trimmed")
+	}
+	for _, bad := range []string{"12 34", "12;drop", "<b>", "a\"b", strings.Repeat("a", MaxSearchInputLength+1)} {
+		if CleanSearchInput(bad) != "" {
+			t.Errorf("Expected %q to be rejected", bad)
+		}
+	}
+
+	if h, ok := ParseHeight("1234"); !ok || h != 1234 {
+		t.Error("Failed to parse height")
+	}
+	for _, bad := range []string{"-1", "4294967296", "12a", ""} {
+		if _, ok := ParseHeight(bad); ok {
+			t.Errorf("Expected height %q to be rejected", bad)
+		}
+	}
+}
+
+func TestEscaping(t *testing.T) {
+	if EscapeHTML(`<a href='x'>"&"</a>`) != "&lt;a href=&#39;x&#39;&gt;&#34;&amp;&#34;&lt;/a&gt;" {
+		t.Errorf("Unexpected escaping %v", EscapeHTML(`<a href='x'>"&"</a>`))
+	}
+
+	link := SearchLink("chainhead", "<b>")
+	if strings.Contains(link, "<b>") {
+		t.Errorf("Link text was not escaped: %v", link)
+	}
+	if !strings.Contains(link, "type='chainhead'") {
+		t.Errorf("Link is missing its type: %v", link)
+	}
+	if strings.Contains(SearchLink("' onmouseover='x", "abc"), "onmouseover") {
+		t.Error("Expected an unknown search type to be dropped")
+	}
+}
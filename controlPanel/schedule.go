@@ -0,0 +1,47 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/schedule"
+)
+
+// The schedule page lists the housekeeping tasks set in the config file (see
+// state/schedule.go), with when each last ran, how long it took, whether it
+// failed, and when it runs next.
+
+type SchedulePage struct {
+	Now   time.Time
+	Tasks []schedule.Status
+}
+
+func (cp *ControlPanel) scheduleHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in ScheduleHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	err := cp.templates.ExecuteTemplate(w, "schedulePage", cp.getSchedule())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/schedule
+func (cp *ControlPanel) apiScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	writeApiResponse(w, cp.getSchedule())
+}
+
+func (cp *ControlPanel) getSchedule() *SchedulePage {
+	page := new(SchedulePage)
+	page.Now = time.Now()
+	page.Tasks = cp.view().State.GetScheduledTasks()
+	return page
+}
@@ -2,11 +2,6 @@ package controlPanel
 
 import (
 	"fmt"
-	"strconv"
-
-	"github.com/FactomProject/btcutil/base58"
-	"github.com/FactomProject/factomd/common/primitives"
-	"github.com/FactomProject/factomd/state"
 	//"github.com/FactomProject/factomd/wsapi"
 )
 
@@ -29,18 +24,19 @@ func newSearchResponse(ftype string, found foundItemInterface) string {
 	return searchJson
 }
 
-func searchDB(searchitem string, st state.State) (bool, string) {
+func (cp *ControlPanel) searchDB(searchitem string) (bool, string) {
+	v := cp.view()
+	searchitem = CleanSearchInput(searchitem)
 	if len(searchitem) < 32 {
-		heightInt, err := strconv.Atoi(searchitem)
-		if err != nil {
+		height, ok := ParseHeight(searchitem)
+		if !ok {
 			return false, ""
 		}
-		height := uint32(heightInt)
-		if height < DisplayState.CurrentNodeHeight {
-			dbase := StatePointer.GetAndLockDB()
+		if height < v.Display.CurrentNodeHeight {
+			dbase := v.State.GetAndLockDB()
 			dBlock, err := dbase.FetchDBlockByHeight(height)
-			StatePointer.UnlockDB()
-			if err != nil {
+			v.State.UnlockDB()
+			if err != nil || dBlock == nil {
 				return false, ""
 			}
 			resp := `{"Type":"dblockHeight","item":"` + dBlock.GetKeyMR().String() + `"}`
@@ -48,46 +44,26 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		}
 		return false, ""
 	}
-	switch searchitem[:2] {
-	case "EC":
-		if !primitives.ValidateECUserStr(searchitem) {
-			break
-		}
-		hash := base58.Decode(searchitem)
-		if len(hash) < 34 {
-			break
-		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%d", st.FactoidState.GetECBalance(fixed))
-		return true, `{"Type":"EC","item":` + bal + "}"
-	case "FA":
-		if !primitives.ValidateFUserStr(searchitem) {
-			break
+	if fixed, addrType, ok := ParseUserAddress(searchitem); ok {
+		switch addrType {
+		case "EC":
+			bal := fmt.Sprintf("%d", v.State.FactoidState.GetECBalance(fixed))
+			return true, `{"Type":"EC","item":` + bal + "}"
+		case "FA":
+			bal := fmt.Sprintf("%.8f", float64(v.State.FactoidState.GetFactoidBalance(fixed))/1e8)
+			return true, `{"Type":"FA","item":` + bal + "}"
 		}
-		hash := base58.Decode(searchitem)
-		if len(hash) < 34 {
-			break
-		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%.8f", float64(st.FactoidState.GetFactoidBalance(fixed))/1e8)
-		return true, `{"Type":"FA","item":` + bal + "}"
 	}
-	if len(searchitem) == 64 {
-		hash, err := primitives.HexToHash(searchitem)
-		if err != nil {
-			return false, ""
-		}
+	if hash, ok := ParseHexHash(searchitem); ok {
 
 		// Must unlock manually when returining. Function continues to wsapi, who needs the dbase
-		dbase := st.GetAndLockDB()
+		dbase := v.State.GetAndLockDB()
 
 		// Search for Entry
 		if entry, err := dbase.FetchEntry(hash); err == nil && entry != nil {
 			resp := newSearchResponse("entry", entry)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -95,7 +71,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if mr, err := dbase.FetchHeadIndexByChainID(hash); err == nil && mr != nil {
 			resp := newSearchResponse("chainhead", mr)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -103,7 +79,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if eBlock, err := dbase.FetchEBlock(hash); err == nil && eBlock != nil {
 			resp := newSearchResponse("eblock", eBlock)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -111,7 +87,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if dBlock, err := dbase.FetchDBlock(hash); err == nil && dBlock != nil {
 			resp := newSearchResponse("dblock", dBlock)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -119,7 +95,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if aBlock, err := dbase.FetchABlock(hash); err == nil && aBlock != nil {
 			resp := newSearchResponse("ablock", aBlock)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -127,7 +103,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if fBlock, err := dbase.FetchFBlock(hash); err == nil && fBlock != nil {
 			resp := newSearchResponse("fblock", fBlock)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -135,7 +111,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if ecBlock, err := dbase.FetchECBlock(hash); err == nil && ecBlock != nil {
 			resp := newSearchResponse("ecblock", ecBlock)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -144,7 +120,7 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if trans, err := dbase.FetchFactoidTransaction(hash); err == nil && trans != nil {
 			resp := newSearchResponse("facttransaction", trans)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
@@ -153,12 +129,12 @@ func searchDB(searchitem string, st state.State) (bool, string) {
 		if trans, err := dbase.FetchECTransaction(hash); err == nil && trans != nil {
 			resp := newSearchResponse("ectransaction", trans)
 			if len(resp) > 1 {
-				st.UnlockDB()
+				v.State.UnlockDB()
 				return true, resp
 			}
 		}
 
-		st.UnlockDB()
+		v.State.UnlockDB()
 
 		// This search takes too long to make it worth it
 		// Search for Entry Transaction
@@ -3,7 +3,6 @@ package controlPanel
 import (
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	htemp "html/template"
 	"net/http"
@@ -12,10 +11,10 @@ import (
 
 	"github.com/FactomProject/btcutil/base58"
 	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/blockView"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
-	"github.com/FactomProject/factomd/controlPanel/files"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/wsapi"
 
@@ -24,73 +23,75 @@ import (
 
 var _ = htemp.HTMLEscaper("sdf")
 
-func handleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
-	// Functions able to be used within the html
-	funcMap := template.FuncMap{
-		"truncate": func(s string) string {
-			bytes := []byte(s)
-			hash := sha256.Sum256(bytes)
-			str := fmt.Sprintf(" - Bytes: %d <br /> - Hash: %x", len(bytes), hash)
-			return str
-		},
-		"AddressFACorrect": func(s string) string {
-			hash, err := primitives.HexToHash(s)
-			if err != nil {
-				return "There has been an error converting the address"
-			}
-			prefix := []byte{0x5f, 0xb1}
-			addr := hash.Bytes()
-			addr = append(prefix, addr[:]...)
-			oneSha := sha256.Sum256(addr)
-			twoSha := sha256.Sum256(oneSha[:])
-			addr = append(addr, twoSha[:4]...)
-			str := base58.Encode(addr)
-			return str
-		},
-		"AddressECCorrect": func(s string) string {
-			hash, err := primitives.HexToHash(s)
-			if err != nil {
-				return "There has been an error converting the address"
-			}
-			prefix := []byte{0x59, 0x2a}
-			addr := hash.Bytes()
-			addr = append(prefix, addr[:]...)
-			oneSha := sha256.Sum256(addr)
-			twoSha := sha256.Sum256(oneSha[:])
-			addr = append(addr, twoSha[:4]...)
-			str := base58.Encode(addr)
-			return str
-		},
-		"TransactionAmountCorrect": func(u uint64) string {
-			s := fmt.Sprintf("%d", u)
-			f, err := strconv.ParseFloat(s, 64)
-			if err != nil {
-				return s
-			}
-			f = f / 1e8
-			return fmt.Sprintf("%.8f", f)
-		},
-	}
-	TemplateMutex.Lock()
-	templates.Funcs(funcMap)
-	files.CustomParseGlob(templates, "templates/searchresults/*.html")
-	files.CustomParseFile(templates, "templates/searchresults/type/"+content.Type+".html")
-	TemplateMutex.Unlock()
-
-	var err error
-	_ = err
+// Functions able to be used within the html
+var searchFuncs = template.FuncMap{
+	"truncate": func(s string) string {
+		bytes := []byte(s)
+		hash := sha256.Sum256(bytes)
+		str := fmt.Sprintf(" - Bytes: %d <br /> - Hash: %x", len(bytes), hash)
+		return str
+	},
+	"AddressFACorrect": func(s string) string {
+		hash, err := primitives.HexToHash(s)
+		if err != nil {
+			return "There has been an error converting the address"
+		}
+		prefix := []byte{0x5f, 0xb1}
+		addr := hash.Bytes()
+		addr = append(prefix, addr[:]...)
+		oneSha := sha256.Sum256(addr)
+		twoSha := sha256.Sum256(oneSha[:])
+		addr = append(addr, twoSha[:4]...)
+		str := base58.Encode(addr)
+		return str
+	},
+	"AddressECCorrect": func(s string) string {
+		hash, err := primitives.HexToHash(s)
+		if err != nil {
+			return "There has been an error converting the address"
+		}
+		prefix := []byte{0x59, 0x2a}
+		addr := hash.Bytes()
+		addr = append(prefix, addr[:]...)
+		oneSha := sha256.Sum256(addr)
+		twoSha := sha256.Sum256(oneSha[:])
+		addr = append(addr, twoSha[:4]...)
+		str := base58.Encode(addr)
+		return str
+	},
+	"TransactionAmountCorrect": func(u uint64) string {
+		s := fmt.Sprintf("%d", u)
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return s
+		}
+		f = f / 1e8
+		return fmt.Sprintf("%.8f", f)
+	},
+}
+
+func (cp *ControlPanel) handleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
+	searched := content.Input
+	if SanitizeSearch(content) {
+		data := cp.getSearchResultData(content)
+		if data != nil {
+			cp.templates.ExecuteTemplate(w, content.Type, data)
+			return
+		}
+	}
+	cp.templates.ExecuteTemplate(w, "notfound", EscapeHTML(searched))
+}
+
+// Returns the item the search result page displays for the given type, or nil
+// if it could not be found. The same data is served by the json api.
+func (cp *ControlPanel) getSearchResultData(content *SearchedStruct) interface{} {
 	switch content.Type {
 	case "entry":
-		entry := getEntry(content.Input)
-		if entry == nil {
-			break
+		if entry := cp.getEntry(content.Input); entry != nil {
+			return entry
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, entry)
-		TemplateMutex.Unlock()
-		return
 	case "chainhead":
-		arr := getAllChainEntries(content.Input)
+		arr := cp.getAllChainEntries(content.Input)
 		if arr == nil {
 			break
 		}
@@ -98,140 +99,77 @@ func handleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 			Head   interface{}
 			Length int
 		}{arr[0].Content, len(arr) - 1}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, arr)
-		TemplateMutex.Unlock()
-		return
+		return arr
 	case "eblock":
-		eblk := getEblock(content.Input)
-		if eblk == nil {
-			break
+		if eblk := cp.getEblock(content.Input); eblk != nil {
+			return eblk
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, eblk)
-		TemplateMutex.Unlock()
-		return
 	case "dblock":
-		dblk := getDblock(content.Input)
-		if dblk == nil {
-			break
+		if dblk := cp.getDblock(content.Input); dblk != nil {
+			return dblk
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, dblk)
-		TemplateMutex.Unlock()
-		return
 	case "ablock":
-		ablk := getAblock(content.Input)
-		if ablk == nil {
-			break
+		if ablk := cp.getAblock(content.Input); ablk != nil {
+			return ablk
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, ablk)
-		TemplateMutex.Unlock()
-		return
 	case "fblock":
-		fblk := getFblock(content.Input)
-		if fblk == nil {
-			break
+		if fblk := cp.getFblock(content.Input); fblk != nil {
+			return fblk
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, fblk)
-		TemplateMutex.Unlock()
-		return
 	case "ecblock":
-		ecblock := getECblock(content.Input)
-		if ecblock == nil {
-			break
+		if ecblock := cp.getECblock(content.Input); ecblock != nil {
+			return ecblock
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, ecblock)
-		TemplateMutex.Unlock()
-		return
 	case "entryack":
-		entryAck := getEntryAck(content.Input)
-		if entryAck == nil {
-			break
+		if entryAck := cp.getEntryAck(content.Input); entryAck != nil {
+			return entryAck
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, entryAck)
-		TemplateMutex.Unlock()
-		return
 	case "factoidack":
-		factoidAck := getFactoidAck(content.Input)
-		if factoidAck == nil {
-			break
+		if factoidAck := cp.getFactoidAck(content.Input); factoidAck != nil {
+			return factoidAck
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, factoidAck)
-		TemplateMutex.Unlock()
-		return
 	case "facttransaction":
-		transaction := getFactTransaction(content.Input)
-		if transaction == nil {
-			break
+		if transaction := cp.getFactTransaction(content.Input); transaction != nil {
+			return transaction
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, transaction)
-		TemplateMutex.Unlock()
-		return
 	case "ectransaction":
-		transaction := getEcTransaction(content.Input)
-		if transaction == nil {
-			break
+		if transaction := cp.getEcTransaction(content.Input); transaction != nil {
+			return transaction
 		}
-		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, transaction)
-		TemplateMutex.Unlock()
-		return
 	case "EC":
-		hash := base58.Decode(content.Input)
-		if len(hash) < 34 {
+		fixed, addrType, ok := ParseUserAddress(content.Input)
+		if !ok || addrType != "EC" {
 			break
 		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%d", StatePointer.FactoidState.GetECBalance(fixed))
-		TemplateMutex.Lock()
-		templates.ExecuteTemplate(w, content.Type,
-			struct {
-				Balance string
-				Address string
-			}{bal, content.Input})
-		TemplateMutex.Unlock()
-		return
+		bal := fmt.Sprintf("%d", cp.view().State.FactoidState.GetECBalance(fixed))
+		return struct {
+			Balance string
+			Address string
+		}{bal, content.Input}
 	case "FA":
-		hash := base58.Decode(content.Input)
-		if len(hash) < 34 {
+		fixed, addrType, ok := ParseUserAddress(content.Input)
+		if !ok || addrType != "FA" {
 			break
 		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%.8f", float64(StatePointer.FactoidState.GetFactoidBalance(fixed))/1e8)
-		TemplateMutex.Lock()
-		templates.ExecuteTemplate(w, content.Type,
-			struct {
-				Balance string
-				Address string
-			}{bal, content.Input})
-		TemplateMutex.Unlock()
-		return
-	}
-
-	TemplateMutex.Lock()
-	files.CustomParseFile(templates, "templates/searchresults/type/notfound.html")
-	templates.ExecuteTemplate(w, "notfound", content.Input)
-	TemplateMutex.Unlock()
+		bal := fmt.Sprintf("%.8f", float64(cp.view().State.FactoidState.GetFactoidBalance(fixed))/1e8)
+		return struct {
+			Balance string
+			Address string
+		}{bal, content.Input}
+	}
+	return nil
 }
 
-func getEcTransaction(hash string) interfaces.IECBlockEntry {
+func (cp *ControlPanel) getEcTransaction(hash string) interfaces.IECBlockEntry {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	trans, err := dbase.FetchECTransaction(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if trans == nil || err != nil {
 		return nil
@@ -242,15 +180,16 @@ func getEcTransaction(hash string) interfaces.IECBlockEntry {
 	return trans
 }
 
-func getFactTransaction(hash string) interfaces.ITransaction {
+func (cp *ControlPanel) getFactTransaction(hash string) interfaces.ITransaction {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	trans, err := dbase.FetchFactoidTransaction(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if trans == nil || err != nil {
 		return nil
@@ -258,7 +197,7 @@ func getFactTransaction(hash string) interfaces.ITransaction {
 	if trans.GetInputs() == nil {
 		return nil
 	}
-	status := getFactoidAck(hash)
+	status := cp.getFactoidAck(hash)
 	if status == nil {
 		return struct {
 			interfaces.ITransaction
@@ -282,20 +221,20 @@ type FactoidAck struct {
 	} `json:"result"`
 }
 
-func getFactoidAck(hash string) *wsapi.FactoidTxStatus {
+func (cp *ControlPanel) getFactoidAck(hash string) *wsapi.FactoidTxStatus {
 	ackReq := new(wsapi.AckRequest)
 	ackReq.TxID = hash
-	answers, err := wsapi.HandleV2FactoidACK(StatePointer, ackReq)
+	answers, err := wsapi.HandleV2FactoidACK(cp.view().State, ackReq)
 	if answers == nil || err != nil {
 		return nil
 	}
 	return answers.(*wsapi.FactoidTxStatus)
 }
 
-func getEntryAck(hash string) *wsapi.EntryStatus {
+func (cp *ControlPanel) getEntryAck(hash string) *wsapi.EntryStatus {
 	ackReq := new(wsapi.AckRequest)
 	ackReq.TxID = hash
-	answers, err := wsapi.HandleV2EntryACK(StatePointer, ackReq)
+	answers, err := wsapi.HandleV2EntryACK(cp.view().State, ackReq)
 	if answers == nil || err != nil {
 		return nil
 	}
@@ -307,15 +246,16 @@ type ECBlockHolder struct {
 	Length  int
 }
 
-func getECblock(hash string) *ECBlockHolder {
+func (cp *ControlPanel) getECblock(hash string) *ECBlockHolder {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	ecblk, err := dbase.FetchECBlock(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if ecblk == nil || err != nil {
 		return nil
@@ -343,15 +283,16 @@ type FBlockHolder struct {
 	Length int
 }
 
-func getFblock(hash string) *FBlockHolder {
+func (cp *ControlPanel) getFblock(hash string) *FBlockHolder {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	fblk, err := dbase.FetchFBlock(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if fblk == nil || err != nil {
 		return nil
@@ -371,19 +312,7 @@ func getFblock(hash string) *FBlockHolder {
 }
 
 type AblockHolder struct {
-	Header struct {
-		PrevBackRefHash     string `json:"PrevBackRefHash"`
-		DBHeight            int    `json:"DBHeight"`
-		HeaderExpansionSize int    `json:"HeaderExpansionSize"`
-		HeaderExpansionArea string `json:"HeaderExpansionArea"`
-		MessageCount        int    `json:"MessageCount"`
-		BodySize            int    `json:"BodySize"`
-		AdminChainID        string `json:"AdminChainID"`
-		ChainID             string `json:"ChainID"`
-	} `json:"Header"`
-	JsonABEntries     []interface{} `json:"ABEntries"`
-	BackReferenceHash string        `json:"BackReferenceHash"`
-	LookupHash        string        `json:"LookupHash"`
+	blockView.ABlock
 
 	ABEntries []interfaces.IABEntry
 	ABDisplay []ABDisplayHolder
@@ -392,122 +321,35 @@ type AblockHolder struct {
 type ABDisplayHolder struct {
 	Type      string
 	OtherInfo string
+
+	// Used to group entries on the admin timeline
+	Category        string // minute, signature, authority, key or fault
+	IdentityChainID string
 }
 
-func getAblock(hash string) *AblockHolder {
+func (cp *ControlPanel) getAblock(hash string) *AblockHolder {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
 
-	holder := new(AblockHolder)
-
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	ablk, err := dbase.FetchABlock(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if ablk == nil || err != nil {
-		StatePointer.UnlockDB()
-		return nil
-	}
-	bytes, err := ablk.JSONByte()
-	if err != nil {
-		return nil
-	}
-	err = json.Unmarshal(bytes, holder)
-	if err != nil {
 		return nil
 	}
 
+	holder := new(AblockHolder)
+	holder.ABlock = *blockView.NewABlock(ablk)
 	holder.ABEntries = ablk.GetABEntries()
 
 	for _, entry := range holder.ABEntries {
-		disp := new(ABDisplayHolder)
-		data, err := entry.MarshalBinary()
+		disp, err := describeABEntry(entry)
 		if err != nil {
-			return nil
-		}
-		switch entry.Type() {
-		case constants.TYPE_MINUTE_NUM:
-			r := new(adminBlock.EndOfMinuteEntry)
-			err := r.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Minute Number"
-			disp.OtherInfo = fmt.Sprintf("%x", r.MinuteNumber)
-		case constants.TYPE_DB_SIGNATURE:
-			r := new(adminBlock.DBSignatureEntry)
-			err := r.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "DB Signature"
-			disp.OtherInfo = "Server: " + r.IdentityAdminChainID.String()
-		case constants.TYPE_REVEAL_MATRYOSHKA:
-			r := new(adminBlock.RevealMatryoshkaHash)
-			err := r.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Reveal Matryoshka Hash"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + r.IdentityChainID.String() + "</a><br />MHash: " + r.MHash.String()
-		case constants.TYPE_ADD_MATRYOSHKA:
-			m := new(adminBlock.AddReplaceMatryoshkaHash)
-			err := m.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Matryoshka Hash"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + m.IdentityChainID.String() + "</a><br />MHash: " + m.MHash.String()
-		case constants.TYPE_ADD_SERVER_COUNT:
-			s := new(adminBlock.IncreaseServerCount)
-			err := s.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Server Count"
-			disp.OtherInfo = fmt.Sprintf("%x", s.Amount)
-		case constants.TYPE_ADD_FED_SERVER:
-			f := new(adminBlock.AddFederatedServer)
-			err := f.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Federated Server"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + f.IdentityChainID.String() + "</a>"
-		case constants.TYPE_ADD_AUDIT_SERVER:
-			a := new(adminBlock.AddAuditServer)
-			err := a.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Audit Server"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + a.IdentityChainID.String() + "</a>"
-		case constants.TYPE_REMOVE_FED_SERVER:
-			f := new(adminBlock.RemoveFederatedServer)
-			err := f.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Remove Server"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + f.IdentityChainID.String() + "</a>"
-		case constants.TYPE_ADD_FED_SERVER_KEY:
-			f := new(adminBlock.AddFederatedServerSigningKey)
-			err := f.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Server Key"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + f.IdentityChainID.String() + "</a><br />Key: " + f.PublicKey.String()
-		case constants.TYPE_ADD_BTC_ANCHOR_KEY:
-			b := new(adminBlock.AddFederatedServerBitcoinAnchorKey)
-			err := b.UnmarshalBinary(data)
-			if err != nil {
-				continue
-			}
-			disp.Type = "Add Bitcoin Server Key"
-			disp.OtherInfo = "Identity ChainID: <a href='' id='factom-search-link' type='chainhead'>" + b.IdentityChainID.String() + "</a>"
+			continue
 		}
 		holder.ABDisplay = append(holder.ABDisplay, *disp)
 	}
@@ -515,106 +357,182 @@ func getAblock(hash string) *AblockHolder {
 	return holder
 }
 
+// describeABEntry decodes an admin block entry for display
+func describeABEntry(entry interfaces.IABEntry) (*ABDisplayHolder, error) {
+	disp := new(ABDisplayHolder)
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	switch entry.Type() {
+	case constants.TYPE_MINUTE_NUM:
+		r := new(adminBlock.EndOfMinuteEntry)
+		err := r.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Minute Number"
+		disp.OtherInfo = fmt.Sprintf("%x", r.MinuteNumber)
+		disp.Category = "minute"
+	case constants.TYPE_DB_SIGNATURE:
+		r := new(adminBlock.DBSignatureEntry)
+		err := r.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "DB Signature"
+		disp.OtherInfo = "Server: " + r.IdentityAdminChainID.String()
+		disp.Category = "signature"
+		disp.IdentityChainID = r.IdentityAdminChainID.String()
+	case constants.TYPE_REVEAL_MATRYOSHKA:
+		r := new(adminBlock.RevealMatryoshkaHash)
+		err := r.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Reveal Matryoshka Hash"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", r.IdentityChainID.String()) + "<br />MHash: " + r.MHash.String()
+		disp.Category = "key"
+		disp.IdentityChainID = r.IdentityChainID.String()
+	case constants.TYPE_ADD_MATRYOSHKA:
+		m := new(adminBlock.AddReplaceMatryoshkaHash)
+		err := m.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Matryoshka Hash"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", m.IdentityChainID.String()) + "<br />MHash: " + m.MHash.String()
+		disp.Category = "key"
+		disp.IdentityChainID = m.IdentityChainID.String()
+	case constants.TYPE_ADD_SERVER_COUNT:
+		s := new(adminBlock.IncreaseServerCount)
+		err := s.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Server Count"
+		disp.OtherInfo = fmt.Sprintf("%x", s.Amount)
+		disp.Category = "authority"
+	case constants.TYPE_ADD_FED_SERVER:
+		f := new(adminBlock.AddFederatedServer)
+		err := f.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Federated Server"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", f.IdentityChainID.String())
+		disp.Category = "authority"
+		disp.IdentityChainID = f.IdentityChainID.String()
+	case constants.TYPE_ADD_AUDIT_SERVER:
+		a := new(adminBlock.AddAuditServer)
+		err := a.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Audit Server"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", a.IdentityChainID.String())
+		disp.Category = "authority"
+		disp.IdentityChainID = a.IdentityChainID.String()
+	case constants.TYPE_REMOVE_FED_SERVER:
+		f := new(adminBlock.RemoveFederatedServer)
+		err := f.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Remove Server"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", f.IdentityChainID.String())
+		disp.Category = "authority"
+		disp.IdentityChainID = f.IdentityChainID.String()
+	case constants.TYPE_ADD_FED_SERVER_KEY:
+		f := new(adminBlock.AddFederatedServerSigningKey)
+		err := f.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Server Key"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", f.IdentityChainID.String()) + "<br />Key: " + f.PublicKey.String()
+		disp.Category = "key"
+		disp.IdentityChainID = f.IdentityChainID.String()
+	case constants.TYPE_ADD_BTC_ANCHOR_KEY:
+		b := new(adminBlock.AddFederatedServerBitcoinAnchorKey)
+		err := b.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Add Bitcoin Server Key"
+		disp.OtherInfo = "Identity ChainID: " + SearchLink("chainhead", b.IdentityChainID.String())
+		disp.Category = "key"
+		disp.IdentityChainID = b.IdentityChainID.String()
+	case constants.TYPE_SERVER_FAULT:
+		sf := new(adminBlock.ServerFault)
+		err := sf.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		disp.Type = "Server Fault"
+		disp.OtherInfo = "Faulted Server: " + SearchLink("chainhead", sf.ServerID.String()) +
+			"<br />Replaced By Audit Server: " + SearchLink("chainhead", sf.AuditServerID.String()) +
+			fmt.Sprintf("<br />VM Index: %d, Height: %d, Signatures: %d", sf.VMIndex, sf.DBHeight, sf.SignatureList.Length)
+		disp.Category = "fault"
+		disp.IdentityChainID = sf.ServerID.String()
+	default:
+		disp.Type = fmt.Sprintf("Unknown Entry Type %d", entry.Type())
+	}
+	return disp, nil
+}
+
 type EblockHolder struct {
-	Header struct {
-		ChainID      string `json:"ChainID"`
-		BodyMR       string `json:"BodyMR"`
-		PrevKeyMR    string `json:"PrevKeyMR"`
-		PrevFullHash string `json:"PrevFullHash"`
-		EBSequence   int    `json:"EBSequence"`
-		DBHeight     int    `json:"DBHeight"`
-		EntryCount   int    `json:"EntryCount"`
-	} `json:"Header"`
-	Body struct {
-		EBEntries []string `json:"EBEntries"`
-	} `json:"Body"`
-
-	KeyMR    string
-	BodyMR   string
-	FullHash string
-	Entries  []EntryHolder
+	blockView.EBlock
+
+	BodyMR  string
+	Entries []EntryHolder // Shadows the view's hashes with the entries themselves
 }
 
-func getEblock(hash string) *EblockHolder {
+func (cp *ControlPanel) getEblock(hash string) *EblockHolder {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
-	holder := new(EblockHolder)
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	eblk, err := dbase.FetchEBlock(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if eblk == nil || err != nil {
 		return nil
 	}
-	bytes, err := eblk.JSONByte()
-	if err != nil {
-		return nil
-	}
-	err = json.Unmarshal(bytes, holder)
-	if err != nil {
-		return nil
-	}
 
-	if keymr, err := eblk.KeyMR(); err != nil {
+	holder := new(EblockHolder)
+	holder.EBlock = *blockView.NewEBlock(eblk)
+	if holder.KeyMR == "" {
 		holder.KeyMR = "Error"
-	} else {
-		holder.KeyMR = keymr.String()
 	}
 	holder.BodyMR = eblk.BodyKeyMR().String()
-	holder.FullHash = eblk.GetHash().String()
 
-	entries := eblk.GetEntryHashes()
 	count := 0
-	for _, entry := range entries {
-		if len(entry.String()) < 32 {
-			continue
-		} else if entry.String()[:10] == "0000000000" {
+	for _, entry := range holder.EBlock.Entries {
+		if entry.Minute > 0 {
 			ent := new(EntryHolder)
 			ent.Hash = "Minute Marker"
-			num := entry.String()[63:]
-			if num == "a" {
-				num = "10"
-			}
-			ent.ChainID = num
+			ent.ChainID = fmt.Sprintf("%d", entry.Minute)
 
 			holder.Entries = append(holder.Entries, *ent)
 			continue
 		}
-		ent := getEntry(entry.String())
+		ent := cp.getEntry(entry.Hash)
 		count++
 		if ent != nil {
-			ent.Hash = entry.String()
 			holder.Entries = append(holder.Entries, *ent)
 		}
 	}
-	holder.Header.EntryCount = count
+	holder.Header.EntryCount = uint32(count)
 
 	return holder
 }
 
 type DblockHolder struct {
-	Header struct {
-		Version      int    `json:"Version"`
-		NetworkID    int    `json:"NetworkID"`
-		BodyMR       string `json:"BodyMR"`
-		PrevKeyMR    string `json:"PrevKeyMR"`
-		PrevFullHash string `json:"PrevFullHash"`
-		Timestamp    uint32 `json:"Timestamp"`
-		DBHeight     int    `json:"DBHeight"`
-		BlockCount   int    `json:"BlockCount"`
-		ChainID      string `json:"ChainID"`
-
-		FormatedTimeStamp string
-	} `json:"Header"`
-	DBEntries []struct {
-		ChainID string `json:"ChainID"`
-		KeyMR   string `json:"KeyMR"`
-	} `json:"DBEntries"`
-	JsonDBHash interface{} `json:"DBHash"`
-	JsonKeyMR  interface{} `json:"KeyMR"`
+	blockView.DBlock
 
 	EBlocks    []EblockHolder
 	AdminBlock struct {
@@ -629,63 +547,47 @@ type DblockHolder struct {
 		ChainID string
 		KeyMr   string
 	}
-	FullHash string
-	KeyMR    string
 }
 
-func getDblock(hash string) *DblockHolder {
+func (cp *ControlPanel) getDblock(hash string) *DblockHolder {
+	st := cp.view().State
 	mr, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
-	holder := new(DblockHolder)
-
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	dblk, err := dbase.FetchDBlock(mr)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if dblk == nil || err != nil {
 		return nil
 	}
-	bytes, err := dblk.JSONByte()
-	if err != nil {
-		return nil
-	}
-	err = json.Unmarshal(bytes, holder)
-	if err != nil {
-		return nil
-	}
 
-	blocks := dblk.GetDBEntries()
-	for _, block := range blocks {
-		if len(block.GetKeyMR().String()) < 32 {
+	holder := new(DblockHolder)
+	holder.DBlock = *blockView.NewDBlock(dblk)
+	for _, block := range holder.DBEntries {
+		if len(block.KeyMR) < 32 {
 			continue
-		} else if block.GetChainID().String()[:10] == "0000000000" {
+		} else if block.ChainID[:10] == "0000000000" {
 			// Admin/FC/EC block
-			switch block.GetChainID().String() {
+			switch block.ChainID {
 			case "000000000000000000000000000000000000000000000000000000000000000a":
-				holder.AdminBlock.ChainID = block.GetChainID().String()
-				holder.AdminBlock.KeyMr = block.GetKeyMR().String()
+				holder.AdminBlock.ChainID = block.ChainID
+				holder.AdminBlock.KeyMr = block.KeyMR
 			case "000000000000000000000000000000000000000000000000000000000000000c":
-				holder.EntryCreditBlock.ChainID = block.GetChainID().String()
-				holder.EntryCreditBlock.KeyMr = block.GetKeyMR().String()
+				holder.EntryCreditBlock.ChainID = block.ChainID
+				holder.EntryCreditBlock.KeyMr = block.KeyMR
 			case "000000000000000000000000000000000000000000000000000000000000000f":
-				holder.FactoidBlock.ChainID = block.GetChainID().String()
-				holder.FactoidBlock.KeyMr = block.GetKeyMR().String()
+				holder.FactoidBlock.ChainID = block.ChainID
+				holder.FactoidBlock.KeyMr = block.KeyMR
 			}
 			continue
 		}
-		blk := getEblock(block.GetKeyMR().String())
+		blk := cp.getEblock(block.KeyMR)
 		if blk != nil {
 			holder.EBlocks = append(holder.EBlocks, *blk)
 		}
 	}
-
-	holder.FullHash = dblk.GetHash().String()
-	holder.KeyMR = dblk.GetKeyMR().String()
-
-	ts := dblk.GetTimestamp()
-	holder.Header.FormatedTimeStamp = ts.String()
 	return holder
 }
 
@@ -700,18 +602,20 @@ type EntryHolder struct {
 	ContentLength int
 	ContentHash   string
 	ECCost        string
+	Decoded       *EntryContentView
 
 	Time string
 }
 
-func getEntry(hash string) *EntryHolder {
+func (cp *ControlPanel) getEntry(hash string) *EntryHolder {
+	st := cp.view().State
 	entryHash, err := primitives.HexToHash(hash)
 	if err != nil {
 		return nil
 	}
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	entry, err := dbase.FetchEntry(entryHash)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if err != nil {
 		return nil
@@ -734,10 +638,10 @@ func getEntry(hash string) *EntryHolder {
 		}
 		if hexString {
 			str := hex.EncodeToString(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Hex  : </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
+			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Hex  : </a></span><span id='data'>"+EscapeHTML(str)+"</span>")
 		} else {
 			str := string(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Ascii: </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
+			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Ascii: </a></span><span id='data'>"+EscapeHTML(str)+"</span>")
 		}
 	}
 	holder.Version = 0
@@ -745,7 +649,7 @@ func getEntry(hash string) *EntryHolder {
 	holder.ContentLength = len(entry.GetContent())
 	data := sha256.Sum256(entry.GetContent())
 	content := string(entry.GetContent())
-	holder.Content = htemp.HTMLEscaper(content)
+	holder.Content = EscapeHTML(content)
 	if bytes, err := entry.MarshalBinary(); err != nil {
 		holder.ECCost = "Error"
 	} else {
@@ -756,12 +660,15 @@ func getEntry(hash string) *EntryHolder {
 		}
 	}
 
+	holder.Decoded = cp.DecodeEntryContent(holder.ChainID, entry.ExternalIDs(), entry.GetContent())
+
 	//holder.Content = string(entry.GetContent())
 	holder.ContentHash = primitives.NewHash(data[:]).String()
 	return holder
 }
 
-func getAllChainEntries(chainIDString string) []SearchedStruct {
+func (cp *ControlPanel) getAllChainEntries(chainIDString string) []SearchedStruct {
+	st := cp.view().State
 	arr := make([]SearchedStruct, 0)
 	chainID, err := primitives.HexToHash(chainIDString)
 	if err != nil {
@@ -771,9 +678,9 @@ func getAllChainEntries(chainIDString string) []SearchedStruct {
 	s.Type = "chainhead"
 	s.Input = chainID.String()
 
-	dbase := StatePointer.GetAndLockDB()
+	dbase := st.GetAndLockDB()
 	mr, err := dbase.FetchHeadIndexByChainID(chainID)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 
 	if err != nil || mr == nil {
 		return nil
@@ -786,10 +693,10 @@ func getAllChainEntries(chainIDString string) []SearchedStruct {
 
 	entries := make([]interfaces.IEBEntry, 0)
 
-	dbase = StatePointer.GetAndLockDB()
+	dbase = st.GetAndLockDB()
 	eblks, err := dbase.FetchAllEBlocksByChain(chainID)
 	if err != nil {
-		StatePointer.UnlockDB()
+		st.UnlockDB()
 		return nil
 	}
 
@@ -804,7 +711,7 @@ func getAllChainEntries(chainIDString string) []SearchedStruct {
 		}
 	}
 	//entries, err := dbase.FetchAllEntriesByChainID(chainID)
-	StatePointer.UnlockDB()
+	st.UnlockDB()
 	if err != nil {
 		return nil
 	}
@@ -812,7 +719,7 @@ func getAllChainEntries(chainIDString string) []SearchedStruct {
 	for _, entry := range entries {
 		s := new(SearchedStruct)
 		s.Type = "entry"
-		e := getEntry(entry.GetHash().String())
+		e := cp.getEntry(entry.GetHash().String())
 		s.Content = e
 		s.Input = entry.GetHash().String()
 		arr = append(arr[:], *s)
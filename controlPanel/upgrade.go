@@ -0,0 +1,38 @@
+package controlPanel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The upgrade page shows the activations the signed network status plans
+// that are still ahead, whether this node is ready for each, and how many of
+// its peers advertise a protocol version that is (see state/upgradeStatus.go).
+
+func (cp *ControlPanel) upgradeHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in UpgradeHandler.\n", r)
+		}
+	}()
+	if false == cp.checkControlPanelPassword(w, r) {
+		return
+	}
+
+	err := cp.templates.ExecuteTemplate(w, "upgradePage", cp.getUpgradeStatus())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// GET /api/upgrade
+func (cp *ControlPanel) apiUpgradeHandler(w http.ResponseWriter, r *http.Request) {
+	writeApiResponse(w, cp.getUpgradeStatus())
+}
+
+func (cp *ControlPanel) getUpgradeStatus() *interfaces.UpgradeStatus {
+	return cp.view().State.GetUpgradeStatus()
+}
@@ -0,0 +1,42 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"encoding/binary"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The timelines of the last blocks saved (see state/blockTimeline.go) are kept in BLOCK_TIMELINES,
+// keyed by height.  The State deletes the oldest as it saves each block.
+
+func blockTimelineKey(height uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, height)
+	return key
+}
+
+func (db *Overlay) SaveBlockTimeline(height uint32, timeline interfaces.BinaryMarshallable) error {
+	if timeline == nil {
+		return nil
+	}
+	return db.Put(BLOCK_TIMELINES, blockTimelineKey(height), timeline)
+}
+
+func (db *Overlay) FetchBlockTimeline(height uint32, dst interfaces.BinaryMarshallable) (interfaces.BinaryMarshallable, error) {
+	timeline, err := db.DB.Get(BLOCK_TIMELINES, blockTimelineKey(height), dst)
+	if err != nil {
+		return nil, err
+	}
+	if timeline == nil {
+		return nil, nil
+	}
+	return timeline, nil
+}
+
+func (db *Overlay) DeleteBlockTimeline(height uint32) error {
+	return db.Delete(BLOCK_TIMELINES, blockTimelineKey(height))
+}
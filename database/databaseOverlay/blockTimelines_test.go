@@ -0,0 +1,47 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/state"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func TestSaveFetchDeleteBlockTimeline(t *testing.T) {
+	dbo := CreateEmptyTestDatabaseOverlay()
+
+	tl := new(state.BlockTimeline)
+	tl.DBHeight = 12
+	tl.Events = []interfaces.TimelineEvent{
+		{Event: state.TimelineFirstCommit, Time: 1500000000000},
+		{Event: state.TimelineEOM(0), Time: 1500000060000},
+		{Event: state.TimelineSaved, Time: 1500000600000},
+	}
+	if err := dbo.SaveBlockTimeline(12, tl); err != nil {
+		t.Fatal(err)
+	}
+
+	fetched, err := dbo.FetchBlockTimeline(12, new(state.BlockTimeline))
+	if err != nil || fetched == nil {
+		t.Fatalf("Timeline not found: %v", err)
+	}
+	got := fetched.(*state.BlockTimeline)
+	if got.DBHeight != 12 || len(got.Events) != 3 || got.Events[1] != tl.Events[1] {
+		t.Errorf("Fetched %+v, saved %+v", got, tl)
+	}
+
+	if fetched, _ := dbo.FetchBlockTimeline(13, new(state.BlockTimeline)); fetched != nil {
+		t.Errorf("Found a timeline that wasn't saved")
+	}
+	if err := dbo.DeleteBlockTimeline(12); err != nil {
+		t.Error(err)
+	}
+	if fetched, _ := dbo.FetchBlockTimeline(12, new(state.BlockTimeline)); fetched != nil {
+		t.Errorf("Found a deleted timeline")
+	}
+}
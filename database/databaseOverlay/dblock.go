@@ -26,6 +26,10 @@ func (db *Overlay) ProcessDBlockBatch(dblock interfaces.DatabaseBlockWithEntries
 		return err
 	}
 
+	err = db.saveDBlockTime(dblock)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlock(dblock, false)
 }
 
@@ -37,6 +41,10 @@ func (db *Overlay) ProcessDBlockBatchWithoutHead(dblock interfaces.DatabaseBlock
 		return err
 	}
 
+	err = db.saveDBlockTime(dblock)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlock(dblock, false)
 }
 
@@ -48,6 +56,10 @@ func (db *Overlay) ProcessDBlockMultiBatch(dblock interfaces.DatabaseBlockWithEn
 		return err
 	}
 
+	err = db.saveDBlockTimeMultiBatch(dblock)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlockMultiBatch(dblock, true)
 }
 
@@ -24,6 +24,7 @@ func (db *Overlay) InsertEntry(entry interfaces.IEBEntry) error {
 	if err != nil {
 		return err
 	}
+	db.addToEntryBloom(entry)
 	if entry.GetChainID().String() == AnchorBlockID {
 		db.SaveAnchorInfoFromEntry(entry)
 	}
@@ -44,6 +45,7 @@ func (db *Overlay) InsertEntryMultiBatch(entry interfaces.IEBEntry) error {
 	batch = append(batch, interfaces.Record{ENTRY, entry.DatabasePrimaryIndex().Bytes(), entry.GetChainIDHash()})
 
 	db.PutInMultiBatch(batch)
+	db.addToEntryBloom(entry)
 	if entry.GetChainID().String() == AnchorBlockID {
 		db.SaveAnchorInfoFromEntryMultiBatch(entry)
 	}
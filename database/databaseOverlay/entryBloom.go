@@ -0,0 +1,162 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The entry bloom is a bloom filter over the hash of every saved entry, so most questions about
+// entries that don't exist are answered without touching the database.  A filter never misses an
+// entry it was given, so "no" is certain; "maybe" is checked against the database.
+//
+// Entry hashes are sha256 already, so the filter's hashes are taken straight from the bytes of the
+// entry hash, by double hashing.  The filter can't grow: once it holds many more entries than it
+// was sized for, most answers are "maybe", and it saves little.
+type EntryBloom struct {
+	spared uint64 // Times the database wasn't read; first, to be aligned for atomic updates
+
+	sync.RWMutex
+	bits  []uint64
+	m     uint64 // Number of bits
+	k     uint64 // Number of bits set for each entry
+	count uint64 // Entries added
+	ready bool   // Every saved entry has been added
+}
+
+// NewEntryBloom returns an empty filter of m bits (at least 64), setting k bits for each entry.
+func NewEntryBloom(m uint64, k uint64) *EntryBloom {
+	if m < 64 {
+		m = 64
+	}
+	if k < 1 {
+		k = 1
+	}
+	eb := new(EntryBloom)
+	eb.bits = make([]uint64, (m+63)/64)
+	eb.m = uint64(len(eb.bits)) * 64
+	eb.k = k
+	return eb
+}
+
+func (eb *EntryBloom) indexes(hash []byte) []uint64 {
+	var key [32]byte
+	copy(key[:], hash)
+	h1 := binary.BigEndian.Uint64(key[0:8])
+	h2 := binary.BigEndian.Uint64(key[8:16]) | 1
+	idx := make([]uint64, eb.k)
+	for i := range idx {
+		idx[i] = (h1 + uint64(i)*h2) % eb.m
+	}
+	return idx
+}
+
+// Add puts an entry hash in the filter
+func (eb *EntryBloom) Add(hash []byte) {
+	idx := eb.indexes(hash)
+	eb.Lock()
+	defer eb.Unlock()
+	for _, i := range idx {
+		eb.bits[i/64] |= 1 << (i % 64)
+	}
+	eb.count++
+}
+
+// MayContain is false only if the entry hash was never added.  It is always true until the filter
+// is ready.
+func (eb *EntryBloom) MayContain(hash []byte) bool {
+	idx := eb.indexes(hash)
+	eb.RLock()
+	defer eb.RUnlock()
+	if !eb.ready {
+		return true
+	}
+	for _, i := range idx {
+		if eb.bits[i/64]&(1<<(i%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (eb *EntryBloom) setReady() {
+	eb.Lock()
+	defer eb.Unlock()
+	eb.ready = true
+}
+
+// Status returns the status of the filter.
+func (eb *EntryBloom) Status() *interfaces.EntryBloomStatus {
+	eb.RLock()
+	defer eb.RUnlock()
+	status := new(interfaces.EntryBloomStatus)
+	status.Entries = eb.count
+	status.Bits = eb.m
+	status.Ready = eb.ready
+	status.FalsePositive = math.Pow(1-math.Exp(-float64(eb.k)*float64(eb.count)/float64(eb.m)), float64(eb.k))
+	status.Spared = atomic.LoadUint64(&eb.spared)
+	return status
+}
+
+// EntryBloomK is the number of bits set for each entry; with ten bits of filter for each entry it
+// gives about one "maybe" in a hundred for entries that don't exist.
+const EntryBloomK = 7
+
+var entryBloomMutex sync.Mutex
+
+func (db *Overlay) getEntryBloom() *EntryBloom {
+	entryBloomMutex.Lock()
+	defer entryBloomMutex.Unlock()
+	return db.EntryBloom
+}
+
+func (db *Overlay) addToEntryBloom(entry interfaces.IEBEntry) {
+	if eb := db.getEntryBloom(); eb != nil {
+		eb.Add(entry.DatabasePrimaryIndex().Bytes())
+	}
+}
+
+// BuildEntryBloom makes an entry bloom of the given number of bits and adds the hash of every saved
+// entry to it.  Entries saved while it runs are added as they are saved; until it is done the
+// filter answers "maybe" to everything, so nothing is missed.
+func (db *Overlay) BuildEntryBloom(bits uint64) error {
+	eb := NewEntryBloom(bits, EntryBloomK)
+	entryBloomMutex.Lock()
+	db.EntryBloom = eb
+	entryBloomMutex.Unlock()
+
+	keys, err := db.ListAllKeys(ENTRY)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		eb.Add(key)
+	}
+	eb.setReady()
+	return nil
+}
+
+// EntryExists is true if an entry with the given hash is saved.  With an entry bloom most entries
+// that aren't saved are found without reading the database.
+func (db *Overlay) EntryExists(hash interfaces.IHash) (bool, error) {
+	if eb := db.getEntryBloom(); eb != nil && !eb.MayContain(hash.Bytes()) {
+		atomic.AddUint64(&eb.spared, 1)
+		return false, nil
+	}
+	return db.DoesKeyExist(ENTRY, hash.Bytes())
+}
+
+// EntryBloomStatus returns the status of the entry bloom, nil if there is none.
+func (db *Overlay) EntryBloomStatus() *interfaces.EntryBloomStatus {
+	if eb := db.getEntryBloom(); eb != nil {
+		return eb.Status()
+	}
+	return nil
+}
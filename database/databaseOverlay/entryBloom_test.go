@@ -0,0 +1,86 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestEntryBloom(t *testing.T) {
+	eb := NewEntryBloom(10000, EntryBloomK)
+	for i := 0; i < 1000; i++ {
+		eb.Add(primitives.Sha([]byte{byte(i), byte(i >> 8)}).Bytes())
+	}
+	if !eb.MayContain(primitives.Sha([]byte("not added")).Bytes()) {
+		t.Errorf("The filter isn't ready, so it should answer maybe")
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !eb.MayContain(primitives.Sha([]byte{byte(i), byte(i >> 8)}).Bytes()) {
+			t.Errorf("Entry %d was added, so it should be maybe", i)
+		}
+	}
+	if status := eb.Status(); status.Ready || status.Entries != 1000 || status.Bits != 10048 {
+		t.Errorf("Unexpected status %v", status)
+	}
+
+	// Without a bloom, every lookup goes to the database
+	dbo := NewOverlay(new(mapdb.MapDB))
+	defer dbo.Close()
+	if dbo.EntryBloomStatus() != nil {
+		t.Errorf("Expected no entry bloom before it is built")
+	}
+	exists, err := dbo.EntryExists(primitives.Sha([]byte("not added")))
+	if err != nil || exists {
+		t.Errorf("Expected no entry, got %v, %v", exists, err)
+	}
+}
+
+func TestEntryExists(t *testing.T) {
+	dbo := NewOverlay(new(mapdb.MapDB))
+	defer dbo.Close()
+
+	// Entries saved before the bloom is built, and after
+	before := testHelper.CreateTestEntry(1)
+	if err := dbo.InsertEntry(before); err != nil {
+		t.Fatal(err)
+	}
+	if err := dbo.BuildEntryBloom(1 << 16); err != nil {
+		t.Fatal(err)
+	}
+	after := testHelper.CreateTestEntry(2)
+	if err := dbo.InsertEntry(after); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range []interfaces.IEBEntry{before, after} {
+		exists, err := dbo.EntryExists(e.GetHash())
+		if err != nil || !exists {
+			t.Errorf("Entry %x should exist: %v", e.GetHash().Bytes(), err)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		exists, err := dbo.EntryExists(primitives.Sha([]byte{byte(i), byte(i >> 8)}))
+		if err != nil || exists {
+			t.Errorf("Entry %d shouldn't exist: %v", i, err)
+		}
+	}
+
+	status := dbo.EntryBloomStatus()
+	if status == nil || !status.Ready || status.Entries != 2 {
+		t.Fatalf("Expected a ready bloom of 2 entries, got %v", status)
+	}
+	// Of 1000 entries that don't exist, nearly all should be answered by the bloom
+	if status.Spared < 990 {
+		t.Errorf("Only %d of the lookups were spared", status.Spared)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Every factoid block carries the EC exchange rate it was built with, but a pruned node deletes
+// old factoid blocks, and finding when a rate changed would mean reading all of them.  So as each
+// factoid block is saved, a change of rate is also recorded in EXCHANGE_RATES, keyed by the
+// height it took effect at.  The rate changes rarely, so the whole record is small.
+
+func exchangeRateKey(height uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, height)
+	return key
+}
+
+func exchangeRateValue(rate uint64) *primitives.ByteSlice {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, rate)
+	return &primitives.ByteSlice{Bytes: value}
+}
+
+type byExchangeRateHeight []interfaces.ExchangeRateChange
+
+func (c byExchangeRateHeight) Len() int           { return len(c) }
+func (c byExchangeRateHeight) Less(i, j int) bool { return c[i].Height < c[j].Height }
+func (c byExchangeRateHeight) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// FetchExchangeRateChanges returns every recorded change of the exchange rate, lowest height first.
+func (db *Overlay) FetchExchangeRateChanges() ([]interfaces.ExchangeRateChange, error) {
+	values, keys, err := db.GetAll(EXCHANGE_RATES, new(primitives.ByteSlice))
+	if err != nil {
+		return nil, err
+	}
+	var changes []interfaces.ExchangeRateChange
+	for i, key := range keys {
+		value := values[i].(*primitives.ByteSlice).Bytes
+		if len(key) != 4 || len(value) != 8 {
+			continue
+		}
+		changes = append(changes, interfaces.ExchangeRateChange{
+			Height: binary.BigEndian.Uint32(key),
+			Rate:   binary.BigEndian.Uint64(value),
+		})
+	}
+	sort.Sort(byExchangeRateHeight(changes))
+	return changes, nil
+}
+
+// FetchExchangeRateByHeight returns the exchange rate of the factoid block at height, and the
+// height that rate took effect at.  Found is false if no change is recorded at or below height.
+func (db *Overlay) FetchExchangeRateByHeight(height uint32) (rate uint64, since uint32, found bool, err error) {
+	changes, err := db.FetchExchangeRateChanges()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	i := sort.Search(len(changes), func(i int) bool { return changes[i].Height > height })
+	if i == 0 {
+		return 0, 0, false, nil
+	}
+	return changes[i-1].Rate, changes[i-1].Height, true, nil
+}
+
+// exchangeRateRecord returns the record of the factoid block's rate, or nil if the rate is
+// the one already in effect at its height.
+func (db *Overlay) exchangeRateRecord(block interfaces.DatabaseBlockWithEntries) (*interfaces.Record, error) {
+	fblk, ok := block.(interfaces.IFBlock)
+	if !ok {
+		return nil, nil
+	}
+	height := fblk.GetDatabaseHeight()
+	if height > 0 {
+		rate, _, found, err := db.FetchExchangeRateByHeight(height - 1)
+		if err != nil {
+			return nil, err
+		}
+		if found && rate == fblk.GetExchRate() {
+			return nil, nil
+		}
+	}
+	return &interfaces.Record{EXCHANGE_RATES, exchangeRateKey(height), exchangeRateValue(fblk.GetExchRate())}, nil
+}
+
+func (db *Overlay) saveExchangeRate(block interfaces.DatabaseBlockWithEntries) error {
+	record, err := db.exchangeRateRecord(block)
+	if err != nil || record == nil {
+		return err
+	}
+	return db.DB.PutInBatch([]interfaces.Record{*record})
+}
+
+func (db *Overlay) saveExchangeRateMultiBatch(block interfaces.DatabaseBlockWithEntries) error {
+	record, err := db.exchangeRateRecord(block)
+	if err != nil || record == nil {
+		return err
+	}
+	db.PutInMultiBatch([]interfaces.Record{*record})
+	return nil
+}
+
+// BuildExchangeRateIndex records the rate changes of a database saved before they were recorded,
+// by reading every factoid block.  The genesis rate is always recorded, so it does nothing once
+// that is.  Blocks saved while it runs record their own changes; recording a rate twice does no
+// harm.
+func (db *Overlay) BuildExchangeRateIndex() error {
+	done, err := db.DoesKeyExist(EXCHANGE_RATES, exchangeRateKey(0))
+	if err != nil || done {
+		return err
+	}
+	head, err := db.FetchFBlockHead()
+	if err != nil || head == nil {
+		return err
+	}
+	var last uint64
+	for height := uint32(0); height <= head.GetDatabaseHeight(); height++ {
+		fblk, err := db.FetchFBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		if fblk == nil || fblk.GetExchRate() == last {
+			continue
+		}
+		last = fblk.GetExchRate()
+		err = db.Put(EXCHANGE_RATES, exchangeRateKey(height), exchangeRateValue(last))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package databaseOverlay_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestExchangeRates(t *testing.T) {
+	rates := []uint64{1000, 1000, 2000, 2000, 1500}
+
+	dbo := NewOverlay(new(mapdb.MapDB))
+	defer dbo.Close()
+
+	var prev interfaces.IFBlock
+	for _, rate := range rates {
+		// Only a coinbase, as the rate changes under the transactions of a block
+		b := testHelper.CreateTestFactoidBlockWithCoinbase(prev, testHelper.NewFactoidAddress(0), testHelper.DefaultCoinbaseAmount)
+		b.SetExchRate(rate)
+		err := dbo.ProcessFBlockBatch(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		prev = b
+	}
+
+	check := func() {
+		changes, err := dbo.FetchExchangeRateChanges()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []interfaces.ExchangeRateChange{{Height: 0, Rate: 1000}, {Height: 2, Rate: 2000}, {Height: 4, Rate: 1500}}
+		if len(changes) != len(expected) {
+			t.Fatalf("Expected %d changes, got %v", len(expected), changes)
+		}
+		for i := range expected {
+			if changes[i] != expected[i] {
+				t.Errorf("Change %d is %v, expected %v", i, changes[i], expected[i])
+			}
+		}
+
+		since := []uint32{0, 0, 2, 2, 4}
+		for h, rate := range rates {
+			r, s, found, err := dbo.FetchExchangeRateByHeight(uint32(h))
+			if err != nil || !found {
+				t.Errorf("No rate at height %d: %v", h, err)
+			}
+			if r != rate || s != since[h] {
+				t.Errorf("At height %d got %d since %d, expected %d since %d", h, r, s, rate, since[h])
+			}
+		}
+	}
+	check()
+
+	// A database saved before the rates were recorded
+	err := dbo.Clear(EXCHANGE_RATES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, found, _ := dbo.FetchExchangeRateByHeight(3); found {
+		t.Error("Found a rate after clearing the changes")
+	}
+	err = dbo.BuildExchangeRateIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	check()
+}
@@ -1,6 +1,8 @@
 package databaseOverlay
 
 import (
+	"encoding/binary"
+
 	"github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -13,6 +15,10 @@ func (db *Overlay) ProcessFBlockBatch(block interfaces.DatabaseBlockWithEntries)
 	if err != nil {
 		return err
 	}
+	err = db.saveExchangeRate(block)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlock(block, false)
 }
 
@@ -21,6 +27,10 @@ func (db *Overlay) ProcessFBlockBatchWithoutHead(block interfaces.DatabaseBlockW
 	if err != nil {
 		return err
 	}
+	err = db.saveExchangeRate(block)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlock(block, false)
 }
 
@@ -29,9 +39,45 @@ func (db *Overlay) ProcessFBlockMultiBatch(block interfaces.DatabaseBlockWithEnt
 	if err != nil {
 		return err
 	}
+	err = db.saveExchangeRateMultiBatch(block)
+	if err != nil {
+		return err
+	}
 	return db.SaveIncludedInMultiFromBlockMultiBatch(block, true)
 }
 
+// DeleteFBlock removes a factoid block, its indexes, and the IncludedIn records of its
+// transactions.  The chain head is left alone; only a pruned node deletes factoid blocks, and
+// never its newest.
+func (db *Overlay) DeleteFBlock(keyMR interfaces.IHash) error {
+	block, err := db.FetchFBlockByPrimary(keyMR)
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return nil
+	}
+
+	for _, hash := range append(block.GetEntryHashes(), block.GetEntrySigHashes()...) {
+		err = db.Delete(INCLUDED_IN, hash.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	height := make([]byte, 4)
+	binary.BigEndian.PutUint32(height, block.GetDatabaseHeight())
+	err = db.Delete(FACTOIDBLOCK_NUMBER, height)
+	if err != nil {
+		return err
+	}
+	err = db.Delete(FACTOIDBLOCK_SECONDARYINDEX, block.DatabaseSecondaryIndex().Bytes())
+	if err != nil {
+		return err
+	}
+	return db.Delete(FACTOIDBLOCK, block.DatabasePrimaryIndex().Bytes())
+}
+
 func (db *Overlay) FetchFBlock(hash interfaces.IHash) (interfaces.IFBlock, error) {
 	block, err := db.FetchFBlockByPrimary(hash)
 	if err != nil {
@@ -190,3 +190,52 @@ func TestLoadUnknownFBlocks(t *testing.T) {
 		}
 	}
 }
+
+func TestDeleteFBlock(t *testing.T) {
+	b1 := testHelper.CreateTestFactoidBlock(nil)
+	b2 := testHelper.CreateTestFactoidBlock(b1)
+
+	dbo := NewOverlay(new(mapdb.MapDB))
+	defer dbo.Close()
+
+	for _, b := range []interfaces.IFBlock{b1, b2} {
+		err := dbo.ProcessFBlockBatch(b)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	err := dbo.DeleteFBlock(b1.DatabasePrimaryIndex())
+	if err != nil {
+		t.Error(err)
+	}
+
+	if blk, _ := dbo.FetchFBlockByPrimary(b1.DatabasePrimaryIndex()); blk != nil {
+		t.Error("Deleted block is still there")
+	}
+	if blk, _ := dbo.FetchFBlockBySecondary(b1.DatabaseSecondaryIndex()); blk != nil {
+		t.Error("Deleted block is still there by its secondary index")
+	}
+	if blk, _ := dbo.FetchFBlockByHeight(b1.GetDatabaseHeight()); blk != nil {
+		t.Error("Deleted block is still there by its height")
+	}
+	for _, h := range b1.GetEntryHashes() {
+		if in, _ := dbo.FetchIncludedIn(h); in != nil {
+			t.Errorf("IncludedIn for %v was not deleted", h.String())
+		}
+	}
+
+	blk, err := dbo.FetchFBlockByHeight(b2.GetDatabaseHeight())
+	if err != nil {
+		t.Error(err)
+	}
+	if blk == nil || !blk.DatabasePrimaryIndex().IsSameAs(b2.DatabasePrimaryIndex()) {
+		t.Error("The block that wasn't deleted is gone")
+	}
+
+	// Deleting it twice does no harm
+	err = dbo.DeleteFBlock(b1.DatabasePrimaryIndex())
+	if err != nil {
+		t.Error(err)
+	}
+}
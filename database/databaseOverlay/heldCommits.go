@@ -0,0 +1,37 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Like the replay filter, the held commits are saved as a single record under their bucket name.
+func (db *Overlay) SaveHeldCommits(commits interfaces.BinaryMarshallable) error {
+	if commits == nil {
+		return nil
+	}
+	batch := []interfaces.Record{}
+
+	batch = append(batch, interfaces.Record{HELD_COMMITS, HELD_COMMITS, commits})
+
+	err := db.DB.PutInBatch(batch)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *Overlay) FetchHeldCommits(dst interfaces.BinaryMarshallable) (interfaces.BinaryMarshallable, error) {
+	commits, err := db.DB.Get(HELD_COMMITS, HELD_COMMITS, dst)
+	if err != nil {
+		return nil, err
+	}
+	if commits == nil {
+		return nil, nil
+	}
+	return commits, nil
+}
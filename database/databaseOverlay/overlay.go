@@ -66,6 +66,24 @@ var (
 
 	//Which EC transaction paid for this Entry
 	PAID_FOR = []byte("PaidFor")
+
+	//The State's replay filter, kept across restarts
+	REPLAY_FILTER = []byte("ReplayFilter")
+
+	//Commits waiting on their reveals, kept across restarts
+	HELD_COMMITS = []byte("HeldCommits")
+
+	//Each change of the EC exchange rate, by the height it took effect at
+	EXCHANGE_RATES = []byte("ExchangeRates")
+
+	//The timestamp of each directory block, by height
+	DBLOCK_TIMES = []byte("DBlockTimes")
+
+	//When each recent block reached each step of its making, by height
+	BLOCK_TIMELINES = []byte("BlockTimelines")
+
+	//What each VM of each recent block processed, by height
+	PROCESS_LIST_LOGS = []byte("ProcessListLogs")
 )
 
 var ConstantNamesMap map[string]string
@@ -105,6 +123,18 @@ func init() {
 
 	ConstantNamesMap[string(PAID_FOR)] = "PaidFor"
 
+	ConstantNamesMap[string(REPLAY_FILTER)] = "ReplayFilter"
+
+	ConstantNamesMap[string(HELD_COMMITS)] = "HeldCommits"
+
+	ConstantNamesMap[string(EXCHANGE_RATES)] = "ExchangeRates"
+
+	ConstantNamesMap[string(DBLOCK_TIMES)] = "DBlockTimes"
+
+	ConstantNamesMap[string(BLOCK_TIMELINES)] = "BlockTimelines"
+
+	ConstantNamesMap[string(PROCESS_LIST_LOGS)] = "ProcessListLogs"
+
 	RegisterPrometheus()
 }
 
@@ -117,6 +147,9 @@ type Overlay struct {
 	BatchSemaphore sync.Mutex
 	MultiBatch     []interfaces.Record
 	BlockExtractor blockExtractor.BlockExtractor
+
+	// Hashes of the saved entries, see entryBloom.go; nil unless built
+	EntryBloom *EntryBloom
 }
 
 var _ interfaces.IDatabase = (*Overlay)(nil)
@@ -149,6 +182,16 @@ func (db *Overlay) ExecuteMultiBatch() error {
 	return db.PutInBatch(db.MultiBatch)
 }
 
+// TakeMultiBatch ends the multi batch without writing it, and returns what was put in it, so
+// the records can be written later with others.
+func (db *Overlay) TakeMultiBatch() []interfaces.Record {
+	defer func() {
+		db.MultiBatch = nil
+		db.BatchSemaphore.Unlock()
+	}()
+	return db.MultiBatch
+}
+
 func (db *Overlay) PutInBatch(records []interfaces.Record) error {
 	return db.DB.PutInBatch(records)
 }
@@ -185,6 +228,14 @@ func (db *Overlay) Trim() {
 	db.DB.Trim()
 }
 
+// Compact compacts the database under us, if it is a Compacter, and does nothing if it isn't.
+func (db *Overlay) Compact() error {
+	if c, ok := db.DB.(interfaces.Compacter); ok {
+		return c.Compact()
+	}
+	return nil
+}
+
 func (db *Overlay) Delete(bucket, key []byte) error {
 	return db.DB.Delete(bucket, key)
 }
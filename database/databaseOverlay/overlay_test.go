@@ -603,3 +603,37 @@ func TestFetchAllBlockKeysFromBucket(t *testing.T) {
 		}
 	}
 }
+
+func TestTakeMultiBatch(t *testing.T) {
+	m := new(mapdb.MapDB)
+	m.Init(nil)
+	dbo := NewOverlay(m)
+
+	blocks := testHelper.CreateFullTestBlockSet()
+	dbo.StartMultiBatch()
+	if err := dbo.ProcessDBlockMultiBatch(blocks[0].DBlock); err != nil {
+		t.Fatal(err)
+	}
+	records := dbo.TakeMultiBatch()
+	if len(records) == 0 {
+		t.Fatal("Expected the records of the directory block")
+	}
+
+	// Nothing is written until we write the records ourselves
+	if dblk, _ := dbo.FetchDBlockByHeight(0); dblk != nil {
+		t.Error("Taking the multi batch should not write it")
+	}
+
+	// And the batch is no longer held, so another can start
+	dbo.StartMultiBatch()
+	if err := dbo.ExecuteMultiBatch(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := dbo.PutInBatch(records); err != nil {
+		t.Fatal(err)
+	}
+	if dblk, err := dbo.FetchDBlockByHeight(0); err != nil || dblk == nil {
+		t.Errorf("Directory block was not written, %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"encoding/binary"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The process list logs of the last blocks saved (see state/processListLog.go) are kept in
+// PROCESS_LIST_LOGS, keyed by height.  The State deletes the oldest as it saves each block.
+
+func processListLogKey(height uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, height)
+	return key
+}
+
+func (db *Overlay) SaveProcessListLog(height uint32, log interfaces.BinaryMarshallable) error {
+	if log == nil {
+		return nil
+	}
+	return db.Put(PROCESS_LIST_LOGS, processListLogKey(height), log)
+}
+
+func (db *Overlay) FetchProcessListLog(height uint32, dst interfaces.BinaryMarshallable) (interfaces.BinaryMarshallable, error) {
+	log, err := db.DB.Get(PROCESS_LIST_LOGS, processListLogKey(height), dst)
+	if err != nil {
+		return nil, err
+	}
+	if log == nil {
+		return nil, nil
+	}
+	return log, nil
+}
+
+func (db *Overlay) DeleteProcessListLog(height uint32) error {
+	return db.Delete(PROCESS_LIST_LOGS, processListLogKey(height))
+}
@@ -0,0 +1,46 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/state"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func TestSaveFetchDeleteProcessListLog(t *testing.T) {
+	dbo := CreateEmptyTestDatabaseOverlay()
+
+	l := new(state.ProcessListLog)
+	l.DBHeight = 12
+	l.Entries = []interfaces.ProcessListLogEntry{
+		{VM: 0, Height: 0, Minute: 0, Type: "Commit Entry", MsgHash: "aa", Hash: "bb", Acked: 1500000000000, Processed: 1500000000100},
+		{VM: 1, Height: 3, Minute: 4, Type: "Reveal Entry", MsgHash: "bb", Hash: "bb", Acked: 1500000240000},
+	}
+	if err := dbo.SaveProcessListLog(12, l); err != nil {
+		t.Fatal(err)
+	}
+
+	fetched, err := dbo.FetchProcessListLog(12, new(state.ProcessListLog))
+	if err != nil || fetched == nil {
+		t.Fatalf("Log not found: %v", err)
+	}
+	got := fetched.(*state.ProcessListLog)
+	if got.DBHeight != 12 || len(got.Entries) != 2 || got.Entries[0] != l.Entries[0] || got.Entries[1] != l.Entries[1] {
+		t.Errorf("Fetched %+v, saved %+v", got, l)
+	}
+
+	if fetched, _ := dbo.FetchProcessListLog(13, new(state.ProcessListLog)); fetched != nil {
+		t.Errorf("Found a log that wasn't saved")
+	}
+	if err := dbo.DeleteProcessListLog(12); err != nil {
+		t.Error(err)
+	}
+	if fetched, _ := dbo.FetchProcessListLog(12, new(state.ProcessListLog)); fetched != nil {
+		t.Errorf("Found a deleted log")
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// There is only ever the one replay filter, so it is kept under its bucket name.
+func (db *Overlay) SaveReplayFilter(filter interfaces.BinaryMarshallable) error {
+	if filter == nil {
+		return nil
+	}
+	batch := []interfaces.Record{}
+
+	batch = append(batch, interfaces.Record{REPLAY_FILTER, REPLAY_FILTER, filter})
+
+	err := db.DB.PutInBatch(batch)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *Overlay) FetchReplayFilter(dst interfaces.BinaryMarshallable) (interfaces.BinaryMarshallable, error) {
+	filter, err := db.DB.Get(REPLAY_FILTER, REPLAY_FILTER, dst)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		return nil, nil
+	}
+	return filter, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/state"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func TestSaveFetchReplayFilter(t *testing.T) {
+	dbo := CreateEmptyTestDatabaseOverlay()
+
+	filter, err := dbo.FetchReplayFilter(new(state.Replay))
+	if err != nil {
+		t.Error(err)
+	}
+	if filter != nil {
+		t.Error("Found a replay filter in an empty database")
+	}
+
+	r := state.RandomReplay()
+	err = dbo.SaveReplayFilter(r)
+	if err != nil {
+		t.Error(err)
+	}
+
+	filter, err = dbo.FetchReplayFilter(new(state.Replay))
+	if err != nil {
+		t.Error(err)
+	}
+	if filter == nil {
+		t.Fatal("Replay filter not found")
+	}
+	if !r.IsSameAs(filter.(*state.Replay)) {
+		t.Error("Replay filters are not the same")
+	}
+}
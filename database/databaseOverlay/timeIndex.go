@@ -0,0 +1,154 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay
+
+import (
+	"encoding/binary"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Finding the directory block of a time means reading the timestamps of the blocks around it.
+// So as each directory block is saved, its timestamp is also recorded in DBLOCK_TIMES, keyed by
+// its height.  Directory block timestamps only ever increase with height, so a time is found by
+// bisecting the heights, reading one small record at each step rather than a whole block.
+
+func dblockTimeKey(height uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, height)
+	return key
+}
+
+func dblockTimeValue(seconds int64) *primitives.ByteSlice {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(seconds))
+	return &primitives.ByteSlice{Bytes: value}
+}
+
+// dblockTimeRecord returns the record of the directory block's timestamp, or nil if the block
+// isn't a directory block.
+func dblockTimeRecord(block interfaces.DatabaseBlockWithEntries) *interfaces.Record {
+	dblk, ok := block.(interfaces.IDirectoryBlock)
+	if !ok {
+		return nil
+	}
+	seconds := dblk.GetHeader().GetTimestamp().GetTimeSeconds()
+	return &interfaces.Record{DBLOCK_TIMES, dblockTimeKey(dblk.GetDatabaseHeight()), dblockTimeValue(seconds)}
+}
+
+func (db *Overlay) saveDBlockTime(block interfaces.DatabaseBlockWithEntries) error {
+	record := dblockTimeRecord(block)
+	if record == nil {
+		return nil
+	}
+	return db.DB.PutInBatch([]interfaces.Record{*record})
+}
+
+func (db *Overlay) saveDBlockTimeMultiBatch(block interfaces.DatabaseBlockWithEntries) error {
+	record := dblockTimeRecord(block)
+	if record == nil {
+		return nil
+	}
+	db.PutInMultiBatch([]interfaces.Record{*record})
+	return nil
+}
+
+// FetchDBlockTimestampByHeight returns the timestamp, in seconds, of the directory block at the
+// given height.  A block saved before timestamps were recorded is read instead.
+func (db *Overlay) FetchDBlockTimestampByHeight(height uint32) (int64, bool, error) {
+	value, err := db.DB.Get(DBLOCK_TIMES, dblockTimeKey(height), new(primitives.ByteSlice))
+	if err != nil {
+		return 0, false, err
+	}
+	if value != nil {
+		if b := value.(*primitives.ByteSlice).Bytes; len(b) == 8 {
+			return int64(binary.BigEndian.Uint64(b)), true, nil
+		}
+	}
+
+	dblk, err := db.FetchDBlockByHeight(height)
+	if err != nil {
+		return 0, false, err
+	}
+	if dblk == nil {
+		return 0, false, nil
+	}
+	return dblk.GetHeader().GetTimestamp().GetTimeSeconds(), true, nil
+}
+
+// FetchDBlockHeightByTimestamp returns the height of the first directory block with a timestamp
+// at or after the given time in seconds.  Found is false if every saved block is older than the
+// time.
+func (db *Overlay) FetchDBlockHeightByTimestamp(seconds int64) (height uint32, found bool, err error) {
+	head, err := db.FetchDBlockHead()
+	if err != nil {
+		return 0, false, err
+	}
+	if head == nil {
+		return 0, false, nil
+	}
+	top := head.GetDatabaseHeight()
+
+	// Find the lowest height in [low, high] with a timestamp >= seconds
+	low, high := uint32(0), top+1
+	for low < high {
+		mid := low + (high-low)/2
+		t, ok, err := db.FetchDBlockTimestampByHeight(mid)
+		if err != nil {
+			return 0, false, err
+		}
+		if !ok {
+			// A missing block; treat it as newer so the search stays below it
+			high = mid
+			continue
+		}
+		if t < seconds {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low > top {
+		return 0, false, nil
+	}
+	return low, true, nil
+}
+
+// BuildTimeIndex records the timestamps of a database saved before they were recorded, by
+// reading every directory block the record is missing, from the head down.  The genesis block is
+// recorded last, so it does nothing once that is.
+func (db *Overlay) BuildTimeIndex() error {
+	done, err := db.DoesKeyExist(DBLOCK_TIMES, dblockTimeKey(0))
+	if err != nil || done {
+		return err
+	}
+	head, err := db.FetchDBlockHead()
+	if err != nil || head == nil {
+		return err
+	}
+	for height := int64(head.GetDatabaseHeight()); height >= 0; height-- {
+		key := dblockTimeKey(uint32(height))
+		exists, err := db.DoesKeyExist(DBLOCK_TIMES, key)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		dblk, err := db.FetchDBlockByHeight(uint32(height))
+		if err != nil {
+			return err
+		}
+		if dblk == nil {
+			continue
+		}
+		err = db.Put(DBLOCK_TIMES, key, dblockTimeValue(dblk.GetHeader().GetTimestamp().GetTimeSeconds()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
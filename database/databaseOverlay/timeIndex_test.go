@@ -0,0 +1,108 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package databaseOverlay_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestFetchDBlockHeightByTimestamp(t *testing.T) {
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	defer dbo.Close()
+
+	// Test directory blocks start at minute 1234, and are one minute apart
+	base := int64(1234 * 60)
+
+	for i := uint32(0); i < uint32(testHelper.BlockCount); i++ {
+		ts, found, err := dbo.FetchDBlockTimestampByHeight(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !found || ts != base+int64(i)*60 {
+			t.Errorf("Wrong timestamp for height %d - %d", i, ts)
+		}
+
+		h, found, err := dbo.FetchDBlockHeightByTimestamp(base + int64(i)*60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !found || h != i {
+			t.Errorf("Expected height %d, found %d", i, h)
+		}
+
+		// A time inside the previous minute rounds up to this block
+		if i > 0 {
+			h, found, _ = dbo.FetchDBlockHeightByTimestamp(base + int64(i)*60 - 30)
+			if !found || h != i {
+				t.Errorf("Expected height %d, found %d", i, h)
+			}
+		}
+	}
+
+	h, found, err := dbo.FetchDBlockHeightByTimestamp(0)
+	if err != nil || !found || h != 0 {
+		t.Errorf("A time before genesis should return height 0, found %d", h)
+	}
+
+	_, found, err = dbo.FetchDBlockHeightByTimestamp(base + int64(testHelper.BlockCount)*60)
+	if err != nil || found {
+		t.Error("A time after the head should not be found")
+	}
+}
+
+func TestBuildTimeIndex(t *testing.T) {
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	defer dbo.Close()
+
+	// Every saved directory block has its timestamp recorded
+	for i := uint32(0); i < uint32(testHelper.BlockCount); i++ {
+		exists, err := dbo.DoesKeyExist(DBLOCK_TIMES, timeKey(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists {
+			t.Errorf("No timestamp recorded for height %d", i)
+		}
+	}
+
+	// A database saved before they were recorded is still searched, and built
+	err := dbo.Clear(DBLOCK_TIMES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, found, err := dbo.FetchDBlockHeightByTimestamp(int64(1236 * 60))
+	if err != nil || !found || h != 2 {
+		t.Errorf("Expected height 2, found %d", h)
+	}
+
+	err = dbo.BuildTimeIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint32(0); i < uint32(testHelper.BlockCount); i++ {
+		exists, err := dbo.DoesKeyExist(DBLOCK_TIMES, timeKey(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists {
+			t.Errorf("No timestamp built for height %d", i)
+		}
+	}
+
+	h, found, err = dbo.FetchDBlockHeightByTimestamp(int64(1236 * 60))
+	if err != nil || !found || h != 2 {
+		t.Errorf("Expected height 2, found %d", h)
+	}
+}
+
+func timeKey(height uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, height)
+	return key
+}
@@ -33,6 +33,16 @@ func (db *HybridDB) Trim() {
 	db.temporaryStorage = m
 }
 
+// Compact compacts the persistent storage, if it can be.
+func (db *HybridDB) Compact() error {
+	db.Sem.Lock()
+	defer db.Sem.Unlock()
+	if c, ok := db.persistentStorage.(interfaces.Compacter); ok {
+		return c.Compact()
+	}
+	return nil
+}
+
 func (db *HybridDB) Close() error {
 	db.Sem.Lock()
 	defer db.Sem.Unlock()
@@ -62,6 +62,11 @@ func (db *LevelDB) Trim() {
 	}
 }
 
+// Compact compacts the whole database, dropping the space of deleted and overwritten records.
+func (db *LevelDB) Compact() error {
+	return db.lDB.CompactRange(util.Range{Start: nil, Limit: nil})
+}
+
 func (db *LevelDB) Delete(bucket []byte, key []byte) error {
 	db.dbLock.Lock()
 	defer db.dbLock.Unlock()
@@ -0,0 +1,310 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package migrator copies a factomd database from one backend to another, key by key, so a node
+// can switch between Bolt and LevelDB without syncing the blockchain again.
+//
+// The values are copied as raw bytes, never unmarshalled, so a migrated database is exactly the
+// database it was copied from.  LevelDB can't list its buckets, so the buckets copied are the
+// ones the databaseOverlay defines, plus the two buckets of each chain in the ChainHead bucket.
+//
+// Progress is saved to a file after every batch.  Writing a key twice does no harm, so an
+// interrupted migration picks up from the last saved batch.
+package migrator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+)
+
+const DefaultBatchSize = 1000
+
+// Progress is what is saved between runs.  Buckets and keys are in hex.
+type Progress struct {
+	Done    []string // Buckets copied in full
+	Bucket  string   // Bucket being copied
+	LastKey string   // Last key of Bucket that was written
+	Keys    int      // Keys written so far, over all buckets
+}
+
+type Migrator struct {
+	From         interfaces.IDatabase
+	To           interfaces.IDatabase
+	ProgressFile string // Where progress is saved; "" to not save it
+	BatchSize    int
+	Out          io.Writer // Progress reports; nil for none
+
+	Progress Progress
+}
+
+func NewMigrator(from interfaces.IDatabase, to interfaces.IDatabase, progressFile string, out io.Writer) *Migrator {
+	m := new(Migrator)
+	m.From = from
+	m.To = to
+	m.ProgressFile = progressFile
+	m.BatchSize = DefaultBatchSize
+	m.Out = out
+	return m
+}
+
+// Buckets returns every bucket in db that factomd writes to, in a fixed order.
+func Buckets(db interfaces.IDatabase) ([][]byte, error) {
+	var names []string
+	for name := range databaseOverlay.ConstantNamesMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buckets [][]byte
+	for _, name := range names {
+		buckets = append(buckets, []byte(name))
+	}
+
+	chains, err := db.ListAllKeys(databaseOverlay.CHAIN_HEAD)
+	if err != nil {
+		return nil, err
+	}
+	for _, chainID := range chains {
+		entries := make([]byte, len(chainID))
+		copy(entries, chainID)
+		numbers := append(append([]byte{}, databaseOverlay.ENTRYBLOCK_CHAIN_NUMBER...), chainID...)
+		buckets = append(buckets, entries, numbers)
+	}
+	return buckets, nil
+}
+
+// BucketName is a printable name for a bucket.
+func BucketName(bucket []byte) string {
+	if name, ok := databaseOverlay.ConstantNamesMap[string(bucket)]; ok {
+		return name
+	}
+	prefix := databaseOverlay.ENTRYBLOCK_CHAIN_NUMBER
+	if len(bucket) > len(prefix) && bytes.Equal(bucket[:len(prefix)], prefix) {
+		return fmt.Sprintf("EntryBlockNumber %x", bucket[len(prefix):])
+	}
+	return fmt.Sprintf("Entries %x", bucket)
+}
+
+// LoadProgress reads the progress of an earlier run, if there was one.
+func (m *Migrator) LoadProgress() error {
+	if m.ProgressFile == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(m.ProgressFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &m.Progress)
+}
+
+func (m *Migrator) saveProgress() error {
+	if m.ProgressFile == "" {
+		return nil
+	}
+	data, err := json.Marshal(m.Progress)
+	if err != nil {
+		return err
+	}
+	// Write then rename, so a crash never leaves half a progress file
+	tmp := m.ProgressFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.ProgressFile)
+}
+
+func (m *Migrator) printf(format string, a ...interface{}) {
+	if m.Out != nil {
+		fmt.Fprintf(m.Out, format, a...)
+	}
+}
+
+func (m *Migrator) isDone(bucket []byte) bool {
+	h := hex.EncodeToString(bucket)
+	for _, d := range m.Progress.Done {
+		if d == h {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy copies every bucket that hasn't been copied yet.
+func (m *Migrator) Copy() error {
+	if err := m.LoadProgress(); err != nil {
+		return err
+	}
+	if m.Progress.Keys > 0 {
+		m.printf("Resuming after %d keys and %d buckets\n", m.Progress.Keys, len(m.Progress.Done))
+	}
+
+	buckets, err := Buckets(m.From)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+
+	// Finish the bucket an earlier run stopped in first, as copying any other would lose its place
+	if m.Progress.Bucket != "" {
+		for i, bucket := range buckets {
+			if hex.EncodeToString(bucket) == m.Progress.Bucket && !m.isDone(bucket) {
+				if err := m.copyBucket(bucket, i, len(buckets)); err != nil {
+					return fmt.Errorf("%s: %s", BucketName(bucket), err.Error())
+				}
+				break
+			}
+		}
+	}
+
+	for i, bucket := range buckets {
+		if m.isDone(bucket) {
+			continue
+		}
+		if err := m.copyBucket(bucket, i, len(buckets)); err != nil {
+			return fmt.Errorf("%s: %s", BucketName(bucket), err.Error())
+		}
+	}
+	m.printf("Copied %d keys in %d buckets in %v\n", m.Progress.Keys, len(buckets), time.Since(start))
+	return nil
+}
+
+func (m *Migrator) copyBucket(bucket []byte, index int, count int) error {
+	keys, err := m.From.ListAllKeys(bucket)
+	if err != nil {
+		return err
+	}
+
+	// Skip what an earlier run already wrote.  Every backend lists its keys in order.
+	h := hex.EncodeToString(bucket)
+	if m.Progress.Bucket == h && m.Progress.LastKey != "" {
+		last, err := hex.DecodeString(m.Progress.LastKey)
+		if err != nil {
+			return err
+		}
+		i := sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], last) > 0 })
+		keys = keys[i:]
+	}
+	m.Progress.Bucket = h
+	m.Progress.LastKey = ""
+
+	lastReport := time.Now()
+	for done := 0; done < len(keys); {
+		end := done + m.BatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var batch []interfaces.Record
+		for _, key := range keys[done:end] {
+			value, err := m.From.Get(bucket, key, new(primitives.ByteSlice))
+			if err != nil {
+				return err
+			}
+			if value == nil {
+				continue // Deleted since we listed it
+			}
+			batch = append(batch, interfaces.Record{Bucket: bucket, Key: key, Data: value})
+		}
+		if err := m.To.PutInBatch(batch); err != nil {
+			return err
+		}
+		m.Progress.LastKey = hex.EncodeToString(keys[end-1])
+		m.Progress.Keys += end - done
+		if err := m.saveProgress(); err != nil {
+			return err
+		}
+		done = end
+
+		if time.Since(lastReport) > 5*time.Second {
+			m.printf("  %s: %d/%d keys\n", BucketName(bucket), done, len(keys))
+			lastReport = time.Now()
+		}
+	}
+
+	m.Progress.Done = append(m.Progress.Done, h)
+	m.Progress.Bucket = ""
+	m.Progress.LastKey = ""
+	if err := m.saveProgress(); err != nil {
+		return err
+	}
+	m.printf("[%d/%d] %s: %d keys\n", index+1, count, BucketName(bucket), len(keys))
+	return nil
+}
+
+// Verify compares every key in every bucket of both databases, and returns the number of
+// differences found: keys missing from the copy, keys whose values differ, and buckets with keys
+// the source doesn't have.  The first few differences in each bucket are reported.
+func (m *Migrator) Verify() (int, error) {
+	buckets, err := Buckets(m.From)
+	if err != nil {
+		return 0, err
+	}
+	bad := 0
+	for i, bucket := range buckets {
+		n, err := m.verifyBucket(bucket)
+		if err != nil {
+			return bad, fmt.Errorf("%s: %s", BucketName(bucket), err.Error())
+		}
+		bad += n
+		if n > 0 {
+			m.printf("[%d/%d] %s: %d differences\n", i+1, len(buckets), BucketName(bucket), n)
+		}
+	}
+	m.printf("Verified %d buckets, %d differences\n", len(buckets), bad)
+	return bad, nil
+}
+
+func (m *Migrator) verifyBucket(bucket []byte) (int, error) {
+	fromKeys, err := m.From.ListAllKeys(bucket)
+	if err != nil {
+		return 0, err
+	}
+	toKeys, err := m.To.ListAllKeys(bucket)
+	if err != nil {
+		return 0, err
+	}
+
+	bad := 0
+	report := func(format string, a ...interface{}) {
+		bad++
+		if bad <= 10 {
+			m.printf("  "+BucketName(bucket)+": "+format+"\n", a...)
+		}
+	}
+
+	for _, key := range fromKeys {
+		a, err := m.From.Get(bucket, key, new(primitives.ByteSlice))
+		if err != nil {
+			return bad, err
+		}
+		b, err := m.To.Get(bucket, key, new(primitives.ByteSlice))
+		if err != nil {
+			return bad, err
+		}
+		if b == nil {
+			report("key %x is missing", key)
+			continue
+		}
+		if a != nil && !bytes.Equal(a.(*primitives.ByteSlice).Bytes, b.(*primitives.ByteSlice).Bytes) {
+			report("key %x differs", key)
+		}
+	}
+	if len(toKeys) > len(fromKeys) {
+		report("%d keys more than the source", len(toKeys)-len(fromKeys))
+	}
+	return bad, nil
+}
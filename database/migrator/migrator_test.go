@@ -0,0 +1,87 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package migrator_test
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+	. "github.com/FactomProject/factomd/database/migrator"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	progress := filepath.Join(dir, "progress.json")
+
+	from := testHelper.CreateAndPopulateTestDatabaseOverlay().DB
+	to := new(mapdb.MapDB)
+	to.Init(nil)
+
+	m := NewMigrator(from, to, progress, nil)
+	m.BatchSize = 7
+	if err := m.Copy(); err != nil {
+		t.Fatal(err)
+	}
+	if m.Progress.Keys == 0 {
+		t.Fatal("Nothing was copied")
+	}
+	if bad, err := m.Verify(); err != nil || bad != 0 {
+		t.Errorf("Verify found %d differences, %v", bad, err)
+	}
+
+	// Running again picks up where the first run stopped, which was the end
+	again := NewMigrator(from, to, progress, nil)
+	if err := again.Copy(); err != nil {
+		t.Fatal(err)
+	}
+	if again.Progress.Keys != m.Progress.Keys {
+		t.Errorf("Expected nothing more to copy, but copied %d keys", again.Progress.Keys-m.Progress.Keys)
+	}
+
+	keys, err := to.ListAllKeys(databaseOverlay.DIRECTORYBLOCK)
+	if err != nil || len(keys) == 0 {
+		t.Fatalf("No directory blocks were copied, %v", err)
+	}
+	to.Delete(databaseOverlay.DIRECTORYBLOCK, keys[0])
+	if bad, _ := m.Verify(); bad != 1 {
+		t.Errorf("Expected the missing directory block to be found, found %d differences", bad)
+	}
+}
+
+func TestResumeMigration(t *testing.T) {
+	from := testHelper.CreateAndPopulateTestDatabaseOverlay().DB
+	keys, err := from.ListAllKeys(databaseOverlay.DIRECTORYBLOCK)
+	if err != nil || len(keys) < 2 {
+		t.Fatalf("Expected test directory blocks, %v", err)
+	}
+
+	// As if a run had stopped after writing the first directory block
+	to := new(mapdb.MapDB)
+	to.Init(nil)
+	m := NewMigrator(from, to, "", nil)
+	m.Progress.Bucket = hex.EncodeToString(databaseOverlay.DIRECTORYBLOCK)
+	m.Progress.LastKey = hex.EncodeToString(keys[0])
+	if err := m.Copy(); err != nil {
+		t.Fatal(err)
+	}
+
+	copied, err := to.ListAllKeys(databaseOverlay.DIRECTORYBLOCK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(copied) != len(keys)-1 {
+		t.Errorf("Expected the first directory block to be skipped, copied %d of %d", len(copied), len(keys))
+	}
+}
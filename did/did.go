@@ -0,0 +1,126 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package did resolves DIDs of the factom method, did:factom:<chain ID>, to DID documents, by
+// walking the chain the DID names and applying its entries by the method's rules:
+//
+// The first entry of the chain creates the DID.  Its external IDs are "DIDManagement" and the
+// entry schema version, "1.0.0", and its content is the initial document, in JSON:
+//
+//	{"didMethodVersion": "0.2.0",
+//	 "managementKey": [{"id", "type", "controller", "publicKeyBase58", "priority"}],
+//	 "didKey": [{"id", "type", "controller", "publicKeyBase58", "purpose", "priorityRequirement"}],
+//	 "service": [{"id", "type", "serviceEndpoint", "priorityRequirement"}]}
+//
+// Management keys control the DID, and the lower its priority, the more a key may do; there must
+// be one of priority 0.  DID keys are the keys of the DID's subject, with purposes
+// "publicKey" and "authentication".  IDs may be given whole, or as fragments of the DID.
+//
+// Every later entry is signed by a management key: its external IDs are the entry type, the
+// schema version "1.0.0", the ID of the key, and the Ed25519 signature of the sha256 of the type,
+// the version, the key ID and the content, one after another.  The types are
+//
+//	DIDUpdate                {"revoke": {"managementKey": [{"id"}], "didKey": [{"id"}], "service": [{"id"}]},
+//	                          "add": {"managementKey": [...], "didKey": [...], "service": [...]}}
+//	DIDMethodVersionUpgrade  {"didMethodVersion": "<version>"}
+//	DIDDeactivation          no content; only a key of priority 0 may deactivate a DID
+//
+// An update may only revoke or add management keys of the priority of its signer or higher
+// numbers, and only revoke or add DID keys and services whose priorityRequirement its signer
+// meets.  An ID can only be used once, even after what it named has been revoked, and an update
+// can't leave the DID without a management key of priority 0.  An entry that breaks a rule, or
+// isn't well formed, is skipped whole, and nothing after a deactivation is applied.
+package did
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+const Prefix = "did:factom:"
+
+// The entry types of the method, and the version of the entry schema
+const (
+	EntryCreate        = "DIDManagement"
+	EntryUpdate        = "DIDUpdate"
+	EntryUpgrade       = "DIDMethodVersionUpgrade"
+	EntryDeactivate    = "DIDDeactivation"
+	EntrySchemaVersion = "1.0.0"
+)
+
+var (
+	ErrInvalidDID = errors.New("invalidDid")
+	ErrNotFound   = errors.New("notFound")
+)
+
+// The JSON-LD context of the documents, and of the resolution results
+const (
+	DocumentContext   = "https://www.w3.org/ns/did/v1"
+	ResolutionContext = "https://w3id.org/did-resolution/v1"
+)
+
+// Document is a DID document, as the W3C DID Core specification has it.
+type Document struct {
+	Context              string               `json:"@context"`
+	ID                   string               `json:"id"`
+	VerificationMethod   []VerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []string             `json:"authentication,omitempty"`
+	AssertionMethod      []string             `json:"assertionMethod,omitempty"`
+	CapabilityInvocation []string             `json:"capabilityInvocation,omitempty"` // The management keys
+	Service              []Service            `json:"service,omitempty"`
+}
+
+type VerificationMethod struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	Controller      string `json:"controller"`
+	PublicKeyBase58 string `json:"publicKeyBase58"`
+}
+
+type Service struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// DocumentMetadata says what became of the DID.
+type DocumentMetadata struct {
+	Deactivated   bool   `json:"deactivated,omitempty"`
+	VersionID     string `json:"versionId"`     // The hash of the last entry applied
+	MethodVersion string `json:"methodVersion"` // The didMethodVersion
+	Applied       int    `json:"applied"`       // The entries applied, counting the first
+	Skipped       int    `json:"skipped"`       // The entries skipped as breaking the rules
+}
+
+type ResolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Result is a DID resolution result, as the W3C DID Resolution specification has it.
+type Result struct {
+	Context            string             `json:"@context"`
+	Document           *Document          `json:"didDocument"`
+	ResolutionMetadata ResolutionMetadata `json:"didResolutionMetadata"`
+	DocumentMetadata   *DocumentMetadata  `json:"didDocumentMetadata"`
+}
+
+// Parse returns the chain ID of a factom DID.
+func Parse(did string) (interfaces.IHash, error) {
+	if !strings.HasPrefix(did, Prefix) {
+		return nil, ErrInvalidDID
+	}
+	id := did[len(Prefix):]
+	if len(id) != 64 {
+		return nil, ErrInvalidDID
+	}
+	if _, err := hex.DecodeString(id); err != nil {
+		return nil, ErrInvalidDID
+	}
+	return primitives.HexToHash(id)
+}
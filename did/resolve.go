@@ -0,0 +1,438 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package did
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/FactomProject/btcutil/base58"
+	"github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// A key or service of an entry, or of the DID
+type element struct {
+	ID                  string   `json:"id"`
+	Type                string   `json:"type,omitempty"`
+	Controller          string   `json:"controller,omitempty"`
+	PublicKeyBase58     string   `json:"publicKeyBase58,omitempty"`
+	Priority            *int     `json:"priority,omitempty"`            // Management keys
+	Purpose             []string `json:"purpose,omitempty"`             // DID keys
+	PriorityRequirement *int     `json:"priorityRequirement,omitempty"` // DID keys and services
+	ServiceEndpoint     string   `json:"serviceEndpoint,omitempty"`     // Services
+}
+
+type elements struct {
+	ManagementKey []element `json:"managementKey"`
+	DIDKey        []element `json:"didKey"`
+	Service       []element `json:"service"`
+}
+
+type createContent struct {
+	DIDMethodVersion string `json:"didMethodVersion"`
+	elements
+}
+
+type updateContent struct {
+	Revoke elements `json:"revoke"`
+	Add    elements `json:"add"`
+}
+
+type upgradeContent struct {
+	DIDMethodVersion string `json:"didMethodVersion"`
+}
+
+// What a DID is, as its entries are applied
+type didState struct {
+	did           string
+	methodVersion string
+	management    map[string]element
+	didKeys       map[string]element
+	services      map[string]element
+	order         []string        // The IDs in use, in the order they were added
+	used          map[string]bool // Every ID ever used
+	deactivated   bool
+}
+
+// Resolve resolves a DID from the chain in the database it names.  It returns ErrInvalidDID if
+// it isn't a factom DID, and ErrNotFound if there is no such chain, or it doesn't start by
+// creating a DID.
+func Resolve(dbase interfaces.DBOverlaySimple, did string) (*Result, error) {
+	chainID, err := Parse(did)
+	if err != nil {
+		return nil, err
+	}
+	head, err := dbase.FetchEBlockHead(chainID)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, ErrNotFound
+	}
+
+	// Walk back to the first entry block, then apply the entries from it
+	blocks := []interfaces.IEntryBlock{head}
+	for {
+		prev := blocks[len(blocks)-1].GetHeader().GetPrevKeyMR()
+		if prev == nil || prev.IsZero() {
+			break
+		}
+		eblk, err := dbase.FetchEBlock(prev)
+		if err != nil {
+			return nil, err
+		}
+		if eblk == nil {
+			return nil, fmt.Errorf("Entry block %s of chain %s is missing", prev.String(), chainID.String())
+		}
+		blocks = append(blocks, eblk)
+	}
+	var entries []interfaces.IEBEntry
+	for i := len(blocks) - 1; i >= 0; i-- {
+		for _, entryHash := range blocks[i].GetEntryHashes() {
+			if entryHash.IsMinuteMarker() {
+				continue
+			}
+			entry, err := dbase.FetchEntry(entryHash)
+			if err != nil {
+				return nil, err
+			}
+			if entry == nil {
+				return nil, fmt.Errorf("Entry %s of chain %s is missing", entryHash.String(), chainID.String())
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return ResolveEntries(Prefix+chainID.String(), entries)
+}
+
+// ResolveEntries resolves a DID from the entries of its chain, oldest first.
+func ResolveEntries(did string, entries []interfaces.IEBEntry) (*Result, error) {
+	if len(entries) == 0 {
+		return nil, ErrNotFound
+	}
+	d, err := create(did, entries[0])
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	metadata := &DocumentMetadata{VersionID: entries[0].GetHash().String(), Applied: 1}
+	for _, entry := range entries[1:] {
+		if d.deactivated {
+			break
+		}
+		if d.apply(entry) == nil {
+			metadata.VersionID = entry.GetHash().String()
+			metadata.Applied++
+		} else {
+			metadata.Skipped++
+		}
+	}
+	metadata.MethodVersion = d.methodVersion
+	metadata.Deactivated = d.deactivated
+
+	result := new(Result)
+	result.Context = ResolutionContext
+	result.Document = d.document()
+	result.ResolutionMetadata.ContentType = "application/did+ld+json"
+	result.DocumentMetadata = metadata
+	return result, nil
+}
+
+func create(did string, entry interfaces.IEBEntry) (*didState, error) {
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) < 2 || string(extIDs[0]) != EntryCreate || string(extIDs[1]) != EntrySchemaVersion {
+		return nil, fmt.Errorf("The first entry doesn't create a DID")
+	}
+	content := new(createContent)
+	if err := json.Unmarshal(entry.GetContent(), content); err != nil {
+		return nil, err
+	}
+	if content.DIDMethodVersion == "" {
+		return nil, fmt.Errorf("No didMethodVersion")
+	}
+
+	d := new(didState)
+	d.did = did
+	d.methodVersion = content.DIDMethodVersion
+	d.management = make(map[string]element)
+	d.didKeys = make(map[string]element)
+	d.services = make(map[string]element)
+	d.used = make(map[string]bool)
+	if err := d.add(content.elements); err != nil {
+		return nil, err
+	}
+	if !d.hasMasterKey() {
+		return nil, fmt.Errorf("No management key of priority 0")
+	}
+	return d, nil
+}
+
+// The full ID of an element, from its full ID or its fragment
+func (d *didState) fullID(id string) string {
+	if strings.HasPrefix(id, d.did+"#") {
+		return id
+	}
+	return d.did + "#" + strings.TrimPrefix(id, "#")
+}
+
+func (d *didState) hasMasterKey() bool {
+	for _, key := range d.management {
+		if *key.Priority == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func checkKey(key element) error {
+	if len(base58.Decode(key.PublicKeyBase58)) != ed25519.PublicKeySize {
+		return fmt.Errorf("Key %s is not an Ed25519 public key", key.ID)
+	}
+	return nil
+}
+
+// add adds elements, all of them or none.
+func (d *didState) add(e elements) error {
+	ids := make(map[string]bool)
+	check := func(el element) (string, error) {
+		if el.ID == "" {
+			return "", fmt.Errorf("An element has no ID")
+		}
+		id := d.fullID(el.ID)
+		if d.used[id] || ids[id] {
+			return "", fmt.Errorf("ID %s is already used", id)
+		}
+		ids[id] = true
+		return id, nil
+	}
+	for _, key := range e.ManagementKey {
+		if _, err := check(key); err != nil {
+			return err
+		}
+		if key.Priority == nil || *key.Priority < 0 {
+			return fmt.Errorf("Management key %s has no priority", key.ID)
+		}
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+	for _, key := range e.DIDKey {
+		if _, err := check(key); err != nil {
+			return err
+		}
+		if err := checkKey(key); err != nil {
+			return err
+		}
+		if len(key.Purpose) == 0 {
+			return fmt.Errorf("DID key %s has no purpose", key.ID)
+		}
+		for _, purpose := range key.Purpose {
+			if purpose != "publicKey" && purpose != "authentication" {
+				return fmt.Errorf("DID key %s has purpose %q", key.ID, purpose)
+			}
+		}
+	}
+	for _, service := range e.Service {
+		if _, err := check(service); err != nil {
+			return err
+		}
+		if service.ServiceEndpoint == "" {
+			return fmt.Errorf("Service %s has no endpoint", service.ID)
+		}
+	}
+
+	put := func(m map[string]element, el element) {
+		el.ID = d.fullID(el.ID)
+		if el.Controller == "" {
+			el.Controller = d.did
+		}
+		m[el.ID] = el
+		d.used[el.ID] = true
+		d.order = append(d.order, el.ID)
+	}
+	for _, key := range e.ManagementKey {
+		put(d.management, key)
+	}
+	for _, key := range e.DIDKey {
+		put(d.didKeys, key)
+	}
+	for _, service := range e.Service {
+		put(d.services, service)
+	}
+	return nil
+}
+
+// signer checks the signature of an entry after the first, and returns its key.
+func (d *didState) signer(entry interfaces.IEBEntry) (element, error) {
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) != 4 || string(extIDs[1]) != EntrySchemaVersion {
+		return element{}, fmt.Errorf("Bad external IDs")
+	}
+	key, ok := d.management[d.fullID(string(extIDs[2]))]
+	if !ok {
+		return element{}, fmt.Errorf("Signed by %s, which isn't a management key", string(extIDs[2]))
+	}
+	if len(extIDs[3]) != ed25519.SignatureSize {
+		return element{}, fmt.Errorf("Bad signature")
+	}
+
+	signed := sha256.New()
+	signed.Write(extIDs[0])
+	signed.Write(extIDs[1])
+	signed.Write(extIDs[2])
+	signed.Write(entry.GetContent())
+	var pub [ed25519.PublicKeySize]byte
+	var sig [ed25519.SignatureSize]byte
+	copy(pub[:], base58.Decode(key.PublicKeyBase58))
+	copy(sig[:], extIDs[3])
+	if !ed25519.VerifyCanonical(&pub, signed.Sum(nil), &sig) {
+		return element{}, fmt.Errorf("Bad signature")
+	}
+	return key, nil
+}
+
+// apply applies an entry after the first, all of it or none.
+func (d *didState) apply(entry interfaces.IEBEntry) error {
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) == 0 {
+		return fmt.Errorf("No entry type")
+	}
+	key, err := d.signer(entry)
+	if err != nil {
+		return err
+	}
+	priority := *key.Priority
+
+	switch string(extIDs[0]) {
+	case EntryUpdate:
+		content := new(updateContent)
+		if err := json.Unmarshal(entry.GetContent(), content); err != nil {
+			return err
+		}
+		return d.update(priority, content)
+	case EntryUpgrade:
+		content := new(upgradeContent)
+		if err := json.Unmarshal(entry.GetContent(), content); err != nil {
+			return err
+		}
+		if content.DIDMethodVersion == "" || content.DIDMethodVersion == d.methodVersion {
+			return fmt.Errorf("No new didMethodVersion")
+		}
+		d.methodVersion = content.DIDMethodVersion
+		return nil
+	case EntryDeactivate:
+		if priority != 0 {
+			return fmt.Errorf("Deactivated by a key of priority %d", priority)
+		}
+		d.deactivated = true
+		return nil
+	}
+	return fmt.Errorf("Unknown entry type %q", string(extIDs[0]))
+}
+
+func (d *didState) update(priority int, content *updateContent) error {
+	// Whether a key of this priority may change an element
+	allowed := func(el element) bool {
+		if el.Priority != nil && *el.Priority < priority {
+			return false
+		}
+		return el.PriorityRequirement == nil || priority <= *el.PriorityRequirement
+	}
+
+	// Work on a copy, so a failed update leaves the DID as it was
+	next := *d
+	next.management = copyElements(d.management)
+	next.didKeys = copyElements(d.didKeys)
+	next.services = copyElements(d.services)
+	next.order = append([]string{}, d.order...)
+	next.used = make(map[string]bool)
+	for id := range d.used {
+		next.used[id] = true
+	}
+
+	revoke := func(m map[string]element, els []element) error {
+		for _, el := range els {
+			id := d.fullID(el.ID)
+			current, ok := m[id]
+			if !ok {
+				return fmt.Errorf("%s can't be revoked, it isn't there", id)
+			}
+			if !allowed(current) {
+				return fmt.Errorf("%s can't be revoked by a key of priority %d", id, priority)
+			}
+			delete(m, id)
+		}
+		return nil
+	}
+	if err := revoke(next.management, content.Revoke.ManagementKey); err != nil {
+		return err
+	}
+	if err := revoke(next.didKeys, content.Revoke.DIDKey); err != nil {
+		return err
+	}
+	if err := revoke(next.services, content.Revoke.Service); err != nil {
+		return err
+	}
+
+	for _, els := range [][]element{content.Add.ManagementKey, content.Add.DIDKey, content.Add.Service} {
+		for _, el := range els {
+			if !allowed(el) {
+				return fmt.Errorf("%s can't be added by a key of priority %d", el.ID, priority)
+			}
+		}
+	}
+	if err := next.add(content.Add); err != nil {
+		return err
+	}
+	if !next.hasMasterKey() {
+		return fmt.Errorf("The update leaves no management key of priority 0")
+	}
+	*d = next
+	return nil
+}
+
+func copyElements(m map[string]element) map[string]element {
+	c := make(map[string]element, len(m))
+	for id, el := range m {
+		c[id] = el
+	}
+	return c
+}
+
+// document builds the DID document, listing keys and services in the order they were added.
+func (d *didState) document() *Document {
+	doc := new(Document)
+	doc.Context = DocumentContext
+	doc.ID = d.did
+	if d.deactivated {
+		return doc
+	}
+	method := func(key element) VerificationMethod {
+		return VerificationMethod{ID: key.ID, Type: key.Type, Controller: key.Controller, PublicKeyBase58: key.PublicKeyBase58}
+	}
+	for _, id := range d.order {
+		if key, ok := d.management[id]; ok {
+			doc.VerificationMethod = append(doc.VerificationMethod, method(key))
+			doc.CapabilityInvocation = append(doc.CapabilityInvocation, id)
+		}
+		if key, ok := d.didKeys[id]; ok {
+			doc.VerificationMethod = append(doc.VerificationMethod, method(key))
+			for _, purpose := range key.Purpose {
+				switch purpose {
+				case "publicKey":
+					doc.AssertionMethod = append(doc.AssertionMethod, id)
+				case "authentication":
+					doc.Authentication = append(doc.Authentication, id)
+				}
+			}
+		}
+		if service, ok := d.services[id]; ok {
+			doc.Service = append(doc.Service, Service{ID: service.ID, Type: service.Type, ServiceEndpoint: service.ServiceEndpoint})
+		}
+	}
+	return doc
+}
@@ -0,0 +1,132 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package did_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/FactomProject/btcutil/base58"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/did"
+)
+
+const testDID = Prefix + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newKey(t *testing.T) *primitives.PrivateKey {
+	key := new(primitives.PrivateKey)
+	if err := key.GenerateKey(); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func pub(key *primitives.PrivateKey) string {
+	return base58.Encode(key.Pub[:])
+}
+
+func newEntry(content string, extIDs ...string) interfaces.IEBEntry {
+	entry := entryBlock.NewEntry()
+	for _, extID := range extIDs {
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: []byte(extID)})
+	}
+	entry.Content = primitives.ByteSlice{Bytes: []byte(content)}
+	return entry
+}
+
+func signedEntry(key *primitives.PrivateKey, keyID string, entryType string, content string) interfaces.IEBEntry {
+	signed := sha256.New()
+	signed.Write([]byte(entryType + EntrySchemaVersion + keyID + content))
+	sig := key.Sign(signed.Sum(nil)).GetSignature()
+	return newEntry(content, entryType, EntrySchemaVersion, keyID, string(sig[:]))
+}
+
+func TestResolve(t *testing.T) {
+	master, manager, auth := newKey(t), newKey(t), newKey(t)
+	create := newEntry(fmt.Sprintf(`{"didMethodVersion": "0.2.0",
+		"managementKey": [
+			{"id": "management-0", "type": "Ed25519VerificationKey", "publicKeyBase58": %q, "priority": 0},
+			{"id": "management-1", "type": "Ed25519VerificationKey", "publicKeyBase58": %q, "priority": 1}],
+		"didKey": [{"id": "public-0", "type": "Ed25519VerificationKey", "publicKeyBase58": %q,
+			"purpose": ["publicKey", "authentication"], "priorityRequirement": 1}],
+		"service": [{"id": "hub", "type": "IdentityHub", "serviceEndpoint": "https://hub.example.com"}]}`,
+		pub(master), pub(manager), pub(auth)), EntryCreate, EntrySchemaVersion)
+
+	entries := []interfaces.IEBEntry{create}
+	resolve := func() *Result {
+		result, err := ResolveEntries(testDID, entries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	result := resolve()
+	doc := result.Document
+	if doc.ID != testDID || len(doc.VerificationMethod) != 3 || len(doc.Service) != 1 {
+		t.Fatalf("Resolved %+v", doc)
+	}
+	if doc.VerificationMethod[0].ID != testDID+"#management-0" || doc.VerificationMethod[0].Controller != testDID {
+		t.Errorf("The first key is %+v", doc.VerificationMethod[0])
+	}
+	if len(doc.Authentication) != 1 || doc.Authentication[0] != testDID+"#public-0" {
+		t.Errorf("Authentication is %v", doc.Authentication)
+	}
+
+	// The key of priority 1 may revoke the DID key, but not the key of priority 0
+	entries = append(entries, signedEntry(manager, "management-1", EntryUpdate,
+		`{"revoke": {"managementKey": [{"id": "management-0"}]}}`))
+	entries = append(entries, signedEntry(manager, testDID+"#management-1", EntryUpdate,
+		`{"revoke": {"didKey": [{"id": "public-0"}]}}`))
+	// A bad signature
+	entries = append(entries, signedEntry(auth, "management-1", EntryUpdate,
+		`{"revoke": {"service": [{"id": "hub"}]}}`))
+	result = resolve()
+	if result.DocumentMetadata.Applied != 2 || result.DocumentMetadata.Skipped != 2 {
+		t.Errorf("Applied %d entries and skipped %d, expected 2 and 2", result.DocumentMetadata.Applied, result.DocumentMetadata.Skipped)
+	}
+	if len(result.Document.VerificationMethod) != 2 || len(result.Document.Authentication) != 0 {
+		t.Errorf("After the updates, resolved %+v", result.Document)
+	}
+
+	// A revoked ID can't be used again
+	entries = append(entries, signedEntry(master, "management-0", EntryUpdate,
+		fmt.Sprintf(`{"add": {"didKey": [{"id": "public-0", "type": "Ed25519VerificationKey", "publicKeyBase58": %q, "purpose": ["publicKey"]}]}}`, pub(auth))))
+	entries = append(entries, signedEntry(master, "management-0", EntryUpgrade, `{"didMethodVersion": "0.3.0"}`))
+	result = resolve()
+	if len(result.Document.AssertionMethod) != 0 || result.DocumentMetadata.MethodVersion != "0.3.0" {
+		t.Errorf("After the upgrade, resolved %+v, %+v", result.Document, result.DocumentMetadata)
+	}
+
+	// Only the key of priority 0 may deactivate the DID
+	entries = append(entries, signedEntry(manager, "management-1", EntryDeactivate, ""))
+	if resolve().DocumentMetadata.Deactivated {
+		t.Errorf("Deactivated by a key of priority 1")
+	}
+	entries = append(entries, signedEntry(master, "management-0", EntryDeactivate, ""))
+	result = resolve()
+	if !result.DocumentMetadata.Deactivated || len(result.Document.VerificationMethod) != 0 {
+		t.Errorf("After deactivation, resolved %+v, %+v", result.Document, result.DocumentMetadata)
+	}
+}
+
+func TestResolveNotADID(t *testing.T) {
+	if _, err := Parse("did:factom:1234"); err != ErrInvalidDID {
+		t.Errorf("Parsed a short DID")
+	}
+	if _, err := Parse("did:example:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"); err != ErrInvalidDID {
+		t.Errorf("Parsed a DID of another method")
+	}
+	if _, err := ResolveEntries(testDID, []interfaces.IEBEntry{newEntry("{}", "Identity Chain")}); err != ErrNotFound {
+		t.Errorf("Resolved a chain that isn't a DID")
+	}
+	// No management key of priority 0
+	if _, err := ResolveEntries(testDID, []interfaces.IEBEntry{newEntry(`{"didMethodVersion": "0.2.0"}`, EntryCreate, EntrySchemaVersion)}); err != ErrNotFound {
+		t.Errorf("Resolved a DID without a management key")
+	}
+}
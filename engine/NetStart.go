@@ -7,22 +7,25 @@ package engine
 import (
 	"bytes"
 	"encoding/binary"
-	"flag"
 	"fmt"
 	"os"
+	"runtime"
 	"time"
 
 	"math"
 
 	"bufio"
 
+	"github.com/FactomProject/factomd/clock"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/conformance"
 	"github.com/FactomProject/factomd/controlPanel"
 	"github.com/FactomProject/factomd/database/leveldb"
 	"github.com/FactomProject/factomd/p2p"
 	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/tokenIndex"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/wsapi"
 )
@@ -30,177 +33,54 @@ import (
 var _ = fmt.Print
 
 type FactomNode struct {
-	Index int
-	State *state.State
-	Peers []interfaces.IPeer
-	MLog  *MsgLog
+	Index  int
+	State  *state.State
+	Peers  []interfaces.IPeer
+	MLog   *MsgLog
+	Router *MsgRouter // What the messages from the peers go through; see router.go
 }
 
+// The simulator's nodes and network.  A node embedded with Start (see embed.go) keeps its own.
 var fnodes []*FactomNode
 var mLog = new(MsgLog)
 var p2pProxy *P2PProxy
 var p2pNetwork *p2p.Controller
-var logPort string
+var simControlPanel *controlPanel.ControlPanel
 
 func NetStart(s *state.State) {
-	ackBalanceHashPtr := flag.Bool("balancehash", true, "If false, then don't pass around balance hashes")
-	enablenetPtr := flag.Bool("enablenet", true, "Enable or disable networking")
-	waitEntriesPtr := flag.Bool("waitentries", false, "Wait for Entries to be validated prior to execution of messages")
-	listenToPtr := flag.Int("node", 0, "Node Number the simulator will set as the focus")
-	cntPtr := flag.Int("count", 1, "The number of nodes to generate")
-	netPtr := flag.String("net", "tree", "The default algorithm to build the network connections")
-	fnetPtr := flag.String("fnet", "", "Read the given file to build the network connections")
-	dropPtr := flag.Int("drop", 0, "Number of messages to drop out of every thousand")
-	journalPtr := flag.String("journal", "", "Rerun a Journal of messages")
-	journalingPtr := flag.Bool("journaling", false, "Write a journal of all messages recieved. Default is off.")
-	followerPtr := flag.Bool("follower", false, "If true, force node to be a follower.  Only used when replaying a journal.")
-	leaderPtr := flag.Bool("leader", true, "If true, force node to be a leader.  Only used when replaying a journal.")
-	dbPtr := flag.String("db", "", "Override the Database in the Config file and use this Database implementation")
-	cloneDBPtr := flag.String("clonedb", "", "Override the main node and use this database for the clones in a Network.")
-	portOverridePtr := flag.Int("port", 0, "Address to serve WSAPI on")
-	networkNamePtr := flag.String("network", "", "Network to join: MAIN, TEST or LOCAL")
-	networkPortOverridePtr := flag.Int("networkPort", 0, "Address for p2p network to listen on.")
-	ControlPanelPortOverridePtr := flag.Int("ControlPanelPort", 0, "Address for control panel webserver to listen on.")
-	logportPtr := flag.String("logPort", "6060", "Port for profile logging")
-	peersPtr := flag.String("peers", "", "Array of peer addresses. ")
-	blkTimePtr := flag.Int("blktime", 0, "Seconds per block.  Production is 600.")
-	faultTimeoutPtr := flag.Int("faulttimeout", 60, "Seconds before considering Federated servers at-fault. Default is 60.")
-	runtimeLogPtr := flag.Bool("runtimeLog", false, "If true, maintain runtime logs of messages passed.")
-	netdebugPtr := flag.Int("netdebug", 0, "0-5: 0 = quiet, >0 = increasing levels of logging")
-	exclusivePtr := flag.Bool("exclusive", false, "If true, we only dial out to special/trusted peers.")
-	prefixNodePtr := flag.String("prefix", "", "Prefix the Factom Node Names with this value; used to create leaderless networks.")
-	rotatePtr := flag.Bool("rotate", false, "If true, responsiblity is owned by one leader, and rotated over the leaders.")
-	timeOffsetPtr := flag.Int("timedelta", 0, "Maximum timeDelta in milliseconds to offset each node.  Simulates deltas in system clocks over a network.")
-	keepMismatchPtr := flag.Bool("keepmismatch", false, "If true, do not discard DBStates even when a majority of DBSignatures have a different hash")
-	startDelayPtr := flag.Int("startdelay", 10, "Delay to start processing messages, in seconds")
-	deadlinePtr := flag.Int("deadline", 1000, "Timeout Delay in milliseconds used on Reads and Writes to the network comm")
-	customNetPtr := flag.String("customnet", "", "This string specifies a custom blockchain network ID.")
-	rpcUserflag := flag.String("rpcuser", "", "Username to protect factomd local API with simple HTTP authentication")
-	rpcPasswordflag := flag.String("rpcpass", "", "Password to protect factomd local API. Ignored if rpcuser is blank")
-	factomdTLSflag := flag.Bool("tls", false, "Set to true to require encrypted connections to factomd API and Control Panel") //to get tls, run as "factomd -tls=true"
-	factomdLocationsflag := flag.String("selfaddr", "", "comma seperated IPAddresses and DNS names of this factomd to use when creating a cert file")
-	fastPtr := flag.Bool("fast", true, "If true, factomd will fast-boot from a file.")
-	fastLocationPtr := flag.String("fastlocation", "", "Directory to put the fast-boot file in.")
-	memProfileRate := flag.Int("mpr", 512*1024, "Set the Memory Profile Rate to update profiling per X bytes allocated. Default 512K, set to 1 to profile everything, 0 to disable.")
-	logLvlPtr := flag.String("loglvl", "none", "Set log level to either: debug, info, notice, warning, error, critical, alert, emergency or none")
-	logFilePtr := flag.Bool("logfile", false, "Use to set logging to use a file rather than stdout")
-
-	flag.Parse()
-
-	ackbalanceHash := *ackBalanceHashPtr
-	enableNet := *enablenetPtr
-	waitEntries := *waitEntriesPtr
-	listenTo := *listenToPtr
-	cnt := *cntPtr
-	net := *netPtr
-	fnet := *fnetPtr
-	droprate := *dropPtr
-	journal := *journalPtr
-	journaling := *journalingPtr
-	follower := *followerPtr
-	leader := *leaderPtr
-	db := *dbPtr
-	cloneDB := *cloneDBPtr
-	portOverride := *portOverridePtr
-	peers := *peersPtr
-	networkName := *networkNamePtr
-	networkPortOverride := *networkPortOverridePtr
-	ControlPanelPortOverride := *ControlPanelPortOverridePtr
-	logPort = *logportPtr
-	blkTime := *blkTimePtr
-	faultTimeout := *faultTimeoutPtr
-	runtimeLog := *runtimeLogPtr
-	netdebug := *netdebugPtr
-	exclusive := *exclusivePtr
-	prefix := *prefixNodePtr
-	rotate := *rotatePtr
-	timeOffset := *timeOffsetPtr
-	keepMismatch := *keepMismatchPtr
-	startDelay := int64(*startDelayPtr)
-	deadline := *deadlinePtr
-	customNet := primitives.Sha([]byte(*customNetPtr)).Bytes()[:4]
-	rpcUser := *rpcUserflag
-	rpcPassword := *rpcPasswordflag
-	factomdTLS := *factomdTLSflag
-	factomdLocations := *factomdLocationsflag
-	fast := *fastPtr
-	logLvl := *logLvlPtr
-	logFile := *logFilePtr
-
-	messages.AckBalanceHash = ackbalanceHash
-	// Must add the prefix before loading the configuration.
-	s.AddPrefix(prefix)
-	FactomConfigFilename := util.GetConfigFilename("m2")
-	fmt.Println(fmt.Sprintf("factom config: %s", FactomConfigFilename))
-	s.LoadConfig(FactomConfigFilename, networkName)
-	s.OneLeader = rotate
-	s.TimeOffset = primitives.NewTimestampFromMilliseconds(uint64(timeOffset))
-	s.StartDelayLimit = startDelay * 1000
-	s.Journaling = journaling
-	s.LogLevel = logLvl
-
-	if !logFile {
-		s.LogPath = "stdout"
+	cfg, err := ParseFlags(os.Args[1:])
+	if err != nil {
+		os.Exit(2)
 	}
 
-	// Set the wait for entries flag
-	s.WaitForEntries = waitEntries
-
-	if 999 < portOverride { // The command line flag exists and seems reasonable.
-		s.SetPort(portOverride)
-	}
-	if 999 < ControlPanelPortOverride { // The command line flag exists and seems reasonable.
-		s.ControlPanelPort = ControlPanelPortOverride
+	if cfg.Conformance {
+		failed, err := conformance.Run(cfg.ConformanceCorpus, os.Stdout)
+		if err != nil {
+			fmt.Println("Conformance:", err)
+			os.Exit(2)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+		os.Exit(0)
 	}
 
-	if blkTime > 0 {
-		s.DirectoryBlockInSeconds = blkTime
-	} else {
-		blkTime = s.DirectoryBlockInSeconds
+	if err := cfg.check(); err != nil {
+		panic(err.Error())
 	}
 
-	s.FaultTimeout = faultTimeout
-
-	if follower {
-		leader = false
-	}
-	if leader {
-		follower = false
-	}
-	if !follower && !leader {
-		panic("Not a leader or a follower")
-	}
+	listenTo := cfg.ListenTo
+	cnt := cfg.Count
+	net := cfg.Net
+	fnet := cfg.Fnet
+	journal := cfg.Journal
 
 	if journal != "" {
 		cnt = 1
 	}
 
-	if rpcUser != "" {
-		s.RpcUser = rpcUser
-	}
-
-	if rpcPassword != "" {
-		s.RpcPass = rpcPassword
-	}
-
-	if factomdTLS == true {
-		s.FactomdTLSEnable = true
-	}
-
-	if factomdLocations != "" {
-		if len(s.FactomdLocations) > 0 {
-			s.FactomdLocations += ","
-		}
-		s.FactomdLocations += factomdLocations
-	}
-
-	if fast == false {
-		s.StateSaverStruct.FastBoot = false
-	}
-	if fastLocationPtr != nil {
-		if *fastLocationPtr != "" {
-			s.StateSaverStruct.FastBootLocation = *fastLocationPtr
-		}
+	if err := applyConfig(s, cfg); err != nil {
+		panic(err.Error())
 	}
 
 	fmt.Println(">>>>>>>>>>>>>>>>")
@@ -212,47 +92,15 @@ func NetStart(s *state.State) {
 	AddInterruptHandler(func() {
 		fmt.Print("<Break>\n")
 		fmt.Print("Gracefully shutting down the server...\n")
-		for _, fnode := range fnodes {
-			fmt.Print("Shutting Down: ", fnode.State.FactomNodeName, "\r\n")
-			fnode.State.ShutdownChan <- 0
-		}
-		if enableNet {
+		shutdownNodes(fnodes)
+		if cfg.EnableNet {
 			p2pNetwork.NetworkStop()
 			// NODE_TALK_FIX
 			p2pProxy.stopProxy()
 		}
-		fmt.Print("Waiting...\r\n")
-		time.Sleep(3 * time.Second)
 		os.Exit(0)
 	})
-
-	if journal != "" {
-		if s.DBType != "Map" {
-			fmt.Println("Journal is ALWAYS a Map database")
-			s.DBType = "Map"
-		}
-	}
-	if follower {
-		s.NodeMode = "FULL"
-		leadID := primitives.Sha([]byte(s.Prefix + "FNode0"))
-		if s.IdentityChainID.IsSameAs(leadID) {
-			s.SetIdentityChainID(primitives.Sha([]byte(time.Now().String()))) // Make sure this node is NOT a leader
-		}
-	}
-
-	s.KeepMismatch = keepMismatch
-
-	if len(db) > 0 {
-		s.DBType = db
-	} else {
-		db = s.DBType
-	}
-
-	if len(cloneDB) > 0 {
-		s.CloneDBType = cloneDB
-	} else {
-		s.CloneDBType = db
-	}
+	handleStateDumpSignal()
 
 	pnet := net
 	if len(fnet) > 0 {
@@ -260,49 +108,68 @@ func NetStart(s *state.State) {
 		net = "file"
 	}
 
-	go StartProfiler(*memProfileRate)
-
-	s.AddPrefix(prefix)
+	s.AddPrefix(cfg.Prefix)
 	s.SetOut(false)
 	s.Init()
-	s.SetDropRate(droprate)
+	s.Supervisor.GoOnce("profiler", func() { StartProfiler(cfg.MemProfileRate, cfg.LogPort) })
+	s.SetDropRate(cfg.DropRate)
 
-	mLog.Init(runtimeLog, cnt)
+	mLog.Init(cfg.RuntimeLog, cnt)
 
 	setupFirstAuthority(s)
 
 	os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "Build", Build))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "balancehash", messages.AckBalanceHash))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "FNode 0 Salt", s.Salt.String()[:16]))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "enablenet", enableNet))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "waitentries", waitEntries))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "enablenet", cfg.EnableNet))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "waitentries", cfg.WaitEntries))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "node", listenTo))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "prefix", prefix))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "prefix", cfg.Prefix))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "node count", cnt))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "net spec", pnet))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "Msgs droped", droprate))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "Msgs droped", cfg.DropRate))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "journal", journal))
-	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database", db))
-	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database for clones", cloneDB))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database", s.DBType))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database for clones", cfg.CloneDB))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%d\"\n", "port", s.PortNumber))
-	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "peers", peers))
-	os.Stderr.WriteString(fmt.Sprintf("%20s \"%d\"\n", "netdebug", netdebug))
-	os.Stderr.WriteString(fmt.Sprintf("%20s \"%t\"\n", "exclusive", exclusive))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "block time", blkTime))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "faultTimeout", faultTimeout))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "runtimeLog", runtimeLog))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "rotate", rotate))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "timeOffset", timeOffset))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "keepMismatch", keepMismatch))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "startDelay", startDelay))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "peers", cfg.Peers))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%d\"\n", "netdebug", cfg.NetDebug))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%t\"\n", "exclusive", cfg.Exclusive))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "block time", s.DirectoryBlockInSeconds))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "faultTimeout", cfg.FaultTimeout))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "runtimeLog", cfg.RuntimeLog))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "rotate", cfg.Rotate))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "timeOffset", cfg.TimeOffset))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "keepMismatch", cfg.KeepMismatch))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "startDelay", cfg.StartDelay))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "Network", s.Network))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %x\n", "customnet", customNet))
-	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "deadline (ms)", deadline))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %x\n", "customnet", customNetID(cfg.CustomNet)))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "deadline (ms)", cfg.Deadline))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "tls", s.FactomdTLSEnable))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "selfaddr", s.FactomdLocations))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "fastBoot", s.StateSaverStruct.FastBoot))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "fastBoot folder", s.StateSaverStruct.FastBootLocation))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "snapshot interval", s.StateSaverStruct.SnapshotInterval))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "audit", cfg.Audit))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "fast catchup", s.FastCatchup))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "compress", cfg.Compress))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "protobuf", cfg.Protobuf))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "replay from height", cfg.ReplayFromHeight))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "skip validation until", s.SkipValidationUntil))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "header sync", s.HeaderSync))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "prune window", s.PruneWindow))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "entry bloom MB", s.EntryBloomMB))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "ack batch (ms)", s.AckBatchWindow))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "replay window (min)", s.ReplayWindow))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "shutdown timeout", s.ShutdownTimeout))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "standby", s.Standby))
+	if s.FollowChains != nil {
+		os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "follow chains", len(s.FollowChains)))
+	} else {
+		os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "follow chains", "all"))
+	}
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "rpcuser", s.RpcUser))
+	os.Stderr.WriteString(s.ReplayBoundsWarning())
 	if "" == s.RpcPass {
 		os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "rpcpass", "is blank"))
 	} else {
@@ -327,78 +194,23 @@ func NetStart(s *state.State) {
 		fnodes[i].State.IntiateNetworkSkeletonIdentity()
 	}
 
+	setNodeTiming(cfg.ProcessDelay, cfg.ClockSkew)
+
 	// Start the P2P netowork
-	var networkID p2p.NetworkID
-	var seedURL, networkPort, specialPeers string
-	switch s.Network {
-	case "MAIN", "main":
-		networkID = p2p.MainNet
-		seedURL = s.MainSeedURL
-		networkPort = s.MainNetworkPort
-		specialPeers = s.MainSpecialPeers
-	case "TEST", "test":
-		networkID = p2p.TestNet
-		seedURL = s.TestSeedURL
-		networkPort = s.TestNetworkPort
-		specialPeers = s.TestSpecialPeers
-	case "LOCAL", "local":
-		networkID = p2p.LocalNet
-		seedURL = s.LocalSeedURL
-		networkPort = s.LocalNetworkPort
-		specialPeers = s.LocalSpecialPeers
-	case "CUSTOM", "custom":
-		if bytes.Compare(customNet, []byte("\xe3\xb0\xc4\x42")) == 0 {
-			panic("Please specify a custom network with -customnet=<something unique here>")
-		}
-		s.CustomNetworkID = customNet
-		networkID = p2p.NetworkID(binary.BigEndian.Uint32(customNet))
-		for i := range fnodes {
-			fnodes[i].State.CustomNetworkID = customNet
-		}
-		seedURL = s.LocalSeedURL
-		networkPort = s.LocalNetworkPort
-		specialPeers = s.LocalSpecialPeers
-	default:
-		panic("Invalid Network choice in Config File or command line. Choose MAIN, TEST, LOCAL, or CUSTOM")
+	ci, err := networkFor(s, cfg)
+	if err != nil {
+		panic(err.Error())
+	}
+	for i := range fnodes {
+		fnodes[i].State.CustomNetworkID = s.CustomNetworkID
 	}
 
 	connectionMetricsChannel := make(chan interface{}, p2p.StandardChannelSize)
-	p2p.NetworkDeadline = time.Duration(deadline) * time.Millisecond
+	ci.ConnectionMetricsChannel = connectionMetricsChannel
+	p2p.NetworkDeadline = time.Duration(cfg.Deadline) * time.Millisecond
 
-	if enableNet {
-		if 0 < networkPortOverride {
-			networkPort = fmt.Sprintf("%d", networkPortOverride)
-		}
-		ci := p2p.ControllerInit{
-			Port:                     networkPort,
-			PeersFile:                s.PeersFile,
-			Network:                  networkID,
-			Exclusive:                exclusive,
-			SeedURL:                  seedURL,
-			SpecialPeers:             specialPeers,
-			ConnectionMetricsChannel: connectionMetricsChannel,
-			LogPath:                  s.LogPath,
-			LogLevel:                 s.LogLevel,
-		}
-		p2pNetwork = new(p2p.Controller).Init(ci)
-		fnodes[0].State.NetworkControler = p2pNetwork
-		p2pNetwork.StartNetwork()
-		// Setup the proxy (Which translates from network parcels to factom messages, handling addressing for directed messages)
-		p2pProxy = new(P2PProxy).Init(fnodes[0].State.FactomNodeName, "P2P Network").(*P2PProxy)
-		p2pProxy.FromNetwork = p2pNetwork.FromNetwork
-		p2pProxy.ToNetwork = p2pNetwork.ToNetwork
-		fnodes[0].Peers = append(fnodes[0].Peers, p2pProxy)
-		p2pProxy.SetDebugMode(netdebug)
-		if 0 < netdebug {
-			go p2pProxy.PeriodicStatusReport(fnodes)
-			p2pNetwork.StartLogging(uint8(netdebug))
-		} else {
-			p2pNetwork.StartLogging(uint8(0))
-		}
-		p2pProxy.StartProxy()
-		// Command line peers lets us manually set special peers
-		p2pNetwork.DialSpecialPeersString(peers)
-		go networkHousekeeping() // This goroutine executes once a second to keep the proxy apprised of the network status.
+	if cfg.EnableNet {
+		p2pNetwork, p2pProxy = startNetwork(ci, fnodes, cfg)
 	}
 
 	switch net {
@@ -508,14 +320,32 @@ func NetStart(s *state.State) {
 
 	}
 	if journal != "" {
-		go LoadJournal(s, journal)
-		startServers(false)
+		s.Supervisor.GoOnce("journal", func() { LoadJournal(s, journal) })
+		startServers(fnodes, false)
 	} else {
-		startServers(true)
+		startServers(fnodes, true)
+	}
+
+	if cfg.Audit >= 0 {
+		fnodes[0].State.Supervise("balance audit", func() { fnodes[0].State.RunBalanceAudit(time.Duration(cfg.Audit) * time.Millisecond) })
+	}
+
+	if fnodes[0].State.FEROracle != nil {
+		fnodes[0].State.Supervise("fer oracle", fnodes[0].State.RunFEROracle)
+	}
+	if fnodes[0].State.Scheduler != nil {
+		fnodes[0].State.Supervise("scheduler", fnodes[0].State.RunScheduler)
+	}
+	if fnodes[0].State.MetricsHistorySeconds > 0 {
+		fnodes[0].State.Supervise("metrics history", fnodes[0].State.RecordMetricsHistory)
+	}
+
+	if tokenIndex.Registered() {
+		fnodes[0].State.Supervise("token indexers", func() { tokenIndex.Run(fnodes[0].State) })
 	}
 
 	// Start the webserver
-	go wsapi.Start(fnodes[0].State)
+	wsapi.Start(fnodes[0].State)
 
 	// Start prometheus on port
 	launchPrometheus(9876)
@@ -525,7 +355,8 @@ func NetStart(s *state.State) {
 	leveldb.RegisterPrometheus()
 	RegisterPrometheus()
 
-	go controlPanel.ServeControlPanel(fnodes[0].State.ControlPanelChannel, fnodes[0].State, connectionMetricsChannel, p2pNetwork, Build)
+	simControlPanel = controlPanel.NewControlPanel(fnodes[0].State.ControlPanelChannel, fnodes[0].State, p2pNetwork, Build)
+	fnodes[0].State.Supervise("control panel", func() { simControlPanel.Serve(connectionMetricsChannel) })
 	// Listen for commands:
 	SimControl(listenTo)
 }
@@ -534,6 +365,213 @@ func NetStart(s *state.State) {
 // Functions that access variables in this method to set up Factom Nodes
 // and start the servers.
 //**********************************************************************
+
+// applyConfig loads the config file into s, and sets everything the Config overrides.
+func applyConfig(s *state.State, cfg *Config) error {
+	messages.AckBalanceHash = cfg.AckBalanceHash
+	// Must add the prefix before loading the configuration.
+	s.AddPrefix(cfg.Prefix)
+	FactomConfigFilename := cfg.ConfigFile
+	if FactomConfigFilename == "" {
+		FactomConfigFilename = util.GetConfigFilename("m2")
+	}
+	fmt.Println(fmt.Sprintf("factom config: %s", FactomConfigFilename))
+	s.LoadConfig(FactomConfigFilename, cfg.Network)
+	s.OneLeader = cfg.Rotate
+	s.TimeOffset = primitives.NewTimestampFromMilliseconds(uint64(cfg.TimeOffset))
+	s.StartDelayLimit = int64(cfg.StartDelay) * 1000
+	s.Journaling = cfg.Journaling
+	s.LogLevel = cfg.LogLvl
+
+	if !cfg.LogFile {
+		s.LogPath = "stdout"
+	}
+
+	// Set the wait for entries flag
+	s.WaitForEntries = cfg.WaitEntries
+
+	if 999 < cfg.PortOverride { // The command line flag exists and seems reasonable.
+		s.SetPort(cfg.PortOverride)
+	}
+	if 999 < cfg.ControlPanelPortOverride { // The command line flag exists and seems reasonable.
+		s.ControlPanelPort = cfg.ControlPanelPortOverride
+	}
+
+	if cfg.BlkTime > 0 {
+		s.DirectoryBlockInSeconds = cfg.BlkTime
+	}
+
+	s.FaultTimeout = cfg.FaultTimeout
+
+	s.VirtualClock = cfg.VirtualClock
+	if s.VirtualClock == nil && cfg.TimeRate > 1 {
+		s.VirtualClock = clock.NewVirtual(cfg.TimeRate)
+	}
+
+	if cfg.RpcUser != "" {
+		s.RpcUser = cfg.RpcUser
+	}
+
+	if cfg.RpcPassword != "" {
+		s.RpcPass = cfg.RpcPassword
+	}
+
+	if cfg.FactomdTLS == true {
+		s.FactomdTLSEnable = true
+	}
+
+	if cfg.FactomdLocations != "" {
+		if len(s.FactomdLocations) > 0 {
+			s.FactomdLocations += ","
+		}
+		s.FactomdLocations += cfg.FactomdLocations
+	}
+
+	if cfg.Fast == false {
+		s.StateSaverStruct.FastBoot = false
+	}
+	s.FastCatchup = cfg.FastCatchup
+	if cfg.ReplayFromHeight >= 0 {
+		s.SetReplayFromHeight(uint32(cfg.ReplayFromHeight))
+	}
+	s.SkipValidationUntil = uint32(cfg.SkipValidationUntil)
+	s.HeaderSync = cfg.HeaderSync
+	s.EntryBloomMB = cfg.EntryBloom
+	s.AckBatchWindow = cfg.AckBatch
+	s.ReplayWindow = cfg.ReplayWindow
+	s.ShutdownTimeout = cfg.ShutdownTimeout
+	s.Standby = cfg.Standby
+	s.StandbyQuiet = cfg.StandbyQuiet
+	if cfg.Prune > 0 {
+		if s.StateSaverStruct.SnapshotInterval <= 0 {
+			return fmt.Errorf("A pruned node can only boot from a snapshot; set SnapshotInterval in the config file to use -prune")
+		}
+		s.PruneWindow = uint32(cfg.Prune)
+	}
+	if cfg.FollowChains != "" {
+		chains, err := state.ParseChainIDs(cfg.FollowChains)
+		if err != nil {
+			return fmt.Errorf("-followchains: %s", err.Error())
+		}
+		s.FollowChains = chains
+	}
+	if cfg.FastLocation != "" {
+		s.StateSaverStruct.FastBootLocation = cfg.FastLocation
+	}
+
+	if cfg.Journal != "" {
+		if s.DBType != "Map" {
+			fmt.Println("Journal is ALWAYS a Map database")
+			s.DBType = "Map"
+		}
+	}
+	if cfg.Follower {
+		s.NodeMode = "FULL"
+		leadID := primitives.Sha([]byte(s.Prefix + "FNode0"))
+		if s.IdentityChainID.IsSameAs(leadID) {
+			s.SetIdentityChainID(primitives.Sha([]byte(time.Now().String()))) // Make sure this node is NOT a leader
+		}
+	}
+
+	s.KeepMismatch = cfg.KeepMismatch
+
+	if len(cfg.DB) > 0 {
+		s.DBType = cfg.DB
+	}
+
+	if len(cfg.CloneDB) > 0 {
+		s.CloneDBType = cfg.CloneDB
+	} else {
+		s.CloneDBType = s.DBType
+	}
+	return nil
+}
+
+// customNetID returns the network ID of a custom network name.
+func customNetID(customNet string) []byte {
+	return primitives.Sha([]byte(customNet)).Bytes()[:4]
+}
+
+// networkFor returns the p2p settings of the network s is configured for.
+func networkFor(s *state.State, cfg *Config) (p2p.ControllerInit, error) {
+	ci := p2p.ControllerInit{
+		PeersFile: s.PeersFile,
+		Exclusive: cfg.Exclusive,
+		LogPath:   s.LogPath,
+		LogLevel:  s.LogLevel,
+	}
+	groups := ""
+	switch s.Network {
+	case "MAIN", "main":
+		ci.Network = p2p.MainNet
+		ci.SeedURL = s.MainSeedURL
+		ci.Port = s.MainNetworkPort
+		ci.SpecialPeers = s.MainSpecialPeers
+		groups = s.MainSpecialPeerGroups
+	case "TEST", "test":
+		ci.Network = p2p.TestNet
+		ci.SeedURL = s.TestSeedURL
+		ci.Port = s.TestNetworkPort
+		ci.SpecialPeers = s.TestSpecialPeers
+		groups = s.TestSpecialPeerGroups
+	case "LOCAL", "local":
+		ci.Network = p2p.LocalNet
+		ci.SeedURL = s.LocalSeedURL
+		ci.Port = s.LocalNetworkPort
+		ci.SpecialPeers = s.LocalSpecialPeers
+		groups = s.LocalSpecialPeerGroups
+	case "CUSTOM", "custom":
+		customNet := customNetID(cfg.CustomNet)
+		if bytes.Compare(customNet, []byte("\xe3\xb0\xc4\x42")) == 0 {
+			return ci, fmt.Errorf("Please specify a custom network with -customnet=<something unique here>")
+		}
+		s.CustomNetworkID = customNet
+		ci.Network = p2p.NetworkID(binary.BigEndian.Uint32(customNet))
+		ci.SeedURL = s.LocalSeedURL
+		ci.Port = s.LocalNetworkPort
+		ci.SpecialPeers = s.LocalSpecialPeers
+		groups = s.LocalSpecialPeerGroups
+	default:
+		return ci, fmt.Errorf("Invalid Network choice in Config File or command line. Choose MAIN, TEST, LOCAL, or CUSTOM")
+	}
+	if 0 < cfg.NetworkPortOverride {
+		ci.Port = fmt.Sprintf("%d", cfg.NetworkPortOverride)
+	}
+	peerGroups, err := p2p.ParsePeerGroups(groups)
+	if err != nil {
+		return ci, err
+	}
+	ci.PeerGroups = peerGroups
+	return ci, nil
+}
+
+// startNetwork starts the p2p network, and the proxy that connects it to the first of nodes.
+func startNetwork(ci p2p.ControllerInit, nodes []*FactomNode, cfg *Config) (*p2p.Controller, *P2PProxy) {
+	network := new(p2p.Controller).Init(ci)
+	nodes[0].State.NetworkControler = network
+	network.Supervise = nodes[0].State.Supervise
+	network.StartNetwork()
+	// Setup the proxy (Which translates from network parcels to factom messages, handling addressing for directed messages)
+	proxy := new(P2PProxy).Init(nodes[0].State.FactomNodeName, "P2P Network").(*P2PProxy)
+	proxy.FromNetwork = network.FromNetwork
+	proxy.ToNetwork = network.ToNetwork
+	nodes[0].Peers = append(nodes[0].Peers, proxy)
+	proxy.SetDebugMode(cfg.NetDebug)
+	proxy.Compress = cfg.Compress
+	proxy.Protobuf = cfg.Protobuf
+	if 0 < cfg.NetDebug {
+		nodes[0].State.Supervise("p2p status report", func() { proxy.PeriodicStatusReport(nodes) })
+		network.StartLogging(uint8(cfg.NetDebug))
+	} else {
+		network.StartLogging(uint8(0))
+	}
+	proxy.StartProxy()
+	// Command line peers lets us manually set special peers
+	network.DialSpecialPeersString(cfg.Peers)
+	// This goroutine executes once a second to keep the proxy apprised of the network status.
+	nodes[0].State.Supervise("p2p housekeeping", func() { networkHousekeeping(proxy, network) })
+	return network, proxy
+}
 func makeServer(s *state.State) *FactomNode {
 	// All other states are clones of the first state.  Which this routine
 	// gets passed to it.
@@ -549,22 +587,25 @@ func makeServer(s *state.State) *FactomNode {
 	fnode.State = newState
 	fnodes = append(fnodes, fnode)
 	fnode.MLog = mLog
+	fnode.Router = NewPeerRouter(fnode)
 
 	return fnode
 }
 
-func startServers(load bool) {
-	for i, fnode := range fnodes {
+func startServers(nodes []*FactomNode, load bool) {
+	for i, fnode := range nodes {
 		if i > 0 {
 			fnode.State.Init()
 		}
-		go NetworkProcessorNet(fnode)
+		s := fnode.State
+		s.StartDBStatePreValidator(runtime.NumCPU())
+		NetworkProcessorNet(fnode)
 		if load {
-			go state.LoadDatabase(fnode.State)
+			s.Supervisor.GoOnce("load database", func() { state.LoadDatabase(s) })
 		}
-		go fnode.State.GoSyncEntries()
-		go Timer(fnode.State)
-		go fnode.State.ValidatorLoop()
+		s.Supervise("sync entries", s.GoSyncEntries)
+		s.Supervise("timer", func() { Timer(s) })
+		s.Supervise("validator", s.ValidatorLoop)
 	}
 }
 
@@ -608,9 +649,9 @@ func setupFirstAuthority(s *state.State) {
 	s.Authorities = append(s.Authorities, &auth)
 }
 
-func networkHousekeeping() {
+func networkHousekeeping(proxy *P2PProxy, network *p2p.Controller) {
 	for {
 		time.Sleep(1 * time.Second)
-		p2pProxy.SetWeight(p2pNetwork.GetNumberConnections())
+		proxy.SetWeight(network.GetNumberConnections())
 	}
 }
@@ -13,77 +13,44 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/log"
+	"github.com/FactomProject/factomd/state"
 )
 
 var _ = log.Printf
 var _ = fmt.Print
 
 func NetworkProcessorNet(fnode *FactomNode) {
-	go Peers(fnode)
-	go NetworkOutputs(fnode)
-	go InvalidOutputs(fnode)
+	if fnode.Router == nil {
+		fnode.Router = NewPeerRouter(fnode)
+	}
+	fnode.State.Supervise("peers", func() { Peers(fnode) })
+	fnode.State.Supervise("network outputs", func() { NetworkOutputs(fnode) })
+	fnode.State.Supervise("invalid outputs", func() { InvalidOutputs(fnode) })
 }
 
 func Peers(fnode *FactomNode) {
 	cnt := 0
 
-	// ackHeight is used in ignoreMsg to determine if we should ignore an ackowledgment
-	ackHeight := uint32(0)
-	// When syncing from disk/network we want to selectivly ignore certain msgs to allow
-	// factom to focus on syncing. The following msgs will be ignored:
-	//		Acks:
-	//				Ignore acks below the ackheight, which is set if we get an ack at a height higher than
-	//			  	the ackheight. This is because Acks are for the current block, which we are not at,
-	//				but acks also serve as an indicator as to which height the network is on. So we allow
-	//				1 ack through to set out leader height.
-	//
-	//		Commit/Reveals:
-	//				These fill up our holding map because we are not getting acks. If we have things in the
-	//				holding map, that increases the amount of time it takes to process the holding map, slowing
-	//				down our inmsg queue draining.
-	//
-	//		EOMs:
-	//				Only helpful at the latest height
-	//
-	//		MissingData:
-	//				We should fufill some of these requests, but we should also focus on ourselves while we are syncing.
-	//				If our inmsg queue has too many msgs, then don't help others.
-	ignoreMsg := func(amsg interfaces.IMsg) bool {
-		// Stop uint32 underflow
-		if fnode.State.GetTrueLeaderHeight() < 35 {
-			return false
+	// Messages held for the node's ProcessDelay
+	delayed := new(DelayedMsgs)
+
+	// DBStates from our peers are PreValidated before the State sees them.  When the State is
+	// backed up, the lowest priority messages are shed first.
+	enqueue := func(msg interfaces.IMsg) {
+		if !fnode.State.AdmitMsg(msg) {
+			return
 		}
-		// If we are syncing up, then apply the filter
-		if fnode.State.GetHighestCompletedBlk() < fnode.State.GetTrueLeaderHeight()-35 {
-			// Discard all commits, reveals, and acks <= the highest ack height we have seen.
-			switch amsg.Type() {
-			case constants.COMMIT_CHAIN_MSG:
-				return true
-			case constants.REVEAL_ENTRY_MSG:
-				return true
-			case constants.COMMIT_ENTRY_MSG:
-				return true
-			case constants.EOM_MSG:
-				return true
-			case constants.MISSING_DATA:
-				if !fnode.State.DBFinished {
-					return true
-				} else if fnode.State.InMsgQueue().Length() > 4000 {
-					// If > 4000, we won't get to this in time anyway. Just drop it since we are behind
-					return true
-				}
-			case constants.ACK_MSG:
-				if amsg.(*messages.Ack).DBHeight <= ackHeight {
-					return true
-				}
-				// Set the highest ack height seen and allow through
-				ackHeight = amsg.(*messages.Ack).DBHeight
-			}
+		if dbstate, ok := msg.(*messages.DBStateMsg); ok {
+			fnode.State.EnqueueDBState(dbstate)
+			return
 		}
-		return false
+		fnode.State.InMsgQueue().Enqueue(msg)
 	}
 
 	for {
+		if fnode.State.IsShutDown() {
+			return
+		}
 		for i := 0; i < 100 && len(fnode.State.APIQueue()) > 0; i++ {
 			select {
 			case msg := <-fnode.State.APIQueue():
@@ -97,23 +64,37 @@ func Peers(fnode *FactomNode) {
 				}
 				cnt++
 				msg.SetOrigin(0)
+				if msg.GetCorrelationID() == "" {
+					msg.SetCorrelationID(log.NewCorrelationID("api"))
+				}
+				if msg.GetTraceID() == "" {
+					msg.SetTraceID(msg.GetCorrelationID())
+				}
 				if fnode.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, repeatHash.Fixed(),
 					msg.GetTimestamp(),
 					fnode.State.GetTimestamp()) {
 					//fnode.MLog.add2(fnode, false, fnode.State.FactomNodeName, "API", true, msg)
-					if fnode.State.InMsgQueue().Length() < 9000 {
+					if fnode.State.AdmitMsg(msg) {
 						fnode.State.InMsgQueue().Enqueue(msg)
 					}
 				} else {
 					RepeatMsgs.Inc()
+					fnode.State.CountReplayDrop(state.ReplayFilterNetwork, msg)
 				}
 			default:
 
 			}
 		}
 
-		// Put any broadcasts from our peers into our BroadcastIn queue
+		// Quarantined commits only get in when there is room to spare.
+		fnode.State.ReleaseQuarantine()
+
+		// Put any broadcasts from our peers into our BroadcastIn queue.  If the State is too far
+		// behind, leave them with the peers for now, so the backlog stays in the p2p layer.
 		for i, peer := range fnode.Peers {
+			if fnode.State.InMsgBackpressure() {
+				break
+			}
 			for j := 0; j < 100; j++ {
 				var msg interfaces.IMsg
 				var err error
@@ -129,42 +110,31 @@ func Peers(fnode *FactomNode) {
 
 				cnt++
 
-				if fnode.State.MessageTally {
-					fnode.State.TallyReceived(int(msg.Type()))
-				}
-
 				if err != nil {
 					fmt.Println("ERROR recieving message on", fnode.State.FactomNodeName+":", err)
 					break
 				}
 				msg.SetOrigin(i + 1)
-				if fnode.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, msg.GetRepeatHash().Fixed(),
-					msg.GetTimestamp(),
-					fnode.State.GetTimestamp()) {
-					//if state.GetOut() {
-					//	fnode.State.Println("In Comming!! ",msg)
-					//}
-					in := "PeerIn"
-					if msg.IsPeer2Peer() {
-						in = "P2P In"
-					}
-					nme := fmt.Sprintf("%s %d", in, i+1)
-
-					fnode.MLog.Add2(fnode, false, peer.GetNameTo(), nme, true, msg)
-
-					// Ignore messages if there are too many.
-					if fnode.State.InMsgQueue().Length() < 9000 && !ignoreMsg(msg) {
-						fnode.State.InMsgQueue().Enqueue(msg)
-					}
-
+				msg.SetCorrelationID(log.NewCorrelationID("p2p"))
+				if msg = fnode.Router.Route(msg); msg == nil {
+					continue
+				}
+				if fnode.State.ProcessDelay > 0 || delayed.Len() > 0 {
+					delayed.Add(msg, fnode.State.ClockNow().UnixNano()/1e6)
 				} else {
-					RepeatMsgs.Inc()
-					//fnode.MLog.add2(fnode, false, peer.GetNameTo(), "PeerIn", false, msg)
+					enqueue(msg)
 				}
 			}
 		}
+		for _, msg := range delayed.Ready(fnode.State.ClockNow().UnixNano()/1e6, fnode.State.ProcessDelay) {
+			enqueue(msg)
+		}
 		if cnt == 0 {
-			time.Sleep(50 * time.Millisecond)
+			if delayed.Len() > 0 {
+				time.Sleep(5 * time.Millisecond)
+			} else {
+				time.Sleep(50 * time.Millisecond)
+			}
 		}
 		cnt = 0
 	}
@@ -12,9 +12,12 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 )
 
-// interruptChannel is used to receive SIGINT (Ctrl+C) signals.
+// interruptChannel is used to receive SIGINT (Ctrl+C) and SIGTERM signals.
 var interruptChannel chan os.Signal
 
 // addHandlerChannel is used to add an interrupt handler to the list of handlers
@@ -37,21 +40,23 @@ func mainInterruptHandler() {
 
 	for {
 		select {
-		case <-interruptChannel:
-			// Ignore more than one shutdown signal.
+		case sig := <-interruptChannel:
+			// A graceful shutdown can take up to a minute of a block; a second signal cuts it short.
 			if isShutdown {
-				fmt.Println("Ctrl+C Already being processed!")
-				continue
+				fmt.Printf("Received %v again.  Exiting without waiting for a clean shutdown.\n", sig)
+				os.Exit(1)
 			}
 			isShutdown = true
-			fmt.Println("Received SIGINT (Ctrl+C).  Shutting down...")
+			fmt.Printf("Received %v.  Shutting down...\n", sig)
 
-			// Run handlers in LIFO order.
-			for i := range interruptCallbacks {
-				idx := len(interruptCallbacks) - 1 - i
-				callback := interruptCallbacks[idx]
-				callback()
-			}
+			// Run handlers in LIFO order, away from this loop so we can still hear a second signal.
+			go func(callbacks []func()) {
+				for i := range callbacks {
+					idx := len(callbacks) - 1 - i
+					callback := callbacks[idx]
+					callback()
+				}
+			}(interruptCallbacks)
 
 		case handler := <-addHandlerChannel:
 			// The shutdown signal has already been received, so
@@ -65,16 +70,58 @@ func mainInterruptHandler() {
 	}
 }
 
-// AddInterruptHandler adds a handler to call when a SIGINT (Ctrl+C) is
+// AddInterruptHandler adds a handler to call when a SIGINT (Ctrl+C) or SIGTERM is
 // received.
 func AddInterruptHandler(handler func()) {
 	// Create the channel and start the main interrupt handler which invokes
 	// all other callbacks and exits if not already done.
 	if interruptChannel == nil {
 		interruptChannel = make(chan os.Signal, 1)
-		signal.Notify(interruptChannel, os.Interrupt)
+		signal.Notify(interruptChannel, os.Interrupt, syscall.SIGTERM)
 		go mainInterruptHandler()
 	}
 
 	addHandlerChannel <- handler
 }
+
+// dumpAllStates writes a state dump for each of our nodes.
+func dumpAllStates() {
+	for _, fnode := range fnodes {
+		name, _, err := fnode.State.WriteStateDump()
+		if err != nil {
+			fmt.Println("State dump of", fnode.State.FactomNodeName, "failed:", err)
+			continue
+		}
+		fmt.Println("State dump of", fnode.State.FactomNodeName, "written to", name)
+	}
+}
+
+// shutdownNodes stops every node taking new work, and waits for each to reach a safe boundary
+// (see state/shutdown.go).  Then each node flushes and closes everything, and we wait until they
+// have.
+func shutdownNodes(nodes []*FactomNode) {
+	var wg sync.WaitGroup
+	for _, fnode := range nodes {
+		fnode.State.BeginShutdown()
+		wg.Add(1)
+		go func(fnode *FactomNode) {
+			defer wg.Done()
+			if !fnode.State.WaitForSafeBoundary() {
+				fmt.Println(fnode.State.FactomNodeName, "did not reach a safe boundary; shutting down anyway")
+			}
+		}(fnode)
+	}
+	wg.Wait()
+
+	for _, fnode := range nodes {
+		fmt.Print("Shutting Down: ", fnode.State.FactomNodeName, "\r\n")
+		fnode.State.ShutdownChan <- 0
+	}
+	for _, fnode := range nodes {
+		select {
+		case <-fnode.State.ShutdownDone:
+		case <-time.After(30 * time.Second):
+			fmt.Println(fnode.State.FactomNodeName, "did not close in time")
+		}
+	}
+}
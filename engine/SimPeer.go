@@ -17,8 +17,9 @@ var _ = fmt.Print
 var _ = bytes.Compare
 
 type SimPacket struct {
-	data []byte
-	sent int64 // Time in milliseconds
+	data  []byte
+	sent  int64  // Time in milliseconds
+	trace string // Trace ID of the message, sent beside it as p2p does
 }
 
 type SimPeer struct {
@@ -121,7 +122,7 @@ func (f *SimPeer) Send(msg interfaces.IMsg) error {
 		return err
 	}
 	if len(f.BroadcastOut) < 9000 {
-		packet := SimPacket{data: data, sent: time.Now().UnixNano() / 1000000}
+		packet := SimPacket{data: data, sent: time.Now().UnixNano() / 1000000, trace: msg.GetTraceID()}
 		f.BroadcastOut <- &packet
 	}
 	return nil
@@ -149,11 +150,13 @@ func (f *SimPeer) Recieve() (interfaces.IMsg, error) {
 	now := time.Now().UnixNano() / 1000000
 
 	if f.Delayed != nil && now-f.Delayed.sent > f.DelayUse {
-		data := f.Delayed.data
+		data, trace := f.Delayed.data, f.Delayed.trace
 		f.Delayed = nil
 		msg, err := messages.UnmarshalMessage(data)
 		if err != nil {
 			fmt.Printf("SimPeer ERROR: %s %x %s\n", err.Error(), data[:8], messages.MessageName(data[0]))
+		} else {
+			msg.SetTraceID(trace)
 		}
 
 		f.bytesIn += len(data)
@@ -165,6 +168,16 @@ func (f *SimPeer) Recieve() (interfaces.IMsg, error) {
 	return nil, nil
 }
 
+// LinkSimPeers returns the two ends of a link between the nodes named name1 and name2: the peer
+// name1 sends to name2 through, and the peer name2 sends to name1 through.
+func LinkSimPeers(name1 string, name2 string) (*SimPeer, *SimPeer) {
+	peer12 := new(SimPeer).Init(name1, name2).(*SimPeer)
+	peer21 := new(SimPeer).Init(name2, name1).(*SimPeer)
+	peer12.BroadcastIn = peer21.BroadcastOut
+	peer21.BroadcastIn = peer12.BroadcastOut
+	return peer12, peer21
+}
+
 func AddSimPeer(fnodes []*FactomNode, i1 int, i2 int) {
 	// Ignore out of range, and connections to self.
 	if i1 < 0 ||
@@ -193,10 +206,7 @@ func AddSimPeer(fnodes []*FactomNode, i1 int, i2 int) {
 
 	fmt.Println(i1, " -- ", i2)
 
-	peer12 := new(SimPeer).Init(f1.State.FactomNodeName, f2.State.FactomNodeName).(*SimPeer)
-	peer21 := new(SimPeer).Init(f2.State.FactomNodeName, f1.State.FactomNodeName).(*SimPeer)
-	peer12.BroadcastIn = peer21.BroadcastOut
-	peer21.BroadcastIn = peer12.BroadcastOut
+	peer12, peer21 := LinkSimPeers(f1.State.FactomNodeName, f2.State.FactomNodeName)
 
 	f1.Peers = append(f1.Peers, peer12)
 	f2.Peers = append(f2.Peers, peer21)
@@ -3,7 +3,10 @@ package engine_test
 import (
 	"math"
 	"testing"
+	"time"
 
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
 	. "github.com/FactomProject/factomd/engine"
 )
 
@@ -28,3 +31,30 @@ func SimPeerTest(t *testing.T) {
 		t.Errorf("Should have %d nodes", cnt)
 	}
 }
+
+func TestSimPeerCarriesTraceID(t *testing.T) {
+	peer12, peer21 := LinkSimPeers("node1", "node2")
+
+	eom := new(messages.EOM)
+	eom.Timestamp = primitives.NewTimestampNow()
+	eom.ChainID = primitives.NewZeroHash()
+	eom.SetTraceID("api-abcdef-1")
+	if err := peer12.Send(eom); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		msg, err := peer21.Recieve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg != nil {
+			if msg.GetTraceID() != "api-abcdef-1" {
+				t.Errorf("Expected the trace ID to arrive with the message, got %q", msg.GetTraceID())
+			}
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Errorf("The message never arrived")
+}
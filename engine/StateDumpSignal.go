@@ -0,0 +1,26 @@
+//go:build !windows
+// +build !windows
+
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// handleStateDumpSignal writes a state dump for every node each time we get a SIGUSR1, so
+// operators can collect diagnostics with "kill -USR1 <pid>" without stopping the node.
+func handleStateDumpSignal() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	go func() {
+		for range c {
+			dumpAllStates()
+		}
+	}()
+}
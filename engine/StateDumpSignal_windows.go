@@ -0,0 +1,9 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+// Windows has no SIGUSR1; use the state-dump debug API call instead.
+func handleStateDumpSignal() {
+}
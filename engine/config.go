@@ -0,0 +1,202 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/FactomProject/factomd/clock"
+	"github.com/FactomProject/factomd/state"
+)
+
+// Config holds everything the command line can set.  ParseFlags fills one from the flags for
+// NetStart; a program embedding factomd (see embed.go) starts from DefaultConfig and sets what it
+// needs.  The factomd.conf named by ConfigFile is read first, and the Config overrides it.
+type Config struct {
+	ConfigFile string // The factomd.conf to read; the usual one in the home directory if empty
+
+	AckBalanceHash           bool
+	EnableNet                bool
+	WaitEntries              bool
+	ListenTo                 int // Simulator only
+	Count                    int // Simulator only
+	Net                      string
+	Fnet                     string
+	DropRate                 int
+	Journal                  string
+	Journaling               bool
+	Follower                 bool
+	Leader                   bool
+	DB                       string
+	CloneDB                  string
+	PortOverride             int
+	Network                  string
+	NetworkPortOverride      int
+	ControlPanelPortOverride int
+	LogPort                  string // Command line only; the profiler isn't started when embedded
+	Peers                    string
+	BlkTime                  int
+	FaultTimeout             int
+	RuntimeLog               bool
+	NetDebug                 int
+	Exclusive                bool
+	Prefix                   string
+	Rotate                   bool
+	TimeOffset               int
+	ProcessDelay             string
+	ClockSkew                string
+	TimeRate                 float64
+	VirtualClock             *clock.Virtual // Embedding only; shared by the nodes of a test, so they agree on the time
+	SimPeers                 []*SimPeer     // Embedding only; links to other nodes in the process, see LinkSimPeers
+	KeepMismatch             bool
+	StartDelay               int
+	Deadline                 int
+	CustomNet                string
+	RpcUser                  string
+	RpcPassword              string
+	FactomdTLS               bool
+	FactomdLocations         string
+	Fast                     bool
+	FastLocation             string
+	MemProfileRate           int // Command line only
+	LogLvl                   string
+	LogFile                  bool
+	FastCatchup              bool
+	ReplayFromHeight         int
+	SkipValidationUntil      int
+	HeaderSync               bool
+	Compress                 bool
+	Protobuf                 bool
+	Conformance              bool // Command line only
+	ConformanceCorpus        string
+	Prune                    int
+	FollowChains             string
+	ShutdownTimeout          int
+	Standby                  bool
+	StandbyQuiet             int
+	Audit                    int
+	EntryBloom               int
+	AckBatch                 int
+	ReplayWindow             int
+}
+
+// DefaultConfig returns the Config of a factomd run without any flags.
+func DefaultConfig() *Config {
+	cfg, _ := ParseFlags(nil)
+	return cfg
+}
+
+// ParseFlags returns the Config set by the command line args, which exclude the program name.
+func ParseFlags(args []string) (*Config, error) {
+	c := new(Config)
+	f := flag.NewFlagSet("factomd", flag.ContinueOnError)
+
+	f.BoolVar(&c.AckBalanceHash, "balancehash", true, "If false, then don't pass around balance hashes")
+	f.BoolVar(&c.EnableNet, "enablenet", true, "Enable or disable networking")
+	f.BoolVar(&c.WaitEntries, "waitentries", false, "Wait for Entries to be validated prior to execution of messages")
+	f.IntVar(&c.ListenTo, "node", 0, "Node Number the simulator will set as the focus")
+	f.IntVar(&c.Count, "count", 1, "The number of nodes to generate")
+	f.StringVar(&c.Net, "net", "tree", "The default algorithm to build the network connections")
+	f.StringVar(&c.Fnet, "fnet", "", "Read the given file to build the network connections")
+	f.IntVar(&c.DropRate, "drop", 0, "Number of messages to drop out of every thousand")
+	f.StringVar(&c.Journal, "journal", "", "Rerun a Journal of messages")
+	f.BoolVar(&c.Journaling, "journaling", false, "Write a journal of all messages recieved. Default is off.")
+	f.BoolVar(&c.Follower, "follower", false, "If true, force node to be a follower.  Only used when replaying a journal.")
+	f.BoolVar(&c.Leader, "leader", true, "If true, force node to be a leader.  Only used when replaying a journal.")
+	f.StringVar(&c.DB, "db", "", "Override the Database in the Config file and use this Database implementation")
+	f.StringVar(&c.CloneDB, "clonedb", "", "Override the main node and use this database for the clones in a Network.")
+	f.IntVar(&c.PortOverride, "port", 0, "Address to serve WSAPI on")
+	f.StringVar(&c.Network, "network", "", "Network to join: MAIN, TEST or LOCAL")
+	f.IntVar(&c.NetworkPortOverride, "networkPort", 0, "Address for p2p network to listen on.")
+	f.IntVar(&c.ControlPanelPortOverride, "ControlPanelPort", 0, "Address for control panel webserver to listen on.")
+	f.StringVar(&c.LogPort, "logPort", "6060", "Port for profile logging")
+	f.StringVar(&c.Peers, "peers", "", "Array of peer addresses. ")
+	f.IntVar(&c.BlkTime, "blktime", 0, "Seconds per block.  Production is 600.")
+	f.IntVar(&c.FaultTimeout, "faulttimeout", 60, "Seconds before considering Federated servers at-fault. Default is 60.")
+	f.BoolVar(&c.RuntimeLog, "runtimeLog", false, "If true, maintain runtime logs of messages passed.")
+	f.IntVar(&c.NetDebug, "netdebug", 0, "0-5: 0 = quiet, >0 = increasing levels of logging")
+	f.BoolVar(&c.Exclusive, "exclusive", false, "If true, we only dial out to special/trusted peers.")
+	f.StringVar(&c.Prefix, "prefix", "", "Prefix the Factom Node Names with this value; used to create leaderless networks.")
+	f.BoolVar(&c.Rotate, "rotate", false, "If true, responsiblity is owned by one leader, and rotated over the leaders.")
+	f.IntVar(&c.TimeOffset, "timedelta", 0, "Maximum timeDelta in milliseconds to offset each node.  Simulates deltas in system clocks over a network.")
+	f.StringVar(&c.ProcessDelay, "processdelay", "", "Comma separated node=milliseconds, eg \"1=500,3=2000\". Holds messages from peers to that node this long before processing them.")
+	f.StringVar(&c.ClockSkew, "clockskew", "", "Comma separated node=milliseconds, eg \"2=-3600000\". Moves the clock of that node by this much.")
+	f.Float64Var(&c.TimeRate, "timerate", 0, "For tests: if more than 1, the nodes' clocks, and so their minutes and timeouts, run this many times as fast as the system clock.")
+	f.BoolVar(&c.KeepMismatch, "keepmismatch", false, "If true, do not discard DBStates even when a majority of DBSignatures have a different hash")
+	f.IntVar(&c.StartDelay, "startdelay", 10, "Delay to start processing messages, in seconds")
+	f.IntVar(&c.Deadline, "deadline", 1000, "Timeout Delay in milliseconds used on Reads and Writes to the network comm")
+	f.StringVar(&c.CustomNet, "customnet", "", "This string specifies a custom blockchain network ID.")
+	f.StringVar(&c.RpcUser, "rpcuser", "", "Username to protect factomd local API with simple HTTP authentication")
+	f.StringVar(&c.RpcPassword, "rpcpass", "", "Password to protect factomd local API. Ignored if rpcuser is blank")
+	f.BoolVar(&c.FactomdTLS, "tls", false, "Set to true to require encrypted connections to factomd API and Control Panel") //to get tls, run as "factomd -tls=true"
+	f.StringVar(&c.FactomdLocations, "selfaddr", "", "comma seperated IPAddresses and DNS names of this factomd to use when creating a cert file")
+	f.BoolVar(&c.Fast, "fast", true, "If true, factomd will fast-boot from a file.")
+	f.StringVar(&c.FastLocation, "fastlocation", "", "Directory to put the fast-boot file in.")
+	f.IntVar(&c.MemProfileRate, "mpr", 512*1024, "Set the Memory Profile Rate to update profiling per X bytes allocated. Default 512K, set to 1 to profile everything, 0 to disable.")
+	f.StringVar(&c.LogLvl, "loglvl", "none", "Set log level to either: debug, info, notice, warning, error, critical, alert, emergency or none")
+	f.BoolVar(&c.LogFile, "logfile", false, "Use to set logging to use a file rather than stdout")
+	f.BoolVar(&c.FastCatchup, "fastcatchup", true, "If true, blocks below the last main net checkpoint are saved in large batches.")
+	f.IntVar(&c.ReplayFromHeight, "replay-from-height", -1, "EMERGENCY USE. If 0 or more, boot from no saved state (FastBoot or snapshot) past this height, so the database is replayed from no later than here.")
+	f.IntVar(&c.SkipValidationUntil, "skip-validation-until", 0, "EMERGENCY USE. If more than 0, blocks up to this height are used even if they fail validation. Remove once the node is synced.")
+	f.BoolVar(&c.Compress, "compress", true, "If true, large entry reveals and DBStates are sent compressed to the peers that can read them.")
+	f.BoolVar(&c.Protobuf, "protobuf", false, "If true, messages are sent in their protobuf encoding to the peers that can read it.")
+	f.BoolVar(&c.HeaderSync, "headersync", false, "If true, directory block headers are synced ahead of the blocks, so the network height is known right away.")
+	f.BoolVar(&c.Conformance, "conformance", false, "If true, run the protocol conformance suite and exit.")
+	f.StringVar(&c.ConformanceCorpus, "conformancecorpus", "", "Directory of JSON files with more conformance cases to run along with the built in ones.")
+	f.IntVar(&c.Prune, "prune", 0, "If more than 0, keep factoid blocks for only this many directory blocks behind the last snapshot. Needs snapshots on.")
+	f.StringVar(&c.FollowChains, "followchains", "", "Comma separated chain IDs. If set, only the entries of these chains (and the identity and exchange rate chains) are kept.")
+	f.IntVar(&c.ShutdownTimeout, "shutdowntimeout", state.DefaultShutdownTimeout, "Seconds to wait on shutdown for the minute in progress to end before closing down anyway.")
+	f.BoolVar(&c.Standby, "standby", false, "If true, run as a hot standby for the identity in the config file: follow the network without signing until promoted with the promote-standby API call.")
+	f.IntVar(&c.StandbyQuiet, "standbyquiet", 0, "Seconds the primary must go unheard before a standby may be promoted. 0 for two minutes of blocks.")
+	f.IntVar(&c.EntryBloom, "entrybloom", 0, "Megabytes of memory for a bloom filter over the saved entry hashes, so entry-exists is answered quickly. 0 for none.")
+	f.IntVar(&c.AckBatch, "ackbatch", 0, "As a leader, send the acks of this many milliseconds together under one signature, once the AckBatch activation of the network has taken effect. 0 to send each ack alone.")
+	f.IntVar(&c.ReplayWindow, "replaywindow", state.Range, "Minutes either side of now a message's timestamp may be for the replay filter to take it. At most the default.")
+	f.IntVar(&c.Audit, "audit", -1, "If 0 or more, re-derive all balances from genesis and check them against ours, pausing this many milliseconds between blocks")
+
+	if err := f.Parse(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// check returns an error for settings that can never work, before anything is started.
+func (c *Config) check() error {
+	if !c.Follower && !c.Leader {
+		return fmt.Errorf("Not a leader or a follower")
+	}
+	if c.Prune > 0 {
+		if c.Prune < state.MinPruneWindow {
+			return fmt.Errorf("A pruned node must keep at least %d blocks (-prune=%d)", state.MinPruneWindow, c.Prune)
+		}
+		if c.Audit >= 0 {
+			return fmt.Errorf("The balance audit needs every factoid block; it can't be run with -prune")
+		}
+	}
+	if c.ReplayFromHeight < -1 {
+		return fmt.Errorf("-replay-from-height can't be negative")
+	}
+	if c.SkipValidationUntil < 0 {
+		return fmt.Errorf("-skip-validation-until can't be negative")
+	}
+	if c.EntryBloom < 0 {
+		return fmt.Errorf("-entrybloom can't be negative")
+	}
+	if c.AckBatch < 0 {
+		return fmt.Errorf("-ackbatch can't be negative")
+	}
+	if c.ReplayWindow < 1 || c.ReplayWindow > state.Range {
+		return fmt.Errorf("-replaywindow must be from 1 to %d minutes", state.Range)
+	}
+	if c.TimeRate < 0 {
+		return fmt.Errorf("-timerate can't be negative")
+	}
+	if c.FollowChains != "" {
+		if _, err := state.ParseChainIDs(c.FollowChains); err != nil {
+			return fmt.Errorf("-followchains: %s", err.Error())
+		}
+	}
+	return nil
+}
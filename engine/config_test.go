@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/engine"
+	"github.com/FactomProject/factomd/state"
+)
+
+func TestParseFlags(t *testing.T) {
+	cfg := DefaultConfig()
+	if !cfg.EnableNet || !cfg.Leader || cfg.Follower || cfg.Count != 1 || cfg.Audit != -1 {
+		t.Errorf("Unexpected defaults %+v", cfg)
+	}
+	if cfg.ShutdownTimeout != state.DefaultShutdownTimeout {
+		t.Errorf("Expected a shutdown timeout of %d, got %d", state.DefaultShutdownTimeout, cfg.ShutdownTimeout)
+	}
+
+	cfg, err := ParseFlags([]string{"-network=LOCAL", "-follower=true", "-port=8090", "-enablenet=false", "-timerate=60"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Network != "LOCAL" || !cfg.Follower || cfg.PortOverride != 8090 || cfg.EnableNet || cfg.TimeRate != 60 {
+		t.Errorf("Flags were not parsed: %+v", cfg)
+	}
+
+	if _, err := ParseFlags([]string{"-nosuchflag"}); err == nil {
+		t.Error("An unknown flag should be an error")
+	}
+}
+
+func TestStartRefusesBadConfig(t *testing.T) {
+	bad := map[string]func(*Config){
+		"simulator":     func(c *Config) { c.Count = 3 },
+		"journal":       func(c *Config) { c.Journal = "journal.log" },
+		"no role":       func(c *Config) { c.Leader, c.Follower = false, false },
+		"small prune":   func(c *Config) { c.Prune = 1 },
+		"prune audit":   func(c *Config) { c.Prune = state.MinPruneWindow; c.Audit = 0 },
+		"follow chains": func(c *Config) { c.FollowChains = "not a chain" },
+		"time rate":     func(c *Config) { c.TimeRate = -1 },
+		"replay from":   func(c *Config) { c.ReplayFromHeight = -2 },
+		"skip valid":    func(c *Config) { c.SkipValidationUntil = -1 },
+		"entry bloom":   func(c *Config) { c.EntryBloom = -1 },
+		"ack batch":     func(c *Config) { c.AckBatch = -1 },
+		"replay window": func(c *Config) { c.ReplayWindow = state.Range + 1 },
+	}
+	for name, set := range bad {
+		cfg := DefaultConfig()
+		set(cfg)
+		d, err := Start(cfg)
+		if err == nil || d != nil {
+			t.Errorf("%s: expected an error, not a running node", name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/controlPanel"
+	"github.com/FactomProject/factomd/database/leveldb"
+	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/tokenIndex"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// A Daemon is a factomd node run inside another program, such as an integration test or a
+// product that runs a follower in-process.  Start returns errors where the command line would
+// panic or exit, and never installs signal handlers; the program calls Stop when it is done.
+//
+// A Daemon keeps its node and network to itself, so it doesn't touch the simulator's globals
+// (fnodes and friends), and it doesn't start the simulator, the profiler, or a prometheus
+// listener; the metrics are registered, so the program can serve them itself.  Each Daemon gets
+// its own control panel (see controlPanel.NewControlPanel).  Two settings are still process wide:
+// the balance hash flag, which is part of the wire format, and the p2p network deadline.  Give
+// each Daemon in a process its own database, API port, control panel port and network port.
+// A test can hand a Daemon's node messages, and take the ones it queued, with
+// State.InjectMessage and State.DrainQueue (see state/inject.go).  Daemons in one process can
+// also be linked as the simulator links its nodes, without the network, by giving each end of
+// LinkSimPeers to one of them in Config.SimPeers; engine/nettest runs networks of them.  A test
+// can watch, change or drop the messages a Daemon gets from its peers with a middleware on
+// Node().Router (see router.go).
+
+type Daemon struct {
+	State *state.State
+
+	node     *FactomNode
+	network  *p2p.Controller
+	proxy    *P2PProxy
+	stopOnce sync.Once
+}
+
+// Start boots a node from cfg, or from DefaultConfig if cfg is nil, and returns once it is
+// running.  The node syncs in the background.
+func Start(cfg *Config) (*Daemon, error) {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+	if cfg.Count > 1 {
+		return nil, fmt.Errorf("The simulator can't be embedded; start a Daemon for each node")
+	}
+	if cfg.Journal != "" {
+		return nil, fmt.Errorf("A journal can't be replayed by an embedded node")
+	}
+	if err := cfg.check(); err != nil {
+		return nil, err
+	}
+
+	s := new(state.State)
+	s.SetLeaderTimestamp(primitives.NewTimestampFromMilliseconds(0))
+	if err := applyConfig(s, cfg); err != nil {
+		return nil, err
+	}
+	ci, err := networkFor(s, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetOut(false)
+	s.Init()
+	s.SetDropRate(cfg.DropRate)
+	setupFirstAuthority(s)
+	s.IntiateNetworkSkeletonIdentity()
+
+	d := new(Daemon)
+	d.State = s
+	d.node = new(FactomNode)
+	d.node.State = s
+	d.node.MLog = new(MsgLog)
+	d.node.MLog.Init(cfg.RuntimeLog, 1)
+	d.node.Router = NewPeerRouter(d.node)
+	for _, peer := range cfg.SimPeers {
+		d.node.Peers = append(d.node.Peers, peer)
+	}
+	nodes := []*FactomNode{d.node}
+
+	connectionMetricsChannel := make(chan interface{}, p2p.StandardChannelSize)
+	ci.ConnectionMetricsChannel = connectionMetricsChannel
+	p2p.NetworkDeadline = time.Duration(cfg.Deadline) * time.Millisecond
+	if cfg.EnableNet {
+		d.network, d.proxy = startNetwork(ci, nodes, cfg)
+	}
+
+	startServers(nodes, true)
+	if cfg.Audit >= 0 {
+		s.Supervise("balance audit", func() { s.RunBalanceAudit(time.Duration(cfg.Audit) * time.Millisecond) })
+	}
+	if s.FEROracle != nil {
+		s.Supervise("fer oracle", s.RunFEROracle)
+	}
+	if s.Scheduler != nil {
+		s.Supervise("scheduler", s.RunScheduler)
+	}
+	if s.MetricsHistorySeconds > 0 {
+		s.Supervise("metrics history", s.RecordMetricsHistory)
+	}
+
+	if tokenIndex.Registered() {
+		s.Supervise("token indexers", func() { tokenIndex.Run(s) })
+	}
+
+	wsapi.Start(s)
+
+	state.RegisterPrometheus()
+	p2p.RegisterPrometheus()
+	leveldb.RegisterPrometheus()
+	RegisterPrometheus()
+
+	panel := controlPanel.NewControlPanel(s.ControlPanelChannel, s, d.network, Build)
+	s.Supervise("control panel", func() { panel.Serve(connectionMetricsChannel) })
+	return d, nil
+}
+
+// Node returns the Daemon's node, to compare with others (see CompareNodes).
+func (d *Daemon) Node() *FactomNode {
+	return d.node
+}
+
+// Stop shuts the node down the way an interrupt shuts down the command line: it finishes the
+// minute in progress (see state/shutdown.go), closes the database, and stops the network and
+// the API.  A stopped Daemon can't be started again.
+func (d *Daemon) Stop() {
+	d.stopOnce.Do(func() {
+		shutdownNodes([]*FactomNode{d.node})
+		if d.network != nil {
+			d.network.NetworkStop()
+			d.proxy.stopProxy()
+		}
+		wsapi.Stop(d.State)
+	})
+}
@@ -20,6 +20,12 @@ var (
 		Name: "factomd_state_broadcast_in_drop_total",
 		Help: "How many messages are dropped due to full queues",
 	})
+
+	// Router, see router.go
+	RouterDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "factomd_engine_router_dropped_total",
+		Help: "Messages from our peers dropped by a middleware of the router, by middleware.",
+	}, []string{"middleware"})
 )
 
 var registered = false
@@ -35,4 +41,5 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(RepeatMsgs)
 	prometheus.MustRegister(BroadInCastQueue)
 	prometheus.MustRegister(BroadCastInQueueDrop)
+	prometheus.MustRegister(RouterDropped)
 }
@@ -6,14 +6,13 @@ package engine
 
 import (
 	"bufio"
-	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/FactomProject/factomd/common/interfaces"
-	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/state"
 )
 
 func LoadJournal(s interfaces.IState, journal string) {
@@ -34,6 +33,8 @@ func LoadJournalFromString(s interfaces.IState, journalStr string) {
 	LoadJournalFromReader(s, r)
 }
 
+// LoadJournalFromReader replays a journal into the State.  Each message is delivered at the
+// time it was journaled, and in the same order, so the State ends up where the journaling node was.
 func LoadJournalFromReader(s interfaces.IState, r *bufio.Reader) {
 	s.SetIsReplaying()
 	defer s.SetIsDoneReplaying()
@@ -49,52 +50,26 @@ func LoadJournalFromReader(s interfaces.IState, r *bufio.Reader) {
 
 		// line is empty if no more data
 		line, err := r.ReadBytes('\n')
-		if len(line) == 0 || err != nil {
+		if len(line) == 0 {
 			break
 		}
 
-		// Get the next word.  If not MsgHex:, then go to next line.
-		adv, word, err := bufio.ScanWords(line, true)
-		if string(word) != "MsgHex:" {
-			continue // Go to next line.
-		}
-		line = line[adv:] // Remove "MsgHex:" from the line.
-
-		// Remove spaces.
-		adv, data, err := bufio.ScanWords(line, true)
-		if err != nil {
-			fmt.Println(err)
+		e, perr := state.ParseJournalLine(line)
+		if perr != nil {
+			fmt.Println(perr)
 			return
 		}
-
-		// Decode the hex
-		binary, err := hex.DecodeString(string(data))
-		if err != nil {
-			fmt.Println(err)
-			return
+		if e != nil {
+			// Process the message.
+			s.ReplayMessage(e.Msg, e.Delivered, e.Local)
+			p++
 		}
-
-		// Unmarshal the message.
-		msg, err := messages.UnmarshalMessage(binary)
 		if err != nil {
-			fmt.Println(err)
-			return
-		}
-
-		// Process the message.
-		s.InMsgQueue().Enqueue(msg)
-		p++
-		if s.InMsgQueue().Length() > 200 {
-			for s.InMsgQueue().Length() > 50 {
-				time.Sleep(time.Millisecond * 10)
-			}
-			time.Sleep(time.Millisecond * 100)
+			break
 		}
 	}
 
-	//Waiting for state to process the message queue
+	//Waiting for state to take the last message
 	//before we disable "IsDoneReplaying"
-	for s.InMsgQueue().Length() > 0 {
-		time.Sleep(time.Millisecond * 100)
-	}
+	s.ReplayMessage(nil, nil, false)
 }
@@ -0,0 +1,269 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package nettest runs a network of factomd nodes inside one process, for integration tests.
+// Each node is an embedded engine.Daemon, with its own database, ports and home directory, and
+// the nodes are linked to each other as the simulator links its nodes (see engine.LinkSimPeers)
+// rather than over the p2p network.  They share a virtual clock, so a test spanning many blocks
+// runs in seconds.
+//
+// A test starts a Network, sends it traffic, kills and rejoins nodes, and checks the nodes end
+// up agreeing (see engine.CompareNodes):
+//
+//	n, err := nettest.New(nettest.Options{Nodes: 3})
+//	...
+//	defer n.Stop()
+//	n.WaitForHeight(2, time.Minute)
+//	n.SendFactoids(0, 10)
+//	n.Kill(2)
+//	n.Rejoin(2)
+//	if c := n.WaitToAgree(time.Minute); !c.Ok() { ... }
+//
+// Node 0 is the only leader, the LOCAL network's first authority; the rest are followers.  Tests
+// of elections need more authorities, which are set up by identity chains a test makes itself.
+//
+// The tests of this package run a network, so they are built with the integration tag:
+//
+//	go test -tags integration ./engine/nettest/
+package nettest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/FactomProject/factomd/clock"
+	"github.com/FactomProject/factomd/engine"
+)
+
+// Options say how to build a Network.  The zero value of each field gets a default.
+type Options struct {
+	Nodes     int                             // Number of nodes; 3 if 0
+	BasePort  int                             // Node i serves its API on BasePort+2i and its control panel on the port after; 18088 if 0
+	TimeRate  float64                         // How much faster than real time the virtual clock runs; 10 if 0
+	BlkTime   int                             // Seconds per block in virtual time; 60 if 0
+	Dir       string                          // Where the nodes keep their files; a temporary directory, removed by Stop, if empty
+	DBType    string                          // Database of every node, LDB, Bolt or Map; Map if empty
+	Configure func(i int, cfg *engine.Config) // Called with each node's Config before it is started, to change it
+}
+
+// A Node is one node of a Network.  Daemon is nil while the node is killed.
+type Node struct {
+	Index  int
+	Config *engine.Config
+	Daemon *engine.Daemon
+}
+
+// A Network is a set of nodes in one process, every one linked to every other.
+type Network struct {
+	Nodes []*Node
+	Clock *clock.Virtual
+
+	options Options
+	tempDir bool // Dir was made by New, and is removed by Stop
+}
+
+// New starts a network as Options say, and returns once every node is running.  The nodes sync in
+// the background.
+func New(options Options) (*Network, error) {
+	if options.Nodes == 0 {
+		options.Nodes = 3
+	}
+	if options.BasePort == 0 {
+		options.BasePort = 18088
+	}
+	if options.TimeRate == 0 {
+		options.TimeRate = 10
+	}
+	if options.BlkTime == 0 {
+		options.BlkTime = 60
+	}
+	if options.DBType == "" {
+		options.DBType = "Map"
+	}
+
+	n := new(Network)
+	if options.Dir == "" {
+		dir, err := ioutil.TempDir("", "nettest")
+		if err != nil {
+			return nil, err
+		}
+		options.Dir = dir
+		n.tempDir = true
+	}
+	n.options = options
+	n.Clock = clock.NewVirtual(options.TimeRate)
+
+	for i := 0; i < options.Nodes; i++ {
+		cfg, err := n.nodeConfig(i)
+		if err != nil {
+			n.Stop()
+			return nil, err
+		}
+		n.Nodes = append(n.Nodes, &Node{Index: i, Config: cfg})
+	}
+
+	// Link every pair of nodes, as AddSimPeer does in the simulator
+	for i := range n.Nodes {
+		for j := i + 1; j < len(n.Nodes); j++ {
+			a, b := n.Nodes[i].Config, n.Nodes[j].Config
+			ab, ba := engine.LinkSimPeers(a.Prefix+"FNode0", b.Prefix+"FNode0")
+			a.SimPeers = append(a.SimPeers, ab)
+			b.SimPeers = append(b.SimPeers, ba)
+		}
+	}
+
+	for i := range n.Nodes {
+		if err := n.start(i); err != nil {
+			n.Stop()
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// nodeConfig writes the factomd.conf of node i, and returns its Config
+func (n *Network) nodeConfig(i int) (*engine.Config, error) {
+	home := filepath.Join(n.options.Dir, fmt.Sprintf("node%d", i))
+	if err := os.MkdirAll(home, 0700); err != nil {
+		return nil, err
+	}
+	file := filepath.Join(home, "factomd.conf")
+	conf := fmt.Sprintf("[app]\nNetwork = LOCAL\nHomeDir = %q\nDBType = %q\n", home, n.options.DBType)
+	if err := ioutil.WriteFile(file, []byte(conf), 0600); err != nil {
+		return nil, err
+	}
+
+	cfg := engine.DefaultConfig()
+	cfg.ConfigFile = file
+	cfg.EnableNet = false
+	cfg.Network = "LOCAL"
+	cfg.PortOverride = n.options.BasePort + 2*i
+	cfg.ControlPanelPortOverride = n.options.BasePort + 2*i + 1
+	cfg.VirtualClock = n.Clock
+	cfg.BlkTime = n.options.BlkTime
+	cfg.Fast = false
+	if i > 0 {
+		// Only node 0 has the leader's identity; the names must differ, as the peers go by them
+		cfg.Follower = true
+		cfg.Prefix = fmt.Sprintf("Node%d-", i)
+	}
+	if n.options.Configure != nil {
+		n.options.Configure(i, cfg)
+	}
+	return cfg, nil
+}
+
+func (n *Network) start(i int) error {
+	node := n.Nodes[i]
+	if node.Daemon != nil {
+		return fmt.Errorf("Node %d is running", i)
+	}
+	d, err := engine.Start(node.Config)
+	if err != nil {
+		return fmt.Errorf("Node %d: %s", i, err.Error())
+	}
+	node.Daemon = d
+	return nil
+}
+
+// Kill stops node i, as an interrupt stops a node.  Messages sent to it while it is down are
+// dropped when it rejoins, so it has to catch up the way a node that was offline does.
+func (n *Network) Kill(i int) {
+	node := n.Nodes[i]
+	if node.Daemon == nil {
+		return
+	}
+	node.Daemon.Stop()
+	node.Daemon = nil
+}
+
+// Rejoin starts node i again, with the Config and database it had, after a Kill.  With the Map
+// database it starts from nothing, and syncs everything from the others.
+func (n *Network) Rejoin(i int) error {
+	for _, peer := range n.Nodes[i].Config.SimPeers {
+		drainSimPeer(peer)
+	}
+	return n.start(i)
+}
+
+// drainSimPeer drops whatever was sent to a peer while its node was down
+func drainSimPeer(peer *engine.SimPeer) {
+	for {
+		select {
+		case <-peer.BroadcastIn:
+		default:
+			peer.Delayed = nil
+			return
+		}
+	}
+}
+
+// Running returns the nodes that aren't killed.
+func (n *Network) Running() []*Node {
+	running := []*Node{}
+	for _, node := range n.Nodes {
+		if node.Daemon != nil {
+			running = append(running, node)
+		}
+	}
+	return running
+}
+
+// Stop stops every node, and removes Dir if New made it.
+func (n *Network) Stop() {
+	for i := range n.Nodes {
+		n.Kill(i)
+	}
+	if n.tempDir {
+		os.RemoveAll(n.options.Dir)
+	}
+}
+
+// WaitForHeight waits until every running node has saved the block at height, and returns an
+// error if one hasn't within timeout, real time.
+func (n *Network) WaitForHeight(height uint32, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		behind := -1
+		for _, node := range n.Running() {
+			if node.Daemon.State.GetHighestSavedBlk() < height {
+				behind = node.Index
+				break
+			}
+		}
+		if behind < 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Node %d is at height %d, not %d, after %s", behind,
+				n.Nodes[behind].Daemon.State.GetHighestSavedBlk(), height, timeout)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// Compare compares the running nodes, as they are now.
+func (n *Network) Compare() *engine.NodeComparison {
+	nodes := []*engine.FactomNode{}
+	for _, node := range n.Running() {
+		nodes = append(nodes, node.Daemon.Node())
+	}
+	return engine.CompareNodes(nodes)
+}
+
+// WaitToAgree compares the running nodes until they agree, or timeout passes, real time, and
+// returns the last comparison.
+func (n *Network) WaitToAgree(timeout time.Duration) *engine.NodeComparison {
+	deadline := time.Now().Add(timeout)
+	for {
+		c := n.Compare()
+		if c.Ok() || time.Now().After(deadline) {
+			return c
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
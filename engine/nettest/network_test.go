@@ -0,0 +1,51 @@
+//go:build integration
+// +build integration
+
+package nettest_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/engine/nettest"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestNetworkKillAndRejoin(t *testing.T) {
+	n, err := New(Options{Nodes: 3, BasePort: 28088})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Stop()
+
+	if err := n.WaitForHeight(2, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.SendFactoids(0, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	n.Kill(2)
+	if err := n.WaitForHeight(4, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Rejoin(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForHeight(6, 3*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if c := n.WaitToAgree(time.Minute); !c.Ok() {
+		t.Fatalf("Nodes don't agree:\n%s", c.String())
+	}
+	for _, node := range n.Nodes {
+		fs := node.Daemon.State.GetFactoidState()
+		for k := uint64(1); k <= 5; k++ {
+			address := testHelper.NewFactoidAddress(k).Fixed()
+			if balance := fs.GetFactoidBalance(address); balance != TrafficAmount {
+				t.Errorf("Node %d has a balance of %d for address %d, not %d", node.Index, balance, k, TrafficAmount)
+			}
+		}
+	}
+}
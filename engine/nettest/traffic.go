@@ -0,0 +1,83 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package nettest
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// SandSecret is the private key of the address the LOCAL network's genesis block funds; the
+// traffic of a test is paid from it.
+const SandSecret = "Fs3E9gV6DXsYzf7Fqx1fVBQPQXV695eP3k5XbmHEZVRLkMdD9qCK"
+
+// TrafficAmount is what each transaction of SendFactoids sends, in factoshis.
+const TrafficAmount = 100000000
+
+// SendFactoids submits count factoid transactions to node i, as its API would, each sending
+// TrafficAmount from the sand address to testHelper.NewFactoidAddress(k) for k from 1 to count.
+// It returns their transaction IDs.
+func (n *Network) SendFactoids(i int, count int) ([]interfaces.IHash, error) {
+	node := n.Nodes[i]
+	if node.Daemon == nil {
+		return nil, fmt.Errorf("Node %d is not running", i)
+	}
+	s := node.Daemon.State
+
+	privKey, pubKey, _, err := factoid.PrivateKeyStringToEverythingString(SandSecret)
+	if err != nil {
+		return nil, err
+	}
+	privBytes, err := hex.DecodeString(privKey)
+	if err != nil {
+		return nil, err
+	}
+	sand, err := factoid.PublicKeyStringToFactoidAddress(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	rcd, err := factoid.PublicKeyStringToFactoidRCDAddress(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []interfaces.IHash{}
+	for k := 1; k <= count; k++ {
+		tx := new(factoid.Transaction)
+		tx.AddInput(sand, TrafficAmount)
+		tx.AddOutput(testHelper.NewFactoidAddress(uint64(k)), TrafficAmount)
+		tx.SetTimestamp(s.GetTimestamp())
+
+		fee, err := tx.CalculateFee(s.GetFactoshisPerEC())
+		if err != nil {
+			return ids, err
+		}
+		in, err := tx.GetInput(0)
+		if err != nil {
+			return ids, err
+		}
+		in.SetAmount(in.GetAmount() + fee)
+
+		tx.AddAuthorization(rcd)
+		data, err := tx.MarshalBinarySig()
+		if err != nil {
+			return ids, err
+		}
+		tx.SetSignatureBlock(0, factoid.NewSingleSignatureBlock(privBytes, data))
+
+		msg := new(messages.FactoidTransaction)
+		msg.SetTransaction(tx)
+		if err := s.InjectMessage("api", msg); err != nil {
+			return ids, err
+		}
+		ids = append(ids, tx.GetTxID())
+	}
+	return ids, nil
+}
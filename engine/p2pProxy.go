@@ -15,6 +15,7 @@ import (
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/state"
 )
 
 var _ = fmt.Print
@@ -37,8 +38,10 @@ type P2PProxy struct {
 	debugMode int
 	logging   chan interface{} // NODE_TALK_FIX
 	NumPeers  int
-	bytesOut  int // bandwidth used by applicaiton without netowrk fan out
-	bytesIn   int // bandwidth recieved by application from network
+	bytesOut  int  // bandwidth used by applicaiton without netowrk fan out
+	bytesIn   int  // bandwidth recieved by application from network
+	Compress  bool // Send large reveals and DBStates compressed, see common/messages/compression.go
+	Protobuf  bool // Send messages in their protobuf encoding, see common/messages/protobuf.go
 }
 
 type factomMessage struct {
@@ -46,6 +49,7 @@ type factomMessage struct {
 	PeerHash string
 	AppHash  string
 	AppType  string
+	TraceID  string
 }
 
 func (e *factomMessage) JSONByte() ([]byte, error) {
@@ -108,11 +112,23 @@ func (f *P2PProxy) Send(msg interfaces.IMsg) error {
 		fmt.Println("ERROR on Send: ", err)
 		return err
 	}
+	if f.Compress && len(data) <= p2p.MaxPayloadSize {
+		data = messages.CompressMessage(data)
+	}
+	if f.Protobuf && !messages.IsCompressed(data) {
+		// A compressed message is left as it is; it is smaller than its protobuf form
+		if pb, err := messages.MarshalProtobuf(msg); err == nil && len(pb) <= p2p.MaxPayloadSize {
+			data = pb
+		}
+	}
 	f.bytesOut += len(data)
 	hash := fmt.Sprintf("%x", msg.GetMsgHash().Bytes())
 	appType := fmt.Sprintf("%d", msg.Type())
-	message := factomMessage{Message: data, PeerHash: msg.GetNetworkOrigin(), AppHash: hash, AppType: appType}
+	message := factomMessage{Message: data, PeerHash: msg.GetNetworkOrigin(), AppHash: hash, AppType: appType, TraceID: msg.GetTraceID()}
 	switch {
+	case !msg.IsPeer2Peer() && state.LeaderCritical(msg):
+		message.PeerHash = p2p.CriticalBroadcastFlag
+		f.trace(message.AppHash, message.AppType, "P2PProxy.Send() - CriticalBroadcastFlag", "a")
 	case !msg.IsPeer2Peer():
 		message.PeerHash = p2p.BroadcastFlag
 		f.trace(message.AppHash, message.AppType, "P2PProxy.Send() - BroadcastFlag", "a")
@@ -142,6 +158,7 @@ func (f *P2PProxy) Recieve() (interfaces.IMsg, error) {
 				msg, err := messages.UnmarshalMessage(fmessage.Message)
 				if nil == err {
 					msg.SetNetworkOrigin(fmessage.PeerHash)
+					msg.SetTraceID(fmessage.TraceID)
 				}
 				// if 1 < f.debugMode {
 				// 	f.logMessage(msg, true) // NODE_TALK_FIX
@@ -283,6 +300,7 @@ func (f *P2PProxy) ManageOutChannel() {
 				parcel.Header.TargetPeer = fmessage.PeerHash
 				parcel.Header.AppHash = fmessage.AppHash
 				parcel.Header.AppType = fmessage.AppType
+				parcel.Header.TraceID = fmessage.TraceID
 				parcel.Trace("P2PProxy.ManageOutChannel()", "b")
 				p2p.BlockFreeChannelSend(f.ToNetwork, parcel)
 			}
@@ -299,7 +317,7 @@ func (f *P2PProxy) ManageInChannel() {
 		case p2p.Parcel:
 			parcel := data.(p2p.Parcel)
 			f.trace(parcel.Header.AppHash, parcel.Header.AppType, "P2PProxy.ManageInChannel()", "M")
-			message := factomMessage{Message: parcel.Payload, PeerHash: parcel.Header.TargetPeer, AppHash: parcel.Header.AppHash, AppType: parcel.Header.AppType}
+			message := factomMessage{Message: parcel.Payload, PeerHash: parcel.Header.TargetPeer, AppHash: parcel.Header.AppHash, AppType: parcel.Header.AppType, TraceID: parcel.Header.TraceID}
 			removed := p2p.BlockFreeChannelSend(f.BroadcastIn, message)
 			BroadInCastQueue.Inc()
 			BroadInCastQueue.Add(float64(-1 * removed))
@@ -17,10 +17,10 @@ import (
 // StartProfiler runs the go pprof tool
 // `go tool pprof http://localhost:6060/debug/pprof/profile`
 // https://golang.org/pkg/net/http/pprof/
-func StartProfiler(mpr int) {
+func StartProfiler(mpr int, port string) {
 	_ = log.Print
 	runtime.MemProfileRate = mpr
-	log.Println(http.ListenAndServe(fmt.Sprintf("localhost:%s", logPort), nil))
+	log.Println(http.ListenAndServe(fmt.Sprintf("localhost:%s", port), nil))
 	//runtime.SetBlockProfileRate(100000)
 }
 
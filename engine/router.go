@@ -0,0 +1,238 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/state"
+)
+
+// Every message a node gets from its peers goes through its MsgRouter on its way to the State
+// (see Peers).  The router hands the message to each middleware registered with it, in order; a
+// middleware can look at the message, count it, log it, change it, or hand back another one, and
+// returns the message for the next, or nil to drop it.  The messages that make it through are
+// queued for the State.
+//
+// A node's router starts with the middleware every node runs (see NewPeerRouter).  Anything else
+// that filters, measures or captures incoming messages registers a middleware of its own, rather
+// than being wired into Peers; a test can register one on a running node, through Daemon.Node.
+
+type MsgMiddleware func(msg interfaces.IMsg) interfaces.IMsg
+
+type namedMiddleware struct {
+	name string
+	fn   MsgMiddleware
+}
+
+type MsgRouter struct {
+	mutex      sync.Mutex
+	middleware []namedMiddleware
+}
+
+// Use adds a middleware after those registered already.  A middleware registered under the name
+// of another replaces it, in its place.
+func (r *MsgRouter) Use(name string, fn MsgMiddleware) {
+	r.UseBefore("", name, fn)
+}
+
+// UseBefore adds a middleware just before the one registered as before, or after all of them if
+// there is none.
+func (r *MsgRouter) UseBefore(before string, name string, fn MsgMiddleware) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Routes in progress keep the list they started with, so build a new one
+	nm := namedMiddleware{name, fn}
+	replace := r.has(name)
+	added := false
+	list := make([]namedMiddleware, 0, len(r.middleware)+1)
+	for _, m := range r.middleware {
+		if m.name == name {
+			list = append(list, nm)
+			added = true
+			continue
+		}
+		if m.name == before && !replace {
+			list = append(list, nm)
+			added = true
+		}
+		list = append(list, m)
+	}
+	if !added {
+		list = append(list, nm)
+	}
+	r.middleware = list
+}
+
+// Remove drops the middleware registered as name; false if there is none.
+func (r *MsgRouter) Remove(name string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	list := make([]namedMiddleware, 0, len(r.middleware))
+	for _, m := range r.middleware {
+		if m.name != name {
+			list = append(list, m)
+		}
+	}
+	removed := len(list) < len(r.middleware)
+	r.middleware = list
+	return removed
+}
+
+// Names returns the names of the middleware, in the order messages go through them.
+func (r *MsgRouter) Names() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	names := []string{}
+	for _, m := range r.middleware {
+		names = append(names, m.name)
+	}
+	return names
+}
+
+func (r *MsgRouter) has(name string) bool {
+	for _, m := range r.middleware {
+		if m.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Route hands msg to each middleware in turn, and returns what is left of it for the State; nil
+// if a middleware dropped it.
+func (r *MsgRouter) Route(msg interfaces.IMsg) interfaces.IMsg {
+	r.mutex.Lock()
+	list := r.middleware
+	r.mutex.Unlock()
+
+	for _, m := range list {
+		if msg = m.fn(msg); msg == nil {
+			RouterDropped.WithLabelValues(m.name).Inc()
+			return nil
+		}
+	}
+	return msg
+}
+
+// NewPeerRouter returns a router with the middleware every node runs on the messages from its
+// peers, in this order:
+//
+//	"tally"   counts the messages by type, if the node keeps a tally
+//	"replay"  drops the messages we have already seen
+//	"log"     adds the messages to the node's message log
+//	"sync"    drops the messages that don't help while we are syncing (see syncFilter)
+func NewPeerRouter(fnode *FactomNode) *MsgRouter {
+	r := new(MsgRouter)
+	r.Use("tally", func(msg interfaces.IMsg) interfaces.IMsg {
+		if fnode.State.MessageTally {
+			fnode.State.TallyReceived(int(msg.Type()))
+		}
+		return msg
+	})
+	r.Use("replay", func(msg interfaces.IMsg) interfaces.IMsg {
+		if !fnode.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, msg.GetRepeatHash().Fixed(),
+			msg.GetTimestamp(),
+			fnode.State.GetTimestamp()) {
+			RepeatMsgs.Inc()
+			fnode.State.CountReplayDrop(state.ReplayFilterNetwork, msg)
+			return nil
+		}
+		return msg
+	})
+	r.Use("log", func(msg interfaces.IMsg) interfaces.IMsg {
+		p := msg.GetOrigin() - 1
+		if p < 0 || p >= len(fnode.Peers) {
+			return msg
+		}
+		in := "PeerIn"
+		if msg.IsPeer2Peer() {
+			in = "P2P In"
+		}
+		fnode.MLog.Add2(fnode, false, fnode.Peers[p].GetNameTo(), fmt.Sprintf("%s %d", in, p+1), true, msg)
+		return msg
+	})
+	r.Use("sync", syncFilter(fnode))
+	return r
+}
+
+// syncFilter returns a middleware that drops the messages that don't help while we are syncing.
+func syncFilter(fnode *FactomNode) MsgMiddleware {
+	// ackHeight is used in ignoreMsg to determine if we should ignore an ackowledgment
+	ackHeight := uint32(0)
+	// When syncing from disk/network we want to selectivly ignore certain msgs to allow
+	// factom to focus on syncing. The following msgs will be ignored:
+	//		Acks:
+	//				Ignore acks below the ackheight, which is set if we get an ack at a height higher than
+	//			  	the ackheight. This is because Acks are for the current block, which we are not at,
+	//				but acks also serve as an indicator as to which height the network is on. So we allow
+	//				1 ack through to set out leader height.
+	//
+	//		Commit/Reveals:
+	//				These fill up our holding map because we are not getting acks. If we have things in the
+	//				holding map, that increases the amount of time it takes to process the holding map, slowing
+	//				down our inmsg queue draining.
+	//
+	//		EOMs:
+	//				Only helpful at the latest height
+	//
+	//		MissingData:
+	//				We should fufill some of these requests, but we should also focus on ourselves while we are syncing.
+	//				If our inmsg queue has too many msgs, then don't help others.
+	ignoreMsg := func(amsg interfaces.IMsg) bool {
+		// Stop uint32 underflow
+		if fnode.State.GetTrueLeaderHeight() < 35 {
+			return false
+		}
+		// If we are syncing up, then apply the filter
+		if fnode.State.GetHighestCompletedBlk() < fnode.State.GetTrueLeaderHeight()-35 {
+			// Discard all commits, reveals, and acks <= the highest ack height we have seen.
+			switch amsg.Type() {
+			case constants.COMMIT_CHAIN_MSG, constants.BOUND_COMMIT_CHAIN_MSG:
+				return true
+			case constants.REVEAL_ENTRY_MSG:
+				return true
+			case constants.COMMIT_ENTRY_MSG, constants.BATCH_COMMIT_ENTRY_MSG:
+				return true
+			case constants.EOM_MSG:
+				return true
+			case constants.MISSING_DATA:
+				if !fnode.State.DBFinished {
+					return true
+				} else if fnode.State.InMsgQueue().Length() > 4000 {
+					// If > 4000, we won't get to this in time anyway. Just drop it since we are behind
+					return true
+				}
+			case constants.ACK_MSG:
+				if amsg.(*messages.Ack).DBHeight <= ackHeight {
+					return true
+				}
+				// Set the highest ack height seen and allow through
+				ackHeight = amsg.(*messages.Ack).DBHeight
+			case constants.ACK_BATCH_MSG:
+				batch := amsg.(*messages.AckBatch)
+				if len(batch.Acks) == 0 || batch.Acks[0].DBHeight <= ackHeight {
+					return true
+				}
+				ackHeight = batch.Acks[0].DBHeight
+			}
+		}
+		return false
+	}
+
+	return func(msg interfaces.IMsg) interfaces.IMsg {
+		if ignoreMsg(msg) {
+			return nil
+		}
+		return msg
+	}
+}
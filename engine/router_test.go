@@ -0,0 +1,63 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestMsgRouter(t *testing.T) {
+	r := new(MsgRouter)
+	seen := []string{}
+	note := func(name string) MsgMiddleware {
+		return func(msg interfaces.IMsg) interfaces.IMsg {
+			seen = append(seen, name)
+			return msg
+		}
+	}
+	r.Use("a", note("a"))
+	r.Use("c", note("c"))
+	r.UseBefore("c", "b", note("b"))
+	if names := r.Names(); !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Errorf("Middleware in the wrong order: %v", names)
+	}
+
+	msg := new(messages.Bounce)
+	if r.Route(msg) != msg || !reflect.DeepEqual(seen, []string{"a", "b", "c"}) {
+		t.Errorf("Message didn't go through every middleware in order: %v", seen)
+	}
+
+	// A middleware can swap the message for another, and drop it
+	other := new(messages.BounceReply)
+	r.Use("b", func(interfaces.IMsg) interfaces.IMsg { return other })
+	seen = nil
+	if r.Route(msg) != other || !reflect.DeepEqual(seen, []string{"a", "c"}) {
+		t.Errorf("Replacing a middleware didn't keep its place: %v", seen)
+	}
+	r.UseBefore("a", "drop", func(interfaces.IMsg) interfaces.IMsg { return nil })
+	seen = nil
+	if r.Route(msg) != nil || len(seen) != 0 {
+		t.Errorf("Dropped message went on to %v", seen)
+	}
+
+	if !r.Remove("drop") || r.Remove("drop") {
+		t.Errorf("Remove should drop a middleware once")
+	}
+	if names := r.Names(); !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Errorf("Middleware after Remove: %v", names)
+	}
+}
+
+func TestPeerRouter(t *testing.T) {
+	r := NewPeerRouter(new(FactomNode))
+	if names := r.Names(); !reflect.DeepEqual(names, []string{"tally", "replay", "log", "sync"}) {
+		t.Errorf("Unexpected default middleware: %v", names)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Comparing the nodes of a simulation checks that they agree after a
+// scenario. Every node is compared to the first: its heights, the directory
+// block and balance hash at the highest block both have saved, and the process
+// lists both are still building. For each process list only the first message
+// that differs in each VM is reported, as everything after it follows from it.
+
+type NodeDivergence struct {
+	Kind      string // height, dblock, balance, or processlist
+	Node      string // The node that differs from the reference
+	Reference string // The first node
+	DBHeight  uint32
+	VM        int // For processlist divergences
+	VMHeight  int
+	Expected  string
+	Found     string
+}
+
+func (d NodeDivergence) String() string {
+	switch d.Kind {
+	case "processlist":
+		return fmt.Sprintf("%s: process list %d VM %d height %d has %s, %s has %s",
+			d.Node, d.DBHeight, d.VM, d.VMHeight, d.Found, d.Reference, d.Expected)
+	case "height":
+		return fmt.Sprintf("%s: %s is %s, %s has %s", d.Node, d.Kind, d.Found, d.Reference, d.Expected)
+	default:
+		return fmt.Sprintf("%s: %s at %d is %s, %s has %s", d.Node, d.Kind, d.DBHeight, d.Found, d.Reference, d.Expected)
+	}
+}
+
+type NodeComparison struct {
+	Divergences []NodeDivergence
+}
+
+// Ok is true if every node agrees with the first
+func (c *NodeComparison) Ok() bool {
+	return len(c.Divergences) == 0
+}
+
+func (c *NodeComparison) String() string {
+	if c.Ok() {
+		return "All nodes agree\n"
+	}
+	out := ""
+	for _, d := range c.Divergences {
+		out += d.String() + "\n"
+	}
+	return out
+}
+
+// CompareFNodes compares the nodes of the running simulation
+func CompareFNodes() *NodeComparison {
+	return CompareNodes(fnodes)
+}
+
+// WaitForFNodesToAgree compares the nodes of the running simulation until they
+// agree or the timeout passes, and returns the last comparison. Nodes that are
+// still catching up on the last messages of a scenario are given time to.
+func WaitForFNodesToAgree(timeout time.Duration) *NodeComparison {
+	deadline := time.Now().Add(timeout)
+	for {
+		c := CompareFNodes()
+		if c.Ok() || time.Now().After(deadline) {
+			return c
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// CompareNodes compares every node to the first
+func CompareNodes(nodes []*FactomNode) *NodeComparison {
+	c := new(NodeComparison)
+	if len(nodes) < 2 {
+		return c
+	}
+	ref := nodes[0]
+	for _, node := range nodes[1:] {
+		c.compareHeights(ref, node)
+		c.compareSaved(ref, node)
+		c.compareProcessLists(ref, node)
+	}
+	return c
+}
+
+func (c *NodeComparison) add(ref *FactomNode, node *FactomNode, d NodeDivergence) {
+	d.Reference = ref.State.FactomNodeName
+	d.Node = node.State.FactomNodeName
+	c.Divergences = append(c.Divergences, d)
+}
+
+func (c *NodeComparison) compareHeights(ref *FactomNode, node *FactomNode) {
+	heights := []struct {
+		name string
+		get  func(*FactomNode) uint32
+	}{
+		{"saved height", func(n *FactomNode) uint32 { return n.State.GetHighestSavedBlk() }},
+		{"complete height", func(n *FactomNode) uint32 { return n.State.GetHighestCompletedBlk() }},
+		{"leader height", func(n *FactomNode) uint32 { return n.State.LLeaderHeight }},
+	}
+	for _, h := range heights {
+		expected, found := h.get(ref), h.get(node)
+		if expected != found {
+			c.add(ref, node, NodeDivergence{Kind: "height", Expected: fmt.Sprintf("%s %d", h.name, expected), Found: fmt.Sprintf("%s %d", h.name, found)})
+		}
+	}
+}
+
+// Compares the directory block and balance hash at the highest height both nodes saved
+func (c *NodeComparison) compareSaved(ref *FactomNode, node *FactomNode) {
+	height := ref.State.GetHighestSavedBlk()
+	if h := node.State.GetHighestSavedBlk(); h < height {
+		height = h
+	}
+
+	refBlock := ref.State.GetDirectoryBlockByHeight(height)
+	nodeBlock := node.State.GetDirectoryBlockByHeight(height)
+	if refBlock != nil && nodeBlock != nil && !refBlock.GetKeyMR().IsSameAs(nodeBlock.GetKeyMR()) {
+		c.add(ref, node, NodeDivergence{Kind: "dblock", DBHeight: height, Expected: refBlock.GetKeyMR().String(), Found: nodeBlock.GetKeyMR().String()})
+	}
+
+	// The balances are only comparable if both nodes stopped at the same block
+	if ref.State.GetHighestSavedBlk() != node.State.GetHighestSavedBlk() {
+		return
+	}
+	refHash := ref.State.FactoidState.GetBalanceHash(false)
+	nodeHash := node.State.FactoidState.GetBalanceHash(false)
+	if !refHash.IsSameAs(nodeHash) {
+		c.add(ref, node, NodeDivergence{Kind: "balance", DBHeight: height, Expected: refHash.String(), Found: nodeHash.String()})
+	}
+}
+
+// Compares the process lists above the saved blocks, up to the lower leader height
+func (c *NodeComparison) compareProcessLists(ref *FactomNode, node *FactomNode) {
+	start := ref.State.GetHighestSavedBlk()
+	if h := node.State.GetHighestSavedBlk(); h < start {
+		start = h
+	}
+	end := ref.State.LLeaderHeight
+	if node.State.LLeaderHeight < end {
+		end = node.State.LLeaderHeight
+	}
+	for height := start + 1; height <= end; height++ {
+		refPL := ref.State.ProcessLists.GetSafe(height)
+		nodePL := node.State.ProcessLists.GetSafe(height)
+		if refPL == nil || nodePL == nil {
+			continue
+		}
+		vms := len(refPL.VMs)
+		if len(nodePL.VMs) < vms {
+			vms = len(nodePL.VMs)
+		}
+		for vm := 0; vm < vms; vm++ {
+			refList, nodeList := refPL.VMs[vm].List, nodePL.VMs[vm].List
+			for i := 0; i < len(refList) && i < len(nodeList); i++ {
+				// A missing message is not a difference, the node may still get it
+				if refList[i] == nil || nodeList[i] == nil {
+					continue
+				}
+				refHash, nodeHash := refList[i].GetMsgHash(), nodeList[i].GetMsgHash()
+				if refHash != nil && nodeHash != nil && !refHash.IsSameAs(nodeHash) {
+					c.add(ref, node, NodeDivergence{Kind: "processlist", DBHeight: height, VM: vm, VMHeight: i,
+						Expected: describeMsg(refList[i]), Found: describeMsg(nodeList[i])})
+					break
+				}
+			}
+		}
+	}
+}
+
+func describeMsg(msg interfaces.IMsg) string {
+	hash := "nil"
+	if h := msg.GetMsgHash(); h != nil {
+		hash = h.String()[:10]
+	}
+	return fmt.Sprintf("%s %s", messages.MessageName(msg.Type()), hash)
+}
@@ -0,0 +1,27 @@
+package engine_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestNodeComparison(t *testing.T) {
+	if !CompareNodes(nil).Ok() || !CompareNodes([]*FactomNode{new(FactomNode)}).Ok() {
+		t.Error("A single node always agrees with itself")
+	}
+
+	c := new(NodeComparison)
+	c.Divergences = append(c.Divergences, NodeDivergence{Kind: "processlist", Node: "FNode02", Reference: "FNode0",
+		DBHeight: 5, VM: 1, VMHeight: 3, Expected: "EOM 0123456789", Found: "Reveal Entry abcdefabcd"})
+	if c.Ok() {
+		t.Error("Expected a divergence")
+	}
+	out := c.String()
+	for _, part := range []string{"FNode02", "process list 5 VM 1 height 3", "Reveal Entry abcdefabcd", "FNode0 has EOM 0123456789"} {
+		if !strings.Contains(out, part) {
+			t.Errorf("Expected %q in %q", part, out)
+		}
+	}
+}
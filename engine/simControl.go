@@ -17,8 +17,6 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
-	"github.com/FactomProject/factomd/controlPanel"
-	"github.com/FactomProject/factomd/p2p"
 	"github.com/FactomProject/factomd/wsapi"
 	"runtime"
 )
@@ -79,8 +77,7 @@ func SimControl(listenTo int) {
 			listenTo = v
 			os.Stderr.WriteString(fmt.Sprintf("Switching to Node %d\n", listenTo))
 			// Update which node will be displayed on the controlPanel page
-			connectionMetricsChannel := make(chan interface{}, p2p.StandardChannelSize)
-			go controlPanel.ServeControlPanel(fnodes[listenTo].State.ControlPanelChannel, fnodes[listenTo].State, connectionMetricsChannel, p2pNetwork, Build)
+			simControlPanel.Show(fnodes[listenTo].State.ControlPanelChannel, fnodes[listenTo].State)
 		} else {
 			switch {
 			case '!' == b[0]:
@@ -1010,6 +1007,35 @@ func SimControl(listenTo int) {
 					}
 				}
 
+			case 'C' == b[0]:
+				os.Stderr.WriteString(CompareFNodes().String())
+
+			case 'L' == b[0]:
+				if listenTo < 0 || listenTo >= len(fnodes) {
+					os.Stderr.WriteString("No Factom Node selected\n")
+					break
+				}
+				nnn, err := strconv.ParseInt(string(b[1:]), 10, 64)
+				if err != nil || nnn < 0 || nnn > 99999 {
+					os.Stderr.WriteString("Specify a processing delay in milliseconds less than 100 seconds\n")
+					break
+				}
+				fnodes[listenTo].State.ProcessDelay = nnn
+				os.Stderr.WriteString(fmt.Sprintf("Setting the processing delay of %10s to %2d.%03d Seconds\n", fnodes[listenTo].State.FactomNodeName, nnn/1000, nnn%1000))
+
+			case 'K' == b[0]:
+				if listenTo < 0 || listenTo >= len(fnodes) {
+					os.Stderr.WriteString("No Factom Node selected\n")
+					break
+				}
+				nnn, err := strconv.ParseInt(string(b[1:]), 10, 64)
+				if err != nil {
+					os.Stderr.WriteString("Specify a clock skew in milliseconds, eg K-5000\n")
+					break
+				}
+				fnodes[listenTo].State.ClockSkew = nnn
+				os.Stderr.WriteString(fmt.Sprintf("Setting the clock skew of %10s to %d milliseconds\n", fnodes[listenTo].State.FactomNodeName, nnn))
+
 			case 'h' == b[0]:
 				os.Stderr.WriteString("-------------------------------------------------------------------------------\n")
 				os.Stderr.WriteString("<enter>       Running Enter with nothing repeats the previous command.\n\n")
@@ -1054,6 +1080,9 @@ func SimControl(listenTo int) {
 				os.Stderr.WriteString("Dnnn          Set the Delay on messages from the current node to nnn milliseconds\n")
 				os.Stderr.WriteString("Fnnn          Set the Delay on messages from all nodes to nnn milliseconds\n")
 				os.Stderr.WriteString("/             Toggle the sort order between ChainID and Factom Node Name\n")
+				os.Stderr.WriteString("C             Compare the heights, balances and process lists of all nodes to the first\n")
+				os.Stderr.WriteString("Lnnn          Hold messages from peers to the current node nnn milliseconds before processing them\n")
+				os.Stderr.WriteString("Knnn          Skew the clock of the current node by nnn milliseconds, which may be negative\n")
 
 				//os.Stderr.WriteString("i[m/b/a][N]   Shows only the Mhash, block signing key, or anchor key up to the Nth identity\n")
 				//os.Stderr.WriteString("isN           Shows only Nth identity\n")
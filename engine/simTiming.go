@@ -0,0 +1,102 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Per node timing for the simulator. A node's ProcessDelay holds every
+// message from its peers for a fixed time before it is processed, and its
+// ClockSkew moves the node's clock. Both are fixed rather than random, so
+// timing problems such as late EOMs, or timestamps at the edge of the replay
+// window, happen the same way on every run.
+
+type delayedMsg struct {
+	msg      interfaces.IMsg
+	received int64 // Milliseconds
+}
+
+// DelayedMsgs holds messages in the order they were received
+type DelayedMsgs struct {
+	msgs []delayedMsg
+}
+
+func (d *DelayedMsgs) Add(msg interfaces.IMsg, now int64) {
+	d.msgs = append(d.msgs, delayedMsg{msg, now})
+}
+
+// Ready removes and returns the messages held at least delay milliseconds
+func (d *DelayedMsgs) Ready(now int64, delay int64) []interfaces.IMsg {
+	i := 0
+	for i < len(d.msgs) && now-d.msgs[i].received >= delay {
+		i++
+	}
+	if i == 0 {
+		return nil
+	}
+	ready := make([]interfaces.IMsg, i)
+	for j := range ready {
+		ready[j] = d.msgs[j].msg
+	}
+	d.msgs = d.msgs[i:]
+	return ready
+}
+
+func (d *DelayedMsgs) Len() int {
+	return len(d.msgs)
+}
+
+// ParseNodeValues parses a list like "1=500,3=-2000" of node numbers and millisecond values
+func ParseNodeValues(list string) (map[int]int64, error) {
+	values := make(map[int]int64)
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%q is not node=milliseconds", item)
+		}
+		node, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil || node < 0 {
+			return nil, fmt.Errorf("%q is not a node number", parts[0])
+		}
+		value, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number of milliseconds", parts[1])
+		}
+		values[node] = value
+	}
+	return values, nil
+}
+
+// setNodeTiming applies the processdelay and clockskew flags to the nodes
+func setNodeTiming(processDelay string, clockSkew string) {
+	delays, err := ParseNodeValues(processDelay)
+	if err != nil {
+		panic(fmt.Sprintf("Bad value for processdelay: %v", err))
+	}
+	skews, err := ParseNodeValues(clockSkew)
+	if err != nil {
+		panic(fmt.Sprintf("Bad value for clockskew: %v", err))
+	}
+	for i, fnode := range fnodes {
+		if delay, ok := delays[i]; ok {
+			if delay < 0 {
+				panic(fmt.Sprintf("Bad value for processdelay: node %d has a negative delay", i))
+			}
+			fnode.State.ProcessDelay = delay
+		}
+		if skew, ok := skews[i]; ok {
+			fnode.State.ClockSkew = skew
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestDelayedMsgs(t *testing.T) {
+	d := new(DelayedMsgs)
+	msgs := []interfaces.IMsg{new(messages.EOM), new(messages.Ack), new(messages.Heartbeat)}
+	d.Add(msgs[0], 1000)
+	d.Add(msgs[1], 1100)
+	d.Add(msgs[2], 1500)
+
+	if ready := d.Ready(1499, 500); len(ready) != 0 {
+		t.Errorf("Expected no messages yet, found %d", len(ready))
+	}
+	ready := d.Ready(1600, 500)
+	if len(ready) != 2 || ready[0] != msgs[0] || ready[1] != msgs[1] {
+		t.Errorf("Expected the first two messages in order, found %v", ready)
+	}
+	if d.Len() != 1 {
+		t.Errorf("Expected one message held, found %d", d.Len())
+	}
+	ready = d.Ready(1600, 0)
+	if len(ready) != 1 || ready[0] != msgs[2] || d.Len() != 0 {
+		t.Error("Expected the last message with no delay")
+	}
+}
+
+func TestParseNodeValues(t *testing.T) {
+	values, err := ParseNodeValues(" 1=500, 3=-2000,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[1] != 500 || values[3] != -2000 {
+		t.Errorf("Wrong values %v", values)
+	}
+	if values, err := ParseNodeValues(""); err != nil || len(values) != 0 {
+		t.Error("Expected no values")
+	}
+	for _, bad := range []string{"1", "a=5", "-1=5", "1=5s", "1=2=3"} {
+		if _, err := ParseNodeValues(bad); err == nil {
+			t.Errorf("Expected %q to be rejected", bad)
+		}
+	}
+}
@@ -21,7 +21,7 @@ func Timer(state interfaces.IState) {
 	period := int64(state.GetDirectoryBlockInSeconds()) * billion
 	tenthPeriod := period / 10
 
-	now := time.Now().UnixNano() // Time in billionths of a second
+	now := clockNanos(state) // Time in billionths of a second
 
 	wait := tenthPeriod - (now % tenthPeriod)
 
@@ -31,7 +31,7 @@ func Timer(state interfaces.IState) {
 		state.Print(fmt.Sprintf("Time: %v\r\n", time.Now()))
 	}
 
-	time.Sleep(time.Duration(wait))
+	clockSleep(state, time.Duration(wait))
 
 	for {
 		for i := 0; i < 10; i++ {
@@ -41,7 +41,7 @@ func Timer(state interfaces.IState) {
 				time.Sleep(time.Millisecond * 10)
 			}
 
-			now = time.Now().UnixNano()
+			now = clockNanos(state)
 			if now > next {
 				wait = 1
 				for next < now {
@@ -52,13 +52,13 @@ func Timer(state interfaces.IState) {
 				wait = next - now
 				next += tenthPeriod
 			}
-			time.Sleep(time.Duration(wait))
+			clockSleep(state, time.Duration(wait))
 			for state.InMsgQueue().Length() > 5000 {
 				time.Sleep(100 * time.Millisecond)
 			}
 
 			// Delay some number of milliseconds.
-			time.Sleep(time.Duration(state.GetTimeOffset().GetTimeMilli()) * time.Millisecond)
+			clockSleep(state, time.Duration(state.GetTimeOffset().GetTimeMilli())*time.Millisecond)
 
 			state.TickerQueue() <- i
 
@@ -69,6 +69,25 @@ func Timer(state interfaces.IState) {
 	}
 }
 
+// clockNanos is the node's clock, which may be corrected by NTP (see state/clock.go), so that our
+// minutes start when the rest of the network's do.
+func clockNanos(state interfaces.IState) int64 {
+	if st, ok := state.(*s.State); ok {
+		return st.ClockNow().UnixNano()
+	}
+	return time.Now().UnixNano()
+}
+
+// clockSleep sleeps for d of the node's clock, which runs faster than the system clock in tests
+// with a virtual clock.
+func clockSleep(state interfaces.IState, d time.Duration) {
+	if st, ok := state.(*s.State); ok {
+		st.ClockSleep(d)
+		return
+	}
+	time.Sleep(d)
+}
+
 func PrintBusy(state interfaces.IState, i int) {
 	s := state.(*s.State)
 
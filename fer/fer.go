@@ -0,0 +1,122 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package fer holds the rules for the factoid exchange rate (FER): how many
+// factoshis buy one entry credit.  The rate is changed by FER entries on the
+// exchange rate chain, signed by the exchange rate authority.  Every node
+// checks them with Validate and VerifyAuthority, and the Oracle proposes new
+// entries from configured price sources for the node that holds the
+// authority's key.
+package fer
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ed "github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// An entry may expire at most this many blocks after the block it is in
+const MaxExpirationWindow = 12
+
+// The activation height must be within this many blocks of the expiration height
+const MaxActivationSpread = 6
+
+// A rate change takes effect no sooner than this many blocks after the entry's block
+const MinActivationDelay = 2
+
+// Blocks after which a priority no longer has to be beaten
+const PriorityWindow = 12
+
+// Validate checks the heights of an FER entry against the height it was found at (its resident
+// height).
+func Validate(fe interfaces.IFEREntry) error {
+	if fe.GetExpirationHeight() < fe.GetResidentHeight() {
+		return fmt.Errorf("Expired at %d", fe.GetExpirationHeight())
+	}
+	if fe.GetExpirationHeight() > fe.GetResidentHeight()+MaxExpirationWindow {
+		return fmt.Errorf("Expiration height %d is too far out", fe.GetExpirationHeight())
+	}
+	// The check for expiration >= MaxActivationSpread keeps the subtraction from wrapping
+	if fe.GetTargetActivationHeight() > fe.GetExpirationHeight()+MaxActivationSpread ||
+		(fe.GetExpirationHeight() >= MaxActivationSpread &&
+			fe.GetTargetActivationHeight() < fe.GetExpirationHeight()-MaxActivationSpread) {
+		return fmt.Errorf("Target height %d is out of range of the expiration height %d", fe.GetTargetActivationHeight(), fe.GetExpirationHeight())
+	}
+	return nil
+}
+
+// VerifyAuthority is true if the first ExtID of an entry is the signature of its content by the
+// exchange rate authority, whose public key is given in hex.
+func VerifyAuthority(e interfaces.IEBEntry, authorityPublicKey string) bool {
+	pubBytes, err := hex.DecodeString(authorityPublicKey)
+	if err != nil || len(pubBytes) != ed.PublicKeySize {
+		return false
+	}
+	pub := new([ed.PublicKeySize]byte)
+	copy(pub[:], pubBytes)
+
+	extIDs := e.ExternalIDs()
+	if len(extIDs) < 1 || len(extIDs[0]) != ed.SignatureSize {
+		return false
+	}
+	sig := new([ed.SignatureSize]byte)
+	copy(sig[:], extIDs[0])
+	return ed.VerifyCanonical(pub, e.GetContent(), sig)
+}
+
+// PredictiveRate returns the higher of the current rate and a scheduled change, so a commit
+// priced now is still paid for when the change takes effect.
+func PredictiveRate(current uint64, changePrice uint64, changeHeight uint32) uint64 {
+	if changeHeight == 0 || changePrice <= current {
+		return current
+	}
+	return changePrice
+}
+
+// NewEntry returns the entry on the exchange rate chain that publishes an FER entry, signed by
+// the authority's key.
+func NewEntry(chainID interfaces.IHash, fe *specialEntries.FEREntry, authority *primitives.PrivateKey) (*entryBlock.Entry, error) {
+	content, err := json.Marshal(fe)
+	if err != nil {
+		return nil, err
+	}
+	e := entryBlock.NewEntry()
+	e.ChainID = chainID
+	e.ExtIDs = []primitives.ByteSlice{{Bytes: authority.Sign(content).GetSignature()[:]}}
+	e.Content = primitives.ByteSlice{Bytes: content}
+	return e, nil
+}
+
+// NewCommit returns the commit paying for an entry from an entry credit key.
+func NewCommit(e *entryBlock.Entry, ecKey *primitives.PrivateKey, milliTime int64) (*entryCreditBlock.CommitEntry, error) {
+	bin, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	cost, err := util.EntryCost(bin)
+	if err != nil {
+		return nil, err
+	}
+
+	c := entryCreditBlock.NewCommitEntry()
+	c.Version = 0
+	ms := make([]byte, 8)
+	binary.BigEndian.PutUint64(ms, uint64(milliTime))
+	copy(c.MilliTime[:], ms[2:])
+	c.EntryHash = e.GetHash()
+	c.Credits = cost
+	if err := c.Sign(ecKey.Key[:]); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
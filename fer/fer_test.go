@@ -0,0 +1,139 @@
+package fer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/fer"
+)
+
+// The private key of all zeroes is the exchange rate authority of the local network
+const localAuthority = "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29"
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		resident, expiration, target uint32
+		valid                        bool
+	}{
+		{10, 16, 16, true},
+		{10, 10, 4, true},
+		{10, 22, 28, true},
+		{10, 9, 9, false},   // Expired
+		{10, 23, 23, false}, // Expires too far out
+		{10, 16, 23, false}, // Target too far after the expiration
+		{10, 16, 9, false},  // Target too far before the expiration
+	}
+	for i, test := range tests {
+		fe := new(specialEntries.FEREntry)
+		fe.ResidentHeight = test.resident
+		fe.ExpirationHeight = test.expiration
+		fe.TargetActivationHeight = test.target
+		if err := Validate(fe); (err == nil) != test.valid {
+			t.Errorf("Test %d: expected valid %v, got %v", i, test.valid, err)
+		}
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	authority, err := primitives.NewPrivateKeyFromHex(strings.Repeat("00", 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authority.Pub.String() != localAuthority {
+		t.Fatalf("Unexpected authority key %s", authority.Pub.String())
+	}
+
+	fe := new(specialEntries.FEREntry)
+	fe.ExpirationHeight = 16
+	fe.TargetActivationHeight = 16
+	fe.Priority = 1
+	fe.TargetPrice = 12345
+	chainID := primitives.Sha([]byte("FER"))
+
+	e, err := NewEntry(chainID, fe, authority)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.GetChainID().IsSameAs(chainID) {
+		t.Error("The entry is not on the exchange rate chain")
+	}
+	if !VerifyAuthority(e, localAuthority) {
+		t.Error("The entry is not signed by the authority")
+	}
+	if VerifyAuthority(e, primitives.RandomPrivateKey().Pub.String()) {
+		t.Error("The entry verified against another key")
+	}
+	if VerifyAuthority(e, "not hex") {
+		t.Error("The entry verified against a bad key")
+	}
+
+	fe2 := new(specialEntries.FEREntry)
+	if err := fe2.UnmarshalBinary(e.GetContent()); err != nil || fe2.TargetPrice != fe.TargetPrice {
+		t.Errorf("The content did not round trip: %v", err)
+	}
+
+	commit, err := NewCommit(e, primitives.RandomPrivateKey(), 1500000000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !commit.IsValid() || !commit.EntryHash.IsSameAs(e.GetHash()) || commit.Credits != 1 {
+		t.Errorf("Bad commit %v", commit)
+	}
+}
+
+func TestPredictiveRate(t *testing.T) {
+	if PredictiveRate(100, 200, 0) != 100 || PredictiveRate(100, 50, 10) != 100 || PredictiveRate(100, 200, 10) != 200 {
+		t.Error("The predictive rate should be the higher of the current and a scheduled rate")
+	}
+}
+
+func TestParseSources(t *testing.T) {
+	sources, err := ParseSources(" static:0.5, https://example.com/price#data.0.usd ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) != 2 {
+		t.Fatalf("Expected 2 sources, got %d", len(sources))
+	}
+	if price, _ := sources[0].FCTPrice(); price != 0.5 {
+		t.Errorf("Expected a static price of 0.5, got %v", price)
+	}
+	h, ok := sources[1].(*HTTPSource)
+	if !ok || h.URL != "https://example.com/price" || h.Path != "data.0.usd" {
+		t.Errorf("Bad HTTP source %v", sources[1])
+	}
+
+	for _, bad := range []string{"static:-1", "static:x", "ftp://example.com"} {
+		if _, err := ParseSources(bad); err == nil {
+			t.Errorf("Expected an error for %q", bad)
+		}
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	doc := []byte(`{"data": [{"usd": 2.5}, {"usd": "3.25"}], "price": 4}`)
+	tests := []struct {
+		path  string
+		price float64
+	}{
+		{"data.0.usd", 2.5},
+		{"data.1.usd", 3.25},
+		{"price", 4},
+	}
+	for _, test := range tests {
+		price, err := ParsePrice(doc, test.path)
+		if err != nil || price != test.price {
+			t.Errorf("At %q expected %v, got %v, %v", test.path, test.price, price, err)
+		}
+	}
+	for _, bad := range []string{"", "data.2.usd", "data.x", "missing", "price.usd"} {
+		if _, err := ParsePrice(doc, bad); err == nil {
+			t.Errorf("Expected an error at %q", bad)
+		}
+	}
+	if price, err := ParsePrice([]byte("1.5"), ""); err != nil || price != 1.5 {
+		t.Errorf("Expected a bare price of 1.5, got %v, %v", price, err)
+	}
+}
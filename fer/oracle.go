@@ -0,0 +1,156 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package fer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+)
+
+// The Oracle turns the quotes of its sources into FER entries.  It takes the median of the quotes,
+// so one bad source can't move the rate, and proposes a change only when:
+//
+//	enough sources answered (MinSources)
+//	the rate is within the sanity bounds (MinRate and MaxRate), else nothing is proposed
+//	the rate moved by at least MinChange of the current rate
+//	Interval blocks have passed since its last proposal
+//
+// A change is limited to MaxChange of the current rate; a larger move takes several proposals.
+
+// Price of an entry credit in US dollars
+var ECPrice = 0.001
+
+type Quote struct {
+	Source string  `json:"source"`
+	Price  float64 `json:"price"` // Of one factoid in US dollars
+	Error  string  `json:"error,omitempty"`
+}
+
+type Oracle struct {
+	Sources         []Source
+	MinSources      int     // Sources that must answer; a majority if 0
+	MinRate         uint64  // Lowest rate proposed, in factoshis per EC; no bound if 0
+	MaxRate         uint64  // Highest rate proposed; no bound if 0
+	MaxChange       float64 // Largest change in one proposal, a fraction of the current rate; no limit if 0
+	MinChange       float64 // Smallest change worth proposing, a fraction of the current rate
+	ActivationDelay uint32  // Blocks from the proposal to the change
+	Interval        uint32  // Blocks between proposals
+
+	proposed     bool
+	lastProposal uint32
+}
+
+func NewOracle(sources []Source) *Oracle {
+	o := new(Oracle)
+	o.Sources = sources
+	o.MaxChange = 0.25
+	o.MinChange = 0.01
+	o.ActivationDelay = 6
+	o.Interval = PriorityWindow / 2
+	return o
+}
+
+// RateFor returns the factoshis per entry credit for a price of a factoid.
+func RateFor(fctPrice float64) uint64 {
+	if fctPrice <= 0 {
+		return 0
+	}
+	return uint64(math.Floor(ECPrice/fctPrice*1e8 + 0.5))
+}
+
+// Quote asks every source for the price of a factoid, and returns the quotes and their median.
+func (o *Oracle) Quote() ([]Quote, float64, error) {
+	var quotes []Quote
+	var prices []float64
+	for _, source := range o.Sources {
+		q := Quote{Source: source.Name()}
+		price, err := source.FCTPrice()
+		switch {
+		case err != nil:
+			q.Error = err.Error()
+		case price <= 0 || math.IsInf(price, 0) || math.IsNaN(price):
+			q.Error = fmt.Sprintf("Bad price %v", price)
+		default:
+			q.Price = price
+			prices = append(prices, price)
+		}
+		quotes = append(quotes, q)
+	}
+
+	need := o.MinSources
+	if need <= 0 {
+		need = len(o.Sources)/2 + 1
+	}
+	if len(prices) < need {
+		return quotes, 0, fmt.Errorf("%d of %d sources answered, %d are needed", len(prices), len(o.Sources), need)
+	}
+
+	sort.Float64s(prices)
+	median := prices[len(prices)/2]
+	if len(prices)%2 == 0 {
+		median = (prices[len(prices)/2-1] + median) / 2
+	}
+	return quotes, median, nil
+}
+
+// Propose returns the FER entry that moves the current rate toward the sources' rate, or nil if
+// no change is needed yet.  The entry must beat the priority of the last change accepted, and is
+// meant for the block after height.
+func (o *Oracle) Propose(current uint64, priority uint32, height uint32) (*specialEntries.FEREntry, []Quote, error) {
+	if o.proposed && height < o.lastProposal+o.Interval {
+		return nil, nil, nil
+	}
+	quotes, price, err := o.Quote()
+	if err != nil {
+		return nil, quotes, err
+	}
+
+	target := RateFor(price)
+	if (o.MinRate > 0 && target < o.MinRate) || (o.MaxRate > 0 && target > o.MaxRate) || target == 0 {
+		return nil, quotes, fmt.Errorf("A rate of %d is out of bounds", target)
+	}
+	if current > 0 {
+		if o.MaxChange > 0 {
+			step := uint64(float64(current) * o.MaxChange)
+			if target > current+step {
+				target = current + step
+			} else if target+step < current {
+				target = current - step
+			}
+		}
+		diff := target - current
+		if target < current {
+			diff = current - target
+		}
+		if float64(diff) < float64(current)*o.MinChange || diff == 0 {
+			return nil, quotes, nil
+		}
+	}
+
+	delay := o.ActivationDelay
+	if delay < MinActivationDelay {
+		delay = MinActivationDelay
+	}
+	fe := new(specialEntries.FEREntry)
+	fe.Version = "1"
+	fe.ExpirationHeight = height + delay
+	fe.TargetActivationHeight = height + delay
+	fe.Priority = priority + 1
+	fe.TargetPrice = target
+
+	// The entry lands in the next block at the soonest
+	fe.ResidentHeight = height + 1
+	if err := Validate(fe); err != nil {
+		return nil, quotes, err
+	}
+	fe.ResidentHeight = 0
+
+	o.proposed = true
+	o.lastProposal = height
+	return fe, quotes, nil
+}
@@ -0,0 +1,92 @@
+package fer_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/FactomProject/factomd/fer"
+)
+
+type failingSource struct{}
+
+func (failingSource) Name() string               { return "failing" }
+func (failingSource) FCTPrice() (float64, error) { return 0, fmt.Errorf("down") }
+
+func TestRateFor(t *testing.T) {
+	// At $1 a factoid an EC costs 0.001 factoids, 100000 factoshis
+	if r := RateFor(1); r != 100000 {
+		t.Errorf("Expected 100000, got %d", r)
+	}
+	if r := RateFor(0); r != 0 {
+		t.Errorf("Expected 0 for no price, got %d", r)
+	}
+}
+
+func TestOracleQuote(t *testing.T) {
+	o := NewOracle([]Source{&StaticSource{Price: 1}, &StaticSource{Price: 100}, &StaticSource{Price: 2}, failingSource{}})
+	quotes, median, err := o.Quote()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotes) != 4 || quotes[3].Error == "" {
+		t.Errorf("Expected the failing source to be quoted with its error, got %v", quotes)
+	}
+	if median != 2 {
+		t.Errorf("Expected the median 2, got %v", median)
+	}
+
+	o = NewOracle([]Source{&StaticSource{Price: 1}, failingSource{}, failingSource{}})
+	if _, _, err := o.Quote(); err == nil {
+		t.Error("Expected an error when most sources fail")
+	}
+	o.MinSources = 1
+	if _, median, err := o.Quote(); err != nil || median != 1 {
+		t.Errorf("Expected one source to be enough, got %v, %v", median, err)
+	}
+}
+
+func TestOraclePropose(t *testing.T) {
+	price := &StaticSource{Price: 1} // 100000 factoshis per EC
+	o := NewOracle([]Source{price})
+
+	// Limited to a 25% change
+	fe, _, err := o.Propose(50000, 3, 100)
+	if err != nil || fe == nil {
+		t.Fatalf("Expected a proposal, got %v", err)
+	}
+	if fe.TargetPrice != 62500 || fe.Priority != 4 || fe.TargetActivationHeight != 106 || fe.ExpirationHeight != 106 {
+		t.Errorf("Unexpected proposal %v", fe)
+	}
+
+	// Not again until the interval passes
+	if fe, _, _ := o.Propose(62500, 4, 101); fe != nil {
+		t.Error("Proposed again before the interval passed")
+	}
+	fe, _, err = o.Propose(62500, 4, 100+o.Interval)
+	if err != nil || fe == nil || fe.TargetPrice != 78125 {
+		t.Errorf("Expected a proposal of 78125, got %v, %v", fe, err)
+	}
+
+	// Close enough not to change
+	o = NewOracle([]Source{price})
+	if fe, _, err := o.Propose(99500, 0, 100); fe != nil || err != nil {
+		t.Errorf("Expected no proposal for a change under 1%%, got %v, %v", fe, err)
+	}
+
+	// Out of bounds
+	o.MaxRate = 90000
+	if fe, _, err := o.Propose(80000, 0, 100); fe != nil || err == nil {
+		t.Error("Expected no proposal for a rate out of bounds")
+	}
+	o.MaxRate = 0
+	o.MinRate = 200000
+	if fe, _, err := o.Propose(80000, 0, 100); fe != nil || err == nil {
+		t.Error("Expected no proposal for a rate out of bounds")
+	}
+
+	// No current rate, no limit on the change
+	o = NewOracle([]Source{price})
+	if fe, _, err := o.Propose(0, 0, 100); err != nil || fe == nil || fe.TargetPrice != 100000 {
+		t.Errorf("Expected a proposal of 100000, got %v, %v", fe, err)
+	}
+}
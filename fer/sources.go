@@ -0,0 +1,131 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package fer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Source quotes the price of one factoid in US dollars.
+type Source interface {
+	Name() string
+	FCTPrice() (float64, error)
+}
+
+// StaticSource always quotes the same price.  Used for test networks, and as a floor among real
+// sources.
+type StaticSource struct {
+	Price float64
+}
+
+func (s *StaticSource) Name() string {
+	return fmt.Sprintf("static:%v", s.Price)
+}
+
+func (s *StaticSource) FCTPrice() (float64, error) {
+	return s.Price, nil
+}
+
+// Longest an HTTPSource waits for a quote
+var HTTPSourceTimeout = 10 * time.Second
+
+// HTTPSource reads the price from a JSON document at a URL.  Path is the dotted path of the price
+// in the document, like "data.price"; the whole document is the price if it is empty.  The price
+// may be a number or a string holding one.
+type HTTPSource struct {
+	URL  string
+	Path string
+}
+
+func (s *HTTPSource) Name() string {
+	if s.Path == "" {
+		return s.URL
+	}
+	return s.URL + "#" + s.Path
+}
+
+func (s *HTTPSource) FCTPrice() (float64, error) {
+	client := &http.Client{Timeout: HTTPSourceTimeout}
+	resp, err := client.Get(s.URL)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s returned %s", s.URL, resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	return ParsePrice(body, s.Path)
+}
+
+// ParsePrice finds the price at a dotted path in a JSON document.
+func ParsePrice(doc []byte, path string) (float64, error) {
+	var v interface{}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return 0, err
+	}
+	if path != "" {
+		for _, key := range strings.Split(path, ".") {
+			switch node := v.(type) {
+			case map[string]interface{}:
+				v = node[key]
+			case []interface{}:
+				i, err := strconv.Atoi(key)
+				if err != nil || i < 0 || i >= len(node) {
+					return 0, fmt.Errorf("No element %s in %s", key, path)
+				}
+				v = node[i]
+			default:
+				return 0, fmt.Errorf("No %s in %s", key, path)
+			}
+		}
+	}
+
+	switch p := v.(type) {
+	case float64:
+		return p, nil
+	case string:
+		return strconv.ParseFloat(p, 64)
+	}
+	return 0, fmt.Errorf("The price at %q is not a number", path)
+}
+
+// ParseSources reads a comma separated list of sources: "static:<price>" for a StaticSource, or
+// a URL with an optional "#<path>" for an HTTPSource.
+func ParseSources(list string) ([]Source, error) {
+	var sources []Source
+	for _, spec := range strings.Split(list, ",") {
+		spec = strings.TrimSpace(spec)
+		switch {
+		case spec == "":
+			continue
+		case strings.HasPrefix(spec, "static:"):
+			price, err := strconv.ParseFloat(strings.TrimPrefix(spec, "static:"), 64)
+			if err != nil || price <= 0 {
+				return nil, fmt.Errorf("Bad static price %q", spec)
+			}
+			sources = append(sources, &StaticSource{Price: price})
+		case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+			s := new(HTTPSource)
+			s.URL = spec
+			if i := strings.Index(spec, "#"); i >= 0 {
+				s.URL, s.Path = spec[:i], spec[i+1:]
+			}
+			sources = append(sources, s)
+		default:
+			return nil, fmt.Errorf("Unknown price source %q", spec)
+		}
+	}
+	return sources, nil
+}
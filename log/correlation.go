@@ -0,0 +1,29 @@
+package log
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+)
+
+// Correlation IDs tag everything done on behalf of one request or message, so it can be
+// followed through the logs of every subsystem it touches.  An ID is handed out where the
+// work enters the node (an API call, a message from a peer) and carried along with it.
+// Each node picks a random tag at start, so IDs from different nodes don't collide when
+// their logs are aggregated.
+
+var correlationNode string
+var correlationCount uint64
+
+func init() {
+	b := make([]byte, 3)
+	rand.Read(b)
+	correlationNode = hex.EncodeToString(b)
+}
+
+// NewCorrelationID returns a new ID for work coming in from source, e.g. "api" or "p2p".
+func NewCorrelationID(source string) string {
+	n := atomic.AddUint64(&correlationCount, 1)
+	return fmt.Sprintf("%s-%s-%d", source, correlationNode, n)
+}
@@ -0,0 +1,22 @@
+package log_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/FactomProject/factomd/log"
+)
+
+func TestNewCorrelationID(t *testing.T) {
+	a := NewCorrelationID("api")
+	b := NewCorrelationID("api")
+	if a == b {
+		t.Errorf("Correlation IDs are not unique: %v", a)
+	}
+	if !strings.HasPrefix(a, "api-") {
+		t.Errorf("Correlation ID %v does not start with its source", a)
+	}
+	if !strings.HasPrefix(NewCorrelationID("p2p"), "p2p-") {
+		t.Error("Correlation ID does not start with its source")
+	}
+}
@@ -190,6 +190,7 @@ func (logger *FLogger) write(level Level, args ...interface{}) {
 	}
 
 	l := fmt.Sprint(args...) // get string for formatting
+	Ring.Add(level, logger.prefix, l)
 	if level == DebugLvl {
 		fmt.Fprintf(logger.out, "%s [%s] %s: %s\n", debugPrefix(), levelPrefix[level], logger.prefix, l)
 	} else {
@@ -0,0 +1,147 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package log
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Subsystems group the logger prefixes so the control panel can filter the
+// ring buffer without knowing every prefix in use.
+const (
+	SubsystemConsensus = "consensus"
+	SubsystemP2P       = "p2p"
+	SubsystemWsapi     = "wsapi"
+	SubsystemOther     = "other"
+)
+
+var subsystems = map[string]string{
+	"State":      SubsystemConsensus,
+	"Networking": SubsystemP2P,
+	"RPC":        SubsystemWsapi,
+	"SERV":       SubsystemWsapi,
+	"WSAPI":      SubsystemWsapi,
+}
+
+// Subsystem returns the subsystem a logger prefix belongs to
+func Subsystem(prefix string) string {
+	if s, ok := subsystems[prefix]; ok {
+		return s
+	}
+	return SubsystemOther
+}
+
+// LogRecord is a single line written by an FLogger
+type LogRecord struct {
+	Seq       uint64
+	Time      time.Time
+	Level     Level
+	LevelName string
+	Prefix    string
+	Subsystem string
+	Message   string
+}
+
+// RingBuffer keeps the most recent log records in memory
+type RingBuffer struct {
+	lock    sync.RWMutex
+	records []LogRecord
+	next    int
+	full    bool
+	seq     uint64
+}
+
+// DefaultRingSize is the number of records kept by the global ring buffer
+const DefaultRingSize = 2000
+
+// Ring holds the recent output of every FLogger
+var Ring = NewRingBuffer(DefaultRingSize)
+
+// NewRingBuffer makes a ring buffer holding at most size records
+func NewRingBuffer(size int) *RingBuffer {
+	if size < 1 {
+		size = 1
+	}
+	r := new(RingBuffer)
+	r.records = make([]LogRecord, size)
+	return r
+}
+
+// Add stores a record, overwriting the oldest one if the buffer is full
+func (r *RingBuffer) Add(level Level, prefix string, message string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.seq++
+	r.records[r.next] = LogRecord{
+		Seq:       r.seq,
+		Time:      time.Now(),
+		Level:     level,
+		LevelName: levelPrefix[level],
+		Prefix:    prefix,
+		Subsystem: Subsystem(prefix),
+		Message:   message,
+	}
+	r.next++
+	if r.next == len(r.records) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// Len returns the number of records currently held
+func (r *RingBuffer) Len() int {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if r.full {
+		return len(r.records)
+	}
+	return r.next
+}
+
+// Filter returns, oldest first, the records newer than since that are from the
+// given subsystem (empty for all) and at or above the given severity.
+func (r *RingBuffer) Filter(subsystem string, level Level, since uint64) []LogRecord {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	start := 0
+	count := r.next
+	if r.full {
+		start = r.next
+		count = len(r.records)
+	}
+
+	result := make([]LogRecord, 0)
+	for i := 0; i < count; i++ {
+		rec := r.records[(start+i)%len(r.records)]
+		if rec.Seq <= since {
+			continue
+		}
+		if rec.Level > level {
+			continue
+		}
+		if subsystem != "" && rec.Subsystem != subsystem {
+			continue
+		}
+		result = append(result, rec)
+	}
+	return result
+}
+
+// ParseLevel returns the level for the given name and whether it was valid
+func ParseLevel(levelName string) (Level, bool) {
+	for l, name := range levelPrefix {
+		if strings.EqualFold(name, levelName) {
+			return l, true
+		}
+	}
+	if strings.EqualFold(levelName, "none") {
+		return None, true
+	}
+	return WarningLvl, false
+}
@@ -0,0 +1,75 @@
+package log_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/FactomProject/factomd/log"
+)
+
+func TestRingBufferWrap(t *testing.T) {
+	r := NewRingBuffer(3)
+	for i := 0; i < 5; i++ {
+		r.Add(InfoLvl, "State", "msg")
+	}
+	if r.Len() != 3 {
+		t.Errorf("Expected 3 records, found %d", r.Len())
+	}
+	recs := r.Filter("", DebugLvl, 0)
+	if len(recs) != 3 {
+		t.Fatalf("Expected 3 records, found %d", len(recs))
+	}
+	for i, rec := range recs {
+		if rec.Seq != uint64(i+3) {
+			t.Errorf("Record %d has seq %d, expected %d", i, rec.Seq, i+3)
+		}
+	}
+}
+
+func TestRingBufferFilter(t *testing.T) {
+	r := NewRingBuffer(10)
+	r.Add(ErrorLvl, "State", "consensus error")
+	r.Add(DebugLvl, "State", "consensus debug")
+	r.Add(WarningLvl, "Networking", "p2p warning")
+	r.Add(InfoLvl, "WSAPI", "wsapi info")
+
+	if n := len(r.Filter(SubsystemConsensus, DebugLvl, 0)); n != 2 {
+		t.Errorf("Expected 2 consensus records, found %d", n)
+	}
+	if n := len(r.Filter("", WarningLvl, 0)); n != 2 {
+		t.Errorf("Expected 2 records at warning or above, found %d", n)
+	}
+	if n := len(r.Filter(SubsystemWsapi, ErrorLvl, 0)); n != 0 {
+		t.Errorf("Expected no wsapi errors, found %d", n)
+	}
+	if n := len(r.Filter("", DebugLvl, 3)); n != 1 {
+		t.Errorf("Expected 1 record after seq 3, found %d", n)
+	}
+}
+
+func TestLoggerWritesRing(t *testing.T) {
+	buf := new(bytes.Buffer)
+	l := New(buf, "info", "Networking")
+	before := Ring.Len()
+	l.Info("to the ring")
+	l.Debug("filtered out")
+	recs := Ring.Filter(SubsystemP2P, DebugLvl, 0)
+	if len(recs) == 0 || recs[len(recs)-1].Message != "to the ring" {
+		t.Error("Logger output was not added to the ring buffer")
+	}
+	if Ring.Len() != before+1 && Ring.Len() != DefaultRingSize {
+		t.Error("Filtered log line should not be added to the ring buffer")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if l, ok := ParseLevel("warning"); !ok || l != WarningLvl {
+		t.Error("Failed to parse warning")
+	}
+	if l, ok := ParseLevel("DEBUG"); !ok || l != DebugLvl {
+		t.Error("Failed to parse DEBUG")
+	}
+	if _, ok := ParseLevel("loud"); ok {
+		t.Error("Should not parse an unknown level")
+	}
+}
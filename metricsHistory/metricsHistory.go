@@ -0,0 +1,277 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package metricsHistory keeps a node's key metrics, like its height, peers and queue depths,
+// over time in a small file, so the control panel can chart them without a Prometheus and
+// Grafana to keep them.  As with rrdtool, the file is a set of rings of fixed size, each keeping
+// the averages of the samples over some step for so many steps back: minutes for a day, say,
+// then quarter hours for a month.  The file never grows, and old averages are written over.
+package metricsHistory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// An Archive keeps the average of the samples of each Step, for the last Slots steps.
+type Archive struct {
+	Step  time.Duration
+	Slots int
+}
+
+// A day of minutes, a month of quarter hours and a year of quarter days
+var DefaultArchives = []Archive{
+	{time.Minute, 24 * 60},
+	{15 * time.Minute, 30 * 24 * 4},
+	{6 * time.Hour, 366 * 4},
+}
+
+// A Point is the average of a series over the step starting at Time, in unix seconds.
+type Point struct {
+	Time  int64   `json:"time"`
+	Value float64 `json:"value"`
+}
+
+type points []Point
+
+func (p points) Len() int           { return len(p) }
+func (p points) Less(i, j int) bool { return p[i].Time < p[j].Time }
+func (p points) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// A DB is a file of archives of the same series.  Its methods are safe from any goroutine.
+type DB struct {
+	mutex    sync.Mutex
+	file     *os.File
+	series   []string
+	archives []*archive
+	last     time.Time // Of the last sample added
+}
+
+type archive struct {
+	Archive
+	offset int64 // Of the first slot in the file
+	step   int64 // The step being summed, in unix seconds; 0 if none
+	sums   []float64
+	counts []int
+}
+
+const magic = "FMH1"
+
+// Open opens the file at path, creating it if need be.  A file kept for other series or
+// archives is started over.
+func Open(path string, series []string, archives []Archive) (*DB, error) {
+	if len(series) == 0 || len(archives) == 0 {
+		return nil, fmt.Errorf("A metrics history needs series and archives")
+	}
+	header := new(bytes.Buffer)
+	header.WriteString(magic)
+	binary.Write(header, binary.BigEndian, uint32(len(series)))
+	binary.Write(header, binary.BigEndian, uint32(len(archives)))
+	for _, a := range archives {
+		if a.Step < time.Second || a.Slots < 1 {
+			return nil, fmt.Errorf("Bad archive of %d steps of %v", a.Slots, a.Step)
+		}
+		binary.Write(header, binary.BigEndian, int64(a.Step))
+		binary.Write(header, binary.BigEndian, int64(a.Slots))
+	}
+	for _, name := range series {
+		binary.Write(header, binary.BigEndian, uint32(len(name)))
+		header.WriteString(name)
+	}
+
+	db := new(DB)
+	db.series = append([]string(nil), series...)
+	offset := int64(header.Len())
+	for _, a := range archives {
+		db.archives = append(db.archives, &archive{
+			Archive: a,
+			offset:  offset,
+			sums:    make([]float64, len(series)),
+			counts:  make([]int, len(series)),
+		})
+		offset += int64(a.Slots) * db.slotSize()
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	db.file = file
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	old := make([]byte, header.Len())
+	if info.Size() != offset || readAt(file, old, 0) != nil || !bytes.Equal(old, header.Bytes()) {
+		// Truncating to nothing first zeroes every slot, which reads as empty
+		err = file.Truncate(0)
+		if err == nil {
+			_, err = file.WriteAt(header.Bytes(), 0)
+		}
+		if err == nil {
+			err = file.Truncate(offset)
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	if err := db.findLast(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// findLast finds about when the last sample was added, from the latest step of the finest
+// archive, so queries pick the same archives after a restart.
+func (db *DB) findLast() error {
+	a := db.archives[0]
+	size := db.slotSize()
+	data := make([]byte, int64(a.Slots)*size)
+	if err := readAt(db.file, data, a.offset); err != nil {
+		return err
+	}
+	for s := int64(0); s < int64(a.Slots); s++ {
+		t := int64(binary.BigEndian.Uint64(data[s*size:]))
+		if t != 0 && t > db.last.Unix() {
+			db.last = time.Unix(t, 0)
+		}
+	}
+	return nil
+}
+
+func readAt(file *os.File, b []byte, offset int64) error {
+	_, err := file.ReadAt(b, offset)
+	return err
+}
+
+// A slot is the time of its step followed by the average of each series
+func (db *DB) slotSize() int64 {
+	return 8 * int64(1+len(db.series))
+}
+
+// Series returns the names of the series kept.
+func (db *DB) Series() []string {
+	return append([]string(nil), db.series...)
+}
+
+// Add adds a sample of every series, in the order of Series, taken at t.  A value that is NaN
+// wasn't measured, and is left out of the averages.
+func (db *DB) Add(t time.Time, values []float64) error {
+	if len(values) != len(db.series) {
+		return fmt.Errorf("Sample of %d values, for %d series", len(values), len(db.series))
+	}
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	if db.file == nil {
+		return fmt.Errorf("The metrics history is closed")
+	}
+
+	db.last = t
+	slot := make([]byte, db.slotSize())
+	for _, a := range db.archives {
+		step := int64(a.Step / time.Second)
+		start := t.Unix() - t.Unix()%step
+		if start != a.step {
+			a.step = start
+			for i := range a.sums {
+				a.sums[i], a.counts[i] = 0, 0
+			}
+		}
+		for i, v := range values {
+			if !math.IsNaN(v) {
+				a.sums[i] += v
+				a.counts[i]++
+			}
+		}
+
+		// The step is written as it goes, so a query sees it and a restart loses little
+		binary.BigEndian.PutUint64(slot, uint64(start))
+		for i := range values {
+			avg := math.NaN()
+			if a.counts[i] > 0 {
+				avg = a.sums[i] / float64(a.counts[i])
+			}
+			binary.BigEndian.PutUint64(slot[8*(i+1):], math.Float64bits(avg))
+		}
+		index := (start / step) % int64(a.Slots)
+		if _, err := db.file.WriteAt(slot, a.offset+index*db.slotSize()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Query returns the points of series from from to to, oldest first, from the finest archive
+// that reaches back to from, along with the step of its points.
+func (db *DB) Query(series string, from, to time.Time) ([]Point, time.Duration, error) {
+	index := -1
+	for i, name := range db.series {
+		if name == series {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, 0, fmt.Errorf("No series %s is kept", series)
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	if db.file == nil {
+		return nil, 0, fmt.Errorf("The metrics history is closed")
+	}
+
+	last := db.last
+	if last.IsZero() {
+		last = time.Now()
+	}
+	a := db.archives[len(db.archives)-1]
+	for _, candidate := range db.archives {
+		if !from.Before(last.Add(-candidate.Step * time.Duration(candidate.Slots))) {
+			a = candidate
+			break
+		}
+	}
+
+	size := db.slotSize()
+	data := make([]byte, int64(a.Slots)*size)
+	if err := readAt(db.file, data, a.offset); err != nil {
+		return nil, 0, err
+	}
+	var found points
+	for s := int64(0); s < int64(a.Slots); s++ {
+		slot := data[s*size : (s+1)*size]
+		t := int64(binary.BigEndian.Uint64(slot))
+		if t == 0 || t < from.Unix()-int64(a.Step/time.Second) || t > to.Unix() {
+			continue
+		}
+		v := math.Float64frombits(binary.BigEndian.Uint64(slot[8*(index+1):]))
+		if !math.IsNaN(v) {
+			found = append(found, Point{t, v})
+		}
+	}
+	sort.Sort(found)
+	return found, a.Step, nil
+}
+
+// Close closes the file.
+func (db *DB) Close() error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	if db.file == nil {
+		return nil
+	}
+	err := db.file.Close()
+	db.file = nil
+	return err
+}
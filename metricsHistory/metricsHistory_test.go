@@ -0,0 +1,97 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package metricsHistory_test
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/metricsHistory"
+)
+
+var testArchives = []Archive{{time.Minute, 5}, {5 * time.Minute, 4}}
+
+func TestMetricsHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metricsHistory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "history.db")
+
+	db, err := Open(path, []string{"height", "peers"}, testArchives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Add(time.Now(), []float64{1}) == nil {
+		t.Errorf("Added a sample missing a series")
+	}
+
+	// Samples every 20 seconds over 10 minutes, the height going up by one each, with no
+	// peers measured in the last minute
+	start := time.Unix(6000, 0) // On the hour
+	for i := 0; i < 30; i++ {
+		peers := 8.0
+		if i >= 27 {
+			peers = math.NaN()
+		}
+		if err := db.Add(start.Add(time.Duration(i)*20*time.Second), []float64{float64(i), peers}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	end := start.Add(10 * time.Minute)
+
+	// The minutes of the last five, each the average of its three samples
+	check := func(db *DB) {
+		got, step, err := db.Query("height", end.Add(-5*time.Minute), end)
+		if err != nil || step != time.Minute || len(got) != 5 {
+			t.Fatalf("Queried the last 5 minutes: %v at %v, %v", got, step, err)
+		}
+		for i, p := range got {
+			if p.Time != start.Unix()+int64(60*(i+5)) || p.Value != float64(3*(i+5)+1) {
+				t.Errorf("Minute %d is %+v", i, p)
+			}
+		}
+	}
+	check(db)
+	peers, _, _ := db.Query("peers", end.Add(-5*time.Minute), end)
+	if len(peers) != 4 || peers[3].Value != 8 {
+		t.Errorf("Peers are %v", peers)
+	}
+
+	// Further back than the minutes reach are the five minute steps
+	got, step, err := db.Query("height", start, end)
+	if err != nil || step != 5*time.Minute || len(got) != 2 || got[0].Value != 7 || got[1].Value != 22 {
+		t.Errorf("Queried all 10 minutes: %v at %v, %v", got, step, err)
+	}
+	if _, _, err := db.Query("tps", start, end); err == nil {
+		t.Errorf("Queried a series not kept")
+	}
+
+	// The history is kept over a restart, but not for other series
+	db.Close()
+	if db.Add(end, []float64{1, 2}) == nil {
+		t.Errorf("Added to a closed history")
+	}
+	db, err = Open(path, []string{"height", "peers"}, testArchives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(db)
+	db.Close()
+
+	db, err = Open(path, []string{"height", "peers", "tps"}, testArchives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if got, _, _ := db.Query("height", start, end); len(got) != 0 {
+		t.Errorf("Kept %v for other series", got)
+	}
+}
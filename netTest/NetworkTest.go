@@ -193,6 +193,7 @@ func listen() {
 					bounce.Stamps = append(bounce.Stamps, primitives.NewTimestampNow())
 					bounce.Number = cntreq
 					bounce.Name = strings.TrimSpace(bounce.Name) + "-" + name
+					bounce.ResetMarshalCache()
 					cntreq++
 
 					SetMsg(msg)
@@ -10,8 +10,10 @@ import (
 	"hash/crc32"
 	"net"
 	"os"
+	"sync/atomic"
 	"time"
 
+	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/log"
 )
@@ -34,6 +36,8 @@ type Connection struct {
 	TimeLastpacket  time.Time         // Time we last successfully recieved a packet or command.
 	timeLastAttempt time.Time         // time of last attempt to connect via dial
 	timeLastPing    time.Time         // time of last ping sent
+	timeLastHealth  time.Time         // time of last health check ping sent
+	timePingSent    time.Time         // time the ping we await a pong for was sent; zero if none
 	timeLastUpdate  time.Time         // time of last peer update sent
 	timeLastStatus  time.Time         // last time we printed our status for debugging.
 	timeLastMetrics time.Time         // last time we updated metrics
@@ -42,6 +46,7 @@ type Connection struct {
 	isPersistent    bool              // Persistent connections we always redail.
 	notes           string            // Notes about the connection, for debugging (eg: error)
 	metrics         ConnectionMetrics // Metrics about this connection
+	peerVersion     uint32            // Protocol version of the last valid parcel from the peer; 0 until one comes
 	Logger          *log.FLogger
 }
 
@@ -100,8 +105,11 @@ type ConnectionMetrics struct {
 	// Red: Below -50
 	// Yellow: -50 - 100
 	// Green: > 100
-	ConnectionState string // Basic state of the connection
-	ConnectionNotes string // Connectivity notes for the connection
+	ConnectionState string        // Basic state of the connection
+	ConnectionNotes string        // Connectivity notes for the connection
+	PeerVersion     uint16        // Protocol version the peer sends; 0 until it sends a parcel
+	Latency         time.Duration // Round trip of the last ping answered; 0 until one is
+	LastPong        time.Time     // When the last ping was answered
 }
 
 // ConnectionCommand is used to instruct the Connection to carry out some functionality.
@@ -248,6 +256,9 @@ func (c *Connection) runLoop() {
 		case ConnectionOnline:
 			p2pConnectionRunLoopOnline.Inc()
 			c.pingPeer() // sends a ping periodically if things have been quiet
+			if SpecialPeer == c.peer.Type && c.isOutGoing {
+				c.healthCheck() // pings special peers often, to know their latency
+			}
 			if PeerSaveInterval < time.Since(c.timeLastUpdate) {
 				c.updatePeer() // every PeerSaveInterval * 0.90 we send an update peer to the controller.
 			}
@@ -441,6 +452,28 @@ func (c *Connection) handleCommand() {
 func (c *Connection) sendParcel(parcel Parcel) {
 
 	parcel.Header.NodeID = NodeID // Send it out with our ID for loopback.
+	if parcel.Header.Type == TypeMessage && messages.IsCompressed(parcel.Payload) &&
+		atomic.LoadUint32(&c.peerVersion) < uint32(ProtocolVersionCompression) {
+		// The peer can't read a compressed message, or hasn't told us it can yet
+		payload, err := messages.DecompressMessage(parcel.Payload)
+		if err != nil {
+			c.Errors <- err
+			return
+		}
+		parcel.Payload = payload
+		parcel.UpdateHeader()
+	}
+	if parcel.Header.Type == TypeMessage && messages.IsProtobuf(parcel.Payload) &&
+		atomic.LoadUint32(&c.peerVersion) < uint32(ProtocolVersionProtobuf) {
+		// The peer can't read a protobuf message, or hasn't told us it can yet
+		payload, err := messages.ProtobufToBinary(parcel.Payload)
+		if err != nil {
+			c.Errors <- err
+			return
+		}
+		parcel.Payload = payload
+		parcel.UpdateHeader()
+	}
 	c.conn.SetWriteDeadline(time.Now().Add(NetworkDeadline * 500))
 
 	//deadline := time.Now().Add(NetworkDeadline)
@@ -555,6 +588,7 @@ func (c *Connection) handleParcel(parcel Parcel) {
 		c.peer.LastContact = time.Now() // We only update for valid messages (incluidng pings and heartbeats)
 		c.attempts = 0                  // reset since we are clearly in touch now.
 		c.peer.merit()                  // Increase peer quality score.
+		atomic.StoreUint32(&c.peerVersion, uint32(parcel.Header.Version))
 		debug(c.peer.PeerIdent(), "Connection.handleParcel() got ParcelValid %s", parcel.MessageType())
 		if Notes <= CurrentLoggingLevel {
 			parcel.PrintMessageType()
@@ -614,7 +648,12 @@ func (c *Connection) handleParcelTypes(parcel Parcel) {
 		pong := NewParcel(CurrentNetwork, []byte("Pong"))
 		pong.Header.Type = TypePong
 		BlockFreeChannelSend(c.SendChannel, ConnectionParcel{Parcel: *pong})
-	case TypePong: // all we need is the timestamp which is set already
+	case TypePong: // all we need is the timestamp which is set already, and the round trip
+		if !c.timePingSent.IsZero() {
+			c.metrics.LastPong = time.Now()
+			c.metrics.Latency = c.metrics.LastPong.Sub(c.timePingSent)
+			c.timePingSent = time.Time{}
+		}
 		return
 	case TypePeerRequest:
 		BlockFreeChannelSend(c.ReceiveChannel, ConnectionParcel{Parcel: parcel}) // Controller handles these.
@@ -645,15 +684,29 @@ func (c *Connection) pingPeer() {
 			c.goOffline()
 			return
 		} else {
-			parcel := NewParcel(CurrentNetwork, []byte("Ping"))
-			parcel.Header.Type = TypePing
 			c.timeLastPing = time.Now()
 			c.attempts++
-			BlockFreeChannelSend(c.SendChannel, ConnectionParcel{Parcel: *parcel})
+			c.sendPing()
 		}
 	}
 }
 
+// healthCheck pings the peer every PeerGroupHealthInterval, busy or not, so its latency is known;
+// see peerGroups.go
+func (c *Connection) healthCheck() {
+	if PeerGroupHealthInterval < time.Since(c.timeLastHealth) {
+		c.timeLastHealth = time.Now()
+		c.sendPing()
+	}
+}
+
+func (c *Connection) sendPing() {
+	parcel := NewParcel(CurrentNetwork, []byte("Ping"))
+	parcel.Header.Type = TypePing
+	c.timePingSent = time.Now()
+	BlockFreeChannelSend(c.SendChannel, ConnectionParcel{Parcel: *parcel})
+}
+
 func (c *Connection) updatePeer() {
 	c.timeLastUpdate = time.Now()
 	BlockFreeChannelSend(c.ReceiveChannel, ConnectionCommand{Command: ConnectionUpdatingPeer, Peer: c.peer})
@@ -666,6 +719,7 @@ func (c *Connection) updateStats() {
 		c.metrics.PeerQuality = c.peer.QualityScore
 		c.metrics.ConnectionState = connectionStateStrings[c.state]
 		c.metrics.ConnectionNotes = c.notes
+		c.metrics.PeerVersion = uint16(atomic.LoadUint32(&c.peerVersion))
 		verbose(c.peer.PeerIdent(), "updatePeer() SENDING ConnectionUpdateMetrics - Bytes Sent: %d Bytes Received: %d", c.metrics.BytesSent, c.metrics.BytesReceived)
 		BlockFreeChannelSend(c.ReceiveChannel, ConnectionCommand{Command: ConnectionUpdateMetrics, Metrics: c.metrics})
 	}
@@ -15,6 +15,7 @@ import (
 	"math/rand"
 	"net"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -42,6 +43,12 @@ type Controller struct {
 	connectionMetrics           map[string]ConnectionMetrics // map of the metrics indexed by peer hash
 	lastConnectionMetricsUpdate time.Time                    // update once a second.
 
+	peerVersionsMutex sync.Mutex
+	peerVersions      map[uint16]int // Connections by the protocol version of their peer, as of the last metrics update
+
+	peerGroupsMutex sync.Mutex
+	peerGroupStatus []PeerGroupStatus // As of the last metrics update
+
 	discovery Discovery // Our discovery structure
 
 	numberOutgoingConnections  int       // In PeerManagmeent we track this to know whent to dial out.
@@ -50,12 +57,19 @@ type Controller struct {
 	lastDiscoveryRequest       time.Time
 	NodeID                     uint64
 	lastStatusReport           time.Time
-	lastPeerRequest            time.Time       // Last time we asked peers about the peers they know about.
-	specialPeersString         string          // configuration set special peers
-	partsAssembler             *PartsAssembler // a data structure that assembles full messages from received message parts
+	lastPeerRequest            time.Time         // Last time we asked peers about the peers they know about.
+	specialPeersString         string            // configuration set special peers
+	peerGroups                 []PeerGroup       // configuration set groups of special peers, see peerGroups.go
+	peerGroupOf                map[string]string // name of the group of each group peer, by host:port
+	preferredGroup             string            // name of the group sent the messages the leaders depend on
+	partsAssembler             *PartsAssembler   // a data structure that assembles full messages from received message parts
 
 	// Logger
 	Logger *log.FLogger
+
+	// Runs the controller's long-lived goroutines, so they are restarted if they panic.  Left nil,
+	// they are plain goroutines.
+	Supervise func(name string, run func())
 }
 
 type ControllerInit struct {
@@ -65,6 +79,7 @@ type ControllerInit struct {
 	Exclusive                bool             // flag to indicate we should only connect to trusted peers
 	SeedURL                  string           // URL to a source of peer info
 	SpecialPeers             string           // Peers to always connect to at startup, and stay persistent
+	PeerGroups               []PeerGroup      // Named groups of special peers, see peerGroups.go
 	ConnectionMetricsChannel chan interface{} // Channel on which we put the connection metrics map, periodically.
 	LogPath                  string           // Path for logs
 	LogLevel                 string           // Logging level
@@ -189,6 +204,13 @@ func (c *Controller) Init(ci ControllerInit) *Controller {
 	CurrentNetwork = ci.Network
 	OnlySpecialPeers = ci.Exclusive
 	c.specialPeersString = ci.SpecialPeers
+	c.peerGroups = ci.PeerGroups
+	c.peerGroupOf = make(map[string]string)
+	for _, g := range c.peerGroups {
+		for _, address := range g.Peers {
+			c.peerGroupOf[address] = g.Name
+		}
+	}
 	c.lastDiscoveryRequest = time.Now() // Discovery does its own on startup.
 	c.lastConnectionMetricsUpdate = time.Now()
 	c.partsAssembler = new(PartsAssembler).Init()
@@ -208,8 +230,17 @@ func (c *Controller) StartNetwork() {
 	c.listen()
 	// Dial the peers in from configuration
 	c.DialSpecialPeersString(c.specialPeersString)
+	c.dialPeerGroups()
 	// Start the runloop
-	go c.runloop()
+	c.spawn("p2p runloop", c.runloop)
+}
+
+func (c *Controller) spawn(name string, run func()) {
+	if c.Supervise == nil {
+		go run()
+		return
+	}
+	c.Supervise(name, run)
 }
 
 // DialSpecialPeersString lets us pass in a string of special peers to dial
@@ -271,6 +302,18 @@ func (c *Controller) GetNumberConnections() int {
 	return len(c.connections)
 }
 
+// PeerVersions returns the number of connections by the protocol version their peer sends; a
+// peer that has sent nothing yet is counted under 0.  Updated once a second.
+func (c *Controller) PeerVersions() map[uint16]int {
+	c.peerVersionsMutex.Lock()
+	defer c.peerVersionsMutex.Unlock()
+	versions := make(map[uint16]int, len(c.peerVersions))
+	for v, n := range c.peerVersions {
+		versions[v] = n
+	}
+	return versions
+}
+
 //////////////////////////////////////////////////////////////////////
 //
 // Private API (unexported)
@@ -291,7 +334,7 @@ func (c *Controller) listen() {
 	if nil != err {
 		logfatal("ctrlr", "Controller.listen() Error: %+v", err)
 	} else {
-		go c.acceptLoop(listener)
+		c.spawn("p2p accept", func() { c.acceptLoop(listener) })
 	}
 }
 
@@ -419,7 +462,9 @@ func (c *Controller) route() {
 		parcel := message.(Parcel)
 		TotalMessagesSent++
 		switch parcel.Header.TargetPeer {
-		case BroadcastFlag: // Send to all peers
+		case BroadcastFlag, CriticalBroadcastFlag: // Send to all peers
+			critical := parcel.Header.TargetPeer == CriticalBroadcastFlag
+			parcel.Header.TargetPeer = BroadcastFlag
 
 			// First off, how many nodes are we broadcasting to?  At least 4, if possible.  But 1/4 of the
 			// number of connections if that is more than 4.
@@ -442,12 +487,14 @@ func (c *Controller) route() {
 			cnt := 0
 			start := rand.Int() % clen
 			spot := start
+			sent := map[string]bool{}
 		broadcast:
 			for i := 0; i < 2; i++ {
 				loopcnt := 0
-				for _, connection := range c.connections {
+				for key, connection := range c.connections {
 					if loopcnt == spot {
 						BlockFreeChannelSend(connection.SendChannel, ConnectionParcel{Parcel: parcel})
+						sent[key] = true
 						spot++
 						if spot >= clen {
 							spot = 0
@@ -460,6 +507,15 @@ func (c *Controller) route() {
 					loopcnt++
 				}
 			}
+			// The messages the leaders depend on go to all of the preferred peer group as well
+			if critical {
+				for key, connection := range c.preferredConnections() {
+					if !sent[key] {
+						BlockFreeChannelSend(connection.SendChannel, ConnectionParcel{Parcel: parcel})
+						cnt++
+					}
+				}
+			}
 			SentToPeers.Set(float64(cnt))
 			StartingPoint.Set(float64(start))
 
@@ -696,6 +752,7 @@ func (c *Controller) updateMetrics() {
 		c.lastConnectionMetricsUpdate = time.Now()
 		// Apparently golang doesn't make a deep copy when sending structs over channels. Bad golang.
 		newMetrics := make(map[string]ConnectionMetrics)
+		versions := make(map[uint16]int)
 		for key, value := range c.connections {
 			metrics, present := c.connectionMetrics[value.peer.Hash]
 			if present {
@@ -709,9 +766,17 @@ func (c *Controller) updateMetrics() {
 					PeerQuality:      metrics.PeerQuality,
 					ConnectionState:  metrics.ConnectionState,
 					ConnectionNotes:  metrics.ConnectionNotes,
+					PeerVersion:      metrics.PeerVersion,
+					Latency:          metrics.Latency,
+					LastPong:         metrics.LastPong,
 				}
+				versions[metrics.PeerVersion]++
 			}
 		}
+		c.peerVersionsMutex.Lock()
+		c.peerVersions = versions
+		c.peerVersionsMutex.Unlock()
+		c.updatePeerGroups()
 		dot("@@9\n")
 		BlockFreeChannelSend(c.connectionMetricsChannel, newMetrics)
 		dot("@@10\n")
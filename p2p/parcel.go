@@ -36,6 +36,7 @@ type ParcelHeader struct {
 	PeerPort    string // port of the peer , or we are listening on
 	AppHash     string // Application specific message hash, for tracing
 	AppType     string // Application specific message type, for tracing
+	TraceID     string // Application specific ID of the message for following it in the logs of each node
 }
 
 type ParcelCommandType uint16
@@ -118,6 +119,7 @@ func ReassembleParcel(parcels []*Parcel) *Parcel {
 	assembledParcel.Header.TargetPeer = origHeader.TargetPeer
 	assembledParcel.Header.PeerAddress = origHeader.PeerAddress
 	assembledParcel.Header.PeerPort = origHeader.PeerPort
+	assembledParcel.Header.TraceID = origHeader.TraceID
 
 	return assembledParcel
 }
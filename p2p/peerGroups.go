@@ -0,0 +1,196 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Special peers can also be put in named groups, for a node with trusted peers in several regions.
+// The groups are set in the config file, as name/priority=peers separated by spaces:
+//
+//	MainSpecialPeerGroups = "us-east/1=10.0.0.1:8108,10.0.0.2:8108 eu-west/2=10.1.0.1:8108"
+//
+// The lower the priority, the more a group is preferred; without one it is 0.  The peers of a group
+// are special peers, dialed at start and kept connected, and pinged every PeerGroupHealthInterval
+// to measure their latency.  A peer is healthy while it is online and has answered a ping within
+// PeerGroupHealthTimeout, and a group while any of its peers is.
+//
+// The preferred group is the healthy group of lowest latency among those of the best priority; the
+// rest are backups, one of which is preferred once it is not healthy.  Messages the leaders depend
+// on are broadcast as usual, and also sent to every peer of the preferred group, so they don't wait
+// on a slow or broken region.
+
+// PeerGroup is a named group of special peers.
+type PeerGroup struct {
+	Name     string
+	Priority int      // Lower is preferred
+	Peers    []string // As host:port
+}
+
+// PeerGroupStatus is the state of a peer group, as of the last metrics update.
+type PeerGroupStatus struct {
+	Name      string        `json:"name"`
+	Priority  int           `json:"priority"`
+	Online    int           `json:"online"`    // Peers connected
+	Healthy   int           `json:"healthy"`   // Peers that answered a ping in time
+	Latency   time.Duration `json:"latency"`   // Lowest round trip of its healthy peers
+	Preferred bool          `json:"preferred"` // Sent the messages the leaders depend on
+}
+
+// ParsePeerGroups parses the peer groups of the config file.
+func ParsePeerGroups(s string) ([]PeerGroup, error) {
+	groups := []PeerGroup{}
+	names := map[string]bool{}
+	peers := map[string]string{}
+	for _, field := range strings.Fields(s) {
+		eq := strings.Index(field, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("Peer group %q has no peers, use the form name/priority=host:port,host:port", field)
+		}
+		g := PeerGroup{Name: field[:eq]}
+		if slash := strings.Index(g.Name, "/"); slash >= 0 {
+			priority, err := strconv.Atoi(g.Name[slash+1:])
+			if err != nil {
+				return nil, fmt.Errorf("Peer group %q has a bad priority", field)
+			}
+			g.Name, g.Priority = g.Name[:slash], priority
+		}
+		if g.Name == "" {
+			return nil, fmt.Errorf("Peer group %q has no name", field)
+		}
+		if names[g.Name] {
+			return nil, fmt.Errorf("Peer group %s is given twice", g.Name)
+		}
+		names[g.Name] = true
+
+		for _, peer := range strings.Split(field[eq+1:], ",") {
+			host, port, err := net.SplitHostPort(peer)
+			if err != nil || host == "" || port == "" {
+				return nil, fmt.Errorf("Peer group %s: %q is not a valid peer, use the form 127.0.0.1:8108", g.Name, peer)
+			}
+			if other, ok := peers[peer]; ok {
+				return nil, fmt.Errorf("Peer %s is in both peer groups %s and %s", peer, other, g.Name)
+			}
+			peers[peer] = g.Name
+			g.Peers = append(g.Peers, peer)
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// peerHealthy is true if a connection's metrics show it online and answering pings
+func peerHealthy(metrics ConnectionMetrics, now time.Time) bool {
+	return metrics.ConnectionState == connectionStateStrings[ConnectionOnline] &&
+		!metrics.LastPong.IsZero() && now.Sub(metrics.LastPong) < PeerGroupHealthTimeout
+}
+
+// RankPeerGroups works out the status of each group from the metrics of the connections to their
+// peers, by host:port, and marks the preferred one.  The statuses are sorted by priority, then
+// latency, healthy groups first.
+func RankPeerGroups(groups []PeerGroup, metrics map[string]ConnectionMetrics, now time.Time) []PeerGroupStatus {
+	status := make([]PeerGroupStatus, len(groups))
+	for i, g := range groups {
+		status[i] = PeerGroupStatus{Name: g.Name, Priority: g.Priority}
+		for _, peer := range g.Peers {
+			m, ok := metrics[peer]
+			if !ok || m.ConnectionState != connectionStateStrings[ConnectionOnline] {
+				continue
+			}
+			status[i].Online++
+			if peerHealthy(m, now) {
+				if status[i].Healthy == 0 || m.Latency < status[i].Latency {
+					status[i].Latency = m.Latency
+				}
+				status[i].Healthy++
+			}
+		}
+	}
+	sort.Stable(peerGroupsByPreference(status))
+	if len(status) > 0 && status[0].Healthy > 0 {
+		status[0].Preferred = true
+	}
+	return status
+}
+
+type peerGroupsByPreference []PeerGroupStatus
+
+func (s peerGroupsByPreference) Len() int      { return len(s) }
+func (s peerGroupsByPreference) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s peerGroupsByPreference) Less(i, j int) bool {
+	if (s[i].Healthy > 0) != (s[j].Healthy > 0) {
+		return s[i].Healthy > 0
+	}
+	if s[i].Priority != s[j].Priority {
+		return s[i].Priority < s[j].Priority
+	}
+	return s[i].Latency < s[j].Latency
+}
+
+// dialPeerGroups dials the peers of every group, as special peers
+func (c *Controller) dialPeerGroups() {
+	for _, g := range c.peerGroups {
+		for _, address := range g.Peers {
+			host, port, _ := net.SplitHostPort(address)
+			peer := new(Peer).Init(host, port, 0, SpecialPeer, 0)
+			peer.Source["Peer-Group-"+g.Name] = time.Now()
+			c.DialPeer(*peer, true)
+		}
+	}
+}
+
+// updatePeerGroups works out the status of the peer groups, and which is preferred
+func (c *Controller) updatePeerGroups() {
+	if len(c.peerGroups) == 0 {
+		return
+	}
+	metrics := map[string]ConnectionMetrics{}
+	for hash, connection := range c.connections {
+		if m, ok := c.connectionMetrics[hash]; ok && connection.IsOutGoing() {
+			metrics[connection.peer.AddressPort()] = m
+		}
+	}
+	status := RankPeerGroups(c.peerGroups, metrics, time.Now())
+
+	preferred := ""
+	if status[0].Preferred {
+		preferred = status[0].Name
+	}
+	if preferred != c.preferredGroup {
+		significant("ctrlr", "Controller.updatePeerGroups() preferred peer group is now %q, was %q", preferred, c.preferredGroup)
+		c.preferredGroup = preferred
+	}
+
+	c.peerGroupsMutex.Lock()
+	c.peerGroupStatus = status
+	c.peerGroupsMutex.Unlock()
+}
+
+// preferredConnections returns the connections to the peers of the preferred group
+func (c *Controller) preferredConnections() map[string]*Connection {
+	preferred := map[string]*Connection{}
+	if c.preferredGroup == "" {
+		return preferred
+	}
+	for hash, connection := range c.connections {
+		if connection.IsOutGoing() && connection.IsOnline() && c.peerGroupOf[connection.peer.AddressPort()] == c.preferredGroup {
+			preferred[hash] = connection
+		}
+	}
+	return preferred
+}
+
+// PeerGroups returns the status of the peer groups, preferred first.  Updated once a second.
+func (c *Controller) PeerGroups() []PeerGroupStatus {
+	c.peerGroupsMutex.Lock()
+	defer c.peerGroupsMutex.Unlock()
+	return append([]PeerGroupStatus{}, c.peerGroupStatus...)
+}
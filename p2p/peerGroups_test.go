@@ -0,0 +1,88 @@
+package p2p_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/p2p"
+)
+
+func TestParsePeerGroups(t *testing.T) {
+	groups, err := ParsePeerGroups("us-east/1=10.0.0.1:8108,10.0.0.2:8108 eu-west=10.1.0.1:8108")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Exp 2 groups, got %d", len(groups))
+	}
+	if groups[0].Name != "us-east" || groups[0].Priority != 1 || len(groups[0].Peers) != 2 {
+		t.Errorf("Bad first group %+v", groups[0])
+	}
+	if groups[1].Name != "eu-west" || groups[1].Priority != 0 || groups[1].Peers[0] != "10.1.0.1:8108" {
+		t.Errorf("Bad second group %+v", groups[1])
+	}
+
+	groups, err = ParsePeerGroups("")
+	if err != nil || len(groups) != 0 {
+		t.Errorf("Exp no groups and no error, got %v %v", groups, err)
+	}
+
+	for _, bad := range []string{
+		"us-east",
+		"us-east/x=10.0.0.1:8108",
+		"/1=10.0.0.1:8108",
+		"us-east=10.0.0.1",
+		"us-east=10.0.0.1:8108, ",
+		"us-east=10.0.0.1:8108 us-east=10.0.0.2:8108",
+		"us-east=10.0.0.1:8108 eu-west=10.0.0.1:8108",
+	} {
+		if _, err := ParsePeerGroups(bad); err == nil {
+			t.Errorf("Exp an error for %q", bad)
+		}
+	}
+}
+
+func TestRankPeerGroups(t *testing.T) {
+	groups, err := ParsePeerGroups("near/1=10.0.0.1:8108,10.0.0.2:8108 far/1=10.1.0.1:8108 backup/2=10.2.0.1:8108")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	online := func(latency time.Duration, pong time.Duration) ConnectionMetrics {
+		return ConnectionMetrics{ConnectionState: "Online", Latency: latency, LastPong: now.Add(-pong)}
+	}
+	metrics := map[string]ConnectionMetrics{
+		"10.0.0.1:8108": online(80*time.Millisecond, time.Second),
+		"10.0.0.2:8108": online(20*time.Millisecond, time.Second),
+		"10.1.0.1:8108": online(50*time.Millisecond, time.Second),
+		"10.2.0.1:8108": online(5*time.Millisecond, time.Second),
+	}
+
+	// Best priority first, then lowest latency
+	status := RankPeerGroups(groups, metrics, now)
+	if status[0].Name != "near" || !status[0].Preferred || status[0].Latency != 20*time.Millisecond || status[0].Healthy != 2 {
+		t.Errorf("Exp near preferred, got %+v", status)
+	}
+	if status[1].Name != "far" || status[1].Preferred || status[2].Name != "backup" {
+		t.Errorf("Bad order %+v", status)
+	}
+
+	// A group with no peer answering pings is not healthy, and goes after the healthy ones
+	metrics["10.0.0.1:8108"] = online(10*time.Millisecond, 2*PeerGroupHealthTimeout)
+	metrics["10.0.0.2:8108"] = ConnectionMetrics{ConnectionState: "Offline"}
+	status = RankPeerGroups(groups, metrics, now)
+	if status[0].Name != "far" || !status[0].Preferred {
+		t.Errorf("Exp far preferred, got %+v", status)
+	}
+	if status[2].Name != "near" || status[2].Online != 1 || status[2].Healthy != 0 {
+		t.Errorf("Exp near last, got %+v", status)
+	}
+
+	// Nothing healthy, nothing preferred
+	status = RankPeerGroups(groups, map[string]ConnectionMetrics{}, now)
+	for _, s := range status {
+		if s.Preferred {
+			t.Errorf("Exp nothing preferred, got %+v", status)
+		}
+	}
+}
@@ -49,10 +49,11 @@ var (
 	NetworkListenPort                    = "8108"
 	BroadcastFlag                        = "<BROADCAST>"
 	RandomPeerFlag                       = "<RANDOMPEER>"
-	NodeID                        uint64 = 0           // Random number used for loopback protection
-	MinumumQualityScore           int32  = -200        // if a peer's score is less than this we ignore them.
-	BannedQualityScore            int32  = -2147000000 // Used to ban a peer
-	MinumumSharingQualityScore    int32  = 20          // if a peer's score is less than this we don't share them.
+	CriticalBroadcastFlag                = "<CRITICAL>" // Broadcast, and send to the preferred peer group too
+	NodeID                        uint64 = 0            // Random number used for loopback protection
+	MinumumQualityScore           int32  = -200         // if a peer's score is less than this we ignore them.
+	BannedQualityScore            int32  = -2147000000  // Used to ban a peer
+	MinumumSharingQualityScore    int32  = 20           // if a peer's score is less than this we don't share them.
 	OnlySpecialPeers                     = false
 	NetworkDeadline                      = time.Duration(30) * time.Second
 	NumberPeersToConnect                 = 32
@@ -67,6 +68,8 @@ var (
 	PeerSaveInterval                     = time.Second * 30
 	PeerRequestInterval                  = time.Second * 180
 	PeerDiscoveryInterval                = time.Hour * 4
+	PeerGroupHealthInterval              = time.Second * 5  // How often special peers are pinged, see peerGroups.go
+	PeerGroupHealthTimeout               = time.Second * 15 // A special peer that hasn't answered a ping in this long isn't healthy
 
 	// Testing metrics
 	TotalMessagesRecieved       uint64
@@ -80,9 +83,15 @@ var (
 
 const (
 	// ProtocolVersion is the latest version this package supports
-	ProtocolVersion uint16 = 8
+	ProtocolVersion uint16 = 10
 	// ProtocolVersionMinimum is the earliest version this package supports
 	ProtocolVersionMinimum uint16 = 8
+	// ProtocolVersionCompression is the earliest version that takes compressed application
+	// messages (see common/messages/compression.go)
+	ProtocolVersionCompression uint16 = 9
+	// ProtocolVersionProtobuf is the earliest version that takes application messages in their
+	// protobuf encoding (see common/messages/protobuf.go)
+	ProtocolVersionProtobuf uint16 = 10
 )
 
 // NetworkIdentifier represents the P2P network we are participating in (eg: test, nmain, etc.)
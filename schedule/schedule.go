@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package schedule runs a node's housekeeping, like compacting the database or checking the
+// clock, at the times factomd.conf gives for it, and keeps how each task did for the control
+// panel.  Times are given as cron expressions, in local time:
+//
+//	minute hour day-of-month month day-of-week
+//
+// each field being "*", a number, a range "a-b", any of those with a step "/n", or a list of
+// them separated by commas.  Days of the week run from 0, Sunday, to 6, and 7 is Sunday too.  As
+// with cron, if both days are given, a day that matches either will do.  There are shorthands:
+//
+//	@hourly, @daily (or @midnight), @weekly, @monthly, and @every <duration>, as "@every 10m"
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Schedule gives the times a task runs at.
+type Schedule interface {
+	// Next is the first time after after that the task runs, or the zero time if never.
+	Next(after time.Time) time.Time
+}
+
+// Every runs a task every so long.
+type Every time.Duration
+
+func (e Every) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(e))
+}
+
+// Cron runs a task at the times that match a cron expression.  Each field is a set of bits.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	anyDay                        bool // Neither day field was given
+	anyDom, anyDow                bool
+}
+
+// How far ahead Next looks before deciding an expression never matches, as "0 0 30 2 *" doesn't
+const cronHorizon = 5 // years
+
+func (c *Cron) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronHorizon, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.anyDay:
+		return true
+	case c.anyDom:
+		return dow
+	case c.anyDow:
+		return dom
+	}
+	return dom || dow
+}
+
+var shorthands = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// Parse parses a cron expression, or a shorthand.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(spec[len("@every "):]))
+		if err != nil {
+			return nil, fmt.Errorf("Schedule %q: %s", spec, err.Error())
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("Schedule %q is more often than once a second", spec)
+		}
+		return Every(d), nil
+	}
+	if expr, ok := shorthands[spec]; ok {
+		spec = expr
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("Schedule %q doesn't have the 5 fields minute, hour, day of month, month and day of week", spec)
+	}
+	c := new(Cron)
+	var err error
+	bounds := []struct {
+		bits     *uint64
+		min, max int
+	}{{&c.minute, 0, 59}, {&c.hour, 0, 23}, {&c.dom, 1, 31}, {&c.month, 1, 12}, {&c.dow, 0, 7}}
+	for i, b := range bounds {
+		*b.bits, err = parseField(fields[i], b.min, b.max)
+		if err != nil {
+			return nil, fmt.Errorf("Schedule %q: %s", spec, err.Error())
+		}
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1 // 7 is Sunday too
+	}
+	c.anyDom = strings.HasPrefix(fields[2], "*")
+	c.anyDow = strings.HasPrefix(fields[4], "*")
+	c.anyDay = c.anyDom && c.anyDow
+	return c, nil
+}
+
+// parseField returns the bits of the values a field matches.
+func parseField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			step = n
+			part = part[:i]
+		}
+
+		from, to := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			n, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, fmt.Errorf("bad value %q", part)
+			}
+			from, to = n, n
+			if len(bounds) == 2 {
+				if to, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("bad range %q", part)
+				}
+			} else if step > 1 {
+				to = max // "5/15" is from 5 on
+			}
+		}
+		if from < min || to > max || from > to {
+			return 0, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+		for v := from; v <= to; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/schedule"
+)
+
+func TestParse(t *testing.T) {
+	// A Wednesday
+	from := time.Date(2020, time.January, 1, 10, 30, 20, 0, time.UTC)
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2020, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2020, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2020, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 2 1-7 * 1", time.Date(2020, 1, 2, 2, 30, 0, 0, time.UTC)}, // The 2nd, or a Monday
+		{"0 12 29 2 *", time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+		{"10,20 9-17/4 * * 1-5", time.Date(2020, 1, 1, 13, 10, 0, 0, time.UTC)},
+		{"@every 90s", from.Add(90 * time.Second)},
+	}
+	for _, test := range tests {
+		schedule, err := Parse(test.spec)
+		if err != nil {
+			t.Errorf("%s: %s", test.spec, err.Error())
+			continue
+		}
+		if next := schedule.Next(from); !next.Equal(test.next) {
+			t.Errorf("%s: next %v, expected %v", test.spec, next, test.next)
+		}
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@every", "@every 1ms", "@yearly"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parsed %q", spec)
+		}
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 10, 30, 20, 0, time.UTC)
+	s := New()
+	s.Now = func() time.Time { return now }
+
+	hourly, minutely := 0, 0
+	if err := s.Add("hourly", "@hourly", func() error { hourly++; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add("minutely", "* * * * *", func() error {
+		minutely++
+		if minutely == 2 {
+			panic("second run")
+		}
+		return errors.New("failed")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if s.Add("hourly", "@daily", nil) == nil || s.Add("bad", "* *", nil) == nil {
+		t.Errorf("Added a task twice, or one with a bad schedule")
+	}
+
+	if s.RunDue() != 0 {
+		t.Errorf("Ran a task before it was due")
+	}
+	now = now.Add(time.Minute)
+	if s.RunDue() != 1 || minutely != 1 {
+		t.Errorf("Didn't run the task due each minute")
+	}
+	// Runs missed are skipped
+	now = now.Add(time.Hour)
+	if s.RunDue() != 2 || hourly != 1 || minutely != 2 {
+		t.Errorf("Ran the hourly task %d times, the minutely %d", hourly, minutely)
+	}
+
+	status := s.Status()
+	if len(status) != 2 || status[0].Name != "hourly" || status[0].Runs != 1 || status[0].LastError != "" ||
+		!status[0].NextRun.Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Status of the hourly task is %+v", status[0])
+	}
+	if status[1].Runs != 2 || status[1].Failures != 2 || status[1].LastError != "panic: second run" {
+		t.Errorf("Status of the minutely task is %+v", status[1])
+	}
+
+	if err := s.RunNow("hourly"); err != nil || hourly != 2 {
+		t.Errorf("RunNow returned %v, and ran the task %d times", err, hourly)
+	}
+	if s.RunNow("daily") == nil {
+		t.Errorf("Ran a task that isn't scheduled")
+	}
+}
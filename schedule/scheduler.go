@@ -0,0 +1,174 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Status is how a task has done.
+type Status struct {
+	Name         string        `json:"name"`
+	Schedule     string        `json:"schedule"`
+	Running      bool          `json:"running"`
+	Runs         int           `json:"runs"`
+	Failures     int           `json:"failures"`
+	LastStart    time.Time     `json:"laststart"`
+	LastDuration time.Duration `json:"lastduration"`
+	LastError    string        `json:"lasterror,omitempty"` // Of the last run, if it failed
+	NextRun      time.Time     `json:"nextrun"`
+}
+
+type task struct {
+	schedule Schedule
+	run      func() error
+	status   Status
+}
+
+// A Scheduler runs tasks at the times of their schedules.  Tasks run one at a time, from the
+// goroutine that calls Run, so a backup never runs over a compaction; a task that is due while
+// another runs waits for it.  A run that is missed altogether, because a task took longer than
+// its interval, is skipped rather than made up.
+type Scheduler struct {
+	mutex sync.Mutex
+	tasks []*task
+	Now   func() time.Time // The time, for tests; time.Now if nil
+}
+
+func New() *Scheduler {
+	return new(Scheduler)
+}
+
+func (s *Scheduler) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// Add schedules run by spec, as Parse has it.  name must be unique within the Scheduler.
+func (s *Scheduler) Add(name string, spec string, run func() error) error {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, t := range s.tasks {
+		if t.status.Name == name {
+			return fmt.Errorf("Task %s is already scheduled", name)
+		}
+	}
+	t := &task{schedule: schedule, run: run}
+	t.status.Name = name
+	t.status.Schedule = spec
+	t.status.NextRun = schedule.Next(s.now())
+	s.tasks = append(s.tasks, t)
+	return nil
+}
+
+// Run runs the tasks as they come due, until stop returns true.
+func (s *Scheduler) Run(stop func() bool) {
+	for !stop() {
+		s.RunDue()
+		time.Sleep(time.Second)
+	}
+}
+
+// RunDue runs every task that is due, and returns how many it ran.
+func (s *Scheduler) RunDue() int {
+	ran := 0
+	for _, t := range s.due() {
+		s.runTask(t)
+		ran++
+	}
+	return ran
+}
+
+func (s *Scheduler) due() []*task {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	var due []*task
+	for _, t := range s.tasks {
+		if !t.status.NextRun.IsZero() && !now.Before(t.status.NextRun) {
+			due = append(due, t)
+		}
+	}
+	return due
+}
+
+// RunNow runs a task at once, whatever its schedule.
+func (s *Scheduler) RunNow(name string) error {
+	s.mutex.Lock()
+	var found *task
+	for _, t := range s.tasks {
+		if t.status.Name == name {
+			found = t
+		}
+	}
+	s.mutex.Unlock()
+
+	if found == nil {
+		return fmt.Errorf("No task %s is scheduled", name)
+	}
+	s.runTask(found)
+	return nil
+}
+
+func (s *Scheduler) runTask(t *task) {
+	s.mutex.Lock()
+	if t.status.Running {
+		s.mutex.Unlock()
+		return
+	}
+	start := s.now()
+	t.status.Running = true
+	t.status.LastStart = start
+	s.mutex.Unlock()
+
+	err := runSafely(t.run)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	t.status.Running = false
+	t.status.Runs++
+	t.status.LastDuration = now.Sub(start)
+	t.status.LastError = ""
+	if err != nil {
+		t.status.Failures++
+		t.status.LastError = err.Error()
+	}
+	t.status.NextRun = t.schedule.Next(now)
+}
+
+// runSafely runs a task, turning a panic into an error, so one bad task doesn't stop the rest.
+func runSafely(run func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+			fmt.Printf("Scheduled task panicked: %v\n%s\n", r, debug.Stack())
+		}
+	}()
+	return run()
+}
+
+// Status returns the status of each task, in the order they were added.
+func (s *Scheduler) Status() []Status {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	statuses := make([]Status, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		statuses = append(statuses, t.status)
+	}
+	return statuses
+}
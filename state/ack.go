@@ -139,7 +139,7 @@ func (s *State) FetchHoldingMessageByHash(hash interfaces.IHash) (int, byte, int
 		//	case h.Type() == constants.FED_SERVER_FAULT_MSG :
 		//	case h.Type() == constants.AUDIT_SERVER_FAULT_MSG :
 		//	case h.Type() == constants.FULL_SERVER_FAULT_MSG :
-		case h.Type() == constants.COMMIT_CHAIN_MSG:
+		case h.Type() == constants.COMMIT_CHAIN_MSG, h.Type() == constants.BOUND_COMMIT_CHAIN_MSG:
 			var rm messages.CommitChainMsg
 			enb, err := h.MarshalBinary()
 			err = rm.UnmarshalBinary(enb)
@@ -0,0 +1,107 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// At high rates of entries, most of what a leader sends, and most of what its followers verify,
+// is the signatures on its acks.  With AckBatchWindow set, a leader holds the acks it makes for
+// up to that many milliseconds and sends them as one AckBatch, under one signature.  The acks it
+// keeps still carry their own signatures, so it can answer for them alone when asked for missing
+// messages.  The acks of the messages the leaders wait on (EOMs, DBSigs, faults) are never held:
+// the batch so far is sent first, then their ack, so our acks go out in order.
+//
+// Every node takes batches, whether or not it makes them.  A follower checks the batch's
+// signature once, then executes each ack as if it had come alone.  Those acks have no signature
+// of their own, so the follower doesn't pass them on one by one, or answer for them; it passes
+// on the batch.
+//
+// Nodes before batches can't decode them, and would lose the acks in them.  So a leader only
+// batches its acks once the AckBatch activation (see activations.go) has taken effect, which is
+// set once every node of the network can take them; until then, -ackbatch has no effect.
+
+// sendAck sends the ack of a message we have added to the process list, or holds it for a batch.
+func (s *State) sendAck(ack *messages.Ack, m interfaces.IMsg) {
+	if ack.Signature == nil {
+		return // From a batch, which we pass on whole
+	}
+	ours := !ack.Response && ack.LeaderChainID.IsSameAs(s.IdentityChainID)
+	if !ours || s.AckBatchWindow <= 0 || !s.IsActive(constants.ACTIVATION_ACK_BATCH, ack.DBHeight) {
+		ack.SendOut(s, ack)
+		return
+	}
+	if LeaderCritical(m) {
+		s.FlushAckBatch()
+		ack.SendOut(s, ack)
+		return
+	}
+	for _, held := range s.pendingAcks {
+		if held == ack {
+			return
+		}
+	}
+	if len(s.pendingAcks) > 0 && s.pendingAcks[0].DBHeight != ack.DBHeight {
+		s.FlushAckBatch()
+	}
+	if len(s.pendingAcks) == 0 {
+		s.pendingSince = s.ClockNow()
+	}
+	s.pendingAcks = append(s.pendingAcks, ack)
+	if len(s.pendingAcks) >= messages.MaxAckBatch {
+		s.FlushAckBatch()
+	}
+}
+
+// CheckAckBatch sends the acks we hold once the oldest has waited AckBatchWindow.
+func (s *State) CheckAckBatch() {
+	if len(s.pendingAcks) == 0 {
+		return
+	}
+	if s.ClockNow().Sub(s.pendingSince) >= time.Duration(s.AckBatchWindow)*time.Millisecond {
+		s.FlushAckBatch()
+	}
+}
+
+// FlushAckBatch sends the acks we hold: alone if there is only one, else as an AckBatch.
+func (s *State) FlushAckBatch() {
+	acks := s.pendingAcks
+	s.pendingAcks = nil
+	if len(acks) == 0 {
+		return
+	}
+	if len(acks) == 1 {
+		acks[0].SendOut(s, acks[0])
+		return
+	}
+	batch, err := messages.NewAckBatch(s, acks)
+	if err != nil {
+		s.Logf("error", "Can't make an ack batch, sending %d acks alone: %s", len(acks), err.Error())
+		for _, ack := range acks {
+			ack.SendOut(s, ack)
+		}
+		return
+	}
+	AckBatchesSent.Inc()
+	AcksBatched.Add(float64(len(acks)))
+	batch.SendOut(s, batch)
+}
+
+// FollowerExecuteAckBatch executes each ack of a valid batch as if it had come alone.
+func (s *State) FollowerExecuteAckBatch(m interfaces.IMsg) {
+	batch := m.(*messages.AckBatch)
+	for _, ack := range batch.Acks {
+		if ack.DBHeight < s.LLeaderHeight {
+			continue
+		}
+		ack.SetNetworkOrigin(batch.GetNetworkOrigin())
+		s.executeMsg(nil, ack)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/util"
+)
+
+// An activation is a change to the protocol that nodes before it don't follow, so every node of a
+// network has to make it from the same directory block.  Each is named in constants.ACTIVATIONS,
+// and has a height for each network, set in the [Activation "<name>"] sections of the config
+// file.  Custom networks use the Local height, and a height of 0 never activates it.
+
+// LoadActivations sets the activation heights from those of the config file.
+func (s *State) LoadActivations(cfg map[string]*util.ActivationHeights) {
+	s.Activations = make(map[string]util.ActivationHeights, len(cfg))
+	for name, h := range cfg {
+		if h == nil {
+			continue
+		}
+		if !isActivation(name) {
+			fmt.Printf("Ignoring the unknown activation %q in the config file\n", name)
+			continue
+		}
+		s.Activations[name] = *h
+	}
+}
+
+func isActivation(name string) bool {
+	for _, a := range constants.ACTIVATIONS {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetActivationHeight sets the height an activation takes effect at on a network.
+func (s *State) SetActivationHeight(name string, network int, height int) {
+	if s.Activations == nil {
+		s.Activations = make(map[string]util.ActivationHeights)
+	}
+	h := s.Activations[name]
+	switch network {
+	case constants.NETWORK_MAIN:
+		h.Main = height
+	case constants.NETWORK_TEST:
+		h.Test = height
+	default:
+		h.Local = height
+	}
+	s.Activations[name] = h
+}
+
+// GetActivationHeight returns the height an activation takes effect at on our network; 0 if it
+// never does.
+func (s *State) GetActivationHeight(name string) uint32 {
+	a := s.Activations[name]
+	h := a.Local
+	switch s.NetworkNumber {
+	case constants.NETWORK_MAIN:
+		h = a.Main
+	case constants.NETWORK_TEST:
+		h = a.Test
+	}
+	if h < 0 {
+		return 0
+	}
+	return uint32(h)
+}
+
+// IsActive is true if the activation has taken effect at dbheight on our network.
+func (s *State) IsActive(name string, dbheight uint32) bool {
+	h := s.GetActivationHeight(name)
+	return h > 0 && dbheight >= h
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/util"
+)
+
+func TestIsActive(t *testing.T) {
+	s := new(State)
+	s.NetworkNumber = constants.NETWORK_MAIN
+	s.SetActivationHeight(constants.ACTIVATION_BATCH_COMMIT, constants.NETWORK_LOCAL, 10)
+	if s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 100) {
+		t.Error("Activated on a network with no activation height")
+	}
+
+	s.SetActivationHeight(constants.ACTIVATION_BATCH_COMMIT, constants.NETWORK_MAIN, 100)
+	if s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 99) {
+		t.Error("Activated before the activation height")
+	}
+	if !s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 100) || !s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 101) {
+		t.Error("Not activated from the activation height on")
+	}
+	if s.IsActive(constants.ACTIVATION_ACK_BATCH, 100) {
+		t.Error("Activated with the height of another activation")
+	}
+
+	// Custom networks use the Local heights
+	s.NetworkNumber = constants.NETWORK_CUSTOM
+	if s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 9) || !s.IsActive(constants.ACTIVATION_BATCH_COMMIT, 10) {
+		t.Error("A custom network didn't use the Local activation height")
+	}
+}
+
+func TestLoadActivations(t *testing.T) {
+	s := new(State)
+	s.NetworkNumber = constants.NETWORK_TEST
+	s.LoadActivations(map[string]*util.ActivationHeights{
+		constants.ACTIVATION_ACK_BATCH: {Main: 300, Test: 200, Local: 1},
+		"NoSuchActivation":             {Main: 1, Test: 1, Local: 1},
+	})
+	if h := s.GetActivationHeight(constants.ACTIVATION_ACK_BATCH); h != 200 {
+		t.Errorf("Expected the Test activation height 200, got %d", h)
+	}
+	if _, ok := s.Activations["NoSuchActivation"]; ok {
+		t.Error("Kept an unknown activation")
+	}
+}
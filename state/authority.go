@@ -354,7 +354,7 @@ func (st *State) VerifyAuthoritySignature(msg []byte, sig *[constants.SIGNATURE_
 		if auth == nil {
 			continue
 		}
-		valid, err := auth.VerifySignature(msg, sig)
+		valid, err := st.VerifyAuthority(auth, msg, sig)
 		if err == nil && valid {
 			return 1, nil
 		}
@@ -365,7 +365,7 @@ func (st *State) VerifyAuthoritySignature(msg []byte, sig *[constants.SIGNATURE_
 		if auth == nil {
 			continue
 		}
-		valid, err := auth.VerifySignature(msg, sig)
+		valid, err := st.VerifyAuthority(auth, msg, sig)
 		if err == nil && valid {
 			return 0, nil
 		}
@@ -394,7 +394,7 @@ func (st *State) FastVerifyAuthoritySignature(msg []byte, sig interfaces.IFullSi
 		compareKey, err := auth.SigningKey.MarshalBinary()
 		if err == nil {
 			if pkEq(sig.GetKey(), compareKey) {
-				valid, err := auth.VerifySignature(msg, sig.GetSignature())
+				valid, err := st.VerifyAuthority(auth, msg, sig.GetSignature())
 				if err == nil && valid {
 					return 1, nil
 				}
@@ -410,7 +410,7 @@ func (st *State) FastVerifyAuthoritySignature(msg []byte, sig interfaces.IFullSi
 		compareKey, err := auth.SigningKey.MarshalBinary()
 		if err == nil {
 			if pkEq(sig.GetKey(), compareKey) {
-				valid, err := auth.VerifySignature(msg, sig.GetSignature())
+				valid, err := st.VerifyAuthority(auth, msg, sig.GetSignature())
 				if err == nil && valid {
 					return 0, nil
 				}
@@ -0,0 +1,186 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sort"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/util"
+)
+
+// Under a flood of messages the InMsgQueue, Holding, and Acks would grow until we ran out of memory.
+// Each is capped.  When the InMsgQueue fills, the lowest priority messages are turned away first, and
+// the network stops reading from its peers until there is room again, so the flood backs up into the
+// p2p layer instead of into us.  Holding and Acks are trimmed back when they pass their caps: Holding
+// lowest priority and oldest first, and Acks those furthest ahead of us first, as the lowest are the
+// ones we need next.
+//
+// An operator can keep PriorityLaneShare percent of the room for user traffic for their own
+// submissions: those to our API from this machine, or carrying PriorityAPIKey.  The API marks them
+// priority, and public user traffic is turned away that much sooner, so a flood of it can't crowd
+// out the operator's application.
+
+// Priorities for shedding messages; the lowest go first.
+const (
+	MsgPriorityLow      = iota // Requests from peers; they can ask again
+	MsgPriorityMedium          // User traffic: commits, reveals, and transactions
+	MsgPriorityOperator        // User traffic from our operator, see PriorityLaneShare
+	MsgPriorityHigh            // Consensus: acks, EOMs, signatures, faults, and blocks
+)
+
+func MsgPriority(msg interfaces.IMsg) int {
+	switch msg.Type() {
+	case constants.MISSING_MSG, constants.MISSING_MSG_RANGE, constants.MISSING_DATA, constants.DBSTATE_MISSING_MSG,
+		constants.REQUEST_BLOCK_MSG, constants.MISSING_ENTRY_BLOCKS, constants.DBLOCK_HEADERS_REQUEST,
+		constants.BOUNCE_MSG, constants.BOUNCEREPLY_MSG, constants.PROBE_MSG:
+		return MsgPriorityLow
+	case constants.COMMIT_CHAIN_MSG, constants.BOUND_COMMIT_CHAIN_MSG, constants.COMMIT_ENTRY_MSG,
+		constants.BATCH_COMMIT_ENTRY_MSG, constants.REVEAL_ENTRY_MSG, constants.FACTOID_TRANSACTION_MSG:
+		if msg.IsPriority() {
+			return MsgPriorityOperator
+		}
+		return MsgPriorityMedium
+	}
+	return MsgPriorityHigh
+}
+
+// LeaderCritical is true for the messages the leaders wait on to end a minute or a block.  They go
+// to the preferred peer group, if there is one (see p2p/peerGroups.go), and ahead of the bulk of a
+// deep InMsgQueue (see inMsgQueue.go).
+func LeaderCritical(msg interfaces.IMsg) bool {
+	switch msg.Type() {
+	case constants.ACK_MSG, constants.ACK_BATCH_MSG, constants.EOM_MSG, constants.DIRECTORY_BLOCK_SIGNATURE_MSG,
+		constants.FED_SERVER_FAULT_MSG, constants.FULL_SERVER_FAULT_MSG:
+		return true
+	}
+	return false
+}
+
+// AdmitMsg returns true if msg may go into the InMsgQueue.  Commits and reveals for chains we filter
+// out never may (see chainFilter.go), and commits that look like spam go to the quarantine
+// instead (see quarantine.go).  Low priority messages only get the first half of the queue,
+// our operator's user traffic the first 90%, other user traffic the first 90% less the operator's
+// share of it, and high priority all of it.  While we are shutting down only
+// high priority messages get in, so we can finish the minute we are in.
+func (s *State) AdmitMsg(msg interfaces.IMsg) bool {
+	if !s.AcceptsChainMsg(msg) || s.Quarantine(msg) {
+		return false
+	}
+	q := s.InMsgQueue()
+	l, c := q.Length(), q.Cap()
+	admit := true
+	switch MsgPriority(msg) {
+	case MsgPriorityLow:
+		admit = l < c/2 && !s.IsShuttingDown()
+	case MsgPriorityMedium:
+		admit = l < c*9/10*(100-s.PriorityLaneShare)/100 && !s.IsShuttingDown()
+	case MsgPriorityOperator:
+		admit = l < c*9/10 && !s.IsShuttingDown()
+	}
+	if !admit {
+		InMsgQueueShed.Inc()
+	}
+	return admit
+}
+
+// InMsgBackpressure is true when the InMsgQueue is too full to keep reading from our peers.
+func (s *State) InMsgBackpressure() bool {
+	q := s.InMsgQueue()
+	return q.Length() >= q.Cap()*9/10
+}
+
+type heldMsg struct {
+	key [32]byte
+	msg interfaces.IMsg
+}
+
+// Lowest priority first, then oldest first
+type byShedOrder []heldMsg
+
+func (b byShedOrder) Len() int      { return len(b) }
+func (b byShedOrder) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byShedOrder) Less(i, j int) bool {
+	pi, pj := MsgPriority(b[i].msg), MsgPriority(b[j].msg)
+	if pi != pj {
+		return pi < pj
+	}
+	return b[i].msg.GetTimestamp().GetTimeMilli() < b[j].msg.GetTimestamp().GetTimeMilli()
+}
+
+// Anything not an ack first, then the highest, by the block and position they acknowledge
+type byAckHeight []heldMsg
+
+func (b byAckHeight) Len() int      { return len(b) }
+func (b byAckHeight) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byAckHeight) Less(i, j int) bool {
+	ai, _ := b[i].msg.(*messages.Ack)
+	aj, _ := b[j].msg.(*messages.Ack)
+	if ai == nil || aj == nil {
+		return ai == nil && aj != nil
+	}
+	if ai.DBHeight != aj.DBHeight {
+		return ai.DBHeight > aj.DBHeight
+	}
+	return ai.Height > aj.Height
+}
+
+// TrimHolding cuts Holding back to 90% of its cap once it goes over.
+func (s *State) TrimHolding() {
+	if len(s.Holding) <= constants.HOLDING_MAX {
+		return
+	}
+	held := make(byShedOrder, 0, len(s.Holding))
+	for k, v := range s.Holding {
+		held = append(held, heldMsg{k, v})
+	}
+	sort.Sort(held)
+	for _, h := range held[:len(held)-constants.HOLDING_MAX*9/10] {
+		delete(s.Holding, h.key)
+		HoldingShed.Inc()
+	}
+}
+
+// TrimAcks cuts Acks back to 90% of its cap once it goes over.
+func (s *State) TrimAcks() {
+	if len(s.Acks) <= constants.ACKS_MAX {
+		return
+	}
+	acks := make(byAckHeight, 0, len(s.Acks))
+	for k, v := range s.Acks {
+		acks = append(acks, heldMsg{k, v})
+	}
+	sort.Sort(acks)
+	for _, a := range acks[:len(acks)-constants.ACKS_MAX*9/10] {
+		delete(s.Acks, a.key)
+		AcksShed.Inc()
+	}
+}
+
+// configurePriorityLane sets the operator's share of the room for user traffic, from 0 to 100.
+func (s *State) configurePriorityLane(cfg *util.FactomdConfig) {
+	s.PriorityLaneShare = cfg.App.PriorityLaneShare
+	if s.PriorityLaneShare < 0 {
+		s.PriorityLaneShare = 0
+	}
+	if s.PriorityLaneShare > 100 {
+		s.PriorityLaneShare = 100
+	}
+	s.PriorityAPIKey = cfg.App.PriorityApiKey
+}
+
+// GetPriorityLaneShare returns the operator's share of the room for user traffic, in percent; 0
+// if they have none, and the API should mark nothing priority.
+func (s *State) GetPriorityLaneShare() int {
+	return s.PriorityLaneShare
+}
+
+// GetPriorityAPIKey returns the key that marks a submission to the API as the operator's, wherever
+// it comes from; empty if there is none.
+func (s *State) GetPriorityAPIKey() string {
+	return s.PriorityAPIKey
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestMsgPriority(t *testing.T) {
+	if MsgPriority(new(messages.MissingMsg)) != MsgPriorityLow {
+		t.Error("Missing message requests should be low priority")
+	}
+	if MsgPriority(new(messages.CommitEntryMsg)) != MsgPriorityMedium {
+		t.Error("Commits should be medium priority")
+	}
+	if MsgPriority(new(messages.Ack)) != MsgPriorityHigh {
+		t.Error("Acks should be high priority")
+	}
+}
+
+func TestTrimHolding(t *testing.T) {
+	s := new(State)
+	s.Holding = make(map[[32]byte]interfaces.IMsg)
+
+	var key [32]byte
+	for i := 0; i < constants.HOLDING_MAX; i++ {
+		eom := new(messages.EOM)
+		eom.Timestamp = primitives.NewTimestampFromMilliseconds(uint64(i))
+		binary.BigEndian.PutUint32(key[:], uint32(i))
+		s.Holding[key] = eom
+	}
+	for i := constants.HOLDING_MAX; i < constants.HOLDING_MAX+100; i++ {
+		mm := new(messages.MissingMsg)
+		mm.Timestamp = primitives.NewTimestampFromMilliseconds(uint64(i))
+		binary.BigEndian.PutUint32(key[:], uint32(i))
+		s.Holding[key] = mm
+	}
+
+	s.TrimHolding()
+	if len(s.Holding) != constants.HOLDING_MAX*9/10 {
+		t.Errorf("Holding has %d messages, expected %d", len(s.Holding), constants.HOLDING_MAX*9/10)
+	}
+	for _, m := range s.Holding {
+		if MsgPriority(m) == MsgPriorityLow {
+			t.Fatal("Low priority messages should be shed first")
+		}
+	}
+}
+
+func TestTrimAcks(t *testing.T) {
+	s := new(State)
+	s.Acks = make(map[[32]byte]interfaces.IMsg)
+
+	var key [32]byte
+	for i := 0; i < constants.ACKS_MAX+100; i++ {
+		ack := new(messages.Ack)
+		ack.DBHeight = uint32(10 + i/1000)
+		ack.Height = uint32(i % 1000)
+		binary.BigEndian.PutUint32(key[:], uint32(i))
+		s.Acks[key] = ack
+	}
+
+	s.TrimAcks()
+	kept := constants.ACKS_MAX * 9 / 10
+	if len(s.Acks) != kept {
+		t.Errorf("Acks has %d messages, expected %d", len(s.Acks), kept)
+	}
+	for _, m := range s.Acks {
+		ack := m.(*messages.Ack)
+		if int(ack.DBHeight-10)*1000+int(ack.Height) >= kept {
+			t.Fatalf("The ack of %d/%d was kept; the highest acks should be shed first", ack.DBHeight, ack.Height)
+		}
+	}
+}
+
+func TestAdmitMsgPriorityLane(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.PriorityLaneShare = 50
+
+	q := s.InMsgQueue()
+	for q.Length() < q.Cap()*9/10/2 {
+		q.Enqueue(new(messages.EOM))
+	}
+
+	public := new(messages.CommitEntryMsg)
+	if s.AdmitMsg(public) {
+		t.Error("A public commit was admitted into the operator's share of the queue")
+	}
+	operator := new(messages.CommitEntryMsg)
+	operator.SetPriority(true)
+	if MsgPriority(operator) != MsgPriorityOperator {
+		t.Error("An operator's commit should have the operator's priority")
+	}
+	if !s.AdmitMsg(operator) {
+		t.Error("An operator's commit was turned away from their share of the queue")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The BalanceAudit re-derives every factoid and entry credit balance from genesis, using only the
+// blocks in our database, and checks them against the balances the State is running on.  It keeps
+// its own balance maps, so it never touches the State's.
+//
+// The State only remembers its balances for recent blocks (the SaveStructs of the DBStates still in
+// the DBStates list) and for the block it is on now.  The audit checks every one of those it passes,
+// so a divergence is pinned between the last height that matched and the first that didn't.  Once it
+// has caught up, it follows the State block by block, so any new divergence is caught at its block.
+
+type BalanceDivergence struct {
+	DBHeight  uint32   // The balances after this block do not match
+	LastMatch int64    // The last height we checked that did match; -1 if none
+	Addresses []string // Addresses whose balances differ
+}
+
+func (d *BalanceDivergence) String() string {
+	if d.LastMatch < 0 {
+		return fmt.Sprintf("balances diverge at or before block %d (%d addresses)", d.DBHeight, len(d.Addresses))
+	}
+	return fmt.Sprintf("balances diverge between blocks %d and %d (%d addresses)", d.LastMatch+1, d.DBHeight, len(d.Addresses))
+}
+
+type BalanceAudit struct {
+	State *State
+	Delay time.Duration // Pause after each block, so the audit can run on a production follower
+
+	Height     uint32 // Next block to apply
+	LastMatch  int64  // Last height whose balances matched the State's; -1 if none yet
+	Checked    int    // Heights compared against the State
+	Divergence *BalanceDivergence
+
+	factoids map[[32]byte]int64
+	ecs      map[[32]byte]int64
+	rate     uint64 // Factoshis per EC the next block's transactions are processed at
+	mutex    sync.Mutex
+}
+
+func NewBalanceAudit(s *State, delay time.Duration) *BalanceAudit {
+	a := new(BalanceAudit)
+	a.State = s
+	a.Delay = delay
+	a.LastMatch = -1
+	a.factoids = map[[32]byte]int64{}
+	a.ecs = map[[32]byte]int64{}
+	return a
+}
+
+// RunBalanceAudit starts an audit and runs it until the first divergence.  Never returns otherwise.
+func (s *State) RunBalanceAudit(delay time.Duration) {
+	a := NewBalanceAudit(s, delay)
+	s.BalanceAudit = a
+	a.Run()
+}
+
+func (a *BalanceAudit) Run() {
+	for {
+		progress, err := a.Step()
+		if err != nil {
+			fmt.Println("BalanceAudit:", err)
+			return
+		}
+		if a.Divergence != nil {
+			fmt.Println("BalanceAudit:", a.Divergence.String())
+			return
+		}
+		if a.Height%1000 == 0 && progress {
+			fmt.Printf("BalanceAudit: at block %d, %d checks passed\n", a.Height, a.Checked)
+		}
+		if !progress {
+			time.Sleep(time.Second)
+			continue
+		}
+		if a.Delay > 0 {
+			time.Sleep(a.Delay)
+		}
+	}
+}
+
+// Step applies the next block from the database, if we have it, and compares the result with the
+// State wherever the State can tell us what its balances were.  Returns false when there is no
+// block to apply yet.
+func (a *BalanceAudit) Step() (bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	s := a.State
+	if a.Height > s.GetHighestSavedBlk() || (a.Height > 0 && !s.DBFinished) {
+		return false, nil
+	}
+
+	fblk, err := s.DB.FetchFBlockByHeight(a.Height)
+	if err != nil {
+		return false, err
+	}
+	ecblk, err := s.DB.FetchECBlockByHeight(a.Height)
+	if err != nil {
+		return false, err
+	}
+	if fblk == nil || ecblk == nil {
+		return false, nil
+	}
+
+	// Transactions are processed at the rate set by the block before them
+	if a.Height == 0 {
+		a.rate = fblk.GetExchRate()
+	}
+	for _, trans := range fblk.GetTransactions() {
+		for _, input := range trans.GetInputs() {
+			a.factoids[input.GetAddress().Fixed()] -= int64(input.GetAmount())
+		}
+		for _, output := range trans.GetOutputs() {
+			a.factoids[output.GetAddress().Fixed()] += int64(output.GetAmount())
+		}
+		if a.rate > 0 {
+			for _, ecOut := range trans.GetECOutputs() {
+				a.ecs[ecOut.GetAddress().Fixed()] += int64(ecOut.GetAmount()) / int64(a.rate)
+			}
+		}
+	}
+	for _, trans := range ecblk.GetBody().GetEntries() {
+		switch t := trans.(type) {
+		case *entryCreditBlock.CommitChain:
+			a.ecs[t.ECPubKey.Fixed()] -= int64(t.Credits)
+		case *entryCreditBlock.CommitEntry:
+			a.ecs[t.ECPubKey.Fixed()] -= int64(t.Credits)
+		}
+	}
+	a.rate = fblk.GetExchRate()
+
+	height := a.Height
+	a.Height++
+
+	// The SaveStruct of the next block holds the balances from after this one
+	if next := s.DBStates.Get(int(height + 1)); next != nil && next.SaveStruct != nil {
+		a.compare(height, next.SaveStruct.FactoidBalancesP, next.SaveStruct.ECBalancesP)
+		return true, nil
+	}
+
+	// Otherwise, if this is the last block the State has processed, check the balances it is on now.
+	if height == s.DBStates.ProcessHeight {
+		fs, ecs := a.liveBalances(height)
+		if fs != nil {
+			a.compare(height, fs, ecs)
+		}
+	}
+	return true, nil
+}
+
+// liveBalances copies the State's permanent balances, provided it is still on height when done.
+func (a *BalanceAudit) liveBalances(height uint32) (map[[32]byte]int64, map[[32]byte]int64) {
+	s := a.State
+	fs := s.FactoidBalancesP.Copy()
+	ecs := s.ECBalancesP.Copy()
+	if s.DBStates.ProcessHeight != height {
+		return nil, nil
+	}
+	return fs, ecs
+}
+
+func (a *BalanceAudit) compare(height uint32, factoids map[[32]byte]int64, ecs map[[32]byte]int64) {
+	a.Checked++
+	var diff []string
+	for _, adr := range diffBalances(a.factoids, factoids) {
+		diff = append(diff, primitives.ConvertFctAddressToUserStr(primitives.NewHash(adr[:])))
+	}
+	for _, adr := range diffBalances(a.ecs, ecs) {
+		diff = append(diff, primitives.ConvertECAddressToUserStr(primitives.NewHash(adr[:])))
+	}
+	if len(diff) == 0 {
+		a.LastMatch = int64(height)
+		return
+	}
+	sort.Strings(diff)
+	a.Divergence = &BalanceDivergence{height, a.LastMatch, diff}
+}
+
+// Returns the addresses whose balances differ.  A missing address is a zero balance.
+func diffBalances(a map[[32]byte]int64, b map[[32]byte]int64) [][32]byte {
+	var diff [][32]byte
+	for k, v := range a {
+		if b[k] != v {
+			diff = append(diff, k)
+		}
+	}
+	for k, v := range b {
+		if _, ok := a[k]; !ok && v != 0 {
+			diff = append(diff, k)
+		}
+	}
+	return diff
+}
+
+func (a *BalanceAudit) String() string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.Divergence != nil {
+		return "BalanceAudit: " + a.Divergence.String()
+	}
+	return fmt.Sprintf("BalanceAudit: at block %d, %d checks passed, last match %d", a.Height, a.Checked, a.LastMatch)
+}
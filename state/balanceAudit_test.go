@@ -0,0 +1,34 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestBalanceAudit(t *testing.T) {
+	s := testHelper.CreateAndPopulateSavedTestState()
+	s.DBFinished = true
+
+	a := NewBalanceAudit(s, 0)
+	for {
+		progress, err := a.Step()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !progress {
+			break
+		}
+	}
+	if a.Height != s.GetHighestSavedBlk()+1 {
+		t.Errorf("Audit stopped at %d, expected %d", a.Height, s.GetHighestSavedBlk()+1)
+	}
+	if a.Divergence != nil {
+		t.Errorf("Unexpected divergence: %v", a.Divergence.String())
+	}
+}
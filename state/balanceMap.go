@@ -0,0 +1,112 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sync"
+)
+
+// The permanent balances are read far more than they are written: by the API, the control panel
+// and the validation of every commit and transaction, while only the saving of a block writes
+// them.  So they are kept in shards by address, each behind its own RWMutex.  Reads only take a
+// read lock, and never wait on each other, nor on anything but a write to the same shard; copying
+// or hashing them all holds one shard at a time.
+
+const balanceShards = 64
+
+type balanceShard struct {
+	mutex    sync.RWMutex
+	balances map[[32]byte]int64
+}
+
+type BalanceMap struct {
+	shards [balanceShards]balanceShard
+}
+
+func NewBalanceMap() *BalanceMap {
+	b := new(BalanceMap)
+	for i := range b.shards {
+		b.shards[i].balances = make(map[[32]byte]int64)
+	}
+	return b
+}
+
+// NewBalanceMapFrom returns a BalanceMap holding a copy of the balances given.
+func NewBalanceMapFrom(balances map[[32]byte]int64) *BalanceMap {
+	b := NewBalanceMap()
+	b.Replace(balances)
+	return b
+}
+
+func (b *BalanceMap) shard(adr [32]byte) *balanceShard {
+	return &b.shards[adr[0]%balanceShards]
+}
+
+// Get returns the balance of adr, and whether it has one.
+func (b *BalanceMap) Get(adr [32]byte) (int64, bool) {
+	s := b.shard(adr)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	v, ok := s.balances[adr]
+	return v, ok
+}
+
+func (b *BalanceMap) Put(adr [32]byte, v int64) {
+	s := b.shard(adr)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.balances[adr] = v
+}
+
+func (b *BalanceMap) Delete(adr [32]byte) {
+	s := b.shard(adr)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.balances, adr)
+}
+
+// Len returns the number of addresses with a balance.
+func (b *BalanceMap) Len() int {
+	l := 0
+	for i := range b.shards {
+		s := &b.shards[i]
+		s.mutex.RLock()
+		l += len(s.balances)
+		s.mutex.RUnlock()
+	}
+	return l
+}
+
+// Copy returns a copy of every balance.  Taken a shard at a time, it is only a consistent copy if
+// nothing writes while it runs, as when called from the goroutine that writes them.
+func (b *BalanceMap) Copy() map[[32]byte]int64 {
+	c := make(map[[32]byte]int64)
+	for i := range b.shards {
+		s := &b.shards[i]
+		s.mutex.RLock()
+		for k, v := range s.balances {
+			c[k] = v
+		}
+		s.mutex.RUnlock()
+	}
+	return c
+}
+
+// Replace replaces every balance with a copy of those given.
+func (b *BalanceMap) Replace(balances map[[32]byte]int64) {
+	shards := make([]map[[32]byte]int64, balanceShards)
+	for i := range shards {
+		shards[i] = make(map[[32]byte]int64)
+	}
+	for k, v := range balances {
+		shards[k[0]%balanceShards][k] = v
+	}
+	for i := range b.shards {
+		s := &b.shards[i]
+		s.mutex.Lock()
+		s.balances = shards[i]
+		s.mutex.Unlock()
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestBalanceMap(t *testing.T) {
+	b := NewBalanceMap()
+	var a1, a2 [32]byte
+	a1[0], a2[0] = 1, 200
+	b.Put(a1, 10)
+	b.Put(a2, 20)
+	if v, ok := b.Get(a1); !ok || v != 10 {
+		t.Errorf("Got %d %v, expected 10", v, ok)
+	}
+	if b.Len() != 2 {
+		t.Errorf("Len %d, expected 2", b.Len())
+	}
+	b.Delete(a2)
+	if _, ok := b.Get(a2); ok {
+		t.Errorf("Found a deleted balance")
+	}
+
+	c := b.Copy()
+	c[a2] = 30
+	if _, ok := b.Get(a2); ok {
+		t.Errorf("Changing a copy changed the map")
+	}
+	b.Replace(c)
+	if v, _ := b.Get(a2); v != 30 || b.Len() != 2 {
+		t.Errorf("Replace gave %d with %d balances", v, b.Len())
+	}
+	if NewBalanceMapFrom(c).Len() != 2 {
+		t.Errorf("NewBalanceMapFrom lost balances")
+	}
+}
+
+func TestBalanceMapConcurrent(t *testing.T) {
+	b := NewBalanceMap()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				var adr [32]byte
+				adr[0], adr[1] = byte(j), byte(i)
+				b.Put(adr, int64(j))
+				b.Get(adr)
+				b.Copy()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if b.Len() != 8*256 {
+		t.Errorf("Len %d, expected %d", b.Len(), 8*256)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Process lists are dropped soon after their block is saved. Before that
+// happens the order messages were processed in is summarized by minute and
+// VM, so the timing within recent blocks can still be looked at.
+
+// Number of blocks whose summaries are kept
+var BlockMinutesHistory uint32 = 200
+
+// A message as it was processed by a VM
+type ProcessedMessage struct {
+	VM        int    // VM that processed the message
+	VMHeight  int    // Position of the message in the VM's list
+	Type      string // See messages.MessageName
+	Hash      string // Message hash
+	EntryHash string // Entry of a commit or reveal
+	TxID      string // Transaction of a factoid transaction
+	Timestamp int64  // Milliseconds, the time the leader acknowledged the message
+}
+
+type MinuteMessages struct {
+	Minute   int
+	Messages []ProcessedMessage // In VM order, then in the order each VM processed them
+	EOMs     []ProcessedMessage // The end of minute marker of each VM
+}
+
+type BlockMinutes struct {
+	DBHeight uint32
+	VMCount  int
+	Minutes  [10]MinuteMessages
+}
+
+// SummarizeProcessList groups the messages of a process list by the minute
+// they were processed in. Returns nil if the list holds no messages, as when
+// the block was loaded from the database or another node's DBState.
+func SummarizeProcessList(pl *ProcessList) *BlockMinutes {
+	if pl == nil {
+		return nil
+	}
+	bm := new(BlockMinutes)
+	bm.DBHeight = pl.DBHeight
+	bm.VMCount = len(pl.VMs)
+	for i := range bm.Minutes {
+		bm.Minutes[i].Minute = i
+	}
+
+	empty := true
+	for vmIndex, vm := range pl.VMs {
+		minute := 0
+		for height, msg := range vm.List {
+			if msg == nil {
+				continue
+			}
+			empty = false
+			pm := ProcessedMessage{
+				VM:       vmIndex,
+				VMHeight: height,
+				Type:     messages.MessageName(msg.Type()),
+			}
+			if hash := msg.GetMsgHash(); hash != nil {
+				pm.Hash = hash.String()
+			}
+			if height < len(vm.ListAck) && vm.ListAck[height] != nil && vm.ListAck[height].Timestamp != nil {
+				pm.Timestamp = vm.ListAck[height].Timestamp.GetTimeMilli()
+			}
+
+			switch m := msg.(type) {
+			case *messages.EOM:
+				if int(m.Minute) < len(bm.Minutes) {
+					minute = int(m.Minute)
+				}
+				bm.Minutes[minute].EOMs = append(bm.Minutes[minute].EOMs, pm)
+				// Messages after the EOM belong to the next minute
+				if minute < len(bm.Minutes)-1 {
+					minute++
+				}
+				continue
+			case *messages.RevealEntryMsg:
+				pm.EntryHash = m.Entry.GetHash().String()
+			case *messages.CommitEntryMsg:
+				pm.EntryHash = m.CommitEntry.EntryHash.String()
+			case *messages.CommitChainMsg:
+				pm.EntryHash = m.CommitChain.EntryHash.String()
+			case *messages.FactoidTransaction:
+				pm.TxID = m.Transaction.GetSigHash().String()
+			}
+			bm.Minutes[minute].Messages = append(bm.Minutes[minute].Messages, pm)
+		}
+	}
+	if empty {
+		return nil
+	}
+	return bm
+}
+
+// AddBlockMinutes keeps the summary of a block, and drops the summaries that
+// are more than BlockMinutesHistory blocks older
+func (s *State) AddBlockMinutes(bm *BlockMinutes) {
+	if bm == nil {
+		return
+	}
+	s.BlockMinutesMutex.Lock()
+	defer s.BlockMinutesMutex.Unlock()
+
+	if s.BlockMinutes == nil {
+		s.BlockMinutes = make(map[uint32]*BlockMinutes)
+	}
+	s.BlockMinutes[bm.DBHeight] = bm
+	for height := range s.BlockMinutes {
+		if height+BlockMinutesHistory <= bm.DBHeight {
+			delete(s.BlockMinutes, height)
+		}
+	}
+}
+
+// GetBlockMinutes returns the summary of the block's process list, or nil if
+// it was not kept
+func (s *State) GetBlockMinutes(dbheight uint32) *BlockMinutes {
+	s.BlockMinutesMutex.RLock()
+	defer s.BlockMinutesMutex.RUnlock()
+	return s.BlockMinutes[dbheight]
+}
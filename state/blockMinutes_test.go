@@ -0,0 +1,72 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func newTestEOM(vm int, minute byte) *messages.EOM {
+	eom := new(messages.EOM)
+	eom.Timestamp = primitives.NewTimestampNow()
+	eom.ChainID = primitives.NewZeroHash()
+	eom.VMIndex = vm
+	eom.Minute = minute
+	return eom
+}
+
+func TestSummarizeProcessList(t *testing.T) {
+	if SummarizeProcessList(nil) != nil || SummarizeProcessList(new(ProcessList)) != nil {
+		t.Error("An empty process list should not be summarized")
+	}
+
+	entry := testHelper.CreateFirstTestEntry()
+	reveal := new(messages.RevealEntryMsg)
+	reveal.Entry = entry
+
+	pl := new(ProcessList)
+	pl.DBHeight = 7
+	pl.VMs = []*VM{
+		{List: []interfaces.IMsg{newTestEOM(0, 0), reveal, newTestEOM(0, 1)}},
+		{List: []interfaces.IMsg{newTestEOM(1, 0), nil}},
+	}
+	ack := new(messages.Ack)
+	ack.Timestamp = primitives.NewTimestampFromMilliseconds(1234)
+	pl.VMs[0].ListAck = []*messages.Ack{nil, ack}
+
+	bm := SummarizeProcessList(pl)
+	if bm == nil || bm.DBHeight != 7 || bm.VMCount != 2 {
+		t.Fatalf("Wrong summary %v", bm)
+	}
+	if len(bm.Minutes[0].EOMs) != 2 || len(bm.Minutes[0].Messages) != 0 {
+		t.Errorf("Expected both minute 0 EOMs and no messages, found %v", bm.Minutes[0])
+	}
+	msgs := bm.Minutes[1].Messages
+	if len(msgs) != 1 || msgs[0].VM != 0 || msgs[0].VMHeight != 1 || msgs[0].EntryHash != entry.GetHash().String() {
+		t.Fatalf("Expected the reveal in minute 1, found %v", msgs)
+	}
+	if msgs[0].Type != "Reveal Entry" || msgs[0].Timestamp != 1234 {
+		t.Errorf("Wrong message %v", msgs[0])
+	}
+	if len(bm.Minutes[1].EOMs) != 1 {
+		t.Errorf("Expected the minute 1 EOM, found %v", bm.Minutes[1].EOMs)
+	}
+}
+
+func TestBlockMinutesHistory(t *testing.T) {
+	s := new(State)
+	for h := uint32(0); h <= BlockMinutesHistory+5; h++ {
+		s.AddBlockMinutes(&BlockMinutes{DBHeight: h})
+	}
+	if s.GetBlockMinutes(5) != nil {
+		t.Error("Old summaries should be dropped")
+	}
+	if s.GetBlockMinutes(6) == nil || s.GetBlockMinutes(BlockMinutesHistory+5) == nil {
+		t.Error("Recent summaries should be kept")
+	}
+	s.AddBlockMinutes(nil)
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+)
+
+// A block's timeline is when this node saw it reach each step of its making, so how long blocks
+// take, and where, can be compared from one release to the next.  The events are
+//
+//	firstcommit   the first commit of the block was processed
+//	eom0 - eom9   the EOMs of a minute were all processed
+//	dbsig         the DBSigs of the block before it were all processed, at the start of the block
+//	saved         the block was written to the database
+//	anchored      an anchor record of the block was saved, in a later block
+//
+// A block synced from peers rather than built only has the last two.  The timelines of the
+// last BlockTimelineBlocks blocks are kept in the database, and given by the v2 API's
+// "block-timeline".
+
+const (
+	TimelineFirstCommit = "firstcommit"
+	TimelineDBSig       = "dbsig"
+	TimelineSaved       = "saved"
+	TimelineAnchored    = "anchored"
+)
+
+// TimelineEOM is the event of the EOMs of a minute all being processed.
+func TimelineEOM(minute int) string {
+	return fmt.Sprintf("eom%d", minute)
+}
+
+// A BlockTimeline as it is kept in the database
+type BlockTimeline struct {
+	interfaces.BlockTimeline
+}
+
+var _ interfaces.BinaryMarshallable = (*BlockTimeline)(nil)
+
+func (t *BlockTimeline) MarshalBinary() ([]byte, error) {
+	buf := primitives.NewBuffer(nil)
+
+	err := buf.PushUInt32(t.DBHeight)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.PushVarInt(uint64(len(t.Events)))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range t.Events {
+		err = buf.PushString(e.Event)
+		if err != nil {
+			return nil, err
+		}
+		err = buf.PushInt64(e.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (t *BlockTimeline) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	buf := primitives.NewBuffer(p)
+
+	t.DBHeight, err = buf.PopUInt32()
+	if err != nil {
+		return
+	}
+	l, err := buf.PopVarInt()
+	if err != nil {
+		return
+	}
+	t.Events = nil
+	for i := 0; i < int(l); i++ {
+		var e interfaces.TimelineEvent
+		e.Event, err = buf.PopString()
+		if err != nil {
+			return
+		}
+		e.Time, err = buf.PopInt64()
+		if err != nil {
+			return
+		}
+		t.Events = append(t.Events, e)
+	}
+
+	newData = buf.DeepCopyBytes()
+	return
+}
+
+func (t *BlockTimeline) UnmarshalBinary(p []byte) error {
+	_, err := t.UnmarshalBinaryData(p)
+	return err
+}
+
+// add adds an event at when, unless the timeline has it already, and returns whether it did.
+func (t *BlockTimeline) add(event string, when time.Time) bool {
+	for _, e := range t.Events {
+		if e.Event == event {
+			return false
+		}
+	}
+	t.Events = append(t.Events, interfaces.TimelineEvent{Event: event, Time: when.UnixNano() / int64(time.Millisecond)})
+	return true
+}
+
+// RecordBlockEvent adds an event to the timeline of the block at dbheight.  Timelines are kept
+// in memory while their blocks are built, and written to the database as they are saved or
+// anchored.
+func (s *State) RecordBlockEvent(dbheight uint32, event string) {
+	kept := uint32(s.BlockTimelineBlocks)
+	if kept == 0 {
+		return
+	}
+	now := time.Now()
+	saved := s.GetHighestSavedBlk()
+	if dbheight+kept <= saved {
+		return
+	}
+
+	s.blockTimelinesMutex.Lock()
+	defer s.blockTimelinesMutex.Unlock()
+	if s.blockTimelines == nil {
+		s.blockTimelines = make(map[uint32]*BlockTimeline)
+	}
+
+	t := s.blockTimelines[dbheight]
+	if t == nil && event == TimelineAnchored {
+		// An anchored block was saved long ago
+		if old, err := s.DB.FetchBlockTimeline(dbheight, new(BlockTimeline)); err == nil && old != nil {
+			t = old.(*BlockTimeline)
+		}
+	}
+	if t == nil {
+		t = new(BlockTimeline)
+		t.DBHeight = dbheight
+	}
+	if !t.add(event, now) {
+		return
+	}
+	if event != TimelineSaved && event != TimelineAnchored {
+		s.blockTimelines[dbheight] = t
+		return
+	}
+
+	delete(s.blockTimelines, dbheight)
+	if err := s.DB.SaveBlockTimeline(dbheight, t); err != nil {
+		s.Logf("error", "Saving the timeline of block %d: %v", dbheight, err)
+	}
+	if event == TimelineSaved {
+		// Blocks are saved in order, so any below this one still in memory were abandoned
+		for height := range s.blockTimelines {
+			if height < dbheight {
+				delete(s.blockTimelines, height)
+			}
+		}
+		if dbheight >= kept {
+			s.DB.DeleteBlockTimeline(dbheight - kept)
+		}
+	}
+}
+
+// recordAnchors adds the anchored event to the timelines of the blocks anchored by the entries
+// of the anchor chain given.
+func (s *State) recordAnchors(entries []interfaces.IEBEntry) {
+	for _, entry := range entries {
+		ar, ok, err := anchor.UnmarshalAndValidateAnchorEntryAnyVersion(entry, databaseOverlay.AnchorSigPublicKeys)
+		if err != nil || !ok || ar == nil {
+			continue
+		}
+		s.RecordBlockEvent(ar.DBHeight, TimelineAnchored)
+	}
+}
+
+// GetBlockTimelines returns the timelines kept of the blocks from one height to another,
+// including those still being built.
+func (s *State) GetBlockTimelines(from uint32, to uint32) []interfaces.BlockTimeline {
+	s.blockTimelinesMutex.Lock()
+	defer s.blockTimelinesMutex.Unlock()
+
+	timelines := []interfaces.BlockTimeline{}
+	for height := from; height <= to && height >= from; height++ {
+		if t := s.blockTimelines[height]; t != nil {
+			timelines = append(timelines, copyTimeline(t))
+			continue
+		}
+		t, err := s.DB.FetchBlockTimeline(height, new(BlockTimeline))
+		if err == nil && t != nil {
+			timelines = append(timelines, copyTimeline(t.(*BlockTimeline)))
+		}
+	}
+	return timelines
+}
+
+func copyTimeline(t *BlockTimeline) interfaces.BlockTimeline {
+	c := t.BlockTimeline
+	c.Events = append([]interfaces.TimelineEvent(nil), t.Events...)
+	return c
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// A directory block's timestamp must be no earlier than the median of the timestamps of the
+// blocks before it, and no further ahead of our clock than the network's max drift.  Timestamps
+// are only kept to the minute, and blocks can be faster than that, so equal to the median is fine.
+//
+// Every follower judges a block with the same past blocks, so they all agree on the lower bound.
+// The upper bound depends on each node's clock, which is why it is a generous one.
+//
+// The rules only apply once the BlockTimestamp activation has taken effect, so that blocks saved
+// before them stay valid, and every node of the network starts applying them at the same block.
+
+// GetBlockTimestampRules returns the number of blocks the median is taken over, and the max
+// future drift, for our network.  A zero turns that check off.
+func (s *State) GetBlockTimestampRules() (int, time.Duration) {
+	switch s.NetworkNumber {
+	case constants.NETWORK_MAIN:
+		return s.MainBlockTimestampMedian, time.Duration(s.MainBlockTimestampMaxDrift) * time.Second
+	case constants.NETWORK_TEST:
+		return s.TestBlockTimestampMedian, time.Duration(s.TestBlockTimestampMaxDrift) * time.Second
+	}
+	return s.LocalBlockTimestampMedian, time.Duration(s.LocalBlockTimestampMaxDrift) * time.Second
+}
+
+type uint32s []uint32
+
+func (u uint32s) Len() int           { return len(u) }
+func (u uint32s) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
+func (u uint32s) Less(i, j int) bool { return u[i] < u[j] }
+
+// ValidateBlockTimestamp checks the timestamp of dblock.  Returns 1 if it is good, 0 if we don't
+// have the blocks before it yet, and -1 if it breaks a rule; with the reason for anything but 1.
+func (s *State) ValidateBlockTimestamp(dblock interfaces.IDirectoryBlock) (int, string) {
+	dbheight := dblock.GetHeader().GetDBHeight()
+	if dbheight == 0 || !s.IsActive(constants.ACTIVATION_BLOCK_TIMESTAMP, dbheight) {
+		return 1, ""
+	}
+	// Main net blocks under the last checkpoint are pinned by their KeyMRs
+	if s.NetworkNumber == constants.NETWORK_MAIN && dbheight <= highestCheckPoint() {
+		return 1, ""
+	}
+
+	medianBlocks, maxDrift := s.GetBlockTimestampRules()
+	ts := dblock.GetHeader().GetTimestamp()
+
+	if maxDrift > 0 {
+		ahead := time.Duration(ts.GetTimeMilli()-s.GetTimestamp().GetTimeMilli()) * time.Millisecond
+		if ahead > maxDrift {
+			return -1, fmt.Sprintf("timestamp %s is %v ahead of our clock, more than the %v allowed", ts.String(), ahead, maxDrift)
+		}
+	}
+
+	if medianBlocks > 0 {
+		var past []uint32
+		for h := int(dbheight) - 1; h >= 0 && len(past) < medianBlocks; h-- {
+			prev := s.GetDirectoryBlockByHeight(uint32(h))
+			if prev == nil {
+				return 0, fmt.Sprintf("don't have block %d to find the median timestamp", h)
+			}
+			past = append(past, prev.GetHeader().GetTimestamp().GetTimeMinutesUInt32())
+		}
+		sort.Sort(uint32s(past))
+		median := past[len(past)/2]
+		if ts.GetTimeMinutesUInt32() < median {
+			return -1, fmt.Sprintf("timestamp %d is before the median %d of the %d blocks before it (minutes)", ts.GetTimeMinutesUInt32(), median, len(past))
+		}
+	}
+	return 1, ""
+}
+
+func highestCheckPoint() uint32 {
+	var highest uint32
+	for h := range constants.CheckPoints {
+		if h > highest {
+			highest = h
+		}
+	}
+	return highest
+}
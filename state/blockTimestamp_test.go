@@ -0,0 +1,52 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestValidateBlockTimestamp(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	dbstates := testHelper.CreateTestDBStateList()
+	dblock := dbstates[len(dbstates)-1].(*messages.DBStateMsg).DirectoryBlock
+	header := dblock.GetHeader()
+	s.SetActivationHeight(constants.ACTIVATION_BLOCK_TIMESTAMP, constants.NETWORK_LOCAL, 1)
+
+	if v, reason := s.ValidateBlockTimestamp(dblock); v != 1 {
+		t.Errorf("Expected a valid timestamp, got %d: %s", v, reason)
+	}
+
+	good := header.GetTimestamp()
+	header.SetTimestamp(primitives.NewTimestampFromMinutes(0))
+	if v, _ := s.ValidateBlockTimestamp(dblock); v != -1 {
+		t.Errorf("Expected a timestamp before the median to be rejected, got %d", v)
+	}
+
+	_, drift := s.GetBlockTimestampRules()
+	future := time.Now().Add(drift + time.Hour)
+	header.SetTimestamp(primitives.NewTimestampFromMilliseconds(uint64(future.UnixNano() / 1e6)))
+	if v, _ := s.ValidateBlockTimestamp(dblock); v != -1 {
+		t.Errorf("Expected a timestamp too far in the future to be rejected, got %d", v)
+	}
+
+	s.SetActivationHeight(constants.ACTIVATION_BLOCK_TIMESTAMP, constants.NETWORK_LOCAL, int(header.GetDBHeight())+1)
+	if v, reason := s.ValidateBlockTimestamp(dblock); v != 1 {
+		t.Errorf("Expected no checks before the activation height, got %d: %s", v, reason)
+	}
+	s.SetActivationHeight(constants.ACTIVATION_BLOCK_TIMESTAMP, constants.NETWORK_LOCAL, 1)
+
+	s.LocalBlockTimestampMaxDrift = 0
+	if v, reason := s.ValidateBlockTimestamp(dblock); v != 1 {
+		t.Errorf("Expected no drift check when it is off, got %d: %s", v, reason)
+	}
+	header.SetTimestamp(good)
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// A commit chain only carries the hash of the chain ID, so until its first entry is revealed no
+// one else knows the chain.  But once the reveal is out, anyone can commit and reveal a different
+// first entry with the same external IDs, and if theirs is processed first, the chain is theirs.
+//
+// Once the ChainBinding activation has taken effect, a commit chain can be bound to its first entry
+// (see messages.CommitChainMsg).  The first bound commit of a chain reserves it: as a leader, we
+// don't ack the first entry of the chain unless it is the entry of that commit, and its external
+// IDs are the ones the commit is bound to, until that commit is revealed or expires.
+
+// Most chains reserved at once; past it, no more are until some are revealed or expire
+var MaxChainBindings = 10000
+
+// reserveChain reserves the chain of a bound commit for its entry, unless the chain is reserved
+// already.
+func (s *State) reserveChain(msg interfaces.IMsg) {
+	c, ok := msg.(*messages.CommitChainMsg)
+	if !ok || !c.IsBound() {
+		return
+	}
+	key := c.CommitChain.ChainIDHash.Fixed()
+	if s.chainReservation(key) != nil {
+		return
+	}
+	if s.chainBindings == nil {
+		s.chainBindings = make(map[[32]byte]*messages.CommitChainMsg)
+	}
+	if len(s.chainBindings) >= MaxChainBindings {
+		for k := range s.chainBindings {
+			s.chainReservation(k)
+		}
+		if len(s.chainBindings) >= MaxChainBindings {
+			return
+		}
+	}
+	s.chainBindings[key] = c
+}
+
+// chainReservation returns the bound commit that reserves the chain whose ID hashes to key; nil
+// if the chain isn't reserved, or the commit that reserved it is no longer pending.
+func (s *State) chainReservation(key [32]byte) *messages.CommitChainMsg {
+	c := s.chainBindings[key]
+	if c == nil {
+		return nil
+	}
+	pending := s.Commits[c.CommitChain.EntryHash.Fixed()]
+	if pending == nil || !pending.GetRepeatHash().IsSameAs(c.GetRepeatHash()) {
+		delete(s.chainBindings, key)
+		return nil
+	}
+	return c
+}
+
+// CheckChainBinding returns an error, and records the message as invalid with the error as the
+// reason, if the first entry of a chain we are about to ack as a leader doesn't match the binding
+// of its commit, or the chain is reserved for another entry.
+func (s *State) CheckChainBinding(m interfaces.IMsg) error {
+	re, ok := m.(*messages.RevealEntryMsg)
+	if !ok || !s.IsActive(constants.ACTIVATION_CHAIN_BINDING, s.LLeaderHeight) {
+		return nil
+	}
+	commit, ok := s.NextCommit(re.Entry.GetHash()).(*messages.CommitChainMsg)
+	if !ok {
+		return nil
+	}
+
+	var err error
+	if commit.IsBound() && !commit.Binding.IsSameAs(entryBlock.ExtIDMerkleRoot(re.Entry)) {
+		err = fmt.Errorf("The external IDs of the first entry are not the ones its commit is bound to")
+	} else if r := s.chainReservation(commit.CommitChain.ChainIDHash.Fixed()); r != nil && !r.CommitChain.EntryHash.IsSameAs(re.Entry.GetHash()) {
+		err = fmt.Errorf("The chain is reserved for the first entry of commit %x", r.GetHash().Bytes())
+	}
+	if err == nil {
+		return nil
+	}
+	s.Logf("debug", "Reveal %x not acked: %s", re.Entry.GetHash().Bytes()[:4], err.Error())
+	ChainBindingRefused.Inc()
+
+	s.SetInvalidReason(m.GetHash(), err.Error())
+	s.InvalidMessagesMutex.Lock()
+	s.InvalidMessages[m.GetHash().Fixed()] = m
+	s.InvalidMessagesMutex.Unlock()
+	delete(s.Holding, m.GetMsgHash().Fixed())
+	return err
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	ed "github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestChainBinding(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.NetworkNumber = constants.NETWORK_LOCAL
+	s.SetActivationHeight(constants.ACTIVATION_CHAIN_BINDING, constants.NETWORK_LOCAL, 1)
+	s.LLeaderHeight = 1
+
+	chainIDHash := primitives.Sha([]byte("chain"))
+	first := func(extID string) *entryBlock.Entry {
+		e := entryBlock.NewEntry()
+		e.ExtIDs = []primitives.ByteSlice{{Bytes: []byte(extID)}}
+		return e
+	}
+	commit := func(e *entryBlock.Entry, bindTo *entryBlock.Entry) *messages.CommitChainMsg {
+		pub, privkey, err := ed.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := new(messages.CommitChainMsg)
+		m.CommitChain = entryCreditBlock.NewCommitChain()
+		m.CommitChain.ChainIDHash = chainIDHash
+		m.CommitChain.EntryHash = e.GetHash()
+		m.CommitChain.Credits = 11
+		m.CommitChain.ECPubKey = (*primitives.ByteSlice32)(pub)
+		m.CommitChain.Sig = (*primitives.ByteSlice64)(ed.Sign(privkey, m.CommitChain.CommitMsg()))
+		if bindTo != nil {
+			if err := m.Bind(bindTo, privkey[:]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		s.PutCommit(e.GetHash(), m)
+		return m
+	}
+	reveal := func(e *entryBlock.Entry) *messages.RevealEntryMsg {
+		m := messages.NewRevealEntryMsg()
+		m.Entry = e
+		m.Timestamp = s.GetTimestamp()
+		return m
+	}
+
+	// The first bound commit reserves the chain for its entry
+	mine, theirs := first("mine"), first("theirs")
+	commit(mine, mine)
+	commit(theirs, nil)
+	if s.CheckChainBinding(reveal(theirs)) == nil {
+		t.Errorf("The first entry of another commit was let claim a reserved chain")
+	}
+	if err := s.CheckChainBinding(reveal(mine)); err != nil {
+		t.Errorf("The first entry of the bound commit was refused: %v", err)
+	}
+
+	// A bound commit only takes the first entry it is bound to
+	other := first("other")
+	commit(other, first("else"))
+	if s.CheckChainBinding(reveal(other)) == nil {
+		t.Errorf("A first entry not matching the binding of its commit was acked")
+	}
+
+	// Once the bound commit is no longer pending, the chain is free
+	delete(s.Commits, mine.GetHash().Fixed())
+	if err := s.CheckChainBinding(reveal(theirs)); err != nil {
+		t.Errorf("A first entry was refused after the reservation expired: %v", err)
+	}
+
+	// Before the activation height, nothing is checked
+	s.SetActivationHeight(constants.ACTIVATION_CHAIN_BINDING, constants.NETWORK_LOCAL, 0)
+	if err := s.CheckChainBinding(reveal(other)); err != nil {
+		t.Errorf("Chain binding was checked before its activation height: %v", err)
+	}
+}
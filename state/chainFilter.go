@@ -0,0 +1,113 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// A follower run for a special purpose may not want to hold and pass on the commits and reveals
+// of every chain.  With ChainDenylist configured it turns away those for the listed chains; with
+// ChainAllowlist configured it turns away those for any chain not listed.  Turned away messages
+// never reach the InMsgQueue, so they are neither held nor gossiped.
+//
+// A reveal names its chain, and a chain commit carries the double sha256 of its chain ID, so both
+// can be filtered.  An entry commit only names its entry, so it can't be, and is held until it
+// expires if its reveal is turned away.  Federated and audit servers must see everything, so the
+// filter is ignored while we are one.
+
+type ChainFilter struct {
+	allow map[[32]byte]bool // Chain IDs, and the double sha256 of each for chain commits
+	deny  map[[32]byte]bool
+}
+
+// NewChainFilter returns a filter from comma separated lists of chain IDs in hex; nil if both are
+// empty.
+func NewChainFilter(allow, deny string) (*ChainFilter, error) {
+	f := new(ChainFilter)
+	var err error
+	if f.allow, err = parseChainList(allow); err != nil {
+		return nil, err
+	}
+	if f.deny, err = parseChainList(deny); err != nil {
+		return nil, err
+	}
+	if f.allow == nil && f.deny == nil {
+		return nil, nil
+	}
+	return f, nil
+}
+
+func parseChainList(list string) (map[[32]byte]bool, error) {
+	var chains map[[32]byte]bool
+	for _, id := range strings.Split(list, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		chainID, err := primitives.HexToHash(id)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a chain ID", id)
+		}
+		if chains == nil {
+			chains = make(map[[32]byte]bool)
+		}
+		chains[chainID.Fixed()] = true
+		chains[primitives.NewHash(primitives.DoubleSha(chainID.Bytes())).Fixed()] = true
+	}
+	return chains, nil
+}
+
+// Accepts returns false if msg is a commit or reveal for a chain the filter turns away.
+func (f *ChainFilter) Accepts(msg interfaces.IMsg) bool {
+	var chain [32]byte
+	switch m := msg.(type) {
+	case *messages.RevealEntryMsg:
+		if m.Entry == nil || m.Entry.GetChainID() == nil {
+			return true
+		}
+		chain = m.Entry.GetChainID().Fixed()
+	case *messages.CommitChainMsg:
+		if m.CommitChain == nil || m.CommitChain.ChainIDHash == nil {
+			return true
+		}
+		chain = m.CommitChain.ChainIDHash.Fixed()
+	default:
+		return true
+	}
+	if f.deny[chain] {
+		return false
+	}
+	return f.allow == nil || f.allow[chain]
+}
+
+// configureChainFilter sets up the filter from the configuration.  Left off, with a warning, if a
+// list isn't of chain IDs.
+func (s *State) configureChainFilter(cfg *util.FactomdConfig) {
+	f, err := NewChainFilter(cfg.App.ChainAllowlist, cfg.App.ChainDenylist)
+	if err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("Chain filter is off: %s\n", err.Error()))
+	}
+	s.ChainFilter = f
+}
+
+// AcceptsChainMsg returns false if msg is a commit or reveal for a chain we filter out.
+func (s *State) AcceptsChainMsg(msg interfaces.IMsg) bool {
+	if s.ChainFilter == nil || s.ChainFilter.Accepts(msg) {
+		return true
+	}
+	if s.IsAuthority(s.LLeaderHeight) {
+		return true
+	}
+	ChainFiltered.Inc()
+	return false
+}
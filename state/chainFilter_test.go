@@ -0,0 +1,67 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestChainFilter(t *testing.T) {
+	spam := primitives.Sha([]byte("spam"))
+	ours := primitives.Sha([]byte("ours"))
+
+	reveal := func(chainID interfaces.IHash) *messages.RevealEntryMsg {
+		e := entryBlock.NewEntry()
+		e.ChainID = chainID
+		m := new(messages.RevealEntryMsg)
+		m.Entry = e
+		return m
+	}
+	commit := func(chainID interfaces.IHash) *messages.CommitChainMsg {
+		m := new(messages.CommitChainMsg)
+		m.CommitChain = entryCreditBlock.NewCommitChain()
+		m.CommitChain.ChainIDHash = primitives.NewHash(primitives.DoubleSha(chainID.Bytes()))
+		return m
+	}
+
+	if f, err := NewChainFilter("", " "); f != nil || err != nil {
+		t.Errorf("Empty lists should make no filter, got %v, %v", f, err)
+	}
+	if _, err := NewChainFilter("", "not a chain"); err == nil {
+		t.Errorf("A list that isn't of chain IDs was accepted")
+	}
+
+	deny, err := NewChainFilter("", spam.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deny.Accepts(reveal(spam)) || deny.Accepts(commit(spam)) {
+		t.Errorf("A denied chain was accepted")
+	}
+	if !deny.Accepts(reveal(ours)) || !deny.Accepts(commit(ours)) {
+		t.Errorf("A chain that isn't denied was turned away")
+	}
+	if !deny.Accepts(new(messages.EOM)) {
+		t.Errorf("A message for no chain was turned away")
+	}
+
+	allow, err := NewChainFilter(ours.String()+", ", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !allow.Accepts(reveal(ours)) || !allow.Accepts(commit(ours)) {
+		t.Errorf("An allowed chain was turned away")
+	}
+	if allow.Accepts(reveal(spam)) || allow.Accepts(commit(spam)) {
+		t.Errorf("A chain that isn't allowed was accepted")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sort"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// As each block is saved the entries, commits and entry credits spent are
+// counted by chain, so operators can see which chains drive the load, as in a
+// spam attack. A commit only names its entry, so it is counted against the
+// chain the entry was revealed on in the same block; commits whose reveal is
+// not in the block are counted under an empty chain ID.
+
+// Number of blocks whose chain statistics are kept
+var ChainStatsHistory uint32 = 200
+
+type BlockChainStats struct {
+	DBHeight uint32
+	Chains   map[string]*interfaces.ChainStats
+}
+
+// ComputeChainStats counts the entries of the entry blocks and the commits of
+// the entry credit block of a block by chain.
+func ComputeChainStats(dbheight uint32, ecblock interfaces.IEntryCreditBlock, eblocks []interfaces.IEntryBlock) *BlockChainStats {
+	bcs := new(BlockChainStats)
+	bcs.DBHeight = dbheight
+	bcs.Chains = make(map[string]*interfaces.ChainStats)
+
+	get := func(chainID string) *interfaces.ChainStats {
+		cs := bcs.Chains[chainID]
+		if cs == nil {
+			cs = &interfaces.ChainStats{ChainID: chainID}
+			bcs.Chains[chainID] = cs
+		}
+		return cs
+	}
+
+	chainOf := make(map[[32]byte]string)
+	for _, eb := range eblocks {
+		if eb == nil {
+			continue
+		}
+		// A chain has one entry block a block, though it may be passed twice
+		chainID := eb.GetChainID().String()
+		if _, ok := bcs.Chains[chainID]; ok {
+			continue
+		}
+		for _, hash := range eb.GetEntryHashes() {
+			if hash.IsMinuteMarker() {
+				continue
+			}
+			chainOf[hash.Fixed()] = chainID
+			get(chainID).Entries++
+		}
+	}
+
+	if ecblock == nil {
+		return bcs
+	}
+	for _, entry := range ecblock.GetEntries() {
+		var entryHash interfaces.IHash
+		var credits uint8
+		switch c := entry.(type) {
+		case *entryCreditBlock.CommitEntry:
+			entryHash, credits = c.EntryHash, c.Credits
+		case *entryCreditBlock.CommitChain:
+			entryHash, credits = c.EntryHash, c.Credits
+		default:
+			continue
+		}
+		cs := get(chainOf[entryHash.Fixed()])
+		cs.Commits++
+		cs.ECs += uint64(credits)
+	}
+	return bcs
+}
+
+// AddBlockChainStats keeps the chain statistics of a block, and drops those
+// more than ChainStatsHistory blocks older
+func (s *State) AddBlockChainStats(bcs *BlockChainStats) {
+	if bcs == nil {
+		return
+	}
+	s.ChainStatsMutex.Lock()
+	defer s.ChainStatsMutex.Unlock()
+
+	if s.ChainStats == nil {
+		s.ChainStats = make(map[uint32]*BlockChainStats)
+	}
+	s.ChainStats[bcs.DBHeight] = bcs
+	for height := range s.ChainStats {
+		if height+ChainStatsHistory <= bcs.DBHeight {
+			delete(s.ChainStats, height)
+		}
+	}
+}
+
+type byECsSpent []interfaces.ChainStats
+
+func (a byECsSpent) Len() int      { return len(a) }
+func (a byECsSpent) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byECsSpent) Less(i, j int) bool {
+	if a[i].ECs != a[j].ECs {
+		return a[i].ECs > a[j].ECs
+	}
+	if a[i].Entries != a[j].Entries {
+		return a[i].Entries > a[j].Entries
+	}
+	return a[i].ChainID < a[j].ChainID
+}
+
+// GetChainStats adds up the chain statistics of the blocks from one height to
+// another, most entry credits spent first, and returns the number of those
+// blocks that are kept.
+func (s *State) GetChainStats(from uint32, to uint32) ([]interfaces.ChainStats, int) {
+	s.ChainStatsMutex.RLock()
+	defer s.ChainStatsMutex.RUnlock()
+
+	total := make(map[string]*interfaces.ChainStats)
+	blocks := 0
+	for height, bcs := range s.ChainStats {
+		if height < from || height > to {
+			continue
+		}
+		blocks++
+		for chainID, cs := range bcs.Chains {
+			t := total[chainID]
+			if t == nil {
+				t = &interfaces.ChainStats{ChainID: chainID}
+				total[chainID] = t
+			}
+			t.Entries += cs.Entries
+			t.Commits += cs.Commits
+			t.ECs += cs.ECs
+		}
+	}
+
+	stats := make([]interfaces.ChainStats, 0, len(total))
+	for _, t := range total {
+		stats = append(stats, *t)
+	}
+	sort.Sort(byECsSpent(stats))
+	return stats, blocks
+}
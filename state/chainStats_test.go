@@ -0,0 +1,87 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestComputeChainStats(t *testing.T) {
+	bs := testHelper.CreateTestBlockSet(testHelper.CreateTestBlockSet(nil))
+	dbheight := bs.DBlock.GetDatabaseHeight()
+
+	// The entry block of the anchor chain is passed twice, and counted once
+	eblocks := []interfaces.IEntryBlock{bs.EBlock, bs.AnchorEBlock, bs.AnchorEBlock}
+	bcs := ComputeChainStats(dbheight, bs.ECBlock, eblocks)
+	if bcs.DBHeight != dbheight {
+		t.Errorf("Expected height %d, got %d", dbheight, bcs.DBHeight)
+	}
+
+	for _, eb := range eblocks[:2] {
+		cs := bcs.Chains[eb.GetChainID().String()]
+		if cs == nil {
+			t.Fatalf("No statistics for chain %s", eb.GetChainID().String())
+		}
+		entries := 0
+		for _, hash := range eb.GetEntryHashes() {
+			if !hash.IsMinuteMarker() {
+				entries++
+			}
+		}
+		if cs.Entries != entries {
+			t.Errorf("Chain %s has %d entries, counted %d", cs.ChainID, entries, cs.Entries)
+		}
+		// The test blocks commit the first entry of each entry block
+		if cs.Commits != 1 || cs.ECs == 0 {
+			t.Errorf("Chain %s has %d commits for %d ECs, expected one", cs.ChainID, cs.Commits, cs.ECs)
+		}
+	}
+	if _, ok := bcs.Chains[""]; ok {
+		t.Error("Every commit was revealed, none should be without a chain")
+	}
+
+	// Without the entry blocks the commits are not revealed
+	bcs = ComputeChainStats(dbheight, bs.ECBlock, nil)
+	if len(bcs.Chains) != 1 || bcs.Chains[""] == nil || bcs.Chains[""].Commits != 2 {
+		t.Errorf("Expected the 2 commits without a chain, got %v", bcs.Chains)
+	}
+}
+
+func TestGetChainStats(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	ecs := uint64(0)
+	var prev *testHelper.BlockSet
+	for i := uint32(0); i < ChainStatsHistory+5; i++ {
+		prev = testHelper.CreateTestBlockSet(prev)
+		s.AddBlockChainStats(ComputeChainStats(i, prev.ECBlock, []interfaces.IEntryBlock{prev.EBlock, prev.AnchorEBlock}))
+		if i >= 5 {
+			for _, entry := range prev.ECBlock.GetEntries() {
+				if c, ok := entry.(*entryCreditBlock.CommitEntry); ok {
+					ecs += uint64(c.Credits)
+				}
+			}
+		}
+	}
+
+	stats, kept := s.GetChainStats(0, ChainStatsHistory+4)
+	if kept != int(ChainStatsHistory) {
+		t.Errorf("Expected %d blocks kept, got %d", ChainStatsHistory, kept)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 chains, got %d", len(stats))
+	}
+	if stats[0].ECs < stats[1].ECs {
+		t.Error("The chain with the most entry credits spent should be first")
+	}
+	if stats[0].ECs+stats[1].ECs != ecs {
+		t.Errorf("Expected %d ECs spent, got %d", ecs, stats[0].ECs+stats[1].ECs)
+	}
+
+	if _, kept := s.GetChainStats(ChainStatsHistory+5, ChainStatsHistory+10); kept != 0 {
+		t.Errorf("Expected no blocks above the last, got %d", kept)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// At each minute boundary, once every VM's EOM is in, the process list being built takes a
+// Checkpoint of where it is.  When a VM's list turns out to be corrupt (an ack whose serial hash
+// doesn't follow from the ones we processed) we roll back to the last checkpoint, throw away what
+// we processed since, and ask our peers for those messages again, instead of resetting to the last
+// saved block and replaying everything.  Each rollback uses up the checkpoint, so if the minute
+// before was bad too, the next one goes back further, and with none left we reset as before.
+//
+// The process list is cheap to checkpoint.  The VM lists are only appended to past what was
+// processed, so a height is enough to get back to them, and so are the lengths of the entry
+// blocks.  The temporary balances and new entries are shared with the process list until it next
+// writes to them, when it copies them.  Only the entry credit and admin block entries, which can
+// be reordered, and the factoid block, which is built in place, are copied.
+//
+// Processing a minute also changes the State: commits are kept until their reveals take them,
+// hashes go into the replay filter, and messages leave Holding or are found invalid.  Those are
+// copied too, or the messages we ask for again would be replays, or reveals without commits.
+
+type vmCheckpoint struct {
+	Height       int
+	LeaderMinute int
+	Synced       bool
+}
+
+type Checkpoint struct {
+	Minute int // The minute we are in at the checkpoint, the one the rollback would redo
+
+	VMs    []vmCheckpoint
+	System vmCheckpoint
+
+	FactoidBalancesT map[[32]byte]int64
+	ECBalancesT      map[[32]byte]int64
+	NewEntries       map[[32]byte]interfaces.IEntry
+	EBlockEntries    map[[32]byte]int // Entries (and minute markers) in each new entry block
+	ECEntries        []interfaces.IECBlockEntry
+	ABEntries        []interfaces.IABEntry
+	DBSignatures     int
+	FBlock           interfaces.IFBlock
+
+	// The State's commits, replay filter, held and invalid messages
+	Commits         map[[32]byte]interfaces.IMsg
+	Replay          *Replay
+	Holding         map[[32]byte]interfaces.IMsg
+	InvalidMessages map[[32]byte]interfaces.IMsg
+
+	// State of the minute's EOM processing
+	EOM          bool
+	EOMDone      bool
+	EOMSys       bool
+	EOMProcessed int
+	EOMLimit     int
+	EOMMinute    int
+	Syncing      bool
+}
+
+// The temporary balances and new entries are shared with the last checkpoint until we write to them.
+type sharedMaps struct {
+	factoidBalances bool
+	ecBalances      bool
+	newEntries      bool
+}
+
+// Checkpoint records where the process list is as a minute begins.  Only the process list being
+// built takes checkpoints, since the temporary balances are kept there.
+func (p *ProcessList) Checkpoint(minute int) {
+	s := p.State
+	if p.DBHeight != s.LLeaderHeight {
+		return
+	}
+	fblock := copyFBlock(s.FactoidState.GetCurrentBlock())
+	if fblock == nil {
+		return
+	}
+
+	c := new(Checkpoint)
+	c.Minute = minute
+	for _, vm := range p.VMs {
+		c.VMs = append(c.VMs, vmCheckpoint{vm.Height, vm.LeaderMinute, vm.Synced})
+	}
+	c.System = vmCheckpoint{p.System.Height, p.System.LeaderMinute, p.System.Synced}
+
+	p.FactoidBalancesTMutex.Lock()
+	c.FactoidBalancesT = p.FactoidBalancesT
+	p.shared.factoidBalances = true
+	p.FactoidBalancesTMutex.Unlock()
+
+	p.ECBalancesTMutex.Lock()
+	c.ECBalancesT = p.ECBalancesT
+	p.shared.ecBalances = true
+	p.ECBalancesTMutex.Unlock()
+
+	p.NewEntriesMutex.Lock()
+	c.NewEntries = p.NewEntries
+	p.shared.newEntries = true
+	p.NewEntriesMutex.Unlock()
+
+	p.neweblockslock.Lock()
+	c.EBlockEntries = make(map[[32]byte]int)
+	for k, eb := range p.NewEBlocks {
+		c.EBlockEntries[k] = len(eb.GetBody().GetEBEntries())
+	}
+	p.neweblockslock.Unlock()
+
+	c.ECEntries = append(c.ECEntries, p.EntryCreditBlock.GetBody().GetEntries()...)
+	c.ABEntries = append(c.ABEntries, p.AdminBlock.GetABEntries()...)
+	c.DBSignatures = len(p.DBSignatures)
+	c.FBlock = fblock
+
+	c.Commits = copyMsgs(s.Commits)
+	c.Replay = s.Replay.Save()
+	c.Holding = copyMsgs(s.Holding)
+	s.InvalidMessagesMutex.RLock()
+	c.InvalidMessages = copyMsgs(s.InvalidMessages)
+	s.InvalidMessagesMutex.RUnlock()
+
+	c.EOM, c.EOMDone, c.EOMSys = s.EOM, s.EOMDone, s.EOMSys
+	c.EOMProcessed, c.EOMLimit, c.EOMMinute = s.EOMProcessed, s.EOMLimit, s.EOMMinute
+	c.Syncing = s.Syncing
+
+	p.Checkpoints = append(p.Checkpoints, c)
+}
+
+// Rollback undoes everything processed since the last checkpoint, and asks for the messages
+// again.  Returns false if we can't, and have to reset instead: there is no checkpoint, this isn't
+// the process list being built, or we are a federated server, who would ack new messages at
+// heights it has already acked.
+func (p *ProcessList) Rollback() bool {
+	s := p.State
+	if len(p.Checkpoints) == 0 || p.DBHeight != s.LLeaderHeight {
+		return false
+	}
+	if fed, _ := p.GetFedServerIndexHash(s.IdentityChainID); fed {
+		return false
+	}
+	c := p.Checkpoints[len(p.Checkpoints)-1]
+	p.Checkpoints = p.Checkpoints[:len(p.Checkpoints)-1]
+	if len(c.VMs) != len(p.VMs) {
+		return false
+	}
+
+	had := make([]int, len(p.VMs))
+	for i, vm := range p.VMs {
+		had[i] = len(vm.List)
+		restoreVM(vm, c.VMs[i])
+	}
+	restoreVM(&p.System, c.System)
+	p.Requests = make(map[[32]byte]*Request)
+	for i := range p.NextHeightToProcess {
+		p.NextHeightToProcess[i] = 0
+	}
+
+	p.FactoidBalancesTMutex.Lock()
+	p.FactoidBalancesT = c.FactoidBalancesT
+	p.shared.factoidBalances = true
+	p.FactoidBalancesTMutex.Unlock()
+
+	p.ECBalancesTMutex.Lock()
+	p.ECBalancesT = c.ECBalancesT
+	p.shared.ecBalances = true
+	p.ECBalancesTMutex.Unlock()
+
+	p.NewEntriesMutex.Lock()
+	p.NewEntries = c.NewEntries
+	p.shared.newEntries = true
+	p.NewEntriesMutex.Unlock()
+
+	p.neweblockslock.Lock()
+	for k, eb := range p.NewEBlocks {
+		n, ok := c.EBlockEntries[k]
+		body, isBody := eb.GetBody().(*entryBlock.EBlockBody)
+		if !ok || !isBody {
+			delete(p.NewEBlocks, k)
+			continue
+		}
+		body.EBEntries = body.EBEntries[:n]
+		eb.GetHeader().SetEntryCount(uint32(n))
+	}
+	p.neweblockslock.Unlock()
+
+	p.EntryCreditBlock.GetBody().SetEntries(append([]interfaces.IECBlockEntry{}, c.ECEntries...))
+	p.AdminBlock.SetABEntries(append([]interfaces.IABEntry{}, c.ABEntries...))
+	if c.DBSignatures < len(p.DBSignatures) {
+		p.DBSignatures = p.DBSignatures[:c.DBSignatures]
+	}
+	if fs, ok := s.FactoidState.(*FactoidState); ok {
+		fs.CurrentBlock = copyFBlock(c.FBlock)
+	}
+
+	// Messages held since are kept, along with those processed since
+	s.Commits = c.Commits
+	s.Replay = c.Replay
+	for k, m := range c.Holding {
+		if _, ok := s.Holding[k]; !ok {
+			s.Holding[k] = m
+		}
+	}
+	s.InvalidMessagesMutex.Lock()
+	s.InvalidMessages = c.InvalidMessages
+	s.InvalidMessagesMutex.Unlock()
+
+	s.CurrentMinute = c.Minute
+	s.EOM, s.EOMDone, s.EOMSys = c.EOM, c.EOMDone, c.EOMSys
+	s.EOMProcessed, s.EOMLimit, s.EOMMinute = c.EOMProcessed, c.EOMLimit, c.EOMMinute
+	s.Syncing = c.Syncing
+	s.Leader, s.LeaderVMIndex = s.LeaderPL.GetVirtualServers(s.CurrentMinute, s.IdentityChainID)
+	s.MinuteRollbackCnt++
+
+	msg := fmt.Sprintf("Rolled back dbht %d to the start of minute %d", p.DBHeight, c.Minute)
+	s.Logf("alert", "%s", msg)
+	s.AddStatus(msg)
+
+	// Ask for everything we threw away, and the message after it, in one go
+	for i := range p.FedServers {
+		start, end := uint32(p.VMs[i].Height), uint32(had[i])
+		if end-start >= messages.MaxMissingMsgRange {
+			end = start + messages.MaxMissingMsgRange - 1
+		}
+		request := messages.NewMissingMsgRange(s, i, p.DBHeight, start, end)
+		request.SendOut(s, request)
+		s.MissingRequestAskCnt++
+	}
+	return true
+}
+
+func restoreVM(vm *VM, c vmCheckpoint) {
+	if c.Height < len(vm.List) {
+		vm.List = vm.List[:c.Height]
+		vm.ListAck = vm.ListAck[:c.Height]
+	}
+	vm.Height = c.Height
+	vm.LeaderMinute = c.LeaderMinute
+	vm.Synced = c.Synced
+	vm.heartBeat = 0
+}
+
+func copyMsgs(m map[[32]byte]interfaces.IMsg) map[[32]byte]interfaces.IMsg {
+	c := make(map[[32]byte]interfaces.IMsg, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// copyFBlock returns a copy of a factoid block under construction, or nil if it can't.
+func copyFBlock(b interfaces.IFBlock) interfaces.IFBlock {
+	if b == nil {
+		return nil
+	}
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return nil
+	}
+	c, err := factoid.UnmarshalFBlock(data)
+	if err != nil {
+		return nil
+	}
+	return c
+}
+
+// Copy-on-write: called with the map's mutex held, before writing to it.
+
+func (p *ProcessList) unshareFactoidBalances() {
+	if !p.shared.factoidBalances {
+		return
+	}
+	m := make(map[[32]byte]int64, len(p.FactoidBalancesT))
+	for k, v := range p.FactoidBalancesT {
+		m[k] = v
+	}
+	p.FactoidBalancesT = m
+	p.shared.factoidBalances = false
+}
+
+func (p *ProcessList) unshareECBalances() {
+	if !p.shared.ecBalances {
+		return
+	}
+	m := make(map[[32]byte]int64, len(p.ECBalancesT))
+	for k, v := range p.ECBalancesT {
+		m[k] = v
+	}
+	p.ECBalancesT = m
+	p.shared.ecBalances = false
+}
+
+func (p *ProcessList) unshareNewEntries() {
+	if !p.shared.newEntries {
+		return
+	}
+	m := make(map[[32]byte]interfaces.IEntry, len(p.NewEntries))
+	for k, v := range p.NewEntries {
+		m[k] = v
+	}
+	p.NewEntries = m
+	p.shared.newEntries = false
+}
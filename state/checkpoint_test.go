@@ -0,0 +1,118 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestRollbackCommitAndReveal(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.IdentityChainID = primitives.Sha([]byte("a follower")) // Federated servers don't roll back
+	pl := s.ProcessLists.Get(s.LLeaderHeight)
+
+	held := testHelper.CreateTestEntry(1)
+	pl.AddNewEntry(held.GetHash(), held)
+	pl.Checkpoint(s.CurrentMinute)
+	if len(pl.Checkpoints) != 1 {
+		t.Fatalf("Expected a checkpoint, have %d", len(pl.Checkpoints))
+	}
+
+	// A chain is committed and revealed after the checkpoint, and an entry it held is deleted
+	entry := entryBlock.NewEntry()
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("rolled back")}}
+	entry.ChainID = entryBlock.NewChainID(entry)
+	commit := new(messages.CommitChainMsg)
+	commit.CommitChain = entryCreditBlock.NewCommitChain()
+	commit.CommitChain.EntryHash = entry.GetHash()
+	commit.CommitChain.Credits = 11
+	s.PutCommit(entry.GetHash(), commit)
+	now := s.GetTimestamp()
+	s.Replay.IsTSValid_(constants.INTERNAL_REPLAY, commit.GetRepeatHash().Fixed(), now, now)
+	if s.Replay.IsHashUnique(constants.INTERNAL_REPLAY, commit.GetRepeatHash().Fixed()) {
+		t.Fatal("The commit isn't in the replay filter")
+	}
+	unrevealed := messages.NewCommitEntryMsg()
+	unrevealed.CommitEntry = entryCreditBlock.NewCommitEntry()
+	unrevealed.CommitEntry.EntryHash = held.GetHash()
+	unrevealed.CommitEntry.Credits = 1
+	s.PutCommit(held.GetHash(), unrevealed)
+
+	reveal := messages.NewRevealEntryMsg()
+	reveal.Entry = entry
+	if !s.ProcessRevealEntry(s.LLeaderHeight, reveal) {
+		t.Fatal("The reveal wasn't processed")
+	}
+	if s.Commits[entry.GetHash().Fixed()] != nil {
+		t.Fatal("The reveal didn't take its commit")
+	}
+	pl.DeleteNewEntry(held.GetHash())
+
+	invalid := testHelper.CreateTestEntry(2).GetHash()
+	s.InvalidMessages[invalid.Fixed()] = reveal
+
+	if !pl.Rollback() {
+		t.Fatal("Didn't roll back to the checkpoint")
+	}
+	if s.Commits[held.GetHash().Fixed()] != nil {
+		t.Error("The commit made after the checkpoint is still kept")
+	}
+	if !s.Replay.IsHashUnique(constants.INTERNAL_REPLAY, commit.GetRepeatHash().Fixed()) {
+		t.Error("The commit made after the checkpoint is still in the replay filter")
+	}
+	if pl.GetNewEntry(entry.GetHash().Fixed()) != nil || s.GetNewEBlocks(s.LLeaderHeight, entry.ChainID) != nil {
+		t.Error("The entry revealed after the checkpoint is still in the process list")
+	}
+	if pl.GetNewEntry(held.GetHash().Fixed()) == nil {
+		t.Error("The entry deleted after the checkpoint wasn't restored")
+	}
+	if _, ok := s.InvalidMessages[invalid.Fixed()]; ok {
+		t.Error("The message found invalid after the checkpoint is still invalid")
+	}
+}
+
+func TestRollbackRestoresCommit(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.IdentityChainID = primitives.Sha([]byte("a follower"))
+	pl := s.ProcessLists.Get(s.LLeaderHeight)
+
+	// The chain is committed before the checkpoint, and revealed after it
+	entry := entryBlock.NewEntry()
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("committed before")}}
+	entry.ChainID = entryBlock.NewChainID(entry)
+	commit := new(messages.CommitChainMsg)
+	commit.CommitChain = entryCreditBlock.NewCommitChain()
+	commit.CommitChain.EntryHash = entry.GetHash()
+	commit.CommitChain.Credits = 11
+	s.PutCommit(entry.GetHash(), commit)
+	heldMsg := messages.NewRevealEntryMsg()
+	heldMsg.Entry = testHelper.CreateTestEntry(3)
+	s.Holding[heldMsg.GetMsgHash().Fixed()] = heldMsg
+	pl.Checkpoint(s.CurrentMinute)
+
+	reveal := messages.NewRevealEntryMsg()
+	reveal.Entry = entry
+	if !s.ProcessRevealEntry(s.LLeaderHeight, reveal) {
+		t.Fatal("The reveal wasn't processed")
+	}
+	delete(s.Holding, heldMsg.GetMsgHash().Fixed())
+
+	if !pl.Rollback() {
+		t.Fatal("Didn't roll back to the checkpoint")
+	}
+	if s.Commits[entry.GetHash().Fixed()] != commit {
+		t.Error("The commit taken by a reveal after the checkpoint wasn't restored")
+	}
+	if s.Holding[heldMsg.GetMsgHash().Fixed()] == nil {
+		t.Error("The message that left Holding after the checkpoint wasn't restored")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/clock"
+	"github.com/FactomProject/factomd/util"
+)
+
+// With NTPServers configured, we measure our clock against them every ClockCheckMinutes, or by
+// ScheduleClockCheck (see clock/ and schedule.go).  Message timestamps are judged against our clock, and a full server fault is only good
+// for 20 seconds, so a clock off by more than ClockMaxOffset seconds is reported as an alert and
+// on the control panel.  With ClockCorrect set we also correct for it: GetTimestamp, and so the
+// timestamps we validate against and put on our EOMs and acks, uses NTP time rather than the
+// system clock.  Fixing the system clock is still the better cure.
+//
+// Tests can instead give us a VirtualClock, which runs faster than the system clock.  Then the
+// minute timer, fault timeouts and standby quiet time all run on it, through ClockNow, ClockSleep
+// and ClockSince, so a test of many blocks takes seconds.
+
+const DefaultClockCheckInterval = 10 * time.Minute
+
+// configureClock sets up the clock from the configuration; nil if no servers are configured.
+func (s *State) configureClock(cfg *util.FactomdConfig) {
+	s.Clock = nil
+	servers := clock.ParseServers(cfg.App.NTPServers)
+	if len(servers) == 0 {
+		return
+	}
+	c := clock.New(servers)
+	if cfg.App.ClockMaxOffset > 0 {
+		c.MaxOffset = time.Duration(cfg.App.ClockMaxOffset) * time.Second
+	}
+	c.Correct = cfg.App.ClockCorrect
+	s.Clock = c
+	s.ClockCheckInterval = DefaultClockCheckInterval
+	if cfg.App.ClockCheckMinutes > 0 {
+		s.ClockCheckInterval = time.Duration(cfg.App.ClockCheckMinutes) * time.Minute
+	}
+}
+
+// ClockNow is our time: the system clock, corrected by NTP if we are correcting, or the virtual
+// clock in tests, and moved by ClockSkew in simulations.
+func (s *State) ClockNow() time.Time {
+	now := time.Now()
+	if s.VirtualClock != nil {
+		now = s.VirtualClock.Now()
+	} else if s.Clock != nil {
+		now = s.Clock.Now()
+	}
+	if s.ClockSkew != 0 {
+		now = now.Add(time.Duration(s.ClockSkew) * time.Millisecond)
+	}
+	return now
+}
+
+// ClockSleep sleeps for d of our time, which is shorter on the system clock if it is virtual.
+func (s *State) ClockSleep(d time.Duration) {
+	if s.VirtualClock != nil {
+		s.VirtualClock.Sleep(d)
+		return
+	}
+	time.Sleep(d)
+}
+
+// ClockSince returns how much of our time has passed since t.
+func (s *State) ClockSince(t time.Time) time.Duration {
+	return s.ClockNow().Sub(t)
+}
+
+// CheckClock measures the clock, and raises an alert if it is too far off.
+func (s *State) CheckClock() error {
+	if s.Clock == nil {
+		return nil
+	}
+	offset, err := s.Clock.Measure()
+	if err != nil {
+		s.AddStatus("Clock: " + err.Error())
+		return err
+	}
+	if !s.Clock.Exceeds(offset) {
+		return nil
+	}
+
+	direction, off := "behind", offset
+	if offset < 0 {
+		direction, off = "ahead of", -offset
+	}
+	msg := fmt.Sprintf("Our clock is %v %s NTP time, more than the %v our messages are judged with", off, direction, s.Clock.MaxOffset)
+	if s.Clock.Correct {
+		msg += "; correcting for it"
+	} else {
+		msg += "; messages may be rejected until the system clock is fixed, or ClockCorrect is set"
+	}
+	os.Stderr.WriteString(msg + "\n")
+	s.Logf("alert", "%s", msg)
+	s.AddStatus(msg)
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// An entry can be committed to more than once before it is revealed.  Every commit a leader acks
+// pays as it is processed, but only one is kept in Commits to match the reveal against:
+//
+//   - A later commit of the same kind that pays more entry credits supersedes the one kept.
+//   - A later commit paying the same or less, or of the other kind, doesn't; the one kept stays.
+//
+// As a leader we don't ack a commit that wouldn't supersede the one pending, so it isn't charged
+// for nothing; it is found invalid, with the reason, for its ack to report.  The commits that were
+// superseded, or that paid without ever being kept, are kept by entry, for the entry's ack to list.
+//
+// Every node has to keep the same commit, or they disagree on which reveal is valid.  So these
+// rules only apply once the CommitReplacement activation (see activations.go) has taken effect.
+// Before it, a later commit replaces the pending one unless it is a commit entry paying fewer
+// entry credits than the pending commit, and leaders ack every commit.
+
+// Most entries the superseded commits are kept for; all are forgotten once there are more
+var MaxSupersededEntries = 2048
+
+// commitCredits returns the credits a commit pays for its entry, leaving out the 10 a commit
+// chain pays for the chain; false if m isn't a commit.
+func commitCredits(m interfaces.IMsg) (int, bool) {
+	switch c := m.(type) {
+	case *messages.CommitEntryMsg:
+		return int(c.CommitEntry.Credits), true
+	case *messages.CommitChainMsg:
+		return int(c.CommitChain.Credits) - 10, true
+	}
+	return 0, false
+}
+
+// commitKind returns the type of a commit, taking a bound commit chain for a commit chain.
+func commitKind(m interfaces.IMsg) byte {
+	if m.Type() == constants.BOUND_COMMIT_CHAIN_MSG {
+		return constants.COMMIT_CHAIN_MSG
+	}
+	return m.Type()
+}
+
+// supersedes is true if commit replaces pending as the commit of their entry.
+func (s *State) supersedes(commit interfaces.IMsg, pending interfaces.IMsg) bool {
+	if pending == nil {
+		return true
+	}
+	if !s.IsActive(constants.ACTIVATION_COMMIT_REPLACEMENT, s.LLeaderHeight) {
+		return replacesBeforeActivation(commit, pending)
+	}
+	if commitKind(commit) != commitKind(pending) {
+		return false
+	}
+	credits, ok1 := commitCredits(commit)
+	pendingCredits, ok2 := commitCredits(pending)
+	return ok1 && ok2 && credits > pendingCredits
+}
+
+// replacesBeforeActivation is true if commit replaces pending under the rule from before the
+// CommitReplacement activation.
+func replacesBeforeActivation(commit interfaces.IMsg, pending interfaces.IMsg) bool {
+	c, ok := commit.(*messages.CommitEntryMsg)
+	if !ok {
+		return true
+	}
+	switch p := pending.(type) {
+	case *messages.CommitEntryMsg:
+		return p.CommitEntry.Credits <= c.CommitEntry.Credits
+	case *messages.CommitChainMsg:
+		return p.CommitChain.Credits <= c.CommitEntry.Credits
+	}
+	return true
+}
+
+// CheckCommitReplacement returns an error, and records the message as invalid with the error as
+// the reason, if a commit we are about to ack as a leader wouldn't supersede the commit pending
+// for its entry.
+func (s *State) CheckCommitReplacement(m interfaces.IMsg) error {
+	hash := commitEntryHash(m)
+	if hash == nil {
+		return nil
+	}
+	if !s.IsActive(constants.ACTIVATION_COMMIT_REPLACEMENT, s.LLeaderHeight) {
+		return nil
+	}
+	pending := s.Commits[hash.Fixed()]
+	if pending == nil || pending.GetRepeatHash().IsSameAs(m.GetRepeatHash()) || s.supersedes(m, pending) {
+		return nil
+	}
+
+	var err error
+	credits, _ := commitCredits(pending)
+	if commitKind(m) != commitKind(pending) {
+		err = fmt.Errorf("The entry is already committed to by a commit of the other kind")
+	} else {
+		err = fmt.Errorf("The entry is already committed to for %d entry credits; a commit must pay more to replace it", credits)
+	}
+	s.Logf("debug", "Commit %x of entry %x not acked: %s", m.GetHash().Bytes()[:4], hash.Bytes()[:4], err.Error())
+	CommitsNotSuperseding.Inc()
+
+	s.SetInvalidReason(m.GetHash(), err.Error())
+	s.InvalidMessagesMutex.Lock()
+	s.InvalidMessages[m.GetHash().Fixed()] = m
+	s.InvalidMessagesMutex.Unlock()
+	delete(s.Holding, m.GetMsgHash().Fixed())
+	return err
+}
+
+// supersede records that a commit of the entry with this hash is not the one kept.
+func (s *State) supersede(hash interfaces.IHash, pending interfaces.IMsg) {
+	CommitsSuperseded.Inc()
+	s.Logf("debug", "Commit %x of entry %x superseded", pending.GetHash().Bytes()[:4], hash.Bytes()[:4])
+
+	s.supersededMutex.Lock()
+	defer s.supersededMutex.Unlock()
+
+	if s.superseded == nil || len(s.superseded) >= MaxSupersededEntries {
+		s.superseded = make(map[[32]byte][]interfaces.IHash)
+	}
+	s.superseded[hash.Fixed()] = append(s.superseded[hash.Fixed()], pending.GetHash())
+}
+
+// GetSupersededCommits returns the transaction IDs of the commits of an entry that were
+// superseded by commits paying more, oldest first.
+func (s *State) GetSupersededCommits(entryHash interfaces.IHash) []interfaces.IHash {
+	s.supersededMutex.Lock()
+	defer s.supersededMutex.Unlock()
+
+	return append([]interfaces.IHash{}, s.superseded[entryHash.Fixed()]...)
+}
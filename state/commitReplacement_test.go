@@ -0,0 +1,96 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+var replacedEntryHash = primitives.Sha([]byte("entry"))
+
+func replacementCommit(credits uint8) *messages.CommitEntryMsg {
+	m := messages.NewCommitEntryMsg()
+	m.CommitEntry = entryCreditBlock.NewCommitEntry()
+	m.CommitEntry.EntryHash = replacedEntryHash
+	m.CommitEntry.Credits = credits
+	return m
+}
+
+func TestCommitReplacement(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.SetActivationHeight(constants.ACTIVATION_COMMIT_REPLACEMENT, s.NetworkNumber, 10)
+	s.LLeaderHeight = 10
+	entryHash, commit := replacedEntryHash, replacementCommit
+	first := commit(2)
+	s.PutCommit(entryHash, first)
+
+	// Paying the same doesn't replace the pending commit, and isn't acked by a leader
+	same := commit(2)
+	same.CommitEntry.MilliTime[5] = 1
+	if s.CheckCommitReplacement(same) == nil {
+		t.Errorf("A commit paying the same was let supersede the pending one")
+	}
+	s.PutCommit(entryHash, same)
+	if s.Commits[entryHash.Fixed()] != first {
+		t.Errorf("A commit paying the same replaced the pending one")
+	}
+
+	// Paying more does, and the replaced commit is reported
+	more := commit(3)
+	if err := s.CheckCommitReplacement(more); err != nil {
+		t.Errorf("A commit paying more was refused: %v", err)
+	}
+	s.PutCommit(entryHash, more)
+	if s.Commits[entryHash.Fixed()] != more {
+		t.Errorf("A commit paying more didn't replace the pending one")
+	}
+	superseded := s.GetSupersededCommits(entryHash)
+	if len(superseded) != 2 || !superseded[1].IsSameAs(first.GetHash()) {
+		t.Errorf("Expected the commit paying the same, then the first, to be superseded, got %v", superseded)
+	}
+}
+
+func TestCommitReplacementBeforeActivation(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.SetActivationHeight(constants.ACTIVATION_COMMIT_REPLACEMENT, s.NetworkNumber, 10)
+	s.LLeaderHeight = 9
+	entryHash, commit := replacedEntryHash, replacementCommit
+	first := commit(3)
+	s.PutCommit(entryHash, first)
+
+	// A leader acks every commit, and one paying the same replaces the pending commit
+	same := commit(3)
+	same.CommitEntry.MilliTime[5] = 1
+	if err := s.CheckCommitReplacement(same); err != nil {
+		t.Errorf("A commit was refused before the activation: %v", err)
+	}
+	s.PutCommit(entryHash, same)
+	if s.Commits[entryHash.Fixed()] != same {
+		t.Errorf("A commit paying the same didn't replace the pending one before the activation")
+	}
+
+	// One paying less doesn't
+	less := commit(2)
+	s.PutCommit(entryHash, less)
+	if s.Commits[entryHash.Fixed()] != same {
+		t.Errorf("A commit paying less replaced the pending one before the activation")
+	}
+
+	// A commit chain replaces a commit entry, whatever it pays
+	chain := new(messages.CommitChainMsg)
+	chain.CommitChain = entryCreditBlock.NewCommitChain()
+	chain.CommitChain.EntryHash = entryHash
+	chain.CommitChain.Credits = 11
+	s.PutCommit(entryHash, chain)
+	if s.Commits[entryHash.Fixed()] != chain {
+		t.Errorf("A commit chain didn't replace the pending commit entry before the activation")
+	}
+}
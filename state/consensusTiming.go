@@ -0,0 +1,48 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+// How long we wait on the other leaders is a network parameter, like the block timestamp rules,
+// so a custom network with more, or less, latency than ours can set its own:
+//
+//   - AckTimeout: how long a VM can be quiet before we ask for its next message.
+//   - ResendInterval: how often a message we hold, waiting to validate it, is sent again.
+//   - EOMWait: how much longer than other missing messages we wait on a missing EOM before asking
+//     for it.
+//
+// A value below zero, or zero for the first two, is taken to mean the default.
+
+// networkTiming returns the value of a timing parameter for our network.
+func (s *State) networkTiming(main int, test int, local int, def int, unit time.Duration) time.Duration {
+	v := local
+	switch s.NetworkNumber {
+	case constants.NETWORK_MAIN:
+		v = main
+	case constants.NETWORK_TEST:
+		v = test
+	}
+	if v < 0 || (v == 0 && def != 0) {
+		v = def
+	}
+	return time.Duration(v) * unit
+}
+
+func (s *State) GetAckTimeout() time.Duration {
+	return s.networkTiming(s.MainAckTimeout, s.TestAckTimeout, s.LocalAckTimeout, constants.ACK_TIMEOUT, time.Second)
+}
+
+func (s *State) GetResendInterval() time.Duration {
+	return s.networkTiming(s.MainResendInterval, s.TestResendInterval, s.LocalResendInterval, constants.RESEND_INTERVAL, time.Second)
+}
+
+func (s *State) GetEOMWait() time.Duration {
+	return s.networkTiming(s.MainEOMWait, s.TestEOMWait, s.LocalEOMWait, constants.EOM_WAIT, time.Millisecond)
+}
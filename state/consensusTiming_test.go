@@ -0,0 +1,37 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestConsensusTiming(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.NetworkNumber = constants.NETWORK_LOCAL
+
+	s.LocalAckTimeout = 5
+	s.MainAckTimeout = 60
+	s.LocalResendInterval = 0
+	s.LocalEOMWait = 250
+	if s.GetAckTimeout() != 5*time.Second {
+		t.Errorf("Expected the local ack timeout, got %v", s.GetAckTimeout())
+	}
+	if s.GetResendInterval() != constants.RESEND_INTERVAL*time.Second {
+		t.Errorf("Expected the default resend interval for 0, got %v", s.GetResendInterval())
+	}
+	if s.GetEOMWait() != 250*time.Millisecond {
+		t.Errorf("Expected the local EOM wait, got %v", s.GetEOMWait())
+	}
+
+	s.NetworkNumber = constants.NETWORK_MAIN
+	if s.GetAckTimeout() != time.Minute {
+		t.Errorf("Expected the main ack timeout, got %v", s.GetAckTimeout())
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/log"
+)
+
+// LogMsg logs, at debug level, something the State did with msg, tagged with the msg's
+// correlation and trace IDs.  Messages without an ID are not logged.
+func (s *State) LogMsg(msg interfaces.IMsg, format string, args ...interface{}) {
+	if !s.logsDebug() {
+		return
+	}
+	s.LogCorrelated(logTag(msg), "%s "+format, append([]interface{}{msg.String()}, args...)...)
+}
+
+// logTag is what msg is logged under: its correlation ID, followed by its trace ID if it came
+// from another node with one, so one grep finds it in the logs of every node.
+func logTag(msg interfaces.IMsg) string {
+	id, trace := msg.GetCorrelationID(), msg.GetTraceID()
+	switch {
+	case trace == "" || trace == id:
+		return id
+	case id == "":
+		return "trace " + trace
+	}
+	return id + " trace " + trace
+}
+
+// LogCorrelated logs, at debug level, something done for the correlation ID id.
+func (s *State) LogCorrelated(id string, format string, args ...interface{}) {
+	if id == "" || !s.logsDebug() {
+		return
+	}
+	s.Logger.Debugf("[%s] "+format, append([]interface{}{id}, args...)...)
+}
+
+func (s *State) logsDebug() bool {
+	return s.Logger != nil && s.Logger.Level() >= log.DebugLvl
+}
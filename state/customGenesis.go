@@ -0,0 +1,176 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A custom network can describe its genesis in a JSON file, named by CustomGenesisFile in
+// factomd.conf, rather than starting from the Local network's genesis:
+//
+//	{
+//	  "BootstrapIdentity": "<identity chain ID>",
+//	  "BootstrapKey": "<public key that signs the first blocks>",
+//	  "Authorities": [{"IdentityChainID": "<chain ID>", "SigningKey": "<public key>"}],
+//	  "ExchangeRate": 1000
+//	}
+//
+// The file is only read while the database is empty, and all of it goes into the genesis blocks:
+// the bootstrap identity and key as the first entry of the admin block, each authority as a
+// federated server with its signing key, and the exchange rate in the factoid block.  From then on
+// the database is the record.  On later boots the bootstrap identity and key are read back out of
+// the genesis admin block, so the file can be changed or removed without effect.
+//
+// Every node of the network must boot from the same file, or their genesis blocks won't match.
+
+type CustomAuthority struct {
+	IdentityChainID string
+	SigningKey      string
+}
+
+type CustomGenesis struct {
+	BootstrapIdentity string
+	BootstrapKey      string
+	Authorities       []CustomAuthority
+	ExchangeRate      uint64 // Factoshis per entry credit; 0 keeps the rate of the Local genesis
+}
+
+// LoadCustomGenesis reads and checks a custom genesis file.
+func LoadCustomGenesis(filename string) (*CustomGenesis, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	g := new(CustomGenesis)
+	err = json.Unmarshal(data, g)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+	err = g.Check()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+	return g, nil
+}
+
+// Check returns an error if any identity or key is missing or isn't 32 bytes of hex.
+func (g *CustomGenesis) Check() error {
+	check := func(name string, value string) error {
+		if _, err := primitives.HexToHash(value); err != nil {
+			return fmt.Errorf("%s %q: %s", name, value, err.Error())
+		}
+		return nil
+	}
+	if err := check("BootstrapIdentity", g.BootstrapIdentity); err != nil {
+		return err
+	}
+	if err := check("BootstrapKey", g.BootstrapKey); err != nil {
+		return err
+	}
+	for i, a := range g.Authorities {
+		if err := check(fmt.Sprintf("Authorities[%d].IdentityChainID", i), a.IdentityChainID); err != nil {
+			return err
+		}
+		if err := check(fmt.Sprintf("Authorities[%d].SigningKey", i), a.SigningKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Blocks returns the genesis blocks of the custom network networkID.  Call Check first.
+func (g *CustomGenesis) Blocks(networkID uint32) (interfaces.IDirectoryBlock, interfaces.IAdminBlock, interfaces.IFBlock, interfaces.IEntryCreditBlock) {
+	dblk := directoryBlock.NewDirectoryBlock(nil)
+	ablk := adminBlock.NewAdminBlock(nil)
+	fblk := factoid.GetGenesisFBlock(networkID)
+	ecblk := entryCreditBlock.NewECBlock()
+
+	id, _ := primitives.HexToHash(g.BootstrapIdentity)
+	key, _ := primitives.HexToHash(g.BootstrapKey)
+	ablk.AddFederatedServerSigningKey(id, key.Fixed())
+	for _, a := range g.Authorities {
+		id, _ := primitives.HexToHash(a.IdentityChainID)
+		key, _ := primitives.HexToHash(a.SigningKey)
+		ablk.AddFedServer(id)
+		ablk.AddFederatedServerSigningKey(id, key.Fixed())
+	}
+
+	if g.ExchangeRate > 0 {
+		fblk.SetExchRate(g.ExchangeRate)
+	}
+
+	dblk.SetABlockHash(ablk)
+	dblk.SetECBlockHash(ecblk)
+	dblk.SetFBlockHash(fblk)
+	dblk.GetHeader().SetNetworkID(networkID)
+
+	dblk.GetHeader().SetTimestamp(primitives.NewTimestampFromMinutes(24018960))
+
+	return dblk, ablk, fblk, ecblk
+}
+
+// bootstrapFromGenesis returns the bootstrap identity and key recorded in a genesis admin block,
+// or nils if it wasn't built from a custom genesis.
+func bootstrapFromGenesis(ablk interfaces.IAdminBlock) (interfaces.IHash, interfaces.IHash) {
+	entries := ablk.GetABEntries()
+	if len(entries) == 0 || entries[0].Type() != constants.TYPE_ADD_FED_SERVER_KEY {
+		return nil, nil
+	}
+	data, err := entries[0].MarshalBinary()
+	if err != nil {
+		return nil, nil
+	}
+	e := new(adminBlock.AddFederatedServerSigningKey)
+	if e.UnmarshalBinary(data) != nil {
+		return nil, nil
+	}
+	return e.IdentityChainID, primitives.NewHash(e.PublicKey[:])
+}
+
+// initCustomGenesis sets up the genesis of a custom network: from the database if it has a
+// genesis block, and from CustomGenesisFile if it doesn't.
+func (s *State) initCustomGenesis() {
+	if s.NetworkNumber != constants.NETWORK_CUSTOM {
+		return
+	}
+
+	ablk, err := s.DB.FetchABlockByHeight(0)
+	if err != nil {
+		s.Logf("error", "Can't read the genesis block to set up the custom network: %v", err)
+		return
+	}
+	if ablk != nil {
+		if id, key := bootstrapFromGenesis(ablk); id != nil {
+			s.CustomBootstrapIdentity = id.String()
+			s.CustomBootstrapKey = key.String()
+		}
+		return
+	}
+
+	if s.CustomGenesisFile == "" {
+		return
+	}
+	g, err := LoadCustomGenesis(s.CustomGenesisFile)
+	if err != nil {
+		panic(fmt.Sprintf("Cannot read the CustomGenesisFile: %s", err.Error()))
+	}
+	s.CustomGenesis = g
+	s.CustomBootstrapIdentity = g.BootstrapIdentity
+	s.CustomBootstrapKey = g.BootstrapKey
+	if g.ExchangeRate > 0 {
+		s.FactoshisPerEC = g.ExchangeRate
+	}
+}
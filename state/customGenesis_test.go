@@ -0,0 +1,106 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+)
+
+const testCustomGenesis = `{
+	"BootstrapIdentity": "38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9",
+	"BootstrapKey": "cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a",
+	"Authorities": [
+		{
+			"IdentityChainID": "888888aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"SigningKey": "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29"
+		}
+	],
+	"ExchangeRate": 5000
+}`
+
+func writeCustomGenesis(t *testing.T, contents string) string {
+	dir, err := ioutil.TempDir("", "customGenesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "genesis.json")
+	if err := ioutil.WriteFile(filename, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestLoadCustomGenesis(t *testing.T) {
+	filename := writeCustomGenesis(t, testCustomGenesis)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	g, err := LoadCustomGenesis(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Authorities) != 1 || g.ExchangeRate != 5000 {
+		t.Errorf("Genesis file was not read correctly: %+v", g)
+	}
+
+	g.Authorities[0].SigningKey = "not hex"
+	if g.Check() == nil {
+		t.Errorf("A bad signing key passed the check")
+	}
+	g.Authorities = nil
+	g.BootstrapKey = ""
+	if g.Check() == nil {
+		t.Errorf("A missing bootstrap key passed the check")
+	}
+
+	if _, err := LoadCustomGenesis(filename + ".missing"); err == nil {
+		t.Errorf("Expected an error for a missing file")
+	}
+}
+
+func TestCustomGenesisBlocks(t *testing.T) {
+	filename := writeCustomGenesis(t, testCustomGenesis)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	g, err := LoadCustomGenesis(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	networkID := uint32(0x12345678)
+	dblk, ablk, fblk, _ := g.Blocks(networkID)
+	again, _, _, _ := g.Blocks(networkID)
+	if !dblk.GetKeyMR().IsSameAs(again.GetKeyMR()) {
+		t.Errorf("The same genesis file built two different genesis blocks")
+	}
+	local, _, _, _ := GenerateGenesisBlocks(networkID)
+	if dblk.GetKeyMR().IsSameAs(local.GetKeyMR()) {
+		t.Errorf("The custom genesis is the same as the default one")
+	}
+
+	if dblk.GetHeader().GetNetworkID() != networkID {
+		t.Errorf("Wrong network ID %x", dblk.GetHeader().GetNetworkID())
+	}
+	if fblk.GetExchRate() != 5000 {
+		t.Errorf("Expected an exchange rate of 5000, have %d", fblk.GetExchRate())
+	}
+
+	// The bootstrap key first, then the authority and its key
+	entries := ablk.GetABEntries()
+	types := []byte{constants.TYPE_ADD_FED_SERVER_KEY, constants.TYPE_ADD_FED_SERVER, constants.TYPE_ADD_FED_SERVER_KEY}
+	if len(entries) != len(types) {
+		t.Fatalf("Expected %d admin block entries, have %d", len(types), len(entries))
+	}
+	for i, e := range entries {
+		if e.Type() != types[i] {
+			t.Errorf("Admin block entry %d is type %d, expected %d", i, e.Type(), types[i])
+		}
+	}
+}
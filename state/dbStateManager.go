@@ -453,6 +453,12 @@ type DBStateList struct {
 	Base          uint32
 	Complete      uint32
 	DBStates      []*DBState
+
+	// Blocks saved but not yet written to the database, see fastCatchup.go
+	batch        []interfaces.Record
+	batchFrom    uint32
+	batchBlocks  int
+	batchStarted time.Time
 }
 
 var _ interfaces.BinaryMarshallable = (*DBStateList)(nil)
@@ -564,6 +570,16 @@ func (dbsl *DBStateList) MarshalBinary() ([]byte, error) {
 }
 
 func (dbsl *DBStateList) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	newData, err = dbsl.unmarshalDBStates(p)
+	if err != nil {
+		return
+	}
+	dbsl.restoreFactomdState()
+	return
+}
+
+// unmarshalDBStates reads the list, without restoring the State from it.
+func (dbsl *DBStateList) unmarshalDBStates(p []byte) (newData []byte, err error) {
 	dbsl.Init()
 	dbsl.DBStates = []*DBState{}
 	newData = p
@@ -624,15 +640,17 @@ func (dbsl *DBStateList) UnmarshalBinaryData(p []byte) (newData []byte, err erro
 	}
 
 	newData = buf.DeepCopyBytes()
+	return
+}
 
+// restoreFactomdState restores the State from the last DBState that carries a SaveState.
+func (dbsl *DBStateList) restoreFactomdState() {
 	for i := len(dbsl.DBStates) - 1; i >= 0; i-- {
 		if dbsl.DBStates[i].SaveStruct != nil {
 			dbsl.DBStates[i].SaveStruct.RestoreFactomdState(dbsl.State)
 			break
 		}
 	}
-
-	return
 }
 
 func (dbsl *DBStateList) UnmarshalBinary(p []byte) error {
@@ -868,7 +886,7 @@ func (list *DBStateList) FixupLinks(p *DBState, d *DBState) (progress bool) {
 	currentAuds := currentPL.AuditServers
 
 	// DB Sigs
-	majority := (len(currentFeds) / 2) + 1
+	majority := list.State.DBStateSigsNeeded(len(currentFeds))
 	lenDBSigs := len(list.State.ProcessLists.Get(currentDBHeight).DBSignatures)
 	if lenDBSigs < majority {
 		//list.State.AddStatus(fmt.Sprintf("FIXUPLINKS: return without processing: lenDBSigs)(%v) < majority(%d)",
@@ -1077,8 +1095,10 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	}
 	// Process the Factoid End of Block
 	fs := list.State.GetFactoidState()
+	rate := list.State.FactoshisPerEC
 	fs.AddTransactionBlock(d.FactoidBlock)
 	fs.AddECBlock(d.EntryCreditBlock)
+	list.State.checkBlockInvariants(dbht, d.FactoidBlock, d.EntryCreditBlock, rate)
 
 	list.State.Balancehash = fs.GetBalanceHash(false)
 
@@ -1100,6 +1120,7 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	// Promote the currently scheduled next FER
 
 	list.State.ProcessRecentFERChainEntries()
+	list.State.ProcessNetworkStatusEntries()
 	// Step my counter of Complete blocks
 	i := d.DirectoryBlock.GetHeader().GetDBHeight() - list.Base
 	if uint32(i) > list.Complete {
@@ -1201,6 +1222,10 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 	}
 
 	if d.Saved {
+		// Blocks still being batched are not in the database yet
+		if list.inBatch(uint32(dbheight)) {
+			return
+		}
 		Havedblk, err := list.State.DB.DoesKeyExist(databaseOverlay.DIRECTORYBLOCK, d.DirectoryBlock.GetKeyMR().Bytes())
 		if err != nil || !Havedblk {
 			panic(fmt.Sprintf("Claimed to be found on %s DBHeight %d Hash %x",
@@ -1211,9 +1236,16 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		return
 	}
 
+	// Below the last checkpoint, we write many blocks at a time.  Otherwise anything held
+	// goes first.
+	fast := list.State.InFastCatchup(uint32(dbheight))
+	if !fast {
+		list.FlushBatch()
+	}
+
 	// Only trim when we are really saving.
 	v := dbheight + int(list.State.IdentityChainID.Bytes()[4])
-	if v%4 == 0 {
+	if v%4 == 0 && !fast {
 		list.State.DB.Trim()
 	}
 
@@ -1236,6 +1268,8 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 
 	allowedEBlocks := make(map[[32]byte]struct{})
 	allowedEntries := make(map[[32]byte]struct{})
+	var savedEBlocks []interfaces.IEntryBlock
+	var anchorEntries []interfaces.IEBEntry
 
 	// Eblocks from DBlock
 	for _, eb := range d.DirectoryBlock.GetEBlockDBEntries() {
@@ -1271,16 +1305,23 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 				if err := list.State.DB.ProcessEBlockMultiBatch(eb, true); err != nil {
 					panic(err.Error())
 				}
+				savedEBlocks = append(savedEBlocks, eb)
 			} else {
 				list.State.Logf("error", "Error saving eblock from dbstate, eblock not allowed")
 			}
 		}
 		for _, e := range d.Entries {
+			if !list.State.FollowsChain(e.GetChainIDHash()) {
+				continue
+			}
 			// If it's in the DBlock
 			if _, ok := allowedEntries[e.GetHash().Fixed()]; ok {
 				if err := list.State.DB.InsertEntryMultiBatch(e); err != nil {
 					panic(err.Error())
 				}
+				if e.GetChainID().String() == databaseOverlay.AnchorBlockID {
+					anchorEntries = append(anchorEntries, e)
+				}
 			} else {
 				list.State.Logf("error", "Error saving entry from dbstate, entry not allowed")
 			}
@@ -1298,12 +1339,21 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 				if err := list.State.DB.ProcessEBlockMultiBatch(eb, true); err != nil {
 					panic(err.Error())
 				}
+				savedEBlocks = append(savedEBlocks, eb)
 
 				for _, e := range eb.GetBody().GetEBEntries() {
+					if !list.State.FollowsChain(eb.GetChainID()) {
+						break
+					}
 					if _, ok := allowedEntries[e.Fixed()]; ok {
-						if err := list.State.DB.InsertEntryMultiBatch(pl.GetNewEntry(e.Fixed())); err != nil {
+						entry := pl.GetNewEntry(e.Fixed())
+						if err := list.State.DB.InsertEntryMultiBatch(entry); err != nil {
 							panic(err.Error())
 						}
+						if eb.GetChainID().String() == databaseOverlay.AnchorBlockID {
+							anchorEntries = append(anchorEntries, entry)
+						}
+						list.State.LogCorrelated(pl.GetEntryCorrelationID(e.Fixed()), "entry %x saved at height %d", e.Bytes()[:6], dbheight)
 					} else {
 						list.State.Logf("error", "Error saving entry from process list, entry not allowed")
 					}
@@ -1323,7 +1373,9 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		panic(err.Error())
 	}
 
-	if err := list.State.DB.ExecuteMultiBatch(); err != nil {
+	if fast {
+		list.addToBatch(list.State.DB.TakeMultiBatch(), uint32(dbheight))
+	} else if err := list.State.DB.ExecuteMultiBatch(); err != nil {
 		panic(err.Error())
 	}
 
@@ -1362,15 +1414,27 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		}
 	}
 
+	list.State.AddBlockChainStats(ComputeChainStats(uint32(dbheight), d.EntryCreditBlock, savedEBlocks))
+	list.State.RecordBlockEvent(uint32(dbheight), TimelineSaved)
+	list.State.saveProcessListLog(uint32(dbheight))
+	list.State.recordAnchors(anchorEntries)
+
 	list.SavedHeight = uint32(dbheight)
 	progress = true
 	d.ReadyToSave = false
 	d.Saved = true
+
+	err := list.State.StateSaverStruct.SaveSnapshot(list, d, list.State.Network)
+	if err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("%20s Error saving snapshot at Directory Block Height %d: %s\n", list.State.FactomNodeName, dbheight, err.Error()))
+	}
+	list.State.PruneFactoidBlocks()
 	return
 }
 
 func (list *DBStateList) UpdateState() (progress bool) {
 	list.Catchup(false)
+	list.flushStaleBatch()
 
 	saved := 0
 	for i, d := range list.DBStates {
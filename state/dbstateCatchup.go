@@ -15,6 +15,12 @@ func (list *DBStateList) Catchup(justDoIt bool) {
 	if list.State.GetHighestKnownBlock() > uint32(hk+2) {
 		hk = int(list.State.GetHighestKnownBlock())
 	}
+	if list.State.HeaderSync {
+		list.State.askForHeaders()
+		if hh := int(list.State.GetHighestHeader()); hh > hk {
+			hk = hh
+		}
+	}
 
 	begin := hs + 1
 	end := hk
@@ -0,0 +1,91 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// While we catch up, DBStates from our peers spend most of their time in signature checks and
+// merkle roots, none of which need the State.  They are PreValidated across a pool of workers,
+// then handed to the InMsgQueue in the order they came in, unless the workers fall so far behind
+// that one has to skip the queue.  The DBStates are still applied one at a time, in height order,
+// by FollowerExecuteDBState.
+
+type dbstateJob struct {
+	msg  *messages.DBStateMsg
+	done chan bool
+}
+
+type DBStatePreValidator struct {
+	State   *State
+	Workers int
+
+	work    chan *dbstateJob
+	ordered chan *dbstateJob
+}
+
+// StartDBStatePreValidator starts the workers.  With none started, DBStates go straight to
+// the InMsgQueue.
+func (s *State) StartDBStatePreValidator(workers int) {
+	if workers < 1 {
+		return
+	}
+	p := new(DBStatePreValidator)
+	p.State = s
+	p.Workers = workers
+	p.work = make(chan *dbstateJob, workers*4)
+	p.ordered = make(chan *dbstateJob, workers*4)
+
+	for i := 0; i < workers; i++ {
+		s.Supervise(fmt.Sprintf("dbstate prevalidator %d", i), p.worker)
+	}
+	s.Supervise("dbstate prevalidator forward", p.forward)
+	s.dbstatePreValidator = p
+}
+
+// EnqueueDBState hands a DBState from the network to the State, PreValidating it first if
+// we have the workers to do so.  Never waits on the workers: if they are behind, the DBState is
+// PreValidated here instead, so the network goroutine calling us isn't held up.
+func (s *State) EnqueueDBState(msg *messages.DBStateMsg) {
+	p := s.dbstatePreValidator
+	if p == nil {
+		s.InMsgQueue().Enqueue(msg)
+		return
+	}
+	job := &dbstateJob{msg, make(chan bool, 1)}
+	select {
+	case p.ordered <- job:
+	default:
+		// The DBStates ahead of it are still to be forwarded; FollowerExecuteDBState sorts
+		// out the order.
+		msg.PreValidate()
+		s.InMsgQueue().Enqueue(msg)
+		return
+	}
+	select {
+	case p.work <- job:
+	default:
+		// Already in order, so it only has to be done before forward gets to it
+		msg.PreValidate()
+		job.done <- true
+	}
+}
+
+func (p *DBStatePreValidator) worker() {
+	for job := range p.work {
+		job.msg.PreValidate()
+		job.done <- true
+	}
+}
+
+func (p *DBStatePreValidator) forward() {
+	for job := range p.ordered {
+		<-job.done
+		p.State.InMsgQueue().Enqueue(job.msg)
+	}
+}
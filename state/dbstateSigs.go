@@ -0,0 +1,61 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// A DBState from the network is taken as the next block if it is signed by more than a threshold
+// percent of the federated servers, a majority by default.  The threshold is a network parameter,
+// like the block timestamp rules: a node with a different one than its network's will accept
+// blocks the others don't, or stall on blocks they accept.  The signatures counted on the last
+// DBState are kept for the debug API, to show how near it came.
+
+// GetDBStateSigThreshold returns the percent of the federated servers a DBState needs signatures
+// from more than, for our network.
+func (s *State) GetDBStateSigThreshold() int {
+	threshold := s.LocalDBStateSigThreshold
+	switch s.NetworkNumber {
+	case constants.NETWORK_MAIN:
+		threshold = s.MainDBStateSigThreshold
+	case constants.NETWORK_TEST:
+		threshold = s.TestDBStateSigThreshold
+	}
+	if threshold < 0 {
+		return 0
+	}
+	if threshold > 100 {
+		return 100
+	}
+	return threshold
+}
+
+// DBStateSigsNeeded returns the number of signatures a DBState needs out of fedCount federated
+// servers: more than the threshold, and never more than all of them.
+func (s *State) DBStateSigsNeeded(fedCount int) int {
+	needed := fedCount*s.GetDBStateSigThreshold()/100 + 1
+	if needed > fedCount && fedCount > 0 {
+		return fedCount
+	}
+	return needed
+}
+
+func (s *State) SetDBStateSigTally(tally interfaces.DBStateSigTally) {
+	s.dbstateSigsMutex.Lock()
+	defer s.dbstateSigsMutex.Unlock()
+	s.dbstateSigs = tally
+}
+
+// GetDBStateSigTally returns the signatures counted on the last DBState checked, and the
+// current threshold.
+func (s *State) GetDBStateSigTally() interfaces.DBStateSigTally {
+	s.dbstateSigsMutex.Lock()
+	defer s.dbstateSigsMutex.Unlock()
+	tally := s.dbstateSigs
+	tally.Threshold = s.GetDBStateSigThreshold()
+	return tally
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestDBStateSigsNeeded(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	tests := []struct {
+		threshold, feds, needed int
+	}{
+		{50, 1, 1},
+		{50, 2, 2},
+		{50, 3, 2},
+		{50, 4, 3},
+		{50, 5, 3},
+		{66, 3, 2},
+		{66, 6, 4},
+		{0, 5, 1},
+		{100, 5, 5},
+		{150, 5, 5},
+	}
+	for _, test := range tests {
+		s.LocalDBStateSigThreshold = test.threshold
+		if needed := s.DBStateSigsNeeded(test.feds); needed != test.needed {
+			t.Errorf("With a threshold of %d%%, %d feds need %d signatures, expected %d", test.threshold, test.feds, needed, test.needed)
+		}
+	}
+
+	s.LocalDBStateSigThreshold = 66
+	s.SetDBStateSigTally(interfaces.DBStateSigTally{DBHeight: 10, Signatures: 3, Needed: 4, FedServers: 6})
+	if tally := s.GetDBStateSigTally(); tally.Signatures != 3 || tally.Needed != 4 || tally.Threshold != 66 {
+		t.Errorf("Got tally %+v", tally)
+	}
+}
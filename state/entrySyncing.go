@@ -7,6 +7,7 @@ package state
 import (
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/FactomProject/factomd/common/constants"
@@ -15,10 +16,78 @@ import (
 	"github.com/FactomProject/factomd/database/databaseOverlay"
 )
 
+// The entry sync is a second pass behind the blocks: it scans the entry blocks we have saved for
+// entries we don't have, and asks our peers for them.  On a slow disk its reads compete with
+// consensus for the database, so EntrySyncReadRate limits the reads it makes a second, and
+// EntrySyncRequestRate the requests it sends a second.  How it is going is kept for the debug
+// API's "entry-sync" and the state dump.
+
+// A rateLimiter spaces out operations, to at most rate a second.
+type rateLimiter struct {
+	mutex sync.Mutex
+	next  time.Time
+}
+
+// wait waits for the next operation's turn, and returns how long it waited.  A rate of 0 or
+// less doesn't limit.
+func (l *rateLimiter) wait(rate int) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	l.mutex.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(time.Second / time.Duration(rate))
+	l.mutex.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+	return wait
+}
+
+// entrySyncRead waits for a database read's turn.
+func (s *State) entrySyncRead() {
+	if wait := s.entrySyncReads.wait(s.EntrySyncReadRate); wait > 0 {
+		s.updateEntrySync(func(p *interfaces.EntrySyncProgress) { p.Throttled += int64(wait / time.Millisecond) })
+	}
+}
+
+// entrySyncRequest waits for a request's turn.
+func (s *State) entrySyncRequest() {
+	wait := s.entrySyncRequests.wait(s.EntrySyncRequestRate)
+	s.updateEntrySync(func(p *interfaces.EntrySyncProgress) {
+		p.Requests++
+		p.Throttled += int64(wait / time.Millisecond)
+	})
+}
+
+func (s *State) updateEntrySync(update func(p *interfaces.EntrySyncProgress)) {
+	s.entrySyncMutex.Lock()
+	defer s.entrySyncMutex.Unlock()
+	update(&s.entrySync)
+}
+
+// GetEntrySyncProgress returns how the entry sync is going.
+func (s *State) GetEntrySyncProgress() interfaces.EntrySyncProgress {
+	s.entrySyncMutex.Lock()
+	defer s.entrySyncMutex.Unlock()
+	p := s.entrySync
+	p.Complete = s.EntryDBHeightComplete
+	p.Highest = s.GetHighestSavedBlk()
+	p.RequestRate = s.EntrySyncRequestRate
+	p.ReadRate = s.EntrySyncReadRate
+	return p
+}
+
 func has(s *State, entry interfaces.IHash) bool {
 	if s.GetHighestKnownBlock()-s.GetHighestSavedBlk() > 100 {
 		time.Sleep(30 * time.Millisecond)
 	}
+	s.entrySyncRead()
 	exists, _ := s.DB.DoesKeyExist(databaseOverlay.ENTRY, entry.Bytes())
 	return exists
 }
@@ -67,6 +136,10 @@ func (s *State) MakeMissingEntryRequests() {
 		ESFound.Set(float64(found))
 		ESAvgRequests.Set(float64(avg) / 1000)
 		ESHighestAsking.Set(float64(highest))
+		s.updateEntrySync(func(p *interfaces.EntrySyncProgress) {
+			p.Asking = cnt
+			p.Found = found
+		})
 
 		// Keep our map of entries that we are asking for filled up.
 	fillMap:
@@ -94,6 +167,7 @@ func (s *State) MakeMissingEntryRequests() {
 				}
 				if now.Unix()-et.LastTime.Unix() > 5 && sent < 100 {
 					sent++
+					s.entrySyncRequest()
 					entryRequest := messages.NewMissingData(s, et.EntryHash)
 					entryRequest.SendOut(s, entryRequest)
 					newrequest++
@@ -117,6 +191,7 @@ func (s *State) MakeMissingEntryRequests() {
 				asked := MissingEntryMap[entry.GetHash().Fixed()] != nil
 
 				if asked {
+					s.entrySyncRead()
 					s.DB.StartMultiBatch()
 					err := s.DB.InsertEntryMultiBatch(entry)
 					if err != nil {
@@ -146,7 +221,7 @@ func (s *State) MakeMissingEntryRequests() {
 }
 
 func (s *State) GoSyncEntries() {
-	go s.MakeMissingEntryRequests()
+	s.Supervise("missing entry requests", s.MakeMissingEntryRequests)
 
 	// Map to track what I know is missing
 	missingMap := make(map[[32]byte]interfaces.IHash)
@@ -167,6 +242,7 @@ func (s *State) GoSyncEntries() {
 		ESDBHTComplete.Set(float64(s.EntryDBHeightComplete))
 		ESFirstMissing.Set(float64(lastfirstmissing))
 		ESHighestMissing.Set(float64(s.GetHighestSavedBlk()))
+		s.updateEntrySync(func(p *interfaces.EntrySyncProgress) { p.Missing = len(missingMap) })
 
 		entryMissing = 0
 
@@ -192,6 +268,8 @@ func (s *State) GoSyncEntries() {
 				}
 			}
 
+			s.updateEntrySync(func(p *interfaces.EntrySyncProgress) { p.Scanning = scan })
+			s.entrySyncRead()
 			db := s.GetDirectoryBlockByHeight(scan)
 
 			// Wait for the database if we have to
@@ -205,6 +283,7 @@ func (s *State) GoSyncEntries() {
 				// definition.  If we decide to not have Factoid blocks or Entry Credit blocks in some cases,
 				// then this assumption might not hold.  But it does for now.
 
+				s.entrySyncRead()
 				eBlock, _ := s.DB.FetchEBlock(ebKeyMR)
 
 				// Dont have an eBlock?  Huh. We can go on, but we can't advance.  We just wait until it
@@ -214,6 +293,8 @@ func (s *State) GoSyncEntries() {
 					eBlock, _ = s.DB.FetchEBlock(ebKeyMR)
 				}
 
+				followed := s.FollowsChain(eBlock.GetChainID())
+
 				// Go through all the entry hashes.
 				for _, entryhash := range eBlock.GetEntryHashes() {
 					if entryhash.IsMinuteMarker() {
@@ -228,6 +309,11 @@ func (s *State) GoSyncEntries() {
 						s.UpdateEntryHash <- ueh
 					}
 
+					// Entries of chains we don't follow are never asked for.
+					if !followed {
+						continue
+					}
+
 					// If I have the entry, then remove it from the Missing Entries list.
 					if has(s, entryhash) {
 						delete(missingMap, entryhash.Fixed())
@@ -0,0 +1,111 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// A commit pays for its entry as soon as it is processed, and waits in Commits for its reveal.
+// If the reveal hasn't come by the time the commit leaves the replay window it never will, so the
+// commit is dropped, and the credits it paid are burned.  Users who lost credits that way can look
+// up their key in the report kept here, through the burned-credits API call.
+
+// Most keys the burned credits report is kept for; those with the oldest expiries go first
+var MaxBurnedCreditKeys = 10000
+
+// ExpireCommits drops the commits in Commits that have left the replay window without a reveal,
+// and adds the credits they paid to the burned credits report.
+func (s *State) ExpireCommits() {
+	now := s.GetTimestamp()
+	for k, v := range s.Commits {
+		if v == nil {
+			continue
+		}
+		if _, ok := s.Replay.Valid(constants.TIME_TEST, v.GetRepeatHash().Fixed(), v.GetTimestamp(), now); ok {
+			continue
+		}
+		delete(s.Commits, k)
+		ExpiredCommits.Inc()
+		s.burnCredits(v, now)
+	}
+}
+
+func (s *State) burnCredits(commit interfaces.IMsg, now interfaces.Timestamp) {
+	var key [32]byte
+	var credits int64
+	var entryHash interfaces.IHash
+	switch c := commit.(type) {
+	case *messages.CommitEntryMsg:
+		key, credits, entryHash = c.CommitEntry.ECPubKey.Fixed(), int64(c.CommitEntry.Credits), c.CommitEntry.EntryHash
+	case *messages.CommitChainMsg:
+		key, credits, entryHash = c.CommitChain.ECPubKey.Fixed(), int64(c.CommitChain.Credits), c.CommitChain.EntryHash
+	default:
+		return
+	}
+	BurnedCredits.Add(float64(credits))
+	s.Logf("debug", "Commit of entry %x expired unrevealed, burning %d entry credits", entryHash.Bytes()[:4], credits)
+
+	s.burnedMutex.Lock()
+	defer s.burnedMutex.Unlock()
+
+	if s.burned == nil {
+		s.burned = make(map[[32]byte]*interfaces.BurnedCredits)
+	}
+	b := s.burned[key]
+	if b == nil {
+		if len(s.burned) >= MaxBurnedCreditKeys {
+			s.forgetOldestBurn()
+		}
+		b = &interfaces.BurnedCredits{ECPubKey: hex.EncodeToString(key[:])}
+		s.burned[key] = b
+	}
+	b.Commits++
+	b.Credits += credits
+	b.LastEntryHash = entryHash.String()
+	b.LastExpired = now.GetTimeMilli()
+}
+
+func (s *State) forgetOldestBurn() {
+	var oldest [32]byte
+	first := true
+	for k, b := range s.burned {
+		if first || b.LastExpired < s.burned[oldest].LastExpired {
+			oldest, first = k, false
+		}
+	}
+	delete(s.burned, oldest)
+}
+
+type burnedByCredits []interfaces.BurnedCredits
+
+func (b burnedByCredits) Len() int           { return len(b) }
+func (b burnedByCredits) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b burnedByCredits) Less(i, j int) bool { return b[i].Credits > b[j].Credits }
+
+// GetBurnedCredits returns the credits burned by expired commits, by the key that paid them, most
+// credits first.  With a key, just the credits it burned, if any.
+func (s *State) GetBurnedCredits(ecPubKey interfaces.IHash) []interfaces.BurnedCredits {
+	s.burnedMutex.Lock()
+	defer s.burnedMutex.Unlock()
+
+	if ecPubKey != nil {
+		if b := s.burned[ecPubKey.Fixed()]; b != nil {
+			return []interfaces.BurnedCredits{*b}
+		}
+		return nil
+	}
+	list := make([]interfaces.BurnedCredits, 0, len(s.burned))
+	for _, b := range s.burned {
+		list = append(list, *b)
+	}
+	sort.Sort(burnedByCredits(list))
+	return list
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestExpireCommits(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	key := primitives.Sha([]byte("key"))
+	pubKey := primitives.ByteSlice32(key.Fixed())
+
+	commit := func(entry string, millis uint64) *messages.CommitEntryMsg {
+		m := messages.NewCommitEntryMsg()
+		m.CommitEntry = entryCreditBlock.NewCommitEntry()
+		m.CommitEntry.EntryHash = primitives.Sha([]byte(entry))
+		m.CommitEntry.ECPubKey = &pubKey
+		m.CommitEntry.Credits = 3
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], millis)
+		copy(m.CommitEntry.MilliTime[:], b[2:])
+		s.PutCommit(m.CommitEntry.EntryHash, m)
+		return m
+	}
+	commit("old", 1000)
+	commit("older", 0)
+	fresh := commit("fresh", uint64(s.GetTimestamp().GetTimeMilli()))
+
+	s.ExpireCommits()
+	if len(s.Commits) != 1 || s.NextCommit(fresh.CommitEntry.EntryHash) == nil {
+		t.Errorf("Expected only the fresh commit to be left, %d are", len(s.Commits))
+	}
+
+	burned := s.GetBurnedCredits(key)
+	if len(burned) != 1 {
+		t.Fatalf("Expected the key to have burned credits")
+	}
+	if burned[0].Commits != 2 || burned[0].Credits != 6 {
+		t.Errorf("Expected 2 commits to burn 6 credits, %d burned %d", burned[0].Commits, burned[0].Credits)
+	}
+	if len(s.GetBurnedCredits(nil)) != 1 {
+		t.Errorf("Expected one key in the report")
+	}
+	if s.GetBurnedCredits(primitives.Sha([]byte("other"))) != nil {
+		t.Errorf("A key that burned nothing is in the report")
+	}
+}
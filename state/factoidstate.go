@@ -88,8 +88,8 @@ func GetMapHash(dbheight uint32, bmap map[[32]byte]int64) interfaces.IHash {
 }
 
 func (fs *FactoidState) GetBalanceHash(includeTemp bool) interfaces.IHash {
-	h1 := GetMapHash(fs.DBHeight, fs.State.FactoidBalancesP)
-	h2 := GetMapHash(fs.DBHeight, fs.State.ECBalancesP)
+	h1 := GetMapHash(fs.DBHeight, fs.State.FactoidBalancesP.Copy())
+	h2 := GetMapHash(fs.DBHeight, fs.State.ECBalancesP.Copy())
 	h3 := h1
 	h4 := h2
 	if includeTemp {
@@ -97,12 +97,12 @@ func (fs *FactoidState) GetBalanceHash(includeTemp bool) interfaces.IHash {
 		if pl == nil {
 			return primitives.NewZeroHash()
 		}
-		pl.ECBalancesTMutex.Lock()
-		pl.FactoidBalancesTMutex.Lock()
+		pl.ECBalancesTMutex.RLock()
+		pl.FactoidBalancesTMutex.RLock()
 		h3 = GetMapHash(fs.DBHeight, pl.FactoidBalancesT)
 		h4 = GetMapHash(fs.DBHeight, pl.ECBalancesT)
-		pl.ECBalancesTMutex.Unlock()
-		pl.FactoidBalancesTMutex.Unlock()
+		pl.ECBalancesTMutex.RUnlock()
+		pl.FactoidBalancesTMutex.RUnlock()
 	}
 	var b []byte
 	b = append(b, h1.Bytes()...)
@@ -252,7 +252,7 @@ func (fs *FactoidState) AddTransaction(index int, trans interfaces.ITransaction)
 			incBal := entryCreditBlock.NewIncreaseBalance()
 			v := eo.GetAddress().Fixed()
 			incBal.ECPubKey = (*primitives.ByteSlice32)(&v)
-			incBal.NumEC = eo.GetAmount() / fs.GetCurrentBlock().GetExchRate()
+			incBal.NumEC = factoid.FactoshisToECs(eo.GetAmount(), fs.GetCurrentBlock().GetExchRate())
 			incBal.TXID = trans.GetSigHash()
 			incBal.Index = uint64(index)
 			entries := pl.EntryCreditBlock.GetEntries()
@@ -291,6 +291,10 @@ func (fs *FactoidState) UpdateECTransaction(rt bool, trans interfaces.IECBlockEn
 	case entryCreditBlock.ECIDMinuteNumber:
 		return nil
 
+	case entryCreditBlock.ECIDBalanceIncrease:
+		// Records the EC outputs of a factoid transaction, which UpdateTransaction has credited
+		return nil
+
 	case entryCreditBlock.ECIDChainCommit:
 		t := trans.(*entryCreditBlock.CommitChain)
 		v := fs.State.GetE(rt, t.ECPubKey.Fixed()) - int64(t.Credits)
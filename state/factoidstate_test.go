@@ -53,8 +53,8 @@ func TestBalanceHash(t *testing.T) {
 	fs := new(FactoidState)
 	s.FactoidState = fs
 	fs.State = s
-	s.FactoidBalancesP = map[[32]byte]int64{}
-	s.ECBalancesP = map[[32]byte]int64{}
+	s.FactoidBalancesP = NewBalanceMap()
+	s.ECBalancesP = NewBalanceMap()
 
 	var ec, fct []interfaces.IHash
 	h := primitives.Sha([]byte("testing"))
@@ -75,14 +75,14 @@ func TestBalanceHash(t *testing.T) {
 		t.Errorf("Expected %s but found %s", Expected, hbal.String())
 	}
 
-	x := func(addrArray []interfaces.IHash, balanceArray *map[[32]byte]int64) {
+	x := func(addrArray []interfaces.IHash, balanceArray *BalanceMap) {
 
 		// Add a random address
 		for i := 1; i < 10; i++ {
 			h = primitives.Sha(h.Bytes())
 			adr := h
 			bal := RandBal()
-			(*balanceArray)[adr.Fixed()] = bal
+			balanceArray.Put(adr.Fixed(), bal)
 
 			hbal := fs.GetBalanceHash(false)
 
@@ -90,7 +90,7 @@ func TestBalanceHash(t *testing.T) {
 				t.Errorf("Should not have gotten %s", Expected)
 			}
 
-			delete((*balanceArray), adr.Fixed())
+			balanceArray.Delete(adr.Fixed())
 
 			hbal = fs.GetBalanceHash(false)
 
@@ -103,15 +103,15 @@ func TestBalanceHash(t *testing.T) {
 		for i := 1; i < 10; i++ {
 			indx := rand.Int() % len(addrArray)
 			adr := addrArray[indx].Fixed()
-			bal := (*balanceArray)[adr]
-			delete((*balanceArray), adr)
+			bal, _ := balanceArray.Get(adr)
+			balanceArray.Delete(adr)
 
 			hbal := fs.GetBalanceHash(false)
 
 			if hbal.String() == Expected {
 				t.Errorf("Should not have gotten %s", Expected)
 			}
-			(*balanceArray)[adr] = bal
+			balanceArray.Put(adr, bal)
 
 			hbal = fs.GetBalanceHash(false)
 
@@ -125,15 +125,15 @@ func TestBalanceHash(t *testing.T) {
 			indx := rand.Int() % len(addrArray)
 			adr := addrArray[indx].Fixed()
 
-			bal := (*balanceArray)[adr]
-			(*balanceArray)[adr] = bal ^ RandBit()
+			bal, _ := balanceArray.Get(adr)
+			balanceArray.Put(adr, bal^RandBit())
 
 			hbal := fs.GetBalanceHash(false)
 			if hbal.String() == Expected {
 				t.Errorf("Should not have gotten %s", Expected)
 			}
 
-			(*balanceArray)[adr] = bal
+			balanceArray.Put(adr, bal)
 
 			hbal = fs.GetBalanceHash(false)
 
@@ -145,8 +145,8 @@ func TestBalanceHash(t *testing.T) {
 
 	}
 
-	x(fct, &s.FactoidBalancesP)
-	x(ec, &s.ECBalancesP)
+	x(fct, s.FactoidBalancesP)
+	x(ec, s.ECBalancesP)
 
 }
 
@@ -0,0 +1,68 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// On main net, the blocks up to the last checkpoint can only be the ones the checkpoints lead to,
+// so catching up through them skips the work that only matters at the head of the chain: the
+// blocks are written to the database many at a time instead of in a batch each.  A DBState from
+// a peer still has its signatures checked, as any other, so no one can feed us a block that links
+// to ours but was never signed.  Turn it off with -fastcatchup=false.
+
+const (
+	FastCatchupBatchBlocks = 200         // Most blocks we hold before writing them
+	FastCatchupBatchTime   = time.Second // Longest we hold blocks before writing them
+)
+
+// InFastCatchup is true if the block at dbheight can be applied in bulk.
+func (s *State) InFastCatchup(dbheight uint32) bool {
+	return s.FastCatchup && s.NetworkNumber == constants.NETWORK_MAIN && dbheight > 0 && dbheight <= highestCheckPoint()
+}
+
+// addToBatch holds the records for the block at dbheight until enough blocks are held to be worth
+// a write.
+func (list *DBStateList) addToBatch(records []interfaces.Record, dbheight uint32) {
+	if list.batchBlocks == 0 {
+		list.batchFrom = dbheight
+		list.batchStarted = time.Now()
+	}
+	list.batch = append(list.batch, records...)
+	list.batchBlocks++
+	if list.batchBlocks >= FastCatchupBatchBlocks {
+		list.FlushBatch()
+	}
+}
+
+// inBatch is true if the block at dbheight is saved, but not written to the database yet.
+func (list *DBStateList) inBatch(dbheight uint32) bool {
+	return list.batchBlocks > 0 && dbheight >= list.batchFrom
+}
+
+// FlushBatch writes the blocks we are holding.
+func (list *DBStateList) FlushBatch() {
+	if list.batchBlocks == 0 {
+		return
+	}
+	if err := list.State.DB.PutInBatch(list.batch); err != nil {
+		panic(fmt.Sprintf("%20s Error writing blocks %d to %d: %s", list.State.FactomNodeName, list.batchFrom, list.SavedHeight, err.Error()))
+	}
+	list.State.DB.Trim()
+	list.batch = nil
+	list.batchBlocks = 0
+}
+
+// flushStaleBatch writes the blocks we are holding if we have held them long enough.
+func (list *DBStateList) flushStaleBatch() {
+	if list.batchBlocks > 0 && time.Since(list.batchStarted) >= FastCatchupBatchTime {
+		list.FlushBatch()
+	}
+}
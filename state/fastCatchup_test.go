@@ -0,0 +1,35 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestInFastCatchup(t *testing.T) {
+	s := new(State)
+	s.NetworkNumber = constants.NETWORK_MAIN
+	s.FastCatchup = true
+	if !s.InFastCatchup(10) {
+		t.Error("Expected a main net block below the checkpoints to be in fast catch up")
+	}
+	if s.InFastCatchup(0) || s.InFastCatchup(1<<31) {
+		t.Error("Genesis and blocks past the checkpoints are not in fast catch up")
+	}
+
+	s.FastCatchup = false
+	if s.InFastCatchup(10) {
+		t.Error("Fast catch up is off")
+	}
+
+	s.FastCatchup = true
+	s.NetworkNumber = constants.NETWORK_LOCAL
+	if s.InFastCatchup(10) {
+		t.Error("Only main net has checkpoints")
+	}
+}
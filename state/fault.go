@@ -8,7 +8,6 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand"
-	"time"
 
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -81,7 +80,7 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 		return
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.ClockNow().Unix()
 	vm := pl.VMs[vmIndex]
 
 	if vm.WhenFaulted == 0 {
@@ -150,7 +149,7 @@ func NegotiationCheck(pl *ProcessList) {
 		return
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.ClockNow().Unix()
 	if now-prevVM.WhenFaulted < int64(pl.State.FaultTimeout) {
 		//It hasn't been long enough; wait a little longer
 		//before starting negotiation
@@ -175,7 +174,7 @@ func NegotiationCheck(pl *ProcessList) {
 func FaultCheck(pl *ProcessList) {
 	NegotiationCheck(pl)
 
-	now := time.Now().Unix()
+	now := pl.State.ClockNow().Unix()
 
 	currentFault := pl.CurrentFault()
 	if currentFault.IsNil() {
@@ -325,7 +324,7 @@ func CraftFullFault(pl *ProcessList, vmIndex int, height int) *messages.FullServ
 		prevFF = pl.System.List[pl.System.Height-1].(*messages.FullServerFault)
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.ClockNow().Unix()
 
 	if faultState.IsNil() || (now-faultState.GetTimestamp().GetTimeSeconds() > int64(pl.State.FaultTimeout)) && !(faultState.HasEnoughSigs(pl.State) && faultState.GetPledgeDone()) {
 		sf = CraftFault(pl, vmIndex, height)
@@ -367,7 +366,7 @@ func (s *State) FollowerExecuteSFault(m interfaces.IMsg) {
 		// If no such ProcessList exists, or if we don't consider
 		// the VM in this ServerFault message to be at fault,
 		// do not proceed with regularFaultExecution
-		s.Holding[m.GetMsgHash().Fixed()] = m
+		s.HoldOnSelf(m, HoldUnvalidated)
 		return
 	}
 
@@ -400,7 +399,7 @@ func (s *State) FollowerExecuteSFault(m interfaces.IMsg) {
 	if auth == nil {
 		isPledge = false
 	} else {
-		valid, err := auth.VerifySignature(lbytes, sfSig)
+		valid, err := s.VerifyAuthority(auth, lbytes, sfSig)
 		if err == nil && valid {
 			isPledge = true
 			currentFault.SetPledgeDone(true)
@@ -461,7 +460,7 @@ func (s *State) FollowerExecuteFullFault(m interfaces.IMsg) {
 	pl := s.ProcessLists.Get(fullFault.DBHeight)
 
 	if pl == nil {
-		s.Holding[m.GetMsgHash().Fixed()] = m
+		s.HoldForHeight(m, fullFault.DBHeight)
 		return
 	}
 
@@ -488,7 +487,7 @@ func (s *State) pledgedByAudit(fullFault *messages.FullServerFault) bool {
 			marshalledSF, err := fullFault.MarshalForSF()
 			if err == nil {
 				for _, sig := range fullFault.SignatureList.List {
-					sigVer, err := a.VerifySignature(marshalledSF, sig.GetSignature())
+					sigVer, err := s.VerifyAuthority(a, marshalledSF, sig.GetSignature())
 					if err == nil && sigVer {
 						return true
 					}
@@ -0,0 +1,115 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/fer"
+	"github.com/FactomProject/factomd/util"
+)
+
+// A node configured with price sources and the exchange rate authority's private key runs the
+// FER oracle (see fer/oracle.go).  Once a block, if it is a federated or audit server, it asks
+// the oracle for a new rate and publishes the FER entry on the exchange rate chain, paid for by
+// the configured entry credit key.
+
+// configureFEROracle sets up the oracle from the configuration.  Left off, with a warning, if the
+// configuration is incomplete or the key isn't the authority's.
+func (s *State) configureFEROracle(cfg *util.FactomdConfig) {
+	s.FEROracle = nil
+	if cfg.App.ExchangeRateSources == "" {
+		return
+	}
+	warn := func(why string) {
+		os.Stderr.WriteString(fmt.Sprintf("FER oracle is off: %s\n", why))
+	}
+
+	sources, err := fer.ParseSources(cfg.App.ExchangeRateSources)
+	if err != nil {
+		warn(err.Error())
+		return
+	}
+	authority, err := primitives.NewPrivateKeyFromHex(cfg.App.ExchangeRateAuthorityPrivateKey)
+	if err != nil {
+		warn("ExchangeRateAuthorityPrivateKey is not a private key")
+		return
+	}
+	if authority.Pub.String() != s.ExchangeRateAuthorityPublicKey {
+		warn("ExchangeRateAuthorityPrivateKey is not the key of the exchange rate authority")
+		return
+	}
+	ecKey, err := primitives.NewPrivateKeyFromHex(cfg.App.ExchangeRateECPrivateKey)
+	if err != nil {
+		warn("ExchangeRateECPrivateKey is not a private key")
+		return
+	}
+
+	o := fer.NewOracle(sources)
+	o.MinRate = cfg.App.ExchangeRateMinimum
+	o.MaxRate = cfg.App.ExchangeRateMaximum
+	o.MaxChange = float64(cfg.App.ExchangeRateMaxChangePercent) / 100
+	s.FEROracle = o
+	s.ferAuthorityKey = authority
+	s.ferECKey = ecKey
+}
+
+// RunFEROracle steps the oracle as each block is saved.
+func (s *State) RunFEROracle() {
+	last := uint32(0)
+	for !s.IsShuttingDown() {
+		time.Sleep(time.Second)
+		height := s.GetHighestSavedBlk()
+		if height == last {
+			continue
+		}
+		last = height
+		if err := s.StepFEROracle(height); err != nil {
+			s.AddStatus("FER oracle: " + err.Error())
+		}
+	}
+}
+
+// StepFEROracle publishes an FER entry if the oracle proposes one at this height.
+func (s *State) StepFEROracle(height uint32) error {
+	if s.FEROracle == nil || !s.DBFinished || s.IsStandby() {
+		return nil
+	}
+	if !s.IsAuthority(s.LLeaderHeight) {
+		return nil
+	}
+
+	fe, _, err := s.FEROracle.Propose(s.GetFactoshisPerEC(), s.FERPriority, height)
+	if err != nil || fe == nil {
+		return err
+	}
+	chainID, err := primitives.HexToHash(s.FERChainId)
+	if err != nil {
+		return err
+	}
+	entry, err := fer.NewEntry(chainID, fe, s.ferAuthorityKey)
+	if err != nil {
+		return err
+	}
+	commit, err := fer.NewCommit(entry, s.ferECKey, time.Now().UnixNano()/1e6)
+	if err != nil {
+		return err
+	}
+
+	cmsg := new(messages.CommitEntryMsg)
+	cmsg.CommitEntry = commit
+	s.APIQueue() <- cmsg
+	rmsg := new(messages.RevealEntryMsg)
+	rmsg.Entry = entry
+	rmsg.Timestamp = s.GetTimestamp()
+	s.APIQueue() <- rmsg
+
+	s.AddStatus(fmt.Sprintf("FER oracle: proposed %d factoshis per EC at height %d, priority %d", fe.TargetPrice, fe.TargetActivationHeight, fe.Priority))
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A follower with FollowChains set only keeps the entries of those chains.  It still takes every
+// directory, admin, factoid, entry credit and entry block, and validates them and their signatures
+// as any follower does, so it knows the chains it follows are the network's.  But it never asks its
+// peers for the other entries, and drops the ones that come to it with a block or in a process list.
+//
+// Identity chains, the exchange rate chain and the network status chain are always followed, as
+// the state is built from their entries.  A node that doesn't keep every entry can't give peers
+// the entries they are missing, so this is for followers serving an application, not for
+// authority servers.
+
+type ChainSet map[[32]byte]bool
+
+// ParseChainIDs parses a comma separated list of chain IDs in hex.
+func ParseChainIDs(list string) (ChainSet, error) {
+	chains := make(ChainSet)
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		chainID, err := primitives.HexToHash(s)
+		if err != nil {
+			return nil, fmt.Errorf("Bad chain ID %q: %s", s, err.Error())
+		}
+		chains[chainID.Fixed()] = true
+	}
+	if len(chains) == 0 {
+		return nil, fmt.Errorf("No chain IDs in %q", list)
+	}
+	return chains, nil
+}
+
+// FollowsChain returns true if we keep the entries of chainID.
+func (s *State) FollowsChain(chainID interfaces.IHash) bool {
+	if s.FollowChains == nil {
+		return true
+	}
+	if s.FollowChains[chainID.Fixed()] {
+		return true
+	}
+	if bytes.Equal(chainID.Bytes()[:3], []byte{0x88, 0x88, 0x88}) {
+		return true
+	}
+	if chainID.IsSameAs(networkStatusChainID) {
+		return true
+	}
+	return chainID.String() == s.FERChainId
+}
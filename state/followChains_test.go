@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestParseChainIDs(t *testing.T) {
+	a := "a642a8674f46696cc47fdb6b65f9c87b2a19c5ea8123b3d2f0c13b6f33a9d5ef"
+	b := "df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604"
+
+	chains, err := ParseChainIDs(a + ", " + b + ",")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chains) != 2 {
+		t.Errorf("Expected 2 chains, have %d", len(chains))
+	}
+
+	for _, bad := range []string{"", " , ", a + ",nothex", a[:10]} {
+		if _, err := ParseChainIDs(bad); err == nil {
+			t.Errorf("Expected an error for %q", bad)
+		}
+	}
+}
+
+func TestFollowsChain(t *testing.T) {
+	s := new(State)
+	s.FERChainId = "111111118d918a8be684e0dac725493a75862ef96d2d3f43f84b26969329bf03"
+
+	followed, _ := primitives.HexToHash("a642a8674f46696cc47fdb6b65f9c87b2a19c5ea8123b3d2f0c13b6f33a9d5ef")
+	other, _ := primitives.HexToHash("df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604")
+	identity, _ := primitives.HexToHash("888888d027c59579fc47a6fc6c4a5c0409c7c39bc38a86cb5fc0069978493762")
+	fer, _ := primitives.HexToHash(s.FERChainId)
+
+	if !s.FollowsChain(other) {
+		t.Errorf("With no FollowChains every chain should be followed")
+	}
+
+	s.FollowChains = ChainSet{followed.Fixed(): true}
+	if !s.FollowsChain(followed) {
+		t.Errorf("A chain in FollowChains is not followed")
+	}
+	if s.FollowsChain(other) {
+		t.Errorf("A chain not in FollowChains is followed")
+	}
+	if !s.FollowsChain(identity) {
+		t.Errorf("Identity chains must always be followed")
+	}
+	if !s.FollowsChain(fer) {
+		t.Errorf("The exchange rate chain must always be followed")
+	}
+}
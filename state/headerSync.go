@@ -0,0 +1,192 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// With header sync on, a node asks its peers for the directory block headers past its highest
+// saved block, and chains them back to that block by their KeyMRs.  A header is only about 100
+// bytes, so a node that starts far behind learns the height of the network, and can answer
+// questions about the headers, long before it has the blocks.  Catchup then asks for the blocks
+// up to the highest header, and each block saved replaces its header.
+//
+// Headers are not signed, so until the blocks come the chain is only as good as the peers that
+// sent it (and the checkpoints, on main net).  It is never used to validate anything, and it does
+// not raise HighestKnown.  If a block we save does not match the header at its height, we throw
+// the headers away and start again from the block.
+
+const (
+	HeaderSyncAskTime  = 2 * time.Second  // How often we ask for more headers while they are coming
+	HeaderSyncIdleTime = 30 * time.Second // How often we ask once a peer had no more to send
+)
+
+// HeaderChain holds the headers past our highest saved block, in height order.
+type HeaderChain struct {
+	Base    uint32 // Height of Headers[0]
+	Headers []interfaces.IDirectoryBlockHeader
+	KeyMRs  []interfaces.IHash
+	NextAsk time.Time
+	mutex   sync.Mutex
+}
+
+func (c *HeaderChain) top() uint32 {
+	return c.Base + uint32(len(c.Headers)) - 1
+}
+
+func (c *HeaderChain) reset(base uint32) {
+	c.Base = base
+	c.Headers = nil
+	c.KeyMRs = nil
+}
+
+// trimHeaders drops the headers we have saved blocks for.  If one of those blocks is not the block the
+// header was for, the headers past it can't be right either, and they all go.  Call with the
+// mutex locked.
+func (s *State) trimHeaders() {
+	c := &s.DBlockHeaders
+	saved := s.GetHighestSavedBlk()
+	for len(c.Headers) > 0 && c.Base <= saved {
+		dblk := s.GetDirectoryBlockByHeight(c.Base)
+		if dblk == nil {
+			return // Saved, but not where we can see it yet
+		}
+		if !dblk.GetKeyMR().IsSameAs(c.KeyMRs[0]) {
+			s.AddStatus(fmt.Sprintf("HeaderSync: block %d does not match its header, dropping %d headers", c.Base, len(c.Headers)))
+			c.reset(saved + 1)
+			return
+		}
+		c.Base++
+		c.Headers = c.Headers[1:]
+		c.KeyMRs = c.KeyMRs[1:]
+	}
+	if len(c.Headers) == 0 {
+		c.reset(saved + 1)
+	}
+}
+
+// AddDBlockHeaders adds the headers that extend our chain of headers.  We stop at the first that
+// doesn't; headers we already have are skipped.
+func (s *State) AddDBlockHeaders(headers []interfaces.IDirectoryBlockHeader) {
+	c := &s.DBlockHeaders
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	s.trimHeaders()
+
+	added := 0
+	for _, h := range headers {
+		dbheight := h.GetDBHeight()
+		if dbheight < c.Base {
+			continue
+		}
+		if h.GetNetworkID() != s.GetNetworkID() {
+			return
+		}
+		keyMR, err := directoryBlock.KeyMRFromHeader(h)
+		if err != nil {
+			return
+		}
+		if len(c.Headers) > 0 && dbheight <= c.top() {
+			if !keyMR.IsSameAs(c.KeyMRs[dbheight-c.Base]) {
+				return // A different chain than ours; first come wins until a block says otherwise
+			}
+			continue
+		}
+		if dbheight != c.Base+uint32(len(c.Headers)) {
+			return // A gap
+		}
+
+		var prevKeyMR interfaces.IHash
+		if len(c.Headers) > 0 {
+			prevKeyMR = c.KeyMRs[len(c.KeyMRs)-1]
+		} else if dbheight > 0 {
+			prev := s.GetDirectoryBlockByHeight(dbheight - 1)
+			if prev == nil {
+				return
+			}
+			prevKeyMR = prev.GetKeyMR()
+		}
+		if prevKeyMR != nil && !h.GetPrevKeyMR().IsSameAs(prevKeyMR) {
+			return
+		}
+		if h.GetNetworkID() == constants.MAIN_NETWORK_ID {
+			if key := constants.CheckPoints[dbheight]; key != "" && key != keyMR.String() {
+				s.AddStatus(fmt.Sprintf("HeaderSync: header %d fails its checkpoint", dbheight))
+				return
+			}
+		}
+
+		c.Headers = append(c.Headers, h)
+		c.KeyMRs = append(c.KeyMRs, keyMR)
+		added++
+	}
+
+	// A short answer means the peer had no more; there won't be many more for a while.
+	if len(headers) < messages.MaxDBlockHeaders {
+		c.NextAsk = time.Now().Add(HeaderSyncIdleTime)
+	} else if added > 0 {
+		c.NextAsk = time.Now()
+	}
+}
+
+// askForHeaders asks our peers for the headers past the ones we have, if it is time to.
+func (s *State) askForHeaders() {
+	if !s.HeaderSync || s.IgnoreMissing {
+		return
+	}
+	c := &s.DBlockHeaders
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	s.trimHeaders()
+	if time.Now().Before(c.NextAsk) {
+		return
+	}
+	msg := messages.NewDBlockHeadersRequest(s, c.Base+uint32(len(c.Headers)), messages.MaxDBlockHeaders)
+	msg.SendOut(s, msg)
+	c.NextAsk = time.Now().Add(HeaderSyncAskTime)
+}
+
+// GetHighestHeader returns the height of the highest directory block we have a header for,
+// whether or not we have the block.
+func (s *State) GetHighestHeader() uint32 {
+	c := &s.DBlockHeaders
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	saved := s.GetHighestSavedBlk()
+	if len(c.Headers) > 0 && c.top() > saved {
+		return c.top()
+	}
+	return saved
+}
+
+// GetDBlockHeaderByHeight returns the header of the directory block at dbheight, and its KeyMR,
+// from the block if we have it and from the header chain if not.  Returns nils if we have neither.
+func (s *State) GetDBlockHeaderByHeight(dbheight uint32) (interfaces.IDirectoryBlockHeader, interfaces.IHash) {
+	if dbheight <= s.GetHighestSavedBlk() {
+		if dblk := s.GetDirectoryBlockByHeight(dbheight); dblk != nil {
+			return dblk.GetHeader(), dblk.GetKeyMR()
+		}
+	}
+
+	c := &s.DBlockHeaders
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.Headers) == 0 || dbheight < c.Base || dbheight > c.top() {
+		return nil, nil
+	}
+	return c.Headers[dbheight-c.Base], c.KeyMRs[dbheight-c.Base]
+}
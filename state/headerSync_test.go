@@ -0,0 +1,57 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestHeaderSync(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	saved := s.GetHighestSavedBlk()
+
+	// The same blocks the test database holds, and three more
+	var headers []interfaces.IDirectoryBlockHeader
+	var keyMRs []interfaces.IHash
+	var prev *testHelper.BlockSet
+	for i := 0; i <= int(saved)+3; i++ {
+		prev = testHelper.CreateTestBlockSet(prev)
+		keyMRs = append(keyMRs, prev.DBlock.GetKeyMR())
+		headers = append(headers, prev.DBlock.GetHeader())
+	}
+
+	s.AddDBlockHeaders(headers[saved+2:])
+	if s.GetHighestHeader() != saved {
+		t.Errorf("Headers that don't follow our saved block were added")
+	}
+
+	next := headers[saved+1]
+	prevKeyMR := next.GetPrevKeyMR()
+	next.SetPrevKeyMR(primitives.NewZeroHash())
+	s.AddDBlockHeaders(headers[saved+1:])
+	if s.GetHighestHeader() != saved {
+		t.Errorf("A header that doesn't link to our saved block was added")
+	}
+	next.SetPrevKeyMR(prevKeyMR)
+
+	s.AddDBlockHeaders(headers)
+	if s.GetHighestHeader() != saved+3 {
+		t.Errorf("Expected headers up to %d, have %d", saved+3, s.GetHighestHeader())
+	}
+
+	for _, h := range []uint32{saved, saved + 1, saved + 3} {
+		header, keyMR := s.GetDBlockHeaderByHeight(h)
+		if header == nil || !keyMR.IsSameAs(keyMRs[h]) {
+			t.Errorf("Wrong header at height %d", h)
+		}
+	}
+	if header, _ := s.GetDBlockHeaderByHeight(saved + 4); header != nil {
+		t.Errorf("Found a header we never had")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A commit pays for an entry that may not show up for a while.  The commits waiting on their
+// reveals, and the commits still waiting in Holding, are written to the database on shutdown and
+// put back on boot with their original timestamps, so a restart doesn't drop paid commits.
+type HeldCommits struct {
+	Commits []interfaces.IMsg // Processed, waiting on their reveals
+	Holding []interfaces.IMsg // Not processed yet
+}
+
+var _ interfaces.BinaryMarshallable = (*HeldCommits)(nil)
+
+func pushMsgList(buf *primitives.Buffer, list []interfaces.IMsg) error {
+	err := buf.PushVarInt(uint64(len(list)))
+	if err != nil {
+		return err
+	}
+	for _, m := range list {
+		b, err := m.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		err = buf.PushBytes(b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func popMsgList(buf *primitives.Buffer) ([]interfaces.IMsg, error) {
+	l, err := buf.PopVarInt()
+	if err != nil {
+		return nil, err
+	}
+	list := []interfaces.IMsg{}
+	for i := 0; i < int(l); i++ {
+		b, err := buf.PopBytes()
+		if err != nil {
+			return nil, err
+		}
+		m, err := messages.UnmarshalMessage(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, nil
+}
+
+func (hc *HeldCommits) MarshalBinary() ([]byte, error) {
+	buf := primitives.NewBuffer(nil)
+
+	err := pushMsgList(buf, hc.Commits)
+	if err != nil {
+		return nil, err
+	}
+	err = pushMsgList(buf, hc.Holding)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (hc *HeldCommits) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	buf := primitives.NewBuffer(p)
+
+	hc.Commits, err = popMsgList(buf)
+	if err != nil {
+		return
+	}
+	hc.Holding, err = popMsgList(buf)
+	if err != nil {
+		return
+	}
+
+	newData = buf.DeepCopyBytes()
+	return
+}
+
+func (hc *HeldCommits) UnmarshalBinary(p []byte) error {
+	_, err := hc.UnmarshalBinaryData(p)
+	return err
+}
+
+// Returns the hash of the entry a commit pays for, or nil if this isn't a commit
+func commitEntryHash(m interfaces.IMsg) interfaces.IHash {
+	switch c := m.(type) {
+	case *messages.CommitChainMsg:
+		return c.CommitChain.EntryHash
+	case *messages.CommitEntryMsg:
+		return c.CommitEntry.EntryHash
+	}
+	return nil
+}
+
+// SaveHeldCommits writes the commits in Commits and Holding to the database.
+func (s *State) SaveHeldCommits() error {
+	hc := new(HeldCommits)
+	for _, m := range s.Commits {
+		if m != nil && commitEntryHash(m) != nil {
+			hc.Commits = append(hc.Commits, m)
+		}
+	}
+	for _, m := range s.Holding {
+		if m != nil && commitEntryHash(m) != nil {
+			hc.Holding = append(hc.Holding, m)
+		}
+	}
+	return s.DB.SaveHeldCommits(hc)
+}
+
+// LoadHeldCommits puts back the commits saved by SaveHeldCommits.  Commits that have aged out
+// of the replay window while we were down are dropped.
+func (s *State) LoadHeldCommits() error {
+	held, err := s.DB.FetchHeldCommits(new(HeldCommits))
+	if err != nil || held == nil {
+		return err
+	}
+	hc := held.(*HeldCommits)
+	now := s.GetTimestamp()
+
+	for _, m := range hc.Commits {
+		if _, ok := s.Replay.Valid(constants.TIME_TEST, m.GetRepeatHash().Fixed(), m.GetTimestamp(), now); !ok {
+			continue
+		}
+		s.PutCommit(commitEntryHash(m), m)
+	}
+	for _, m := range hc.Holding {
+		if _, ok := s.Replay.Valid(constants.TIME_TEST, m.GetRepeatHash().Fixed(), m.GetTimestamp(), now); !ok {
+			continue
+		}
+		s.Holding[m.GetMsgHash().Fixed()] = m
+	}
+	return nil
+}
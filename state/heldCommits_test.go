@@ -0,0 +1,63 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestHeldCommitsMarshal(t *testing.T) {
+	ce := new(messages.CommitEntryMsg)
+	ce.CommitEntry = entryCreditBlock.NewCommitEntry()
+	ce.CommitEntry.MilliTime = (*primitives.ByteSlice6)(&[6]byte{1, 1, 1, 1, 1, 1})
+	ce.CommitEntry.EntryHash = primitives.RandomHash()
+	ce.CommitEntry.Credits = 1
+	// Commits are checked against their signatures as they are unmarshalled
+	if err := ce.CommitEntry.Sign(testHelper.NewPrivKey(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	cc := new(messages.CommitChainMsg)
+	cc.CommitChain = entryCreditBlock.NewCommitChain()
+	cc.CommitChain.MilliTime = (*primitives.ByteSlice6)(&[6]byte{1, 1, 1, 1, 1, 2})
+	cc.CommitChain.EntryHash = primitives.RandomHash()
+	cc.CommitChain.Credits = 11
+	if err := cc.CommitChain.Sign(testHelper.NewPrivKey(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	hc := new(HeldCommits)
+	hc.Commits = []interfaces.IMsg{ce}
+	hc.Holding = []interfaces.IMsg{cc}
+
+	b, err := hc.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hc2 := new(HeldCommits)
+	rest, err := hc2.UnmarshalBinaryData(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 {
+		t.Errorf("%v bytes left over", len(rest))
+	}
+	if len(hc2.Commits) != 1 || len(hc2.Holding) != 1 {
+		t.Fatalf("Expected 1 commit and 1 held, got %v and %v", len(hc2.Commits), len(hc2.Holding))
+	}
+	if hc2.Commits[0].GetMsgHash().IsSameAs(ce.GetMsgHash()) == false {
+		t.Error("Commits do not match")
+	}
+	if hc2.Holding[0].GetMsgHash().IsSameAs(cc.GetMsgHash()) == false {
+		t.Error("Held commits do not match")
+	}
+}
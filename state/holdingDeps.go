@@ -0,0 +1,179 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// A message goes into Holding when it can't be processed yet, and is recorded in HoldingDeps under
+// the hash of what it is waiting on: its ack, which is found by its own hash; the commit paying for
+// it, found by its entry hash; or a block we haven't reached, which has no hash, so stands in as
+// HeightKey of its height.  When what a message waits on arrives, ReleaseHeld moves it out of
+// Holding and into XReview to be tried again, without waiting for ReviewHolding to get to it.
+// Messages still go out of Holding in all the old ways, so the graph is pruned of those that have
+// gone as it is snapshotted for the API, which shows why each held message is held.
+
+// Why a message is held
+const (
+	HoldMissingAck    = "missing ack"
+	HoldMissingCommit = "missing commit"
+	HoldFutureHeight  = "future height"
+	HoldUnvalidated   = "can't validate yet"
+	HoldInvalid       = "invalid"
+)
+
+type heldOn struct {
+	dependency [32]byte
+	reason     string
+	since      int64 // Unix milliseconds
+}
+
+// HeightKey is what messages waiting for a block at dbheight are held on.
+func HeightKey(dbheight uint32) [32]byte {
+	var key [32]byte
+	copy(key[:], "height")
+	binary.BigEndian.PutUint32(key[28:], dbheight)
+	return key
+}
+
+// Hold puts msg into Holding, waiting on dependency.
+func (s *State) Hold(msg interfaces.IMsg, dependency [32]byte, reason string) {
+	if s.HoldingDeps == nil {
+		s.HoldingDeps = make(map[[32]byte]map[[32]byte]bool)
+		s.holdingWhy = make(map[[32]byte]heldOn)
+		s.holdingHeights = make(map[uint32]bool)
+	}
+	h := msg.GetMsgHash().Fixed()
+	s.Holding[h] = msg
+
+	if was, ok := s.holdingWhy[h]; ok {
+		if was.dependency == dependency {
+			s.holdingWhy[h] = heldOn{dependency, reason, was.since}
+			return
+		}
+		s.unlinkHeld(h, was.dependency)
+	}
+	s.holdingWhy[h] = heldOn{dependency, reason, time.Now().UnixNano() / 1e6}
+	if s.HoldingDeps[dependency] == nil {
+		s.HoldingDeps[dependency] = make(map[[32]byte]bool)
+	}
+	s.HoldingDeps[dependency][h] = true
+}
+
+// HoldOnSelf puts msg into Holding, waiting on something found by its own hash, such as its ack.
+func (s *State) HoldOnSelf(msg interfaces.IMsg, reason string) {
+	s.Hold(msg, msg.GetMsgHash().Fixed(), reason)
+}
+
+// HoldUntilValid puts a message that doesn't validate yet into Holding, waiting on whatever it is
+// missing, so far as we can tell.
+func (s *State) HoldUntilValid(msg interfaces.IMsg) {
+	var dbheight uint32
+	switch m := msg.(type) {
+	case *messages.RevealEntryMsg:
+		if s.Commits[m.Entry.GetHash().Fixed()] == nil {
+			s.HoldOnSelf(msg, HoldMissingCommit)
+			return
+		}
+	case *messages.Ack:
+		dbheight = m.DBHeight
+	case *messages.EOM:
+		dbheight = m.DBHeight
+	case *messages.DirectoryBlockSignature:
+		dbheight = m.DBHeight
+	}
+	if dbheight > s.LLeaderHeight {
+		s.HoldForHeight(msg, dbheight)
+		return
+	}
+	s.HoldOnSelf(msg, HoldUnvalidated)
+}
+
+// HoldForHeight puts msg into Holding until we reach dbheight.
+func (s *State) HoldForHeight(msg interfaces.IMsg, dbheight uint32) {
+	s.Hold(msg, HeightKey(dbheight), HoldFutureHeight)
+	s.holdingHeights[dbheight] = true
+}
+
+func (s *State) unlinkHeld(h [32]byte, dependency [32]byte) {
+	delete(s.holdingWhy, h)
+	if held := s.HoldingDeps[dependency]; held != nil {
+		delete(held, h)
+		if len(held) == 0 {
+			delete(s.HoldingDeps, dependency)
+		}
+	}
+}
+
+// ReleaseHeld moves the messages waiting on dependency out of Holding and into XReview, and
+// returns them.  A message held under dependency itself is released too.
+func (s *State) ReleaseHeld(dependency [32]byte) []interfaces.IMsg {
+	var released []interfaces.IMsg
+	release := func(h [32]byte) {
+		if msg := s.Holding[h]; msg != nil {
+			released = append(released, msg)
+			s.XReview = append(s.XReview, msg)
+			delete(s.Holding, h)
+		}
+		if was, ok := s.holdingWhy[h]; ok {
+			s.unlinkHeld(h, was.dependency)
+		}
+	}
+	for h := range s.HoldingDeps[dependency] {
+		release(h)
+	}
+	if _, ok := s.Holding[dependency]; ok {
+		release(dependency)
+	}
+	return released
+}
+
+// releaseHeights releases the messages waiting on blocks we have now reached.
+func (s *State) releaseHeights() {
+	for dbheight := range s.holdingHeights {
+		if dbheight <= s.LLeaderHeight {
+			s.ReleaseHeld(HeightKey(dbheight))
+			delete(s.holdingHeights, dbheight)
+		}
+	}
+}
+
+// pruneHoldingDeps drops the messages that have left Holding from the graph.
+func (s *State) pruneHoldingDeps() {
+	for h, was := range s.holdingWhy {
+		if _, ok := s.Holding[h]; !ok {
+			s.unlinkHeld(h, was.dependency)
+		}
+	}
+}
+
+// holdingReasons lists why each message in Holding is held.
+func (s *State) holdingReasons() []interfaces.HeldMessage {
+	s.pruneHoldingDeps()
+	reasons := make([]interfaces.HeldMessage, 0, len(s.Holding))
+	for h, msg := range s.Holding {
+		held := interfaces.HeldMessage{Hash: hex.EncodeToString(h[:]), Type: messages.MessageName(msg.Type())}
+		if was, ok := s.holdingWhy[h]; ok {
+			held.Reason = was.reason
+			held.WaitingOn = hex.EncodeToString(was.dependency[:])
+			held.Since = was.since
+		}
+		reasons = append(reasons, held)
+	}
+	return reasons
+}
+
+// GetHoldingReasons returns why each message in Holding is held, as of the last snapshot of it.
+func (s *State) GetHoldingReasons() []interfaces.HeldMessage {
+	s.HoldingMutex.RLock()
+	defer s.HoldingMutex.RUnlock()
+	return s.HoldingReasons
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestReleaseHeld(t *testing.T) {
+	s := new(State)
+	s.Holding = make(map[[32]byte]interfaces.IMsg)
+
+	entry := entryBlock.NewEntry()
+	entry.ChainID = primitives.Sha([]byte("chain"))
+	reveal := new(messages.RevealEntryMsg)
+	reveal.Entry = entry
+	reveal.Timestamp = primitives.NewTimestampNow()
+
+	eom := new(messages.EOM)
+	eom.ChainID = primitives.NewZeroHash()
+	eom.DBHeight = 10
+	eom.Timestamp = primitives.NewTimestampNow()
+
+	s.HoldOnSelf(reveal, HoldMissingCommit)
+	s.HoldForHeight(eom, 10)
+	if len(s.Holding) != 2 {
+		t.Fatalf("Holding has %d messages, expected 2", len(s.Holding))
+	}
+	if !s.HoldingDeps[entry.GetHash().Fixed()][reveal.GetMsgHash().Fixed()] {
+		t.Errorf("The reveal isn't waiting on its commit")
+	}
+	if !s.HoldingDeps[HeightKey(10)][eom.GetMsgHash().Fixed()] {
+		t.Errorf("The EOM isn't waiting on its height")
+	}
+
+	if released := s.ReleaseHeld(HeightKey(9)); len(released) != 0 {
+		t.Errorf("Released %d messages on a height nothing waits on", len(released))
+	}
+	released := s.ReleaseHeld(entry.GetHash().Fixed())
+	if len(released) != 1 || released[0] != reveal {
+		t.Fatalf("Expected the reveal to be released by its commit")
+	}
+	if len(s.XReview) != 1 || len(s.Holding) != 1 {
+		t.Errorf("Expected the reveal to move from Holding to XReview")
+	}
+	if _, ok := s.HoldingDeps[entry.GetHash().Fixed()]; ok {
+		t.Errorf("The released reveal is still in the graph")
+	}
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 )
 
@@ -17,6 +18,82 @@ func (InMsgQueueRatePrometheus) SetCompleteTotalAvg(v float64) { InMsgTotalCompl
 func (InMsgQueueRatePrometheus) SetMovingArrival(v float64)    { InMsgMovingArrivalQueueRate.Set(v) }
 func (InMsgQueueRatePrometheus) SetMovingComplete(v float64)   { InMsgMovingCompleteQueueRate.Set(v) }
 
+// The InMsgQueue has two lanes.  Messages go into the bulk lane, first in first out, until it is
+// InMsgPriorityDepth deep.  From then on the messages the leaders wait on (acks, EOMs, DBSigs and
+// faults, see LeaderCritical) skip the line: they go into the critical lane, which is always
+// emptied first.  So under a flood of commits and reveals the leaders' messages are still handled
+// in time, and the minute doesn't overrun.  The critical lane has as much room as the bulk lane,
+// so a full bulk lane never blocks them.
+
+// InMsgPriorityDepth is how deep the bulk lane must be before consensus messages skip it.
+const InMsgPriorityDepth = constants.INMSGQUEUE_LOW
+
+// PriorityInMsgQueue is the InMsgQueue: a bulk lane, and a critical lane emptied first.
+type PriorityInMsgQueue struct {
+	bulk     InMsgMSGQueue
+	critical InMsgMSGQueue
+}
+
+var _ interfaces.IQueue = (*PriorityInMsgQueue)(nil)
+
+func NewPriorityInMsgQueue(capacity int) *PriorityInMsgQueue {
+	q := new(PriorityInMsgQueue)
+	q.bulk = NewInMsgQueue(capacity)
+	q.critical = NewInMsgQueue(capacity)
+	return q
+}
+
+// Length of both lanes
+func (q *PriorityInMsgQueue) Length() int {
+	return q.bulk.Length() + q.critical.Length()
+}
+
+// Cap of the bulk lane
+func (q *PriorityInMsgQueue) Cap() int {
+	return q.bulk.Cap()
+}
+
+// CriticalLength is the number of messages that skipped the line, and are waiting in the
+// critical lane.
+func (q *PriorityInMsgQueue) CriticalLength() int {
+	return q.critical.Length()
+}
+
+// Enqueue puts m in the critical lane if the leaders wait on it and the bulk lane is deep, and
+// otherwise in the bulk lane.
+func (q *PriorityInMsgQueue) Enqueue(m interfaces.IMsg) {
+	if m != nil && LeaderCritical(m) && q.bulk.Length() >= InMsgPriorityDepth {
+		InMsgPrioritized.Inc()
+		q.critical.Enqueue(m)
+		return
+	}
+	q.bulk.Enqueue(m)
+}
+
+// Dequeue removes a message from the critical lane, or if it is empty the bulk lane.  Returns nil
+// if both are empty.
+func (q *PriorityInMsgQueue) Dequeue() interfaces.IMsg {
+	if m := q.critical.Dequeue(); m != nil {
+		return m
+	}
+	return q.bulk.Dequeue()
+}
+
+// BlockingDequeue will block until it retrieves from either lane, the critical lane first
+func (q *PriorityInMsgQueue) BlockingDequeue() interfaces.IMsg {
+	if m := q.Dequeue(); m != nil {
+		return m
+	}
+	select {
+	case m := <-q.critical:
+		measureMessage(q.critical, m, false)
+		return m
+	case m := <-q.bulk:
+		measureMessage(q.bulk, m, false)
+		return m
+	}
+}
+
 // InMsgMSGQueue counts incoming and outgoing messages for inmsg queue
 type InMsgMSGQueue chan interfaces.IMsg
 
@@ -0,0 +1,147 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/log"
+)
+
+// A program embedding factomd (see engine.Daemon), such as an integration test or a load
+// generator, can put messages into the State's queues and take them out again, and so can the
+// debug API.  An injected message is handled as if it had got to its queue the usual way:
+//
+//	api              from our own API, so it is checked for replays and then validated
+//	in               from a peer, straight to validation
+//	ack, msg         already validated, for the leader or the follower
+//	timer            from the timer
+//	network-out      from us, to be sent to our peers
+//	network-invalid  from a peer, found invalid
+//	quarantine       a commit that looks like spam, see quarantine.go
+//
+// Draining a queue takes out whatever is waiting in it.  A test drains network-out to see what a
+// node sends to its peers, or drains in to keep a node from acting on what it was sent.
+
+// The queues messages can be injected into and drained from
+var MessageQueues = []string{"api", "in", "ack", "msg", "timer", "network-out", "network-invalid", "quarantine"}
+
+type messageQueue struct {
+	enqueue func(interfaces.IMsg) bool // False if the queue is full
+	dequeue func() interfaces.IMsg     // Nil if the queue is empty
+	length  func() int
+}
+
+func chanQueue(c chan interfaces.IMsg) *messageQueue {
+	q := new(messageQueue)
+	q.enqueue = func(msg interfaces.IMsg) bool {
+		select {
+		case c <- msg:
+			return true
+		default:
+			return false
+		}
+	}
+	q.dequeue = func() interfaces.IMsg {
+		select {
+		case msg := <-c:
+			return msg
+		default:
+			return nil
+		}
+	}
+	q.length = func() int { return len(c) }
+	return q
+}
+
+func iQueue(c interfaces.IQueue) *messageQueue {
+	q := new(messageQueue)
+	q.enqueue = func(msg interfaces.IMsg) bool {
+		if c.Length() >= c.Cap() {
+			return false
+		}
+		c.Enqueue(msg)
+		return true
+	}
+	q.dequeue = c.Dequeue
+	q.length = c.Length
+	return q
+}
+
+func (s *State) messageQueue(name string) (*messageQueue, error) {
+	switch name {
+	case "api":
+		return chanQueue(s.APIQueue()), nil
+	case "in":
+		return iQueue(s.InMsgQueue()), nil
+	case "ack":
+		return chanQueue(s.AckQueue()), nil
+	case "msg":
+		return chanQueue(s.MsgQueue()), nil
+	case "timer":
+		return chanQueue(s.TimerMsgQueue()), nil
+	case "network-out":
+		return iQueue(s.NetworkOutMsgQueue()), nil
+	case "network-invalid":
+		return chanQueue(s.NetworkInvalidMsgQueue()), nil
+	case "quarantine":
+		return chanQueue(s.quarantine), nil
+	}
+	return nil, fmt.Errorf("There is no message queue %q", name)
+}
+
+// InjectMessage puts msg at the end of the named queue.  It doesn't wait for room, but returns
+// an error if the queue is full.
+func (s *State) InjectMessage(queue string, msg interfaces.IMsg) error {
+	if msg == nil {
+		return fmt.Errorf("No message to inject")
+	}
+	q, err := s.messageQueue(queue)
+	if err != nil {
+		return err
+	}
+	if msg.GetCorrelationID() == "" {
+		msg.SetCorrelationID(log.NewCorrelationID("inject"))
+	}
+	if !q.enqueue(msg) {
+		return fmt.Errorf("The %s queue is full", queue)
+	}
+	s.Logf("debug", "[%s] injected a %s into the %s queue", msg.GetCorrelationID(), messages.MessageName(msg.Type()), queue)
+	return nil
+}
+
+// DrainQueue takes out the messages waiting in the named queue, at most max of them if max is
+// more than 0, and returns them oldest first.  The State goes on reading its queues meanwhile, so
+// it may get to some of them first.
+func (s *State) DrainQueue(queue string, max int) ([]interfaces.IMsg, error) {
+	q, err := s.messageQueue(queue)
+	if err != nil {
+		return nil, err
+	}
+	n := q.length()
+	if max > 0 && n > max {
+		n = max
+	}
+	msgs := make([]interfaces.IMsg, 0, n)
+	for len(msgs) < n {
+		msg := q.dequeue()
+		if msg == nil {
+			break
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// QueueLength returns how many messages are waiting in the named queue.
+func (s *State) QueueLength(queue string) (int, error) {
+	q, err := s.messageQueue(queue)
+	if err != nil {
+		return 0, err
+	}
+	return q.length(), nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/messages"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestInjectAndDrain(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	for _, queue := range MessageQueues {
+		// The empty state starts with the genesis block queued
+		if _, err := s.DrainQueue(queue, 0); err != nil {
+			t.Fatalf("Draining %s: %v", queue, err)
+		}
+
+		eom := new(messages.EOM)
+		eom.Minute = 3
+		if err := s.InjectMessage(queue, eom); err != nil {
+			t.Fatalf("Injecting into %s: %v", queue, err)
+		}
+		if eom.GetCorrelationID() == "" {
+			t.Errorf("The message injected into %s was given no correlation ID", queue)
+		}
+		if n, err := s.QueueLength(queue); err != nil || n != 1 {
+			t.Errorf("The %s queue holds %d messages (%v), expected 1", queue, n, err)
+		}
+
+		msgs, err := s.DrainQueue(queue, 0)
+		if err != nil {
+			t.Fatalf("Draining %s: %v", queue, err)
+		}
+		if len(msgs) != 1 || msgs[0] != eom {
+			t.Errorf("Drained %d messages from %s, expected the one injected", len(msgs), queue)
+		}
+		if n, _ := s.QueueLength(queue); n != 0 {
+			t.Errorf("The %s queue still holds %d messages after draining", queue, n)
+		}
+	}
+}
+
+func TestDrainAtMost(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	for i := 0; i < 5; i++ {
+		eom := new(messages.EOM)
+		eom.Minute = byte(i)
+		s.InjectMessage("network-out", eom)
+	}
+
+	msgs, _ := s.DrainQueue("network-out", 2)
+	if len(msgs) != 2 {
+		t.Fatalf("Drained %d messages, expected 2", len(msgs))
+	}
+	for i, msg := range msgs {
+		if msg.(*messages.EOM).Minute != byte(i) {
+			t.Errorf("Message %d is from minute %d; messages should be drained oldest first", i, msg.(*messages.EOM).Minute)
+		}
+	}
+	if n, _ := s.QueueLength("network-out"); n != 3 {
+		t.Errorf("%d messages are left, expected 3", n)
+	}
+}
+
+func TestInjectUnknownQueue(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	if err := s.InjectMessage("nowhere", new(messages.EOM)); err == nil {
+		t.Error("Injected into a queue that doesn't exist")
+	}
+	if _, err := s.DrainQueue("nowhere", 0); err == nil {
+		t.Error("Drained a queue that doesn't exist")
+	}
+	if err := s.InjectMessage("in", nil); err == nil {
+		t.Error("Injected a nil message")
+	}
+}
@@ -12,6 +12,118 @@ var (
 	//})
 	//
 
+	// Shedding under load, see backpressure.go
+	InMsgQueueShed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_inmsg_shed_total",
+		Help: "Messages turned away because the inmsg queue was too full for their priority.",
+	})
+	InMsgPrioritized = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_inmsg_prioritized_total",
+		Help: "Consensus messages put ahead of the bulk of a deep inmsg queue, see inMsgQueue.go.",
+	})
+	HoldingShed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_holding_shed_total",
+		Help: "Messages dropped from holding because it was over its cap.",
+	})
+	AcksShed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_acks_shed_total",
+		Help: "Acks dropped because we held more than the cap.",
+	})
+
+	// The replay filter, see replay.go
+	ReplayDuplicates = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "factomd_state_replay_duplicates_total",
+		Help: "Messages the replay filter dropped as seen before, by filter and message type.",
+	}, []string{"filter", "type"})
+	ReplayExpired = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "factomd_state_replay_expired_total",
+		Help: "Messages the replay filter dropped as outside the replay window, by filter and message type.",
+	}, []string{"filter", "type"})
+
+	// Ack batches, see ackBatch.go
+	AckBatchesSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_ack_batches_sent_total",
+		Help: "Ack batches sent as a leader.",
+	})
+	AcksBatched = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_acks_batched_total",
+		Help: "Acks sent in ack batches as a leader, rather than alone.",
+	})
+
+	// Authority signature cache, see sigCache.go
+	SigCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_sig_cache_hits_total",
+		Help: "Authority signatures found already verified in the cache.",
+	})
+	SigCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_sig_cache_misses_total",
+		Help: "Authority signatures not in the cache, so verified.",
+	})
+
+	// Spam quarantine, see quarantine.go
+	QuarantineQueued = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_quarantine_queued_total",
+		Help: "Commits quarantined as likely spam.",
+	})
+	QuarantineReleased = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_quarantine_released_total",
+		Help: "Quarantined commits moved on into the inmsg queue.",
+	})
+	QuarantineDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_quarantine_dropped_total",
+		Help: "Commits dropped as likely spam because the quarantine was full.",
+	})
+	QuarantineLength = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "factomd_state_quarantine_length",
+		Help: "Commits waiting in the quarantine.",
+	})
+
+	// Commits expired without a reveal, see expiredCommits.go
+	ExpiredCommits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_expired_commits_total",
+		Help: "Commits dropped because their reveals never came.",
+	})
+	BurnedCredits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_burned_credits_total",
+		Help: "Entry credits paid by commits that expired without a reveal.",
+	})
+
+	// Commit replacement, see commitReplacement.go
+	CommitsSuperseded = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_commits_superseded_total",
+		Help: "Pending commits replaced by a commit of the same entry paying more.",
+	})
+	CommitsNotSuperseding = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_commits_not_superseding_total",
+		Help: "Commits not acked as a leader because they paid no more than the commit pending for their entry.",
+	})
+
+	// Chain binding, see chainBinding.go
+	ChainBindingRefused = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_chain_binding_refused_total",
+		Help: "First entries not acked as a leader because their chain was bound to other external IDs, or reserved for another entry.",
+	})
+
+	// Probes between authorities, see probe.go
+	ProbesSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_probes_sent_total",
+		Help: "Probes sent to other authorities.",
+	})
+	ProbesEchoed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_probes_echoed_total",
+		Help: "Probes from other authorities echoed back to them.",
+	})
+	ProbeRoundTrip = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_state_probe_round_trip_ms",
+		Help: "Milliseconds from sending a probe to getting its echo.",
+	})
+
+	// Chain filtering, see chainFilter.go
+	ChainFiltered = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_chain_filtered_total",
+		Help: "Commits and reveals turned away because their chain is filtered out.",
+	})
+
 	// Entry Syncing Controller
 	ESMissingQueue = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "factomd_state_es_missing_entry_queue",
@@ -566,4 +678,28 @@ func RegisterPrometheus() {
 
 	prometheus.MustRegister(TotalMessageQueueInMsgGeneral)
 	prometheus.MustRegister(TotalMessageQueueNetOutMsgGeneral)
+
+	prometheus.MustRegister(InMsgQueueShed)
+	prometheus.MustRegister(InMsgPrioritized)
+	prometheus.MustRegister(HoldingShed)
+	prometheus.MustRegister(AcksShed)
+	prometheus.MustRegister(ChainFiltered)
+	prometheus.MustRegister(ExpiredCommits)
+	prometheus.MustRegister(CommitsSuperseded)
+	prometheus.MustRegister(CommitsNotSuperseding)
+	prometheus.MustRegister(ChainBindingRefused)
+	prometheus.MustRegister(ProbesSent)
+	prometheus.MustRegister(ProbesEchoed)
+	prometheus.MustRegister(ProbeRoundTrip)
+	prometheus.MustRegister(BurnedCredits)
+	prometheus.MustRegister(QuarantineQueued)
+	prometheus.MustRegister(QuarantineReleased)
+	prometheus.MustRegister(QuarantineDropped)
+	prometheus.MustRegister(QuarantineLength)
+	prometheus.MustRegister(SigCacheHits)
+	prometheus.MustRegister(SigCacheMisses)
+	prometheus.MustRegister(AckBatchesSent)
+	prometheus.MustRegister(AcksBatched)
+	prometheus.MustRegister(ReplayDuplicates)
+	prometheus.MustRegister(ReplayExpired)
 }
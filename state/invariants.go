@@ -0,0 +1,232 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// After each block is applied to the balances, CheckInvariants checks the block moved them the
+// way it should have:
+//
+//   - No transaction but the coinbase creates factoids: the inputs of each cover its outputs and
+//     the factoids it burns for entry credits.
+//   - The factoid supply (the total of all balances) moved by exactly what the block's
+//     transactions add up to: what the coinbase issued, less fees and factoids burned.
+//   - The entry credits moved by exactly the credits bought with those burns, less the credits
+//     spent by commits.
+//   - No address the block took from is left with a negative balance.
+//
+// Adding up every balance each block would slow a sync down too much, so the supply totals are
+// only kept once we are synced; the other checks run on every block.
+//
+// A violation means our balances can't be trusted.  An authority server must not sign anything
+// built on them, so once synced it halts: it stops processing messages and refuses new work, and
+// keeps serving the API so the operator can look at it, and writes a state dump with the report.
+// A follower only prints the report and logs an alert.
+
+const InvariantViolationsKept = 20
+
+type InvariantViolation struct {
+	DBHeight  uint32
+	Invariant string
+	Expected  int64    // For the supply totals
+	Actual    int64    // For the supply totals
+	Details   []string // The transactions or addresses at fault
+}
+
+func (v *InvariantViolation) String() string {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "Invariant violated at block %d: %s", v.DBHeight, v.Invariant)
+	if v.Expected != v.Actual {
+		fmt.Fprintf(&out, " (expected %d, found %d, off by %d)", v.Expected, v.Actual, v.Actual-v.Expected)
+	}
+	for _, d := range v.Details {
+		fmt.Fprintf(&out, "\n    %s", d)
+	}
+	return out.String()
+}
+
+type InvariantChecker struct {
+	Checked    int    // Blocks checked
+	LastHeight uint32 // Last block checked
+	Violations []*InvariantViolation
+
+	factoidSupply int64
+	ecSupply      int64
+	supplyAt      uint32 // Height the supply totals are for
+	supplyKnown   bool
+	halted        int32
+	mutex         sync.Mutex
+}
+
+// blockDeltas returns how a block moves each balance, given the rate its entry credits were
+// bought at, and the transactions that create factoids.
+func blockDeltas(fblock interfaces.IFBlock, ecblock interfaces.IEntryCreditBlock, rate uint64) (map[[32]byte]int64, map[[32]byte]int64, []string) {
+	factoids := make(map[[32]byte]int64)
+	ecs := make(map[[32]byte]int64)
+	var creators []string
+
+	if fblock != nil {
+		for i, trans := range fblock.GetTransactions() {
+			var in, out uint64
+			for _, input := range trans.GetInputs() {
+				factoids[input.GetAddress().Fixed()] -= int64(input.GetAmount())
+				in += input.GetAmount()
+			}
+			for _, output := range trans.GetOutputs() {
+				factoids[output.GetAddress().Fixed()] += int64(output.GetAmount())
+				out += output.GetAmount()
+			}
+			for _, ecOut := range trans.GetECOutputs() {
+				if rate > 0 {
+					ecs[ecOut.GetAddress().Fixed()] += int64(ecOut.GetAmount()) / int64(rate)
+				}
+				out += ecOut.GetAmount()
+			}
+			if i > 0 && in < out {
+				creators = append(creators, fmt.Sprintf("transaction %s: inputs %d, outputs and burns %d", trans.GetSigHash().String(), in, out))
+			}
+		}
+	}
+
+	if ecblock != nil {
+		for _, trans := range ecblock.GetBody().GetEntries() {
+			switch t := trans.(type) {
+			case *entryCreditBlock.CommitChain:
+				ecs[t.ECPubKey.Fixed()] -= int64(t.Credits)
+			case *entryCreditBlock.CommitEntry:
+				ecs[t.ECPubKey.Fixed()] -= int64(t.Credits)
+			}
+		}
+	}
+	return factoids, ecs, creators
+}
+
+// negativeBalances returns the addresses the block took from that it left negative.
+func negativeBalances(balances *BalanceMap, deltas map[[32]byte]int64, name func(interfaces.IAddress) string) []string {
+	var negative []string
+	for adr, delta := range deltas {
+		if v, _ := balances.Get(adr); delta < 0 && v < 0 {
+			negative = append(negative, fmt.Sprintf("%s: balance %d after %d this block", name(primitives.NewHash(adr[:])), v, delta))
+		}
+	}
+	return negative
+}
+
+func sumBalances(balances *BalanceMap) int64 {
+	var sum int64
+	for _, v := range balances.Copy() {
+		sum += v
+	}
+	return sum
+}
+
+func sumDeltas(deltas map[[32]byte]int64) int64 {
+	var sum int64
+	for _, v := range deltas {
+		sum += v
+	}
+	return sum
+}
+
+// CheckInvariants checks the balances after the blocks at dbheight were applied at rate, and
+// returns the invariants they break.  Either block may be nil if it has nothing in it.
+func (s *State) CheckInvariants(dbheight uint32, fblock interfaces.IFBlock, ecblock interfaces.IEntryCreditBlock, rate uint64) []*InvariantViolation {
+	c := &s.Invariants
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var violations []*InvariantViolation
+	violated := func(invariant string, expected int64, actual int64, details []string) {
+		violations = append(violations, &InvariantViolation{dbheight, invariant, expected, actual, details})
+	}
+
+	factoids, ecs, creators := blockDeltas(fblock, ecblock, rate)
+	if len(creators) > 0 {
+		violated("transactions create factoids", 0, 0, creators)
+	}
+	if negative := negativeBalances(s.FactoidBalancesP, factoids, primitives.ConvertFctAddressToUserStr); len(negative) > 0 {
+		violated("negative factoid balances", 0, 0, negative)
+	}
+	if negative := negativeBalances(s.ECBalancesP, ecs, primitives.ConvertECAddressToUserStr); len(negative) > 0 {
+		violated("negative entry credit balances", 0, 0, negative)
+	}
+
+	if s.DBFinished {
+		factoidSupply := sumBalances(s.FactoidBalancesP)
+		ecSupply := sumBalances(s.ECBalancesP)
+		if c.supplyKnown && c.supplyAt+1 == dbheight {
+			if expected := c.factoidSupply + sumDeltas(factoids); expected != factoidSupply {
+				violated("factoid supply does not match the block's issuance, fees and burns", expected, factoidSupply, nil)
+			}
+			if expected := c.ecSupply + sumDeltas(ecs); expected != ecSupply {
+				violated("entry credits do not match the credits bought less the credits spent", expected, ecSupply, nil)
+			}
+		}
+		c.factoidSupply, c.ecSupply, c.supplyAt, c.supplyKnown = factoidSupply, ecSupply, dbheight, true
+	} else {
+		c.supplyKnown = false
+	}
+
+	c.Checked++
+	c.LastHeight = dbheight
+	c.Violations = append(c.Violations, violations...)
+	if len(c.Violations) > InvariantViolationsKept {
+		c.Violations = c.Violations[len(c.Violations)-InvariantViolationsKept:]
+	}
+	return violations
+}
+
+// checkBlockInvariants runs CheckInvariants on a block ProcessBlocks just applied, and halts us or
+// raises an alert if it breaks any.
+func (s *State) checkBlockInvariants(dbheight uint32, fblock interfaces.IFBlock, ecblock interfaces.IEntryCreditBlock, rate uint64) {
+	violations := s.CheckInvariants(dbheight, fblock, ecblock, rate)
+	if len(violations) == 0 {
+		return
+	}
+	halt := s.DBFinished && s.IsAuthority(s.LLeaderHeight)
+	level := "alert"
+	if halt {
+		level = "emergency"
+	}
+	for _, v := range violations {
+		fmt.Println(s.FactomNodeName, v.String())
+		s.Logf(level, "%s", v.String())
+		s.AddStatus(fmt.Sprintf("INVARIANT VIOLATED at block %d: %s", v.DBHeight, v.Invariant))
+	}
+	if !halt {
+		return
+	}
+
+	atomic.StoreInt32(&s.Invariants.halted, 1)
+	s.BeginShutdown()
+	s.AddStatus("Halted: our balances can't be trusted; restart from a good database once the cause is found")
+	if name, _, err := s.WriteStateDump(); err != nil {
+		fmt.Println("Could not write the state dump:", err)
+	} else {
+		fmt.Println("State dump written to", name)
+	}
+}
+
+// IsHalted is true once a broken invariant has halted us.
+func (s *State) IsHalted() bool {
+	return atomic.LoadInt32(&s.Invariants.halted) == 1
+}
+
+// InvariantStatus returns the blocks checked, the last of them, and the latest violations.
+func (s *State) InvariantStatus() (int, uint32, []*InvariantViolation) {
+	c := &s.Invariants
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.Checked, c.LastHeight, append([]*InvariantViolation(nil), c.Violations...)
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	s.DBFinished = true
+	checked0, _, violations0 := s.InvariantStatus()
+
+	// The first block only tells us the supply
+	if v := s.CheckInvariants(1, nil, nil, 1); len(v) != 0 {
+		t.Errorf("Unexpected violations %v", v)
+	}
+	if v := s.CheckInvariants(2, nil, nil, 1); len(v) != 0 {
+		t.Errorf("An empty block should leave the supply alone, got %v", v)
+	}
+
+	// Factoids that appear without a block to account for them
+	s.PutF(false, testHelper.NewFactoidAddress(3).Fixed(), 5)
+	v := s.CheckInvariants(3, nil, nil, 1)
+	if len(v) != 1 || v[0].Actual-v[0].Expected != 5 {
+		t.Fatalf("Expected the supply to be 5 over, got %v", v)
+	}
+
+	// A transaction that pays out more than it takes in, from an address it leaves negative
+	from, to := testHelper.NewFactoidAddress(1), testHelper.NewFactoidAddress(2)
+	tx := new(factoid.Transaction)
+	tx.AddInput(from, 10)
+	tx.AddOutput(to, 20)
+	fblock := testHelper.CreateTestFactoidBlockWithCoinbase(nil, to, 0).(*factoid.FBlock)
+	fblock.Transactions = append(fblock.Transactions, tx)
+	s.PutF(false, from.Fixed(), -10)
+	s.PutF(false, to.Fixed(), 20)
+
+	v = s.CheckInvariants(4, fblock, nil, 1)
+	found := map[string]bool{}
+	for _, violation := range v {
+		found[violation.Invariant] = true
+	}
+	if !found["transactions create factoids"] || !found["negative factoid balances"] || len(v) != 2 {
+		t.Errorf("Expected the transaction to create factoids from a negative balance, got %v", v)
+	}
+
+	checked, last, violations := s.InvariantStatus()
+	if checked-checked0 != 4 || last != 4 || len(violations)-len(violations0) != 3 {
+		t.Errorf("Expected 4 more blocks checked through 4 with 3 more violations, got %d, %d, %d", checked-checked0, last, len(violations)-len(violations0))
+	}
+	if s.IsHalted() {
+		t.Error("Checking alone should not halt the node")
+	}
+}
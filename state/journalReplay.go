@@ -0,0 +1,118 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The journal is an append only log of every message the ValidatorLoop hands to the State, with
+// the time it was handed over.  Replaying it feeds the State those messages, in the same order and
+// at the same times, and nothing else: the timer's EOMs come from the journal too.  Two nodes that
+// disagree can then be stepped through, message by message, on a developer's machine.
+//
+// Replay into a node with no database and no network (-enablenet=false) to get the same State back.
+
+// A message as it was delivered to the State
+type JournalEntry struct {
+	Msg       interfaces.IMsg
+	Delivered interfaces.Timestamp
+	Local     bool
+}
+
+// What is written to the journal, one per line.  Message is only there so people can read it.
+type journalLine struct {
+	Type      byte
+	Message   interfaces.IMsg `json:",omitempty"`
+	MsgHex    string
+	Delivered int64
+	Local     bool
+}
+
+// MarshalJournal returns the journal line of the entry.  A message that can't be marshaled, like
+// one missing its timestamp, is an error rather than a panic, so it can't take the node down.
+func (e *JournalEntry) MarshalJournal() (line []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Error marshalling a journal entry: %v", r)
+		}
+	}()
+
+	data, err := e.Msg.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	l := new(journalLine)
+	l.Type = e.Msg.Type()
+	l.Message = e.Msg
+	l.MsgHex = hex.EncodeToString(data)
+	l.Delivered = e.Delivered.GetTimeMilli()
+	l.Local = e.Local
+	return json.Marshal(l)
+}
+
+// ParseJournalLine reads one line of a journal.  Lines in the older "MsgHex: <hex>" form are
+// accepted, and are delivered at the message's own timestamp.  Returns nil for lines that don't
+// hold a message.
+func ParseJournalLine(line []byte) (*JournalEntry, error) {
+	adv, word, _ := bufio.ScanWords(line, true)
+	var data []byte
+	var err error
+	e := new(JournalEntry)
+
+	switch {
+	case string(word) == "MsgHex:":
+		_, word, _ = bufio.ScanWords(line[adv:], true)
+		data, err = hex.DecodeString(string(word))
+		if err != nil {
+			return nil, err
+		}
+	case len(word) > 0 && word[0] == '{':
+		// Unmarshal into a struct without the Message, as it can't be rebuilt from JSON
+		l := new(struct {
+			MsgHex    string
+			Delivered int64
+			Local     bool
+		})
+		err = json.Unmarshal(line, l)
+		if err != nil {
+			return nil, err
+		}
+		data, err = hex.DecodeString(l.MsgHex)
+		if err != nil {
+			return nil, err
+		}
+		e.Delivered = primitives.NewTimestampFromMilliseconds(uint64(l.Delivered))
+		e.Local = l.Local
+	default:
+		return nil, nil
+	}
+
+	e.Msg, err = messages.UnmarshalMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	if e.Delivered == nil || e.Delivered.GetTimeMilli() == 0 {
+		e.Delivered = e.Msg.GetTimestamp()
+	}
+	return e, nil
+}
+
+// ReplayMessage hands a journaled message to the ValidatorLoop, and returns once it has been taken.
+// The State must be replaying.  A nil msg just waits for the State to take everything before it.
+func (s *State) ReplayMessage(msg interfaces.IMsg, delivered interfaces.Timestamp, local bool) {
+	if !s.IsReplaying {
+		fmt.Println("ReplayMessage: not replaying a journal")
+		return
+	}
+	s.journalQueue <- &JournalEntry{msg, delivered, local}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
 	. "github.com/FactomProject/factomd/testHelper"
 )
 
@@ -37,3 +38,40 @@ func TestJournal(t *testing.T) {
 		t.Error("No messages returned from journal")
 	}
 }
+
+func TestJournalLine(t *testing.T) {
+	msg := new(messages.Ack)
+	msg.MessageHash = primitives.NewZeroHash()
+	msg.SerialHash = primitives.NewZeroHash()
+	msg.LeaderChainID = primitives.NewZeroHash()
+	msg.Timestamp = primitives.NewTimestampFromMilliseconds(1000)
+
+	e := &JournalEntry{msg, primitives.NewTimestampFromMilliseconds(5000), true}
+	line, err := e.MarshalJournal()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	e2, err := ParseJournalLine(line)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if e2 == nil {
+		t.Fatal("No entry parsed from the journal line")
+	}
+	if e2.Delivered.GetTimeMilli() != 5000 || e2.Local != true {
+		t.Errorf("Wrong delivery %v %v", e2.Delivered.GetTimeMilli(), e2.Local)
+	}
+	if e2.Msg.GetMsgHash().IsSameAs(msg.GetMsgHash()) == false {
+		t.Error("Messages do not match")
+	}
+
+	incomplete := &JournalEntry{new(messages.Ack), primitives.NewTimestampFromMilliseconds(5000), true}
+	if _, err := incomplete.MarshalJournal(); err == nil {
+		t.Error("Expected an error journaling an ack without a timestamp")
+	}
+
+	e3, err := ParseJournalLine([]byte("not a message\n"))
+	if err != nil || e3 != nil {
+		t.Errorf("Expected nothing from a line without a message, got %v %v", e3, err)
+	}
+}
@@ -80,6 +80,9 @@ func LoadDatabase(s *State) {
 		s.Println("***********************************\n")
 
 		dblk, ablk, fblk, ecblk := GenerateGenesisBlocks(s.GetNetworkID())
+		if s.CustomGenesis != nil {
+			dblk, ablk, fblk, ecblk = s.CustomGenesis.Blocks(s.GetNetworkID())
+		}
 
 		msg := messages.NewDBStateMsg(s.GetTimestamp(), dblk, ablk, fblk, ecblk, nil, nil, nil)
 		s.InMsgQueue().Enqueue(msg)
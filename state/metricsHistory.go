@@ -0,0 +1,93 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/FactomProject/factomd/metricsHistory"
+)
+
+// With MetricsHistorySeconds set, we sample a few key metrics that often, and keep them in a
+// metrics history beside the FastBoot file (see metricsHistory/), which the control panel
+// charts.  Operators of a node or two needn't run Prometheus and Grafana to see how it has been.
+
+// The series kept, in the order of each sample
+var MetricsHistorySeries = []string{
+	"height",   // The highest saved block
+	"peers",    // Connections to peers
+	"inqueue",  // Messages from peers and the API waiting for the state loop
+	"ackqueue", // Acks waiting to be processed
+	"msgqueue", // Other messages waiting to be processed
+	"tps",      // Transactions, entries and chains a second, since the last sample
+}
+
+func MetricsHistoryFilename(networkName string, fileLocation string) string {
+	file := fmt.Sprintf("MetricsHistory_%s.db", networkName)
+	if fileLocation != "" {
+		return fmt.Sprintf("%v/%v", fileLocation, file)
+	}
+	return file
+}
+
+// RecordMetricsHistory samples the metrics every MetricsHistorySeconds, until we shut down.
+func (s *State) RecordMetricsHistory() {
+	if s.MetricsHistorySeconds <= 0 {
+		return
+	}
+	filename := MetricsHistoryFilename(s.Network, s.StateSaverStruct.FastBootLocation)
+	db, err := metricsHistory.Open(filename, MetricsHistorySeries, metricsHistory.DefaultArchives)
+	if err != nil {
+		s.AddStatus("Metrics history: " + err.Error())
+		return
+	}
+	s.metricsHistoryMutex.Lock()
+	s.metricsHistory = db
+	s.metricsHistoryMutex.Unlock()
+	defer db.Close()
+
+	interval := time.Duration(s.MetricsHistorySeconds) * time.Second
+	lastTime, lastCount := time.Now(), s.transactionCount()
+	for !s.IsShuttingDown() {
+		time.Sleep(interval)
+		now, count := time.Now(), s.transactionCount()
+
+		peers := math.NaN()
+		if s.NetworkControler != nil {
+			peers = float64(s.NetworkControler.GetNumberConnections())
+		}
+		tps := float64(count-lastCount) / now.Sub(lastTime).Seconds()
+		lastTime, lastCount = now, count
+
+		err := db.Add(now, []float64{
+			float64(s.GetHighestSavedBlk()),
+			peers,
+			float64(s.InMsgQueue().Length()),
+			float64(len(s.AckQueue())),
+			float64(len(s.MsgQueue())),
+			tps,
+		})
+		if err != nil {
+			s.Logf("error", "Metrics history: %v", err)
+		}
+	}
+}
+
+func (s *State) transactionCount() int {
+	return s.FactoidTrans + s.NewEntryChains + s.NewEntries
+}
+
+// GetMetricsHistory returns the points of a series kept since from, and their step.
+func (s *State) GetMetricsHistory(series string, from time.Time) ([]metricsHistory.Point, time.Duration, error) {
+	s.metricsHistoryMutex.Lock()
+	db := s.metricsHistory
+	s.metricsHistoryMutex.Unlock()
+	if db == nil {
+		return nil, 0, fmt.Errorf("No metrics history is kept")
+	}
+	return db.Query(series, from, time.Now())
+}
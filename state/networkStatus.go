@@ -0,0 +1,168 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The network status is the latest status published on the network status chain and signed by
+// most of the federated servers (see specialEntries/networkStatus.go).  Each authority signs the
+// status with SignNetworkStatus, anyone may publish the entry, and every node checks it as its
+// block is processed, so wallets can ask any node for a status they know the authorities agreed.
+//
+// The signatures are checked against the federated servers of the block the entry is in.  On a
+// boot we only know the current federated servers, so the last status is checked against them.
+
+type NetworkStatusRecord struct {
+	Status    *specialEntries.NetworkStatus
+	Content   []byte
+	Signers   []interfaces.IHash
+	EntryHash interfaces.IHash
+	DBHeight  uint32 // Block the entry is in
+}
+
+var networkStatusChainID = specialEntries.NetworkStatusChainID()
+
+// VerifyNetworkStatusEntry returns the status of a network status entry, and the federated
+// servers at dbheight that signed it, if most of them did.
+func (s *State) VerifyNetworkStatusEntry(entry interfaces.IEBEntry, dbheight uint32) (*specialEntries.NetworkStatus, []interfaces.IHash, error) {
+	status, sigs, err := specialEntries.ParseNetworkStatusEntry(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	feds := s.GetFedServers(dbheight)
+	if feds == nil {
+		feds = s.GetFedServers(s.LLeaderHeight)
+	}
+	if len(feds) == 0 {
+		return nil, nil, fmt.Errorf("The federated servers at %d are unknown", dbheight)
+	}
+
+	var signers []interfaces.IHash
+	signed := make(map[[32]byte]bool)
+	for _, sig := range sigs {
+		if signed[sig.IdentityChainID.Fixed()] {
+			continue
+		}
+		for _, fed := range feds {
+			if !fed.GetChainID().IsSameAs(sig.IdentityChainID) {
+				continue
+			}
+			auth, _ := s.GetAuthority(sig.IdentityChainID)
+			if auth == nil {
+				break
+			}
+			if valid, err := auth.VerifySignature(entry.GetContent(), &sig.Signature); err == nil && valid {
+				signed[sig.IdentityChainID.Fixed()] = true
+				signers = append(signers, sig.IdentityChainID)
+			}
+			break
+		}
+	}
+	if len(signers)*2 <= len(feds) {
+		return nil, nil, fmt.Errorf("Signed by %d of %d federated servers", len(signers), len(feds))
+	}
+	return status, signers, nil
+}
+
+// ProcessNetworkStatusEntries looks for a newer network status in the blocks of the network
+// status chain added since the last call.  Called as each block is processed.
+func (s *State) ProcessNetworkStatusEntries() {
+	head, err := s.DB.FetchEBlockHead(networkStatusChainID)
+	if err != nil || head == nil {
+		return
+	}
+	scanned := s.networkStatusScanned
+	s.networkStatusScanned = head.GetHeader().GetDBHeight()
+
+	// Newest first, so the first good status we find is the one we want
+	for eblk := head; eblk != nil && (scanned == 0 || eblk.GetHeader().GetDBHeight() > scanned); {
+		dbheight := eblk.GetHeader().GetDBHeight()
+		hashes := eblk.GetEntryHashes()
+		for i := len(hashes) - 1; i >= 0; i-- {
+			if hashes[i].IsMinuteMarker() {
+				continue
+			}
+			entry, err := s.DB.FetchEntry(hashes[i])
+			if err != nil || entry == nil {
+				continue
+			}
+			if s.acceptNetworkStatus(entry, dbheight) {
+				return
+			}
+		}
+
+		prev := eblk.GetHeader().GetPrevKeyMR()
+		if prev == nil || prev.IsZero() {
+			break
+		}
+		eblk, err = s.DB.FetchEBlock(prev)
+		if err != nil {
+			break
+		}
+	}
+}
+
+func (s *State) acceptNetworkStatus(entry interfaces.IEBEntry, dbheight uint32) bool {
+	status, signers, err := s.VerifyNetworkStatusEntry(entry, dbheight)
+	if err != nil {
+		s.Println("Skipping network status entry ", entry.GetHash().String(), ": ", err.Error())
+		return false
+	}
+	if status.Height > dbheight {
+		s.Println("Skipping network status entry ", entry.GetHash().String(), ": signed for a future height")
+		return false
+	}
+
+	s.networkStatusMutex.Lock()
+	defer s.networkStatusMutex.Unlock()
+	if s.networkStatus != nil && status.Height <= s.networkStatus.Status.Height {
+		return false
+	}
+	s.networkStatus = &NetworkStatusRecord{
+		Status:    status,
+		Content:   entry.GetContent(),
+		Signers:   signers,
+		EntryHash: entry.GetHash(),
+		DBHeight:  dbheight,
+	}
+	s.AddStatus(fmt.Sprintf("Network status for height %d, signed by %d federated servers", status.Height, len(signers)))
+	return true
+}
+
+// GetNetworkStatus returns the content of the latest network status the authorities signed, the
+// servers that signed it, and the entry and block it was published in.  Content is nil if there
+// is none.
+func (s *State) GetNetworkStatus() ([]byte, []interfaces.IHash, interfaces.IHash, uint32) {
+	s.networkStatusMutex.Lock()
+	defer s.networkStatusMutex.Unlock()
+	ns := s.networkStatus
+	if ns == nil {
+		return nil, nil, nil, 0
+	}
+	return ns.Content, ns.Signers, ns.EntryHash, ns.DBHeight
+}
+
+// SignNetworkStatus signs the content of a network status with our block signing key, if we are a
+// federated server.
+func (s *State) SignNetworkStatus(content []byte) (interfaces.IHash, interfaces.IFullSignature, error) {
+	status := new(specialEntries.NetworkStatus)
+	if err := status.UnmarshalBinary(content); err != nil || len(content) == 0 || content[0] != '{' {
+		return nil, nil, fmt.Errorf("Not a network status")
+	}
+	if s.IsStandby() {
+		return nil, nil, fmt.Errorf("A standby does not sign")
+	}
+	for _, fed := range s.GetFedServers(s.LLeaderHeight) {
+		if fed.GetChainID().IsSameAs(s.IdentityChainID) {
+			return s.IdentityChainID, s.Sign(content), nil
+		}
+	}
+	return nil, nil, fmt.Errorf("Not a federated server")
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sort"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Audit servers send their health in their heartbeats (see common/messages/heartbeatHealth.go),
+// and every node keeps the last health each one reported, for the API.
+
+// endMinute notes the end of a minute, to time the next one by.
+func (s *State) endMinute() {
+	now := s.ClockNow()
+	if !s.minuteEnded.IsZero() {
+		s.lastMinute = now.Sub(s.minuteEnded)
+	}
+	s.minuteEnded = now
+}
+
+// NewHeartbeatHealth returns our health, to send in our heartbeat.
+func (s *State) NewHeartbeatHealth() *messages.HeartbeatHealth {
+	h := new(messages.HeartbeatHealth)
+	h.SavedHeight = s.GetHighestSavedBlk()
+	h.LastMinuteMillis = uint32(s.lastMinute / time.Millisecond)
+	h.InMsgQueue = uint32(s.InMsgQueue().Length())
+	h.AckQueue = uint32(len(s.AckQueue()))
+	h.MsgQueue = uint32(len(s.MsgQueue()))
+	h.Holding = uint32(len(s.Holding))
+	h.FactomdVersion = uint32(s.FactomdVersion)
+	return h
+}
+
+// PutNodeHealth keeps the health a node reported, unless we have a later report from it.
+func (s *State) PutNodeHealth(health interfaces.NodeHealth) {
+	s.nodeHealthMutex.Lock()
+	defer s.nodeHealthMutex.Unlock()
+
+	if s.nodeHealth == nil {
+		s.nodeHealth = make(map[string]interfaces.NodeHealth)
+	}
+	if old, ok := s.nodeHealth[health.IdentityChainID]; ok && old.Timestamp > health.Timestamp {
+		return
+	}
+	s.nodeHealth[health.IdentityChainID] = health
+}
+
+// GetNodeHealth returns the last health each node reported, by identity.
+func (s *State) GetNodeHealth() []interfaces.NodeHealth {
+	s.nodeHealthMutex.Lock()
+	defer s.nodeHealthMutex.Unlock()
+
+	health := []interfaces.NodeHealth{}
+	for _, h := range s.nodeHealth {
+		health = append(health, h)
+	}
+	sort.Sort(healthByIdentity(health))
+	return health
+}
+
+type healthByIdentity []interfaces.NodeHealth
+
+func (h healthByIdentity) Len() int           { return len(h) }
+func (h healthByIdentity) Less(i, j int) bool { return h[i].IdentityChainID < h[j].IdentityChainID }
+func (h healthByIdentity) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
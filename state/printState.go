@@ -28,6 +28,7 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v  Last value %d \n\n", str, "ResetCnt", state.ResetCnt, cnts[state.FactomNodeName])
 	cnts[state.FactomNodeName] = state.ResetCnt
 	cntsMutex.Unlock()
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "MinuteRollbackCnt", state.MinuteRollbackCnt)
 
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "filename", state.filename)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "Salt", state.Salt)
@@ -50,6 +51,8 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "PortNumber", state.PortNumber)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "DropRate", state.DropRate)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "Delay", state.Delay)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "ProcessDelay", state.ProcessDelay)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "ClockSkew", state.ClockSkew)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "ControlPanelPort", state.ControlPanelPort)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "ControlPanelSetting", state.ControlPanelSetting)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "ControlPanelChannel", state.ControlPanelChannel)
@@ -59,12 +62,15 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "PeersFile", state.PeersFile)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainSeedURL", state.MainSeedURL)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainSpecialPeers", state.MainSpecialPeers)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainSpecialPeerGroups", state.MainSpecialPeerGroups)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "TestNetworkPort", state.TestNetworkPort)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "TestSeedURL", state.TestSeedURL)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "TestSpecialPeers", state.TestSpecialPeers)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "TestSpecialPeerGroups", state.TestSpecialPeerGroups)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "LocalNetworkPort", state.LocalNetworkPort)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "LocalSeedURL", state.LocalSeedURL)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "LocalSpecialPeers", state.LocalSpecialPeers)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "LocalSpecialPeerGroups", state.LocalSpecialPeerGroups)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "CustomNetworkID", state.CustomNetworkID)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "IdentityChainID", state.IdentityChainID)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "Identities", state.Identities)
@@ -171,9 +177,7 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "FactoidState", state.FactoidState)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "NumTransactions", state.NumTransactions)
 	//str = fmt.Sprintf("%s %35s = %+v\n", str, "FactoidBalancesP", state.FactoidBalancesP)
-	//str = fmt.Sprintf("%s %35s = %+v\n", str, "FactoidBalancesPMutex", state.FactoidBalancesPMutex)
 	//str = fmt.Sprintf("%s %35s = %+v\n", str, "ECBalancesP", state.ECBalancesP)
-	//str = fmt.Sprintf("%s %35s = %+v\n", str, "ECBalancesPMutex", state.ECBalancesPMutex)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "Port", state.Port)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "IsReplaying", state.IsReplaying)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "ReplayTimestamp", state.ReplayTimestamp)
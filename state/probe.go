@@ -0,0 +1,121 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// An authority can probe another, to measure how long messages take to gossip between them (see
+// common/messages/probe.go).  We keep what our last probes found, for the API.
+
+// Most probe results kept; the oldest are forgotten first
+var MaxProbeResults = 100
+
+// SendProbe sends a probe to the authority with the target identity, and returns its number.
+func (s *State) SendProbe(target interfaces.IHash) (uint32, error) {
+	if !s.IsAuthority(s.LLeaderHeight) {
+		return 0, fmt.Errorf("Only an authority can send a probe")
+	}
+	if target.IsSameAs(s.IdentityChainID) {
+		return 0, fmt.Errorf("An authority can't probe itself")
+	}
+	if !s.VerifyIsAuthority(target) {
+		return 0, fmt.Errorf("%s is not an authority", target.String())
+	}
+
+	s.probesMutex.Lock()
+	defer s.probesMutex.Unlock()
+
+	s.probeNumber++
+	probe := new(messages.Probe)
+	probe.Timestamp = s.GetTimestamp()
+	probe.Origin = s.IdentityChainID
+	probe.Target = target
+	probe.Number = s.probeNumber
+	if err := probe.Sign(s.GetServerPrivateKey()); err != nil {
+		return 0, err
+	}
+
+	if len(s.probes) >= MaxProbeResults {
+		s.probes = append(s.probes[:0], s.probes[1:]...)
+	}
+	s.probes = append(s.probes, interfaces.ProbeResult{
+		Target: target.String(),
+		Number: probe.Number,
+		Sent:   probe.Timestamp.GetTimeMilli(),
+	})
+
+	s.LogMsg(probe, "probe sent")
+	probe.SendOut(s, probe)
+	ProbesSent.Inc()
+	return probe.Number, nil
+}
+
+// FollowerExecuteProbe stamps a probe or an echo on its way.  A probe to us is echoed, and an echo
+// of one of our probes completes it.
+func (s *State) FollowerExecuteProbe(m interfaces.IMsg) {
+	probe, ok := m.(*messages.Probe)
+	if !ok {
+		return
+	}
+	now := s.GetTimestamp()
+	probe.AddStamp(now)
+
+	switch {
+	case !probe.Echo && probe.Target.IsSameAs(s.IdentityChainID) && !s.IsStandby():
+		echo := probe.NewEcho(now)
+		if err := echo.Sign(s.GetServerPrivateKey()); err != nil {
+			return
+		}
+		s.LogMsg(echo, "probe echoed")
+		echo.SendOut(s, echo)
+		ProbesEchoed.Inc()
+
+	case probe.Echo && probe.Origin.IsSameAs(s.IdentityChainID):
+		s.putProbeEcho(probe, now.GetTimeMilli())
+	}
+}
+
+// putProbeEcho completes the result of the probe an echo answers, unless it was forgotten, or
+// already answered.
+func (s *State) putProbeEcho(echo *messages.Probe, received int64) {
+	s.probesMutex.Lock()
+	defer s.probesMutex.Unlock()
+
+	target := echo.Target.String()
+	for i := range s.probes {
+		r := &s.probes[i]
+		if r.Number != echo.Number || r.Target != target || r.Received != 0 {
+			continue
+		}
+		r.Outbound = probeMillis(echo.Outbound)
+		r.Echoed = echo.Timestamp.GetTimeMilli()
+		r.Return = probeMillis(echo.Stamps)
+		r.Received = received
+		r.RoundTripMillis = received - r.Sent
+		ProbeRoundTrip.Observe(float64(r.RoundTripMillis))
+		return
+	}
+}
+
+func probeMillis(stamps []interfaces.Timestamp) []int64 {
+	millis := []int64{}
+	for _, ts := range stamps {
+		millis = append(millis, ts.GetTimeMilli())
+	}
+	return millis
+}
+
+// GetProbeResults returns what the probes we sent found, oldest first.
+func (s *State) GetProbeResults() []interfaces.ProbeResult {
+	s.probesMutex.Lock()
+	defer s.probesMutex.Unlock()
+
+	return append([]interfaces.ProbeResult{}, s.probes...)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"encoding/binary"
 
@@ -30,7 +31,7 @@ var _ = log.Print
 type Request struct {
 	vmIndex    int    // VM Index
 	vmheight   uint32 // Height in the Process List where we are missing a message
-	wait       int64  // How long to wait before we actually request
+	wait       int64  // How long to wait before we actually request, in milliseconds
 	sent       int64  // Last time sent (zero means none have been sent)
 	requestCnt int
 }
@@ -58,9 +59,9 @@ type ProcessList struct {
 
 	// Temporary balances from updating transactions in real time.
 	FactoidBalancesT      map[[32]byte]int64
-	FactoidBalancesTMutex sync.Mutex
+	FactoidBalancesTMutex sync.RWMutex
 	ECBalancesT           map[[32]byte]int64
-	ECBalancesTMutex      sync.Mutex
+	ECBalancesTMutex      sync.RWMutex
 
 	State        *State
 	VMs          []*VM       // Process list for each server (up to 32)
@@ -84,6 +85,7 @@ type ProcessList struct {
 
 	NewEntriesMutex sync.RWMutex
 	NewEntries      map[[32]byte]interfaces.IEntry
+	correlationIDs  map[[32]byte]string // Correlation IDs of the reveals behind NewEntries
 
 	// State information about the directory block while it is under construction.  We may
 	// have to start building the next block while still building the previous block.
@@ -108,6 +110,13 @@ type ProcessList struct {
 	Requests map[[32]byte]*Request
 	//Requests map[[20]byte]*Request
 	NextHeightToProcess [64]int
+
+	// Checkpoints at the minute boundaries of this block, for rolling back a corrupted minute
+	Checkpoints []*Checkpoint
+	shared      sharedMaps
+
+	// When each message was processed, by message hash, if process list logs are kept; see processListLog.go
+	processedAt map[[32]byte]int64
 }
 
 var _ interfaces.IProcessList = (*ProcessList)(nil)
@@ -168,6 +177,7 @@ func (p *ProcessList) Clear() {
 	p.NewEntriesMutex.Lock()
 	defer p.NewEntriesMutex.Unlock()
 	p.NewEntries = nil
+	p.correlationIDs = nil
 
 	p.AdminBlock = nil
 	p.EntryCreditBlock = nil
@@ -500,7 +510,7 @@ func (p *ProcessList) GetAckAt(vmIndex int, height int) *messages.Ack {
 	return vm.ListAck[height]
 }
 
-func (p ProcessList) HasMessage() bool {
+func (p *ProcessList) HasMessage() bool {
 	for i := 0; i < len(p.FedServers); i++ {
 		if len(p.VMs[i].List) > 0 {
 			return true
@@ -549,12 +559,33 @@ func (p *ProcessList) DeleteEBlocks(key interfaces.IHash) {
 func (p *ProcessList) AddNewEntry(key interfaces.IHash, value interfaces.IEntry) {
 	p.NewEntriesMutex.Lock()
 	defer p.NewEntriesMutex.Unlock()
+	p.unshareNewEntries()
 	p.NewEntries[key.Fixed()] = value
 }
 
+// Remembers the correlation ID of the reveal that brought in the entry key, for when it is saved.
+func (p *ProcessList) SetEntryCorrelationID(key interfaces.IHash, id string) {
+	if id == "" {
+		return
+	}
+	p.NewEntriesMutex.Lock()
+	defer p.NewEntriesMutex.Unlock()
+	if p.correlationIDs == nil {
+		p.correlationIDs = make(map[[32]byte]string)
+	}
+	p.correlationIDs[key.Fixed()] = id
+}
+
+func (p *ProcessList) GetEntryCorrelationID(key [32]byte) string {
+	p.NewEntriesMutex.RLock()
+	defer p.NewEntriesMutex.RUnlock()
+	return p.correlationIDs[key]
+}
+
 func (p *ProcessList) DeleteNewEntry(key interfaces.IHash) {
 	p.NewEntriesMutex.Lock()
 	defer p.NewEntriesMutex.Unlock()
+	p.unshareNewEntries()
 	delete(p.NewEntries, key.Fixed())
 }
 
@@ -595,9 +626,9 @@ func (p *ProcessList) CheckDiffSigTally() bool {
 	return true
 }
 
-func (p *ProcessList) GetRequest(now int64, vmIndex int, height int, waitSeconds int64) *Request {
+func (p *ProcessList) GetRequest(now int64, vmIndex int, height int, waitMillis int64) *Request {
 	r := new(Request)
-	r.wait = waitSeconds
+	r.wait = waitMillis
 	r.vmIndex = vmIndex
 	r.vmheight = uint32(height)
 
@@ -613,18 +644,16 @@ func (p *ProcessList) GetRequest(now int64, vmIndex int, height int, waitSeconds
 }
 
 // Return the number of times we have tripped an ask for this request.
-func (p *ProcessList) Ask(vmIndex int, height int, waitSeconds int64, tag int) int {
+func (p *ProcessList) Ask(vmIndex int, height int, waitMillis int64, tag int) int {
 	now := p.State.GetTimestamp().GetTimeMilli()
 
-	r := p.GetRequest(now, vmIndex, len(p.VMs[0].List), waitSeconds)
+	r := p.GetRequest(now, vmIndex, len(p.VMs[0].List), waitMillis)
 
 	if r == nil {
 		return 0
 	}
 
-	if now-r.sent >= waitSeconds*1000+500 && p.State.inMsgQueue.Length() < constants.INMSGQUEUE_MED {
-		missingMsgRequest := messages.NewMissingMsg(p.State, r.vmIndex, p.DBHeight, r.vmheight)
-
+	if now-r.sent >= waitMillis+500 && p.State.inMsgQueue.Length() < constants.INMSGQUEUE_MED {
 		// The System (handling full faults) is a special VM.  Let's guess it first.
 		vm := &p.System
 		if vmIndex >= 0 {
@@ -639,23 +668,48 @@ func (p *ProcessList) Ask(vmIndex int, height int, waitSeconds int64, tag int) i
 			}
 		}
 
-		missingMsgRequest.AddHeight(uint32(height))
-		// Okay, we are going to send one, so ask for all nil messages for this vm
+		// Okay, we are going to send one, so ask for everything from the first nil message for this
+		// vm through the next message, which won't hurt, in one ranged request.
+		start, end := uint32(height), uint32(len(vm.List))
 		for i := 0; i < len(vm.List); i++ {
 			if vm.List[i] == nil {
-				missingMsgRequest.AddHeight(uint32(i))
+				if uint32(i) < start {
+					start = uint32(i)
+				}
+				break
 			}
 		}
-		// Might as well as for the next message too.  Won't hurt.
-		missingMsgRequest.AddHeight(uint32(len(vm.List)))
-
+		if end < uint32(height) {
+			end = uint32(height)
+		}
+		if end-start >= messages.MaxMissingMsgRange {
+			end = start + messages.MaxMissingMsgRange - 1
+		}
+		rangeRequest := messages.NewMissingMsgRange(p.State, r.vmIndex, p.DBHeight, start, end)
 		if vmIndex < 0 {
-			missingMsgRequest.SystemHeight = uint32(p.System.Height)
+			rangeRequest.SystemHeight = uint32(p.System.Height)
 		}
-
-		missingMsgRequest.SendOut(p.State, missingMsgRequest)
+		rangeRequest.SendOut(p.State, rangeRequest)
 		p.State.MissingRequestAskCnt++
 
+		// Peers that don't know ranged requests drop them, so every third time we also ask the old
+		// way, one height at a time.
+		if r.requestCnt%3 == 2 {
+			missingMsgRequest := messages.NewMissingMsg(p.State, r.vmIndex, p.DBHeight, r.vmheight)
+			missingMsgRequest.AddHeight(uint32(height))
+			for i := 0; i < len(vm.List); i++ {
+				if vm.List[i] == nil {
+					missingMsgRequest.AddHeight(uint32(i))
+				}
+			}
+			missingMsgRequest.AddHeight(uint32(len(vm.List)))
+			if vmIndex < 0 {
+				missingMsgRequest.SystemHeight = uint32(p.System.Height)
+			}
+			missingMsgRequest.SendOut(p.State, missingMsgRequest)
+			p.State.MissingRequestAskCnt++
+		}
+
 		r.sent = now
 		r.requestCnt++
 	}
@@ -700,7 +754,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 	systemloop:
 		for i, f := range p.System.List[p.System.Height:] {
 			if f == nil {
-				p.Ask(-1, i, 10, 100)
+				p.Ask(-1, i, 10000, 100)
 				break systemloop
 			}
 
@@ -743,12 +797,12 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 
 		if vm.Height == len(vm.List) && p.State.Syncing && !vm.Synced {
 			// means that we are missing an EOM
-			p.Ask(i, vm.Height, 0, 1)
+			p.Ask(i, vm.Height, int64(p.State.GetEOMWait()/time.Millisecond), 1)
 		}
 
 		// If we haven't heard anything from a VM, ask for a message at the last-known height
 		if vm.Height == len(vm.List) {
-			p.Ask(i, vm.Height, 20, 2)
+			p.Ask(i, vm.Height, int64(p.State.GetAckTimeout()/time.Millisecond), 2)
 		}
 
 	VMListLoop:
@@ -772,7 +826,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 				if err != nil {
 					vm.List[j] = nil
 					//p.State.AddStatus(fmt.Sprintf("ProcessList.go Process: Error computing serial hash at dbht: %d vm %d  vm-height %d ", p.DBHeight, i, j))
-					p.Ask(i, j, 3, 4)
+					p.Ask(i, j, 3000, 4)
 					break VMListLoop
 				}
 
@@ -797,7 +851,9 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 
 					//fault(p, i, 0, vm, 0, j, 2)
 					//p.State.AddStatus(fmt.Sprintf("ProcessList.go Process: SerialHash fails to match at dbht %d vm %d vm-height %d ", p.DBHeight, i, j))
-					p.State.Reset()
+					if !p.Rollback() {
+						p.State.Reset()
+					}
 					return
 				}
 			}
@@ -813,6 +869,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 				p.NextHeightToProcess[i] = j + 1
 				msg := vm.List[j]
 				if msg.Process(p.DBHeight, state) { // Try and Process this entry
+					p.recordProcessed(msg)
 					vm.heartBeat = 0
 					vm.Height = j + 1 // Don't process it again if the process worked.
 
@@ -859,7 +916,7 @@ func (p *ProcessList) AddToSystemList(m interfaces.IMsg) bool {
 		//	p.System.Height,
 		//	int(fullFault.SystemHeight),
 		//	fullFault.String()))
-		p.State.Holding[m.GetMsgHash().Fixed()] = m
+		p.State.HoldOnSelf(m, HoldUnvalidated)
 		return false
 	}
 
@@ -1007,7 +1064,7 @@ func (p *ProcessList) AddToProcessList(ack *messages.Ack, m interfaces.IMsg) {
 	ack.SetPeer2Peer(false)
 	m.SetPeer2Peer(false)
 
-	ack.SendOut(p.State, ack)
+	p.State.sendAck(ack, m)
 	m.SendOut(p.State, m)
 
 	for len(vm.List) <= int(ack.Height) {
@@ -1136,6 +1193,8 @@ func (p *ProcessList) Reset() bool {
 
 	p.FactoidBalancesT = map[[32]byte]int64{}
 	p.ECBalancesT = map[[32]byte]int64{}
+	p.Checkpoints = nil
+	p.shared = sharedMaps{}
 
 	p.FedServers = append(p.FedServers[:0], previous.FedServers...)
 	p.AuditServers = append(p.AuditServers[:0], previous.AuditServers...)
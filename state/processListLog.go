@@ -0,0 +1,195 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A block's process list log is every message its VMs held when it was saved: where each was
+// acked, when, and when this node processed it.  A message acked but never processed, or
+// processed long after its ack, is what lies behind most reports of an entry that was acked but
+// isn't in its block.  The logs of the last ProcessListLogBlocks blocks built by this node are kept
+// in the database, and given by the v2 API's "process-list-log"; blocks synced from peers rather
+// than built have none.
+
+// A ProcessListLog as it is kept in the database
+type ProcessListLog struct {
+	interfaces.ProcessListLog
+}
+
+var _ interfaces.BinaryMarshallable = (*ProcessListLog)(nil)
+
+func (l *ProcessListLog) MarshalBinary() ([]byte, error) {
+	buf := primitives.NewBuffer(nil)
+
+	err := buf.PushUInt32(l.DBHeight)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.PushVarInt(uint64(len(l.Entries)))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range l.Entries {
+		for _, i := range []int{e.VM, e.Height, e.Minute} {
+			err = buf.PushInt(i)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, s := range []string{e.Type, e.MsgHash, e.Hash} {
+			err = buf.PushString(s)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = buf.PushInt64(e.Acked)
+		if err != nil {
+			return nil, err
+		}
+		err = buf.PushInt64(e.Processed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (l *ProcessListLog) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	buf := primitives.NewBuffer(p)
+
+	l.DBHeight, err = buf.PopUInt32()
+	if err != nil {
+		return
+	}
+	n, err := buf.PopVarInt()
+	if err != nil {
+		return
+	}
+	l.Entries = nil
+	for i := 0; i < int(n); i++ {
+		var e interfaces.ProcessListLogEntry
+		for _, dst := range []*int{&e.VM, &e.Height, &e.Minute} {
+			*dst, err = buf.PopInt()
+			if err != nil {
+				return
+			}
+		}
+		for _, dst := range []*string{&e.Type, &e.MsgHash, &e.Hash} {
+			*dst, err = buf.PopString()
+			if err != nil {
+				return
+			}
+		}
+		e.Acked, err = buf.PopInt64()
+		if err != nil {
+			return
+		}
+		e.Processed, err = buf.PopInt64()
+		if err != nil {
+			return
+		}
+		l.Entries = append(l.Entries, e)
+	}
+
+	newData = buf.DeepCopyBytes()
+	return
+}
+
+func (l *ProcessListLog) UnmarshalBinary(p []byte) error {
+	_, err := l.UnmarshalBinaryData(p)
+	return err
+}
+
+// recordProcessed notes when a message of the process list was processed, if logs are kept.  Only
+// the goroutine that processes and saves blocks touches the times.
+func (p *ProcessList) recordProcessed(msg interfaces.IMsg) {
+	if p.State.ProcessListLogBlocks == 0 {
+		return
+	}
+	if p.processedAt == nil {
+		p.processedAt = make(map[[32]byte]int64)
+	}
+	p.processedAt[msg.GetMsgHash().Fixed()] = p.State.ClockNow().UnixNano() / int64(time.Millisecond)
+}
+
+// log returns the process list log of the process list as it stands.
+func (p *ProcessList) log() *ProcessListLog {
+	l := new(ProcessListLog)
+	l.DBHeight = p.DBHeight
+	l.Entries = []interfaces.ProcessListLogEntry{}
+	for i, vm := range p.VMs {
+		for j, msg := range vm.List {
+			if msg == nil {
+				continue
+			}
+			e := interfaces.ProcessListLogEntry{VM: i, Height: j}
+			e.Type = messages.MessageName(msg.Type())
+			e.MsgHash = msg.GetMsgHash().String()
+			switch m := msg.(type) {
+			case *messages.CommitEntryMsg:
+				e.Hash = m.CommitEntry.EntryHash.String()
+			case *messages.CommitChainMsg:
+				e.Hash = m.CommitChain.EntryHash.String()
+			default:
+				e.Hash = msg.GetHash().String()
+			}
+			if j < len(vm.ListAck) && vm.ListAck[j] != nil {
+				e.Minute = int(vm.ListAck[j].Minute)
+				e.Acked = vm.ListAck[j].Timestamp.GetTimeMilli()
+			}
+			e.Processed = p.processedAt[msg.GetMsgHash().Fixed()]
+			l.Entries = append(l.Entries, e)
+		}
+	}
+	return l
+}
+
+// saveProcessListLog writes the log of the block at dbheight to the database as it is saved, and
+// drops the oldest kept.
+func (s *State) saveProcessListLog(dbheight uint32) {
+	kept := uint32(s.ProcessListLogBlocks)
+	if kept == 0 {
+		return
+	}
+	if pl := s.ProcessLists.GetSafe(dbheight); pl != nil {
+		if err := s.DB.SaveProcessListLog(dbheight, pl.log()); err != nil {
+			s.Logf("error", "Saving the process list log of block %d: %v", dbheight, err)
+		}
+	}
+	if dbheight >= kept {
+		s.DB.DeleteProcessListLog(dbheight - kept)
+	}
+}
+
+// GetProcessListLogs returns the process list logs kept of the blocks from one height to another.
+// Given a hash, only the messages with it as their message hash or hash are included.
+func (s *State) GetProcessListLogs(from uint32, to uint32, hash string) []interfaces.ProcessListLog {
+	logs := []interfaces.ProcessListLog{}
+	for height := from; height <= to && height >= from; height++ {
+		l, err := s.DB.FetchProcessListLog(height, new(ProcessListLog))
+		if err != nil || l == nil {
+			continue
+		}
+		log := l.(*ProcessListLog).ProcessListLog
+		if hash != "" {
+			entries := []interfaces.ProcessListLogEntry{}
+			for _, e := range log.Entries {
+				if e.MsgHash == hash || e.Hash == hash {
+					entries = append(entries, e)
+				}
+			}
+			log.Entries = entries
+		}
+		logs = append(logs, log)
+	}
+	return logs
+}
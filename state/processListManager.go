@@ -39,6 +39,7 @@ func (lists *ProcessLists) UpdateState(dbheight uint32) (progress bool) {
 		lists.DBHeightBase += uint32(diff)
 		var newlist []*ProcessList
 		for i := 0; i < diff; i++ {
+			lists.State.AddBlockMinutes(SummarizeProcessList(lists.Lists[i]))
 			lists.Lists[i].Clear()
 		}
 		newlist = append(newlist, lists.Lists[diff:]...)
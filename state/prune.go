@@ -0,0 +1,90 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync/atomic"
+)
+
+// A pruned node keeps the factoid blocks of only its last PruneWindow directory blocks.  Balances
+// are all a node needs to validate new factoid transactions, and the snapshot carries them, so
+// once a snapshot is on disk the factoid blocks behind it are only history.  Everything else (the
+// directory, admin, entry credit and entry blocks, and the entries) is kept.
+//
+// What we give up:
+//   - We can only boot from the snapshot.  LoadDatabase replays the blocks from 10 below it, so
+//     we never prune those; if the snapshot is lost, the node has to sync from scratch.
+//   - We can't answer for factoid transactions, or send peers DBStates, below PrunedHeight.
+//   - The balance audit, which replays every factoid block from genesis, can't run.
+//
+// Pruned factoid blocks are always the blocks below some height, so we find where the last run
+// stopped by searching for the lowest factoid block we still have.
+
+const MinPruneWindow = 100 // The fewest directory blocks of factoid history a pruned node keeps
+
+// pruneTarget returns the height we can prune up to, but not including.
+func (s *State) pruneTarget() uint32 {
+	snapshot := s.StateSaverStruct.SnapshotHeight
+	saved := s.GetHighestSavedBlk()
+	if s.PruneWindow == 0 || snapshot < 11 || saved < s.PruneWindow {
+		return 0
+	}
+	target := snapshot - 11 // LoadDatabase starts 10 below DBHeightComplete, one below the snapshot
+	if saved-s.PruneWindow < target {
+		target = saved - s.PruneWindow
+	}
+	return target
+}
+
+// findPrunedHeight returns the height of the lowest factoid block in the database.
+func (s *State) findPrunedHeight(top uint32) uint32 {
+	return uint32(sort.Search(int(top), func(i int) bool {
+		fblk, err := s.DB.FetchFBlockByHeight(uint32(i))
+		return err != nil || fblk != nil
+	}))
+}
+
+// PruneFactoidBlocks deletes the factoid blocks we no longer need, in the background.  It is
+// called each time a block is saved; if the last call is still pruning, it does nothing.
+func (s *State) PruneFactoidBlocks() {
+	if s.PruneWindow == 0 || !atomic.CompareAndSwapInt32(&s.pruning, 0, 1) {
+		return
+	}
+	target := s.pruneTarget()
+	if target == 0 || target <= s.PrunedHeight {
+		atomic.StoreInt32(&s.pruning, 0)
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&s.pruning, 0)
+		if err := s.pruneTo(target); err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("%20s Error pruning factoid blocks: %s\n", s.FactomNodeName, err.Error()))
+		}
+	}()
+}
+
+func (s *State) pruneTo(target uint32) error {
+	if s.PrunedHeight == 0 {
+		s.PrunedHeight = s.findPrunedHeight(target)
+	}
+	for s.PrunedHeight < target {
+		dblk, err := s.DB.FetchDBlockByHeight(s.PrunedHeight)
+		if err != nil {
+			return err
+		}
+		if dblk == nil {
+			return fmt.Errorf("no directory block at %d", s.PrunedHeight)
+		}
+		err = s.DB.DeleteFBlock(dblk.GetDBEntries()[2].GetKeyMR())
+		if err != nil {
+			return err
+		}
+		s.PrunedHeight++
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// waitForPrune waits for the background prune to reach height.
+func waitForPrune(s *state.State, height uint32) bool {
+	for i := 0; i < 100; i++ {
+		if s.PrunedHeight >= height {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestPruneFactoidBlocks(t *testing.T) {
+	count := testHelper.BlockCount
+	testHelper.BlockCount = 20
+	defer func() { testHelper.BlockCount = count }()
+
+	s := testHelper.CreateAndPopulateSavedTestState()
+	saved := s.GetHighestSavedBlk()
+	if saved < 15 {
+		t.Fatalf("Expected at least 15 saved blocks, have %d", saved)
+	}
+
+	// Nothing is pruned until there is a snapshot
+	s.PruneWindow = 5
+	s.PruneFactoidBlocks()
+	time.Sleep(50 * time.Millisecond)
+	if s.PrunedHeight != 0 {
+		t.Errorf("Pruned to %d without a snapshot", s.PrunedHeight)
+	}
+
+	// The blocks LoadDatabase replays from the snapshot are kept, however small the window
+	s.StateSaverStruct.SnapshotHeight = saved
+	target := saved - 11
+	s.PruneFactoidBlocks()
+	if !waitForPrune(s, target) {
+		t.Fatalf("Expected to prune up to %d, pruned up to %d", target, s.PrunedHeight)
+	}
+	if s.PrunedHeight != target {
+		t.Errorf("Expected to prune up to %d, pruned up to %d", target, s.PrunedHeight)
+	}
+
+	for i := uint32(0); i <= saved; i++ {
+		fblk, err := s.DB.FetchFBlockByHeight(i)
+		if err != nil {
+			t.Error(err)
+		}
+		if i < target && fblk != nil {
+			t.Errorf("The factoid block at %d was not pruned", i)
+		}
+		if i >= target && fblk == nil {
+			t.Errorf("The factoid block at %d was pruned", i)
+		}
+	}
+	if _, err := s.LoadDBState(target - 1); err == nil {
+		t.Errorf("Loaded a DBState for a pruned height")
+	}
+
+	// After a restart we find where we left off, and carry on from there
+	s.PrunedHeight = 0
+	s.PruneWindow = 8
+	s.PruneFactoidBlocks()
+	if !waitForPrune(s, target) {
+		t.Errorf("Expected to find we had pruned up to %d, have %d", target, s.PrunedHeight)
+	}
+}
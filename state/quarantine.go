@@ -0,0 +1,154 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/util"
+)
+
+// Entry spam is cheap to send and costly to hold, so commits are scored as they come in, and
+// those scoring SpamThreshold or more are quarantined rather than admitted to the InMsgQueue.  A
+// commit scores for:
+//
+//	2  paying with a key that doesn't have the credits (it may yet, so it isn't invalid)
+//	1  committing an entry already committed this minute
+//	1  paying with a key that has made more than SpamKeyRate commits this minute
+//
+// The quarantine is a queue of QuarantineSize commits, moved on into the InMsgQueue only while
+// that has spare room, and dropped when full.  The operator's own submissions (see
+// PriorityLaneShare) are never quarantined.  The operator can look at and change the threshold,
+// and drain or inject into the quarantine, through the debug API.
+
+const (
+	DefaultSpamKeyRate    = 60
+	DefaultQuarantineSize = 1000
+)
+
+type spamScorer struct {
+	mutex    sync.Mutex
+	minute   int64
+	keys     map[[32]byte]int // Commits this minute, by paying key
+	payloads map[[32]byte]int // Commits this minute, by entry hash
+}
+
+func newSpamScorer() *spamScorer {
+	sc := new(spamScorer)
+	sc.keys = make(map[[32]byte]int)
+	sc.payloads = make(map[[32]byte]int)
+	return sc
+}
+
+// score counts the commit, and returns its score.  balance is the paying key's balance.
+func (sc *spamScorer) score(key, entryHash [32]byte, credits int64, balance int64, keyRate int, now time.Time) int {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	if minute := now.Unix() / 60; minute != sc.minute {
+		sc.minute = minute
+		sc.keys = make(map[[32]byte]int)
+		sc.payloads = make(map[[32]byte]int)
+	}
+	sc.keys[key]++
+	sc.payloads[entryHash]++
+
+	score := 0
+	if balance < credits {
+		score += 2
+	}
+	if sc.payloads[entryHash] > 1 {
+		score++
+	}
+	if sc.keys[key] > keyRate {
+		score++
+	}
+	return score
+}
+
+// SpamScore returns the score of a commit, counting it towards the scores of later ones; 0 for
+// other messages.
+func (s *State) SpamScore(msg interfaces.IMsg) int {
+	var key, entryHash [32]byte
+	var credits int64
+	switch m := msg.(type) {
+	case *messages.CommitEntryMsg:
+		if m.CommitEntry == nil || m.CommitEntry.ECPubKey == nil || m.CommitEntry.EntryHash == nil {
+			return 0
+		}
+		key, entryHash, credits = m.CommitEntry.ECPubKey.Fixed(), m.CommitEntry.EntryHash.Fixed(), int64(m.CommitEntry.Credits)
+	case *messages.CommitChainMsg:
+		if m.CommitChain == nil || m.CommitChain.ECPubKey == nil || m.CommitChain.EntryHash == nil {
+			return 0
+		}
+		key, entryHash, credits = m.CommitChain.ECPubKey.Fixed(), m.CommitChain.EntryHash.Fixed(), int64(m.CommitChain.Credits)
+	default:
+		return 0
+	}
+	return s.spam.score(key, entryHash, credits, s.GetE(true, key), s.SpamKeyRate, s.ClockNow())
+}
+
+// Quarantine returns true if msg is a commit scoring SpamThreshold or more, having put it in the
+// quarantine, or dropped it if the quarantine is full.
+func (s *State) Quarantine(msg interfaces.IMsg) bool {
+	if s.SpamThreshold <= 0 || msg.IsPriority() {
+		return false
+	}
+	score := s.SpamScore(msg)
+	if score < s.SpamThreshold {
+		return false
+	}
+	select {
+	case s.quarantine <- msg:
+		QuarantineQueued.Inc()
+	default:
+		QuarantineDropped.Inc()
+	}
+	s.Logf("debug", "[%s] quarantined %s, spam score %d", msg.GetCorrelationID(), msg.GetMsgHash().String(), score)
+	return true
+}
+
+// ReleaseQuarantine moves quarantined commits on into the InMsgQueue while it is less than a
+// quarter full.
+func (s *State) ReleaseQuarantine() {
+	q := s.InMsgQueue()
+	for q.Length() < q.Cap()/4 {
+		select {
+		case msg := <-s.quarantine:
+			q.Enqueue(msg)
+			QuarantineReleased.Inc()
+		default:
+			QuarantineLength.Set(0)
+			return
+		}
+	}
+	QuarantineLength.Set(float64(len(s.quarantine)))
+}
+
+// configureSpam sets the spam threshold, the commits a key may make in a minute, and the size of
+// the quarantine.
+func (s *State) configureSpam(cfg *util.FactomdConfig) {
+	s.SpamThreshold = cfg.App.SpamThreshold
+	s.SpamKeyRate = DefaultSpamKeyRate
+	if cfg.App.SpamKeyRate > 0 {
+		s.SpamKeyRate = cfg.App.SpamKeyRate
+	}
+	s.QuarantineSize = DefaultQuarantineSize
+	if cfg.App.QuarantineSize > 0 {
+		s.QuarantineSize = cfg.App.QuarantineSize
+	}
+}
+
+func (s *State) GetSpamThreshold() int {
+	return s.SpamThreshold
+}
+
+// SetSpamThreshold sets the score at which commits are quarantined; 0 quarantines none.
+func (s *State) SetSpamThreshold(threshold int) {
+	s.SpamThreshold = threshold
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestQuarantine(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	// Paid for by a key with no credits
+	commit := func(entry string) *messages.CommitEntryMsg {
+		m := messages.NewCommitEntryMsg()
+		m.CommitEntry = entryCreditBlock.NewCommitEntry()
+		m.CommitEntry.EntryHash = primitives.Sha([]byte(entry))
+		m.CommitEntry.Credits = 1
+		return m
+	}
+
+	if score := s.SpamScore(commit("a")); score != 2 {
+		t.Errorf("An unfunded commit scored %d, expected 2", score)
+	}
+	if score := s.SpamScore(commit("a")); score != 3 {
+		t.Errorf("A repeated unfunded commit scored %d, expected 3", score)
+	}
+
+	if !s.AdmitMsg(commit("b")) {
+		t.Errorf("A commit was quarantined with no spam threshold")
+	}
+
+	s.SetSpamThreshold(2)
+	if s.AdmitMsg(commit("c")) {
+		t.Errorf("An unfunded commit was admitted")
+	}
+	operator := commit("d")
+	operator.SetPriority(true)
+	if !s.AdmitMsg(operator) {
+		t.Errorf("The operator's commit was quarantined")
+	}
+	if n, _ := s.QueueLength("quarantine"); n != 1 {
+		t.Errorf("The quarantine has %d commits, expected 1", n)
+	}
+
+	before := s.InMsgQueue().Length()
+	s.ReleaseQuarantine()
+	if n, _ := s.QueueLength("quarantine"); n != 0 {
+		t.Errorf("The quarantine has %d commits after its release, expected none", n)
+	}
+	if s.InMsgQueue().Length() != before+1 {
+		t.Errorf("The quarantined commit wasn't released into the InMsgQueue")
+	}
+}
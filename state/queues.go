@@ -93,7 +93,7 @@ func measureMessage(channel IPrometheusChannel, msg interfaces.IMsg, increment b
 	switch msg.Type() {
 	case constants.EOM_MSG: // 1
 		channel.EOM(increment)
-	case constants.ACK_MSG: // 2
+	case constants.ACK_MSG, constants.ACK_BATCH_MSG: // 2
 		channel.ACK(increment)
 	case constants.AUDIT_SERVER_FAULT_MSG: // 3
 		channel.AudFault(increment)
@@ -101,9 +101,9 @@ func measureMessage(channel IPrometheusChannel, msg interfaces.IMsg, increment b
 		channel.FedFault(increment)
 	case constants.FULL_SERVER_FAULT_MSG: // 5
 		channel.FullFault(increment)
-	case constants.COMMIT_CHAIN_MSG: // 6
+	case constants.COMMIT_CHAIN_MSG, constants.BOUND_COMMIT_CHAIN_MSG: // 6
 		channel.CommitChain(increment)
-	case constants.COMMIT_ENTRY_MSG: // 7
+	case constants.COMMIT_ENTRY_MSG, constants.BATCH_COMMIT_ENTRY_MSG: // 7
 		channel.CommitEntry(increment)
 	case constants.DIRECTORY_BLOCK_SIGNATURE_MSG: // 8
 		channel.DBSig(increment)
@@ -115,9 +115,9 @@ func measureMessage(channel IPrometheusChannel, msg interfaces.IMsg, increment b
 		channel.Heartbeat(increment)
 	case constants.INVALID_DIRECTORY_BLOCK_MSG: // 12
 		channel.EtcdHashPickup(increment)
-	case constants.MISSING_MSG: // 13
+	case constants.MISSING_MSG, constants.MISSING_MSG_RANGE: // 13
 		channel.MissingMsg(increment)
-	case constants.MISSING_MSG_RESPONSE: // 14
+	case constants.MISSING_MSG_RESPONSE, constants.MISSING_MSG_BATCH: // 14
 		channel.MissingMsgResp(increment)
 	case constants.MISSING_DATA: // 15
 		channel.MissingData(increment)
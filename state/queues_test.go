@@ -242,3 +242,41 @@ func BenchmarkCompetingQueues(b *testing.B) {
 		c.Dequeue()
 	}
 }
+
+func TestPriorityInMsgQueue(t *testing.T) {
+	q := NewPriorityInMsgQueue(1000)
+
+	// While the queue is shallow, first in first out
+	q.Enqueue(new(messages.CommitEntryMsg))
+	q.Enqueue(new(messages.EOM))
+	if _, ok := q.Dequeue().(*messages.CommitEntryMsg); !ok {
+		t.Error("Exp the commit first while the queue is shallow")
+	}
+	if _, ok := q.Dequeue().(*messages.EOM); !ok {
+		t.Error("Exp the EOM second")
+	}
+
+	// Once it is deep, the messages the leaders wait on skip the line
+	for i := 0; i < InMsgPriorityDepth; i++ {
+		q.Enqueue(new(messages.RevealEntryMsg))
+	}
+	q.Enqueue(new(messages.Ack))
+	q.Enqueue(new(messages.CommitEntryMsg))
+	if q.Length() != InMsgPriorityDepth+2 || q.CriticalLength() != 1 {
+		t.Errorf("Exp %d messages, 1 critical, got %d, %d", InMsgPriorityDepth+2, q.Length(), q.CriticalLength())
+	}
+	if _, ok := q.BlockingDequeue().(*messages.Ack); !ok {
+		t.Error("Exp the ack first while the queue is deep")
+	}
+	for i := 0; i < InMsgPriorityDepth; i++ {
+		if _, ok := q.Dequeue().(*messages.RevealEntryMsg); !ok {
+			t.Fatal("Exp the reveals in order")
+		}
+	}
+	if _, ok := q.Dequeue().(*messages.CommitEntryMsg); !ok {
+		t.Error("Exp the commit last")
+	}
+	if q.Dequeue() != nil || q.Length() != 0 {
+		t.Error("Exp the queue empty")
+	}
+}
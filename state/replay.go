@@ -12,6 +12,7 @@ import (
 
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/common/primitives/random"
 )
@@ -27,6 +28,7 @@ type Replay struct {
 	Buckets  [numBuckets]map[[32]byte]int
 	Basetime int // hours since 1970
 	Center   int // Hour of the current time.
+	Window   int // Minutes either side of now a timestamp may be; Range if 0.  Not marshaled
 }
 
 var _ interfaces.BinaryMarshallable = (*Replay)(nil)
@@ -157,9 +159,80 @@ func (r *Replay) Save() *Replay {
 	}
 	newr.Basetime = r.Basetime
 	newr.Center = r.Center
+	newr.Window = r.Window
 	return newr
 }
 
+// Merge adds the hashes seen in o to r.  The buckets are lined up by their minute;
+// anything in o that falls outside of the range r covers is dropped.
+func (r *Replay) Merge(o *Replay) {
+	o = o.Save()
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+
+	if r.Center == 0 {
+		r.Buckets = o.Buckets
+		r.Basetime = o.Basetime
+		r.Center = o.Center
+		return
+	}
+	for i, b := range o.Buckets {
+		index := o.Basetime + i - r.Basetime
+		if index < 0 || index >= numBuckets {
+			continue
+		}
+		if r.Buckets[index] == nil {
+			r.Buckets[index] = make(map[[32]byte]int)
+		}
+		for k, v := range b {
+			r.Buckets[index][k] = r.Buckets[index][k] | v
+		}
+	}
+}
+
+// SaveReplayFilter writes the replay filter to the database, so a restarted node doesn't
+// accept (and send out again) the messages it processed before it went down.
+func (s *State) SaveReplayFilter() error {
+	return s.DB.SaveReplayFilter(s.Replay.Save())
+}
+
+// LoadReplayFilter merges the replay filter saved by SaveReplayFilter into ours.
+func (s *State) LoadReplayFilter() error {
+	filter, err := s.DB.FetchReplayFilter(new(Replay))
+	if err != nil || filter == nil {
+		return err
+	}
+	s.Replay.Merge(filter.(*Replay))
+	return nil
+}
+
+// The replay filters a message can be dropped by, as labelled in ReplayDuplicates and ReplayExpired
+const (
+	ReplayFilterNetwork  = "network"  // Messages from peers and the API, as they come in
+	ReplayFilterInternal = "internal" // Messages as they are executed
+)
+
+// CountReplayDrop counts a message the replay filter dropped, by its type, as a duplicate or, if
+// its timestamp is outside the replay window, as expired.  It returns which of the two it was.
+func (s *State) CountReplayDrop(filter string, msg interfaces.IMsg) interfaces.ValidationReason {
+	name := messages.MessageName(msg.Type())
+	if _, inTime := s.Replay.Valid(constants.TIME_TEST, msg.GetRepeatHash().Fixed(), msg.GetTimestamp(), s.GetTimestamp()); !inTime {
+		ReplayExpired.WithLabelValues(filter, name).Inc()
+		return interfaces.ReasonExpired
+	}
+	ReplayDuplicates.WithLabelValues(filter, name).Inc()
+	return interfaces.ReasonReplay
+}
+
+// window returns the minutes either side of now a timestamp may be.  The buckets only cover
+// Range, so the window can be narrowed, but not widened past it.
+func (r *Replay) window() int {
+	if r.Window <= 0 || r.Window > Range {
+		return Range
+	}
+	return r.Window
+}
+
 // Remember that Unix time is in seconds since 1970.  This code
 // wants to be handed time in seconds.
 func Minutes(unix int64) int {
@@ -175,7 +248,7 @@ func (r *Replay) Valid(mask int, hash [32]byte, timestamp interfaces.Timestamp,
 	diff := now - t
 	// Check the timestamp to see if within 12 hours of the system time.  That not valid, we are
 	// just done without any added concerns.
-	if diff > Range || diff < -Range {
+	if window := r.window(); diff > window || diff < -window {
 		//fmt.Println("Time in hours, range:", hours(timeSeconds-systemTimeSeconds), HourRange)
 		return -1, false
 	}
@@ -231,7 +304,9 @@ func (r *Replay) Valid(mask int, hash [32]byte, timestamp interfaces.Timestamp,
 // this code remembers hashes tested in the past, and rejects the
 // second submission of the same hash.
 func (r *Replay) IsTSValid(mask int, hash interfaces.IHash, timestamp interfaces.Timestamp) bool {
-	return r.IsTSValid_(mask, hash.Fixed(), timestamp, primitives.NewTimestampNow())
+	now := primitives.GetPooledTimestamp()
+	defer primitives.PutPooledTimestamp(now)
+	return r.IsTSValid_(mask, hash.Fixed(), timestamp, now)
 }
 
 // To make the function testable, the logic accepts the current time
@@ -0,0 +1,57 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+)
+
+// The replay bounds are for an operator getting a wedged node going again without hand-editing
+// its database.  Both trade some of the checking a node does for availability, so neither is for
+// everyday use, and both are announced loudly whenever they are set or take effect.
+//
+// -replay-from-height=N has the node boot from no saved state (the FastBoot file or a snapshot)
+// past N, so that the blocks from no later than N on are replayed from the database again, for
+// when what a saved state holds is what wedges the node.
+//
+// -skip-validation-until=H has the blocks up to H in our own database used even when they fail
+// validation: one that doesn't link to the block before it is logged rather than stopping the
+// boot.  It is no help against peers; the DBStates they send are always validated in full, or
+// anyone could feed a recovering node forged blocks.
+
+// SetReplayFromHeight has the node boot from no saved state past dbheight.  Call before Init.
+func (s *State) SetReplayFromHeight(dbheight uint32) {
+	s.StateSaverStruct.LoadBelow = dbheight + 1
+}
+
+// SkipsValidation is true if blocks at dbheight are used even if they fail validation.
+func (s *State) SkipsValidation(dbheight uint32) bool {
+	return dbheight > 0 && dbheight <= s.SkipValidationUntil
+}
+
+// ReplayBoundsWarning is the warning to print at startup for the replay bounds set; empty if none.
+func (s *State) ReplayBoundsWarning() string {
+	warning := ""
+	if s.StateSaverStruct.LoadBelow > 0 {
+		warning += fmt.Sprintf("WARNING: -replay-from-height=%d: saved states past it are ignored, and the database is replayed from no later than there.\n",
+			s.StateSaverStruct.LoadBelow-1)
+	}
+	if s.SkipValidationUntil > 0 {
+		warning += fmt.Sprintf("WARNING: -skip-validation-until=%d: blocks up to there are used EVEN IF THEY FAIL VALIDATION.  Remove it once the node is synced.\n",
+			s.SkipValidationUntil)
+	}
+	if warning == "" {
+		return ""
+	}
+	return "\n******************************************************************************\n" + warning +
+		"******************************************************************************\n\n"
+}
+
+func (s *State) warnSkippedValidation(dbheight uint32, reason string) {
+	os.Stderr.WriteString(fmt.Sprintf("%20s WARNING: block %d failed validation, used anyway as -skip-validation-until=%d: %s\n",
+		s.FactomNodeName, dbheight, s.SkipValidationUntil, reason))
+	s.Logf("warning", "Block %d failed validation, used anyway as -skip-validation-until=%d: %s", dbheight, s.SkipValidationUntil, reason)
+}
@@ -0,0 +1,44 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestSkipsValidation(t *testing.T) {
+	s := new(State)
+	if s.SkipsValidation(10) || s.ReplayBoundsWarning() != "" {
+		t.Error("Nothing is skipped unless asked for")
+	}
+
+	s.SkipValidationUntil = 10
+	if !s.SkipsValidation(1) || !s.SkipsValidation(10) {
+		t.Error("Expected the blocks up to 10 to skip validation")
+	}
+	if s.SkipsValidation(0) || s.SkipsValidation(11) {
+		t.Error("Genesis and the blocks past 10 are validated")
+	}
+	if s.ReplayBoundsWarning() == "" {
+		t.Error("Expected a warning")
+	}
+}
+
+func TestSetReplayFromHeight(t *testing.T) {
+	s := new(State)
+	s.SetReplayFromHeight(0)
+	if s.StateSaverStruct.LoadBelow != 1 {
+		t.Errorf("Expected no saved state to be loaded, got LoadBelow %d", s.StateSaverStruct.LoadBelow)
+	}
+	s.SetReplayFromHeight(5000)
+	if s.StateSaverStruct.LoadBelow != 5001 {
+		t.Errorf("Expected saved states up to 5000 to be loaded, got LoadBelow %d", s.StateSaverStruct.LoadBelow)
+	}
+	if s.ReplayBoundsWarning() == "" {
+		t.Error("Expected a warning")
+	}
+}
@@ -177,3 +177,61 @@ func TestMarshalUnmarshalReplay(t *testing.T) {
 		}
 	}
 }
+
+func TestReplayMerge(t *testing.T) {
+	now := primitives.NewTimestampNow()
+	h1 := primitives.RandomHash().Fixed()
+	h2 := primitives.RandomHash().Fixed()
+
+	old := new(Replay)
+	if !old.IsTSValid_(constants.NETWORK_REPLAY, h1, now, now) {
+		t.Fatal("First sighting of a hash should be valid")
+	}
+
+	// Merging into an empty filter takes everything
+	r1 := new(Replay)
+	r1.Merge(old)
+	if r1.IsTSValid_(constants.NETWORK_REPLAY, h1, now, now) {
+		t.Error("Merged hash should not be valid again")
+	}
+
+	// Merging into a filter that is in use keeps both
+	r2 := new(Replay)
+	if !r2.IsTSValid_(constants.NETWORK_REPLAY, h2, now, now) {
+		t.Fatal("First sighting of a hash should be valid")
+	}
+	r2.Merge(old)
+	if r2.IsTSValid_(constants.NETWORK_REPLAY, h1, now, now) {
+		t.Error("Merged hash should not be valid again")
+	}
+	if r2.IsTSValid_(constants.NETWORK_REPLAY, h2, now, now) {
+		t.Error("Existing hash should not be valid again")
+	}
+}
+
+func TestReplayWindow(t *testing.T) {
+	now := primitives.NewTimestampNow()
+	old := primitives.NewTimestampFromMilliseconds(uint64(now.GetTimeMilli() - 30*60*1000))
+
+	r := new(Replay)
+	if !r.IsTSValid_(constants.NETWORK_REPLAY, primitives.RandomHash().Fixed(), old, now) {
+		t.Error("A message 30 minutes old should be in the default window")
+	}
+
+	r = new(Replay)
+	r.Window = 10
+	if r.IsTSValid_(constants.NETWORK_REPLAY, primitives.RandomHash().Fixed(), old, now) {
+		t.Error("A message 30 minutes old should be outside a 10 minute window")
+	}
+	if r.Save().Window != 10 {
+		t.Error("A copy of the filter should keep its window")
+	}
+
+	// The buckets only cover Range, so a wider window is taken as Range
+	r = new(Replay)
+	r.Window = Range * 2
+	older := primitives.NewTimestampFromMilliseconds(uint64(now.GetTimeMilli() - int64(Range+5)*60*1000))
+	if r.IsTSValid_(constants.NETWORK_REPLAY, primitives.RandomHash().Fixed(), older, now) {
+		t.Error("A message older than Range should be outside any window")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/util"
+)
+
+// A reveal is checked against the commit paying for it before it can go into a process list.  The
+// commit must pay at least util.EntryCost of the entry, and 10 entry credits more if it makes a
+// chain.  A reveal its commit doesn't pay enough for is held, as a commit paying more can still
+// replace that one (see commitReplacement.go), and the shortfall is kept for the entry's ack to
+// report.  An entry too big to be paid for at all is rejected then and there, and the reason is
+// kept with the invalid messages.
+
+// CheckRevealCost returns Valid if the commit pays for the entry, Pending if it pays too little,
+// and Invalid if nothing could; and records why for anything but Valid.
+func (s *State) CheckRevealCost(entry interfaces.IEBEntry, commit interfaces.IMsg) interfaces.ValidationResult {
+	v, err := revealCost(entry, commit)
+	if err == nil {
+		s.SetPendingReason(entry.GetHash(), "")
+		return v
+	}
+	if v.Code < 0 {
+		s.SetInvalidReason(entry.GetHash(), err.Error())
+	} else {
+		s.SetPendingReason(entry.GetHash(), err.Error())
+	}
+	s.Logf("debug", "Reveal of entry %x %s: %s", entry.GetHash().Bytes()[:4], v.String(), err.Error())
+	return v
+}
+
+func revealCost(entry interfaces.IEBEntry, commit interfaces.IMsg) (interfaces.ValidationResult, error) {
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		return interfaces.Invalid(interfaces.ReasonMalformed), err
+	}
+	cost, err := util.EntryCost(data)
+	if err != nil {
+		return interfaces.Invalid(interfaces.ReasonMalformed), err
+	}
+
+	var paid int
+	switch c := commit.(type) {
+	case *messages.CommitEntryMsg:
+		paid = int(c.CommitEntry.Credits)
+	case *messages.CommitChainMsg:
+		paid = int(c.CommitChain.Credits) - 10
+	default:
+		return interfaces.Invalid(interfaces.ReasonMalformed), fmt.Errorf("Not paid for by a commit")
+	}
+	if paid < int(cost) {
+		return interfaces.Pending(interfaces.ReasonUnderpaid), fmt.Errorf("The entry costs %d entry credits, but its commit paid %d for it", cost, paid)
+	}
+	return interfaces.Valid(), nil
+}
+
+// SetInvalidReason records why the message with this hash was found invalid.
+func (s *State) SetInvalidReason(hash interfaces.IHash, reason string) {
+	s.InvalidMessagesMutex.Lock()
+	defer s.InvalidMessagesMutex.Unlock()
+
+	s.InvalidReasons[hash.Fixed()] = reason
+}
+
+// GetInvalidReason returns why the message with this hash was found invalid, if we know.
+func (s *State) GetInvalidReason(hash interfaces.IHash) string {
+	if hash == nil {
+		return ""
+	}
+
+	s.InvalidMessagesMutex.RLock()
+	defer s.InvalidMessagesMutex.RUnlock()
+
+	return s.InvalidReasons[hash.Fixed()]
+}
+
+// SetPendingReason records why the message with this hash is held; an empty reason forgets it.
+func (s *State) SetPendingReason(hash interfaces.IHash, reason string) {
+	s.InvalidMessagesMutex.Lock()
+	defer s.InvalidMessagesMutex.Unlock()
+
+	if reason == "" {
+		delete(s.PendingReasons, hash.Fixed())
+		return
+	}
+	s.PendingReasons[hash.Fixed()] = reason
+}
+
+// GetPendingReason returns why the message with this hash is held, if we know.
+func (s *State) GetPendingReason(hash interfaces.IHash) string {
+	if hash == nil {
+		return ""
+	}
+
+	s.InvalidMessagesMutex.RLock()
+	defer s.InvalidMessagesMutex.RUnlock()
+
+	return s.PendingReasons[hash.Fixed()]
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestCheckRevealCost(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	entry := entryBlock.NewEntry()
+	entry.ChainID = primitives.Sha([]byte("chain"))
+	entry.Content = primitives.ByteSlice{Bytes: make([]byte, 1500)} // 2 entry credits
+
+	commitEntry := func(credits uint8) *messages.CommitEntryMsg {
+		c := messages.NewCommitEntryMsg()
+		c.CommitEntry = entryCreditBlock.NewCommitEntry()
+		c.CommitEntry.Credits = credits
+		return c
+	}
+	commitChain := func(credits uint8) *messages.CommitChainMsg {
+		c := new(messages.CommitChainMsg)
+		c.CommitChain = entryCreditBlock.NewCommitChain()
+		c.CommitChain.Credits = credits
+		return c
+	}
+
+	if v := s.CheckRevealCost(entry, commitEntry(1)); v.Code != 0 || v.Reason != interfaces.ReasonUnderpaid {
+		t.Errorf("An entry paid for with too few entry credits wasn't held, got %v", v)
+	}
+	if v := s.CheckRevealCost(entry, commitChain(11)); v.Code != 0 {
+		t.Errorf("A chain paid for with too few entry credits wasn't held, got %v", v)
+	}
+	if reason := s.GetPendingReason(entry.GetHash()); reason == "" {
+		t.Errorf("No reason was recorded for holding the entry")
+	}
+
+	if v := s.CheckRevealCost(entry, commitEntry(2)); v.Code != 1 {
+		t.Errorf("A paid for entry was refused, got %v", v)
+	}
+	if v := s.CheckRevealCost(entry, commitChain(12)); v.Code != 1 {
+		t.Errorf("A paid for chain was refused, got %v", v)
+	}
+	if reason := s.GetPendingReason(entry.GetHash()); reason != "" {
+		t.Errorf("A paid for entry is held because %q", reason)
+	}
+
+	big := entryBlock.NewEntry()
+	big.ChainID = entry.ChainID
+	big.Content = primitives.ByteSlice{Bytes: make([]byte, 10241)}
+	if v := s.CheckRevealCost(big, commitEntry(10)); v.Code != -1 {
+		t.Errorf("An entry over 10KiB wasn't rejected, got %v", v)
+	}
+	if reason := s.GetInvalidReason(big.GetHash()); reason == "" {
+		t.Errorf("No reason was recorded for rejecting the entry")
+	}
+}
@@ -327,19 +327,8 @@ func SaveFactomdState(state *State, d *DBState) (ss *SaveState) {
 	ss.FedServers = append(ss.FedServers, pl.FedServers...)
 	ss.AuditServers = append(ss.AuditServers, pl.AuditServers...)
 
-	state.FactoidBalancesPMutex.Lock()
-	ss.FactoidBalancesP = make(map[[32]byte]int64)
-	for k := range state.FactoidBalancesP {
-		ss.FactoidBalancesP[k] = state.FactoidBalancesP[k]
-	}
-	state.FactoidBalancesPMutex.Unlock()
-
-	state.ECBalancesPMutex.Lock()
-	ss.ECBalancesP = make(map[[32]byte]int64)
-	for k := range state.ECBalancesP {
-		ss.ECBalancesP[k] = state.ECBalancesP[k]
-	}
-	state.ECBalancesPMutex.Unlock()
+	ss.FactoidBalancesP = state.FactoidBalancesP.Copy()
+	ss.ECBalancesP = state.ECBalancesP.Copy()
 
 	ss.Identities = append(ss.Identities, state.Identities...)
 	ss.Authorities = append(ss.Authorities, state.Authorities...)
@@ -465,6 +454,7 @@ func (ss *SaveState) TrimBack(state *State, d *DBState) {
 	state.Syncing = pss.Syncing
 
 	state.Replay = pss.Replay.Save()
+	state.Replay.Window = state.ReplayWindow
 
 	return
 	/*
@@ -583,6 +573,7 @@ func (ss *SaveState) RestoreFactomdState(state *State) { //, d *DBState) {
 	//state.AddStatus(fmt.Sprintf("SAVESTATE Restoring the State to dbht: %d", ss.DBHeight))
 
 	state.Replay = ss.Replay.Save()
+	state.Replay.Window = state.ReplayWindow
 	state.LeaderTimestamp = ss.LeaderTimestamp
 
 	pl.FedServers = []interfaces.IServer{}
@@ -590,19 +581,8 @@ func (ss *SaveState) RestoreFactomdState(state *State) { //, d *DBState) {
 	pl.FedServers = append(pl.FedServers, ss.FedServers...)
 	pl.AuditServers = append(pl.AuditServers, ss.AuditServers...)
 
-	state.FactoidBalancesPMutex.Lock()
-	state.FactoidBalancesP = make(map[[32]byte]int64, 0)
-	for k := range ss.FactoidBalancesP {
-		state.FactoidBalancesP[k] = ss.FactoidBalancesP[k]
-	}
-	state.FactoidBalancesPMutex.Unlock()
-
-	state.ECBalancesPMutex.Lock()
-	state.ECBalancesP = make(map[[32]byte]int64, 0)
-	for k := range ss.ECBalancesP {
-		state.ECBalancesP[k] = ss.ECBalancesP[k]
-	}
-	state.ECBalancesPMutex.Unlock()
+	state.FactoidBalancesP.Replace(ss.FactoidBalancesP)
+	state.ECBalancesP.Replace(ss.ECBalancesP)
 
 	state.Identities = append(state.Identities[:0], ss.Identities...)
 	state.Authorities = append(state.Authorities[:0], ss.Authorities...)
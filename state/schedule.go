@@ -0,0 +1,96 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/schedule"
+	"github.com/FactomProject/factomd/util"
+)
+
+// Our housekeeping runs on the Scheduler (see schedule/), at the times set in factomd.conf, and
+// the control panel shows how each task last did.  The tasks are
+//
+//	clock check   measure our clock against the NTP servers, every ClockCheckMinutes unless
+//	              ScheduleClockCheck is given; only with NTPServers set
+//	compaction    compact the database, by ScheduleCompaction; only LevelDB compacts
+//	snapshot      take a snapshot at the next block saved, by ScheduleSnapshot, as well as those
+//	              taken every SnapshotInterval blocks
+
+// configureSchedule sets up the Scheduler from the configuration; nil if there is nothing to
+// schedule.  A task with a bad schedule is left off, with a warning.
+func (s *State) configureSchedule(cfg *util.FactomdConfig) {
+	s.Scheduler = nil
+	sched := schedule.New()
+	add := func(name string, spec string, run func() error) {
+		if spec == "" {
+			return
+		}
+		if err := sched.Add(name, spec, run); err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("Task %s is off: %s\n", name, err.Error()))
+		}
+	}
+
+	if s.Clock != nil {
+		spec := cfg.App.ScheduleClockCheck
+		if spec == "" {
+			spec = fmt.Sprintf("@every %v", s.ClockCheckInterval)
+		}
+		add("clock check", spec, s.CheckClock)
+	}
+	add("compaction", cfg.App.ScheduleCompaction, s.CompactDatabase)
+	add("snapshot", cfg.App.ScheduleSnapshot, s.requestSnapshot)
+
+	if len(sched.Status()) > 0 {
+		s.Scheduler = sched
+	}
+}
+
+// RunScheduler runs the scheduled tasks until we shut down.  The clock is measured at once, as
+// our timestamps depend on it.
+func (s *State) RunScheduler() {
+	if s.Scheduler == nil {
+		return
+	}
+	if s.Clock != nil {
+		s.Scheduler.RunNow("clock check")
+	}
+	s.Scheduler.Run(s.IsShuttingDown)
+}
+
+// GetScheduledTasks returns the status of each scheduled task.
+func (s *State) GetScheduledTasks() []schedule.Status {
+	if s.Scheduler == nil {
+		return nil
+	}
+	return s.Scheduler.Status()
+}
+
+// CompactDatabase compacts the database, if it is one that compacts.
+func (s *State) CompactDatabase() error {
+	c, ok := s.DB.(interfaces.Compacter)
+	if !ok {
+		return nil
+	}
+	start := time.Now()
+	if err := c.Compact(); err != nil {
+		s.AddStatus("Compaction: " + err.Error())
+		return err
+	}
+	s.Logf("info", "Compacted the database in %v", time.Since(start))
+	return nil
+}
+
+func (s *State) requestSnapshot() error {
+	if s.StateSaverStruct.SnapshotInterval <= 0 {
+		return fmt.Errorf("Snapshots are off, as SnapshotInterval is 0")
+	}
+	s.StateSaverStruct.RequestSnapshot()
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// A graceful shutdown goes in three steps.  First we stop taking new work: the API refuses
+// submissions, and only consensus messages are admitted to the InMsgQueue, so we can still finish
+// the minute we are in.  Then we wait for a safe boundary: the minute we were in has ended, and
+// every block we completed is in the database.  Last, the ValidatorLoop flushes the DBStates,
+// takes a snapshot, saves the replay filter and held commits, stops the StateSaver, and closes
+// the database, and signals ShutdownDone.
+//
+// If the boundary doesn't come within ShutdownTimeout (the network may have stalled), we shut
+// down anyway; everything saved is still consistent, we just lose the minute in flight.
+
+const DefaultShutdownTimeout = 90 // seconds; what systemd gives a service before it kills it
+
+// BeginShutdown stops the State taking any new work.
+func (s *State) BeginShutdown() {
+	atomic.StoreInt32(&s.shuttingDown, 1)
+	if s.Supervisor != nil {
+		s.Supervisor.Stop()
+	}
+}
+
+// IsShuttingDown is true once BeginShutdown has been called.
+func (s *State) IsShuttingDown() bool {
+	return atomic.LoadInt32(&s.shuttingDown) == 1
+}
+
+// IsShutDown is true once the ValidatorLoop has closed everything down.  A node's other loops
+// stop on it, so a node started again in the same process (see engine.Daemon) has its peers to
+// itself.
+func (s *State) IsShutDown() bool {
+	return atomic.LoadInt32(&s.shutDown) == 1
+}
+
+// atSafeBoundary returns true if we have moved past the minute we were in at height and minute,
+// and have saved every block we have completed.
+func (s *State) atSafeBoundary(height uint32, minute int) bool {
+	if s.GetHighestSavedBlk() < s.GetHighestCompletedBlk() {
+		return false
+	}
+	// While we are catching up there is no minute in flight, only blocks to save.
+	if !s.DBFinished || s.GetHighestKnownBlock() > s.GetHighestSavedBlk()+1 {
+		return true
+	}
+	return s.LLeaderHeight != height || s.CurrentMinute != minute
+}
+
+// WaitForSafeBoundary waits until we reach a safe boundary to shut down at, or until
+// ShutdownTimeout.  Returns false on a timeout.
+func (s *State) WaitForSafeBoundary() bool {
+	height, minute := s.LLeaderHeight, s.CurrentMinute
+	timeout := time.Duration(s.ShutdownTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if s.atSafeBoundary(height, minute) {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	s.AddStatus(fmt.Sprintf("Shutdown: no safe boundary after %v, at height %d minute %d", timeout, s.LLeaderHeight, s.CurrentMinute))
+	return false
+}
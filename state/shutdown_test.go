@@ -0,0 +1,65 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/messages"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestShutdownAdmitsOnlyConsensus(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	if s.IsShuttingDown() {
+		t.Fatal("A new State should not be shutting down")
+	}
+	if !s.AdmitMsg(new(messages.CommitEntryMsg)) || !s.AdmitMsg(new(messages.MissingMsg)) {
+		t.Error("An empty queue should admit everything")
+	}
+
+	s.BeginShutdown()
+	if !s.IsShuttingDown() {
+		t.Fatal("BeginShutdown did not take")
+	}
+	if s.AdmitMsg(new(messages.CommitEntryMsg)) {
+		t.Error("A commit was admitted while shutting down")
+	}
+	if s.AdmitMsg(new(messages.MissingMsg)) {
+		t.Error("A request from a peer was admitted while shutting down")
+	}
+	if !s.AdmitMsg(new(messages.EOM)) {
+		t.Error("An EOM must be admitted while shutting down, or we can't finish the minute")
+	}
+}
+
+func TestSaveToFileReplacesWhole(t *testing.T) {
+	dir, err := ioutil.TempDir("", "saveToFile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "FastBoot_TEST.db")
+
+	for _, contents := range []string{"first", "second"} {
+		if err := SaveToFile([]byte(contents), filename); err != nil {
+			t.Fatal(err)
+		}
+		b, err := LoadFromFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != contents {
+			t.Errorf("Read back %q, expected %q", b, contents)
+		}
+	}
+	if _, err := os.Stat(filename + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("The file written to the side was left behind")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	ed "github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+// The same leader messages arrive again and again as they are gossiped, and are validated more
+// than once as they pass through holding, so checking their signatures against the authorities
+// took most of our CPU when busy.  The cache remembers the key each signature was verified with,
+// by the hash of the message and the signature, so a signature is verified once.  Only signatures
+// that verified are cached; a bad one is checked every time, as before.
+
+// The number of verified signatures remembered
+const SigCacheSize = 10000
+
+type sigCache struct {
+	mutex sync.Mutex
+	keys  map[[32]byte][32]byte // Signing key by sigCacheKey
+	order [][32]byte            // The sigCacheKeys, in a ring, to forget the oldest
+	next  int
+}
+
+func newSigCache(size int) *sigCache {
+	c := new(sigCache)
+	c.keys = make(map[[32]byte][32]byte)
+	c.order = make([][32]byte, size)
+	return c
+}
+
+func sigCacheKey(msg []byte, sig *[constants.SIGNATURE_LENGTH]byte) [32]byte {
+	msgHash := sha256.Sum256(msg)
+	return sha256.Sum256(append(msgHash[:], sig[:]...))
+}
+
+// signer returns the key a signature was verified with, if it is cached.
+func (c *sigCache) signer(key [32]byte) ([32]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	pub, ok := c.keys[key]
+	return pub, ok
+}
+
+func (c *sigCache) add(key [32]byte, pub [32]byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.keys[key]; ok {
+		return
+	}
+	delete(c.keys, c.order[c.next])
+	c.order[c.next] = key
+	c.next = (c.next + 1) % len(c.order)
+	c.keys[key] = pub
+}
+
+// VerifyAuthority checks a signature against an authority's signing key, and the keys it had
+// before, like Authority.VerifySignature, but through the signature cache.
+func (st *State) VerifyAuthority(auth *Authority, msg []byte, sig *[constants.SIGNATURE_LENGTH]byte) (bool, error) {
+	if st.sigCache == nil {
+		return auth.VerifySignature(msg, sig)
+	}
+
+	var pubs [][32]byte
+	var pub [32]byte
+	tmp, err := auth.SigningKey.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	copy(pub[:], tmp)
+	pubs = append(pubs, pub)
+	for _, histKey := range auth.KeyHistory {
+		histTemp, err := histKey.SigningKey.MarshalBinary()
+		if err != nil {
+			continue
+		}
+		copy(pub[:], histTemp)
+		pubs = append(pubs, pub)
+	}
+
+	key := sigCacheKey(msg, sig)
+	if signer, ok := st.sigCache.signer(key); ok {
+		// A signature that verified with one key won't with another
+		SigCacheHits.Inc()
+		for _, pub := range pubs {
+			if pub == signer {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	SigCacheMisses.Inc()
+	for _, pub := range pubs {
+		if ed.VerifyCanonical(&pub, msg, sig) {
+			st.sigCache.add(key, pub)
+			return true, nil
+		}
+	}
+	return false, nil
+}
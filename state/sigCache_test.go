@@ -0,0 +1,51 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestVerifyAuthorityCached(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	key := new(primitives.PrivateKey)
+	if err := key.GenerateKey(); err != nil {
+		t.Fatal(err)
+	}
+	signer := new(Authority)
+	signer.SigningKey = *key.Pub
+	other := new(Authority)
+	other.SigningKey = *primitives.RandomPrivateKey().Pub
+
+	msg := []byte("a leader message")
+	sig := key.Sign(msg).GetSignature()
+
+	// The second time, the signature is found in the cache
+	for i := 0; i < 2; i++ {
+		if valid, err := s.VerifyAuthority(signer, msg, sig); err != nil || !valid {
+			t.Errorf("The signer's signature didn't verify: %v", err)
+		}
+		if valid, _ := s.VerifyAuthority(other, msg, sig); valid {
+			t.Errorf("The signer's signature verified with another authority's key")
+		}
+	}
+
+	if valid, _ := s.VerifyAuthority(signer, []byte("another message"), sig); valid {
+		t.Errorf("The signature verified for another message")
+	}
+
+	// A key the authority had before still verifies
+	rotated := new(Authority)
+	rotated.SigningKey = *primitives.RandomPrivateKey().Pub
+	rotated.KeyHistory = append(rotated.KeyHistory, HistoricKey{SigningKey: *key.Pub})
+	if valid, _ := s.VerifyAuthority(rotated, msg, sig); !valid {
+		t.Errorf("The signature didn't verify with the authority's old key")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// FastBoot only saves while we are loading blocks out of our own database, so a node that has been
+// following the network for a long time still has to walk every block saved since it booted.  A snapshot
+// is taken every SnapshotInterval blocks once we are following the network.  It holds the last two saved
+// DBStates; the SaveState of the second one carries the balances, the replay filter, and the identities.
+// We also keep the ProcessLists base, so on restore we don't build a process list for every block from
+// genesis.
+
+//To be increased whenever the data being saved changes from the last version
+const snapshotVersion = 1
+
+func SnapshotFilename(networkName string, fileLocation string) string {
+	file := fmt.Sprintf("Snapshot_%s_v%v.db", networkName, snapshotVersion)
+	if fileLocation != "" {
+		return fmt.Sprintf("%v/%v", fileLocation, file)
+	}
+	return file
+}
+
+func (sss *StateSaverStruct) DeleteSnapshot(networkName string) error {
+	return DeleteFile(SnapshotFilename(networkName, sss.FastBootLocation))
+}
+
+// SaveSnapshot is called once d has been written to the database.
+func (sss *StateSaverStruct) SaveSnapshot(list *DBStateList, d *DBState, networkName string) error {
+	if sss.Stop == true || sss.SnapshotInterval <= 0 {
+		return nil
+	}
+
+	// While we are loading from the database, FastBoot is saving for us
+	if list.State.DBFinished == false {
+		return nil
+	}
+
+	dbheight := d.DirectoryBlock.GetHeader().GetDBHeight()
+	requested := atomic.LoadInt32(&sss.snapshotRequested) == 1
+	if (dbheight%uint32(sss.SnapshotInterval) != 0 && !requested) || dbheight < 2 {
+		return nil
+	}
+
+	// SaveFactomdState won't build a SaveState for blocks more than a day behind the network.
+	if d.SaveStruct == nil {
+		return nil
+	}
+	prev := list.Get(int(dbheight - 1))
+	if prev == nil || !prev.Saved {
+		return nil
+	}
+
+	sss.Mutex.Lock()
+	defer sss.Mutex.Unlock()
+	err := sss.writeSnapshot(list, prev, d, networkName)
+	if err == nil {
+		atomic.StoreInt32(&sss.snapshotRequested, 0)
+	}
+	return err
+}
+
+// RequestSnapshot has a snapshot taken at the next block saved, whatever the SnapshotInterval.
+// A node that has snapshots off takes none.
+func (sss *StateSaverStruct) RequestSnapshot() {
+	atomic.StoreInt32(&sss.snapshotRequested, 1)
+}
+
+// SaveLatestSnapshot takes a snapshot at the highest saved block that we can take one at, whatever
+// the interval.  It is called on shutdown, so the next boot can start from there.
+func (sss *StateSaverStruct) SaveLatestSnapshot(list *DBStateList, networkName string) error {
+	if sss.Stop == true || sss.SnapshotInterval <= 0 || list.State.DBFinished == false {
+		return nil
+	}
+
+	sss.Mutex.Lock()
+	defer sss.Mutex.Unlock()
+
+	for h := int(list.GetHighestSavedBlk()); h >= 2 && h > int(sss.SnapshotHeight); h-- {
+		d := list.Get(h)
+		prev := list.Get(h - 1)
+		if d == nil || prev == nil {
+			return nil
+		}
+		if d.Saved && prev.Saved && d.SaveStruct != nil {
+			return sss.writeSnapshot(list, prev, d, networkName)
+		}
+	}
+	return nil
+}
+
+// writeSnapshot writes the snapshot ending on d.  Call with the Mutex locked.
+func (sss *StateSaverStruct) writeSnapshot(list *DBStateList, prev *DBState, d *DBState, networkName string) error {
+	dbheight := d.DirectoryBlock.GetHeader().GetDBHeight()
+
+	buf := primitives.NewBuffer(nil)
+	err := buf.PushUInt32(list.State.ProcessLists.DBHeightBase)
+	if err != nil {
+		return err
+	}
+	err = buf.PushBinaryMarshallable(prev)
+	if err != nil {
+		return err
+	}
+	err = buf.PushBinaryMarshallable(d)
+	if err != nil {
+		return err
+	}
+	b := buf.DeepCopyBytes()
+
+	//adding an integrity check
+	h := primitives.Sha(b)
+	b = append(h.Bytes(), b...)
+
+	err = SaveToFile(b, SnapshotFilename(networkName, sss.FastBootLocation))
+	if err != nil {
+		return err
+	}
+	sss.SnapshotHeight = dbheight
+	return nil
+}
+
+// LoadSnapshot restores the State from the latest snapshot, if it is ahead of where FastBoot left us.
+// The snapshot is only used if its two directory blocks are the ones in our database.
+func (sss *StateSaverStruct) LoadSnapshot(s *State, networkName string) error {
+	b, err := LoadFromFile(SnapshotFilename(networkName, sss.FastBootLocation))
+	if err != nil || b == nil {
+		return nil
+	}
+	h := primitives.NewZeroHash()
+	b, err = h.UnmarshalBinaryData(b)
+	if err != nil {
+		return nil
+	}
+	h2 := primitives.Sha(b)
+	if h.IsSameAs(h2) == false {
+		fmt.Printf("LoadSnapshot - Integrity hashes do not match!")
+		return nil
+	}
+
+	buf := primitives.NewBuffer(b)
+	plbase, err := buf.PopUInt32()
+	if err != nil {
+		return nil
+	}
+
+	prev := new(DBState)
+	err = buf.PopBinaryMarshallable(prev)
+	if err != nil {
+		return nil
+	}
+	last := new(DBState)
+	err = buf.PopBinaryMarshallable(last)
+	if err != nil || last.SaveStruct == nil {
+		return nil
+	}
+	dbheight := last.DirectoryBlock.GetHeader().GetDBHeight()
+
+	if dbheight <= s.DBStates.GetHighestSavedBlk() {
+		return nil
+	}
+	if sss.LoadBelow > 0 && dbheight >= sss.LoadBelow {
+		fmt.Printf("LoadSnapshot - Not using the snapshot at %d, as it is past -replay-from-height\n", dbheight)
+		return nil
+	}
+	check := &DBStateList{Base: dbheight - 1, DBStates: []*DBState{prev, last}}
+	if err := VerifyDBStates(s.DB, check); err != nil {
+		fmt.Printf("LoadSnapshot - Snapshot at %d is not of our database, as %s\n", dbheight, err.Error())
+		return nil
+	}
+
+	if plbase > dbheight {
+		plbase = dbheight
+	}
+	s.ProcessLists.DBHeightBase = plbase
+	s.ProcessLists.Lists = nil
+
+	s.DBStates.LastEnd = int(dbheight)
+	s.DBStates.LastBegin = int(dbheight)
+	s.DBStates.ProcessHeight = dbheight - 1
+	s.DBStates.SavedHeight = dbheight - 1
+	s.DBStates.Base = dbheight - 1
+	s.DBStates.Complete = dbheight - 1
+	s.DBStates.DBStates = []*DBState{prev, last}
+
+	// Puts us back to just before last was processed; LoadDatabase picks up from there.
+	last.SaveStruct.RestoreFactomdState(s)
+	sss.SnapshotHeight = dbheight
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestSnapshotFilename(t *testing.T) {
+	if f := SnapshotFilename("MAIN", ""); f != "Snapshot_MAIN_v1.db" {
+		t.Errorf("Wrong snapshot filename %v", f)
+	}
+	if f := SnapshotFilename("MAIN", "/tmp"); f != "/tmp/Snapshot_MAIN_v1.db" {
+		t.Errorf("Wrong snapshot filename %v", f)
+	}
+	if SnapshotFilename("MAIN", "") == NetworkIDToFilename("MAIN", "") {
+		t.Errorf("Snapshot and FastBoot must not share a file")
+	}
+}
+
+func TestLoadSnapshotMissingFile(t *testing.T) {
+	sss := new(StateSaverStruct)
+	sss.FastBootLocation = "does/not/exist"
+	if err := sss.LoadSnapshot(nil, "MAIN"); err != nil {
+		t.Errorf("A missing snapshot should not be an error, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// A hot standby runs beside an authority server, with the same identity and key in its
+// factomd.conf, but stays passive.  It sets the configured identity aside and follows the network
+// under a throwaway one, so it is synced and ready but never signs anything.  Meanwhile it watches
+// for the acks, EOMs, DBSigs and heartbeats of the configured identity, so it knows when the
+// primary was last heard from.
+//
+// PromoteStandby (the promote-standby API call) swaps the configured identity in at the next
+// block, the same way ChangeAcksHeight does a brain swap.  Two nodes signing with one identity
+// fault the server, so promotion is refused until the primary has been quiet for StandbyQuiet
+// seconds, and called off if the primary is heard from again before the swap.  Force skips both
+// checks, for when the operator knows the primary is down but it was heard from moments ago.
+
+// DefaultStandbyQuiet returns the seconds of quiet from the primary before a standby may take
+// over: two minutes of blocks, as an authority server speaks at least once a minute.
+func (s *State) DefaultStandbyQuiet() int {
+	return s.DirectoryBlockInSeconds / 5
+}
+
+// initStandby sets the configured identity aside, if we are a standby.
+func (s *State) initStandby() {
+	if !s.Standby {
+		return
+	}
+	s.StandbyIdentityChainID = s.IdentityChainID
+	s.standbyPrivKey = s.LocalServerPrivKey
+	s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName + "-standby"))
+	s.LocalServerPrivKey = primitives.RandomPrivateKey().PrivateKeyString()
+	if s.StandbyQuiet <= 0 {
+		s.StandbyQuiet = s.DefaultStandbyQuiet()
+	}
+	atomic.StoreInt64(&s.standbyLastSeen, s.ClockNow().UnixNano())
+}
+
+// IsStandby is true for a standby that hasn't yet taken over its identity.
+func (s *State) IsStandby() bool {
+	return s.Standby && atomic.LoadInt32(&s.standbyPromoted) == 0
+}
+
+// signerOf returns the identity that signed a consensus message, or nil for other messages.
+func signerOf(msg interfaces.IMsg) interfaces.IHash {
+	switch m := msg.(type) {
+	case *messages.Ack:
+		return m.LeaderChainID
+	case *messages.EOM:
+		return m.ChainID
+	case *messages.DirectoryBlockSignature:
+		return m.ServerIdentityChainID
+	case *messages.Heartbeat:
+		return m.IdentityChainID
+	}
+	return nil
+}
+
+// watchStandbyIdentity notes when a valid message signed by the identity we stand by for is seen.
+func (s *State) watchStandbyIdentity(msg interfaces.IMsg) {
+	if !s.IsStandby() {
+		return
+	}
+	if signer := signerOf(msg); signer != nil && signer.IsSameAs(s.StandbyIdentityChainID) {
+		atomic.StoreInt64(&s.standbyLastSeen, s.ClockNow().UnixNano())
+	}
+}
+
+// StandbyQuietFor returns how long it has been since the primary was heard from, or since we
+// started, if it hasn't been.
+func (s *State) StandbyQuietFor() time.Duration {
+	return s.ClockSince(time.Unix(0, atomic.LoadInt64(&s.standbyLastSeen)))
+}
+
+// PromoteStandby has a standby take over its identity at the next block, and returns that height.
+func (s *State) PromoteStandby(force bool) (uint32, error) {
+	if !s.IsStandby() {
+		return 0, fmt.Errorf("Not a standby")
+	}
+	if at := atomic.LoadUint32(&s.standbyPromoteAt); at > 0 {
+		return at, nil
+	}
+	if !s.DBFinished {
+		return 0, fmt.Errorf("Still syncing, at height %d", s.GetHighestSavedBlk())
+	}
+	quiet := s.StandbyQuietFor()
+	if !force && quiet < time.Duration(s.StandbyQuiet)*time.Second {
+		return 0, fmt.Errorf("The primary was heard from %v ago; it must be quiet for %d seconds", quiet/time.Second*time.Second, s.StandbyQuiet)
+	}
+
+	at := s.LLeaderHeight + 1
+	atomic.StoreInt64(&s.standbyPromoteTime, s.ClockNow().UnixNano())
+	if force {
+		atomic.StoreInt32(&s.standbyForce, 1)
+	}
+	atomic.StoreUint32(&s.standbyPromoteAt, at)
+	s.AddStatus(fmt.Sprintf("Standby: taking over identity %x at height %d", s.StandbyIdentityChainID.Bytes()[:3], at))
+	return at, nil
+}
+
+// checkStandbyPromotion swaps in the identity we stand by for, once we reach the height
+// PromoteStandby set.  Called at the start of each block.
+func (s *State) checkStandbyPromotion() {
+	at := atomic.LoadUint32(&s.standbyPromoteAt)
+	if at == 0 || s.LLeaderHeight < at || !s.IsStandby() {
+		return
+	}
+	requested := atomic.LoadInt64(&s.standbyPromoteTime)
+	if atomic.LoadInt32(&s.standbyForce) == 0 && atomic.LoadInt64(&s.standbyLastSeen) > requested {
+		atomic.StoreUint32(&s.standbyPromoteAt, 0)
+		s.AddStatus("Standby: the primary was heard from again, promotion called off")
+		return
+	}
+	s.IdentityChainID = s.StandbyIdentityChainID
+	s.LocalServerPrivKey = s.standbyPrivKey
+	s.initServerKeys()
+	atomic.StoreInt32(&s.standbyPromoted, 1)
+	s.AddStatus(fmt.Sprintf("Standby: promoted, now signing as %x", s.IdentityChainID.Bytes()[:3]))
+}
@@ -0,0 +1,46 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestPromoteStandby(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	if s.IsStandby() {
+		t.Fatal("A State should not be a standby unless asked")
+	}
+	if _, err := s.PromoteStandby(true); err == nil {
+		t.Error("Only a standby can be promoted")
+	}
+
+	s.Standby = true
+	s.StandbyIdentityChainID = primitives.Sha([]byte("primary"))
+	s.StandbyQuiet = 60
+	s.DBFinished = false
+	if _, err := s.PromoteStandby(true); err == nil {
+		t.Error("A standby that is still syncing should not be promoted")
+	}
+
+	s.DBFinished = true
+	at, err := s.PromoteStandby(false)
+	if err != nil {
+		t.Fatalf("A primary that was never heard from should allow promotion: %v", err)
+	}
+	if at != s.LLeaderHeight+1 {
+		t.Errorf("Expected the promotion at the next block %d, got %d", s.LLeaderHeight+1, at)
+	}
+	again, err := s.PromoteStandby(false)
+	if err != nil || again != at {
+		t.Errorf("Asking again should give the same height, got %d, %v", again, err)
+	}
+	if !s.IsStandby() {
+		t.Error("A standby is still a standby until the swap at the next block")
+	}
+}
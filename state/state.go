@@ -7,7 +7,6 @@ package state
 import (
 	"bufio"
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 
+	"github.com/FactomProject/factomd/clock"
 	"github.com/FactomProject/factomd/common/adminBlock"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
@@ -28,8 +28,12 @@ import (
 	"github.com/FactomProject/factomd/database/boltdb"
 	"github.com/FactomProject/factomd/database/leveldb"
 	"github.com/FactomProject/factomd/database/mapdb"
+	"github.com/FactomProject/factomd/fer"
 	"github.com/FactomProject/factomd/log"
+	"github.com/FactomProject/factomd/metricsHistory"
 	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/schedule"
+	"github.com/FactomProject/factomd/supervisor"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/wsapi"
 
@@ -66,17 +70,42 @@ type State struct {
 	DBStatesSent            []*interfaces.DBStateSent
 	DBStatesReceivedBase    int
 	DBStatesReceived        []*messages.DBStateMsg
+	dbstatePreValidator     *DBStatePreValidator
+	BalanceAudit            *BalanceAudit // Only set if an audit was started
+	ProcessedMsgs           ProcessedMsgs // The last few messages executed, for state dumps
+	FastCatchup             bool          // Apply blocks below the last checkpoint in bulk, see fastCatchup.go
+	SkipValidationUntil     uint32        // Blocks up to here are used even if they fail validation, see replayBounds.go
+	HeaderSync              bool          // Sync directory block headers ahead of the blocks, see headerSync.go
+	DBlockHeaders           HeaderChain   // Headers past our highest saved block
+	PruneWindow             uint32        // Directory blocks of factoid history to keep; 0 keeps it all, see prune.go
+	PrunedHeight            uint32        // Factoid blocks below this height have been pruned
+	pruning                 int32         // 1 while a prune is running
+	FollowChains            ChainSet      // Only keep the entries of these chains; nil keeps them all, see followChains.go
+	EntryBloomMB            int           // Megabytes of entry bloom filter; 0 for none, see database/databaseOverlay/entryBloom.go
 	LocalServerPrivKey      string
 	DirectoryBlockInSeconds int
 	PortNumber              int
 	Replay                  *Replay
+	ReplayWindow            int // Minutes either side of now the replay filter takes; Range if 0
 	DropRate                int
 	Delay                   int64 // Simulation delays sending messages this many milliseconds
+	ProcessDelay            int64 // Simulation holds messages from peers this many milliseconds before processing them
+	ClockSkew               int64 // Simulation moves this node's clock this many milliseconds
+
+	Clock              *clock.Clock   // Checks our clock against NTP servers, if configured; see clock.go
+	VirtualClock       *clock.Virtual // Runs our clock, and timers, faster in tests; nil otherwise
+	ClockCheckInterval time.Duration
+	Scheduler          *schedule.Scheduler // Runs our housekeeping, if any is configured; see schedule.go
+
+	Invariants InvariantChecker       // Checks the balances after each block, see invariants.go
+	Supervisor *supervisor.Supervisor // Runs our long-lived goroutines, see supervisor.go
 
 	ControlPanelPort        int
 	ControlPanelSetting     int
+	ControlPanelSiblings    []string // wsapi urls of other nodes shown on the control panel
 	ControlPanelChannel     chan DisplayState
-	ControlPanelDataRequest bool // If true, update Display state
+	ControlPanelDataRequest bool       // If true, update Display state
+	controlPanelMutex       sync.Mutex // Guards ControlPanelDataRequest, set from the control panel's goroutines
 
 	// Network Configuration
 	Network                 string
@@ -84,15 +113,53 @@ type State struct {
 	PeersFile               string
 	MainSeedURL             string
 	MainSpecialPeers        string
+	MainSpecialPeerGroups   string
 	TestNetworkPort         string
 	TestSeedURL             string
 	TestSpecialPeers        string
+	TestSpecialPeerGroups   string
 	LocalNetworkPort        string
 	LocalSeedURL            string
 	LocalSpecialPeers       string
+	LocalSpecialPeerGroups  string
 	CustomNetworkID         []byte
 	CustomBootstrapIdentity string
 	CustomBootstrapKey      string
+	CustomGenesisFile       string         // JSON description of a custom network's genesis, see customGenesis.go
+	CustomGenesis           *CustomGenesis // Only set when the genesis blocks are still to be built from the file
+
+	// Directory block timestamp rules for each network; see GetBlockTimestampRules
+	MainBlockTimestampMedian    int
+	MainBlockTimestampMaxDrift  int
+	TestBlockTimestampMedian    int
+	TestBlockTimestampMaxDrift  int
+	LocalBlockTimestampMedian   int
+	LocalBlockTimestampMaxDrift int
+
+	// Percent of the federated servers a DBState needs signatures from, for each network; see
+	// dbstateSigs.go
+	MainDBStateSigThreshold  int
+	TestDBStateSigThreshold  int
+	LocalDBStateSigThreshold int
+	dbstateSigs              interfaces.DBStateSigTally // The last DBState whose signatures we counted
+	dbstateSigsMutex         sync.Mutex
+
+	// Consensus timing for each network; see consensusTiming.go
+	MainAckTimeout      int // Seconds
+	TestAckTimeout      int
+	LocalAckTimeout     int
+	MainResendInterval  int // Seconds
+	TestResendInterval  int
+	LocalResendInterval int
+	MainEOMWait         int // Milliseconds
+	TestEOMWait         int
+	LocalEOMWait        int
+
+	// Heights each activation takes effect at on each network, by name; see activations.go
+	Activations map[string]util.ActivationHeights
+
+	// The bound commit that reserves each chain, by the hash of the chain ID; see chainBinding.go
+	chainBindings map[[32]byte]*messages.CommitChainMsg
 
 	IdentityChainID      interfaces.IHash // If this node has an identity, this is it
 	Identities           []*Identity      // Identities of all servers in management chain
@@ -111,11 +178,15 @@ type State struct {
 	ResetTryCnt int
 	ResetCnt    int
 
+	MinuteRollbackCnt int // Corrupted minutes rolled back to a checkpoint, rather than reset
+
 	//  pending entry/transaction api calls for the holding queue do not have proper scope
 	//  This is used to create a temporary, correctly scoped holdingqueue snapshot for the calls on demand
 	HoldingMutex sync.RWMutex
 	HoldingLast  int64
 	HoldingMap   map[[32]byte]interfaces.IMsg
+	// Why each message in the snapshot is held, see holdingDeps.go
+	HoldingReasons []interfaces.HeldMessage
 
 	//  pending entry/transaction api calls for the ack queue do not have proper scope
 	//  This is used to create a temporary, correctly scoped ackqueue snapshot for the calls on demand
@@ -137,35 +208,76 @@ type State struct {
 	ExpireCnt int
 
 	tickerQueue            chan int
+	journalQueue           chan *JournalEntry // Messages from a journal being replayed
 	timerMsgQueue          chan interfaces.IMsg
 	TimeOffset             interfaces.Timestamp
 	MaxTimeOffset          interfaces.Timestamp
 	networkOutMsgQueue     NetOutMsgQueue
 	networkInvalidMsgQueue chan interfaces.IMsg
-	inMsgQueue             InMsgMSGQueue
+	inMsgQueue             *PriorityInMsgQueue
 	apiQueue               chan interfaces.IMsg
 	ackQueue               chan interfaces.IMsg
 	msgQueue               chan interfaces.IMsg
 
-	ShutdownChan chan int // For gracefully halting Factom
-	JournalFile  string
-	Journaling   bool
+	ShutdownChan    chan int // For gracefully halting Factom
+	ShutdownDone    chan int // Signaled once the ValidatorLoop has closed everything down
+	ShutdownTimeout int      // Seconds to wait for a safe boundary to shut down at, see shutdown.go
+	shuttingDown    int32
+	shutDown        int32 // Set once the database is closed
+	JournalFile     string
+	Journaling      bool
 
 	serverPrivKey         *primitives.PrivateKey
 	serverPubKey          *primitives.PublicKey
 	serverPendingPrivKeys []*primitives.PrivateKey
 	serverPendingPubKeys  []*primitives.PublicKey
 
+	// Hot standby, see standby.go
+	Standby                bool // Started as a standby; stays true once promoted
+	StandbyQuiet           int  // Seconds the primary must be quiet before we may take over
+	StandbyIdentityChainID interfaces.IHash
+	standbyPrivKey         string
+	standbyLastSeen        int64 // UnixNano of the last message seen from the primary
+	standbyPromoteTime     int64
+	standbyPromoteAt       uint32
+	standbyForce           int32
+	standbyPromoted        int32
+
 	// RPC connection config
 	RpcUser     string
 	RpcPass     string
 	RpcAuthHash []byte
 
+	// Our acks sent together under one signature, see ackBatch.go
+	AckBatchWindow int // Milliseconds; 0 to send each ack alone
+	pendingAcks    []*messages.Ack
+	pendingSince   time.Time
+
+	// The operator's share of the InMsgQueue, see backpressure.go
+	PriorityLaneShare int
+	PriorityAPIKey    string
+
+	// Commits and reveals we turn away by chain, see chainFilter.go
+	ChainFilter *ChainFilter
+
+	// Commits we hold back as likely spam, see quarantine.go
+	SpamThreshold  int
+	SpamKeyRate    int
+	QuarantineSize int
+	spam           *spamScorer
+	quarantine     chan interfaces.IMsg
+
 	FactomdTLSEnable   bool
 	factomdTLSKeyFile  string
 	factomdTLSCertFile string
 	FactomdLocations   string
 
+	// Security headers for the Control Panel and the RPC API
+	ControlPanelContentSecurityPolicy string
+	FactomdContentSecurityPolicy      string
+	HttpFrameOptions                  string
+	HttpStrictTransportSecurity       string
+
 	// Server State
 	StartDelay      int64 // Time in Milliseconds since the last DBState was applied
 	StartDelayLimit int64
@@ -228,9 +340,26 @@ type State struct {
 	Acks          map[[32]byte]interfaces.IMsg // Hold Acknowledgemets
 	Commits       map[[32]byte]interfaces.IMsg // Commit Messages
 
+	// What the messages in Holding wait on, see holdingDeps.go
+	HoldingDeps    map[[32]byte]map[[32]byte]bool // Held messages by the hash they wait on
+	holdingWhy     map[[32]byte]heldOn
+	holdingHeights map[uint32]bool // Heights messages wait on
+
 	InvalidMessages      map[[32]byte]interfaces.IMsg
+	InvalidReasons       map[[32]byte]string // Why some of them are invalid, see CheckRevealCost
+	PendingReasons       map[[32]byte]string // Why some held messages are held, see CheckRevealCost
 	InvalidMessagesMutex sync.RWMutex
 
+	// Credits paid by commits that expired unrevealed, see expiredCommits.go
+	burned      map[[32]byte]*interfaces.BurnedCredits
+	burnedMutex sync.Mutex
+
+	// Commits superseded by commits of the same entry paying more, see commitReplacement.go
+	superseded      map[[32]byte][]interfaces.IHash
+	supersededMutex sync.Mutex
+
+	sigCache *sigCache // Verified authority signatures, see sigCache.go
+
 	AuditHeartBeats []interfaces.IMsg // The checklist of HeartBeats for this period
 
 	FaultTimeout    int
@@ -264,6 +393,34 @@ type State struct {
 	ResetRequest    bool // Set to true to trigger a reset
 	ProcessLists    *ProcessLists
 	HighestKnown    uint32
+
+	// Summaries of the process lists of recent blocks, see blockMinutes.go
+	BlockMinutes      map[uint32]*BlockMinutes
+	BlockMinutesMutex sync.RWMutex
+
+	// Entries, commits and entry credits by chain of recent blocks, see chainStats.go
+	ChainStats      map[uint32]*BlockChainStats
+	ChainStatsMutex sync.RWMutex
+
+	// Blocks whose timelines are kept, 0 for none, and those of the blocks being built; see blockTimeline.go
+	BlockTimelineBlocks int
+	blockTimelines      map[uint32]*BlockTimeline
+	blockTimelinesMutex sync.Mutex
+
+	// Blocks whose process list logs are kept, 0 for none; see processListLog.go
+	ProcessListLogBlocks int
+
+	// How long the last minute took, and the last health each audit server reported; see nodeHealth.go
+	minuteEnded     time.Time
+	lastMinute      time.Duration
+	nodeHealth      map[string]interfaces.NodeHealth
+	nodeHealthMutex sync.Mutex
+
+	// The probes we sent, and what they found; see probe.go
+	probeNumber uint32
+	probes      []interfaces.ProbeResult
+	probesMutex sync.Mutex
+
 	HighestAck      uint32
 	AuthorityDeltas string
 
@@ -272,12 +429,10 @@ type State struct {
 	NumTransactions int
 
 	// Permanent balances from processing blocks.
-	FactoidBalancesP      map[[32]byte]int64
-	FactoidBalancesPMutex sync.Mutex
-	ECBalancesP           map[[32]byte]int64
-	ECBalancesPMutex      sync.Mutex
-	TempBalanceHash       interfaces.IHash
-	Balancehash           interfaces.IHash
+	FactoidBalancesP *BalanceMap
+	ECBalancesP      *BalanceMap
+	TempBalanceHash  interfaces.IHash
+	Balancehash      interfaces.IHash
 
 	// Web Services
 	Port int
@@ -302,6 +457,17 @@ type State struct {
 	// Height in the Directory Block where we have
 	// Entries we don't have that we are asking our neighbors for
 	MissingEntries chan *MissingEntry
+	// Limits on the entry sync, a second, 0 for none, and how it is going; see entrySyncing.go
+	EntrySyncRequestRate int
+	EntrySyncReadRate    int
+	entrySyncRequests    rateLimiter
+	entrySyncReads       rateLimiter
+	entrySync            interfaces.EntrySyncProgress
+	entrySyncMutex       sync.Mutex
+	// How often the metrics charted by the control panel are sampled, 0 for never; see metricsHistory.go
+	MetricsHistorySeconds int
+	metricsHistory        *metricsHistory.DB
+	metricsHistoryMutex   sync.Mutex
 
 	// Holds leaders and followers up until all missing entries are processed, if true
 	WaitForEntries  bool
@@ -320,6 +486,9 @@ type State struct {
 	FactoshisPerEC                 uint64
 	FERChainId                     string
 	ExchangeRateAuthorityPublicKey string
+	FEROracle                      *fer.Oracle // Proposes rates, if we hold the authority's key; see ferOracle.go
+	ferAuthorityKey                *primitives.PrivateKey
+	ferECKey                       *primitives.PrivateKey
 
 	FERChangeHeight      uint32
 	FERChangePrice       uint64
@@ -328,6 +497,11 @@ type State struct {
 
 	AckChange uint32
 
+	// The latest network status the authorities signed, see networkStatus.go
+	networkStatus        *NetworkStatusRecord
+	networkStatusMutex   sync.Mutex
+	networkStatusScanned uint32
+
 	StateSaverStruct StateSaverStruct
 }
 
@@ -385,25 +559,70 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.PeersFile = s.PeersFile
 	newState.MainSeedURL = s.MainSeedURL
 	newState.MainSpecialPeers = s.MainSpecialPeers
+	newState.MainSpecialPeerGroups = s.MainSpecialPeerGroups
 	newState.TestNetworkPort = s.TestNetworkPort
 	newState.TestSeedURL = s.TestSeedURL
 	newState.TestSpecialPeers = s.TestSpecialPeers
+	newState.TestSpecialPeerGroups = s.TestSpecialPeerGroups
 	newState.LocalNetworkPort = s.LocalNetworkPort
 	newState.LocalSeedURL = s.LocalSeedURL
 	newState.LocalSpecialPeers = s.LocalSpecialPeers
+	newState.LocalSpecialPeerGroups = s.LocalSpecialPeerGroups
+	newState.MainBlockTimestampMedian = s.MainBlockTimestampMedian
+	newState.MainBlockTimestampMaxDrift = s.MainBlockTimestampMaxDrift
+	newState.TestBlockTimestampMedian = s.TestBlockTimestampMedian
+	newState.TestBlockTimestampMaxDrift = s.TestBlockTimestampMaxDrift
+	newState.LocalBlockTimestampMedian = s.LocalBlockTimestampMedian
+	newState.LocalBlockTimestampMaxDrift = s.LocalBlockTimestampMaxDrift
+	newState.MainDBStateSigThreshold = s.MainDBStateSigThreshold
+	newState.TestDBStateSigThreshold = s.TestDBStateSigThreshold
+	newState.LocalDBStateSigThreshold = s.LocalDBStateSigThreshold
+	newState.MainAckTimeout = s.MainAckTimeout
+	newState.TestAckTimeout = s.TestAckTimeout
+	newState.LocalAckTimeout = s.LocalAckTimeout
+	newState.MainResendInterval = s.MainResendInterval
+	newState.TestResendInterval = s.TestResendInterval
+	newState.LocalResendInterval = s.LocalResendInterval
+	newState.MainEOMWait = s.MainEOMWait
+	newState.TestEOMWait = s.TestEOMWait
+	newState.LocalEOMWait = s.LocalEOMWait
+	newState.Activations = make(map[string]util.ActivationHeights, len(s.Activations))
+	for name, h := range s.Activations {
+		newState.Activations[name] = h
+	}
+	newState.Clock = s.Clock // The simulated nodes share our system clock
+	newState.VirtualClock = s.VirtualClock
+	newState.ClockCheckInterval = s.ClockCheckInterval
 	newState.StartDelayLimit = s.StartDelayLimit
 	newState.CustomNetworkID = s.CustomNetworkID
+	newState.CustomGenesisFile = s.CustomGenesisFile
 
 	newState.DirectoryBlockInSeconds = s.DirectoryBlockInSeconds
 	newState.PortNumber = s.PortNumber
 
 	newState.ControlPanelPort = s.ControlPanelPort
 	newState.ControlPanelSetting = s.ControlPanelSetting
+	newState.ControlPanelSiblings = s.ControlPanelSiblings
 
 	newState.Identities = s.Identities
 	newState.Authorities = s.Authorities
 	newState.AuthorityServerCount = s.AuthorityServerCount
 
+	newState.FastCatchup = s.FastCatchup
+	newState.SkipValidationUntil = s.SkipValidationUntil
+	newState.HeaderSync = s.HeaderSync
+	newState.PruneWindow = s.PruneWindow
+	newState.EntryBloomMB = s.EntryBloomMB
+	newState.AckBatchWindow = s.AckBatchWindow
+	newState.ReplayWindow = s.ReplayWindow
+	newState.EntrySyncRequestRate = s.EntrySyncRequestRate
+	newState.EntrySyncReadRate = s.EntrySyncReadRate
+	newState.MetricsHistorySeconds = s.MetricsHistorySeconds
+	newState.BlockTimelineBlocks = s.BlockTimelineBlocks
+	newState.ProcessListLogBlocks = s.ProcessListLogBlocks
+	newState.ShutdownTimeout = s.ShutdownTimeout
+	newState.StandbyQuiet = s.StandbyQuiet
+	newState.FollowChains = s.FollowChains
 	newState.FaultTimeout = s.FaultTimeout
 	newState.FaultWait = s.FaultWait
 	newState.EOMfaultIndex = s.EOMfaultIndex
@@ -430,6 +649,12 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 
 	newState.RpcUser = s.RpcUser
 	newState.RpcPass = s.RpcPass
+	newState.PriorityLaneShare = s.PriorityLaneShare
+	newState.PriorityAPIKey = s.PriorityAPIKey
+	newState.ChainFilter = s.ChainFilter
+	newState.SpamThreshold = s.SpamThreshold
+	newState.SpamKeyRate = s.SpamKeyRate
+	newState.QuarantineSize = s.QuarantineSize
 	newState.RpcAuthHash = s.RpcAuthHash
 
 	newState.FactomdTLSEnable = s.FactomdTLSEnable
@@ -437,14 +662,23 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.factomdTLSCertFile = s.factomdTLSCertFile
 	newState.FactomdLocations = s.FactomdLocations
 
+	newState.ControlPanelContentSecurityPolicy = s.ControlPanelContentSecurityPolicy
+	newState.FactomdContentSecurityPolicy = s.FactomdContentSecurityPolicy
+	newState.HttpFrameOptions = s.HttpFrameOptions
+	newState.HttpStrictTransportSecurity = s.HttpStrictTransportSecurity
+
 	switch newState.DBType {
 	case "LDB":
 		newState.StateSaverStruct.FastBoot = s.StateSaverStruct.FastBoot
 		newState.StateSaverStruct.FastBootLocation = newState.LdbPath
+		newState.StateSaverStruct.SnapshotInterval = s.StateSaverStruct.SnapshotInterval
+		newState.StateSaverStruct.LoadBelow = s.StateSaverStruct.LoadBelow
 		break
 	case "Bolt":
 		newState.StateSaverStruct.FastBoot = s.StateSaverStruct.FastBoot
 		newState.StateSaverStruct.FastBootLocation = newState.BoltDBPath
+		newState.StateSaverStruct.SnapshotInterval = s.StateSaverStruct.SnapshotInterval
+		newState.StateSaverStruct.LoadBelow = s.StateSaverStruct.LoadBelow
 		break
 	}
 
@@ -531,6 +765,37 @@ func (s *State) GetFactomdLocations() string {
 	return s.FactomdLocations
 }
 
+// GetAPISecurityHeaders returns the headers sent with every RPC API response
+func (s *State) GetAPISecurityHeaders() map[string]string {
+	return s.securityHeaders(s.FactomdContentSecurityPolicy)
+}
+
+// GetControlPanelSecurityHeaders returns the headers sent with every Control Panel response
+func (s *State) GetControlPanelSecurityHeaders() map[string]string {
+	return s.securityHeaders(s.ControlPanelContentSecurityPolicy)
+}
+
+// IsExplorerMode is true for a read only public explorer, which serves no write
+// APIs and no admin pages
+func (s *State) IsExplorerMode() bool {
+	return s.NodeMode == "EXPLORER"
+}
+
+func (s *State) securityHeaders(contentSecurityPolicy string) map[string]string {
+	headers := map[string]string{"X-Content-Type-Options": "nosniff"}
+	if contentSecurityPolicy != "" {
+		headers["Content-Security-Policy"] = contentSecurityPolicy
+	}
+	if s.HttpFrameOptions != "" {
+		headers["X-Frame-Options"] = s.HttpFrameOptions
+	}
+	// Browsers ignore HSTS over plain http, and it would lock them out of the node if TLS is later disabled
+	if s.HttpStrictTransportSecurity != "" && s.FactomdTLSEnable {
+		headers["Strict-Transport-Security"] = s.HttpStrictTransportSecurity
+	}
+	return headers
+}
+
 func (s *State) GetCurrentMinute() int {
 	return s.CurrentMinute
 }
@@ -599,14 +864,37 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.PeersFile = cfg.App.PeersFile
 		s.MainSeedURL = cfg.App.MainSeedURL
 		s.MainSpecialPeers = cfg.App.MainSpecialPeers
+		s.MainSpecialPeerGroups = cfg.App.MainSpecialPeerGroups
 		s.TestNetworkPort = cfg.App.TestNetworkPort
 		s.TestSeedURL = cfg.App.TestSeedURL
 		s.TestSpecialPeers = cfg.App.TestSpecialPeers
+		s.TestSpecialPeerGroups = cfg.App.TestSpecialPeerGroups
 		s.CustomBootstrapIdentity = cfg.App.CustomBootstrapIdentity
 		s.CustomBootstrapKey = cfg.App.CustomBootstrapKey
+		s.CustomGenesisFile = cfg.App.CustomGenesisFile
 		s.LocalNetworkPort = cfg.App.LocalNetworkPort
 		s.LocalSeedURL = cfg.App.LocalSeedURL
 		s.LocalSpecialPeers = cfg.App.LocalSpecialPeers
+		s.LocalSpecialPeerGroups = cfg.App.LocalSpecialPeerGroups
+		s.MainBlockTimestampMedian = cfg.App.MainBlockTimestampMedian
+		s.MainBlockTimestampMaxDrift = cfg.App.MainBlockTimestampMaxDrift
+		s.TestBlockTimestampMedian = cfg.App.TestBlockTimestampMedian
+		s.TestBlockTimestampMaxDrift = cfg.App.TestBlockTimestampMaxDrift
+		s.LocalBlockTimestampMedian = cfg.App.LocalBlockTimestampMedian
+		s.LocalBlockTimestampMaxDrift = cfg.App.LocalBlockTimestampMaxDrift
+		s.MainDBStateSigThreshold = cfg.App.MainDBStateSigThreshold
+		s.TestDBStateSigThreshold = cfg.App.TestDBStateSigThreshold
+		s.LocalDBStateSigThreshold = cfg.App.LocalDBStateSigThreshold
+		s.MainAckTimeout = cfg.App.MainAckTimeout
+		s.TestAckTimeout = cfg.App.TestAckTimeout
+		s.LocalAckTimeout = cfg.App.LocalAckTimeout
+		s.MainResendInterval = cfg.App.MainResendInterval
+		s.TestResendInterval = cfg.App.TestResendInterval
+		s.LocalResendInterval = cfg.App.LocalResendInterval
+		s.MainEOMWait = cfg.App.MainEOMWait
+		s.TestEOMWait = cfg.App.TestEOMWait
+		s.LocalEOMWait = cfg.App.LocalEOMWait
+		s.LoadActivations(cfg.Activation)
 		s.LocalServerPrivKey = cfg.App.LocalServerPrivKey
 		s.FactoshisPerEC = cfg.App.ExchangeRate
 		s.DirectoryBlockInSeconds = cfg.App.DirectoryBlockInSeconds
@@ -616,8 +904,18 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.RpcPass = cfg.App.FactomdRpcPass
 		s.StateSaverStruct.FastBoot = cfg.App.FastBoot
 		s.StateSaverStruct.FastBootLocation = cfg.App.FastBootLocation
+		s.StateSaverStruct.SnapshotInterval = cfg.App.SnapshotInterval
+		s.EntrySyncRequestRate = cfg.App.EntrySyncRequestsPerSecond
+		s.EntrySyncReadRate = cfg.App.EntrySyncReadsPerSecond
+		s.MetricsHistorySeconds = cfg.App.MetricsHistorySeconds
+		s.BlockTimelineBlocks = cfg.App.BlockTimelineBlocks
+		s.ProcessListLogBlocks = cfg.App.ProcessListLogBlocks
 
 		s.FactomdTLSEnable = cfg.App.FactomdTlsEnabled
+		s.ControlPanelContentSecurityPolicy = cfg.App.ControlPanelContentSecurityPolicy
+		s.FactomdContentSecurityPolicy = cfg.App.FactomdContentSecurityPolicy
+		s.HttpFrameOptions = cfg.App.HttpFrameOptions
+		s.HttpStrictTransportSecurity = cfg.App.HttpStrictTransportSecurity
 		if cfg.App.FactomdTlsPrivateKey == "/full/path/to/factomdAPIpriv.key" {
 			s.factomdTLSKeyFile = fmt.Sprint(cfg.App.HomeDir, "factomdAPIpriv.key")
 		}
@@ -639,8 +937,20 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		default:
 			s.ControlPanelSetting = 1
 		}
+		s.ControlPanelSiblings = nil
+		for _, sibling := range strings.Split(cfg.App.ControlPanelSiblings, ",") {
+			if sibling = strings.TrimSpace(sibling); sibling != "" {
+				s.ControlPanelSiblings = append(s.ControlPanelSiblings, sibling)
+			}
+		}
 		s.FERChainId = cfg.App.ExchangeRateChainId
 		s.ExchangeRateAuthorityPublicKey = cfg.App.ExchangeRateAuthorityPublicKey
+		s.configureFEROracle(cfg)
+		s.configureClock(cfg)
+		s.configureSchedule(cfg)
+		s.configurePriorityLane(cfg)
+		s.configureChainFilter(cfg)
+		s.configureSpam(cfg)
 		identity, err := primitives.HexToHash(cfg.App.IdentityChainID)
 		if err != nil {
 			s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName))
@@ -662,12 +972,33 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.PeersFile = "peers.json"
 		s.MainSeedURL = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/mainseed.txt"
 		s.MainSpecialPeers = ""
+		s.MainSpecialPeerGroups = ""
 		s.TestNetworkPort = "8109"
 		s.TestSeedURL = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/testseed.txt"
 		s.TestSpecialPeers = ""
+		s.TestSpecialPeerGroups = ""
 		s.LocalNetworkPort = "8110"
 		s.LocalSeedURL = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/localseed.txt"
 		s.LocalSpecialPeers = ""
+		s.LocalSpecialPeerGroups = ""
+		s.MainBlockTimestampMedian = constants.BLOCK_TIMESTAMP_MEDIAN_BLOCKS
+		s.MainBlockTimestampMaxDrift = constants.BLOCK_TIMESTAMP_MAX_DRIFT
+		s.TestBlockTimestampMedian = constants.BLOCK_TIMESTAMP_MEDIAN_BLOCKS
+		s.TestBlockTimestampMaxDrift = constants.BLOCK_TIMESTAMP_MAX_DRIFT
+		s.LocalBlockTimestampMedian = constants.BLOCK_TIMESTAMP_MEDIAN_BLOCKS
+		s.LocalBlockTimestampMaxDrift = constants.BLOCK_TIMESTAMP_MAX_DRIFT
+		s.MainDBStateSigThreshold = constants.DBSTATE_SIG_THRESHOLD
+		s.TestDBStateSigThreshold = constants.DBSTATE_SIG_THRESHOLD
+		s.LocalDBStateSigThreshold = constants.DBSTATE_SIG_THRESHOLD
+		s.MainAckTimeout = constants.ACK_TIMEOUT
+		s.TestAckTimeout = constants.ACK_TIMEOUT
+		s.LocalAckTimeout = constants.ACK_TIMEOUT
+		s.MainResendInterval = constants.RESEND_INTERVAL
+		s.TestResendInterval = constants.RESEND_INTERVAL
+		s.LocalResendInterval = constants.RESEND_INTERVAL
+		s.MainEOMWait = constants.EOM_WAIT
+		s.TestEOMWait = constants.EOM_WAIT
+		s.LocalEOMWait = constants.EOM_WAIT
 
 		s.LocalServerPrivKey = "4c38c72fc5cdad68f13b74674d3ffb1f3d63a112710868c9b08946553448d26d"
 		s.FactoshisPerEC = 006666
@@ -677,6 +1008,10 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.PortNumber = 8088
 		s.ControlPanelPort = 8090
 		s.ControlPanelSetting = 1
+		s.ControlPanelContentSecurityPolicy = "default-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; frame-ancestors 'none'"
+		s.FactomdContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+		s.HttpFrameOptions = "DENY"
+		s.HttpStrictTransportSecurity = "max-age=31536000"
 
 		// TODO:  Actually load the IdentityChainID from the config file
 		s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName))
@@ -704,6 +1039,9 @@ func (s *State) GetSalt(ts interfaces.Timestamp) uint32 {
 }
 
 func (s *State) Init() {
+	if s.Supervisor == nil {
+		s.Supervisor = supervisor.New(s.FactomNodeName)
+	}
 	if s.Salt == nil {
 		b := make([]byte, 32)
 		_, err := rand.Read(b)
@@ -737,30 +1075,48 @@ func (s *State) Init() {
 
 	s.ControlPanelChannel = make(chan DisplayState, 20)
 	s.tickerQueue = make(chan int, 100)                        //ticks from a clock
+	s.journalQueue = make(chan *JournalEntry)                  //messages being replayed from a journal
 	s.timerMsgQueue = make(chan interfaces.IMsg, 100)          //incoming eom notifications, used by leaders
 	s.TimeOffset = new(primitives.Timestamp)                   //interfaces.Timestamp(int64(rand.Int63() % int64(time.Microsecond*10)))
 	s.networkInvalidMsgQueue = make(chan interfaces.IMsg, 100) //incoming message queue from the network messages
 	s.InvalidMessages = make(map[[32]byte]interfaces.IMsg, 0)
+	s.InvalidReasons = make(map[[32]byte]string)
+	s.PendingReasons = make(map[[32]byte]string)
+	//incoming message queue for factom application messages, see inMsgQueue.go
+	s.inMsgQueue = NewPriorityInMsgQueue(10000)
 	s.networkOutMsgQueue = NewNetOutMsgQueue(1000)      //Messages to be broadcast to the network
-	s.inMsgQueue = NewInMsgQueue(10000)                 //incoming message queue for factom application messages
 	s.apiQueue = make(chan interfaces.IMsg, 100)        //incoming message queue from the API
 	s.ackQueue = make(chan interfaces.IMsg, 100)        //queue of Leadership messages
 	s.msgQueue = make(chan interfaces.IMsg, 400)        //queue of Follower messages
 	s.ShutdownChan = make(chan int, 1)                  //Channel to gracefully shut down.
+	s.ShutdownDone = make(chan int, 1)                  //Signaled once we have shut down.
 	s.MissingEntries = make(chan *MissingEntry, 1000)   //Entries I discover are missing from the database
 	s.UpdateEntryHash = make(chan *EntryUpdate, 10000)  //Handles entry hashes and updating Commit maps.
 	s.WriteEntry = make(chan interfaces.IEBEntry, 3000) //Entries to be written to the database
 
+	if s.SpamKeyRate <= 0 {
+		s.SpamKeyRate = DefaultSpamKeyRate
+	}
+	if s.QuarantineSize <= 0 {
+		s.QuarantineSize = DefaultQuarantineSize
+	}
+	s.spam = newSpamScorer()
+	s.quarantine = make(chan interfaces.IMsg, s.QuarantineSize) //Commits held back as likely spam
+	s.sigCache = newSigCache(SigCacheSize)
+
 	if s.Journaling {
-		f, err := os.Create(s.JournalFile)
+		// The journal is only ever appended to, so it covers restarts too
+		f, err := os.OpenFile(s.JournalFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
 		if err != nil {
 			fmt.Println("Could not create the journal file:", s.JournalFile)
 			s.JournalFile = ""
+		} else {
+			f.Close()
 		}
-		f.Close()
 	}
 	// Set up struct to stop replay attacks
 	s.Replay = new(Replay)
+	s.Replay.Window = s.ReplayWindow
 
 	// Set up maps for the followers
 	s.Holding = make(map[[32]byte]interfaces.IMsg)
@@ -768,8 +1124,8 @@ func (s *State) Init() {
 	s.Commits = make(map[[32]byte]interfaces.IMsg)
 
 	// Setup the FactoidState and Validation Service that holds factoid and entry credit balances
-	s.FactoidBalancesP = map[[32]byte]int64{}
-	s.ECBalancesP = map[[32]byte]int64{}
+	s.FactoidBalancesP = NewBalanceMap()
+	s.ECBalancesP = NewBalanceMap()
 
 	fs := new(FactoidState)
 	fs.State = s
@@ -793,12 +1149,21 @@ func (s *State) Init() {
 		s.Println("\n   +---------------------------+")
 		s.Println("   +------ Follower Only ------+")
 		s.Print("   +---------------------------+\n\n")
+	case "EXPLORER":
+		s.Leader = false
+		// Nothing can be changed on a public explorer through the Control Panel
+		if s.ControlPanelSetting > 1 {
+			s.ControlPanelSetting = 1
+		}
+		s.Println("\n   +---------------------------+")
+		s.Println("   +--- Read Only Explorer ----+")
+		s.Print("   +---------------------------+\n\n")
 	case "SERVER":
 		s.Println("\n   +-------------------------+")
 		s.Println("   |       Leader Node       |")
 		s.Print("   +-------------------------+\n\n")
 	default:
-		panic("Bad Node Mode (must be FULL or SERVER)")
+		panic("Bad Node Mode (must be FULL, SERVER or EXPLORER)")
 	}
 
 	//Database
@@ -823,6 +1188,26 @@ func (s *State) Init() {
 		s.DB.SetExportData(s.ExportDataSubpath)
 	}
 
+	go func() {
+		if err := s.DB.BuildTimeIndex(); err != nil {
+			s.Println("Error building the time index: ", err)
+		}
+	}()
+
+	go func() {
+		if err := s.DB.BuildExchangeRateIndex(); err != nil {
+			s.Println("Error building the exchange rate index: ", err)
+		}
+	}()
+
+	if s.EntryBloomMB > 0 {
+		go func() {
+			if err := s.DB.BuildEntryBloom(uint64(s.EntryBloomMB) << 23); err != nil {
+				s.Println("Error building the entry bloom: ", err)
+			}
+		}()
+	}
+
 	//Network
 	switch s.Network {
 	case "MAIN":
@@ -836,6 +1221,7 @@ func (s *State) Init() {
 	default:
 		panic("Bad value for Network in factomd.conf")
 	}
+	s.initCustomGenesis()
 
 	s.Println("\nRunning on the ", s.Network, "Network")
 	s.Println("\nExchange rate chain id set to ", s.FERChainId)
@@ -843,6 +1229,7 @@ func (s *State) Init() {
 
 	s.AuditHeartBeats = make([]interfaces.IMsg, 0)
 
+	s.initStandby()
 	s.initServerKeys()
 	s.AuthorityServerCount = 0
 
@@ -875,6 +1262,31 @@ func (s *State) Init() {
 			}
 		}
 	}
+
+	if s.StateSaverStruct.SnapshotInterval > 0 {
+		d, err := s.DB.FetchDBlockHead()
+		if err != nil {
+			panic(err)
+		}
+
+		if d == nil || d.GetDatabaseHeight() < 2000 {
+			// Same as FastBoot, don't keep a snapshot around for a database that has been deleted
+			s.StateSaverStruct.DeleteSnapshot(s.Network)
+		} else {
+			err = s.StateSaverStruct.LoadSnapshot(s, s.Network)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Restoring from FastBoot or a snapshot replaces the replay filter, so this goes last.
+	if err := s.LoadReplayFilter(); err != nil {
+		fmt.Println("Error loading the replay filter on", s.FactomNodeName, err)
+	}
+	if err := s.LoadHeldCommits(); err != nil {
+		fmt.Println("Error loading the held commits on", s.FactomNodeName, err)
+	}
 }
 
 func (s *State) GetEntryBlockDBHeightComplete() uint32 {
@@ -1002,6 +1414,10 @@ func (s *State) ValidatePrevious(dbheight uint32) error {
 }
 
 func (s *State) LoadDBState(dbheight uint32) (interfaces.IMsg, error) {
+	if dbheight < s.PrunedHeight {
+		return nil, fmt.Errorf("The factoid block at %d has been pruned", dbheight)
+	}
+
 	dblk, err := s.DB.FetchDBlockByHeight(dbheight)
 	if err != nil {
 		return nil, err
@@ -1009,7 +1425,10 @@ func (s *State) LoadDBState(dbheight uint32) (interfaces.IMsg, error) {
 
 	err = s.ValidatePrevious(dbheight)
 	if err != nil {
-		panic(err.Error() + " " + s.FactomNodeName)
+		if !s.SkipsValidation(dbheight) {
+			panic(err.Error() + " " + s.FactomNodeName)
+		}
+		s.warnSkippedValidation(dbheight, err.Error())
 	}
 
 	if dblk == nil {
@@ -1182,10 +1601,12 @@ func (s *State) fillHoldingMap() {
 		for i, msg := range s.Holding {
 			localMap[i] = msg
 		}
+		reasons := s.holdingReasons()
 		s.HoldingLast = time.Now().Unix()
 		s.HoldingMutex.Lock()
 		defer s.HoldingMutex.Unlock()
 		s.HoldingMap = localMap
+		s.HoldingReasons = reasons
 
 	}
 }
@@ -1232,7 +1653,7 @@ func (s *State) GetPendingEntries(params interface{}) []interfaces.IPendingEntry
 			if pl.DBHeight > LastComplete {
 				for _, v := range pl.VMs {
 					for _, plmsg := range v.List {
-						if plmsg.Type() == constants.COMMIT_CHAIN_MSG { //5
+						if plmsg.Type() == constants.COMMIT_CHAIN_MSG || plmsg.Type() == constants.BOUND_COMMIT_CHAIN_MSG { //5
 							enb, err := plmsg.MarshalBinary()
 							if err != nil {
 								return nil
@@ -1274,6 +1695,21 @@ func (s *State) GetPendingEntries(params interface{}) []interfaces.IPendingEntry
 							if !util.IsInPendingEntryList(resp, tmp) {
 								resp = append(resp, tmp)
 							}
+						} else if b, ok := plmsg.(*messages.BatchCommitEntryMsg); ok { //33
+							for _, c := range b.Commits {
+								tmp.EntryHash = c.EntryHash
+
+								tmp.ChainID = nil
+								if pl.DBHeight > s.GetDBHeightComplete() {
+									tmp.Status = "AckStatusACK"
+								} else {
+									tmp.Status = "AckStatusDBlockConfirmed"
+								}
+
+								if !util.IsInPendingEntryList(resp, tmp) {
+									resp = append(resp, tmp)
+								}
+							}
 						} else if plmsg.Type() == constants.REVEAL_ENTRY_MSG { //13
 							enb, err := plmsg.MarshalBinary()
 							if err != nil {
@@ -1421,7 +1857,7 @@ func (s *State) FetchEntryHashFromProcessListsByTxID(txID string) (interfaces.IH
 					for _, plmsg := range v.List {
 						if plmsg != nil {
 							//	if plmsg.Type() != nil {
-							if plmsg.Type() == constants.COMMIT_CHAIN_MSG { //5 other types could be in this VM
+							if plmsg.Type() == constants.COMMIT_CHAIN_MSG || plmsg.Type() == constants.BOUND_COMMIT_CHAIN_MSG { //5 other types could be in this VM
 								enb, err := plmsg.MarshalBinary()
 								if err != nil {
 									return nil, err
@@ -1448,6 +1884,12 @@ func (s *State) FetchEntryHashFromProcessListsByTxID(txID string) (interfaces.IH
 									return ce.CommitEntry.EntryHash, nil
 								}
 
+							} else if b, ok := plmsg.(*messages.BatchCommitEntryMsg); ok { //33
+								for _, c := range b.Commits {
+									if c.GetSigHash().String() == txID {
+										return c.EntryHash, nil
+									}
+								}
 							} else if plmsg.Type() == constants.REVEAL_ENTRY_MSG { //13
 								enb, err := plmsg.MarshalBinary()
 								if err != nil {
@@ -1493,14 +1935,10 @@ func (s *State) DatabaseContains(hash interfaces.IHash) bool {
 	return false
 }
 
-// JournalMessage writes the message to the message journal for debugging
+// JournalMessage writes the message to the message journal, with the time it was delivered to
+// the State, so the journal can be replayed.  Messages being replayed are not journaled again.
 func (s *State) JournalMessage(msg interfaces.IMsg) {
-	type journalentry struct {
-		Type    byte
-		Message interfaces.IMsg
-	}
-
-	if s.Journaling && len(s.JournalFile) != 0 {
+	if s.Journaling && len(s.JournalFile) != 0 && !s.IsReplaying {
 		f, err := os.OpenFile(s.JournalFile, os.O_APPEND+os.O_WRONLY, 0666)
 		if err != nil {
 			s.JournalFile = ""
@@ -1508,12 +1946,10 @@ func (s *State) JournalMessage(msg interfaces.IMsg) {
 		}
 		defer f.Close()
 
-		e := new(journalentry)
-		e.Type = msg.Type()
-		e.Message = msg
-
-		p, err := json.Marshal(e)
+		e := &JournalEntry{msg, s.GetTimestamp(), msg.IsLocal()}
+		p, err := e.MarshalJournal()
 		if err != nil {
+			s.Logf("error", "Message not journaled: %s", err.Error())
 			return
 		}
 		fmt.Fprintln(f, string(p))
@@ -1594,9 +2030,7 @@ func (s *State) UpdateState() (progress bool) {
 	progress = progress || p2
 
 	s.SetString()
-	if s.ControlPanelDataRequest {
-		s.CopyStateToControlPanel()
-	}
+	s.CopyStateToControlPanel()
 
 	// Update our TPS every ~ 3 seconds at the earliest
 	if s.lasttime.Before(time.Now().Add(-3 * time.Second)) {
@@ -1688,6 +2122,16 @@ func (s *State) GetOnlineAuditServers(dbheight uint32) []interfaces.IServer {
 	return onlineAuditServers
 }
 
+// IsAuthority is true if we are a federated or audit server at dbheight.
+func (s *State) IsAuthority(dbheight uint32) bool {
+	for _, server := range append(s.GetFedServers(dbheight), s.GetAuditServers(dbheight)...) {
+		if server.GetChainID().IsSameAs(s.IdentityChainID) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *State) IsLeader() bool {
 	return s.Leader
 }
@@ -1806,11 +2250,10 @@ func (s *State) SetIsDoneReplaying() {
 
 // Returns a millisecond timestamp
 func (s *State) GetTimestamp() interfaces.Timestamp {
-	if s.IsReplaying == true {
-		fmt.Println("^^^^^^^^ IsReplying is true")
+	if s.IsReplaying == true && s.ReplayTimestamp != nil {
 		return s.ReplayTimestamp
 	}
-	return primitives.NewTimestampNow()
+	return primitives.NewTimestampFromMilliseconds(uint64(s.ClockNow().UnixNano() / 1e6))
 }
 
 func (s *State) GetTimeOffset() interfaces.Timestamp {
@@ -2408,6 +2851,12 @@ func (s *State) ProcessInvalidMsgQueue() {
 		//Clearing old invalid messages
 		s.InvalidMessages = map[[32]byte]interfaces.IMsg{}
 	}
+	if len(s.InvalidReasons) > 2048 {
+		s.InvalidReasons = map[[32]byte]string{}
+	}
+	if len(s.PendingReasons) > 2048 {
+		s.PendingReasons = map[[32]byte]string{}
+	}
 
 	for {
 		if len(s.networkInvalidMsgQueue) == 0 {
@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"hash"
-	"time"
 
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/entryBlock"
@@ -31,6 +30,8 @@ var _ = (*hash.Hash32)(nil)
 func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 	_, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, msg.GetRepeatHash().Fixed(), msg.GetTimestamp(), s.GetTimestamp())
 	if !ok {
+		reason := s.CountReplayDrop(ReplayFilterInternal, msg)
+		s.LogMsg(msg, "dropped: %s", reason)
 		return
 	}
 	s.SetString()
@@ -43,8 +44,10 @@ func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 		}
 	}
 
-	switch msg.Validate(s) {
+	v := msg.Validate(s)
+	switch v.Code {
 	case 1:
+		s.watchStandbyIdentity(msg)
 		if s.RunLeader &&
 			s.Leader &&
 			!s.Saving &&
@@ -56,16 +59,27 @@ func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 				s.SendDBSig(s.LLeaderHeight, s.LeaderVMIndex)
 				s.XReview = append(s.XReview, msg)
 			} else {
+				s.LogMsg(msg, "executing as leader")
 				msg.LeaderExecute(s)
 			}
 		} else {
+			s.LogMsg(msg, "executing as follower")
 			msg.FollowerExecute(s)
 		}
+		s.ProcessedMsgs.Add(msg, "executed")
 		ret = true
 	case 0:
-		s.Holding[msg.GetMsgHash().Fixed()] = msg
+		s.LogMsg(msg, "holding, can't validate yet: %s", v.Reason)
+		s.ProcessedMsgs.Add(msg, "holding")
+		s.HoldUntilValid(msg)
 	default:
-		s.Holding[msg.GetMsgHash().Fixed()] = msg
+		s.LogMsg(msg, "invalid: %s", v.Reason)
+		s.Logf("debug", "Message %x invalid: %s", msg.GetHash().Bytes()[:4], v.Reason)
+		s.ProcessedMsgs.Add(msg, "invalid")
+		if s.GetInvalidReason(msg.GetHash()) == "" { // Keep a more detailed reason, like CheckRevealCost's
+			s.SetInvalidReason(msg.GetHash(), v.Reason.String())
+		}
+		s.HoldOnSelf(msg, HoldInvalid)
 		if !msg.SentInvlaid() {
 			msg.MarkSentInvalid(true)
 			s.networkInvalidMsgQueue <- msg
@@ -151,7 +165,7 @@ ackLoop:
 		select {
 		case ack := <-s.ackQueue:
 			a := ack.(*messages.Ack)
-			if a.DBHeight >= s.LLeaderHeight && ack.Validate(s) == 1 {
+			if a.DBHeight >= s.LLeaderHeight && ack.Validate(s).Code == 1 {
 				if s.IgnoreMissing {
 					now := s.GetTimestamp().GetTimeSeconds()
 					if now-a.GetTimestamp().GetTimeSeconds() < 60*15 {
@@ -234,6 +248,11 @@ func CheckDBKeyMR(s *State, ht uint32, hash string) error {
 // review if this is a leader, and those messages are that leader's
 // responsibility
 func (s *State) ReviewHolding() {
+	// Keep a flood from growing these without bound, even when we are too busy to review them
+	s.TrimHolding()
+	s.TrimAcks()
+	s.releaseHeights()
+
 	if len(s.XReview) > 0 {
 		return
 	}
@@ -317,14 +336,14 @@ func (s *State) ReviewHolding() {
 		}
 
 		if v.Resend(s) {
-			if v.Validate(s) == 1 {
+			if v.Validate(s).Code == 1 {
 				s.ResendCnt++
 				v.SendOut(s, v)
 				continue
 			}
 		}
 
-		if v.Validate(s) < 0 {
+		if v.Validate(s).Code < 0 {
 			delete(s.Holding, k)
 			continue
 		}
@@ -401,7 +420,7 @@ func (s *State) AddDBState(isNew bool,
 // Returns true if it finds a match, puts the message in holding, or invalidates the message
 func (s *State) FollowerExecuteMsg(m interfaces.IMsg) {
 
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.HoldOnSelf(m, HoldMissingAck)
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 
 	if ack != nil {
@@ -423,7 +442,7 @@ func (s *State) FollowerExecuteEOM(m interfaces.IMsg) {
 		return // This is an internal EOM message.  We are not a leader so ignore.
 	}
 
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.HoldOnSelf(m, HoldMissingAck)
 
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 	if ack != nil {
@@ -587,11 +606,22 @@ func (s *State) FollowerExecuteMMR(m interfaces.IMsg) {
 		return
 	}
 
-	mmr, _ := m.(*messages.MissingMsgResponse)
+	switch mmr := m.(type) {
+	case *messages.MissingMsgResponse:
+		s.applyMissingMsgResponse(mmr)
+	case *messages.MissingMsgBatch:
+		for _, r := range mmr.Responses {
+			s.applyMissingMsgResponse(r)
+		}
+	}
+}
 
+// applyMissingMsgResponse puts a message and its ack, or a full fault, that we asked for into
+// the process list, or back into review if we haven't seen them yet.
+func (s *State) applyMissingMsgResponse(mmr *messages.MissingMsgResponse) {
 	fullFault, ok := mmr.MsgResponse.(*messages.FullServerFault)
 	if ok && fullFault != nil {
-		switch fullFault.Validate(s) {
+		switch fullFault.Validate(s).Code {
 		case 1:
 			pl := s.ProcessLists.Get(fullFault.DBHeight)
 			if pl != nil && fullFault.HasEnoughSigs(s) && s.pledgedByAudit(fullFault) {
@@ -617,7 +647,7 @@ func (s *State) FollowerExecuteMMR(m interfaces.IMsg) {
 	ack, ok := mmr.AckResponse.(*messages.Ack)
 
 	// If we don't need this message, we don't have to do everything else.
-	if !ok || ack.Validate(s) == -1 {
+	if !ok || ack.Validate(s).Code == -1 {
 		return
 	}
 
@@ -705,56 +735,89 @@ func (s *State) FollowerExecuteDataResponse(m interfaces.IMsg) {
 	}
 }
 
+// FollowerExecuteMissingMsg answers a MissingMsg with a MissingMsgResponse for each height asked
+// for, or a MissingMsgRange with MissingMsgBatches covering the range.
 func (s *State) FollowerExecuteMissingMsg(msg interfaces.IMsg) {
 	// Don't respond to missing messages if we are behind.
 	if s.inMsgQueue.Length() > constants.INMSGQUEUE_LOW {
 		return
 	}
 
-	m := msg.(*messages.MissingMsg)
+	var dbheight, systemHeight uint32
+	var heights []uint32
+	var batch bool
+	switch m := msg.(type) {
+	case *messages.MissingMsg:
+		dbheight, systemHeight, heights = m.DBHeight, m.SystemHeight, m.ProcessListHeight
+	case *messages.MissingMsgRange:
+		dbheight, systemHeight, batch = m.DBHeight, m.SystemHeight, true
+		for h := m.Start; h <= m.End && h-m.Start < messages.MaxMissingMsgRange; h++ {
+			heights = append(heights, h)
+		}
+	default:
+		return
+	}
 
-	pl := s.ProcessLists.Get(m.DBHeight)
+	pl := s.ProcessLists.Get(dbheight)
 
 	if pl == nil {
 		s.MissingRequestIgnoreCnt++
 		return
 	}
-	sent := false
-	if len(pl.System.List) > int(m.SystemHeight) && pl.System.List[m.SystemHeight] != nil {
-		msgResponse := messages.NewMissingMsgResponse(s, pl.System.List[m.SystemHeight], nil)
-		msgResponse.SetOrigin(m.GetOrigin())
-		msgResponse.SetNetworkOrigin(m.GetNetworkOrigin())
-		s.NetworkOutMsgQueue().Enqueue(msgResponse)
-		s.MissingRequestReplyCnt++
-		sent = true
+
+	var responses []*messages.MissingMsgResponse
+	if len(pl.System.List) > int(systemHeight) && pl.System.List[systemHeight] != nil {
+		responses = append(responses, messages.NewMissingMsgResponse(s, pl.System.List[systemHeight], nil).(*messages.MissingMsgResponse))
 	}
 
-	for _, h := range m.ProcessListHeight {
-		missingmsg, ackMsg, err := s.LoadSpecificMsgAndAck(m.DBHeight, m.VMIndex, h)
+	for _, h := range heights {
+		missingmsg, ackMsg, err := s.LoadSpecificMsgAndAck(dbheight, msg.GetVMIndex(), h)
 
+		// If I don't have this message, ignore.  Nor can I answer for an ack that came in an
+		// AckBatch, as it has no signature of its own (see ackBatch.go).
+		if ack, ok := ackMsg.(*messages.Ack); ok && ack != nil && ack.Signature == nil {
+			continue
+		}
 		if missingmsg != nil && ackMsg != nil && err == nil {
-			// If I don't have this message, ignore.
-			msgResponse := messages.NewMissingMsgResponse(s, missingmsg, ackMsg)
-			msgResponse.SetOrigin(m.GetOrigin())
-			msgResponse.SetNetworkOrigin(m.GetNetworkOrigin())
-			s.NetworkOutMsgQueue().Enqueue(msgResponse)
-			s.MissingRequestReplyCnt++
-			sent = true
+			responses = append(responses, messages.NewMissingMsgResponse(s, missingmsg, ackMsg).(*messages.MissingMsgResponse))
 		}
 	}
 
-	if !sent {
+	if len(responses) == 0 {
 		s.MissingRequestIgnoreCnt++
+		return
+	}
+
+	reply := func(m interfaces.IMsg) {
+		m.SetOrigin(msg.GetOrigin())
+		m.SetNetworkOrigin(msg.GetNetworkOrigin())
+		s.NetworkOutMsgQueue().Enqueue(m)
+		s.MissingRequestReplyCnt++
+	}
+
+	if !batch {
+		for _, r := range responses {
+			reply(r)
+		}
+		return
+	}
+
+	for len(responses) > 0 {
+		n := len(responses)
+		if n > messages.MaxMissingMsgBatch {
+			n = messages.MaxMissingMsgBatch
+		}
+		b := messages.NewMissingMsgBatch(s)
+		b.Responses = responses[:n]
+		responses = responses[n:]
+		reply(b)
 	}
-	return
 }
 
 func (s *State) FollowerExecuteCommitChain(m interfaces.IMsg) {
 	s.FollowerExecuteMsg(m)
 	cc := m.(*messages.CommitChainMsg)
-	re := s.Holding[cc.CommitChain.EntryHash.Fixed()]
-	if re != nil {
-		s.XReview = append(s.XReview, re)
+	for _, re := range s.ReleaseHeld(cc.CommitChain.EntryHash.Fixed()) {
 		re.SendOut(s, re)
 	}
 }
@@ -762,20 +825,28 @@ func (s *State) FollowerExecuteCommitChain(m interfaces.IMsg) {
 func (s *State) FollowerExecuteCommitEntry(m interfaces.IMsg) {
 	s.FollowerExecuteMsg(m)
 	ce := m.(*messages.CommitEntryMsg)
-	re := s.Holding[ce.CommitEntry.EntryHash.Fixed()]
-	if re != nil {
-		s.XReview = append(s.XReview, re)
+	for _, re := range s.ReleaseHeld(ce.CommitEntry.EntryHash.Fixed()) {
 		re.SendOut(s, re)
 	}
 }
 
+func (s *State) FollowerExecuteBatchCommitEntry(m interfaces.IMsg) {
+	s.FollowerExecuteMsg(m)
+	b := m.(*messages.BatchCommitEntryMsg)
+	for _, ce := range b.Commits {
+		for _, re := range s.ReleaseHeld(ce.EntryHash.Fixed()) {
+			re.SendOut(s, re)
+		}
+	}
+}
+
 func (s *State) FollowerExecuteRevealEntry(m interfaces.IMsg) {
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.HoldOnSelf(m, HoldMissingAck)
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 
 	if ack != nil {
 		m.SendOut(s, m)
-		ack.SendOut(s, ack)
+		s.sendAck(ack, m)
 		m.SetLeaderChainID(ack.GetLeaderChainID())
 		m.SetMinute(ack.Minute)
 
@@ -829,6 +900,7 @@ func (s *State) LeaderExecuteEOM(m interfaces.IMsg) {
 			eom.SysHash = ff.GetSerialHash()
 		}
 	}
+	eom.ResetMarshalCache()
 
 	if s.Syncing && vm.Synced {
 		return
@@ -889,6 +961,7 @@ func (s *State) LeaderExecuteDBSig(m interfaces.IMsg) {
 			dbs.SysHash = ff.GetSerialHash()
 		}
 	}
+	dbs.ResetMarshalCache()
 
 	_, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, m.GetRepeatHash().Fixed(), m.GetTimestamp(), s.GetTimestamp())
 	if !ok {
@@ -905,32 +978,64 @@ func (s *State) LeaderExecuteDBSig(m interfaces.IMsg) {
 }
 
 func (s *State) LeaderExecuteCommitChain(m interfaces.IMsg) {
+	if s.CheckCommitReplacement(m) != nil {
+		return
+	}
 	s.LeaderExecute(m)
 	cc := m.(*messages.CommitChainMsg)
-	re := s.Holding[cc.CommitChain.EntryHash.Fixed()]
-	if re != nil {
-		s.XReview = append(s.XReview, re)
+	for _, re := range s.ReleaseHeld(cc.CommitChain.EntryHash.Fixed()) {
 		re.SendOut(s, re)
 	}
 }
 
 func (s *State) LeaderExecuteCommitEntry(m interfaces.IMsg) {
+	if s.CheckCommitReplacement(m) != nil {
+		return
+	}
 	s.LeaderExecute(m)
 	ce := m.(*messages.CommitEntryMsg)
-	re := s.Holding[ce.CommitEntry.EntryHash.Fixed()]
-	if re != nil {
-		s.XReview = append(s.XReview, re)
+	for _, re := range s.ReleaseHeld(ce.CommitEntry.EntryHash.Fixed()) {
 		re.SendOut(s, re)
 	}
 }
 
+// LeaderExecuteBatchCommitEntry acks a batch of commits only if none of them has been recorded
+// already, alone or in another batch, and none of their entries revealed; then it records them,
+// so that they can't be acked again.
+func (s *State) LeaderExecuteBatchCommitEntry(m interfaces.IMsg) {
+	b := m.(*messages.BatchCommitEntryMsg)
+	now := s.GetTimestamp()
+	if _, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, m.GetRepeatHash().Fixed(), m.GetTimestamp(), now); !ok {
+		delete(s.Holding, m.GetMsgHash().Fixed())
+		return
+	}
+	for _, ce := range b.Commits {
+		if !s.NoEntryYet(ce.EntryHash, ce.GetTimestamp()) {
+			s.FollowerExecuteBatchCommitEntry(m)
+			return
+		}
+		if _, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, ce.GetSigHash().Fixed(), ce.GetTimestamp(), now); !ok {
+			delete(s.Holding, m.GetMsgHash().Fixed())
+			return
+		}
+	}
+
+	s.LeaderExecute(m)
+	for _, ce := range b.Commits {
+		s.Replay.IsTSValid_(constants.INTERNAL_REPLAY, ce.GetSigHash().Fixed(), ce.GetTimestamp(), now)
+		for _, re := range s.ReleaseHeld(ce.EntryHash.Fixed()) {
+			re.SendOut(s, re)
+		}
+	}
+}
+
 func (s *State) LeaderExecuteRevealEntry(m interfaces.IMsg) {
 	re := m.(*messages.RevealEntryMsg)
 	eh := re.Entry.GetHash()
 
 	rtn := re.Validate(s)
 
-	switch rtn {
+	switch rtn.Code {
 	case 0:
 		m.FollowerExecute(s)
 		return
@@ -938,6 +1043,10 @@ func (s *State) LeaderExecuteRevealEntry(m interfaces.IMsg) {
 		return
 	}
 
+	if s.CheckChainBinding(m) != nil {
+		return
+	}
+
 	commit := s.NextCommit(eh)
 
 	now := s.GetTimestamp()
@@ -1026,14 +1135,12 @@ func (s *State) ProcessCommitChain(dbheight uint32, commitChain interfaces.IMsg)
 	pl := s.ProcessLists.Get(dbheight)
 	pl.EntryCreditBlock.GetBody().AddEntry(c.CommitChain)
 	if e := s.GetFactoidState().UpdateECTransaction(true, c.CommitChain); e == nil {
+		s.RecordBlockEvent(dbheight, TimelineFirstCommit)
 		// save the Commit to match agains the Reveal later
 		h := c.CommitChain.EntryHash
 		s.PutCommit(h, c)
-		entry := s.Holding[h.Fixed()]
-		if entry != nil {
+		for _, entry := range s.ReleaseHeld(h.Fixed()) {
 			entry.SendOut(s, entry)
-			s.XReview = append(s.XReview, entry)
-			delete(s.Holding, h.Fixed())
 		}
 		return true
 	}
@@ -1048,14 +1155,12 @@ func (s *State) ProcessCommitEntry(dbheight uint32, commitEntry interfaces.IMsg)
 	pl := s.ProcessLists.Get(dbheight)
 	pl.EntryCreditBlock.GetBody().AddEntry(c.CommitEntry)
 	if e := s.GetFactoidState().UpdateECTransaction(true, c.CommitEntry); e == nil {
+		s.RecordBlockEvent(dbheight, TimelineFirstCommit)
 		// save the Commit to match agains the Reveal later
 		h := c.CommitEntry.EntryHash
 		s.PutCommit(h, c)
-		entry := s.Holding[h.Fixed()]
-		if entry != nil {
+		for _, entry := range s.ReleaseHeld(h.Fixed()) {
 			entry.SendOut(s, entry)
-			s.XReview = append(s.XReview, entry)
-			delete(s.Holding, h.Fixed())
 		}
 		return true
 	}
@@ -1064,6 +1169,44 @@ func (s *State) ProcessCommitEntry(dbheight uint32, commitEntry interfaces.IMsg)
 	return false
 }
 
+// ProcessBatchCommitEntry processes every commit of a batch as ProcessCommitEntry would one, or
+// none of them, until every key can pay for all of its commits.  Each is kept as a CommitEntryMsg
+// of its own to match its reveal against.
+func (s *State) ProcessBatchCommitEntry(dbheight uint32, m interfaces.IMsg) bool {
+	b, _ := m.(*messages.BatchCommitEntryMsg)
+
+	for key, credits := range b.Credits() {
+		if credits > s.GetFactoidState().GetECBalance(key) {
+			return false
+		}
+	}
+
+	pl := s.ProcessLists.Get(dbheight)
+	now := s.GetTimestamp()
+	for _, ce := range b.Commits {
+		if e := s.GetFactoidState().UpdateECTransaction(true, ce); e != nil {
+			// The balances were checked above, so this is a commit of a key gone negative
+			s.Logf("error", "Batch %x: commit %x: %v", b.GetMsgHash().Bytes()[:3], ce.GetSigHash().Bytes()[:3], e)
+			continue
+		}
+		pl.EntryCreditBlock.GetBody().AddEntry(ce)
+		s.Replay.IsTSValid_(constants.INTERNAL_REPLAY, ce.GetSigHash().Fixed(), ce.GetTimestamp(), now)
+
+		c := messages.NewCommitEntryMsg()
+		c.CommitEntry = ce
+		c.SetLeaderChainID(b.GetLeaderChainID())
+		c.SetMinute(b.GetMinute())
+		c.SetVMIndex(b.GetVMIndex())
+		h := ce.EntryHash
+		s.PutCommit(h, c)
+		for _, entry := range s.ReleaseHeld(h.Fixed()) {
+			entry.SendOut(s, entry)
+		}
+	}
+	s.RecordBlockEvent(dbheight, TimelineFirstCommit)
+	return true
+}
+
 func (s *State) ProcessRevealEntry(dbheight uint32, m interfaces.IMsg) bool {
 
 	msg := m.(*messages.RevealEntryMsg)
@@ -1093,6 +1236,8 @@ func (s *State) ProcessRevealEntry(dbheight uint32, m interfaces.IMsg) bool {
 		// Put it in our list of new Entry Blocks for this Directory Block
 		s.PutNewEBlocks(dbheight, chainID, eb)
 		s.PutNewEntries(dbheight, myhash, msg.Entry)
+		s.ProcessLists.Get(dbheight).SetEntryCorrelationID(myhash, logTag(msg))
+		s.LogMsg(msg, "new chain at height %d", dbheight)
 
 		s.IncEntryChains()
 		s.IncEntries()
@@ -1122,6 +1267,8 @@ func (s *State) ProcessRevealEntry(dbheight uint32, m interfaces.IMsg) bool {
 	// Put it in our list of new Entry Blocks for this Directory Block
 	s.PutNewEBlocks(dbheight, chainID, eb)
 	s.PutNewEntries(dbheight, myhash, msg.Entry)
+	s.ProcessLists.Get(dbheight).SetEntryCorrelationID(myhash, logTag(msg))
+	s.LogMsg(msg, "added to the entry block at height %d", dbheight)
 
 	// Monitor key changes for fed/audit servers
 	LoadIdentityByEntry(msg.Entry, s, dbheight, false)
@@ -1283,6 +1430,8 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 		//	e.VMIndex, allfaults, s.EOMProcessed, s.EOMLimit, s.EOMDone))
 
 		s.EOMDone = true
+		s.RecordBlockEvent(e.DBHeight, TimelineEOM(int(e.Minute)))
+		s.endMinute()
 		for _, eb := range pl.NewEBlocks {
 			eb.AddEndOfMinuteMarker(byte(e.Minute + 1))
 		}
@@ -1302,6 +1451,7 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 
 		switch {
 		case s.CurrentMinute < 10:
+			pl.Checkpoint(s.CurrentMinute)
 			if s.CurrentMinute == 1 {
 				dbstate := s.GetDBState(dbheight - 1)
 				if !dbstate.Saved {
@@ -1336,6 +1486,7 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 
 			s.GetAckChange()
 			s.CheckForIDChange()
+			s.checkStandbyPromotion()
 
 			s.LeaderPL = s.ProcessLists.Get(s.LLeaderHeight)
 			s.Leader, s.LeaderVMIndex = s.LeaderPL.GetVirtualServers(0, s.IdentityChainID)
@@ -1372,14 +1523,7 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 			s.Saving = true
 		}
 
-		for k, v := range s.Commits {
-			if v != nil {
-				_, ok := s.Replay.Valid(constants.TIME_TEST, v.GetRepeatHash().Fixed(), v.GetTimestamp(), s.GetTimestamp())
-				if !ok {
-					delete(s.Commits, k)
-				}
-			}
-		}
+		s.ExpireCommits()
 
 		for k := range s.Acks {
 			v := s.Acks[k].(*messages.Ack)
@@ -1396,6 +1540,9 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 }
 
 func (s *State) CheckForIDChange() {
+	if s.IsStandby() {
+		return // A standby takes over its identity with PromoteStandby
+	}
 	var reloadIdentity bool = false
 	if s.AckChange > 0 {
 		if s.LLeaderHeight >= s.AckChange {
@@ -1559,6 +1706,7 @@ func (s *State) ProcessDBSig(dbheight uint32, msg interfaces.IMsg) bool {
 		s.ReviewHolding()
 		s.Saving = false
 		s.DBSigDone = true
+		s.RecordBlockEvent(dbheight, TimelineDBSig)
 	}
 	return false
 	/*
@@ -1701,7 +1849,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 				pl.State.AddAuthorityDelta(authorityDeltaString)
 				//s.AddStatus(authorityDeltaString)
 
-				pl.State.LastFaultAction = time.Now().Unix()
+				pl.State.LastFaultAction = s.ClockNow().Unix()
 				markNoFault(pl, fullFault.GetVMIndex())
 				nextIndex := (int(fullFault.VMIndex) + 1) % len(pl.FedServers)
 				if pl.VMs[nextIndex].FaultFlag > 0 {
@@ -1739,7 +1887,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 			if auth == nil {
 				isPledge = false
 			} else {
-				valid, err := auth.VerifySignature(lbytes, signature.GetSignature())
+				valid, err := s.VerifyAuthority(auth, lbytes, signature.GetSignature())
 				if err == nil && valid {
 					isPledge = true
 					fullFault.SetPledgeDone(true)
@@ -1761,7 +1909,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 					if myAuth == nil || err != nil {
 						continue
 					}
-					valid, err := myAuth.VerifySignature(sfbytes, signature.GetSignature())
+					valid, err := s.VerifyAuthority(myAuth, sfbytes, signature.GetSignature())
 					if err == nil && valid {
 						fullFault.SetMyVoteTallied(true)
 					}
@@ -1771,7 +1919,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 
 		if s.Leader || s.IdentityChainID.IsSameAs(fullFault.AuditServerID) {
 			if !fullFault.GetMyVoteTallied() {
-				now := time.Now().Unix()
+				now := s.ClockNow().Unix()
 				if now-fullFault.LastMatch > 5 && int(now-s.LastTiebreak) > s.FaultTimeout/2 {
 					if fullFault.SigTally(s) >= len(pl.FedServers)-1 {
 						s.LastTiebreak = now
@@ -1820,8 +1968,12 @@ func (s *State) SendHeartBeat() {
 			hb.SecretNumber = s.GetSalt(hb.Timestamp)
 			hb.DBlockHash = dbstate.DBHash
 			hb.IdentityChainID = s.IdentityChainID
+			hb.Health = s.NewHeartbeatHealth()
 			hb.Sign(s.GetServerPrivateKey())
 			hb.SendOut(s, hb)
+			if health, ok := hb.GetNodeHealth(); ok {
+				s.PutNodeHealth(health)
+			}
 		}
 	}
 }
@@ -1877,20 +2029,24 @@ func (s *State) NextCommit(hash interfaces.IHash) interfaces.IMsg {
 	return c
 }
 
+// PutCommit keeps msg as the commit to match the reveal of the entry with this hash against, if
+// it supersedes the one kept already; see commitReplacement.go.
 func (s *State) PutCommit(hash interfaces.IHash, msg interfaces.IMsg) {
-	e, ok1 := s.Commits[hash.Fixed()].(*messages.CommitEntryMsg)
-	m, ok1b := msg.(*messages.CommitEntryMsg)
-	ec, ok2 := s.Commits[hash.Fixed()].(*messages.CommitChainMsg)
-	mc, ok2b := msg.(*messages.CommitEntryMsg)
-
-	// Keep the most entry credits.
-
-	switch {
-	case ok1 && ok1b && e.CommitEntry.Credits > m.CommitEntry.Credits:
-	case ok2 && ok2b && ec.CommitChain.Credits > mc.CommitEntry.Credits:
-	default:
+	pending := s.Commits[hash.Fixed()]
+	if pending != nil && pending.GetRepeatHash().IsSameAs(msg.GetRepeatHash()) {
 		s.Commits[hash.Fixed()] = msg
+		s.reserveChain(msg)
+		return
+	}
+	if !s.supersedes(msg, pending) {
+		s.supersede(hash, msg) // Paid for, but never the commit of the entry
+		return
+	}
+	if pending != nil {
+		s.supersede(hash, pending)
 	}
+	s.Commits[hash.Fixed()] = msg
+	s.reserveChain(msg)
 }
 
 func (s *State) GetHighestAck() uint32 {
@@ -1935,17 +2091,15 @@ func (s *State) GetHighestKnownBlock() uint32 {
 func (s *State) GetF(rt bool, adr [32]byte) (v int64) {
 	ok := false
 	if rt {
-		pl := s.ProcessLists.Get(s.LLeaderHeight)
+		pl := s.ProcessLists.GetSafe(s.LLeaderHeight)
 		if pl != nil {
-			pl.FactoidBalancesTMutex.Lock()
-			defer pl.FactoidBalancesTMutex.Unlock()
+			pl.FactoidBalancesTMutex.RLock()
+			defer pl.FactoidBalancesTMutex.RUnlock()
 			v, ok = pl.FactoidBalancesT[adr]
 		}
 	}
 	if !ok {
-		s.FactoidBalancesPMutex.Lock()
-		defer s.FactoidBalancesPMutex.Unlock()
-		v = s.FactoidBalancesP[adr]
+		v, _ = s.FactoidBalancesP.Get(adr)
 	}
 
 	return v
@@ -1960,29 +2114,26 @@ func (s *State) PutF(rt bool, adr [32]byte, v int64) {
 			pl.FactoidBalancesTMutex.Lock()
 			defer pl.FactoidBalancesTMutex.Unlock()
 
+			pl.unshareFactoidBalances()
 			pl.FactoidBalancesT[adr] = v
 		}
 	} else {
-		s.FactoidBalancesPMutex.Lock()
-		defer s.FactoidBalancesPMutex.Unlock()
-		s.FactoidBalancesP[adr] = v
+		s.FactoidBalancesP.Put(adr, v)
 	}
 }
 
 func (s *State) GetE(rt bool, adr [32]byte) (v int64) {
 	ok := false
 	if rt {
-		pl := s.ProcessLists.Get(s.LLeaderHeight)
+		pl := s.ProcessLists.GetSafe(s.LLeaderHeight)
 		if pl != nil {
-			pl.ECBalancesTMutex.Lock()
-			defer pl.ECBalancesTMutex.Unlock()
+			pl.ECBalancesTMutex.RLock()
+			defer pl.ECBalancesTMutex.RUnlock()
 			v, ok = pl.ECBalancesT[adr]
 		}
 	}
 	if !ok {
-		s.ECBalancesPMutex.Lock()
-		defer s.ECBalancesPMutex.Unlock()
-		v = s.ECBalancesP[adr]
+		v, _ = s.ECBalancesP.Get(adr)
 	}
 	return v
 
@@ -1995,12 +2146,11 @@ func (s *State) PutE(rt bool, adr [32]byte, v int64) {
 		if pl != nil {
 			pl.ECBalancesTMutex.Lock()
 			defer pl.ECBalancesTMutex.Unlock()
+			pl.unshareECBalances()
 			pl.ECBalancesT[adr] = v
 		}
 	} else {
-		s.ECBalancesPMutex.Lock()
-		defer s.ECBalancesPMutex.Unlock()
-		s.ECBalancesP[adr] = v
+		s.ECBalancesP.Put(adr, v)
 	}
 }
 
@@ -2037,6 +2187,8 @@ func (s *State) NewAck(msg interfaces.IMsg, balanceHash interfaces.IHash) interf
 
 	msg.SetLeaderChainID(s.IdentityChainID)
 	ack := new(messages.Ack)
+	ack.SetCorrelationID(msg.GetCorrelationID())
+	ack.SetTraceID(msg.GetTraceID())
 	ack.DBHeight = s.LLeaderHeight
 	ack.VMIndex = vmIndex
 	ack.Minute = byte(s.ProcessLists.Get(s.LLeaderHeight).VMs[vmIndex].LeaderMinute)
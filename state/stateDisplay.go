@@ -77,12 +77,22 @@ func NewDisplayState() *DisplayState {
 	return d
 }
 
-// Sends the copy of State over channel to control panel
+// RequestControlPanelData asks for a copy of State to be sent to the control panel
+func (s *State) RequestControlPanelData() {
+	s.controlPanelMutex.Lock()
+	s.ControlPanelDataRequest = true
+	s.controlPanelMutex.Unlock()
+}
+
+// Sends the copy of State over channel to control panel, if one was requested
 func (s *State) CopyStateToControlPanel() error {
-	if !s.ControlPanelDataRequest {
+	s.controlPanelMutex.Lock()
+	requested := s.ControlPanelDataRequest
+	s.ControlPanelDataRequest = false
+	s.controlPanelMutex.Unlock()
+	if !requested {
 		return nil
 	}
-	s.ControlPanelDataRequest = false
 	if len(s.ControlPanelChannel) < ControlPanelAllowedSize {
 		ds, err := DeepStateDisplayCopy(s)
 		if err != nil {
@@ -0,0 +1,198 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// A state dump is a plain text summary of where a node is and what it is doing, written to a
+// file so operators can send us the same diagnostics no matter who collects them.
+
+const ProcessedMsgsKept = 20
+
+type ProcessedMsg struct {
+	When    time.Time
+	Outcome string
+	Msg     string
+}
+
+// ProcessedMsgs remembers the last ProcessedMsgsKept messages the State executed.
+type ProcessedMsgs struct {
+	msgs  [ProcessedMsgsKept]ProcessedMsg
+	next  int
+	count int
+	mutex sync.Mutex
+}
+
+func (p *ProcessedMsgs) Add(msg interfaces.IMsg, outcome string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.msgs[p.next] = ProcessedMsg{time.Now(), outcome, msg.String()}
+	p.next = (p.next + 1) % ProcessedMsgsKept
+	if p.count < ProcessedMsgsKept {
+		p.count++
+	}
+}
+
+// Get returns the messages remembered, oldest first.
+func (p *ProcessedMsgs) Get() []ProcessedMsg {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var r []ProcessedMsg
+	for i := 0; i < p.count; i++ {
+		r = append(r, p.msgs[(p.next-p.count+i+ProcessedMsgsKept)%ProcessedMsgsKept])
+	}
+	return r
+}
+
+// StateDump builds the summary.  It reads the State without stopping it, so the sections
+// may be a few milliseconds apart from each other.
+func (s *State) StateDump() string {
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "=== State dump of %s at %s ===\n", s.FactomNodeName, time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&out, "%25s %d\n", "Version", s.FactomdVersion)
+	fmt.Fprintf(&out, "%25s %s\n", "Network", s.Network)
+	if s.IdentityChainID != nil {
+		fmt.Fprintf(&out, "%25s %s\n", "Identity", s.IdentityChainID.String())
+	}
+	fmt.Fprintf(&out, "%25s %v\n", "Leader", s.Leader)
+	fmt.Fprintf(&out, "%25s %v\n", "Replaying", s.IsReplaying)
+	fmt.Fprintf(&out, "%25s %v\n", "Uptime", time.Since(s.starttime))
+
+	fmt.Fprintf(&out, "\n--- Heights ---\n")
+	fmt.Fprintf(&out, "%25s %d\n", "Leader height", s.LLeaderHeight)
+	fmt.Fprintf(&out, "%25s %d\n", "Current minute", s.CurrentMinute)
+	fmt.Fprintf(&out, "%25s %d\n", "Highest saved", s.GetHighestSavedBlk())
+	fmt.Fprintf(&out, "%25s %d\n", "Highest completed", s.GetHighestCompletedBlk())
+	fmt.Fprintf(&out, "%25s %d\n", "Highest known", s.GetHighestKnownBlock())
+	fmt.Fprintf(&out, "%25s %d\n", "Highest ack", s.GetHighestAck())
+	fmt.Fprintf(&out, "%25s %d\n", "Entries complete", s.EntryDBHeightComplete)
+
+	fmt.Fprintf(&out, "\n--- Queues ---\n")
+	fmt.Fprintf(&out, "%25s %d/%d\n", "InMsgQueue", s.InMsgQueue().Length(), s.InMsgQueue().Cap())
+	fmt.Fprintf(&out, "%25s %d\n", "InMsgQueue critical", s.inMsgQueue.CriticalLength())
+	fmt.Fprintf(&out, "%25s %d/%d\n", "APIQueue", len(s.apiQueue), cap(s.apiQueue))
+	fmt.Fprintf(&out, "%25s %d/%d\n", "AckQueue", len(s.ackQueue), cap(s.ackQueue))
+	fmt.Fprintf(&out, "%25s %d/%d\n", "MsgQueue", len(s.msgQueue), cap(s.msgQueue))
+	fmt.Fprintf(&out, "%25s %d/%d\n", "NetworkOutMsgQueue", s.NetworkOutMsgQueue().Length(), s.NetworkOutMsgQueue().Cap())
+	fmt.Fprintf(&out, "%25s %d/%d\n", "Holding", len(s.Holding), constants.HOLDING_MAX)
+	fmt.Fprintf(&out, "%25s %d/%d\n", "Acks", len(s.Acks), constants.ACKS_MAX)
+	fmt.Fprintf(&out, "%25s %d\n", "Commits", len(s.Commits))
+	fmt.Fprintf(&out, "%25s %d\n", "XReview", len(s.XReview))
+
+	fmt.Fprintf(&out, "\n--- Elections ---\n")
+	fmt.Fprintf(&out, "%25s %d\n", "Fault timeout", s.FaultTimeout)
+	fmt.Fprintf(&out, "%25s %v\n", "Ack timeout", s.GetAckTimeout())
+	fmt.Fprintf(&out, "%25s %v\n", "Resend interval", s.GetResendInterval())
+	fmt.Fprintf(&out, "%25s %v\n", "EOM wait", s.GetEOMWait())
+	var pl *ProcessList
+	if s.ProcessLists != nil {
+		pl = s.ProcessLists.Get(s.LLeaderHeight)
+	}
+	if pl != nil {
+		fmt.Fprintf(&out, "%25s %v\n", "Negotiator", pl.AmINegotiator)
+		fmt.Fprintf(&out, "%25s %d\n", "Federated servers", len(pl.FedServers))
+		fmt.Fprintf(&out, "%25s %d\n", "Audit servers", len(pl.AuditServers))
+		for i, vm := range pl.VMs {
+			if i >= len(pl.FedServers) {
+				break
+			}
+			leader := ""
+			if fed := pl.ServerMap[s.CurrentMinute%10][i]; fed < len(pl.FedServers) {
+				leader = pl.FedServers[fed].GetChainID().String()[6:12]
+			}
+			faulted := "no"
+			if vm.WhenFaulted > 0 {
+				faulted = fmt.Sprintf("since %d (flag %d)", vm.WhenFaulted, vm.FaultFlag)
+			}
+			fmt.Fprintf(&out, "%22s %2d leader %s height %d/%d synced %v faulted %s\n",
+				"VM", i, leader, vm.Height, len(vm.List), vm.Synced, faulted)
+		}
+		fmt.Fprintf(&out, "%25s %d/%d\n", "System VM", pl.System.Height, len(pl.System.List))
+	}
+
+	fmt.Fprintf(&out, "\n--- Invariants ---\n")
+	checked, last, violations := s.InvariantStatus()
+	fmt.Fprintf(&out, "%25s %d through block %d\n", "Blocks checked", checked, last)
+	fmt.Fprintf(&out, "%25s %v\n", "Halted", s.IsHalted())
+	for _, v := range violations {
+		fmt.Fprintf(&out, "%s\n", v.String())
+	}
+
+	fmt.Fprintf(&out, "\n--- Routines ---\n")
+	for _, r := range s.GetRoutineStatus() {
+		fmt.Fprintf(&out, "%25s %-8s starts %d panics %d\n", r.Name, r.State, r.Starts, r.Panics)
+		if r.LastPanic != "" {
+			fmt.Fprintf(&out, "%25s %s\n", "last panic", r.LastPanic)
+		}
+	}
+
+	fmt.Fprintf(&out, "\n--- Clock ---\n")
+	fmt.Fprintf(&out, "%25s %s\n", "Now", s.ClockNow().String())
+	if s.Clock != nil {
+		st := s.Clock.Status()
+		fmt.Fprintf(&out, "%25s %v\n", "NTP servers", st.Servers)
+		fmt.Fprintf(&out, "%25s %v measured %v\n", "Offset", st.Offset, st.Measured)
+		fmt.Fprintf(&out, "%25s %v\n", "Correction", st.Applied)
+		if st.Error != "" {
+			fmt.Fprintf(&out, "%25s %s\n", "Error", st.Error)
+		}
+	}
+
+	fmt.Fprintf(&out, "\n--- Scheduled tasks ---\n")
+	for _, t := range s.GetScheduledTasks() {
+		fmt.Fprintf(&out, "%25s %-14s runs %d failures %d last %v took %v next %v\n", t.Name, t.Schedule, t.Runs, t.Failures,
+			t.LastStart.Format("2006-01-02 15:04:05"), t.LastDuration, t.NextRun.Format("2006-01-02 15:04:05"))
+		if t.LastError != "" {
+			fmt.Fprintf(&out, "%25s %s\n", "last error", t.LastError)
+		}
+	}
+
+	fmt.Fprintf(&out, "\n--- Entry sync ---\n")
+	es := s.GetEntrySyncProgress()
+	fmt.Fprintf(&out, "%25s %d of %d, scanning %d\n", "Complete", es.Complete, es.Highest, es.Scanning)
+	fmt.Fprintf(&out, "%25s %d asking %d found %d\n", "Missing", es.Missing, es.Asking, es.Found)
+	fmt.Fprintf(&out, "%25s %d, limit %d/s\n", "Requests", es.Requests, es.RequestRate)
+	fmt.Fprintf(&out, "%25s %d/s, throttled %dms\n", "Read limit", es.ReadRate, es.Throttled)
+
+	fmt.Fprintf(&out, "\n--- Peers ---\n")
+	if s.NetworkControler != nil {
+		fmt.Fprintf(&out, "%25s %d\n", "Connections", s.NetworkControler.GetNumberConnections())
+	} else {
+		fmt.Fprintf(&out, "%25s %s\n", "Connections", "no p2p network")
+	}
+
+	fmt.Fprintf(&out, "\n--- Last %d processed messages ---\n", ProcessedMsgsKept)
+	for _, m := range s.ProcessedMsgs.Get() {
+		fmt.Fprintf(&out, "%s %-10s %s\n", m.When.Format("15:04:05.000"), m.Outcome, m.Msg)
+	}
+
+	return out.String()
+}
+
+// WriteStateDump writes a StateDump to a new file in the log directory, and returns the name
+// of the file along with what was written to it.
+func (s *State) WriteStateDump() (string, string, error) {
+	dir := s.LogPath
+	if dir == "" || dir == "stdout" {
+		dir = "."
+	}
+	name := filepath.Join(dir, fmt.Sprintf("statedump_%s_%s.txt", s.FactomNodeName, time.Now().Format("20060102_150405")))
+	dump := s.StateDump()
+	if err := ioutil.WriteFile(name, []byte(dump), 0644); err != nil {
+		return "", dump, err
+	}
+	return name, dump, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestProcessedMsgs(t *testing.T) {
+	p := new(ProcessedMsgs)
+	if len(p.Get()) != 0 {
+		t.Errorf("Expected no messages")
+	}
+	for i := 0; i < ProcessedMsgsKept+5; i++ {
+		eom := new(messages.EOM)
+		eom.Timestamp = primitives.NewTimestampNow()
+		eom.ChainID = primitives.NewZeroHash()
+		eom.Minute = byte(i % 10)
+		p.Add(eom, fmt.Sprintf("outcome%d", i))
+	}
+	msgs := p.Get()
+	if len(msgs) != ProcessedMsgsKept {
+		t.Fatalf("Kept %d messages, expected %d", len(msgs), ProcessedMsgsKept)
+	}
+	if msgs[0].Outcome != "outcome5" || msgs[len(msgs)-1].Outcome != fmt.Sprintf("outcome%d", ProcessedMsgsKept+4) {
+		t.Errorf("Messages are not oldest first: %s ... %s", msgs[0].Outcome, msgs[len(msgs)-1].Outcome)
+	}
+}
+
+func TestStateDump(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	dump := s.StateDump()
+	for _, section := range []string{"--- Heights ---", "--- Queues ---", "--- Elections ---", "--- Peers ---", "processed messages"} {
+		if !strings.Contains(dump, section) {
+			t.Errorf("State dump is missing %q", section)
+		}
+	}
+}
@@ -5,16 +5,13 @@
 package state
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 
-	"github.com/FactomProject/btcutil/base58"
-	ed "github.com/FactomProject/ed25519"
 	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
-	"github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/fer"
 )
 
 // Go through the factoid exchange rate chain and determine if an FER change should be scheduled
@@ -56,9 +53,9 @@ func (this *State) ProcessRecentFERChainEntries() {
 	}
 
 	// Check for the need to clear the priority
-	// (this.GetDBHeightComplete() >= 12) is import because height is a uint and can't break logic if subtracted into false sub-zero
-	if (this.GetDBHeightComplete() >= 12) &&
-		(this.GetDBHeightComplete()-12) >= this.FERPrioritySetHeight {
+	// (this.GetDBHeightComplete() >= fer.PriorityWindow) is import because height is a uint and can't break logic if subtracted into false sub-zero
+	if (this.GetDBHeightComplete() >= fer.PriorityWindow) &&
+		(this.GetDBHeightComplete()-fer.PriorityWindow) >= this.FERPrioritySetHeight {
 		this.FERPrioritySetHeight = 0
 		this.FERPriority = 0
 		// Now the next entry to come through with a priority of 1 or more will be considered
@@ -120,8 +117,8 @@ func (this *State) ProcessRecentFERChainEntries() {
 				this.FERChangeHeight = ferEntry.GetTargetActivationHeight()
 
 				// Adjust the target if needed
-				if this.FERChangeHeight < (this.GetDBHeightComplete() + 2) {
-					this.FERChangeHeight = this.GetDBHeightComplete() + 2
+				if this.FERChangeHeight < (this.GetDBHeightComplete() + fer.MinActivationDelay) {
+					this.FERChangeHeight = this.GetDBHeightComplete() + fer.MinActivationDelay
 				}
 			} else {
 				this.Println(" Failed FER entry : ", string(entryContent))
@@ -140,78 +137,20 @@ func (this *State) ProcessRecentFERChainEntries() {
 	return
 }
 
+// ExchangeRateAuthorityIsValid is true if the entry is signed by the exchange rate authority
 func (this *State) ExchangeRateAuthorityIsValid(e interfaces.IEBEntry) bool {
-	pubStr, err := factoid.PublicKeyStringToECAddressString(this.ExchangeRateAuthorityPublicKey)
-	if err != nil {
-		return false
-	}
-	// convert the conf quthority address into a
-	authorityAddress := base58.Decode(pubStr)
-	ecPubPrefix := []byte{0x59, 0x2a}
-
-	if !bytes.Equal(authorityAddress[:2], ecPubPrefix) {
-		fmt.Errorf("Invalid Entry Credit Private Address")
-		return false
-	}
-
-	pub := new([32]byte)
-	copy(pub[:], authorityAddress[2:34])
-
-	// in case verify can't handle empty public key
-	if this.ExchangeRateAuthorityPublicKey == "" {
-		return false
-	}
-	sig := new([64]byte)
-	externalIds := e.ExternalIDs()
-
-	// check for number of ext ids
-	if len(externalIds) < 1 {
-		return false
-	}
-
-	copy(sig[:], externalIds[0]) // First ext id needs to be the signature of the content
-
-	if !ed.VerifyCanonical(pub, e.GetContent(), sig) {
-		return false
-	}
-
-	return true
+	return fer.VerifyAuthority(e, this.ExchangeRateAuthorityPublicKey)
 }
 
 func (this *State) FerEntryIsValid(passedFEREntry interfaces.IFEREntry) bool {
-	// fail if expired
-	if passedFEREntry.GetExpirationHeight() < passedFEREntry.GetResidentHeight() {
-		fmt.Println("FER Failed-fail if expired")
-		return false
-	}
-
-	// fail if expired height is too far out
-	if passedFEREntry.GetExpirationHeight() > (passedFEREntry.GetResidentHeight() + 12) {
-		fmt.Println("FER Failed-fail if expired height is too far out")
-
+	if err := fer.Validate(passedFEREntry); err != nil {
+		fmt.Println("FER Failed-", err.Error())
 		return false
 	}
-
-	// fail if target is out of range of the expire height
-	// The check for expire height >= 6 is import because a lower value results in a uint binary wrap to near maxint, cracks logic
-	if (passedFEREntry.GetTargetActivationHeight() > (passedFEREntry.GetExpirationHeight() + 6)) ||
-		((passedFEREntry.GetExpirationHeight() >= 6) &&
-			(passedFEREntry.GetTargetActivationHeight() < (passedFEREntry.GetExpirationHeight() - 6))) {
-		fmt.Println("FER Failed-fail if target is out of range of the expire height")
-		return false
-	}
-
 	return true
 }
 
 // Returns the higher of the current factoid exchange rate and what it knows will change in the future
 func (this *State) GetPredictiveFER() uint64 {
-	currentFER := this.GetFactoshisPerEC()
-
-	if (this.FERChangeHeight == 0) || // Check to see if no change has been registered
-		(this.FERChangePrice <= currentFER) {
-		return currentFER
-	}
-
-	return this.FERChangePrice
+	return fer.PredictiveRate(this.GetFactoshisPerEC(), this.FERChangePrice, this.FERChangeHeight)
 }
@@ -10,16 +10,22 @@ import (
 	"os"
 	"sync"
 
+	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
 
 type StateSaverStruct struct {
 	FastBoot         bool
 	FastBootLocation string
+	SnapshotInterval int    // Directory blocks between snapshots once booted; 0 disables them
+	SnapshotHeight   uint32 // Height of the last snapshot saved or loaded; 0 if none
+	LoadBelow        uint32 // If not 0, saved states at or above this height are not loaded, see replayBounds.go
 
 	TmpState []byte
 	Mutex    sync.Mutex
 	Stop     bool
+
+	snapshotRequested int32 // A snapshot is to be taken at the next block, whatever the interval
 }
 
 //To be increased whenever the data being saved changes from the last verion
@@ -74,27 +80,88 @@ func (sss *StateSaverStruct) DeleteSaveState(networkName string) error {
 	return DeleteFile(NetworkIDToFilename(networkName, sss.FastBootLocation))
 }
 
+// LoadDBStateList restores the State from the FastBoot file, if it is whole and agrees with the
+// database (see VerifyDBStates).  A file that doesn't is deleted, and we boot as if there were
+// none, rebuilding from the database, or from a snapshot if there is one, rather than starting
+// from balances that are not those of our blocks.
 func (sss *StateSaverStruct) LoadDBStateList(ss *DBStateList, networkName string) error {
-	b, err := LoadFromFile(NetworkIDToFilename(networkName, sss.FastBootLocation))
+	filename := NetworkIDToFilename(networkName, sss.FastBootLocation)
+	b, err := LoadFromFile(filename)
 	if err != nil {
 		return nil
 	}
 	if b == nil {
 		return nil
 	}
+	reject := func(why string) error {
+		fmt.Printf("LoadDBStateList - Not using %s, as %s; rebuilding from the database\n", filename, why)
+		sss.DeleteSaveState(networkName)
+		return nil
+	}
+
 	h := primitives.NewZeroHash()
 	b, err = h.UnmarshalBinaryData(b)
 	if err != nil {
-		return nil
+		return reject("it is too short")
 	}
 	h2 := primitives.Sha(b)
 	if h.IsSameAs(h2) == false {
-		fmt.Printf("LoadDBStateList - Integrity hashes do not match!")
+		return reject("its integrity hash does not match")
+	}
+
+	loaded := new(DBStateList)
+	if _, err := loaded.unmarshalDBStates(b); err != nil {
+		return reject(fmt.Sprintf("it can't be read: %v", err))
+	}
+	if err := VerifyDBStates(ss.State.DB, loaded); err != nil {
+		return reject(err.Error())
+	}
+	if sss.LoadBelow > 0 && loaded.GetHighestSavedBlk() >= sss.LoadBelow {
+		fmt.Printf("LoadDBStateList - Not using %s, as it is at %d, past -replay-from-height\n", filename, loaded.GetHighestSavedBlk())
 		return nil
-		//return fmt.Errorf("Integrity hashes do not match")
 	}
 
-	return ss.UnmarshalBinary(b)
+	loaded.State = ss.State
+	*ss = *loaded
+	ss.restoreFactomdState()
+	return nil
+}
+
+// VerifyDBStates checks that the DBStates of a list we saved are those of our database: that their
+// directory blocks chain, each from the one before it, that they are at the heights the list puts
+// them at, and that each one that was saved is the block the database has at its height.
+func VerifyDBStates(dbase interfaces.DBOverlaySimple, list *DBStateList) error {
+	var prev interfaces.IDirectoryBlock
+	for i, d := range list.DBStates {
+		if d == nil || d.DirectoryBlock == nil {
+			prev = nil
+			continue
+		}
+		dblk := d.DirectoryBlock
+		height := dblk.GetHeader().GetDBHeight()
+		if height != list.Base+uint32(i) {
+			return fmt.Errorf("the DBState at %d holds directory block %d", list.Base+uint32(i), height)
+		}
+		if prev != nil && !dblk.GetHeader().GetPrevKeyMR().IsSameAs(prev.GetKeyMR()) {
+			return fmt.Errorf("directory block %d doesn't follow directory block %d", height, height-1)
+		}
+		prev = dblk
+
+		if !d.Saved {
+			continue
+		}
+		keyMR, err := dbase.FetchDBKeyMRByHeight(height)
+		if err != nil {
+			return fmt.Errorf("directory block %d can't be read from the database: %v", height, err)
+		}
+		if keyMR == nil {
+			return fmt.Errorf("directory block %d is not in the database", height)
+		}
+		if !keyMR.IsSameAs(dblk.GetKeyMR()) {
+			return fmt.Errorf("directory block %d is %x, but %x in the database", height, dblk.GetKeyMR().Bytes()[:4], keyMR.Bytes()[:4])
+		}
+	}
+	return nil
 }
 
 func NetworkIDToFilename(networkName string, fileLocation string) string {
@@ -105,12 +172,14 @@ func NetworkIDToFilename(networkName string, fileLocation string) string {
 	return file
 }
 
+// SaveToFile writes to the side and renames, so a crash or a kill in the middle of a write
+// leaves the last good file whole.
 func SaveToFile(b []byte, filename string) error {
-	err := ioutil.WriteFile(filename, b, 0644)
+	err := ioutil.WriteFile(filename+".tmp", b, 0644)
 	if err != nil {
 		return err
 	}
-	return nil
+	return os.Rename(filename+".tmp", filename)
 }
 
 func LoadFromFile(filename string) ([]byte, error) {
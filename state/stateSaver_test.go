@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestVerifyDBStates(t *testing.T) {
+	dbase := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	list := new(DBStateList)
+	list.Base = 2
+	for h := uint32(2); h < 6; h++ {
+		dblk, err := dbase.FetchDBlockByHeight(h)
+		if err != nil || dblk == nil {
+			t.Fatalf("No directory block %d: %v", h, err)
+		}
+		list.DBStates = append(list.DBStates, &DBState{DirectoryBlock: dblk, Saved: true})
+	}
+	if err := VerifyDBStates(dbase, list); err != nil {
+		t.Errorf("The DBStates of the database don't verify: %v", err)
+	}
+
+	// A block not saved yet needn't be in the database, but must follow the one before it
+	next := &DBState{DirectoryBlock: directoryBlock.NewDirectoryBlock(list.DBStates[3].DirectoryBlock)}
+	list.DBStates = append(list.DBStates, next)
+	if err := VerifyDBStates(dbase, list); err != nil {
+		t.Errorf("A DBState not yet saved doesn't verify: %v", err)
+	}
+	next.Saved = true
+	if VerifyDBStates(dbase, list) == nil {
+		t.Errorf("Verified a saved DBState that isn't in the database")
+	}
+	list.DBStates = list.DBStates[:4]
+
+	// A block of another database, that follows the same block
+	saved := list.DBStates[2]
+	list.DBStates[2] = &DBState{DirectoryBlock: directoryBlock.NewDirectoryBlock(list.DBStates[1].DirectoryBlock), Saved: true}
+	if VerifyDBStates(dbase, list) == nil {
+		t.Errorf("Verified a DBState of another database")
+	}
+	list.DBStates[2].Saved = false
+	if VerifyDBStates(dbase, list) == nil {
+		t.Errorf("Verified a DBState the next doesn't follow")
+	}
+	list.DBStates[2] = saved
+
+	// Blocks out of place
+	list.DBStates[1], list.DBStates[2] = list.DBStates[2], list.DBStates[1]
+	if VerifyDBStates(dbase, list) == nil {
+		t.Errorf("Verified DBStates out of order")
+	}
+}
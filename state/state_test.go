@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/FactomProject/factomd/clock"
 	//"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/log"
@@ -27,6 +28,33 @@ func TestInit(t *testing.T) {
 	testHelper.CreateEmptyTestState()
 }
 
+func TestClockSkew(t *testing.T) {
+	s := new(State)
+	s.ClockSkew = -2 * 60 * 60 * 1000
+	now := time.Now().UnixNano() / 1e6
+	skewed := s.GetTimestamp().GetTimeMilli()
+	if skewed-now > s.ClockSkew+1000 || skewed-now < s.ClockSkew-1000 {
+		t.Errorf("Expected the clock to be skewed by %d, found %d", s.ClockSkew, skewed-now)
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	s := new(State)
+	s.VirtualClock = clock.NewVirtual(600)
+	start := s.ClockNow()
+	before := time.Now()
+	s.ClockSleep(time.Minute)
+	if real := time.Since(before); real > time.Second {
+		t.Errorf("A virtual minute at 600 times should take a tenth of a second, took %v", real)
+	}
+	if passed := s.ClockSince(start); passed < time.Minute {
+		t.Errorf("Expected a virtual minute to pass, got %v", passed)
+	}
+	if ms := s.GetTimestamp().GetTimeMilli(); ms-start.UnixNano()/1e6 < 60000 {
+		t.Errorf("Timestamps should be taken from the virtual clock")
+	}
+}
+
 func TestSecretCode(t *testing.T) {
 	s := new(state.State)
 	ts1 := s.GetTimestamp()
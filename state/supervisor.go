@@ -0,0 +1,32 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Each node's long-lived goroutines (its state loops, and for the first node the network, the
+// control panel and the API) run under its Supervisor, which Init creates.  A loop that panics is
+// restarted with a backoff until we begin shutting down; the debug API's "routines" method and
+// the state dump show how each is doing.
+
+// Supervise runs run as the long-lived goroutine name, restarted if it panics.  A State that was
+// never Init'ed, as in some tests, has no Supervisor and just starts the goroutine.
+func (s *State) Supervise(name string, run func()) {
+	if s.Supervisor == nil {
+		go run()
+		return
+	}
+	s.Supervisor.Go(name, run)
+}
+
+// GetRoutineStatus returns the status of each of our long-lived goroutines.
+func (s *State) GetRoutineStatus() []interfaces.RoutineStatus {
+	if s.Supervisor == nil {
+		return nil
+	}
+	return s.Supervisor.Status()
+}
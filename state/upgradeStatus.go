@@ -0,0 +1,130 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/p2p"
+)
+
+// The upgrade status helps coordinate a network upgrade: it lists the activations the network
+// status (see networkStatus.go) plans that are still ahead, and for each whether this node is
+// ready for it, and how many of our peers are.  Peers only tell us their p2p protocol version, not
+// the version of factomd they run, so we can only count the peers ready for an activation that
+// names the protocol version it needs.
+
+// ParseFactomdVersion returns the version number of a version like "5.0.0" or "5.0.0.1", in the
+// form of FactomdVersion.
+func ParseFactomdVersion(version string) (int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) > 4 {
+		return 0, fmt.Errorf("Not a version: %q", version)
+	}
+	v := 0
+	for i := 0; i < 4; i++ {
+		n := 0
+		if i < len(parts) {
+			var err error
+			n, err = strconv.Atoi(parts[i])
+			if err != nil || n < 0 || n > 999 {
+				return 0, fmt.Errorf("Not a version: %q", version)
+			}
+		}
+		v = v*1000 + n
+	}
+	return v, nil
+}
+
+// FactomdVersionString returns a version number in the form of FactomdVersion as a string.
+func FactomdVersionString(v int) string {
+	return fmt.Sprintf("%d.%d.%d.%d", v/1000000000, (v%1000000000)/1000000, (v%1000000)/1000, v%1000)
+}
+
+// NewUpgradeStatus returns the upgrade status at dbheight of a node running factomdVersion with
+// peers on the given p2p protocol versions, for a network status; status can be nil.
+func NewUpgradeStatus(status *specialEntries.NetworkStatus, dbheight uint32, factomdVersion int, peers map[uint16]int) *interfaces.UpgradeStatus {
+	us := new(interfaces.UpgradeStatus)
+	us.DBHeight = dbheight
+	us.FactomdVersion = FactomdVersionString(factomdVersion)
+	us.P2PVersion = p2p.ProtocolVersion
+	us.PeerVersions = peers
+	for _, n := range peers {
+		us.Peers += n
+	}
+	us.Activations = []interfaces.UpgradeActivation{}
+	us.VersionReady = true
+	us.Ready = true
+	if status == nil {
+		return us
+	}
+
+	us.ProtocolVersion = status.ProtocolVersion
+	us.StatusHeight = status.Height
+	if status.ProtocolVersion != "" {
+		wanted, err := ParseFactomdVersion(status.ProtocolVersion)
+		us.VersionReady = err == nil && factomdVersion >= wanted
+	}
+	us.Ready = us.VersionReady
+
+	for _, a := range status.Activations {
+		if a.Height <= dbheight {
+			continue
+		}
+		ua := interfaces.UpgradeActivation{
+			Name:            a.Name,
+			Height:          a.Height,
+			BlocksToGo:      a.Height - dbheight,
+			MinProtocol:     a.MinProtocol,
+			CompatiblePeers: -1,
+			PercentPeers:    -1,
+			Ready:           us.VersionReady,
+		}
+		if a.MinProtocol > 0 {
+			ua.Ready = ua.Ready && p2p.ProtocolVersion >= a.MinProtocol
+			ua.CompatiblePeers = 0
+			for v, n := range peers {
+				if v >= a.MinProtocol {
+					ua.CompatiblePeers += n
+				}
+			}
+			ua.PercentPeers = 0
+			if us.Peers > 0 {
+				ua.PercentPeers = 100 * float64(ua.CompatiblePeers) / float64(us.Peers)
+			}
+		}
+		us.Ready = us.Ready && ua.Ready
+		us.Activations = append(us.Activations, ua)
+	}
+	sort.Sort(activationsByHeight(us.Activations))
+	return us
+}
+
+type activationsByHeight []interfaces.UpgradeActivation
+
+func (a activationsByHeight) Len() int           { return len(a) }
+func (a activationsByHeight) Less(i, j int) bool { return a[i].Height < a[j].Height }
+func (a activationsByHeight) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+// GetUpgradeStatus returns our upgrade status for the latest network status.
+func (s *State) GetUpgradeStatus() *interfaces.UpgradeStatus {
+	s.networkStatusMutex.Lock()
+	var status *specialEntries.NetworkStatus
+	if s.networkStatus != nil {
+		status = s.networkStatus.Status
+	}
+	s.networkStatusMutex.Unlock()
+
+	peers := map[uint16]int{}
+	if s.NetworkControler != nil {
+		peers = s.NetworkControler.PeerVersions()
+	}
+	return NewUpgradeStatus(status, s.GetLLeaderHeight(), s.FactomdVersion, peers)
+}
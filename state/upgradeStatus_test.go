@@ -0,0 +1,66 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/p2p"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestParseFactomdVersion(t *testing.T) {
+	for version, expected := range map[string]int{"5.0.0": 5000000000, "v5.1.2.3": 5001002003, "6": 6000000000} {
+		v, err := ParseFactomdVersion(version)
+		if err != nil || v != expected {
+			t.Errorf("%q parsed as %d, %v; expected %d", version, v, err, expected)
+		}
+		if FactomdVersionString(v) == "" {
+			t.Errorf("No string for %d", v)
+		}
+	}
+	for _, bad := range []string{"", "5.x", "1.2.3.4.5", "5.1000"} {
+		if _, err := ParseFactomdVersion(bad); err == nil {
+			t.Errorf("Parsed %q", bad)
+		}
+	}
+}
+
+func TestNewUpgradeStatus(t *testing.T) {
+	us := NewUpgradeStatus(nil, 100, 5000000000, nil)
+	if !us.Ready || len(us.Activations) != 0 {
+		t.Errorf("Without a network status there is nothing to be ready for: %+v", us)
+	}
+
+	status := &specialEntries.NetworkStatus{
+		Height:          90,
+		ProtocolVersion: "5.0.0",
+		Activations: []specialEntries.NetworkActivation{
+			{Name: "later", Height: 300, MinProtocol: p2p.ProtocolVersion},
+			{Name: "past", Height: 50},
+			{Name: "sooner", Height: 200},
+		},
+	}
+	peers := map[uint16]int{0: 1, p2p.ProtocolVersion - 1: 1, p2p.ProtocolVersion: 2}
+	us = NewUpgradeStatus(status, 100, 5000000000, peers)
+	if !us.Ready || us.Peers != 4 {
+		t.Errorf("Expected a ready node with 4 peers: %+v", us)
+	}
+	if len(us.Activations) != 2 || us.Activations[0].Name != "sooner" || us.Activations[1].Name != "later" {
+		t.Fatalf("Expected the activations still ahead, soonest first: %+v", us.Activations)
+	}
+	if us.Activations[0].BlocksToGo != 100 || us.Activations[0].CompatiblePeers != -1 {
+		t.Errorf("Unexpected %+v", us.Activations[0])
+	}
+	if us.Activations[1].CompatiblePeers != 2 || us.Activations[1].PercentPeers != 50 {
+		t.Errorf("Expected half the peers to be compatible: %+v", us.Activations[1])
+	}
+
+	us = NewUpgradeStatus(status, 100, 4009000000, peers)
+	if us.Ready || us.VersionReady || us.Activations[0].Ready {
+		t.Errorf("A node running 4.9 is not ready for 5.0: %+v", us)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
+	"sync/atomic"
 	"time"
 )
 
@@ -18,13 +19,45 @@ func (state *State) ValidatorLoop() {
 		select {
 		case <-state.ShutdownChan:
 			fmt.Println("Closing the Database on", state.GetFactomNodeName())
-			state.DB.Close()
+			state.DBStates.FlushBatch()
+			// A halted node's balances can't be trusted, so they must not go into a snapshot
+			if state.IsHalted() {
+				fmt.Println("Not saving a snapshot on", state.GetFactomNodeName(), "as it is halted")
+			} else if err := state.StateSaverStruct.SaveLatestSnapshot(state.DBStates, state.Network); err != nil {
+				fmt.Println("Error saving a snapshot on", state.GetFactomNodeName(), err)
+			}
+			if err := state.SaveReplayFilter(); err != nil {
+				fmt.Println("Error saving the replay filter on", state.GetFactomNodeName(), err)
+			}
+			if err := state.SaveHeldCommits(); err != nil {
+				fmt.Println("Error saving the held commits on", state.GetFactomNodeName(), err)
+			}
+			// Waits for any save in progress, so the database is not closed under it
 			state.StateSaverStruct.StopSaving()
+			state.DB.Close()
 			fmt.Println(state.GetFactomNodeName(), "closed")
+			atomic.StoreInt32(&state.shutDown, 1)
+			state.ShutdownDone <- 0
 			return
 		default:
 		}
 
+		// A node halted on a broken invariant (see invariants.go) does no more work.  We still
+		// empty its queues, so the network doesn't block on it.
+		if state.IsHalted() {
+			select {
+			case <-state.tickerQueue:
+			default:
+			}
+			for state.InMsgQueue().Dequeue() != nil {
+			}
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+
+		// Send the acks we hold for a batch once they have waited long enough.
+		state.CheckAckBatch()
+
 		// Look for pending messages, and get one if there is one.
 		var msg interfaces.IMsg
 	loop:
@@ -39,6 +72,26 @@ func (state *State) ValidatorLoop() {
 			}
 
 			for i := 0; i < 10; i++ {
+				// While replaying a journal, the journal is all the State hears, timer included.
+				if state.IsReplaying {
+					select {
+					case <-state.tickerQueue:
+					default:
+					}
+					select {
+					case e := <-state.journalQueue:
+						if e.Msg == nil {
+							continue
+						}
+						msg = e.Msg
+						msg.SetLocal(e.Local)
+						state.ReplayTimestamp = e.Delivered
+						break loop
+					case <-time.After(10 * time.Millisecond):
+					}
+					continue
+				}
+
 				select {
 				case min := <-state.tickerQueue:
 					timeStruct.timer(state, min)
@@ -67,9 +120,7 @@ func (state *State) ValidatorLoop() {
 
 		// Sort the messages.
 		if msg != nil {
-			if state.IsReplaying == true {
-				state.ReplayTimestamp = msg.GetTimestamp()
-			}
+			state.LogMsg(msg, "delivered")
 			if _, ok := msg.(*messages.Ack); ok {
 				state.ackQueue <- msg
 			} else {
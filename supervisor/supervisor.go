@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package supervisor runs a node's long-lived goroutines: its state loops, the network, the
+// control panel and the API.  A loop started with a bare "go" that panics either takes the whole
+// node down, or, if it recovers, quietly stops doing its job.  A Supervisor recovers the panic,
+// prints it with its stack, and restarts the loop after a backoff, and it keeps the status of
+// every loop so the debug API and state dumps can show what is running, what crashed, and how
+// often.
+package supervisor
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+const (
+	Running  = "running"
+	Backoff  = "backoff"  // Crashed, and waiting to be restarted
+	Crashed  = "crashed"  // Crashed, and not restarted
+	Finished = "finished" // Returned
+)
+
+var (
+	InitialBackoff = 1 * time.Second
+	MaxBackoff     = 1 * time.Minute
+	ResetAfter     = 5 * time.Minute // A loop that runs this long before crashing starts over at InitialBackoff
+)
+
+type routine struct {
+	status  interfaces.RoutineStatus
+	restart bool
+	backoff time.Duration
+}
+
+type Supervisor struct {
+	Name string // Of the node, for the messages we print
+
+	mutex    sync.Mutex
+	routines map[string]*routine
+	stopped  int32
+}
+
+func New(name string) *Supervisor {
+	s := new(Supervisor)
+	s.Name = name
+	s.routines = make(map[string]*routine)
+	return s
+}
+
+// Go runs run in a goroutine, and runs it again, after a backoff, each time it panics.  Once run
+// returns it is finished.  name must be unique within the Supervisor.
+func (s *Supervisor) Go(name string, run func()) {
+	s.start(name, run, true)
+}
+
+// GoOnce runs run in a goroutine, and records a panic, but doesn't run it again.  For work that
+// is not safe to repeat, like loading the database.
+func (s *Supervisor) GoOnce(name string, run func()) {
+	s.start(name, run, false)
+}
+
+// Stop stops restarting crashed routines, as we shut down.
+func (s *Supervisor) Stop() {
+	atomic.StoreInt32(&s.stopped, 1)
+}
+
+func (s *Supervisor) IsStopped() bool {
+	return atomic.LoadInt32(&s.stopped) == 1
+}
+
+func (s *Supervisor) start(name string, run func(), restart bool) {
+	s.mutex.Lock()
+	r := s.routines[name]
+	if r == nil {
+		r = new(routine)
+		r.status.Name = name
+		s.routines[name] = r
+	}
+	r.restart = restart
+	r.backoff = 0
+	s.mutex.Unlock()
+
+	go s.supervise(r, run)
+}
+
+func (s *Supervisor) supervise(r *routine, run func()) {
+	for {
+		s.mutex.Lock()
+		r.status.State = Running
+		r.status.Starts++
+		r.status.LastStart = time.Now()
+		started := r.status.LastStart
+		s.mutex.Unlock()
+
+		crash := s.run(run)
+
+		s.mutex.Lock()
+		r.status.LastExit = time.Now()
+		if crash == "" {
+			r.status.State = Finished
+			s.mutex.Unlock()
+			return
+		}
+		r.status.Panics++
+		r.status.LastPanic = crash
+		if !r.restart || s.IsStopped() {
+			r.status.State = Crashed
+			s.mutex.Unlock()
+			return
+		}
+		if r.backoff == 0 || time.Since(started) > ResetAfter {
+			r.backoff = InitialBackoff
+		} else {
+			r.backoff *= 2
+		}
+		if r.backoff > MaxBackoff {
+			r.backoff = MaxBackoff
+		}
+		wait := r.backoff
+		r.status.State = Backoff
+		r.status.NextStart = time.Now().Add(wait)
+		s.mutex.Unlock()
+
+		fmt.Fprintf(os.Stderr, "%s: %s restarts in %v\n", s.Name, r.status.Name, wait)
+		time.Sleep(wait)
+		if s.IsStopped() {
+			s.mutex.Lock()
+			r.status.State = Crashed
+			s.mutex.Unlock()
+			return
+		}
+	}
+}
+
+// run calls run, and returns what it panicked with, or "" if it returned.
+func (s *Supervisor) run(run func()) (crash string) {
+	defer func() {
+		if p := recover(); p != nil {
+			crash = fmt.Sprint(p)
+			if crash == "" {
+				crash = "panic"
+			}
+			fmt.Fprintf(os.Stderr, "%s: panic: %s\n%s\n", s.Name, crash, debug.Stack())
+		}
+	}()
+	run()
+	return ""
+}
+
+// Status returns the status of every routine, by name.
+func (s *Supervisor) Status() []interfaces.RoutineStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var list []interfaces.RoutineStatus
+	for _, r := range s.routines {
+		list = append(list, r.status)
+	}
+	sort.Sort(byName(list))
+	return list
+}
+
+type byName []interfaces.RoutineStatus
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byName) Less(i, j int) bool { return b[i].Name < b[j].Name }
@@ -0,0 +1,82 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package supervisor_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/supervisor"
+)
+
+func status(s *Supervisor, name string) interfaces.RoutineStatus {
+	for _, r := range s.Status() {
+		if r.Name == name {
+			return r
+		}
+	}
+	return interfaces.RoutineStatus{}
+}
+
+// waitFor waits up to a second for the routine to be in state.
+func waitFor(t *testing.T, s *Supervisor, name string, state string) interfaces.RoutineStatus {
+	for i := 0; i < 100; i++ {
+		if r := status(s, name); r.State == state {
+			return r
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%s never got to %s: %+v", name, state, status(s, name))
+	return interfaces.RoutineStatus{}
+}
+
+func TestRestart(t *testing.T) {
+	InitialBackoff, MaxBackoff = 10*time.Millisecond, 20*time.Millisecond
+	s := New("test")
+
+	// Panics twice, then returns
+	var runs int32
+	s.Go("flaky", func() {
+		if atomic.AddInt32(&runs, 1) < 3 {
+			panic("flaky")
+		}
+	})
+	r := waitFor(t, s, "flaky", Finished)
+	if r.Starts != 3 || r.Panics != 2 || r.LastPanic != "flaky" {
+		t.Errorf("Expected 3 starts and 2 panics, got %+v", r)
+	}
+
+	// Not restarted
+	s.GoOnce("once", func() { panic("once") })
+	r = waitFor(t, s, "once", Crashed)
+	if r.Starts != 1 || r.Panics != 1 {
+		t.Errorf("Expected 1 start and 1 panic, got %+v", r)
+	}
+
+	if list := s.Status(); len(list) != 2 || list[0].Name != "flaky" || list[1].Name != "once" {
+		t.Errorf("Expected the routines by name, got %+v", list)
+	}
+}
+
+func TestStop(t *testing.T) {
+	InitialBackoff, MaxBackoff = 50*time.Millisecond, 50*time.Millisecond
+	s := New("test")
+
+	s.Go("crasher", func() { panic("crash") })
+	waitFor(t, s, "crasher", Backoff)
+	s.Stop()
+	r := waitFor(t, s, "crasher", Crashed)
+	if r.Starts != 1 {
+		t.Errorf("A stopped supervisor should not restart anything, got %+v", r)
+	}
+
+	block := make(chan bool)
+	s.Go("running", func() { <-block })
+	waitFor(t, s, "running", Running)
+	close(block)
+	waitFor(t, s, "running", Finished)
+}
@@ -66,6 +66,39 @@ func CreateAndPopulateTestState() *state.State {
 	return s
 }
 
+// CreateAndPopulateSavedTestState is CreateAndPopulateTestState with every block of the test
+// database saved in its DBStates, for tests that need a saved height above the genesis block.
+// The DBStates LoadDatabase queues don't reach the DBStates list in a test state, so the blocks
+// are applied here before the validator starts.
+func CreateAndPopulateSavedTestState() *state.State {
+	s := new(state.State)
+	s.SetLeaderTimestamp(primitives.NewTimestampFromMilliseconds(0))
+	s.DB = CreateAndPopulateTestDatabaseOverlay()
+	s.LoadConfig("", "")
+	s.DirectoryBlockInSeconds = 20
+	s.Network = "LOCAL"
+	s.Init()
+	s.Network = "LOCAL"
+	s.SetFactoshisPerEC(1)
+
+	for i := uint32(0); ; i++ {
+		msg, err := s.LoadDBState(i)
+		if err != nil {
+			panic(err)
+		}
+		if msg == nil {
+			break
+		}
+		msg.(*messages.DBStateMsg).IsInDB = true
+		s.FollowerExecuteDBState(msg)
+		s.UpdateState()
+	}
+	go s.ValidatorLoop()
+	time.Sleep(30 * time.Millisecond)
+
+	return s
+}
+
 func CreateTestDBStateList() []interfaces.IMsg {
 	answer := make([]interfaces.IMsg, BlockCount)
 	var prev *BlockSet = nil
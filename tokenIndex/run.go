@@ -0,0 +1,173 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tokenIndex
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Source is where the interpreters are fed from; the State is one.
+type Source interface {
+	GetAndLockDB() interfaces.DBOverlaySimple
+	UnlockDB()
+	// The last block whose entries are all saved
+	GetEntryDBHeightComplete() uint32
+}
+
+// Run feeds the registered interpreters the entries of the blocks the node saves, block by block,
+// as long as one of them is running.  An interpreter that fails is stopped, with its error in its
+// status; the rest carry on.
+func Run(src Source) {
+	if !Registered() {
+		return
+	}
+	for {
+		progress, err := Step(src)
+		if err != nil {
+			// Most likely the node is still saving the block; try it again later
+			fmt.Println("TokenIndex:", err)
+		}
+		if !running() {
+			fmt.Println("TokenIndex: every token indexer has stopped")
+			return
+		}
+		if !progress {
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// running tells whether any interpreter is still being fed.
+func running() bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+	for _, r := range interpreters {
+		if r.err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Step applies the next block to the interpreters furthest behind, if all its entries are
+// saved.  Returns false when there is no block to apply yet.
+func Step(src Source) (bool, error) {
+	// The interpreters to feed, and the block they need
+	mutex.Lock()
+	var behind []*registered
+	var height uint32
+	for _, r := range interpreters {
+		if r.err != nil {
+			continue
+		}
+		if len(behind) == 0 || r.next < height {
+			behind, height = behind[:0], r.next
+		}
+		if r.next == height {
+			behind = append(behind, r)
+		}
+	}
+	mutex.Unlock()
+
+	if len(behind) == 0 || height > src.GetEntryDBHeightComplete() {
+		return false, nil
+	}
+
+	entries, timestamp, ok, err := readBlock(src, height, behind)
+	if !ok {
+		return false, err
+	}
+
+	for _, e := range entries {
+		for _, r := range e.wanting {
+			if r.err == nil {
+				r.fail(r.interpreter.ApplyEntry(e.entry, height, timestamp))
+			}
+		}
+	}
+
+	for _, r := range behind {
+		if r.err == nil {
+			r.fail(r.interpreter.EndBlock(height))
+		}
+		mutex.Lock()
+		if r.err == nil {
+			r.next = height + 1
+		}
+		mutex.Unlock()
+	}
+	return true, nil
+}
+
+// fail stops the interpreter, if err isn't nil.
+func (r *registered) fail(err error) {
+	if err == nil {
+		return
+	}
+	mutex.Lock()
+	r.err = err
+	mutex.Unlock()
+	fmt.Printf("TokenIndex: %s stopped at block %d: %s\n", r.interpreter.Namespace(), r.next, err.Error())
+}
+
+type wanted struct {
+	entry   interfaces.IEBEntry
+	wanting []*registered // The interpreters that want it
+}
+
+// readBlock reads the entries of the block at height that the interpreters want, with the
+// block's timestamp, or nothing if the block isn't saved yet.  Every entry is read before any is
+// applied, so a block is applied whole or not at all, and without the database locked.
+func readBlock(src Source, height uint32, behind []*registered) ([]wanted, interfaces.Timestamp, bool, error) {
+	dbase := src.GetAndLockDB()
+	defer src.UnlockDB()
+
+	dblk, err := dbase.FetchDBlockByHeight(height)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if dblk == nil {
+		return nil, nil, false, nil
+	}
+	timestamp := dblk.GetHeader().GetTimestamp()
+
+	var entries []wanted
+	for _, dbEntry := range dblk.GetEBlockDBEntries() {
+		var wanting []*registered
+		for _, r := range behind {
+			if r.interpreter.Wants(dbEntry.GetChainID()) {
+				wanting = append(wanting, r)
+			}
+		}
+		if len(wanting) == 0 {
+			continue
+		}
+
+		eblk, err := dbase.FetchEBlock(dbEntry.GetKeyMR())
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if eblk == nil {
+			return nil, nil, false, fmt.Errorf("Entry block %s of block %d is missing", dbEntry.GetKeyMR().String(), height)
+		}
+		for _, entryHash := range eblk.GetEntryHashes() {
+			if entryHash.IsMinuteMarker() {
+				continue
+			}
+			entry, err := dbase.FetchEntry(entryHash)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			if entry == nil {
+				return nil, nil, false, fmt.Errorf("Entry %s of block %d is missing", entryHash.String(), height)
+			}
+			entries = append(entries, wanted{entry, wanting})
+		}
+	}
+	return entries, timestamp, true, nil
+}
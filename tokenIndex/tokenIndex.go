@@ -0,0 +1,159 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package tokenIndex lets token protocols that live in chains, like FAT, keep their indexes in the
+// node: an Interpreter registered here is fed the entries of the chains it wants as the node
+// saves them, block by block, and answers API calls under its namespace, so that "<namespace>.
+// <method>" on the v2 API is the interpreter's method.  Explorers can then ride the node's sync,
+// rather than syncing the chains all over again themselves.
+//
+// Interpreters register themselves from an init function, as database/sql drivers do, and a
+// build of factomd that imports them runs them:
+//
+//	func init() {
+//		tokenIndex.Register(NewFATIndexer(dir))
+//	}
+package tokenIndex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// An Interpreter keeps the index of a token protocol.  Entries are applied from one goroutine,
+// in the order of the chain, a block at a time, but Call is made from the API's goroutines, so
+// an Interpreter must guard its index itself.
+type Interpreter interface {
+	// Namespace names the interpreter, and prefixes its API methods.  It may not have a ".".
+	Namespace() string
+	// Wants tells whether the interpreter reads a chain.
+	Wants(chainID interfaces.IHash) bool
+	// Height is the last directory block the index has all of, if it has any, so an index
+	// that persists can pick up where it left off.
+	Height() (uint32, bool)
+	// ApplyEntry applies an entry of a chain the interpreter wants, from the block at dbheight.
+	ApplyEntry(entry interfaces.IEBEntry, dbheight uint32, timestamp interfaces.Timestamp) error
+	// EndBlock says every entry of the block at dbheight has been applied.
+	EndBlock(dbheight uint32) error
+	// Call answers the API method "<namespace>.<method>".  It returns ErrMethodNotFound for a
+	// method it doesn't have, and an InvalidParams for params it can't take.
+	Call(method string, params json.RawMessage) (interface{}, error)
+}
+
+var (
+	ErrMethodNotFound = errors.New("Method not found")
+	ErrNotRunning     = errors.New("The indexer has stopped")
+)
+
+// InvalidParams is the error of a Call given params it can't take.
+type InvalidParams string
+
+func (e InvalidParams) Error() string {
+	return string(e)
+}
+
+// Status is how an interpreter is doing.
+type Status struct {
+	Namespace string `json:"namespace"`
+	Height    int64  `json:"height"`          // The last block indexed, or -1
+	Error     string `json:"error,omitempty"` // Why the interpreter was stopped
+}
+
+type registered struct {
+	interpreter Interpreter
+	next        uint32 // The next block to apply
+	err         error  // Set when the interpreter fails, after which it is fed nothing more
+}
+
+var (
+	mutex        sync.Mutex
+	interpreters = make(map[string]*registered)
+)
+
+// Register adds an interpreter, to be fed from when the node starts.
+func Register(i Interpreter) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	namespace := i.Namespace()
+	if namespace == "" || strings.Contains(namespace, ".") {
+		return fmt.Errorf("Token indexer namespace %q isn't allowed", namespace)
+	}
+	if _, ok := interpreters[namespace]; ok {
+		return fmt.Errorf("Token indexer %s is already registered", namespace)
+	}
+	r := &registered{interpreter: i}
+	if height, ok := i.Height(); ok {
+		r.next = height + 1
+	}
+	interpreters[namespace] = r
+	return nil
+}
+
+// Unregister removes an interpreter.
+func Unregister(namespace string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(interpreters, namespace)
+}
+
+// Registered tells whether any interpreter is registered.
+func Registered() bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return len(interpreters) > 0
+}
+
+// Statuses returns the status of each interpreter, by namespace.
+func Statuses() []Status {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var statuses []Status
+	for namespace, r := range interpreters {
+		s := Status{Namespace: namespace, Height: int64(r.next) - 1}
+		if r.err != nil {
+			s.Error = r.err.Error()
+		}
+		statuses = append(statuses, s)
+	}
+	sort.Sort(byNamespace(statuses))
+	return statuses
+}
+
+type byNamespace []Status
+
+func (s byNamespace) Len() int           { return len(s) }
+func (s byNamespace) Less(i, j int) bool { return s[i].Namespace < s[j].Namespace }
+func (s byNamespace) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Call answers the API method "<namespace>.<method>".  It returns ErrMethodNotFound if no
+// interpreter has it.
+func Call(method string, params json.RawMessage) (interface{}, error) {
+	dot := strings.Index(method, ".")
+	if dot < 0 {
+		return nil, ErrMethodNotFound
+	}
+	mutex.Lock()
+	r, ok := interpreters[method[:dot]]
+	var err error
+	if ok {
+		err = r.err
+	}
+	mutex.Unlock()
+
+	if !ok {
+		return nil, ErrMethodNotFound
+	}
+	if err != nil {
+		return nil, ErrNotRunning
+	}
+	return r.interpreter.Call(method[dot+1:], params)
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tokenIndex_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/tokenIndex"
+)
+
+// A database of directory blocks by height, and their entry blocks and entries
+type testDB struct {
+	interfaces.DBOverlaySimple
+	dblocks []interfaces.IDirectoryBlock
+	eblocks map[[32]byte]interfaces.IEntryBlock
+	entries map[[32]byte]interfaces.IEBEntry
+}
+
+func (db *testDB) FetchDBlockByHeight(height uint32) (interfaces.IDirectoryBlock, error) {
+	if int(height) >= len(db.dblocks) {
+		return nil, nil
+	}
+	return db.dblocks[height], nil
+}
+
+func (db *testDB) FetchEBlock(keyMR interfaces.IHash) (interfaces.IEntryBlock, error) {
+	return db.eblocks[keyMR.Fixed()], nil
+}
+
+func (db *testDB) FetchEntry(hash interfaces.IHash) (interfaces.IEBEntry, error) {
+	return db.entries[hash.Fixed()], nil
+}
+
+// addBlock adds a directory block with the entries of each chain, by chain
+func (db *testDB) addBlock(chains ...[]interfaces.IEBEntry) {
+	dblk := directoryBlock.NewDirectoryBlock(nil)
+	dblk.GetHeader().SetDBHeight(uint32(len(db.dblocks)))
+	for _, entries := range chains {
+		eblk := entryBlock.NewEBlock()
+		eblk.GetHeader().SetChainID(entries[0].GetChainID())
+		for _, entry := range entries {
+			eblk.AddEBEntry(entry)
+			db.entries[entry.GetHash().Fixed()] = entry
+		}
+		keyMR, _ := eblk.KeyMR()
+		db.eblocks[keyMR.Fixed()] = eblk
+		dblk.AddEntry(eblk.GetChainID(), keyMR)
+	}
+	db.dblocks = append(db.dblocks, dblk)
+}
+
+type testSource struct {
+	db       *testDB
+	complete uint32
+}
+
+func (s *testSource) GetAndLockDB() interfaces.DBOverlaySimple { return s.db }
+func (s *testSource) UnlockDB()                                {}
+func (s *testSource) GetEntryDBHeightComplete() uint32         { return s.complete }
+
+// Counts the entries of its chain, and fails on an entry with content "fail"
+type counter struct {
+	namespace string
+	chainID   interfaces.IHash
+	count     int
+	height    int64
+}
+
+func (c *counter) Namespace() string                   { return c.namespace }
+func (c *counter) Wants(chainID interfaces.IHash) bool { return chainID.IsSameAs(c.chainID) }
+func (c *counter) Height() (uint32, bool)              { return 0, false }
+
+func (c *counter) ApplyEntry(entry interfaces.IEBEntry, dbheight uint32, timestamp interfaces.Timestamp) error {
+	if string(entry.GetContent()) == "fail" {
+		return errors.New("bad entry")
+	}
+	c.count++
+	return nil
+}
+
+func (c *counter) EndBlock(dbheight uint32) error {
+	c.height = int64(dbheight)
+	return nil
+}
+
+func (c *counter) Call(method string, params json.RawMessage) (interface{}, error) {
+	if method != "count" {
+		return nil, ErrMethodNotFound
+	}
+	if len(params) > 0 {
+		return nil, InvalidParams("count takes no params")
+	}
+	return c.count, nil
+}
+
+func newEntry(chainID interfaces.IHash, content string) interfaces.IEBEntry {
+	entry := entryBlock.NewEntry()
+	entry.ChainID = chainID
+	entry.Content = primitives.ByteSlice{Bytes: []byte(content)}
+	return entry
+}
+
+func TestTokenIndex(t *testing.T) {
+	chainA, chainB := primitives.Sha([]byte("A")), primitives.Sha([]byte("B"))
+	a := &counter{namespace: "a", chainID: chainA}
+	b := &counter{namespace: "b", chainID: chainB}
+	for _, c := range []*counter{a, b} {
+		if err := Register(c); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(c.namespace)
+	}
+	if Register(a) == nil || Register(&counter{namespace: "a.b"}) == nil {
+		t.Errorf("Registered a namespace twice, or one with a dot")
+	}
+
+	db := &testDB{eblocks: make(map[[32]byte]interfaces.IEntryBlock), entries: make(map[[32]byte]interfaces.IEBEntry)}
+	db.addBlock([]interfaces.IEBEntry{newEntry(chainA, "1"), newEntry(chainA, "2")})
+	db.addBlock([]interfaces.IEBEntry{newEntry(chainA, "3")}, []interfaces.IEBEntry{newEntry(chainB, "1")})
+	db.addBlock([]interfaces.IEBEntry{newEntry(chainB, "fail")})
+	src := &testSource{db: db}
+
+	// Only the first block's entries are all saved
+	for i := 0; i < 3; i++ {
+		if _, err := Step(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if a.count != 2 || a.height != 0 || b.height != 0 {
+		t.Errorf("Indexed %d entries of A, up to %d, and B up to %d", a.count, a.height, b.height)
+	}
+
+	src.complete = 2
+	for i := 0; i < 3; i++ {
+		if _, err := Step(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if a.count != 3 || b.count != 1 || a.height != 2 {
+		t.Errorf("Indexed %d entries of A and %d of B, A up to %d", a.count, b.count, a.height)
+	}
+
+	statuses := Statuses()
+	if len(statuses) != 2 || statuses[0].Height != 2 || statuses[0].Error != "" || statuses[1].Height != 1 || statuses[1].Error != "bad entry" {
+		t.Errorf("Statuses are %+v", statuses)
+	}
+
+	if count, err := Call("a.count", nil); err != nil || count != 3 {
+		t.Errorf("a.count returned %v, %v", count, err)
+	}
+	if _, err := Call("a.count", json.RawMessage("[1]")); err != InvalidParams("count takes no params") {
+		t.Errorf("a.count with params returned %v", err)
+	}
+	if _, err := Call("b.count", nil); err != ErrNotRunning {
+		t.Errorf("b.count returned %v after it failed", err)
+	}
+	for _, method := range []string{"a.total", "c.count", "count"} {
+		if _, err := Call(method, nil); err != ErrMethodNotFound {
+			t.Errorf("%s returned %v", method, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/user"
+	"sort"
 	"time"
 
 	"github.com/FactomProject/factomd/common/primitives"
@@ -21,6 +22,7 @@ type FactomdConfig struct {
 		ControlPanelPort                       int
 		ControlPanelFilesPath                  string
 		ControlPanelSetting                    string
+		ControlPanelSiblings                   string
 		DBType                                 string
 		LdbPath                                string
 		BoltDBPath                             string
@@ -30,6 +32,7 @@ type FactomdConfig struct {
 		ExportDataSubpath                      string
 		FastBoot                               bool
 		FastBootLocation                       string
+		SnapshotInterval                       int
 		NodeMode                               string
 		IdentityChainID                        string
 		LocalServerPrivKey                     string
@@ -40,6 +43,12 @@ type FactomdConfig struct {
 		ExchangeRateAuthorityPublicKeyMainNet  string
 		ExchangeRateAuthorityPublicKeyTestNet  string
 		ExchangeRateAuthorityPublicKeyLocalNet string
+		ExchangeRateSources                    string
+		ExchangeRateAuthorityPrivateKey        string
+		ExchangeRateECPrivateKey               string
+		ExchangeRateMinimum                    uint64
+		ExchangeRateMaximum                    uint64
+		ExchangeRateMaxChangePercent           int
 
 		// Network Configuration
 		Network                 string
@@ -47,19 +56,80 @@ type FactomdConfig struct {
 		PeersFile               string
 		MainSeedURL             string
 		MainSpecialPeers        string
+		MainSpecialPeerGroups   string
 		TestNetworkPort         string
 		TestSeedURL             string
 		TestSpecialPeers        string
+		TestSpecialPeerGroups   string
 		LocalNetworkPort        string
 		LocalSeedURL            string
 		LocalSpecialPeers       string
+		LocalSpecialPeerGroups  string
 		CustomBootstrapIdentity string
 		CustomBootstrapKey      string
+		CustomGenesisFile       string
 		FactomdTlsEnabled       bool
 		FactomdTlsPrivateKey    string
 		FactomdTlsPublicCert    string
 		FactomdRpcUser          string
 		FactomdRpcPass          string
+		PriorityLaneShare       int
+		PriorityApiKey          string
+		ChainAllowlist          string
+		ChainDenylist           string
+		SpamThreshold           int
+		SpamKeyRate             int
+		QuarantineSize          int
+
+		// Directory block timestamp rules for each network
+		MainBlockTimestampMedian    int
+		MainBlockTimestampMaxDrift  int
+		TestBlockTimestampMedian    int
+		TestBlockTimestampMaxDrift  int
+		LocalBlockTimestampMedian   int
+		LocalBlockTimestampMaxDrift int
+		MainDBStateSigThreshold     int
+		TestDBStateSigThreshold     int
+		LocalDBStateSigThreshold    int
+		MainAckTimeout              int
+		TestAckTimeout              int
+		LocalAckTimeout             int
+		MainResendInterval          int
+		TestResendInterval          int
+		LocalResendInterval         int
+		MainEOMWait                 int
+		TestEOMWait                 int
+		LocalEOMWait                int
+
+		// Checking our clock against NTP servers
+		NTPServers        string
+		ClockCheckMinutes int
+		ClockMaxOffset    int
+		ClockCorrect      bool
+
+		// Housekeeping, at the times of cron expressions; see schedule/
+		ScheduleClockCheck string
+		ScheduleCompaction string
+		ScheduleSnapshot   string
+
+		// Limits on the pass that fills in missing entries, see state/entrySyncing.go
+		EntrySyncRequestsPerSecond int
+		EntrySyncReadsPerSecond    int
+
+		// Sampling the metrics charted by the control panel, see metricsHistory/
+		MetricsHistorySeconds int
+
+		// Blocks whose timelines are kept, see state/blockTimeline.go
+		BlockTimelineBlocks int
+
+		// Blocks whose process list logs are kept, see state/processListLog.go
+		ProcessListLogBlocks int
+
+		// Security headers for the Control Panel and the RPC API
+		ControlPanelContentSecurityPolicy string
+		FactomdContentSecurityPolicy      string
+		HttpFrameOptions                  string
+		HttpStrictTransportSecurity       string
 
 		ChangeAcksHeight uint32
 	}
@@ -95,6 +165,17 @@ type FactomdConfig struct {
 		FactomdLocation     string
 		WalletdLocation     string
 	}
+
+	// Activation heights by name, see constants.ACTIVATIONS
+	Activation map[string]*ActivationHeights
+}
+
+// The directory block heights an activation takes effect at on each network.  Custom networks
+// use the Local one, and 0 never activates it.
+type ActivationHeights struct {
+	Main  int
+	Test  int
+	Local int
 }
 
 // defaultConfig
@@ -108,6 +189,8 @@ HomeDir                               = ""
 ; --------------- ControlPanel disabled | readonly | readwrite
 ControlPanelSetting                   = readonly
 ControlPanelPort                      = 8090
+; --------------- Comma separated wsapi urls of sibling nodes shown on the control panel's node grid
+ControlPanelSiblings                  = 
 ; --------------- DBType: LDB | Bolt | Map
 DBType                                = "LDB"
 LdbPath                               = "database/ldb"
@@ -118,21 +201,81 @@ ExportData                            = false
 ExportDataSubpath                     = "database/export/"
 FastBoot                              = true
 FastBootLocation                      = ""
+SnapshotInterval                      = 1000
 ; --------------- Network: MAIN | TEST | LOCAL
 Network                               = MAIN
 PeersFile            = "peers.json"
 MainNetworkPort      = 8108
 MainSeedURL          = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/mainseed.txt"
 MainSpecialPeers     = ""
+MainSpecialPeerGroups = ""
 TestNetworkPort      = 8109
 TestSeedURL          = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/testseed.txt"
 TestSpecialPeers     = ""
+TestSpecialPeerGroups = ""
 LocalNetworkPort     = 8110
 LocalSeedURL         = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/localseed.txt"
 LocalSpecialPeers    = ""
+LocalSpecialPeerGroups = ""
+; --------------- A directory block's timestamp can be no earlier than the median of the BlockTimestampMedian
+; --------------- blocks before it, nor more than BlockTimestampMaxDrift seconds ahead of our clock.  0 turns a check off.
+; --------------- Custom networks use the Local values.
+MainBlockTimestampMedian     = 11
+MainBlockTimestampMaxDrift   = 7200
+TestBlockTimestampMedian     = 11
+TestBlockTimestampMaxDrift   = 7200
+LocalBlockTimestampMedian    = 11
+LocalBlockTimestampMaxDrift  = 7200
+; --------------- A DBState from the network needs signatures from more than DBStateSigThreshold percent of the
+; --------------- federated servers.  Every node of a network must use the same value.  Custom networks use the Local one.
+MainDBStateSigThreshold      = 50
+TestDBStateSigThreshold      = 50
+LocalDBStateSigThreshold     = 50
+; --------------- Consensus timing: a VM quiet for AckTimeout seconds is asked for its next message, a message held
+; --------------- for validation is sent again every ResendInterval seconds, and a missing EOM is waited on EOMWait
+; --------------- milliseconds more than other missing messages before it is asked for.  Custom networks use the Local ones.
+MainAckTimeout               = 20
+TestAckTimeout               = 20
+LocalAckTimeout              = 20
+MainResendInterval           = 20
+TestResendInterval           = 20
+LocalResendInterval          = 20
+MainEOMWait                  = 0
+TestEOMWait                  = 0
+LocalEOMWait                 = 0
+; --------------- Comma separated NTP servers our clock is checked against every ClockCheckMinutes.  A clock more than
+; --------------- ClockMaxOffset seconds off is reported, and corrected for if ClockCorrect is true.  Empty turns it off.
+NTPServers                   = ""
+ClockCheckMinutes            = 10
+ClockMaxOffset               = 10
+ClockCorrect                 = false
+; --------------- When to do housekeeping, as cron expressions ("minute hour day month weekday", or @daily, @every 1h, ...)
+; --------------- in local time.  The clock check runs every ClockCheckMinutes unless ScheduleClockCheck is given.
+; --------------- ScheduleCompaction compacts a LevelDB database, and ScheduleSnapshot takes a snapshot of the state
+; --------------- whatever the SnapshotInterval.  Empty turns a task off.
+ScheduleClockCheck           = ""
+ScheduleCompaction           = ""
+ScheduleSnapshot             = ""
+; --------------- The pass that fills in the entries we are missing asks peers for at most EntrySyncRequestsPerSecond
+; --------------- entries, and reads the database at most EntrySyncReadsPerSecond times, a second.  0 is no limit.
+EntrySyncRequestsPerSecond   = 0
+EntrySyncReadsPerSecond      = 0
+; --------------- Every MetricsHistorySeconds, the height, peers, queues and transaction rate are kept in a small file
+; --------------- beside the FastBoot file, for the control panel's charts.  0 turns it off.
+MetricsHistorySeconds        = 10
+; --------------- When each of the last BlockTimelineBlocks blocks saw its first commit, each minute's EOMs, its DBSigs,
+; --------------- and was saved and anchored, for the API's block-timeline.  0 keeps none.
+BlockTimelineBlocks          = 1000
+; --------------- What each VM of the last ProcessListLogBlocks blocks built by this node acked and processed, and when,
+; --------------- for the API's process-list-log.  0 keeps none.
+ProcessListLogBlocks         = 100
 CustomBootstrapIdentity     = 38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9
 CustomBootstrapKey          = cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a
-; --------------- NodeMode: FULL | SERVER ----------------
+; --------------- A JSON file with the genesis of a custom network, read when its database is first created.
+; --------------- It replaces the CustomBootstrapIdentity and CustomBootstrapKey above.  See state/customGenesis.go.
+CustomGenesisFile           = ""
+; --------------- NodeMode: FULL | SERVER | EXPLORER ----------------
+; EXPLORER is a follower for public deployments: write APIs and admin pages are disabled and reads are cached
 NodeMode                                = FULL
 LocalServerPrivKey                      = 4c38c72fc5cdad68f13b74674d3ffb1f3d63a112710868c9b08946553448d26d
 LocalServerPublicKey                    = cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a
@@ -141,6 +284,15 @@ ExchangeRateAuthorityPublicKeyMainNet   = daf5815c2de603dbfa3e1e64f88a5cf0608330
 ExchangeRateAuthorityPublicKeyTestNet   = 1d75de249c2fc0384fb6701b30dc86b39dc72e5a47ba4f79ef250d39e21e7a4f
 ; Private key all zeroes:
 ExchangeRateAuthorityPublicKeyLocalNet  = 3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29
+; --------------- A node holding the exchange rate authority's key proposes rates from these price sources, see fer/.
+; --------------- A comma separated list of "static:<USD per FCT>" or URLs of JSON quotes, with "#<path.to.price>".
+; --------------- Rates outside the minimum and maximum (factoshis per EC, 0 for no bound) are never proposed.
+ExchangeRateSources                     = ""
+ExchangeRateAuthorityPrivateKey         = ""
+ExchangeRateECPrivateKey                = ""
+ExchangeRateMinimum                     = 0
+ExchangeRateMaximum                     = 0
+ExchangeRateMaxChangePercent            = 25
 
 ; These define if the RPC and Control Panel connection to factomd should be encrypted, and if it is, what files
 ; are the secret key and the public certificate.  factom-cli and factom-walletd uses the certificate specified here if TLS is enabled.
@@ -154,6 +306,32 @@ FactomdTlsPublicCert                  = "/full/path/to/factomdAPIpub.cert"
 FactomdRpcUser                        = ""
 FactomdRpcPass                        = ""
 
+; A share, in percent, of the room the node has for commits, reveals and transactions, kept for its operator's own
+; submissions, so public traffic can't crowd them out when the node is congested.  Submissions to the API from
+; this machine, or carrying PriorityApiKey in an X-Priority-Key header, are the operator's.  0 turns it off.
+PriorityLaneShare                     = 0
+PriorityApiKey                        = ""
+
+; Comma separated chain IDs whose commits and reveals a follower turns away, neither holding nor passing them on.  With
+; ChainAllowlist set, those of every chain not in it are turned away too.  Entry commits don't name their chain and
+; aren't filtered.  Federated and audit servers ignore both lists.
+ChainAllowlist                        = ""
+ChainDenylist                         = ""
+
+; Commits scoring SpamThreshold or more are quarantined, and only processed when the node has room to spare.  A commit
+; scores 2 if its key can't pay for it, 1 if its entry was already committed this minute, and 1 if its key has made
+; more than SpamKeyRate commits this minute.  0 quarantines none.  QuarantineSize commits are held before more are dropped.
+SpamThreshold                         = 0
+SpamKeyRate                           = 60
+QuarantineSize                        = 1000
+
+; Security headers sent with every Control Panel and RPC API response. Leave a value empty to not send that header.
+; Strict-Transport-Security is only sent when FactomdTlsEnabled is true.
+ControlPanelContentSecurityPolicy     = "default-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; frame-ancestors 'none'"
+FactomdContentSecurityPolicy          = "default-src 'none'; frame-ancestors 'none'"
+HttpFrameOptions                      = DENY
+HttpStrictTransportSecurity           = max-age=31536000
+
 ; Specifying when to change ACKs for switching leader servers
 ChangeAcksHeight                      = 0
 
@@ -166,6 +344,37 @@ logLevel                              = error
 LogPath                               = "database/Log"
 ConsoleLogLevel                       = standard
 
+; ------------------------------------------------------------------------------
+; Activations - changes to the protocol that every node of a network makes from the same directory block height.
+; Each has the height it takes effect at on the Main, Test and Local networks; custom networks use the Local one.
+; 0 never activates it.  Every node of a network must use the same values.
+; ------------------------------------------------------------------------------
+; --------------- The directory block timestamp rules (see BlockTimestampMedian and BlockTimestampMaxDrift)
+[Activation "BlockTimestamp"]
+Main                                  = 0
+Test                                  = 0
+Local                                 = 0
+; --------------- A commit chain bound to its first entry, so no other first entry can claim the chain before it is revealed
+[Activation "ChainBinding"]
+Main                                  = 0
+Test                                  = 0
+Local                                 = 0
+; --------------- Entry commits sent in batches, to take one ack between them
+[Activation "BatchCommit"]
+Main                                  = 0
+Test                                  = 0
+Local                                 = 0
+; --------------- Leaders' acks sent in batches (see -ackbatch)
+[Activation "AckBatch"]
+Main                                  = 0
+Test                                  = 0
+Local                                 = 0
+; --------------- Only a commit of the same kind paying more entry credits replaces the commit pending for an entry
+[Activation "CommitReplacement"]
+Main                                  = 0
+Test                                  = 0
+Local                                 = 0
+
 ; ------------------------------------------------------------------------------
 ; Configurations for factom-walletd
 ; ------------------------------------------------------------------------------
@@ -195,12 +404,16 @@ func (s *FactomdConfig) String() string {
 	var out primitives.Buffer
 
 	out.WriteString(fmt.Sprintf("\nFactomd Config"))
+	out.WriteString(fmt.Sprintf("\n    LocalSpecialPeerGroups  %v", s.App.LocalSpecialPeerGroups))
+	out.WriteString(fmt.Sprintf("\n    TestSpecialPeerGroups   %v", s.App.TestSpecialPeerGroups))
+	out.WriteString(fmt.Sprintf("\n    MainSpecialPeerGroups   %v", s.App.MainSpecialPeerGroups))
 	out.WriteString(fmt.Sprintf("\n  App"))
 	out.WriteString(fmt.Sprintf("\n    PortNumber              %v", s.App.PortNumber))
 	out.WriteString(fmt.Sprintf("\n    HomeDir                 %v", s.App.HomeDir))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelPort        %v", s.App.ControlPanelPort))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelFilesPath   %v", s.App.ControlPanelFilesPath))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelSetting     %v", s.App.ControlPanelSetting))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelSiblings    %v", s.App.ControlPanelSiblings))
 	out.WriteString(fmt.Sprintf("\n    DBType                  %v", s.App.DBType))
 	out.WriteString(fmt.Sprintf("\n    LdbPath                 %v", s.App.LdbPath))
 	out.WriteString(fmt.Sprintf("\n    BoltDBPath              %v", s.App.BoltDBPath))
@@ -219,8 +432,39 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    LocalNetworkPort        %v", s.App.LocalNetworkPort))
 	out.WriteString(fmt.Sprintf("\n    LocalSeedURL            %v", s.App.LocalSeedURL))
 	out.WriteString(fmt.Sprintf("\n    LocalSpecialPeers       %v", s.App.LocalSpecialPeers))
+	out.WriteString(fmt.Sprintf("\n    MainBlockTimestampMedian    %v", s.App.MainBlockTimestampMedian))
+	out.WriteString(fmt.Sprintf("\n    MainBlockTimestampMaxDrift  %v", s.App.MainBlockTimestampMaxDrift))
+	out.WriteString(fmt.Sprintf("\n    TestBlockTimestampMedian    %v", s.App.TestBlockTimestampMedian))
+	out.WriteString(fmt.Sprintf("\n    TestBlockTimestampMaxDrift  %v", s.App.TestBlockTimestampMaxDrift))
+	out.WriteString(fmt.Sprintf("\n    LocalBlockTimestampMedian   %v", s.App.LocalBlockTimestampMedian))
+	out.WriteString(fmt.Sprintf("\n    LocalBlockTimestampMaxDrift %v", s.App.LocalBlockTimestampMaxDrift))
+	out.WriteString(fmt.Sprintf("\n    MainDBStateSigThreshold     %v", s.App.MainDBStateSigThreshold))
+	out.WriteString(fmt.Sprintf("\n    TestDBStateSigThreshold     %v", s.App.TestDBStateSigThreshold))
+	out.WriteString(fmt.Sprintf("\n    LocalDBStateSigThreshold    %v", s.App.LocalDBStateSigThreshold))
+	out.WriteString(fmt.Sprintf("\n    MainAckTimeout              %v", s.App.MainAckTimeout))
+	out.WriteString(fmt.Sprintf("\n    TestAckTimeout              %v", s.App.TestAckTimeout))
+	out.WriteString(fmt.Sprintf("\n    LocalAckTimeout             %v", s.App.LocalAckTimeout))
+	out.WriteString(fmt.Sprintf("\n    MainResendInterval          %v", s.App.MainResendInterval))
+	out.WriteString(fmt.Sprintf("\n    TestResendInterval          %v", s.App.TestResendInterval))
+	out.WriteString(fmt.Sprintf("\n    LocalResendInterval         %v", s.App.LocalResendInterval))
+	out.WriteString(fmt.Sprintf("\n    MainEOMWait                 %v", s.App.MainEOMWait))
+	out.WriteString(fmt.Sprintf("\n    TestEOMWait                 %v", s.App.TestEOMWait))
+	out.WriteString(fmt.Sprintf("\n    LocalEOMWait                %v", s.App.LocalEOMWait))
+	out.WriteString(fmt.Sprintf("\n    NTPServers              %v", s.App.NTPServers))
+	out.WriteString(fmt.Sprintf("\n    ClockCheckMinutes       %v", s.App.ClockCheckMinutes))
+	out.WriteString(fmt.Sprintf("\n    ClockMaxOffset          %v", s.App.ClockMaxOffset))
+	out.WriteString(fmt.Sprintf("\n    ClockCorrect            %v", s.App.ClockCorrect))
+	out.WriteString(fmt.Sprintf("\n    ScheduleClockCheck      %v", s.App.ScheduleClockCheck))
+	out.WriteString(fmt.Sprintf("\n    ScheduleCompaction      %v", s.App.ScheduleCompaction))
+	out.WriteString(fmt.Sprintf("\n    ScheduleSnapshot        %v", s.App.ScheduleSnapshot))
+	out.WriteString(fmt.Sprintf("\n    EntrySyncRequestsPerSecond %v", s.App.EntrySyncRequestsPerSecond))
+	out.WriteString(fmt.Sprintf("\n    EntrySyncReadsPerSecond %v", s.App.EntrySyncReadsPerSecond))
+	out.WriteString(fmt.Sprintf("\n    MetricsHistorySeconds   %v", s.App.MetricsHistorySeconds))
+	out.WriteString(fmt.Sprintf("\n    BlockTimelineBlocks     %v", s.App.BlockTimelineBlocks))
+	out.WriteString(fmt.Sprintf("\n    ProcessListLogBlocks    %v", s.App.ProcessListLogBlocks))
 	out.WriteString(fmt.Sprintf("\n    CustomBootstrapIdentity %v", s.App.CustomBootstrapIdentity))
 	out.WriteString(fmt.Sprintf("\n    CustomBootstrapKey      %v", s.App.CustomBootstrapKey))
+	out.WriteString(fmt.Sprintf("\n    CustomGenesisFile       %v", s.App.CustomGenesisFile))
 	out.WriteString(fmt.Sprintf("\n    NodeMode                %v", s.App.NodeMode))
 	out.WriteString(fmt.Sprintf("\n    IdentityChainID         %v", s.App.IdentityChainID))
 	out.WriteString(fmt.Sprintf("\n    LocalServerPrivKey      %v", s.App.LocalServerPrivKey))
@@ -228,11 +472,26 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    ExchangeRate            %v", s.App.ExchangeRate))
 	out.WriteString(fmt.Sprintf("\n    ExchangeRateChainId     %v", s.App.ExchangeRateChainId))
 	out.WriteString(fmt.Sprintf("\n    ExchangeRateAuthorityPublicKey   %v", s.App.ExchangeRateAuthorityPublicKey))
+	out.WriteString(fmt.Sprintf("\n    ExchangeRateSources     %v", s.App.ExchangeRateSources))
+	out.WriteString(fmt.Sprintf("\n    ExchangeRateMinimum     %v", s.App.ExchangeRateMinimum))
+	out.WriteString(fmt.Sprintf("\n    ExchangeRateMaximum     %v", s.App.ExchangeRateMaximum))
+	out.WriteString(fmt.Sprintf("\n    ExchangeRateMaxChangePercent %v", s.App.ExchangeRateMaxChangePercent))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsEnabled        %v", s.App.FactomdTlsEnabled))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsPrivateKey     %v", s.App.FactomdTlsPrivateKey))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsPublicCert     %v", s.App.FactomdTlsPublicCert))
 	out.WriteString(fmt.Sprintf("\n    FactomdRpcUser          %v", s.App.FactomdRpcUser))
 	out.WriteString(fmt.Sprintf("\n    FactomdRpcPass          %v", s.App.FactomdRpcPass))
+	out.WriteString(fmt.Sprintf("\n    PriorityLaneShare       %v", s.App.PriorityLaneShare))
+	out.WriteString(fmt.Sprintf("\n    PriorityApiKey          %v", s.App.PriorityApiKey))
+	out.WriteString(fmt.Sprintf("\n    ChainAllowlist          %v", s.App.ChainAllowlist))
+	out.WriteString(fmt.Sprintf("\n    ChainDenylist           %v", s.App.ChainDenylist))
+	out.WriteString(fmt.Sprintf("\n    SpamThreshold           %v", s.App.SpamThreshold))
+	out.WriteString(fmt.Sprintf("\n    SpamKeyRate             %v", s.App.SpamKeyRate))
+	out.WriteString(fmt.Sprintf("\n    QuarantineSize          %v", s.App.QuarantineSize))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelContentSecurityPolicy %v", s.App.ControlPanelContentSecurityPolicy))
+	out.WriteString(fmt.Sprintf("\n    FactomdContentSecurityPolicy      %v", s.App.FactomdContentSecurityPolicy))
+	out.WriteString(fmt.Sprintf("\n    HttpFrameOptions                  %v", s.App.HttpFrameOptions))
+	out.WriteString(fmt.Sprintf("\n    HttpStrictTransportSecurity       %v", s.App.HttpStrictTransportSecurity))
 	out.WriteString(fmt.Sprintf("\n    ChangeAcksHeight         %v", s.App.ChangeAcksHeight))
 
 	out.WriteString(fmt.Sprintf("\n  Log"))
@@ -240,6 +499,17 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    LogLevel                %v", s.Log.LogLevel))
 	out.WriteString(fmt.Sprintf("\n    ConsoleLogLevel         %v", s.Log.ConsoleLogLevel))
 
+	out.WriteString(fmt.Sprintf("\n  Activation"))
+	names := make([]string, 0, len(s.Activation))
+	for name := range s.Activation {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		h := s.Activation[name]
+		out.WriteString(fmt.Sprintf("\n    %-23s Main %v, Test %v, Local %v", name, h.Main, h.Test, h.Local))
+	}
+
 	out.WriteString(fmt.Sprintf("\n  Walletd"))
 	out.WriteString(fmt.Sprintf("\n    WalletRpcUser           %v", s.Walletd.WalletRpcUser))
 	out.WriteString(fmt.Sprintf("\n    WalletRpcPass           %v", s.Walletd.WalletRpcPass))
@@ -0,0 +1,71 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package voting
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Most entries read from a poll chain
+var MaxPollEntries = 100000
+
+// LoadPoll reads a poll and the entries after its definition from its chain, oldest first.
+func LoadPoll(db interfaces.DBOverlaySimple, chainID interfaces.IHash) (*Poll, []PollEntry, error) {
+	eblk, err := db.FetchEBlockHead(chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if eblk == nil {
+		return nil, nil, fmt.Errorf("Chain not found")
+	}
+
+	// The entry blocks are linked newest to oldest
+	var blocks []interfaces.IEntryBlock
+	count := 0
+	for eblk != nil {
+		blocks = append(blocks, eblk)
+		count += len(eblk.GetEntryHashes())
+		if count > MaxPollEntries {
+			return nil, nil, fmt.Errorf("The chain has more than %d entries", MaxPollEntries)
+		}
+		prev := eblk.GetHeader().GetPrevKeyMR()
+		if prev == nil || prev.IsZero() {
+			break
+		}
+		eblk, err = db.FetchEBlock(prev)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var entries []PollEntry
+	for i := len(blocks) - 1; i >= 0; i-- {
+		height := blocks[i].GetHeader().GetDBHeight()
+		for _, hash := range blocks[i].GetEntryHashes() {
+			if hash.IsMinuteMarker() {
+				continue
+			}
+			e, err := db.FetchEntry(hash)
+			if err != nil {
+				return nil, nil, err
+			}
+			if e == nil {
+				return nil, nil, fmt.Errorf("Entry %s is missing", hash.String())
+			}
+			entries = append(entries, PollEntry{Height: height, Entry: e})
+		}
+	}
+	if len(entries) == 0 {
+		return nil, nil, fmt.Errorf("The chain has no entries")
+	}
+
+	p, err := ParsePoll(entries[0].Entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, entries[1:], nil
+}
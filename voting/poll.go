@@ -0,0 +1,164 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package voting tallies polls held on a chain with commit and reveal votes.
+//
+// The first entry of a poll chain defines the poll: its first ExtID is "Poll" and its content is
+// the JSON of a Poll.  A vote takes two entries, signed by the voter's ed25519 key:
+//
+//	commit  ExtIDs "VoteCommit", voter key, signature of the content
+//	        content: the SHA256 of the reveal's content, between CommitStart and CommitEnd
+//	reveal  ExtIDs "VoteReveal", voter key, signature of the content
+//	        content: the JSON of a Ballot, after CommitEnd and up to RevealEnd
+//
+// Hiding the ballots until the commits close keeps voters from following each other.  The salt of a
+// Ballot keeps a ballot from being guessed from its commit.
+package voting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ed "github.com/FactomProject/ed25519"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+const PollTag = "Poll"
+const CommitTag = "VoteCommit"
+const RevealTag = "VoteReveal"
+
+// Largest number of options a poll may have
+const MaxOptions = 100
+
+type Voter struct {
+	Key    string `json:"key"`    // ed25519 public key, in hex
+	Weight uint64 `json:"weight"` // 1 if 0
+}
+
+type Poll struct {
+	Title       string   `json:"title"`
+	Options     []string `json:"options"`
+	MaxChoices  int      `json:"maxchoices"`  // Options a ballot may choose; 1 if 0
+	CommitStart uint32   `json:"commitstart"` // Heights, inclusive
+	CommitEnd   uint32   `json:"commitend"`
+	RevealEnd   uint32   `json:"revealend"`
+	Voters      []Voter  `json:"voters"` // Eligible voters; anyone, with a weight of 1, if empty
+}
+
+type Ballot struct {
+	Choices []string `json:"choices"`
+	Salt    string   `json:"salt"`
+}
+
+// ParsePoll returns the poll a chain's first entry defines.
+func ParsePoll(e interfaces.IEBEntry) (*Poll, error) {
+	extIDs := e.ExternalIDs()
+	if len(extIDs) < 1 || string(extIDs[0]) != PollTag {
+		return nil, fmt.Errorf("Not a poll")
+	}
+	p := new(Poll)
+	if err := json.Unmarshal(e.GetContent(), p); err != nil {
+		return nil, err
+	}
+	if len(p.Options) == 0 || len(p.Options) > MaxOptions {
+		return nil, fmt.Errorf("A poll must have 1 to %d options", MaxOptions)
+	}
+	seen := make(map[string]bool)
+	for _, o := range p.Options {
+		if seen[o] {
+			return nil, fmt.Errorf("Option %q is given twice", o)
+		}
+		seen[o] = true
+	}
+	if p.CommitStart > p.CommitEnd || p.CommitEnd >= p.RevealEnd {
+		return nil, fmt.Errorf("The commit and reveal heights are out of order")
+	}
+	p.MaxChoices = p.maxChoices()
+	return p, nil
+}
+
+// maxChoices returns the number of options a ballot may choose.
+func (p *Poll) maxChoices() int {
+	if p.MaxChoices <= 0 {
+		return 1
+	}
+	return p.MaxChoices
+}
+
+// Weight returns the weight of a voter's key, and whether the key may vote.
+func (p *Poll) Weight(key string) (uint64, bool) {
+	if len(p.Voters) == 0 {
+		return 1, true
+	}
+	for _, v := range p.Voters {
+		if v.Key == key {
+			if v.Weight == 0 {
+				return 1, true
+			}
+			return v.Weight, true
+		}
+	}
+	return 0, false
+}
+
+// parseVote returns the kind of a vote entry, the voter's key in hex and the content, if the entry
+// is signed by that key.
+func parseVote(e interfaces.IEBEntry) (string, string, []byte, bool) {
+	extIDs := e.ExternalIDs()
+	if len(extIDs) < 3 || len(extIDs[1]) != ed.PublicKeySize || len(extIDs[2]) != ed.SignatureSize {
+		return "", "", nil, false
+	}
+	kind := string(extIDs[0])
+	if kind != CommitTag && kind != RevealTag {
+		return "", "", nil, false
+	}
+	pub := new([ed.PublicKeySize]byte)
+	copy(pub[:], extIDs[1])
+	sig := new([ed.SignatureSize]byte)
+	copy(sig[:], extIDs[2])
+	if !ed.VerifyCanonical(pub, e.GetContent(), sig) {
+		return "", "", nil, false
+	}
+	return kind, hex.EncodeToString(extIDs[1]), e.GetContent(), true
+}
+
+// Commitment returns the content of the commit for the content of a reveal.
+func Commitment(reveal []byte) []byte {
+	h := sha256.Sum256(reveal)
+	return h[:]
+}
+
+// NewCommitEntry returns the commit of a ballot, signed by the voter.
+func NewCommitEntry(chainID interfaces.IHash, voter *primitives.PrivateKey, ballot *Ballot) (*entryBlock.Entry, error) {
+	reveal, err := json.Marshal(ballot)
+	if err != nil {
+		return nil, err
+	}
+	return newVoteEntry(chainID, voter, CommitTag, Commitment(reveal)), nil
+}
+
+// NewRevealEntry returns the reveal of a ballot, signed by the voter.
+func NewRevealEntry(chainID interfaces.IHash, voter *primitives.PrivateKey, ballot *Ballot) (*entryBlock.Entry, error) {
+	reveal, err := json.Marshal(ballot)
+	if err != nil {
+		return nil, err
+	}
+	return newVoteEntry(chainID, voter, RevealTag, reveal), nil
+}
+
+func newVoteEntry(chainID interfaces.IHash, voter *primitives.PrivateKey, tag string, content []byte) *entryBlock.Entry {
+	e := entryBlock.NewEntry()
+	e.ChainID = chainID
+	e.ExtIDs = []primitives.ByteSlice{
+		{Bytes: []byte(tag)},
+		{Bytes: voter.Pub[:]},
+		{Bytes: voter.Sign(content).GetSignature()[:]},
+	}
+	e.Content = primitives.ByteSlice{Bytes: content}
+	return e
+}
@@ -0,0 +1,134 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package voting
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// An entry of a poll chain and the height of its block
+type PollEntry struct {
+	Height uint32
+	Entry  interfaces.IEBEntry
+}
+
+type OptionResult struct {
+	Option string `json:"option"`
+	Voters int    `json:"voters"`
+	Weight uint64 `json:"weight"`
+}
+
+type Result struct {
+	Poll          *Poll          `json:"poll"`
+	Height        uint32         `json:"height"` // Height the poll was tallied at
+	Final         bool           `json:"final"`  // The reveals have closed
+	Options       []OptionResult `json:"options"`
+	Winners       []string       `json:"winners"` // The options with the most weight; more than one on a tie
+	Committed     int            `json:"committed"`
+	Revealed      int            `json:"revealed"`
+	Invalid       int            `json:"invalid"`       // Reveals with a ballot that breaks the poll's rules
+	InvalidWeight uint64         `json:"invalidweight"` // Weight of those reveals
+	Ignored       int            `json:"ignored"`       // Unsigned, ineligible or late entries
+}
+
+// Tally counts the votes of a poll in its chain's entries, in the order they were saved, as of a
+// height.  A voter's last commit is the one that counts, and the first reveal that matches it.
+func Tally(p *Poll, entries []PollEntry, height uint32) *Result {
+	r := new(Result)
+	r.Poll = p
+	r.Height = height
+	r.Final = height > p.RevealEnd
+	index := make(map[string]int)
+	for i, o := range p.Options {
+		index[o] = i
+		r.Options = append(r.Options, OptionResult{Option: o})
+	}
+
+	commits := make(map[string][]byte)
+	revealed := make(map[string]bool)
+	for _, pe := range entries {
+		if pe.Height > height {
+			continue
+		}
+		kind, voter, content, ok := parseVote(pe.Entry)
+		if !ok {
+			r.Ignored++
+			continue
+		}
+		weight, eligible := p.Weight(voter)
+		if !eligible {
+			r.Ignored++
+			continue
+		}
+
+		switch kind {
+		case CommitTag:
+			if pe.Height < p.CommitStart || pe.Height > p.CommitEnd {
+				r.Ignored++
+				continue
+			}
+			commits[voter] = content
+		case RevealTag:
+			commit, ok := commits[voter]
+			if pe.Height <= p.CommitEnd || pe.Height > p.RevealEnd || !ok || revealed[voter] || !bytes.Equal(commit, Commitment(content)) {
+				r.Ignored++
+				continue
+			}
+			revealed[voter] = true
+			r.Revealed++
+
+			choices, ok := p.choices(content, index)
+			if !ok {
+				r.Invalid++
+				r.InvalidWeight += weight
+				continue
+			}
+			for _, i := range choices {
+				r.Options[i].Voters++
+				r.Options[i].Weight += weight
+			}
+		}
+	}
+	r.Committed = len(commits)
+
+	top := uint64(0)
+	for _, o := range r.Options {
+		if o.Weight > top {
+			top = o.Weight
+		}
+	}
+	for _, o := range r.Options {
+		if top > 0 && o.Weight == top {
+			r.Winners = append(r.Winners, o.Option)
+		}
+	}
+	return r
+}
+
+// choices returns the indexes of the options a revealed ballot chose, if it follows the poll's
+// rules.
+func (p *Poll) choices(content []byte, index map[string]int) ([]int, bool) {
+	b := new(Ballot)
+	if err := json.Unmarshal(content, b); err != nil {
+		return nil, false
+	}
+	if len(b.Choices) == 0 || len(b.Choices) > p.maxChoices() {
+		return nil, false
+	}
+	var choices []int
+	seen := make(map[int]bool)
+	for _, c := range b.Choices {
+		i, ok := index[c]
+		if !ok || seen[i] {
+			return nil, false
+		}
+		seen[i] = true
+		choices = append(choices, i)
+	}
+	return choices, true
+}
@@ -0,0 +1,166 @@
+package voting_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+	. "github.com/FactomProject/factomd/voting"
+)
+
+func newPollEntry(chainID interfaces.IHash, p *Poll) *entryBlock.Entry {
+	content, _ := json.Marshal(p)
+	e := entryBlock.NewEntry()
+	e.ChainID = chainID
+	e.ExtIDs = []primitives.ByteSlice{{Bytes: []byte(PollTag)}}
+	e.Content = primitives.ByteSlice{Bytes: content}
+	return e
+}
+
+func TestParsePoll(t *testing.T) {
+	chainID := primitives.Sha([]byte("poll"))
+	good := Poll{Options: []string{"yes", "no"}, CommitStart: 10, CommitEnd: 20, RevealEnd: 30}
+	p, err := ParsePoll(newPollEntry(chainID, &good))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MaxChoices != 1 {
+		t.Errorf("Expected one choice by default, got %d", p.MaxChoices)
+	}
+
+	bad := []Poll{
+		{CommitStart: 10, CommitEnd: 20, RevealEnd: 30},
+		{Options: []string{"yes", "yes"}, CommitStart: 10, CommitEnd: 20, RevealEnd: 30},
+		{Options: []string{"yes", "no"}, CommitStart: 20, CommitEnd: 10, RevealEnd: 30},
+		{Options: []string{"yes", "no"}, CommitStart: 10, CommitEnd: 20, RevealEnd: 20},
+	}
+	for i := range bad {
+		if _, err := ParsePoll(newPollEntry(chainID, &bad[i])); err == nil {
+			t.Errorf("Poll %d should not parse", i)
+		}
+	}
+}
+
+func TestTally(t *testing.T) {
+	chainID := primitives.Sha([]byte("poll"))
+	alice, bob, carol, dave := primitives.RandomPrivateKey(), primitives.RandomPrivateKey(), primitives.RandomPrivateKey(), primitives.RandomPrivateKey()
+	p := &Poll{
+		Options:     []string{"yes", "no", "abstain"},
+		CommitStart: 10,
+		CommitEnd:   20,
+		RevealEnd:   30,
+		Voters: []Voter{
+			{Key: alice.Pub.String(), Weight: 5},
+			{Key: bob.Pub.String(), Weight: 3},
+			{Key: carol.Pub.String()},
+		},
+	}
+
+	var entries []PollEntry
+	vote := func(height uint32, newEntry func(interfaces.IHash, *primitives.PrivateKey, *Ballot) (*entryBlock.Entry, error), key *primitives.PrivateKey, b *Ballot) {
+		e, err := newEntry(chainID, key, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, PollEntry{Height: height, Entry: e})
+	}
+	aliceFirst := &Ballot{Choices: []string{"no"}, Salt: "1"}
+	aliceVote := &Ballot{Choices: []string{"yes"}, Salt: "2"}
+	bobVote := &Ballot{Choices: []string{"no"}, Salt: "3"}
+	carolVote := &Ballot{Choices: []string{"maybe"}, Salt: "4"}
+	daveVote := &Ballot{Choices: []string{"no"}, Salt: "5"}
+
+	vote(11, NewCommitEntry, alice, aliceFirst)
+	vote(12, NewCommitEntry, alice, aliceVote) // Replaces her first commit
+	vote(12, NewCommitEntry, bob, bobVote)
+	vote(20, NewCommitEntry, carol, carolVote)
+	vote(13, NewCommitEntry, dave, daveVote) // Not eligible
+
+	vote(21, NewRevealEntry, alice, aliceFirst) // Not her last commit
+	vote(22, NewRevealEntry, alice, aliceVote)
+	vote(25, NewRevealEntry, bob, bobVote)
+	vote(30, NewRevealEntry, carol, carolVote) // Not an option
+	vote(23, NewRevealEntry, dave, daveVote)
+
+	r := Tally(p, entries, 40)
+	if !r.Final {
+		t.Error("The poll should be final after the reveals close")
+	}
+	if r.Committed != 3 || r.Revealed != 3 || r.Invalid != 1 || r.InvalidWeight != 1 {
+		t.Errorf("Unexpected counts %+v", r)
+	}
+	if r.Options[0].Weight != 5 || r.Options[1].Weight != 3 || r.Options[2].Weight != 0 {
+		t.Errorf("Unexpected weights %+v", r.Options)
+	}
+	if len(r.Winners) != 1 || r.Winners[0] != "yes" {
+		t.Errorf("Expected yes to win, got %v", r.Winners)
+	}
+
+	// Before bob's reveal
+	r = Tally(p, entries, 24)
+	if r.Final || r.Revealed != 1 || r.Options[1].Weight != 0 {
+		t.Errorf("Unexpected tally at 24 %+v", r)
+	}
+
+	// An unsigned reveal is ignored
+	forged, _ := NewRevealEntry(chainID, bob, &Ballot{Choices: []string{"yes"}, Salt: "3"})
+	forged.ExtIDs[2].Bytes = make([]byte, 64)
+	r = Tally(p, []PollEntry{entries[2], {Height: 21, Entry: forged}}, 40)
+	if r.Revealed != 0 || r.Ignored != 1 {
+		t.Errorf("Expected the forged reveal to be ignored, got %+v", r)
+	}
+}
+
+func TestLoadPoll(t *testing.T) {
+	dbo := databaseOverlay.NewOverlay(new(mapdb.MapDB))
+	defer dbo.Close()
+
+	chainID := primitives.Sha([]byte("poll"))
+	voter := primitives.RandomPrivateKey()
+	ballot := &Ballot{Choices: []string{"yes"}, Salt: "1"}
+	commit, _ := NewCommitEntry(chainID, voter, ballot)
+	reveal, _ := NewRevealEntry(chainID, voter, ballot)
+	def := newPollEntry(chainID, &Poll{Options: []string{"yes", "no"}, CommitStart: 1, CommitEnd: 1, RevealEnd: 2})
+
+	var prev *entryBlock.EBlock
+	for height, entries := range [][]*entryBlock.Entry{{def}, {commit}, {reveal}} {
+		eb := entryBlock.NewEBlock()
+		eb.GetHeader().SetChainID(chainID)
+		eb.GetHeader().SetDBHeight(uint32(height))
+		if prev != nil {
+			keyMR, _ := prev.KeyMR()
+			eb.GetHeader().SetPrevKeyMR(keyMR)
+			eb.GetHeader().SetEBSequence(prev.GetHeader().GetEBSequence() + 1)
+		}
+		for _, e := range entries {
+			eb.AddEBEntry(e)
+			if err := dbo.InsertEntry(e); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := dbo.ProcessEBlockBatch(eb, true); err != nil {
+			t.Fatal(err)
+		}
+		prev = eb
+	}
+
+	p, entries, err := LoadPoll(dbo, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Height != 1 || entries[1].Height != 2 {
+		t.Fatalf("Unexpected entries %v", entries)
+	}
+	r := Tally(p, entries, 2)
+	if r.Revealed != 1 || r.Options[0].Voters != 1 {
+		t.Errorf("Unexpected tally %+v", r)
+	}
+
+	if _, _, err := LoadPoll(dbo, primitives.Sha([]byte("none"))); err == nil {
+		t.Error("Expected an error for a missing chain")
+	}
+}
@@ -74,6 +74,7 @@ func HandleV2FactoidACK(state interfaces.IState, params interface{}) (interface{
 	switch status {
 	case constants.AckStatusInvalid:
 		answer.Status = AckStatusInvalid
+		answer.Reason = state.GetInvalidReason(txhash)
 		break
 	case constants.AckStatusUnknown:
 		answer.Status = AckStatusUnknown
@@ -193,7 +194,13 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 
 					}
 					//	ecTxID = rm.CommitEntry.GetEntryHash().String()
-				} else if a.Type() == constants.COMMIT_CHAIN_MSG {
+				} else if b, ok := a.(*messages.BatchCommitEntryMsg); ok {
+					for _, c := range b.Commits {
+						if c.GetSigHash().String() == ackReq.TxID {
+							eTxID = c.GetEntryHash().String()
+						}
+					}
+				} else if a.Type() == constants.COMMIT_CHAIN_MSG || a.Type() == constants.BOUND_COMMIT_CHAIN_MSG {
 					var rm messages.CommitChainMsg
 					enb, err := a.MarshalBinary()
 					if err != nil {
@@ -246,7 +253,13 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 
 						}
 
-					} else if h.Type() == constants.COMMIT_CHAIN_MSG {
+					} else if b, ok := h.(*messages.BatchCommitEntryMsg); ok {
+						for _, c := range b.Commits {
+							if c.GetSigHash().String() == ackReq.TxID {
+								eTxID = c.GetEntryHash().String()
+							}
+						}
+					} else if h.Type() == constants.COMMIT_CHAIN_MSG || h.Type() == constants.BOUND_COMMIT_CHAIN_MSG {
 						var rm messages.CommitChainMsg
 						enb, err := h.MarshalBinary()
 						if err != nil {
@@ -346,6 +359,7 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 		switch status {
 		case constants.AckStatusInvalid:
 			answer.CommitData.Status = AckStatusInvalid
+			answer.CommitData.Reason = state.GetInvalidReason(h)
 			break
 		case constants.AckStatusUnknown:
 			answer.CommitData.Status = AckStatusUnknown
@@ -398,12 +412,15 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 		switch status {
 		case constants.AckStatusInvalid:
 			answer.EntryData.Status = AckStatusInvalid
+			answer.EntryData.Reason = state.GetInvalidReason(h)
 			break
 		case constants.AckStatusUnknown:
 			answer.EntryData.Status = AckStatusUnknown
+			answer.EntryData.Reason = state.GetPendingReason(h)
 			break
 		case constants.AckStatusNotConfirmed:
 			answer.EntryData.Status = AckStatusNotConfirmed
+			answer.EntryData.Reason = state.GetPendingReason(h)
 			break
 		case constants.AckStatusACK:
 			answer.EntryData.Status = AckStatusACK
@@ -418,6 +435,11 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 			return nil, NewInternalError()
 			break
 		}
+
+		// Commits of the entry that one paying more replaced
+		for _, superseded := range state.GetSupersededCommits(h) {
+			answer.SupersededCommitTxIDs = append(answer.SupersededCommitTxIDs, superseded.String())
+		}
 	}
 
 	return answer, nil
@@ -476,6 +498,7 @@ type EntryStatus struct {
 
 	ReserveTransactions          []ReserveInfo `json:"reserveinfo,omitempty"`
 	ConflictingRevealEntryHashes []string      `json:"conflictingrevealentryhashes,omitempty"`
+	SupersededCommitTxIDs        []string      `json:"supersededcommittxids,omitempty"`
 }
 
 type ReserveInfo struct {
@@ -491,6 +514,7 @@ type GeneralTransactionData struct {
 
 	Malleated *Malleated `json:"malleated,omitempty"`
 	Status    string     `json:"status"`
+	Reason    string     `json:"reason,omitempty"` // Why it is invalid, or not yet acked, if we know
 }
 
 type Malleated struct {
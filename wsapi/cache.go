@@ -0,0 +1,161 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The response cache absorbs repeated reads of expensive methods. Only reads of
+// objects by their own hash, and of saved blocks by their height, are cached, as
+// nothing can change what they return; balances, heights and chain heads change
+// within a block. Every entry is still tied to the highest saved block of the node
+// that answered it, so the whole cache is dropped as soon as a new block is saved.
+// There is one cache per API server, whichever node it answers for, dropped when
+// the server stops.
+
+// Methods that read an object by its hash
+var CacheableMethods = map[string]bool{
+	"directory-block": true,
+	"entry-block":     true,
+	"entry":           true,
+}
+
+// Methods that read a block by its height. They are cached only for heights at or
+// below the highest saved block, as the block at a height is only settled once saved.
+var HeightCacheableMethods = map[string]bool{
+	"dblock-by-height":  true,
+	"ablock-by-height":  true,
+	"ecblock-by-height": true,
+	"fblock-by-height":  true,
+}
+
+// Set to false to answer every call from the database
+var ResponseCacheEnabled bool = true
+
+// Maximum entries held per node before the cache is flushed
+var ResponseCacheMaxEntries int = 10000
+
+type ResponseCache struct {
+	mutex   sync.Mutex
+	tip     uint32
+	entries map[string]interface{}
+
+	Hits   uint64
+	Misses uint64
+}
+
+func NewResponseCache() *ResponseCache {
+	c := new(ResponseCache)
+	c.entries = make(map[string]interface{})
+	return c
+}
+
+// Get returns the cached response for the key, if one was stored at the given chain tip
+func (c *ResponseCache) Get(tip uint32, key string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.invalidate(tip)
+	resp, ok := c.entries[key]
+	if ok {
+		c.Hits++
+		HandleV2APICacheHits.Inc()
+	} else {
+		c.Misses++
+		HandleV2APICacheMisses.Inc()
+	}
+	return resp, ok
+}
+
+// Put stores a response computed at the given chain tip
+func (c *ResponseCache) Put(tip uint32, key string, resp interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.invalidate(tip)
+	if c.tip != tip {
+		// The response was computed against an older block
+		return
+	}
+	if len(c.entries) >= ResponseCacheMaxEntries {
+		c.entries = make(map[string]interface{})
+	}
+	c.entries[key] = resp
+}
+
+func (c *ResponseCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.entries)
+}
+
+// HitRate returns the fraction of lookups served from the cache
+func (c *ResponseCache) HitRate() float64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.Hits+c.Misses == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(c.Hits+c.Misses)
+}
+
+// invalidate drops every entry if the chain tip moved forward. Must hold the mutex.
+func (c *ResponseCache) invalidate(tip uint32) {
+	if tip > c.tip {
+		c.tip = tip
+		if len(c.entries) > 0 {
+			c.entries = make(map[string]interface{})
+			HandleV2APICacheInvalidations.Inc()
+		}
+	}
+}
+
+// By the port of the API server
+var responseCaches = make(map[int]*ResponseCache)
+var responseCachesMutex sync.Mutex
+
+// GetResponseCache returns the cache of the API server the state answers on
+func GetResponseCache(state interfaces.IState) *ResponseCache {
+	responseCachesMutex.Lock()
+	defer responseCachesMutex.Unlock()
+
+	c, ok := responseCaches[state.GetPort()]
+	if !ok {
+		c = NewResponseCache()
+		responseCaches[state.GetPort()] = c
+	}
+	return c
+}
+
+// dropResponseCache forgets the cache of the API server on the port
+func dropResponseCache(port int) {
+	responseCachesMutex.Lock()
+	defer responseCachesMutex.Unlock()
+
+	delete(responseCaches, port)
+}
+
+func responseCacheKey(method string, params interface{}, tip uint32) (string, bool) {
+	if !ResponseCacheEnabled {
+		return "", false
+	}
+	if HeightCacheableMethods[method] {
+		req := new(HeightRequest)
+		if err := MapToObject(params, req); err != nil || req.Height < 0 || req.Height > int64(tip) {
+			return "", false
+		}
+	} else if !CacheableMethods[method] {
+		return "", false
+	}
+	p, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	return method + string(p), true
+}
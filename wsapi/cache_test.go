@@ -0,0 +1,85 @@
+package wsapi_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestResponseCache(t *testing.T) {
+	c := NewResponseCache()
+
+	if _, ok := c.Get(5, "heights{}"); ok {
+		t.Error("Empty cache should not have a hit")
+	}
+	c.Put(5, "heights{}", "five")
+	resp, ok := c.Get(5, "heights{}")
+	if !ok || resp.(string) != "five" {
+		t.Error("Expected a hit for a stored response")
+	}
+	if c.HitRate() != 0.5 {
+		t.Errorf("Expected a hit rate of 0.5, found %f", c.HitRate())
+	}
+
+	// A new block drops everything
+	if _, ok := c.Get(6, "heights{}"); ok {
+		t.Error("Cache should be invalidated by a new block")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Expected an empty cache, found %d entries", c.Len())
+	}
+
+	// Responses computed at an old tip are not stored
+	c.Put(5, "heights{}", "stale")
+	if _, ok := c.Get(6, "heights{}"); ok {
+		t.Error("Stale response should not be cached")
+	}
+}
+
+func TestResponseCacheLimit(t *testing.T) {
+	old := ResponseCacheMaxEntries
+	defer func() { ResponseCacheMaxEntries = old }()
+	ResponseCacheMaxEntries = 2
+
+	c := NewResponseCache()
+	c.Put(1, "a", 1)
+	c.Put(1, "b", 2)
+	c.Put(1, "c", 3)
+	if c.Len() != 1 {
+		t.Errorf("Expected the cache to be flushed when full, found %d entries", c.Len())
+	}
+}
+
+func TestCacheableMethods(t *testing.T) {
+	// These change within a block, so caching them until the next one gives stale answers
+	for _, method := range []string{"chain-head", "entry-credit-balance", "factoid-balance", "heights", "directory-block-head"} {
+		if CacheableMethods[method] {
+			t.Errorf("%s should not be cached", method)
+		}
+	}
+}
+
+func TestResponseCacheByHeight(t *testing.T) {
+	st := testHelper.CreateAndPopulateTestState()
+	st.PortNumber = 18088 // A cache of its own
+	cache := GetResponseCache(st)
+
+	// The test state has saved the genesis block, but holds more in its database
+	req := primitives.NewJSON2Request("dblock-by-height", 1, HeightRequest{Height: 0})
+	if _, jsonError := HandleV2Request(st, req); jsonError != nil {
+		t.Fatalf("%v", jsonError)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Expected a saved block to be cached, found %d entries", cache.Len())
+	}
+
+	req = primitives.NewJSON2Request("dblock-by-height", 1, HeightRequest{Height: 5})
+	if _, jsonError := HandleV2Request(st, req); jsonError != nil {
+		t.Fatalf("%v", jsonError)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("A block above the highest saved one should not be cached, found %d entries", cache.Len())
+	}
+}
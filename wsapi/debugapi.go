@@ -5,6 +5,7 @@
 package wsapi
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/web"
@@ -22,6 +24,7 @@ func HandleDebug(ctx *web.Context) {
 	state := ctx.Server.Env["state"].(interfaces.IState)
 	ServersMutex.Unlock()
 
+	setSecurityHeaders(ctx, state)
 	if err := checkAuthHeader(state, ctx.Request); err != nil {
 		remoteIP := ""
 		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
@@ -105,6 +108,15 @@ func HandleDebugRequest(
 	case "holding-queue":
 		resp, jsonError = HandleHoldingQueue(state, params)
 		break
+	case "holding-reasons":
+		resp, jsonError = HandleHoldingReasons(state, params)
+		break
+	case "dbstate-signatures":
+		resp, jsonError = HandleDBStateSignatures(state, params)
+		break
+	case "entry-sync":
+		resp, jsonError = HandleEntrySync(state, params)
+		break
 	case "messages":
 		resp, jsonError = HandleMessages(state, params)
 		break
@@ -114,6 +126,9 @@ func HandleDebugRequest(
 	case "summary":
 		resp, jsonError = HandleSummary(state, params)
 		break
+	case "state-dump":
+		resp, jsonError = HandleStateDump(state, params)
+		break
 	case "predictive-fer":
 		resp, jsonError = HandlePredictiveFER(state, params)
 		break
@@ -123,6 +138,21 @@ func HandleDebugRequest(
 	case "reload-configuration":
 		resp, jsonError = HandleReloadConfig(state, params)
 		break
+	case "routines":
+		resp, jsonError = HandleRoutines(state, params)
+		break
+	case "inject-message":
+		resp, jsonError = HandleInjectMessage(state, params)
+		break
+	case "drain-queue":
+		resp, jsonError = HandleDrainQueue(state, params)
+		break
+	case "quarantine":
+		resp, jsonError = HandleQuarantine(state, params)
+		break
+	case "set-spam-threshold":
+		resp, jsonError = HandleSetSpamThreshold(state, params)
+		break
 	default:
 		jsonError = NewMethodNotFoundError()
 		break
@@ -310,6 +340,40 @@ func HandleHoldingQueue(
 	return r, nil
 }
 
+// Reports why each message in Holding is held, and what would release it.
+func HandleHoldingReasons(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		Messages []interfaces.HeldMessage
+	}
+	return &ret{state.GetHoldingReasons()}, nil
+}
+
+func HandleDBStateSignatures(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	return state.GetDBStateSigTally(), nil
+}
+
+func HandleEntrySync(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	return state.GetEntrySyncProgress(), nil
+}
+
 func HandleMessages(
 	state interfaces.IState,
 	params interface{},
@@ -362,6 +426,157 @@ func HandleSummary(
 	return r, nil
 }
 
+func HandleStateDump(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		Filename string
+		Dump     string
+	}
+	r := new(ret)
+	var err error
+	r.Filename, r.Dump, err = state.WriteStateDump()
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	return r, nil
+}
+
+func HandleRoutines(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		Routines []interfaces.RoutineStatus `json:"routines"`
+	}
+	r := new(ret)
+	r.Routines = state.GetRoutineStatus()
+	return r, nil
+}
+
+// Puts a message, in hex, into one of the State's queues (see state/inject.go).  The api queue
+// is used if none is given.
+func HandleInjectMessage(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	req := new(InjectMessageRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	data, err := hex.DecodeString(req.Message)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	_, msg, err := messages.UnmarshalMessageData(data)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	if req.Queue == "" {
+		req.Queue = "api"
+	}
+	if err := state.InjectMessage(req.Queue, msg); err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	type ret struct {
+		Queue string
+		Hash  string
+	}
+	return &ret{req.Queue, msg.GetMsgHash().String()}, nil
+}
+
+// Takes the messages waiting in one of the State's queues out of it, and returns them in hex so
+// they can be injected again.
+func HandleDrainQueue(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	req := new(DrainQueueRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	msgs, err := state.DrainQueue(req.Queue, req.Max)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	type ret struct {
+		Queue    string
+		Messages []DrainedMessage
+	}
+	r := new(ret)
+	r.Queue = req.Queue
+	r.Messages = make([]DrainedMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		d := DrainedMessage{Type: messages.MessageName(msg.Type()), Hash: msg.GetMsgHash().String()}
+		if data, err := msg.MarshalBinary(); err == nil {
+			d.Message = hex.EncodeToString(data)
+		}
+		r.Messages = append(r.Messages, d)
+	}
+	return r, nil
+}
+
+// Reports the score at which commits are quarantined as likely spam, and how many are waiting
+// in the quarantine.  They can be drained and injected like any other queue's.
+func HandleQuarantine(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	length, err := state.QueueLength("quarantine")
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+
+	type ret struct {
+		SpamThreshold int
+		Length        int
+	}
+	return &ret{state.GetSpamThreshold(), length}, nil
+}
+
+func HandleSetSpamThreshold(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		SpamThreshold int
+	}
+	r := new(ret)
+
+	threshold := new(SetSpamThresholdRequest)
+	err := MapToObject(params, threshold)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	state.SetSpamThreshold(threshold.SpamThreshold)
+	r.SpamThreshold = threshold.SpamThreshold
+	return r, nil
+}
+
 func HandlePredictiveFER(
 	state interfaces.IState,
 	params interface{},
@@ -412,3 +627,23 @@ type SetDelayRequest struct {
 type SetDropRateRequest struct {
 	DropRate int `json:"droprate"`
 }
+
+type SetSpamThresholdRequest struct {
+	SpamThreshold int `json:"spamthreshold"`
+}
+
+type InjectMessageRequest struct {
+	Queue   string `json:"queue"`
+	Message string `json:"message"`
+}
+
+type DrainQueueRequest struct {
+	Queue string `json:"queue"`
+	Max   int    `json:"max"` // Most messages to take; all of them if 0
+}
+
+type DrainedMessage struct {
+	Type    string
+	Hash    string
+	Message string // Empty if the message could not be marshalled
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/did"
+	"github.com/FactomProject/web"
+)
+
+// /1.0/identifiers/<did> resolves a factom DID (see did/), as the HTTP binding of the W3C DID
+// Resolution specification has it, so a node can be a resolver for SSI stacks.  It returns the
+// resolution result, or only the DID document if that is what is asked for with
+// Accept: application/did+ld+json.  A deactivated DID is 410 Gone, with its result.
+
+const (
+	didDocumentType   = "application/did+ld+json"
+	didResolutionType = `application/ld+json;profile="https://w3id.org/did-resolution"`
+)
+
+func HandleDIDResolve(ctx *web.Context, identifier string) {
+	ServersMutex.Lock()
+	state := ctx.Server.Env["state"].(interfaces.IState)
+	ServersMutex.Unlock()
+
+	if err := checkAuthHeader(state, ctx.Request); err != nil {
+		ctx.ResponseWriter.Header().Add("WWW-Authenticate", `Basic realm="factomd RPC"`)
+		http.Error(ctx.ResponseWriter, "401 Unauthorized.", http.StatusUnauthorized)
+		return
+	}
+	if unescaped, err := url.QueryUnescape(identifier); err == nil {
+		identifier = unescaped
+	}
+
+	dbase := state.GetAndLockDB()
+	result, err := did.Resolve(dbase, identifier)
+	state.UnlockDB()
+
+	if err != nil {
+		status := http.StatusInternalServerError
+		result = &did.Result{Context: did.ResolutionContext}
+		switch err {
+		case did.ErrInvalidDID:
+			status = http.StatusBadRequest
+			result.ResolutionMetadata.Error = err.Error()
+		case did.ErrNotFound:
+			status = http.StatusNotFound
+			result.ResolutionMetadata.Error = err.Error()
+		default:
+			state.Logf("error", "Resolving %s: %s", identifier, err.Error())
+			result.ResolutionMetadata.Error = "internalError"
+		}
+		writeDIDResponse(ctx, status, didResolutionType, result)
+		return
+	}
+
+	status := http.StatusOK
+	if result.DocumentMetadata.Deactivated {
+		status = http.StatusGone
+	}
+	if strings.Contains(ctx.Request.Header.Get("Accept"), didDocumentType) {
+		writeDIDResponse(ctx, status, didDocumentType, result.Document)
+		return
+	}
+	writeDIDResponse(ctx, status, didResolutionType, result)
+}
+
+func writeDIDResponse(ctx *web.Context, status int, contentType string, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		http.Error(ctx.ResponseWriter, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ctx.ResponseWriter.Header().Set("Content-Type", contentType)
+	ctx.ResponseWriter.WriteHeader(status)
+	ctx.ResponseWriter.Write(data)
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// "entry-exists" tells whether entries are saved, for writers that check each entry before they
+// commit it, at rates that would keep the database busy.  With -entrybloom the node keeps a bloom
+// filter over the saved entry hashes (see database/databaseOverlay/entryBloom.go), so most entries
+// that aren't saved are answered from memory; the rest are looked up.  Either way the answer is
+// exact.  Entries not yet in a block aren't saved, so they don't exist here.
+
+// Most hashes one entry-exists call takes
+const MaxEntryExistsHashes = 1000
+
+func HandleV2EntryExists(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallEntryExists.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(EntryExistsRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	hashes := req.Hashes
+	if req.Hash != "" {
+		hashes = append([]string{req.Hash}, hashes...)
+	}
+	if len(hashes) == 0 || len(hashes) > MaxEntryExistsHashes {
+		return nil, NewInvalidParamsError()
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	resp := new(EntryExistsResponse)
+	resp.Entries = []EntryExists{}
+	for _, s := range hashes {
+		h, err := primitives.HexToHash(s)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		exists, err := dbase.EntryExists(h)
+		if err != nil {
+			return nil, NewInternalError()
+		}
+		resp.Entries = append(resp.Entries, EntryExists{Hash: h.String(), Exists: exists})
+	}
+	resp.Bloom = dbase.EntryBloomStatus()
+	return resp, nil
+}
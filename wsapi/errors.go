@@ -94,3 +94,12 @@ func NewMissingChainHeadError() *primitives.JSONError {
 func NewReceiptError() *primitives.JSONError {
 	return primitives.NewJSONError(-32010, "Receipt creation error", nil)
 }
+func NewReadOnlyError() *primitives.JSONError {
+	return primitives.NewJSONError(-32011, "Read only node", nil)
+}
+func NewShuttingDownError() *primitives.JSONError {
+	return primitives.NewJSONError(-32012, "Node is shutting down", nil)
+}
+func NewStandbyError(data interface{}) *primitives.JSONError {
+	return primitives.NewJSONError(-32013, "Standby promotion refused", data)
+}
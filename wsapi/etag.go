@@ -0,0 +1,90 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/web"
+)
+
+// Conditional requests for the v1 REST reads. Objects addressed by their own
+// keyMR or hash never change, so their ETag is that hash. Everything else is
+// tagged with the keyMR of the highest saved directory block, so a polling
+// client gets a 304 until the next block is saved.
+//
+// A response is only tagged once the object it is about is found, so a 404
+// never carries an ETag, and "*" only matches an object that exists.
+
+// ImmutableETag returns the tag for an object addressed by its own hash
+func ImmutableETag(kind string, hash string) string {
+	return fmt.Sprintf("\"%s-%s\"", kind, strings.ToLower(hash))
+}
+
+// TipETag returns the tag for a response that can change with every block
+func TipETag(state interfaces.IState, path string) string {
+	dblk := state.GetDirectoryBlockByHeight(state.GetHighestSavedBlk())
+	if dblk == nil {
+		return ""
+	}
+	h := sha256.Sum256([]byte(dblk.GetKeyMR().String() + path))
+	return fmt.Sprintf("\"%x\"", h)
+}
+
+// requestTipETag returns the TipETag of the request. Take it before the lookup,
+// so a block saved meanwhile can't tag an older response as the current one.
+func requestTipETag(ctx *web.Context, state interfaces.IState) string {
+	if ctx.Request == nil {
+		return ""
+	}
+	return TipETag(state, ctx.Request.URL.Path)
+}
+
+// checkTipNotModified is checkNotModified for responses tagged with the chain
+// tip, taken by requestTipETag
+func checkTipNotModified(ctx *web.Context, state interfaces.IState, etag string) bool {
+	setCacheControl(ctx, state, false)
+	return checkNotModified(ctx, etag)
+}
+
+// checkImmutableNotModified is checkNotModified for objects addressed by their own hash
+func checkImmutableNotModified(ctx *web.Context, state interfaces.IState, kind string, hash string) bool {
+	setCacheControl(ctx, state, true)
+	return checkNotModified(ctx, ImmutableETag(kind, hash))
+}
+
+// checkNotModified sets the ETag header and answers with a 304 if the client
+// already holds the current version. Returns true if the response was written.
+// Only call it once the object was found.
+func checkNotModified(ctx *web.Context, etag string) bool {
+	if etag == "" || ctx.Request == nil {
+		return false
+	}
+	ctx.ResponseWriter.Header().Set("ETag", etag)
+	if etagMatches(ctx.Request.Header.Get("If-None-Match"), etag) {
+		ctx.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// etagMatches does the strong comparison of If-None-Match, which may hold a
+// list of tags or "*", which matches any version of an object that exists
+func etagMatches(header string, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
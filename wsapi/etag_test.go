@@ -0,0 +1,85 @@
+package wsapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestImmutableETag(t *testing.T) {
+	a := ImmutableETag("entry", "ABCDEF")
+	b := ImmutableETag("entry", "abcdef")
+	if a != b {
+		t.Errorf("ETags should not depend on hash case - %v vs %v", a, b)
+	}
+	if a == ImmutableETag("eblock", "abcdef") {
+		t.Error("ETags for different kinds of objects should differ")
+	}
+}
+
+func TestHandleDirectoryBlockNotModified(t *testing.T) {
+	context := testHelper.CreateWebContext()
+	hash := testHelper.DBlockHeadPrimaryIndex
+
+	context.Request, _ = http.NewRequest("GET", "/v1/directory-block-by-keymr/"+hash, nil)
+	HandleDirectoryBlock(context, hash)
+	etag := context.ResponseWriter.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("No ETag was set")
+	}
+	if testHelper.GetBody(context) == "" {
+		t.Error("Expected a body on the first request")
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	context.Request.Header.Set("If-None-Match", etag)
+	HandleDirectoryBlock(context, hash)
+	if context.ResponseWriter.(*testHelper.TestResponseWriter).HeaderCode != http.StatusNotModified {
+		t.Error("Expected a 304 for a matching If-None-Match")
+	}
+	if testHelper.GetBody(context) != "" {
+		t.Errorf("Expected an empty body - %v", testHelper.GetBody(context))
+	}
+}
+
+func TestHandleHeightsNotModified(t *testing.T) {
+	context := testHelper.CreateWebContext()
+
+	context.Request, _ = http.NewRequest("GET", "/v1/heights/", nil)
+	HandleHeights(context)
+	etag := context.ResponseWriter.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("No ETag was set")
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	context.Request.Header.Set("If-None-Match", `"stale", `+etag)
+	HandleHeights(context)
+	if context.ResponseWriter.(*testHelper.TestResponseWriter).HeaderCode != http.StatusNotModified {
+		t.Error("Expected a 304 when the tag is in the If-None-Match list")
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	context.Request.Header.Set("If-None-Match", `"stale"`)
+	HandleHeights(context)
+	if testHelper.GetBody(context) == "" {
+		t.Error("Expected a body for a stale tag")
+	}
+}
+
+func TestHandleEntryNotFoundHasNoETag(t *testing.T) {
+	context := testHelper.CreateWebContext()
+	hash := "0000000000000000000000000000000000000000000000000000000000000001"
+
+	context.Request, _ = http.NewRequest("GET", "/v1/entry-by-hash/"+hash, nil)
+	context.Request.Header.Set("If-None-Match", "*")
+	HandleEntry(context, hash)
+	if etag := context.ResponseWriter.Header().Get("ETag"); etag != "" {
+		t.Errorf("A missing entry was tagged %v", etag)
+	}
+	if context.ResponseWriter.(*testHelper.TestResponseWriter).HeaderCode == http.StatusNotModified {
+		t.Error("If-None-Match * matched a missing entry")
+	}
+}
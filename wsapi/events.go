@@ -0,0 +1,329 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/web"
+	"golang.org/x/net/websocket"
+)
+
+// The events socket, /v2/events, streams the entries and factoid transactions of each directory
+// block as it is saved.  A client sends an EventFilter when it connects, and may send another at
+// any time to replace it.  The filter is matched here, so the client is only sent the events it
+// asked for, not every entry and transaction on the network.
+//
+// A filter matches an entry if it is in one of ChainIDs and has an ExtID starting with one of
+// ExtIDPrefixes, leaving out the parts that are empty.  It matches a transaction if one of its
+// inputs or outputs is in Addresses and it moves at least MinAmount factoshis, again leaving out
+// what is empty.  A filter with only entry parts is sent no transactions, and one with only
+// transaction parts no entries; an empty filter is sent everything.
+
+const (
+	EventEntry       = "entry"
+	EventTransaction = "transaction"
+)
+
+// Time between checks for newly saved blocks
+var EventPollInterval time.Duration = 1 * time.Second
+
+// The most chain IDs, prefixes and addresses, together, that a filter may list
+const MaxEventFilterItems = 1000
+
+type EventFilter struct {
+	StartHeight   int64    `json:"startheight"` // First block to send events of; -1, the default, for the next saved
+	ChainIDs      []string `json:"chainids"`
+	ExtIDPrefixes []string `json:"extidprefixes"` // In hex
+	Addresses     []string `json:"addresses"`     // FA addresses
+	MinAmount     uint64   `json:"minamount"`     // Factoshis
+}
+
+type Event struct {
+	Type      string   `json:"type"`
+	Height    uint32   `json:"height"`
+	ChainID   string   `json:"chainid,omitempty"`
+	EntryHash string   `json:"entryhash,omitempty"`
+	ExtIDs    []string `json:"extids,omitempty"`
+	TxID      string   `json:"txid,omitempty"`
+	Addresses []string `json:"addresses,omitempty"` // FA addresses of the inputs and outputs
+	Amount    uint64   `json:"amount,omitempty"`    // Factoshis in, or out for a coinbase
+}
+
+// An EventMatcher is an EventFilter checked and indexed for matching.
+type EventMatcher struct {
+	entries      bool
+	transactions bool
+	chains       map[string]bool
+	prefixes     []string
+	addresses    map[string]bool
+	minAmount    uint64
+}
+
+// Matcher checks the filter and returns its matcher.
+func (f *EventFilter) Matcher() (*EventMatcher, error) {
+	if len(f.ChainIDs)+len(f.ExtIDPrefixes)+len(f.Addresses) > MaxEventFilterItems {
+		return nil, fmt.Errorf("A filter may list at most %d chain IDs, prefixes and addresses", MaxEventFilterItems)
+	}
+	m := new(EventMatcher)
+	m.minAmount = f.MinAmount
+	for _, c := range f.ChainIDs {
+		h, err := primitives.HexToHash(c)
+		if err != nil {
+			return nil, fmt.Errorf("Bad chain ID %q: %s", c, err.Error())
+		}
+		if m.chains == nil {
+			m.chains = make(map[string]bool)
+		}
+		m.chains[h.String()] = true
+	}
+	for _, p := range f.ExtIDPrefixes {
+		b, err := hex.DecodeString(p)
+		if err != nil {
+			return nil, fmt.Errorf("Bad ExtID prefix %q: %s", p, err.Error())
+		}
+		// Events carry their ExtIDs in lower case hex, so a prefix of the bytes is a prefix of the hex
+		m.prefixes = append(m.prefixes, hex.EncodeToString(b))
+	}
+	for _, a := range f.Addresses {
+		if !primitives.ValidateFUserStr(a) {
+			return nil, fmt.Errorf("Bad factoid address %q", a)
+		}
+		if m.addresses == nil {
+			m.addresses = make(map[string]bool)
+		}
+		m.addresses[a] = true
+	}
+
+	entryParts := m.chains != nil || len(m.prefixes) > 0
+	transactionParts := m.addresses != nil || m.minAmount > 0
+	m.entries = entryParts || !transactionParts
+	m.transactions = transactionParts || !entryParts
+	return m, nil
+}
+
+// Match returns true if the event passes the filter.
+func (m *EventMatcher) Match(e *Event) bool {
+	switch e.Type {
+	case EventEntry:
+		if !m.entries {
+			return false
+		}
+		if m.chains != nil && !m.chains[e.ChainID] {
+			return false
+		}
+		if len(m.prefixes) == 0 {
+			return true
+		}
+		for _, extID := range e.ExtIDs {
+			for _, p := range m.prefixes {
+				if strings.HasPrefix(extID, p) {
+					return true
+				}
+			}
+		}
+		return false
+	case EventTransaction:
+		if !m.transactions || e.Amount < m.minAmount {
+			return false
+		}
+		if m.addresses == nil {
+			return true
+		}
+		for _, a := range e.Addresses {
+			if m.addresses[a] {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// BlockEvents returns the events of the directory block at height, transactions first, or nil
+// if we don't have the block.
+func BlockEvents(dbase interfaces.DBOverlaySimple, height uint32) ([]*Event, error) {
+	dblk, err := dbase.FetchDBlockByHeight(height)
+	if err != nil || dblk == nil {
+		return nil, err
+	}
+
+	var events []*Event
+	fblk, err := dbase.FetchFBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if fblk != nil {
+		for _, tx := range fblk.GetTransactions() {
+			events = append(events, transactionEvent(height, tx))
+		}
+	}
+
+	for _, dbEntry := range dblk.GetEBlockDBEntries() {
+		eblk, err := dbase.FetchEBlock(dbEntry.GetKeyMR())
+		if err != nil {
+			return nil, err
+		}
+		if eblk == nil {
+			continue
+		}
+		for _, hash := range eblk.GetEntryHashes() {
+			if hash.IsMinuteMarker() {
+				continue
+			}
+			entry, err := dbase.FetchEntry(hash)
+			if err != nil {
+				return nil, err
+			}
+			if entry == nil {
+				continue // Still missing; we don't hold up the stream for it
+			}
+			event := &Event{Type: EventEntry, Height: height, EntryHash: hash.String()}
+			event.ChainID = entry.GetChainIDHash().String()
+			for _, extID := range entry.ExternalIDs() {
+				event.ExtIDs = append(event.ExtIDs, hex.EncodeToString(extID))
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func transactionEvent(height uint32, tx interfaces.ITransaction) *Event {
+	event := &Event{Type: EventTransaction, Height: height, TxID: tx.GetSigHash().String()}
+	for _, in := range tx.GetInputs() {
+		event.Addresses = append(event.Addresses, primitives.ConvertFctAddressToUserStr(in.GetAddress()))
+		event.Amount += in.GetAmount()
+	}
+	coinbase := len(tx.GetInputs()) == 0
+	for _, out := range tx.GetOutputs() {
+		event.Addresses = append(event.Addresses, primitives.ConvertFctAddressToUserStr(out.GetAddress()))
+		if coinbase {
+			event.Amount += out.GetAmount()
+		}
+	}
+	return event
+}
+
+func HandleEvents(ctx *web.Context) {
+	ServersMutex.Lock()
+	state := ctx.Server.Env["state"].(interfaces.IState)
+	ServersMutex.Unlock()
+
+	if err := checkAuthHeader(state, ctx.Request); err != nil {
+		ctx.ResponseWriter.Header().Add("WWW-Authenticate", `Basic realm="factomd RPC"`)
+		http.Error(ctx.ResponseWriter, "401 Unauthorized.", http.StatusUnauthorized)
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: checkEventsOrigin,
+		Handler:   func(ws *websocket.Conn) { eventsHandler(state, ws) },
+	}
+	server.ServeHTTP(ctx.ResponseWriter, ctx.Request)
+}
+
+// Clients that aren't browsers send no origin.  A browser page may only connect from the API's
+// own host, so another site can't use the browser's credentials.
+func checkEventsOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	if origin != nil && origin.Host != r.Host {
+		return fmt.Errorf("websocket origin %v is not allowed", origin)
+	}
+	config.Origin = origin
+	return nil
+}
+
+type eventSubscription struct {
+	matcher *EventMatcher
+	start   int64
+}
+
+func eventsHandler(state interfaces.IState, ws *websocket.Conn) {
+	defer ws.Close()
+
+	subscriptions := make(chan eventSubscription, 1)
+	go readEventFilters(ws, subscriptions)
+
+	// Nothing is sent until the first filter arrives
+	sub, ok := <-subscriptions
+	if !ok {
+		return
+	}
+	next := nextEventHeight(state, sub.start)
+
+	ticker := time.NewTicker(EventPollInterval)
+	defer ticker.Stop()
+	for {
+		for next <= state.GetHighestSavedBlk() {
+			dbase := state.GetAndLockDB()
+			events, err := BlockEvents(dbase, next)
+			state.UnlockDB()
+			if err != nil {
+				return
+			}
+			for _, e := range events {
+				if sub.matcher.Match(e) {
+					if err := websocket.JSON.Send(ws, e); err != nil {
+						return
+					}
+				}
+			}
+			next++
+		}
+		select {
+		case s, ok := <-subscriptions:
+			if !ok {
+				return
+			}
+			sub = s
+			if sub.start >= 0 {
+				next = nextEventHeight(state, sub.start)
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+func nextEventHeight(state interfaces.IState, start int64) uint32 {
+	if start < 0 {
+		return state.GetHighestSavedBlk() + 1
+	}
+	return uint32(start)
+}
+
+// Reads filters from the client until the socket is closed.  A bad filter is answered with an
+// error, and the last good filter stays in place.
+func readEventFilters(ws *websocket.Conn, subscriptions chan eventSubscription) {
+	defer close(subscriptions)
+	for {
+		f := &EventFilter{StartHeight: -1}
+		if err := websocket.JSON.Receive(ws, f); err != nil {
+			return
+		}
+		m, err := f.Matcher()
+		if err != nil {
+			if websocket.JSON.Send(ws, NewCustomInvalidParamsError(err.Error())) != nil {
+				return
+			}
+			continue
+		}
+		// Only the latest filter matters
+		select {
+		case <-subscriptions:
+		default:
+		}
+		subscriptions <- eventSubscription{matcher: m, start: f.StartHeight}
+	}
+}
@@ -0,0 +1,92 @@
+package wsapi_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+const (
+	testChainID = "df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604"
+	testAddress = "FA2jK2HcLnRdS94dEcU27rF3meoJfpUcZPSinpb7AwQvPRY6RL1Q"
+)
+
+func TestEventMatcher(t *testing.T) {
+	entry := &Event{Type: EventEntry, ChainID: testChainID, ExtIDs: []string{"0102", "abcdef"}}
+	tx := &Event{Type: EventTransaction, Addresses: []string{testAddress}, Amount: 500}
+
+	filters := []struct {
+		filter EventFilter
+		entry  bool
+		tx     bool
+	}{
+		{EventFilter{}, true, true},
+		{EventFilter{ChainIDs: []string{testChainID}}, true, false},
+		{EventFilter{ChainIDs: []string{"888888" + testChainID[6:]}}, false, false},
+		{EventFilter{ExtIDPrefixes: []string{"ABCD"}}, true, false},
+		{EventFilter{ExtIDPrefixes: []string{"02"}}, false, false},
+		{EventFilter{ChainIDs: []string{testChainID}, ExtIDPrefixes: []string{"01"}}, true, false},
+		{EventFilter{Addresses: []string{testAddress}}, false, true},
+		{EventFilter{MinAmount: 500}, false, true},
+		{EventFilter{MinAmount: 501}, false, false},
+		{EventFilter{Addresses: []string{testAddress}, ChainIDs: []string{testChainID}}, true, true},
+	}
+	for i, f := range filters {
+		m, err := f.filter.Matcher()
+		if err != nil {
+			t.Fatalf("Filter %d: %v", i, err)
+		}
+		if m.Match(entry) != f.entry {
+			t.Errorf("Filter %d: matching the entry should be %v", i, f.entry)
+		}
+		if m.Match(tx) != f.tx {
+			t.Errorf("Filter %d: matching the transaction should be %v", i, f.tx)
+		}
+	}
+}
+
+func TestEventFilterErrors(t *testing.T) {
+	bad := []EventFilter{
+		{ChainIDs: []string{"xyz"}},
+		{ExtIDPrefixes: []string{"abc"}},
+		{Addresses: []string{"EC2DKSYyRcNWf7RS963VFYgMExoHRYLHVeCfQ9PGPmNzwrcmgm2r"}},
+		{Addresses: make([]string, MaxEventFilterItems+1)},
+	}
+	for i, f := range bad {
+		if _, err := f.Matcher(); err == nil {
+			t.Errorf("Filter %d should not be accepted", i)
+		}
+	}
+}
+
+func TestBlockEvents(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	events, err := BlockEvents(dbase, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries, txs int
+	for _, e := range events {
+		if e.Height != 1 {
+			t.Errorf("Event at height %d, expected 1", e.Height)
+		}
+		switch e.Type {
+		case EventEntry:
+			entries++
+		case EventTransaction:
+			txs++
+		}
+	}
+	if entries == 0 || txs == 0 {
+		t.Errorf("Expected entries and transactions, got %d entries and %d transactions", entries, txs)
+	}
+
+	events, err = BlockEvents(dbase, 1<<30)
+	if err != nil || events != nil {
+		t.Errorf("Expected no events past the top block, got %v, %v", events, err)
+	}
+}
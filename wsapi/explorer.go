@@ -0,0 +1,66 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/web"
+)
+
+// A node run with NodeMode EXPLORER serves public readers. Nothing can be
+// submitted through it, the debug API is not served, and reads are cached as
+// long as possible, both in the response cache and by http caches in front of
+// the node.
+
+// Methods that submit to the network, refused by an explorer
+var WriteMethods = map[string]bool{
+	"commit-chain":        true,
+	"commit-entry":        true,
+	"factoid-submit":      true,
+	"promote-standby":     true,
+	"reveal-chain":        true,
+	"reveal-entry":        true,
+	"send-probe":          true,
+	"send-raw-message":    true,
+	"sign-network-status": true,
+}
+
+// Methods an explorer adds to CacheableMethods
+var ExplorerCacheableMethods = []string{"raw-data"}
+
+// Response cache size of an explorer
+var ExplorerResponseCacheMaxEntries int = 100000
+
+// Seconds an http cache may keep a response that changes with the next block
+var ExplorerTipMaxAge int = 60
+
+// Cache-Control of objects addressed by their own hash
+const ImmutableCacheControl = "public, max-age=31536000, immutable"
+
+// startExplorer enables the caching of an explorer. Called by Start.
+func startExplorer() {
+	ResponseCacheEnabled = true
+	if ResponseCacheMaxEntries < ExplorerResponseCacheMaxEntries {
+		ResponseCacheMaxEntries = ExplorerResponseCacheMaxEntries
+	}
+	for _, method := range ExplorerCacheableMethods {
+		CacheableMethods[method] = true
+	}
+}
+
+// setCacheControl lets http caches keep the response of an explorer. Errors
+// are never cached, see handleV1Error.
+func setCacheControl(ctx *web.Context, state interfaces.IState, immutable bool) {
+	if !state.IsExplorerMode() || ctx.ResponseWriter == nil {
+		return
+	}
+	if immutable {
+		ctx.ResponseWriter.Header().Set("Cache-Control", ImmutableCacheControl)
+	} else {
+		ctx.ResponseWriter.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", ExplorerTipMaxAge))
+	}
+}
@@ -0,0 +1,68 @@
+package wsapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestExplorerRefusesWrites(t *testing.T) {
+	st := testHelper.CreateAndPopulateTestState()
+	st.NodeMode = "EXPLORER"
+
+	for method := range WriteMethods {
+		req := primitives.NewJSON2Request(method, 1, nil)
+		_, jsonError := HandleV2Request(st, req)
+		if jsonError == nil || jsonError.Code != NewReadOnlyError().Code {
+			t.Errorf("Expected %v to be refused, got %v", method, jsonError)
+		}
+	}
+
+	req := primitives.NewJSON2Request("heights", 1, nil)
+	if _, jsonError := HandleV2Request(st, req); jsonError != nil {
+		t.Errorf("Expected reads to be answered, got %v", jsonError)
+	}
+
+	st.NodeMode = "FULL"
+	req = primitives.NewJSON2Request("commit-chain", 1, nil)
+	if _, jsonError := HandleV2Request(st, req); jsonError != nil && jsonError.Code == NewReadOnlyError().Code {
+		t.Error("Only an explorer should refuse writes")
+	}
+}
+
+func TestExplorerCacheControl(t *testing.T) {
+	context := testHelper.CreateWebContext()
+	st := context.Server.Env["state"].(*state.State)
+	hash := testHelper.DBlockHeadPrimaryIndex
+
+	context.Request, _ = http.NewRequest("GET", "/v1/directory-block-by-keymr/"+hash, nil)
+	HandleDirectoryBlock(context, hash)
+	if cc := context.ResponseWriter.Header().Get("Cache-Control"); cc != "" {
+		t.Errorf("Only an explorer should send Cache-Control, found %v", cc)
+	}
+
+	st.NodeMode = "EXPLORER"
+	testHelper.ClearContextResponseWriter(context)
+	HandleDirectoryBlock(context, hash)
+	if cc := context.ResponseWriter.Header().Get("Cache-Control"); cc != ImmutableCacheControl {
+		t.Errorf("Wrong Cache-Control for an immutable object %v", cc)
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	context.Request, _ = http.NewRequest("GET", "/v1/heights/", nil)
+	HandleHeights(context)
+	if cc := context.ResponseWriter.Header().Get("Cache-Control"); cc != "public, max-age=60" {
+		t.Errorf("Wrong Cache-Control for the heights %v", cc)
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	context.Request, _ = http.NewRequest("GET", "/v1/entry-by-hash/abc", nil)
+	HandleEntry(context, "abc")
+	if cc := context.ResponseWriter.Header().Get("Cache-Control"); cc != "no-store" {
+		t.Errorf("Errors should not be cached, found %v", cc)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/FactomProject/factomd/chainExport"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/web"
+)
+
+// /v2/export/<chainid> streams the export of a chain (see chainExport/) straight from the
+// database to the response, so a chain of many gigabytes can be exported without holding it in
+// memory.  Add ?version=1 for the format's first version, and ?gzip=1 to have it compressed.
+// Once it has started, an http response can't report an error, so an export that broke off is
+// one without the trailer.
+
+func HandleExport(ctx *web.Context, chainIDString string) {
+	ServersMutex.Lock()
+	state := ctx.Server.Env["state"].(interfaces.IState)
+	ServersMutex.Unlock()
+
+	if err := checkAuthHeader(state, ctx.Request); err != nil {
+		ctx.ResponseWriter.Header().Add("WWW-Authenticate", `Basic realm="factomd RPC"`)
+		http.Error(ctx.ResponseWriter, "401 Unauthorized.", http.StatusUnauthorized)
+		return
+	}
+	chainID, err := primitives.HexToHash(chainIDString)
+	if err != nil {
+		http.Error(ctx.ResponseWriter, "Bad chain ID", http.StatusBadRequest)
+		return
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+	if head, err := dbase.FetchEBlockHead(chainID); err != nil || head == nil {
+		http.Error(ctx.ResponseWriter, "Chain not found", http.StatusNotFound)
+		return
+	}
+
+	version := chainExport.LatestVersion
+	if v := ctx.Request.URL.Query().Get("version"); v != "" {
+		version, err = strconv.Atoi(v)
+		if err != nil || chainExport.Magics[version] == nil {
+			http.Error(ctx.ResponseWriter, "Bad version", http.StatusBadRequest)
+			return
+		}
+	}
+
+	compress := ctx.Request.URL.Query().Get("gzip") == "1"
+	name := chainID.String() + ".export"
+	if compress {
+		name += ".gz"
+	}
+	header := ctx.ResponseWriter.Header()
+	header.Set("Content-Type", "application/octet-stream")
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+
+	buffered := bufio.NewWriter(ctx.ResponseWriter)
+	var w io.Writer = buffered
+	var zw *gzip.Writer
+	if compress {
+		zw = gzip.NewWriter(buffered)
+		w = zw
+	}
+	count, err := chainExport.Export(dbase, chainID, version, w)
+	if err != nil {
+		// Without the trailer, the client can tell the export is incomplete
+		state.Logf("error", "Export of chain %s stopped after %d entries: %s", chainID.String(), count, err.Error())
+		buffered.Flush()
+		return
+	}
+	if zw != nil {
+		zw.Close()
+	}
+	buffered.Flush()
+}
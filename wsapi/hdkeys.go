@@ -0,0 +1,102 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// "derive-addresses" and "derive-xpub" work on the extended public keys of hierarchical
+// deterministic wallets (see common/primitives/hdkey.go), so that an exchange can hand out and
+// watch deposit addresses from the node without any private key on it.  An extended public key is
+// usually that of an account, m/44'/131'/account' for factoids or m/44'/132'/account' for entry
+// credits, whose chain 0 holds the receiving addresses and chain 1 the change.
+
+// Most addresses derive-addresses returns
+const MaxDeriveAddressesCount = 1000
+
+func HandleV2DeriveAddresses(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallDeriveAddresses.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(DeriveAddressesRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Count < 0 || req.Count > MaxDeriveAddressesCount {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Count == 0 {
+		req.Count = 20
+	}
+	if req.Chain >= primitives.HDHardened || uint64(req.Start)+uint64(req.Count) > uint64(primitives.HDHardened) {
+		return nil, NewCustomInvalidParamsError("Hardened keys can't be derived from a public key")
+	}
+
+	xpub, err := primitives.NewHDPublicKeyFromString(req.XPub)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	chain, err := xpub.Child(req.Chain)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	resp := new(DeriveAddressesResponse)
+	resp.Chain = req.Chain
+	resp.Addresses = []DerivedAddress{}
+	for i := req.Start; i < req.Start+uint32(req.Count); i++ {
+		key, err := chain.Child(i)
+		if err != nil {
+			return nil, NewCustomInternalError(err.Error())
+		}
+		da := DerivedAddress{Index: i, PublicKey: hex.EncodeToString(key.Key[:])}
+		if req.EC {
+			adr := factoid.NewAddress(key.Key[:])
+			da.Address = primitives.ConvertECAddressToUserStr(adr)
+			da.Balance = state.GetFactoidState().GetECBalance(adr.Fixed())
+		} else {
+			adr, _ := factoid.NewRCD_1(key.Key[:]).GetAddress()
+			da.Address = primitives.ConvertFctAddressToUserStr(adr)
+			da.Balance = state.GetFactoidState().GetFactoidBalance(adr.Fixed())
+		}
+		resp.Addresses = append(resp.Addresses, da)
+	}
+	return resp, nil
+}
+
+func HandleV2DeriveXPub(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallDeriveXPub.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(DeriveXPubRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	xpub, err := primitives.NewHDPublicKeyFromString(req.XPub)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	path, err := primitives.ParseHDPath(req.Path)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	child, err := xpub.Derive(path)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	resp := new(DeriveXPubResponse)
+	resp.XPub = child.String()
+	resp.PublicKey = hex.EncodeToString(child.Key[:])
+	return resp, nil
+}
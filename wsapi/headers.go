@@ -0,0 +1,22 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/web"
+)
+
+// setSecurityHeaders adds the configured security headers to the response.
+// Every handler calls it before writing anything.
+func setSecurityHeaders(ctx *web.Context, state interfaces.IState) {
+	if ctx.ResponseWriter == nil {
+		return
+	}
+	header := ctx.ResponseWriter.Header()
+	for name, value := range state.GetAPISecurityHeaders() {
+		header.Set(name, value)
+	}
+}
@@ -0,0 +1,43 @@
+package wsapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	context := testHelper.CreateWebContext()
+	st := context.Server.Env["state"].(*state.State)
+	context.Request, _ = http.NewRequest("GET", "/v1/directory-block-head/", nil)
+
+	HandleDirectoryBlockHead(context)
+	header := context.ResponseWriter.Header()
+	if header.Get("Content-Security-Policy") != st.FactomdContentSecurityPolicy {
+		t.Errorf("Wrong Content-Security-Policy %v", header.Get("Content-Security-Policy"))
+	}
+	if header.Get("X-Frame-Options") != "DENY" {
+		t.Errorf("Wrong X-Frame-Options %v", header.Get("X-Frame-Options"))
+	}
+	if header.Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("Wrong X-Content-Type-Options %v", header.Get("X-Content-Type-Options"))
+	}
+	if header.Get("Strict-Transport-Security") != "" {
+		t.Error("Strict-Transport-Security should only be sent with TLS enabled")
+	}
+
+	st.FactomdTLSEnable = true
+	st.HttpFrameOptions = ""
+	testHelper.ClearContextResponseWriter(context)
+	HandleDirectoryBlockHead(context)
+	header = context.ResponseWriter.Header()
+	if header.Get("Strict-Transport-Security") != st.HttpStrictTransportSecurity {
+		t.Errorf("Wrong Strict-Transport-Security %v", header.Get("Strict-Transport-Security"))
+	}
+	if header.Get("X-Frame-Options") != "" {
+		t.Error("An empty setting should not send its header")
+	}
+}
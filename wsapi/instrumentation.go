@@ -24,6 +24,10 @@ var (
 		Name: "factomd_wsapi_v2_api_call_commitentry_ns",
 		Help: "Time it takes to compelete a commitentry",
 	})
+	HandleV2APICallCommitEntries = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_commitentries_ns",
+		Help: "Time it takes to compelete a commitentries",
+	})
 
 	HandleV2APICallDBlock = prometheus.NewSummary(prometheus.SummaryOpts{
 		Name: "factomd_wsapi_v2_api_call_dblock_ns",
@@ -154,6 +158,156 @@ var (
 		Name: "factomd_wsapi_v2_api_call_tpsrate_ns",
 		Help: "Time it takes to compelete a tpsrate",
 	})
+
+	HandleV2APICallEntriesByTime = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_entriesbytime_ns",
+		Help: "Time it takes to compelete an entriesbytime",
+	})
+
+	HandleV2APICallNodeStatus = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_nodestatus_ns",
+		Help: "Time it takes to compelete a nodestatus",
+	})
+
+	HandleV2APICallAuthorityReport = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_authorityreport_ns",
+		Help: "Time it takes to compelete an authorityreport",
+	})
+
+	HandleV2APICallCoinbasePreview = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_coinbasepreview_ns",
+		Help: "Time it takes to compelete a coinbasepreview",
+	})
+
+	HandleV2APICallValidateDBState = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_validatedbstate_ns",
+		Help: "Time it takes to compelete a validatedbstate",
+	})
+
+	HandleV2APICallDBlockHeaderByHeight = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_dblockheaderbyheight_ns",
+		Help: "Time it takes to compelete a dblockheaderbyheight",
+	})
+
+	HandleV2APICallECRateAtHeight = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_ecrateatheight_ns",
+		Help: "Time it takes to compelete an ecrateatheight",
+	})
+
+	HandleV2APICallPromoteStandby = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_promotestandby_ns",
+		Help: "Time it takes to compelete a promotestandby",
+	})
+
+	HandleV2APICallPollResults = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_pollresults_ns",
+		Help: "Time it takes to compelete a pollresults",
+	})
+
+	HandleV2APICallChainStats = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_chainstats_ns",
+		Help: "Time it takes to compelete a chainstats",
+	})
+
+	HandleV2APICallBlockTimeline = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_blocktimeline_ns",
+		Help: "Time it takes to compelete a blocktimeline",
+	})
+
+	HandleV2APICallProcessListLog = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_processlistlog_ns",
+		Help: "Time it takes to compelete a processlistlog",
+	})
+
+	HandleV2APICallBurnedCredits = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_burnedcredits_ns",
+		Help: "Time it takes to compelete a burnedcredits",
+	})
+
+	HandleV2APICallNetworkStatus = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_networkstatus_ns",
+		Help: "Time it takes to compelete a networkstatus",
+	})
+
+	HandleV2APICallSignNetworkStatus = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_signnetworkstatus_ns",
+		Help: "Time it takes to compelete a signnetworkstatus",
+	})
+
+	HandleV2APICallUpgradeStatus = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_upgradestatus_ns",
+		Help: "Time it takes to compelete a upgradestatus",
+	})
+
+	HandleV2APICallNodeHealth = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_nodehealth_ns",
+		Help: "Time it takes to compelete a nodehealth",
+	})
+
+	HandleV2APICallSendProbe = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_sendprobe_ns",
+		Help: "Time it takes to compelete a sendprobe",
+	})
+
+	HandleV2APICallProbeResults = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_proberesults_ns",
+		Help: "Time it takes to compelete a proberesults",
+	})
+
+	HandleV2APICallTokenIndexers = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_tokenindexers_ns",
+		Help: "Time it takes to compelete a tokenindexers",
+	})
+
+	HandleV2APICallSweepTransactions = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_sweeptransactions_ns",
+		Help: "Time it takes to compelete a sweeptransactions",
+	})
+
+	HandleV2APICallDeriveAddresses = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_deriveaddresses_ns",
+		Help: "Time it takes to compelete a deriveaddresses",
+	})
+
+	HandleV2APICallDeriveXPub = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_derivexpub_ns",
+		Help: "Time it takes to compelete a derivexpub",
+	})
+
+	HandleV2APICallSignChallenge = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_signchallenge_ns",
+		Help: "Time it takes to compelete a signchallenge",
+	})
+
+	HandleV2APICallVerifyAddressSignature = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_verifyaddresssignature_ns",
+		Help: "Time it takes to compelete a verifyaddresssignature",
+	})
+
+	HandleV2APICallEntryExists = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_entryexists_ns",
+		Help: "Time it takes to compelete an entryexists",
+	})
+
+	HandleV2APICallTokenIndex = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_tokenindex_ns",
+		Help: "Time it takes to compelete a call to a token indexer",
+	})
+
+	HandleV2APICacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_wsapi_v2_api_cache_hits",
+		Help: "Number of calls answered from the response cache",
+	})
+
+	HandleV2APICacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_wsapi_v2_api_cache_misses",
+		Help: "Number of cacheable calls not found in the response cache",
+	})
+
+	HandleV2APICacheInvalidations = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_wsapi_v2_api_cache_invalidations",
+		Help: "Number of times the response cache was dropped due to a new block",
+	})
 )
 
 var registered = false
@@ -170,6 +324,7 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(HandleV2APICallChainHead)
 	prometheus.MustRegister(HandleV2APICallCommitChain)
 	prometheus.MustRegister(HandleV2APICallCommitEntry)
+	prometheus.MustRegister(HandleV2APICallCommitEntries)
 	prometheus.MustRegister(HandleV2APICallDBlock)
 	prometheus.MustRegister(HandleV2APICallDBlockHead)
 	prometheus.MustRegister(HandleV2APICallEblock)
@@ -196,4 +351,34 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(HandleV2APICallABlockByHeight)
 	prometheus.MustRegister(HandleV2APICallAuthorities)
 	prometheus.MustRegister(HandleV2APICallTpsRate)
+	prometheus.MustRegister(HandleV2APICallEntriesByTime)
+	prometheus.MustRegister(HandleV2APICallNodeStatus)
+	prometheus.MustRegister(HandleV2APICallAuthorityReport)
+	prometheus.MustRegister(HandleV2APICallCoinbasePreview)
+	prometheus.MustRegister(HandleV2APICallValidateDBState)
+	prometheus.MustRegister(HandleV2APICallDBlockHeaderByHeight)
+	prometheus.MustRegister(HandleV2APICallECRateAtHeight)
+	prometheus.MustRegister(HandleV2APICallPromoteStandby)
+	prometheus.MustRegister(HandleV2APICallPollResults)
+	prometheus.MustRegister(HandleV2APICallChainStats)
+	prometheus.MustRegister(HandleV2APICallBlockTimeline)
+	prometheus.MustRegister(HandleV2APICallProcessListLog)
+	prometheus.MustRegister(HandleV2APICallBurnedCredits)
+	prometheus.MustRegister(HandleV2APICallNetworkStatus)
+	prometheus.MustRegister(HandleV2APICallSignNetworkStatus)
+	prometheus.MustRegister(HandleV2APICallUpgradeStatus)
+	prometheus.MustRegister(HandleV2APICallNodeHealth)
+	prometheus.MustRegister(HandleV2APICallSendProbe)
+	prometheus.MustRegister(HandleV2APICallProbeResults)
+	prometheus.MustRegister(HandleV2APICallTokenIndexers)
+	prometheus.MustRegister(HandleV2APICallSweepTransactions)
+	prometheus.MustRegister(HandleV2APICallDeriveAddresses)
+	prometheus.MustRegister(HandleV2APICallDeriveXPub)
+	prometheus.MustRegister(HandleV2APICallSignChallenge)
+	prometheus.MustRegister(HandleV2APICallVerifyAddressSignature)
+	prometheus.MustRegister(HandleV2APICallEntryExists)
+	prometheus.MustRegister(HandleV2APICallTokenIndex)
+	prometheus.MustRegister(HandleV2APICacheHits)
+	prometheus.MustRegister(HandleV2APICacheMisses)
+	prometheus.MustRegister(HandleV2APICacheInvalidations)
 }
@@ -0,0 +1,137 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// "sign-challenge" and "verify-address-signature" prove control of an FA or EC address, as
+// exchanges and governance tools ask for.  The holder of the address signs a message in the
+// format of common/factoid/signedMessage.go, and anyone can check the signature with
+// "verify-address-signature".
+//
+// So that an old signature can't be replayed, the message can be a challenge got from
+// "sign-challenge": it names the address, when it was issued, and a nonce the node made from
+// those with a key only it holds, so it can tell the challenge is its own, and still fresh,
+// without remembering it.  The key is made anew each time the node starts, so challenges don't
+// outlive it.
+
+// How long a challenge can be answered
+const ChallengeLifetime = time.Hour
+
+var challengeKey = func() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+func challengeNonce(address string, issued int64) string {
+	mac := hmac.New(sha256.New, challengeKey)
+	mac.Write([]byte(fmt.Sprintf("%s %d", address, issued)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// challengeText is the challenge for an address issued at a time, in seconds.
+func challengeText(address string, issued int64, message string) string {
+	text := fmt.Sprintf("Address: %s\nIssued: %d\nNonce: %s", address, issued, challengeNonce(address, issued))
+	if message != "" {
+		text += "\n" + message
+	}
+	return text
+}
+
+// checkChallenge returns an error unless the text is a challenge this node issued for the address
+// that hasn't expired.
+func checkChallenge(address string, text string, now time.Time) error {
+	lines := strings.SplitN(text, "\n", 4)
+	if len(lines) < 3 || lines[0] != "Address: "+address || !strings.HasPrefix(lines[1], "Issued: ") {
+		return fmt.Errorf("Not a challenge for %s", address)
+	}
+	issued, err := strconv.ParseInt(strings.TrimPrefix(lines[1], "Issued: "), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Not a challenge for %s", address)
+	}
+	if !hmac.Equal([]byte(lines[2]), []byte("Nonce: "+challengeNonce(address, issued))) {
+		return fmt.Errorf("Not a challenge issued by this node")
+	}
+	if age := now.Sub(time.Unix(issued, 0)); age > ChallengeLifetime || age < 0 {
+		return fmt.Errorf("The challenge has expired")
+	}
+	return nil
+}
+
+func HandleV2SignChallenge(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallSignChallenge.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(SignChallengeRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if !primitives.ValidateFUserStr(req.Address) && !primitives.ValidateECUserStr(req.Address) {
+		return nil, NewInvalidAddressError()
+	}
+
+	issued := time.Now()
+	resp := new(SignChallengeResponse)
+	resp.Challenge = challengeText(req.Address, issued.Unix(), req.Message)
+	resp.Data = hex.EncodeToString(factoid.SignedMessageData(resp.Challenge))
+	resp.Expires = issued.Add(ChallengeLifetime).Unix()
+	return resp, nil
+}
+
+func HandleV2VerifyAddressSignature(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallVerifyAddressSignature.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(VerifyAddressSignatureRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if !primitives.ValidateFUserStr(req.Address) && !primitives.ValidateECUserStr(req.Address) {
+		return nil, NewInvalidAddressError()
+	}
+	var publicKey []byte
+	if req.PublicKey != "" {
+		publicKey, err = hex.DecodeString(req.PublicKey)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError("Bad public key")
+		}
+	}
+	signature, err := hex.DecodeString(req.Signature)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError("Bad signature")
+	}
+
+	resp := new(VerifyAddressSignatureResponse)
+	if err := factoid.VerifyAddressSignature(req.Address, publicKey, req.Message, signature); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	if req.Challenge {
+		if err := checkChallenge(req.Address, req.Message, time.Now()); err != nil {
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+	}
+	resp.Valid = true
+	return resp, nil
+}
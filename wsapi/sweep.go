@@ -0,0 +1,274 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// "sweep-transactions" composes the transactions that move the balances of many factoid addresses
+// into one, for operators cleaning up old funding keys.  Entry credits can't be moved, so only
+// factoid addresses can be swept, though an EC address can be the destination, turning them into
+// credits.  This node never sees a private key, so a sweep takes two calls:
+//
+//	1. Given the public keys and the destination, it returns the transactions, each with the data
+//	   its inputs' keys have to sign, and the timestamp they were made with.
+//	2. Given the same request with that timestamp and the signatures, it composes the same
+//	   transactions and returns them signed, ready for "factoid-submit".
+//
+// A transaction takes as many inputs as fit in the size limit, or the batch size asked for, and
+// pays the fee at the higher of the current and the next exchange rate out of what it sweeps.
+// Addresses holding too little to pay for their own signature are left out, as are the inputs of a
+// transaction that can't pay its fee.  Should a balance
+// change between the calls, its transaction comes out different, and its signatures won't check.
+
+// Most inputs a sweep takes
+const MaxSweepInputs = 1000
+
+type sweepInput struct {
+	key       string
+	rcd       interfaces.IRCD
+	balance   uint64
+	signature []byte
+}
+
+func HandleV2SweepTransactions(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallSweepTransactions.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(SweepTransactionsRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if len(req.Inputs) == 0 || len(req.Inputs) > MaxSweepInputs || req.BatchSize < 0 {
+		return nil, NewInvalidParamsError()
+	}
+
+	var toEC bool
+	switch {
+	case primitives.ValidateFUserStr(req.Destination):
+	case primitives.ValidateECUserStr(req.Destination):
+		toEC = true
+	default:
+		return nil, NewInvalidAddressError()
+	}
+	destination := factoid.NewAddress(primitives.ConvertUserStrToAddress(req.Destination))
+
+	rate := state.GetFactoshisPerEC()
+	if next := state.GetPredictiveFER(); next > rate {
+		rate = next
+	}
+	if rate == 0 {
+		return nil, NewCustomInternalError("No exchange rate yet")
+	}
+
+	resp := new(SweepTransactionsResponse)
+	resp.Rate = rate
+	resp.Timestamp = req.Timestamp
+	resp.Skipped = []string{}
+	resp.Transactions = []SweepTransaction{}
+
+	var inputs []*sweepInput
+	seen := make(map[string]bool)
+	for _, in := range req.Inputs {
+		key, err := hex.DecodeString(in.PublicKey)
+		if err != nil || len(key) != constants.ADDRESS_LENGTH || seen[in.PublicKey] {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Bad or repeated public key %q", in.PublicKey))
+		}
+		seen[in.PublicKey] = true
+		input := &sweepInput{key: in.PublicKey, rcd: factoid.NewRCD_1(key)}
+		if in.Signature != "" {
+			input.signature, err = hex.DecodeString(in.Signature)
+			if err != nil || len(input.signature) != constants.SIGNATURE_LENGTH {
+				return nil, NewCustomInvalidParamsError(fmt.Sprintf("Bad signature for %s", in.PublicKey))
+			}
+			if req.Timestamp == 0 {
+				return nil, NewCustomInvalidParamsError("Signatures need the timestamp their transactions were made with")
+			}
+		}
+
+		adr, _ := input.rcd.GetAddress()
+		balance := state.GetFactoidState().GetFactoidBalance(adr.Fixed())
+		// A signature costs an EC, and its input may take the transaction over another KiB
+		if balance <= int64(2*rate) {
+			resp.Skipped = append(resp.Skipped, in.PublicKey)
+			continue
+		}
+		input.balance = uint64(balance)
+		inputs = append(inputs, input)
+	}
+	if len(inputs) == 0 {
+		return resp, nil
+	}
+
+	if resp.Timestamp == 0 {
+		resp.Timestamp = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	batches, err := composeSweep(inputs, destination, toEC, rate, uint64(resp.Timestamp), req.BatchSize)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	for _, b := range batches {
+		if b.tx == nil {
+			for _, input := range b.inputs {
+				resp.Skipped = append(resp.Skipped, input.key)
+			}
+			continue
+		}
+		st, err := b.response(rate, toEC)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(err.Error())
+		}
+		resp.Total += st.Amount + st.Fee
+		resp.Fees += st.Fee
+		resp.Transactions = append(resp.Transactions, *st)
+	}
+	return resp, nil
+}
+
+type sweepBatch struct {
+	inputs []*sweepInput
+	tx     *factoid.Transaction
+	fee    uint64
+}
+
+// composeSweep splits the inputs into the transactions of a sweep to the destination.
+func composeSweep(inputs []*sweepInput, destination interfaces.IAddress, toEC bool, rate uint64, timestamp uint64, batchSize int) ([]*sweepBatch, error) {
+	var batches []*sweepBatch
+	var batch *sweepBatch
+	for _, input := range inputs {
+		if batch != nil && (batchSize == 0 || len(batch.inputs) < batchSize) {
+			candidate := append(append([]*sweepInput(nil), batch.inputs...), input)
+			next, err := sweepTransaction(candidate, destination, toEC, rate, timestamp)
+			if err != nil {
+				return nil, err
+			}
+			if next != nil {
+				batch = next
+				batches[len(batches)-1] = batch
+				continue
+			}
+		}
+		var err error
+		batch, err = sweepTransaction([]*sweepInput{input}, destination, toEC, rate, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return nil, fmt.Errorf("A transaction from %s is too large", input.key)
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+// sweepTransaction builds the transaction sweeping the inputs to the destination, less its fee.
+// It returns nil if the transaction would be too large, and a batch without one if the inputs
+// don't cover the fee.
+func sweepTransaction(inputs []*sweepInput, destination interfaces.IAddress, toEC bool, rate uint64, timestamp uint64) (*sweepBatch, error) {
+	var total uint64
+	for _, input := range inputs {
+		total += input.balance
+	}
+
+	build := func(amount uint64) *factoid.Transaction {
+		tx := new(factoid.Transaction)
+		tx.MilliTimestamp = timestamp
+		for i, input := range inputs {
+			adr, _ := input.rcd.GetAddress()
+			tx.AddInput(adr, input.balance)
+			tx.AddRCD(input.rcd)
+			// Inputs not yet signed get an empty signature, so the size, and fee, are the same
+			sig := new(factoid.FactoidSignature)
+			if input.signature != nil {
+				sig.SetSignature(input.signature)
+			}
+			sb := new(factoid.SignatureBlock)
+			sb.AddSignature(sig)
+			tx.SetSignatureBlock(i, sb)
+		}
+		if toEC {
+			tx.AddECOutput(destination, amount)
+		} else {
+			tx.AddOutput(destination, amount)
+		}
+		return tx
+	}
+
+	// Sized with the whole total paid out, the fee can only be more than enough for the amount
+	// that is, which is no longer
+	tx := build(total)
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > constants.MAX_TRANSACTION_SIZE || len(inputs) > 255 {
+		return nil, nil
+	}
+	fee, err := tx.CalculateFee(rate)
+	if err != nil {
+		return nil, err
+	}
+	if fee >= total {
+		return &sweepBatch{inputs: inputs}, nil
+	}
+	amount := total - fee
+	if toEC {
+		// Only whole credits are bought, so what would buy part of one is left to the fee
+		amount -= amount % rate
+	}
+	return &sweepBatch{inputs: inputs, tx: build(amount), fee: total - amount}, nil
+}
+
+// response describes the batch, checking any signatures given, and includes the transaction
+// itself once they all are.
+func (b *sweepBatch) response(rate uint64, toEC bool) (*SweepTransaction, error) {
+	st := new(SweepTransaction)
+	st.Fee = b.fee
+	if toEC {
+		st.Amount = b.tx.OutECs[0].GetAmount()
+		st.Credits = st.Amount / rate
+	} else {
+		st.Amount = b.tx.Outputs[0].GetAmount()
+	}
+	data, err := b.tx.MarshalBinarySig()
+	if err != nil {
+		return nil, err
+	}
+	st.SigData = hex.EncodeToString(data)
+	st.TxID = b.tx.GetSigHash().String()
+
+	signed := 0
+	for i, input := range b.inputs {
+		st.Inputs = append(st.Inputs, input.key)
+		if input.signature == nil {
+			continue
+		}
+		if !input.rcd.CheckSig(b.tx, b.tx.GetSignatureBlock(i)) {
+			return nil, fmt.Errorf("The signature for %s does not sign transaction %s", input.key, st.TxID)
+		}
+		signed++
+	}
+	if signed == len(b.inputs) {
+		if err := b.tx.Validate(1); err != nil {
+			return nil, err
+		}
+		data, err := b.tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		st.Transaction = hex.EncodeToString(data)
+	}
+	return st, nil
+}
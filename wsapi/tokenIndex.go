@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/tokenIndex"
+)
+
+// The token indexers (see tokenIndex/) answer the v2 methods "<namespace>.<method>", and
+// "token-indexers" says how far each has got.
+
+type TokenIndexersResponse struct {
+	Height   int64               `json:"height"` // The last block whose entries are all saved
+	Indexers []tokenIndex.Status `json:"indexers"`
+}
+
+func HandleV2TokenIndexers(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallTokenIndexers.Observe(float64(time.Since(n).Nanoseconds()))
+
+	resp := new(TokenIndexersResponse)
+	resp.Height = int64(state.GetEntryDBHeightComplete())
+	resp.Indexers = tokenIndex.Statuses()
+	return resp, nil
+}
+
+// HandleV2TokenIndex passes a method the node doesn't have to the token indexer of its namespace.
+func HandleV2TokenIndex(state interfaces.IState, method string, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallTokenIndex.Observe(float64(time.Since(n).Nanoseconds()))
+
+	var raw json.RawMessage
+	if params != nil {
+		var err error
+		raw, err = json.Marshal(params)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+
+	resp, err := tokenIndex.Call(method, raw)
+	if err != nil {
+		if _, ok := err.(tokenIndex.InvalidParams); ok {
+			return nil, NewCustomInvalidParamsError(err.Error())
+		}
+		if err == tokenIndex.ErrMethodNotFound {
+			return nil, NewMethodNotFoundError()
+		}
+		return nil, NewCustomInternalError(err.Error())
+	}
+	return resp, nil
+}
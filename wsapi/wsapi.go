@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
@@ -59,11 +60,15 @@ func Start(state interfaces.IState) {
 		Servers[state.GetPort()] = server
 		server.Env["state"] = state
 
-		server.Post("/v1/factoid-submit/?", HandleFactoidSubmit)
-		server.Post("/v1/commit-chain/?", HandleCommitChain)
-		server.Post("/v1/reveal-chain/?", HandleRevealChain)
-		server.Post("/v1/commit-entry/?", HandleCommitEntry)
-		server.Post("/v1/reveal-entry/?", HandleRevealEntry)
+		if state.IsExplorerMode() {
+			startExplorer()
+		} else {
+			server.Post("/v1/factoid-submit/?", HandleFactoidSubmit)
+			server.Post("/v1/commit-chain/?", HandleCommitChain)
+			server.Post("/v1/reveal-chain/?", HandleRevealChain)
+			server.Post("/v1/commit-entry/?", HandleCommitEntry)
+			server.Post("/v1/reveal-entry/?", HandleRevealEntry)
+		}
 		server.Get("/v1/directory-block-head/?", HandleDirectoryBlockHead)
 		server.Get("/v1/get-raw-data/([^/]+)", HandleGetRaw)
 		server.Get("/v1/get-receipt/([^/]+)", HandleGetReceipt)
@@ -85,9 +90,12 @@ func Start(state interfaces.IState) {
 
 		server.Post("/v2", HandleV2)
 		server.Get("/v2", HandleV2)
+		server.Get("/v2/events", HandleEvents)
+		server.Get("/v2/export/([^/]+)", HandleExport)
+		server.Get("/1.0/identifiers/([^/]+)", HandleDIDResolve)
 
-		// start the debugging api if we are not on the main network
-		if state.GetNetworkName() != "MAIN" {
+		// start the debugging api if we are not on the main network, or a public explorer
+		if state.GetNetworkName() != "MAIN" && !state.IsExplorerMode() {
 			server.Post("/debug", HandleDebug)
 			server.Get("/debug", HandleDebug)
 		}
@@ -109,11 +117,11 @@ func Start(state interfaces.IState) {
 				Certificates: []tls.Certificate{keypair},
 				MinVersion:   tls.VersionTLS12,
 			}
-			go server.RunTLS(fmt.Sprintf(":%d", state.GetPort()), tlsConfig)
+			state.Supervise("api", func() { server.RunTLS(fmt.Sprintf(":%d", state.GetPort()), tlsConfig) })
 
 		} else {
 			log.Print("Starting API server")
-			go server.Run(fmt.Sprintf(":%d", state.GetPort()))
+			state.Supervise("api", func() { server.Run(fmt.Sprintf(":%d", state.GetPort())) })
 		}
 	}
 }
@@ -136,6 +144,7 @@ func Stop(state interfaces.IState) {
 	defer ServersMutex.Unlock()
 
 	Servers[state.GetPort()].Close()
+	dropResponseCache(state.GetPort())
 }
 
 func handleV1Error(ctx *web.Context, err *primitives.JSONError) {
@@ -152,6 +161,10 @@ func handleV1Error(ctx *web.Context, err *primitives.JSONError) {
 		returnMsg(ctx,"", false)
 		return
 	*/
+	// An explorer's errors are never cached, see setCacheControl
+	if state, ok := ctx.Server.Env["state"].(interfaces.IState); ok && state.IsExplorerMode() {
+		ctx.ResponseWriter.Header().Set("Cache-Control", "no-store")
+	}
 	ctx.WriteHeader(httpBad)
 	return
 }
@@ -174,6 +187,8 @@ func HandleDBlockByHeight(ctx *web.Context, height string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	h, err := strconv.ParseInt(height, 0, 64)
 	if err != nil {
 		handleV1Error(ctx, NewInvalidParamsError())
@@ -183,6 +198,9 @@ func HandleDBlockByHeight(ctx *web.Context, height string) {
 	req := primitives.NewJSON2Request("dblock-by-height", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -196,6 +214,8 @@ func HandleECBlockByHeight(ctx *web.Context, height string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	h, err := strconv.ParseInt(height, 0, 64)
 	if err != nil {
 		handleV1Error(ctx, NewInvalidParamsError())
@@ -205,6 +225,9 @@ func HandleECBlockByHeight(ctx *web.Context, height string) {
 	req := primitives.NewJSON2Request("ecblock-by-height", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -218,6 +241,8 @@ func HandleFBlockByHeight(ctx *web.Context, height string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	h, err := strconv.ParseInt(height, 0, 64)
 	if err != nil {
 		handleV1Error(ctx, NewInvalidParamsError())
@@ -227,6 +252,9 @@ func HandleFBlockByHeight(ctx *web.Context, height string) {
 	req := primitives.NewJSON2Request("fblock-by-height", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -240,6 +268,8 @@ func HandleABlockByHeight(ctx *web.Context, height string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	h, err := strconv.ParseInt(height, 0, 64)
 	if err != nil {
 		handleV1Error(ctx, NewInvalidParamsError())
@@ -249,6 +279,9 @@ func HandleABlockByHeight(ctx *web.Context, height string) {
 	req := primitives.NewJSON2Request("ablock-by-height", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -277,7 +310,7 @@ func HandleCommitChain(ctx *web.Context) {
 	}
 	param := MessageRequest{Message: c.CommitChainMsg}
 	req := primitives.NewJSON2Request("commit-chain", 1, param)
-	_, jsonError := HandleV2Request(state, req)
+	_, jsonError := handleV1Submission(ctx, state, req)
 
 	if jsonError != nil {
 		returnV1(ctx, nil, jsonError)
@@ -324,7 +357,7 @@ func HandleCommitEntry(ctx *web.Context) {
 	param := MessageRequest{Message: c.CommitEntryMsg}
 	req := primitives.NewJSON2Request("commit-entry", 1, param)
 
-	_, jsonError := HandleV2Request(state, req)
+	_, jsonError := handleV1Submission(ctx, state, req)
 	if jsonError != nil {
 		returnV1(ctx, nil, jsonError)
 		return
@@ -364,7 +397,7 @@ func HandleRevealEntry(ctx *web.Context) {
 	param := EntryRequest{Entry: e.Entry}
 	req := primitives.NewJSON2Request("reveal-entry", 1, param)
 
-	_, jsonError := HandleV2Request(state, req)
+	_, jsonError := handleV1Submission(ctx, state, req)
 	if jsonError != nil {
 		returnV1(ctx, nil, jsonError)
 		return
@@ -384,6 +417,8 @@ func HandleDirectoryBlockHead(ctx *web.Context) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	req := primitives.NewJSON2Request("directory-block-head", 1, nil)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
@@ -391,6 +426,9 @@ func HandleDirectoryBlockHead(ctx *web.Context) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	tmp, err := json.Marshal(jsonResp.Result)
 	resp := string(tmp)
 	if err != nil {
@@ -417,6 +455,9 @@ func HandleGetRaw(ctx *web.Context, hashkey string) {
 	req := primitives.NewJSON2Request("raw-data", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkImmutableNotModified(ctx, state, "raw", hashkey) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -430,10 +471,15 @@ func HandleGetReceipt(ctx *web.Context, hashkey string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	param := HashRequest{Hash: hashkey}
 	req := primitives.NewJSON2Request("receipt", 1, param)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
+	if jsonError == nil && checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnV1(ctx, jsonResp, jsonError)
 }
 
@@ -454,6 +500,9 @@ func HandleDirectoryBlock(ctx *web.Context, hashkey string) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkImmutableNotModified(ctx, state, "dblock", hashkey) {
+		return
+	}
 
 	type DBlock struct {
 		Header struct {
@@ -492,6 +541,8 @@ func HandleDirectoryBlockHeight(ctx *web.Context) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	req := primitives.NewJSON2Request("heights", 1, nil)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
@@ -499,6 +550,9 @@ func HandleDirectoryBlockHeight(ctx *web.Context) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 
 	resp := "{\"Height\":0}"
 
@@ -535,6 +589,9 @@ func HandleEntryBlock(ctx *web.Context, hashkey string) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkImmutableNotModified(ctx, state, "eblock", hashkey) {
+		return
+	}
 
 	type EBlock struct {
 		Header struct {
@@ -576,6 +633,9 @@ func HandleEntry(ctx *web.Context, hashkey string) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkImmutableNotModified(ctx, state, "entry", hashkey) {
+		return
+	}
 	d := new(EntryStruct)
 
 	d.ChainID = jsonResp.Result.(*EntryResponse).ChainID
@@ -595,6 +655,8 @@ func HandleChainHead(ctx *web.Context, chainid string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	param := ChainIDRequest{ChainID: chainid}
 	req := primitives.NewJSON2Request("chain-head", 1, param)
 
@@ -604,6 +666,9 @@ func HandleChainHead(ctx *web.Context, chainid string) {
 		return
 
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	// restatement of chead from structs file
 	// v1 doesn't like the lcase in v2'
 	type CHead struct {
@@ -630,6 +695,8 @@ func HandleEntryCreditBalance(ctx *web.Context, address string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	param := AddressRequest{Address: address}
 	req := primitives.NewJSON2Request("entry-credit-balance", 1, param)
 
@@ -638,6 +705,9 @@ func HandleEntryCreditBalance(ctx *web.Context, address string) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 
 	t := new(x)
 	t.Response = fmt.Sprint(jsonResp.Result.(*EntryCreditBalanceResponse).Balance)
@@ -655,6 +725,8 @@ func HandleGetFee(ctx *web.Context) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	req := primitives.NewJSON2Request("entry-credit-rate", 1, nil)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
@@ -662,6 +734,9 @@ func HandleGetFee(ctx *web.Context) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	type x struct{ Fee int64 }
 	d := new(x)
 
@@ -703,7 +778,7 @@ func HandleFactoidSubmit(ctx *web.Context) {
 	param := TransactionRequest{Transaction: t.Transaction}
 	req := primitives.NewJSON2Request("factoid-submit", 1, param)
 
-	jsonResp, jsonError := HandleV2Request(state, req)
+	jsonResp, jsonError := handleV1Submission(ctx, state, req)
 	if jsonError != nil {
 		returnV1(ctx, nil, jsonError)
 		return
@@ -729,6 +804,8 @@ func HandleFactoidBalance(ctx *web.Context, address string) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	param := AddressRequest{Address: address}
 	req := primitives.NewJSON2Request("factoid-balance", 1, param)
 
@@ -737,6 +814,9 @@ func HandleFactoidBalance(ctx *web.Context, address string) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 
 	r := new(x)
 	r.Response = fmt.Sprint(jsonResp.Result.(*FactoidBalanceResponse).Balance)
@@ -755,6 +835,8 @@ func HandleProperties(ctx *web.Context) {
 		return
 	}
 
+	etag := requestTipETag(ctx, state)
+
 	req := primitives.NewJSON2Request("properties", 1, nil)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
@@ -762,6 +844,9 @@ func HandleProperties(ctx *web.Context) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	type x struct {
 		Protocol_Version string
 		Factomd_Version  string
@@ -777,6 +862,10 @@ func HandleHeights(ctx *web.Context) {
 	defer ServersMutex.Unlock()
 
 	state := ctx.Server.Env["state"].(interfaces.IState)
+
+	setSecurityHeaders(ctx, state)
+	etag := requestTipETag(ctx, state)
+
 	req := primitives.NewJSON2Request("heights", 1, nil)
 
 	jsonResp, jsonError := HandleV2Request(state, req)
@@ -784,6 +873,9 @@ func HandleHeights(ctx *web.Context) {
 		returnV1(ctx, nil, jsonError)
 		return
 	}
+	if checkTipNotModified(ctx, state, etag) {
+		return
+	}
 	returnMsg(ctx, jsonResp.Result, true)
 }
 
@@ -870,7 +962,35 @@ func checkAuthHeader(state interfaces.IState, r *http.Request) error {
 	return nil
 }
 
+// handleV1Submission handles a v1 call that submits a message, as the operator's if the request
+// is from them.
+func handleV1Submission(ctx *web.Context, state interfaces.IState, j *primitives.JSON2Request) (*primitives.JSON2Response, *primitives.JSONError) {
+	return handleV2Request(state, j, log.NewCorrelationID("api"), isPriorityRequest(state, ctx.Request))
+}
+
+// isPriorityRequest returns true if the request is from the node's operator, so the messages it
+// submits get the operator's share of the InMsgQueue: it comes from this machine, or carries the
+// priority API key in an X-Priority-Key header.  Nothing is priority if the operator has no share.
+func isPriorityRequest(state interfaces.IState, r *http.Request) bool {
+	if state.GetPriorityLaneShare() <= 0 {
+		return false
+	}
+	if key := state.GetPriorityAPIKey(); key != "" {
+		presented := r.Header.Get("X-Priority-Key")
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(key)) == 1 {
+			return true
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func checkHttpPasswordOkV1(state interfaces.IState, ctx *web.Context) bool {
+	setSecurityHeaders(ctx, state)
 	if err := checkAuthHeader(state, ctx.Request); err != nil {
 		remoteIP := ""
 		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
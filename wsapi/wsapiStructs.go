@@ -5,6 +5,7 @@
 package wsapi
 
 import (
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/receipts"
@@ -28,6 +29,12 @@ type CommitEntryResponse struct {
 	TxID    string `json:"txid"`
 }
 
+type CommitEntriesResponse struct {
+	Message string   `json:"message"`
+	Hash    string   `json:"hash"`
+	TxIDs   []string `json:"txids"`
+}
+
 type RevealEntryResponse struct {
 	Message   string `json:"message"`
 	EntryHash string `json:"entryhash"`
@@ -59,6 +66,18 @@ type HeightsResponse struct {
 	MissingEntryCount            int64 `json:"missingentrycount"`
 	EntryBlockDBHeightProcessing int64 `json:"entryblockdbheightprocessing"`
 	EntryBlockDBHeightComplete   int64 `json:"entryblockdbheightcomplete"`
+	HeaderHeight                 int64 `json:"headerheight"`
+}
+
+type NodeStatusResponse struct {
+	NodeName             string `json:"nodename"`
+	IdentityChainID      string `json:"identitychainid"`
+	Role                 string `json:"role"`
+	DirectoryBlockHeight int64  `json:"directoryblockheight"`
+	CompleteHeight       int64  `json:"completeheight"`
+	LeaderHeight         int64  `json:"leaderheight"`
+	KnownHeight          int64  `json:"knownheight"`
+	Syncing              bool   `json:"syncing"`
 }
 
 type RawDataResponse struct {
@@ -104,6 +123,128 @@ type EntryCreditRateResponse struct {
 	Rate int64 `json:"rate"`
 }
 
+type ChainStatsResponse struct {
+	FromHeight  uint32                  `json:"fromheight"`
+	ToHeight    uint32                  `json:"toheight"`
+	BlocksKept  int                     `json:"blockskept"`  // Blocks in the range whose statistics the node still has
+	TotalChains int                     `json:"totalchains"` // Chains before the limit was applied
+	Chains      []interfaces.ChainStats `json:"chains"`      // Most entry credits spent first
+}
+
+type BlockTimelineResponse struct {
+	FromHeight uint32                     `json:"fromheight"`
+	ToHeight   uint32                     `json:"toheight"`
+	Timelines  []interfaces.BlockTimeline `json:"timelines"` // Of the blocks in the range whose timelines are kept
+}
+
+type ProcessListLogResponse struct {
+	FromHeight uint32                      `json:"fromheight"`
+	ToHeight   uint32                      `json:"toheight"`
+	Logs       []interfaces.ProcessListLog `json:"logs"` // Of the blocks in the range whose logs are kept
+}
+
+type BurnedCreditsResponse struct {
+	TotalKeys    int                        `json:"totalkeys"`    // Keys before the limit was applied
+	TotalCredits int64                      `json:"totalcredits"` // Credits they burned
+	Keys         []interfaces.BurnedCredits `json:"keys"`         // Most credits burned first
+}
+
+type NodeHealthResponse struct {
+	Nodes []interfaces.NodeHealth `json:"nodes"` // By identity chain ID
+}
+
+type SendProbeResponse struct {
+	Number uint32 `json:"number"` // To find the probe in the probe-results
+}
+
+type ProbeResultsResponse struct {
+	Probes []interfaces.ProbeResult `json:"probes"` // Oldest first
+}
+
+type SweepTransaction struct {
+	Inputs      []string `json:"inputs"`  // Public keys, in the order of the transaction's inputs
+	Amount      uint64   `json:"amount"`  // Factoshis paid to the destination
+	Credits     uint64   `json:"credits"` // Credits they buy, when the destination is an EC address
+	Fee         uint64   `json:"fee"`     // Factoshis
+	TxID        string   `json:"txid"`
+	SigData     string   `json:"sigdata"`     // What each input's key signs, in hex
+	Transaction string   `json:"transaction"` // For factoid-submit, once every input is signed
+}
+
+type SweepTransactionsResponse struct {
+	Timestamp    int64              `json:"timestamp"` // Of the transactions, in milliseconds; give it back with the signatures
+	Rate         uint64             `json:"rate"`      // Factoshis per EC the fees were paid at
+	Total        uint64             `json:"total"`     // Factoshis swept, fees included
+	Fees         uint64             `json:"fees"`
+	Skipped      []string           `json:"skipped"` // Public keys whose balances can't pay their fees
+	Transactions []SweepTransaction `json:"transactions"`
+}
+
+type DerivedAddress struct {
+	Index     uint32 `json:"index"` // In the chain
+	PublicKey string `json:"publickey"`
+	Address   string `json:"address"`
+	Balance   int64  `json:"balance"` // Factoshis, or credits for an EC address
+}
+
+type DeriveAddressesResponse struct {
+	Chain     uint32           `json:"chain"`
+	Addresses []DerivedAddress `json:"addresses"`
+}
+
+type DeriveXPubResponse struct {
+	XPub      string `json:"xpub"`
+	PublicKey string `json:"publickey"`
+}
+
+type SignChallengeResponse struct {
+	Challenge string `json:"challenge"` // The message to sign
+	Data      string `json:"data"`      // What the address's key signs, in hex
+	Expires   int64  `json:"expires"`   // In seconds since the epoch
+}
+
+type VerifyAddressSignatureResponse struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"` // Why not, if not
+}
+
+type EntryExists struct {
+	Hash   string `json:"hash"`
+	Exists bool   `json:"exists"`
+}
+
+type EntryExistsResponse struct {
+	Entries []EntryExists                `json:"entries"`         // In the order asked
+	Bloom   *interfaces.EntryBloomStatus `json:"bloom,omitempty"` // Nil if the node keeps no entry bloom
+}
+
+type NetworkStatusResponse struct {
+	Status    *specialEntries.NetworkStatus `json:"status"`
+	Content   string                        `json:"content"` // The signed bytes, in hex
+	Signers   []string                      `json:"signers"` // Identity chain IDs of the federated servers that signed
+	EntryHash string                        `json:"entryhash"`
+	DBHeight  uint32                        `json:"dbheight"` // Block the status was published in
+}
+
+type SignNetworkStatusResponse struct {
+	Content         string `json:"content"` // The signed bytes, in hex; publish exactly these
+	IdentityChainID string `json:"identitychainid"`
+	Signature       string `json:"signature"`
+}
+
+type PromoteStandbyResponse struct {
+	Message string `json:"message"`
+	Height  uint32 `json:"height"` // The first block we sign as the standby identity
+}
+
+type ECRateAtHeightResponse struct {
+	Height         int64  `json:"height"`
+	Rate           uint64 `json:"rate"`
+	Since          uint32 `json:"since"`          // Height the rate took effect at
+	FactoshisToECs uint64 `json:"factoshistoecs"` // ECs the requested factoshis buy
+	ECsToFactoshis uint64 `json:"ecstofactoshis"` // Factoshis the requested ECs cost
+}
+
 type PropertiesResponse struct {
 	FactomdVersion string `json:"factomdversion"`
 	ApiVersion     string `json:"factomdapiversion"`
@@ -198,6 +339,13 @@ type TransactionResponse struct {
 	IncludedInDirectoryBlockHeight int64 `json:"includedindirectoryblockheight"`
 }
 
+type DBlockHeaderResponse struct {
+	Header  *JStruct `json:"header"`
+	KeyMR   string   `json:"keymr"`
+	Saved   bool     `json:"saved"` // False if we only have the header so far
+	RawData string   `json:"rawdata"`
+}
+
 type BlockHeightResponse struct {
 	DBlock  *JStruct `json:"dblock,omitempty"`
 	ABlock  *JStruct `json:"ablock,omitempty"`
@@ -208,6 +356,17 @@ type BlockHeightResponse struct {
 
 //Requests
 
+type EntriesByTimeRequest struct {
+	ChainID string `json:"chainid"`
+	Start   int64  `json:"start"`
+	End     int64  `json:"end"`
+}
+
+type AuthorityReportRequest struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
 type AddressRequest struct {
 	Address string `json:"address"`
 }
@@ -216,6 +375,90 @@ type HeightRequest struct {
 	Height int64 `json:"height"`
 }
 
+type PromoteStandbyRequest struct {
+	Force bool `json:"force"` // Promote even if the primary was heard from recently
+}
+
+type SendProbeRequest struct {
+	Target string `json:"target"` // Identity chain ID of the authority to probe
+}
+
+type ECRateAtHeightRequest struct {
+	Height    int64  `json:"height"`
+	Factoshis uint64 `json:"factoshis"` // Optional amount to convert to ECs
+	ECs       uint64 `json:"ecs"`       // Optional amount to convert to factoshis
+}
+
+type PollResultsRequest struct {
+	ChainID string `json:"chainid"`
+	Height  int64  `json:"height"` // Tally as of this height; the highest saved if 0
+}
+
+type ChainStatsRequest struct {
+	Height int64 `json:"height"` // Last block to count; the highest saved if 0
+	Blocks int64 `json:"blocks"` // Number of blocks to count, ending at height; 1 if 0
+	Limit  int   `json:"limit"`  // Most chains to return; 100 if 0
+}
+
+type BlockTimelineRequest struct {
+	Height int64 `json:"height"` // Last block; the highest saved if 0
+	Blocks int64 `json:"blocks"` // Number of blocks, ending at height; 1 if 0
+}
+
+type ProcessListLogRequest struct {
+	Height int64  `json:"height"` // Last block; the highest saved if 0
+	Blocks int64  `json:"blocks"` // Number of blocks, ending at height; 1 if 0
+	Hash   string `json:"hash"`   // Only the messages with this message hash, entry hash or transaction ID, if given
+}
+
+type BurnedCreditsRequest struct {
+	Address string `json:"address"` // EC address or public key to report on; every key if empty
+	Limit   int    `json:"limit"`   // Most keys to return; 100 if 0
+}
+
+type SweepInput struct {
+	PublicKey string `json:"publickey"` // Of an RCD-1 factoid address, in hex
+	Signature string `json:"signature"` // Of its transaction's sigdata, in hex; empty until signed
+}
+
+type SweepTransactionsRequest struct {
+	Inputs      []SweepInput `json:"inputs"`
+	Destination string       `json:"destination"` // FA or EC address
+	Timestamp   int64        `json:"timestamp"`   // Of the transactions, in milliseconds; now if 0, but needed with signatures
+	BatchSize   int          `json:"batchsize"`   // Most inputs a transaction; as many as fit if 0
+}
+
+type DeriveAddressesRequest struct {
+	XPub  string `json:"xpub"`  // Extended public key, in hex, usually of an account
+	Chain uint32 `json:"chain"` // 0 for receiving addresses, 1 for change
+	Start uint32 `json:"start"` // Index of the first address
+	Count int    `json:"count"` // Number of addresses; 20 if 0
+	EC    bool   `json:"ec"`    // Entry credit addresses rather than factoid ones
+}
+
+type DeriveXPubRequest struct {
+	XPub string `json:"xpub"` // Extended public key, in hex
+	Path string `json:"path"` // Relative to it, without hardened steps, like 0/7
+}
+
+type SignChallengeRequest struct {
+	Address string `json:"address"` // FA or EC address
+	Message string `json:"message"` // Added to the end of the challenge, if given
+}
+
+type VerifyAddressSignatureRequest struct {
+	Address   string `json:"address"`   // FA or EC address
+	PublicKey string `json:"publickey"` // In hex; needed for an FA address
+	Message   string `json:"message"`
+	Signature string `json:"signature"` // In hex
+	Challenge bool   `json:"challenge"` // Whether the message has to be a live challenge from this node
+}
+
+type EntryExistsRequest struct {
+	Hash   string   `json:"hash,omitempty"`   // An entry hash
+	Hashes []string `json:"hashes,omitempty"` // Or many, up to MaxEntryExistsHashes in all
+}
+
 type ChainIDRequest struct {
 	ChainID string `json:"chainid"`
 }
@@ -240,6 +483,10 @@ type MessageRequest struct {
 	Message string `json:"message"`
 }
 
+type CommitEntriesRequest struct {
+	Messages []string `json:"messages"`
+}
+
 type PendingEntry struct {
 	EntryHash interfaces.IHash `json:"entryhash"`
 	ChainID   interfaces.IHash `json:"chainid"`
@@ -258,3 +505,59 @@ type TransactionRequest struct {
 type SendRawMessageRequest struct {
 	Message string `json:"message"`
 }
+
+type EntryAddrWithHeight struct {
+	EntryHash string `json:"entryhash"`
+	Timestamp int64  `json:"timestamp"`
+	DBHeight  int64  `json:"dbheight"`
+}
+
+type EntriesByTimeResponse struct {
+	ChainID string                `json:"chainid"`
+	Start   int64                 `json:"start"`
+	End     int64                 `json:"end"`
+	Entries []EntryAddrWithHeight `json:"entries"`
+}
+
+type AuthorityReportIdentity struct {
+	IdentityChainID string `json:"identitychainid"`
+	BlocksAsFed     int64  `json:"blocksasfed"`
+	BlocksSigned    int64  `json:"blockssigned"`
+	BlocksMissed    int64  `json:"blocksmissed"`
+}
+
+type AuthorityReportPayout struct {
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+}
+
+type AuthorityReportResponse struct {
+	Start      int64                     `json:"start"`
+	End        int64                     `json:"end"`
+	Identities []AuthorityReportIdentity `json:"identities"`
+	Coinbase   []AuthorityReportPayout   `json:"coinbase"`
+}
+
+type CoinbasePreviewResponse struct {
+	Height    int64                   `json:"height"`
+	Timestamp int64                   `json:"timestamp"`
+	Outputs   []AuthorityReportPayout `json:"outputs"`
+	Total     int64                   `json:"total"`
+}
+
+type ValidateDBStateRequest struct {
+	DBState string `json:"dbstate"`
+}
+
+type ValidateDBStateRule struct {
+	Rule   string `json:"rule"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type ValidateDBStateResponse struct {
+	Height int64                 `json:"height"`
+	KeyMR  string                `json:"keymr"`
+	Valid  bool                  `json:"valid"`
+	Rules  []ValidateDBStateRule `json:"rules"`
+}
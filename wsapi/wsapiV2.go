@@ -11,17 +11,23 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/blockView"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
 	"github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/log"
 	"github.com/FactomProject/factomd/receipts"
+	"github.com/FactomProject/factomd/voting"
 	"github.com/FactomProject/web"
 )
 
@@ -34,6 +40,7 @@ func HandleV2(ctx *web.Context) {
 	state := ctx.Server.Env["state"].(interfaces.IState)
 	ServersMutex.Unlock()
 
+	setSecurityHeaders(ctx, state)
 	if err := checkAuthHeader(state, ctx.Request); err != nil {
 		remoteIP := ""
 		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
@@ -56,7 +63,9 @@ func HandleV2(ctx *web.Context) {
 		return
 	}
 
-	jsonResp, jsonError := HandleV2Request(state, j)
+	cid := log.NewCorrelationID("api")
+	ctx.ResponseWriter.Header().Set("X-Correlation-ID", cid)
+	jsonResp, jsonError := handleV2Request(state, j, cid, isPriorityRequest(state, ctx.Request))
 
 	if jsonError != nil {
 		HandleV2Error(ctx, j, jsonError)
@@ -67,9 +76,65 @@ func HandleV2(ctx *web.Context) {
 }
 
 func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*primitives.JSON2Response, *primitives.JSONError) {
+	return handleV2Request(state, j, log.NewCorrelationID("api"), false)
+}
+
+// A State for the handlers of one request, so the messages they send the State carry the
+// request's correlation ID, and are marked priority if the request is from the node's operator.
+type correlatedState struct {
+	interfaces.IState
+	correlationID string
+	priority      bool
+}
+
+// queueAPIMessage hands a message built by an API call to the State, tagged with the
+// correlation ID of the call and whether it came from the operator.  A State that is shutting
+// down takes no new messages.
+func queueAPIMessage(state interfaces.IState, msg interfaces.IMsg) *primitives.JSONError {
+	if state.IsShuttingDown() {
+		return NewShuttingDownError()
+	}
+	if cs, ok := state.(*correlatedState); ok {
+		msg.SetCorrelationID(cs.correlationID)
+		msg.SetPriority(cs.priority)
+	} else {
+		msg.SetCorrelationID(log.NewCorrelationID("api"))
+	}
+	msg.SetTraceID(msg.GetCorrelationID())
+	if rpcLog != nil {
+		rpcLog.Debugf("[%s] queued %s", msg.GetCorrelationID(), msg.String())
+	}
+	state.APIQueue() <- msg
+	return nil
+}
+
+func handleV2Request(state interfaces.IState, j *primitives.JSON2Request, cid string, priority bool) (*primitives.JSON2Response, *primitives.JSONError) {
 	var resp interface{}
 	var jsonError *primitives.JSONError
 	params := j.Params
+
+	if rpcLog != nil {
+		rpcLog.Debugf("[%s] %s", cid, j.Method)
+	}
+
+	if WriteMethods[j.Method] && state.IsExplorerMode() {
+		return nil, NewReadOnlyError()
+	}
+
+	cache := GetResponseCache(state)
+	tip := state.GetHighestSavedBlk()
+	key, cacheable := responseCacheKey(j.Method, params, tip)
+	if cacheable {
+		if cached, ok := cache.Get(tip, key); ok {
+			jsonResp := primitives.NewJSON2Response()
+			jsonResp.ID = j.ID
+			jsonResp.Result = cached
+			return jsonResp, nil
+		}
+	}
+
+	state = &correlatedState{state, cid, priority}
+
 	switch j.Method {
 	case "chain-head":
 		resp, jsonError = HandleV2ChainHead(state, params)
@@ -80,6 +145,9 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "commit-entry":
 		resp, jsonError = HandleV2CommitEntry(state, params)
 		break
+	case "commit-entries":
+		resp, jsonError = HandleV2CommitEntries(state, params)
+		break
 	case "directory-block":
 		resp, jsonError = HandleV2DirectoryBlock(state, params)
 		break
@@ -156,13 +224,71 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 		resp, jsonError = HandleAuthorities(state, params)
 	case "tps-rate":
 		resp, jsonError = HandleV2TransactionRate(state, params)
+	case "entries-by-time":
+		resp, jsonError = HandleV2EntriesByTime(state, params)
+	case "node-status":
+		resp, jsonError = HandleV2NodeStatus(state, params)
+	case "authority-report":
+		resp, jsonError = HandleV2AuthorityReport(state, params)
+	case "coinbase-preview":
+		resp, jsonError = HandleV2CoinbasePreview(state, params)
+	case "validate-dbstate":
+		resp, jsonError = HandleV2ValidateDBState(state, params)
+	case "dblock-header-by-height":
+		resp, jsonError = HandleV2DBlockHeaderByHeight(state, params)
+	case "ec-rate-at-height":
+		resp, jsonError = HandleV2ECRateAtHeight(state, params)
+	case "promote-standby":
+		resp, jsonError = HandleV2PromoteStandby(state, params)
+	case "poll-results":
+		resp, jsonError = HandleV2PollResults(state, params)
+	case "chain-stats":
+		resp, jsonError = HandleV2ChainStats(state, params)
+	case "block-timeline":
+		resp, jsonError = HandleV2BlockTimeline(state, params)
+	case "burned-credits":
+		resp, jsonError = HandleV2BurnedCredits(state, params)
+	case "network-status":
+		resp, jsonError = HandleV2NetworkStatus(state, params)
+	case "sign-network-status":
+		resp, jsonError = HandleV2SignNetworkStatus(state, params)
+	case "upgrade-status":
+		resp, jsonError = HandleV2UpgradeStatus(state, params)
+	case "node-health":
+		resp, jsonError = HandleV2NodeHealth(state, params)
+	case "send-probe":
+		resp, jsonError = HandleV2SendProbe(state, params)
+	case "probe-results":
+		resp, jsonError = HandleV2ProbeResults(state, params)
+	case "token-indexers":
+		resp, jsonError = HandleV2TokenIndexers(state, params)
+	case "sweep-transactions":
+		resp, jsonError = HandleV2SweepTransactions(state, params)
+	case "process-list-log":
+		resp, jsonError = HandleV2ProcessListLog(state, params)
+	case "derive-addresses":
+		resp, jsonError = HandleV2DeriveAddresses(state, params)
+	case "derive-xpub":
+		resp, jsonError = HandleV2DeriveXPub(state, params)
+	case "sign-challenge":
+		resp, jsonError = HandleV2SignChallenge(state, params)
+	case "verify-address-signature":
+		resp, jsonError = HandleV2VerifyAddressSignature(state, params)
+	case "entry-exists":
+		resp, jsonError = HandleV2EntryExists(state, params)
 	default:
-		jsonError = NewMethodNotFoundError()
+		resp, jsonError = HandleV2TokenIndex(state, j.Method, params)
 		break
 	}
 	if jsonError != nil {
+		if rpcLog != nil {
+			rpcLog.Debugf("[%s] %s failed: %v", cid, j.Method, jsonError.Message)
+		}
 		return nil, jsonError
 	}
+	if cacheable {
+		cache.Put(tip, key, resp)
+	}
 
 	jsonResp := primitives.NewJSON2Response()
 	jsonResp.ID = j.ID
@@ -207,6 +333,40 @@ func HandleV2DBlockByHeight(state interfaces.IState, params interface{}) (interf
 	return resp, nil
 }
 
+// HandleV2DBlockHeaderByHeight returns the header of a directory block, which with header sync on
+// we may have before the block itself
+func HandleV2DBlockHeaderByHeight(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallDBlockHeaderByHeight.Observe(float64(time.Since(n).Nanoseconds()))
+
+	heightRequest := new(HeightRequest)
+	err := MapToObject(params, heightRequest)
+	if err != nil || heightRequest.Height < 0 {
+		return nil, NewInvalidParamsError()
+	}
+
+	header, keyMR := state.GetDBlockHeaderByHeight(uint32(heightRequest.Height))
+	if header == nil {
+		return nil, NewBlockNotFoundError()
+	}
+
+	raw, err := header.MarshalBinary()
+	if err != nil {
+		return nil, NewInternalError()
+	}
+
+	resp := new(DBlockHeaderResponse)
+	resp.Header, err = ObjectToJStruct(header)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	resp.KeyMR = keyMR.String()
+	resp.Saved = uint32(heightRequest.Height) <= state.GetHighestSavedBlk()
+	resp.RawData = hex.EncodeToString(raw)
+
+	return resp, nil
+}
+
 func HandleV2ECBlockByHeight(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallECBlockByHeight.Observe(float64(time.Since(n).Nanoseconds()))
@@ -388,7 +548,9 @@ func HandleV2CommitChain(state interfaces.IState, params interface{}) (interface
 
 	msg := new(messages.CommitChainMsg)
 	msg.CommitChain = commit
-	state.APIQueue() <- msg
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
 	state.IncECCommits()
 
 	resp := new(CommitChainResponse)
@@ -428,7 +590,9 @@ func HandleV2CommitEntry(state interfaces.IState, params interface{}) (interface
 
 	msg := new(messages.CommitEntryMsg)
 	msg.CommitEntry = commit
-	state.APIQueue() <- msg
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
 	state.IncECommits()
 
 	resp := new(CommitEntryResponse)
@@ -438,6 +602,58 @@ func HandleV2CommitEntry(state interfaces.IState, params interface{}) (interface
 	return resp, nil
 }
 
+// HandleV2CommitEntries sends many entry commits in one BatchCommitEntryMsg, so that they take
+// one ack between them.
+func HandleV2CommitEntries(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallCommitEntries.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(CommitEntriesRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if !state.IsActive(constants.ACTIVATION_BATCH_COMMIT, state.GetLeaderHeight()) {
+		return nil, NewCustomInvalidParamsError("Entry commits can't be sent in batches on this network yet")
+	}
+	if len(req.Messages) == 0 || len(req.Messages) > messages.MaxBatchCommits {
+		return nil, NewCustomInvalidParamsError(fmt.Sprintf("From 1 to %d commits can be sent at once", messages.MaxBatchCommits))
+	}
+
+	msg := messages.NewBatchCommitEntryMsg()
+	entries := make(map[[32]byte]bool)
+	for _, m := range req.Messages {
+		commit := entryCreditBlock.NewCommitEntry()
+		p, err := hex.DecodeString(m)
+		if err != nil {
+			return nil, NewInvalidCommitEntryError()
+		}
+		if _, err := commit.UnmarshalBinaryData(p); err != nil {
+			return nil, NewInvalidCommitEntryError()
+		}
+		if !commit.IsValid() || entries[commit.EntryHash.Fixed()] {
+			return nil, NewInvalidCommitEntryError()
+		}
+		entries[commit.EntryHash.Fixed()] = true
+		msg.Commits = append(msg.Commits, commit)
+	}
+
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
+	for range msg.Commits {
+		state.IncECommits()
+	}
+
+	resp := new(CommitEntriesResponse)
+	resp.Message = "Entry Commits Success"
+	resp.Hash = msg.GetMsgHash().String()
+	for _, commit := range msg.Commits {
+		resp.TxIDs = append(resp.TxIDs, commit.GetSigHash().String())
+	}
+	return resp, nil
+}
+
 func HandleV2RevealEntry(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallRevealEntry.Observe(float64(time.Since(n).Nanoseconds()))
@@ -465,7 +681,9 @@ func HandleV2RevealEntry(state interfaces.IState, params interface{}) (interface
 	msg := new(messages.RevealEntryMsg)
 	msg.Entry = entry
 	msg.Timestamp = state.GetTimestamp()
-	state.APIQueue() <- msg
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
 
 	resp := new(RevealEntryResponse)
 	resp.Message = "Entry Reveal Success"
@@ -594,15 +812,13 @@ func HandleV2DirectoryBlock(state interfaces.IState, params interface{}) (interf
 		return nil, NewBlockNotFoundError()
 	}
 
+	view := blockView.NewDBlock(block)
 	d := new(DirectoryBlockResponse)
-	d.Header.PrevBlockKeyMR = block.GetHeader().GetPrevKeyMR().String()
-	d.Header.SequenceNumber = int64(block.GetHeader().GetDBHeight())
-	d.Header.Timestamp = block.GetHeader().GetTimestamp().GetTimeSeconds()
-	for _, v := range block.GetDBEntries() {
-		l := new(EBlockAddr)
-		l.ChainID = v.GetChainID().String()
-		l.KeyMR = v.GetKeyMR().String()
-		d.EntryBlockList = append(d.EntryBlockList, *l)
+	d.Header.PrevBlockKeyMR = view.Header.PrevKeyMR
+	d.Header.SequenceNumber = int64(view.Header.DBHeight)
+	d.Header.Timestamp = view.Header.Timestamp
+	for _, v := range view.DBEntries {
+		d.EntryBlockList = append(d.EntryBlockList, EBlockAddr{v.ChainID, v.KeyMR})
 	}
 
 	return d, nil
@@ -641,39 +857,29 @@ func HandleV2EntryBlock(state interfaces.IState, params interface{}) (interface{
 		}
 	}
 
-	e.Header.BlockSequenceNumber = int64(block.GetHeader().GetEBSequence())
-	e.Header.ChainID = block.GetHeader().GetChainID().String()
-	e.Header.PrevKeyMR = block.GetHeader().GetPrevKeyMR().String()
-	e.Header.DBHeight = int64(block.GetHeader().GetDBHeight())
+	view := blockView.NewEBlock(block)
+	e.Header.BlockSequenceNumber = int64(view.Header.EBSequence)
+	e.Header.ChainID = view.Header.ChainID
+	e.Header.PrevKeyMR = view.Header.PrevKeyMR
+	e.Header.DBHeight = int64(view.Header.DBHeight)
 
-	if dblock, err := dbase.FetchDBlockByHeight(block.GetHeader().GetDBHeight()); err == nil {
+	if dblock, err := dbase.FetchDBlockByHeight(view.Header.DBHeight); err == nil && dblock != nil {
 		e.Header.Timestamp = dblock.GetHeader().GetTimestamp().GetTimeSeconds()
 	}
 
-	// create a map of possible minute markers that may be found in the
-	// EBlock Body
-	mins := make(map[string]uint8)
-	for i := byte(1); i <= 10; i++ {
-		h := make([]byte, 32)
-		h[len(h)-1] = i
-		mins[hex.EncodeToString(h)] = i
-	}
-
 	estack := make([]EntryAddr, 0)
-	for _, v := range block.GetBody().GetEBEntries() {
-		if n, exist := mins[v.String()]; exist {
+	for _, v := range view.Entries {
+		if v.Minute > 0 {
 			// the entry is a minute marker. add time to all of the
 			// previous entries for the minute
-			t := int64(e.Header.Timestamp + 60*int64(n))
+			t := int64(e.Header.Timestamp + 60*int64(v.Minute))
 			for _, w := range estack {
 				w.Timestamp = t
 				e.EntryList = append(e.EntryList, w)
 			}
 			estack = make([]EntryAddr, 0)
 		} else {
-			l := new(EntryAddr)
-			l.EntryHash = v.String()
-			estack = append(estack, *l)
+			estack = append(estack, EntryAddr{EntryHash: v.Hash})
 		}
 	}
 
@@ -813,6 +1019,417 @@ func HandleV2EntryCreditRate(state interfaces.IState, params interface{}) (inter
 	return resp, nil
 }
 
+// HandleV2ECRateAtHeight returns the EC exchange rate of the factoid block at a height, the
+// height that rate took effect at, and the given amounts converted at that rate
+func HandleV2ECRateAtHeight(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallECRateAtHeight.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(ECRateAtHeightRequest)
+	err := MapToObject(params, req)
+	if err != nil || req.Height < 0 {
+		return nil, NewInvalidParamsError()
+	}
+	if uint32(req.Height) > state.GetHighestSavedBlk() {
+		return nil, NewBlockNotFoundError()
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	rate, since, found, err := dbase.FetchExchangeRateByHeight(uint32(req.Height))
+	if err != nil {
+		return nil, NewInternalDatabaseError()
+	}
+	if !found {
+		return nil, NewBlockNotFoundError()
+	}
+
+	resp := new(ECRateAtHeightResponse)
+	resp.Height = req.Height
+	resp.Rate = rate
+	resp.Since = since
+	resp.FactoshisToECs = factoid.FactoshisToECs(req.Factoshis, rate)
+	resp.ECsToFactoshis = factoid.ECsToFactoshis(req.ECs, rate)
+	return resp, nil
+}
+
+// HandleV2PollResults tallies the commit and reveal votes of a poll chain, as of a height or the
+// highest saved block.
+func HandleV2PollResults(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallPollResults.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(PollResultsRequest)
+	err := MapToObject(params, req)
+	if err != nil || req.Height < 0 {
+		return nil, NewInvalidParamsError()
+	}
+	h, err := primitives.HexToHash(req.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+	height := state.GetHighestSavedBlk()
+	if req.Height > 0 {
+		if uint32(req.Height) > height {
+			return nil, NewBlockNotFoundError()
+		}
+		height = uint32(req.Height)
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	poll, entries, err := voting.LoadPoll(dbase, h)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	return voting.Tally(poll, entries, height), nil
+}
+
+// Largest number of chains chain-stats returns
+const MaxChainStatsLimit = 1000
+
+// HandleV2ChainStats returns the entries, commits and entry credits spent by chain over the
+// last blocks saved, most entry credits first.
+func HandleV2ChainStats(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallChainStats.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(ChainStatsRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+	if req.Blocks < 0 || req.Height < 0 || req.Limit < 0 || req.Limit > MaxChainStatsLimit {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Blocks == 0 {
+		req.Blocks = 1
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+
+	to := state.GetHighestSavedBlk()
+	if req.Height > 0 {
+		if uint32(req.Height) > to {
+			return nil, NewBlockNotFoundError()
+		}
+		to = uint32(req.Height)
+	}
+	from := uint32(0)
+	if int64(to)+1 > req.Blocks {
+		from = to + 1 - uint32(req.Blocks)
+	}
+
+	chains, blocks := state.GetChainStats(from, to)
+	resp := new(ChainStatsResponse)
+	resp.FromHeight = from
+	resp.ToHeight = to
+	resp.BlocksKept = blocks
+	resp.TotalChains = len(chains)
+	if len(chains) > req.Limit {
+		chains = chains[:req.Limit]
+	}
+	resp.Chains = chains
+	return resp, nil
+}
+
+// Most blocks block-timeline returns
+const MaxBlockTimelineBlocks = 100
+
+// HandleV2BlockTimeline returns when blocks reached each step of their making, as this node saw
+// them, for the blocks it keeps timelines of.
+func HandleV2BlockTimeline(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallBlockTimeline.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(BlockTimelineRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+	if req.Blocks < 0 || req.Blocks > MaxBlockTimelineBlocks || req.Height < 0 {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Blocks == 0 {
+		req.Blocks = 1
+	}
+
+	// The block being built has a timeline too
+	to := state.GetHighestSavedBlk()
+	if req.Height > 0 {
+		if uint32(req.Height) > state.GetLLeaderHeight() {
+			return nil, NewBlockNotFoundError()
+		}
+		to = uint32(req.Height)
+	}
+	from := uint32(0)
+	if int64(to)+1 > req.Blocks {
+		from = to + 1 - uint32(req.Blocks)
+	}
+
+	resp := new(BlockTimelineResponse)
+	resp.FromHeight = from
+	resp.ToHeight = to
+	resp.Timelines = state.GetBlockTimelines(from, to)
+	return resp, nil
+}
+
+// Most blocks process-list-log returns
+const MaxProcessListLogBlocks = 100
+
+// HandleV2ProcessListLog returns the messages the VMs of saved blocks acked, and when this node
+// processed them, for the blocks it keeps process list logs of.
+func HandleV2ProcessListLog(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallProcessListLog.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(ProcessListLogRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+	if req.Blocks < 0 || req.Blocks > MaxProcessListLogBlocks || req.Height < 0 {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Blocks == 0 {
+		req.Blocks = 1
+	}
+	if req.Hash != "" {
+		h, err := primitives.HexToHash(req.Hash)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		req.Hash = h.String()
+	}
+
+	to := state.GetHighestSavedBlk()
+	if req.Height > 0 {
+		if uint32(req.Height) > to {
+			return nil, NewBlockNotFoundError()
+		}
+		to = uint32(req.Height)
+	}
+	from := uint32(0)
+	if int64(to)+1 > req.Blocks {
+		from = to + 1 - uint32(req.Blocks)
+	}
+
+	resp := new(ProcessListLogResponse)
+	resp.FromHeight = from
+	resp.ToHeight = to
+	resp.Logs = state.GetProcessListLogs(from, to, req.Hash)
+	return resp, nil
+}
+
+// Largest number of keys burned-credits returns
+const MaxBurnedCreditsLimit = 1000
+
+// HandleV2BurnedCredits returns the entry credits burned by commits whose reveals never came, by
+// the key that paid them, most credits first; or just those of the key asked about, given as an
+// EC address or a public key in hex.
+func HandleV2BurnedCredits(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallBurnedCredits.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(BurnedCreditsRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+	if req.Limit < 0 || req.Limit > MaxBurnedCreditsLimit {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+
+	var key interfaces.IHash
+	if req.Address != "" {
+		var adr []byte
+		if primitives.ValidateECUserStr(req.Address) {
+			adr = primitives.ConvertUserStrToAddress(req.Address)
+		} else {
+			var err error
+			adr, err = hex.DecodeString(req.Address)
+			if err != nil {
+				return nil, NewInvalidAddressError()
+			}
+		}
+		if len(adr) != constants.HASH_LENGTH {
+			return nil, NewInvalidAddressError()
+		}
+		key = primitives.NewHash(adr)
+	}
+
+	keys := state.GetBurnedCredits(key)
+	resp := new(BurnedCreditsResponse)
+	resp.TotalKeys = len(keys)
+	for _, b := range keys {
+		resp.TotalCredits += b.Credits
+	}
+	if len(keys) > req.Limit {
+		keys = keys[:req.Limit]
+	}
+	resp.Keys = keys
+	return resp, nil
+}
+
+// HandleV2PromoteStandby has a hot standby take over the identity it stands by for, at the next
+// block.  Only a node with an RPC user and password set will do it.
+func HandleV2PromoteStandby(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallPromoteStandby.Observe(float64(time.Since(n).Nanoseconds()))
+
+	if state.GetRpcUser() == "" {
+		return nil, NewStandbyError("promote-standby needs FactomdRpcUser and FactomdRpcPass to be set")
+	}
+
+	req := new(PromoteStandbyRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+
+	height, err := state.PromoteStandby(req.Force)
+	if err != nil {
+		return nil, NewStandbyError(err.Error())
+	}
+
+	resp := new(PromoteStandbyResponse)
+	resp.Message = "Promotion scheduled"
+	resp.Height = height
+	return resp, nil
+}
+
+// HandleV2NetworkStatus returns the latest network status signed by most of the federated servers
+func HandleV2NetworkStatus(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallNetworkStatus.Observe(float64(time.Since(n).Nanoseconds()))
+
+	content, signers, entryHash, dbheight := state.GetNetworkStatus()
+	if content == nil {
+		return nil, NewObjectNotFoundError()
+	}
+	status := new(specialEntries.NetworkStatus)
+	if err := status.UnmarshalBinary(content); err != nil {
+		return nil, NewInternalError()
+	}
+
+	resp := new(NetworkStatusResponse)
+	resp.Status = status
+	resp.Content = hex.EncodeToString(content)
+	for _, signer := range signers {
+		resp.Signers = append(resp.Signers, signer.String())
+	}
+	resp.EntryHash = entryHash.String()
+	resp.DBHeight = dbheight
+	return resp, nil
+}
+
+// HandleV2UpgradeStatus returns how ready we and our peers are for the activations the network
+// status plans
+func HandleV2UpgradeStatus(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallUpgradeStatus.Observe(float64(time.Since(n).Nanoseconds()))
+
+	return state.GetUpgradeStatus(), nil
+}
+
+// HandleV2NodeHealth returns the health each audit server last reported in its heartbeat.
+func HandleV2NodeHealth(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallNodeHealth.Observe(float64(time.Since(n).Nanoseconds()))
+
+	resp := new(NodeHealthResponse)
+	resp.Nodes = state.GetNodeHealth()
+	return resp, nil
+}
+
+// HandleV2SendProbe sends a probe from this authority to another, to measure how long messages
+// take to gossip between them.  Only a node with an RPC user and password set will do it.
+func HandleV2SendProbe(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallSendProbe.Observe(float64(time.Since(n).Nanoseconds()))
+
+	if state.GetRpcUser() == "" {
+		return nil, NewCustomInternalError("send-probe needs FactomdRpcUser and FactomdRpcPass to be set")
+	}
+
+	req := new(SendProbeRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	target, err := primitives.HexToHash(req.Target)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+
+	number, err := state.SendProbe(target)
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+
+	resp := new(SendProbeResponse)
+	resp.Number = number
+	return resp, nil
+}
+
+// HandleV2ProbeResults returns what the last probes this node sent found.
+func HandleV2ProbeResults(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallProbeResults.Observe(float64(time.Since(n).Nanoseconds()))
+
+	resp := new(ProbeResultsResponse)
+	resp.Probes = state.GetProbeResults()
+	return resp, nil
+}
+
+// HandleV2SignNetworkStatus signs a network status with the block signing key of a federated
+// server.  Only a node with an RPC user and password set will do it.
+func HandleV2SignNetworkStatus(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallSignNetworkStatus.Observe(float64(time.Since(n).Nanoseconds()))
+
+	if state.GetRpcUser() == "" {
+		return nil, NewCustomInternalError("sign-network-status needs FactomdRpcUser and FactomdRpcPass to be set")
+	}
+
+	status := new(specialEntries.NetworkStatus)
+	err := MapToObject(params, status)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	content, err := status.MarshalBinary()
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	identity, sig, err := state.SignNetworkStatus(content)
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+
+	resp := new(SignNetworkStatusResponse)
+	resp.Content = hex.EncodeToString(content)
+	resp.IdentityChainID = identity.String()
+	resp.Signature = hex.EncodeToString(sig.GetSignature()[:])
+	return resp, nil
+}
+
 func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallFctTx.Observe(float64(time.Since(n).Nanoseconds()))
@@ -837,7 +1454,9 @@ func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interfa
 
 	state.IncFCTSubmits()
 
-	state.APIQueue() <- msg
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
 
 	resp := new(FactoidSubmitResponse)
 	resp.Message = "Successfully submitted the transaction"
@@ -892,6 +1511,38 @@ func HandleV2Heights(state interfaces.IState, params interface{}) (interface{},
 	h.MissingEntryCount = int64(state.GetMissingEntryCount())
 	h.EntryBlockDBHeightProcessing = int64(state.GetEntryBlockDBHeightProcessing())
 	h.EntryBlockDBHeightComplete = int64(state.GetEntryBlockDBHeightComplete())
+	h.HeaderHeight = int64(state.GetHighestHeader())
+
+	return h, nil
+}
+
+// HandleV2NodeStatus returns the identity, role and sync status of the node, so
+// a control panel can show it next to its siblings
+func HandleV2NodeStatus(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallNodeStatus.Observe(float64(time.Since(n).Nanoseconds()))
+
+	h := new(NodeStatusResponse)
+	h.NodeName = state.GetFactomNodeName()
+	h.IdentityChainID = state.GetIdentityChainID().String()
+	h.DirectoryBlockHeight = int64(state.GetHighestSavedBlk())
+	h.CompleteHeight = int64(state.GetHighestCompletedBlk())
+	h.LeaderHeight = int64(state.GetTrueLeaderHeight())
+	h.KnownHeight = int64(state.GetHighestKnownBlock())
+	h.Syncing = h.CompleteHeight+1 < h.KnownHeight
+
+	h.Role = "Follower"
+	leaderHeight := state.GetLeaderHeight()
+	for _, fed := range state.GetFedServers(leaderHeight) {
+		if fed.GetChainID().IsSameAs(state.GetIdentityChainID()) {
+			h.Role = "Federated"
+		}
+	}
+	for _, aud := range state.GetAuditServers(leaderHeight) {
+		if aud.GetChainID().IsSameAs(state.GetIdentityChainID()) {
+			h.Role = "Audit"
+		}
+	}
 
 	return h, nil
 }
@@ -963,7 +1614,9 @@ func HandleV2SendRawMessage(state interfaces.IState, params interface{}) (interf
 		return nil, NewInvalidParamsError()
 	}
 
-	state.APIQueue() <- msg
+	if err := queueAPIMessage(state, msg); err != nil {
+		return nil, err
+	}
 
 	resp := new(SendRawMessageResponse)
 	resp.Message = "Successfully sent the message"
@@ -1079,3 +1732,538 @@ func HandleV2TransactionRate(state interfaces.IState, params interface{}) (inter
 	r.InstantTransactionRate = instant
 	return r, nil
 }
+
+// Maximum number of directory blocks covered by a single entries-by-time call
+var EntriesByTimeMaxBlocks uint32 = 10000
+
+// HandleV2EntriesByTime returns every entry of a chain with a timestamp between
+// start and end (unix seconds, inclusive). The heights the range covers are
+// found by bisecting the directory block time index, then the chain is walked
+// back from its last entry block at or below the end of that range, to the
+// first below its start.
+func HandleV2EntriesByTime(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallEntriesByTime.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(EntriesByTimeRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if req.End < req.Start {
+		return nil, NewCustomInvalidParamsError("End must not be before start")
+	}
+	h, err := primitives.HexToHash(req.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	resp := new(EntriesByTimeResponse)
+	resp.ChainID = h.String()
+	resp.Start = req.Start
+	resp.End = req.End
+	resp.Entries = make([]EntryAddrWithHeight, 0)
+
+	head, err := dbase.FetchEBlockHead(h)
+	if err != nil {
+		return nil, NewInternalDatabaseError()
+	}
+	if head == nil {
+		return nil, NewMissingChainHeadError()
+	}
+
+	// Entries are stamped with the end of their minute, so a block started up
+	// to 10 minutes before the range can still hold entries inside it
+	startHeight, found, err := dbase.FetchDBlockHeightByTimestamp(req.Start - 600)
+	if err != nil {
+		return nil, NewInternalDatabaseError()
+	}
+	if !found {
+		return resp, nil
+	}
+
+	// The last block started at or before the end of the range
+	endHeight, found, err := dbase.FetchDBlockHeightByTimestamp(req.End + 1)
+	if err != nil {
+		return nil, NewInternalDatabaseError()
+	}
+	if !found {
+		dblk, err := dbase.FetchDBlockHead()
+		if err != nil || dblk == nil {
+			return nil, NewInternalDatabaseError()
+		}
+		endHeight = dblk.GetDatabaseHeight()
+	} else if endHeight == 0 {
+		return resp, nil
+	} else {
+		endHeight--
+	}
+	if endHeight < startHeight {
+		return resp, nil
+	}
+	if endHeight-startHeight >= EntriesByTimeMaxBlocks {
+		return nil, NewCustomInvalidParamsError("Time range covers too many blocks")
+	}
+
+	// Find the last entry block of the chain at or below the end of the range:
+	// the head, unless it is newer, in which case the directory blocks of the
+	// range are searched for it, newest first
+	block := head
+	if head.GetHeader().GetDBHeight() > endHeight {
+		block = nil
+		for height := int64(endHeight); block == nil && height >= int64(startHeight); height-- {
+			dblk, err := dbase.FetchDBlockByHeight(uint32(height))
+			if err != nil {
+				return nil, NewInternalDatabaseError()
+			}
+			if dblk == nil {
+				continue
+			}
+			for _, e := range dblk.GetDBEntries() {
+				if !e.GetChainID().IsSameAs(h) {
+					continue
+				}
+				block, err = dbase.FetchEBlock(e.GetKeyMR())
+				if err != nil {
+					return nil, NewInternalDatabaseError()
+				}
+				break
+			}
+		}
+	}
+
+	// Walk back from there, collecting the blocks in range newest first
+	blocks := make([]interfaces.IEntryBlock, 0)
+	zero := primitives.NewZeroHash()
+	for block != nil && block.GetHeader().GetDBHeight() >= startHeight {
+		blocks = append(blocks, block)
+		prev := block.GetHeader().GetPrevKeyMR()
+		if prev == nil || prev.IsSameAs(zero) {
+			break
+		}
+		block, err = dbase.FetchEBlock(prev)
+		if err != nil {
+			return nil, NewInternalDatabaseError()
+		}
+	}
+
+	for i := len(blocks) - 1; i >= 0; i-- {
+		height := blocks[i].GetHeader().GetDBHeight()
+		blockTime, ok, err := dbase.FetchDBlockTimestampByHeight(height)
+		if err != nil {
+			return nil, NewInternalDatabaseError()
+		}
+		if !ok {
+			continue
+		}
+		estack := make([]EntryAddrWithHeight, 0)
+		for _, v := range blocks[i].GetBody().GetEBEntries() {
+			if v.IsMinuteMarker() {
+				// Same timestamps as entry-block: the end of the entry's minute
+				m := v.Bytes()[31]
+				t := blockTime + 60*int64(m)
+				for _, w := range estack {
+					if t >= req.Start && t <= req.End {
+						w.Timestamp = t
+						resp.Entries = append(resp.Entries, w)
+					}
+				}
+				estack = make([]EntryAddrWithHeight, 0)
+			} else {
+				estack = append(estack, EntryAddrWithHeight{EntryHash: v.String(), DBHeight: int64(height)})
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// Maximum number of directory blocks covered by a single authority-report call
+var AuthorityReportMaxBlocks int64 = 10000
+
+// HandleV2AuthorityReport returns, for every identity that was a federated server
+// between start and end (heights, inclusive), the blocks it was federated for, and
+// how many of those it signed or missed. The federated set is rebuilt by walking
+// the admin blocks from genesis; a block is signed when the next admin block holds
+// the identity's DBSignature. Identities carry no coinbase address in this version,
+// so payouts are reported per address as found in the coinbase transactions.
+func HandleV2AuthorityReport(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallAuthorityReport.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(AuthorityReportRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if req.Start < 0 || req.End < req.Start {
+		return nil, NewCustomInvalidParamsError("End must not be before start")
+	}
+	if req.End-req.Start >= AuthorityReportMaxBlocks {
+		return nil, NewCustomInvalidParamsError("Height range covers too many blocks")
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	head, err := dbase.FetchDBlockHead()
+	if err != nil {
+		return nil, NewInternalDatabaseError()
+	}
+	if head == nil {
+		return nil, NewBlockNotFoundError()
+	}
+	top := int64(head.GetHeader().GetDBHeight())
+	if req.End > top {
+		req.End = top
+	}
+
+	resp := new(AuthorityReportResponse)
+	resp.Start = req.Start
+	resp.End = req.End
+	resp.Identities = make([]AuthorityReportIdentity, 0)
+	resp.Coinbase = make([]AuthorityReportPayout, 0)
+	if req.Start > req.End {
+		return resp, nil
+	}
+
+	feds := map[string]bool{}
+	report := map[string]*AuthorityReportIdentity{}
+	order := make([]string, 0)
+	payouts := map[string]int64{}
+	porder := make([]string, 0)
+
+	// The federated set for height h is the one in place once admin block h-1 has
+	// been processed, and its signatures for h are found in admin block h+1.
+	var infed []string
+	for h := int64(0); h <= req.End+1 && h <= top; h++ {
+		ablk, err := dbase.FetchABlockByHeight(uint32(h))
+		if err != nil {
+			return nil, NewInternalDatabaseError()
+		}
+		if ablk == nil {
+			continue
+		}
+
+		if h > req.Start && h-1 <= req.End {
+			signed := map[string]bool{}
+			for _, entry := range ablk.GetABEntries() {
+				if sig, ok := entry.(*adminBlock.DBSignatureEntry); ok {
+					signed[sig.IdentityAdminChainID.String()] = true
+				}
+			}
+			for _, id := range infed {
+				r := report[id]
+				r.BlocksAsFed++
+				if signed[id] {
+					r.BlocksSigned++
+				} else {
+					r.BlocksMissed++
+				}
+			}
+		}
+
+		if h >= req.Start && h <= req.End {
+			infed = infed[:0]
+			for id := range feds {
+				if report[id] == nil {
+					report[id] = &AuthorityReportIdentity{IdentityChainID: id}
+					order = append(order, id)
+				}
+				infed = append(infed, id)
+			}
+
+			fblk, err := dbase.FetchFBlockByHeight(uint32(h))
+			if err != nil {
+				return nil, NewInternalDatabaseError()
+			}
+			if fblk != nil && len(fblk.GetTransactions()) > 0 {
+				for _, out := range fblk.GetTransactions()[0].GetOutputs() {
+					adr := primitives.ConvertFctAddressToUserStr(out.GetAddress())
+					if _, ok := payouts[adr]; !ok {
+						porder = append(porder, adr)
+					}
+					payouts[adr] += int64(out.GetAmount())
+				}
+			}
+		}
+
+		for _, entry := range ablk.GetABEntries() {
+			switch e := entry.(type) {
+			case *adminBlock.AddFederatedServer:
+				feds[e.IdentityChainID.String()] = true
+			case *adminBlock.AddAuditServer:
+				delete(feds, e.IdentityChainID.String())
+			case *adminBlock.RemoveFederatedServer:
+				delete(feds, e.IdentityChainID.String())
+			}
+		}
+	}
+
+	sort.Strings(order)
+	for _, id := range order {
+		resp.Identities = append(resp.Identities, *report[id])
+	}
+	for _, adr := range porder {
+		resp.Coinbase = append(resp.Coinbase, AuthorityReportPayout{adr, payouts[adr]})
+	}
+	return resp, nil
+}
+
+// HandleV2CoinbasePreview returns the coinbase outputs the factoid block at a future
+// height will be built with, so they can be checked before the block is made. The
+// coinbase pays the configured coinbase addresses, as identities carry no coinbase
+// address or efficiency in this version. The timestamp is an estimate, assuming every
+// block from now on takes the full block time.
+func HandleV2CoinbasePreview(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallCoinbasePreview.Observe(float64(time.Since(n).Nanoseconds()))
+
+	heightRequest := new(HeightRequest)
+	err := MapToObject(params, heightRequest)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	saved := int64(state.GetHighestSavedBlk())
+	if heightRequest.Height <= saved {
+		return nil, NewCustomInvalidParamsError("Height must be above the highest saved block")
+	}
+
+	ms := state.GetTimestamp().GetTimeMilli()
+	ms += (heightRequest.Height - saved) * int64(state.GetDirectoryBlockInSeconds()) * 1000
+	coinbase := factoid.GetCoinbase(primitives.NewTimestampFromMilliseconds(uint64(ms)))
+
+	resp := new(CoinbasePreviewResponse)
+	resp.Height = heightRequest.Height
+	resp.Timestamp = ms / 1000
+	resp.Outputs = make([]AuthorityReportPayout, 0)
+	for _, out := range coinbase.GetOutputs() {
+		adr := primitives.ConvertFctAddressToUserStr(out.GetAddress())
+		resp.Outputs = append(resp.Outputs, AuthorityReportPayout{adr, int64(out.GetAmount())})
+		resp.Total += int64(out.GetAmount())
+	}
+	return resp, nil
+}
+
+// HandleV2ValidateDBState takes a DBState built outside the network, such as one made by
+// recovery tooling, and checks it against this node without applying it. Every rule is
+// reported on its own, so a drill can see everything that is wrong with a block at once.
+// Rules that depend on our state (links to the previous blocks, balances) can only be
+// checked for the block after our highest saved block.
+func HandleV2ValidateDBState(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallValidateDBState.Observe(float64(time.Since(n).Nanoseconds()))
+
+	r := new(ValidateDBStateRequest)
+	err := MapToObject(params, r)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	data, err := hex.DecodeString(r.DBState)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	msg, err := messages.UnmarshalMessage(data)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError("Could not unmarshal the DBState: " + err.Error())
+	}
+	dbstate, ok := msg.(*messages.DBStateMsg)
+	if !ok {
+		return nil, NewCustomInvalidParamsError("Message is not a DBState")
+	}
+
+	resp := new(ValidateDBStateResponse)
+	resp.Valid = true
+	resp.Rules = make([]ValidateDBStateRule, 0)
+	check := func(rule string, passed bool, detail string) {
+		resp.Rules = append(resp.Rules, ValidateDBStateRule{rule, passed, detail})
+		if !passed {
+			resp.Valid = false
+		}
+	}
+
+	if dbstate.DirectoryBlock == nil || dbstate.AdminBlock == nil || dbstate.FactoidBlock == nil || dbstate.EntryCreditBlock == nil {
+		check("blocks", false, "DBState does not have a directory, admin, factoid and entry credit block")
+		return resp, nil
+	}
+	check("blocks", true, "")
+
+	dbheight := dbstate.DirectoryBlock.GetHeader().GetDBHeight()
+	resp.Height = int64(dbheight)
+	resp.KeyMR = dbstate.DirectoryBlock.GetKeyMR().String()
+	saved := state.GetHighestSavedBlk()
+	next := dbheight == saved+1
+
+	check("network-id", state.GetNetworkID() == dbstate.DirectoryBlock.GetHeader().GetNetworkID(),
+		fmt.Sprintf("expected %x, found %x", state.GetNetworkID(), dbstate.DirectoryBlock.GetHeader().GetNetworkID()))
+
+	if next {
+		check("height", true, "")
+	} else {
+		check("height", false, fmt.Sprintf("block is at %d, the next block to be saved is %d", dbheight, saved+1))
+	}
+
+	if key := constants.CheckPoints[dbheight]; key != "" && state.GetNetworkID() == constants.MAIN_NETWORK_ID {
+		check("checkpoint", key == dbstate.DirectoryBlock.DatabasePrimaryIndex().String(), "expected "+key)
+	}
+
+	ts, reason := state.ValidateBlockTimestamp(dbstate.DirectoryBlock)
+	check("timestamp", ts == 1, reason)
+
+	check("heights-match", dbstate.AdminBlock.GetHeader().GetDBHeight() == dbheight &&
+		dbstate.FactoidBlock.GetDBHeight() == dbheight &&
+		dbstate.EntryCreditBlock.GetHeader().GetDBHeight() == dbheight,
+		"admin, factoid and entry credit blocks must be at the directory block height")
+
+	check("contents", dbstate.ValidateData(state) == 1,
+		"blocks and entries must be the ones listed in the directory block")
+
+	if next {
+		tally := dbstate.SigTally(state)
+		feds := len(state.GetFedServers(dbheight))
+		check("signatures", dbstate.ValidateSignatures(state) == 1,
+			fmt.Sprintf("%d valid signatures of %d federated servers", tally, feds))
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	if !next {
+		// Past blocks are checked against what we saved at that height instead
+		if dbheight <= saved {
+			ours, err := dbase.FetchDBlockByHeight(dbheight)
+			check("saved-block", err == nil && ours != nil && ours.GetKeyMR().IsSameAs(dbstate.DirectoryBlock.GetKeyMR()),
+				"block must match the directory block we saved at this height")
+		}
+		return resp, nil
+	}
+
+	if dbheight > 0 {
+		checkPrevBlocks(dbase, dbstate, check)
+	}
+	checkBalances(state, dbstate, check)
+
+	return resp, nil
+}
+
+func checkPrevBlocks(dbase interfaces.DBOverlaySimple, dbstate *messages.DBStateMsg, check func(string, bool, string)) {
+	prev := dbstate.DirectoryBlock.GetHeader().GetDBHeight() - 1
+
+	dblk, err := dbase.FetchDBlockByHeight(prev)
+	if err != nil || dblk == nil {
+		check("prev-dblock", false, "could not load our directory block at the previous height")
+	} else {
+		header := dbstate.DirectoryBlock.GetHeader()
+		check("prev-dblock", header.GetPrevKeyMR().IsSameAs(dblk.GetKeyMR()) && header.GetPrevFullHash().IsSameAs(dblk.GetFullHash()),
+			"previous KeyMR and full hash must match our directory block "+dblk.GetKeyMR().String())
+	}
+
+	ablk, err := dbase.FetchABlockByHeight(prev)
+	if err != nil || ablk == nil {
+		check("prev-ablock", false, "could not load our admin block at the previous height")
+	} else {
+		backref, err := ablk.BackReferenceHash()
+		check("prev-ablock", err == nil && dbstate.AdminBlock.GetHeader().GetPrevBackRefHash().IsSameAs(backref),
+			"previous back reference hash must match our admin block")
+	}
+
+	fblk, err := dbase.FetchFBlockByHeight(prev)
+	if err != nil || fblk == nil {
+		check("prev-fblock", false, "could not load our factoid block at the previous height")
+	} else {
+		check("prev-fblock", dbstate.FactoidBlock.GetPrevKeyMR().IsSameAs(fblk.GetKeyMR()) &&
+			dbstate.FactoidBlock.GetPrevLedgerKeyMR().IsSameAs(fblk.GetLedgerKeyMR()),
+			"previous KeyMR and ledger KeyMR must match our factoid block "+fblk.GetKeyMR().String())
+	}
+
+	ecblk, err := dbase.FetchECBlockByHeight(prev)
+	if err != nil || ecblk == nil {
+		check("prev-ecblock", false, "could not load our entry credit block at the previous height")
+	} else {
+		hh, err1 := ecblk.HeaderHash()
+		fh, err2 := ecblk.GetFullHash()
+		header := dbstate.EntryCreditBlock.GetHeader()
+		check("prev-ecblock", err1 == nil && err2 == nil && header.GetPrevHeaderHash().IsSameAs(hh) && header.GetPrevFullHash().IsSameAs(fh),
+			"previous header hash and full hash must match our entry credit block")
+	}
+}
+
+// checkBalances runs the block's transactions and commits over a copy of our balances, in
+// block order, and reports the first one that isn't covered.
+func checkBalances(state interfaces.IState, dbstate *messages.DBStateMsg, check func(string, bool, string)) {
+	fbalances := map[[32]byte]int64{}
+	fbalance := func(adr [32]byte) int64 {
+		if v, ok := fbalances[adr]; ok {
+			return v
+		}
+		return state.GetF(false, adr)
+	}
+
+	problem := ""
+	for i, tx := range dbstate.FactoidBlock.GetTransactions() {
+		if err := tx.Validate(i); err != nil {
+			problem = fmt.Sprintf("transaction %s: %v", tx.GetSigHash().String(), err)
+			break
+		}
+		if i > 0 {
+			if err := tx.ValidateSignatures(); err != nil {
+				problem = fmt.Sprintf("transaction %s: %v", tx.GetSigHash().String(), err)
+				break
+			}
+		}
+		for _, in := range tx.GetInputs() {
+			adr := in.GetAddress().Fixed()
+			bal := fbalance(adr) - int64(in.GetAmount())
+			if bal < 0 {
+				problem = fmt.Sprintf("transaction %s: input %s is short by %d factoshis",
+					tx.GetSigHash().String(), primitives.ConvertFctAddressToUserStr(in.GetAddress()), -bal)
+				break
+			}
+			fbalances[adr] = bal
+		}
+		if problem != "" {
+			break
+		}
+		for _, out := range tx.GetOutputs() {
+			adr := out.GetAddress().Fixed()
+			fbalances[adr] = fbalance(adr) + int64(out.GetAmount())
+		}
+	}
+	check("factoid-transactions", problem == "", problem)
+
+	ebalances := map[[32]byte]int64{}
+	ebalance := func(adr [32]byte) int64 {
+		if v, ok := ebalances[adr]; ok {
+			return v
+		}
+		return state.GetE(false, adr)
+	}
+
+	problem = ""
+	for _, e := range dbstate.EntryCreditBlock.GetEntries() {
+		var adr [32]byte
+		var credits int64
+		switch ec := e.(type) {
+		case *entryCreditBlock.IncreaseBalance:
+			adr = ec.ECPubKey.Fixed()
+			ebalances[adr] = ebalance(adr) + int64(ec.NumEC)
+			continue
+		case *entryCreditBlock.CommitChain:
+			adr, credits = ec.ECPubKey.Fixed(), int64(ec.Credits)
+		case *entryCreditBlock.CommitEntry:
+			adr, credits = ec.ECPubKey.Fixed(), int64(ec.Credits)
+		default:
+			continue
+		}
+		bal := ebalance(adr) - credits
+		if bal < 0 {
+			problem = fmt.Sprintf("commit %s is short by %d entry credits", e.Hash().String(), -bal)
+			break
+		}
+		ebalances[adr] = bal
+	}
+	check("entry-credit-commits", problem == "", problem)
+}
@@ -2,15 +2,19 @@ package wsapi_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/receipts"
+	"github.com/FactomProject/factomd/state"
 	"github.com/FactomProject/factomd/testHelper"
 	. "github.com/FactomProject/factomd/wsapi"
 )
@@ -398,3 +402,461 @@ func TestHandleV2GetTranasction(t *testing.T) {
 		}
 	}
 }
+
+// saveTimedChain saves a chain with entries in minutes 1 and 3 of the directory blocks at heights
+// 2, 4, 6 and 8.  The test chain's entry blocks have no minute markers, so their entries have no
+// time; real entry blocks close each minute they have entries in.
+func saveTimedChain(t *testing.T, dbase interfaces.DBOverlay) interfaces.IHash {
+	chainID := primitives.Sha([]byte("timed chain"))
+	prev := interfaces.IHash(primitives.NewZeroHash())
+	for height := uint32(2); height <= 8; height += 2 {
+		eblk := entryBlock.NewEBlock()
+		eblk.GetHeader().SetChainID(chainID)
+		eblk.GetHeader().SetDBHeight(height)
+		eblk.GetHeader().SetPrevKeyMR(prev)
+		for _, minute := range []byte{1, 3} {
+			entry := testHelper.CreateTestEntry(height*10 + uint32(minute))
+			eblk.AddEBEntry(entry)
+			eblk.AddEndOfMinuteMarker(minute)
+		}
+		keyMR, err := eblk.KeyMR()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dbase.ProcessEBlockBatch(eblk, false); err != nil {
+			t.Fatal(err)
+		}
+
+		dblk, err := dbase.FetchDBlockByHeight(height)
+		if err != nil || dblk == nil {
+			t.Fatalf("No directory block at %d: %v", height, err)
+		}
+		dblk.AddEntry(chainID, keyMR)
+		if _, err := dblk.BuildKeyMerkleRoot(); err != nil {
+			t.Fatal(err)
+		}
+		if err := dbase.ProcessDBlockBatchWithoutHead(dblk); err != nil {
+			t.Fatal(err)
+		}
+		prev = keyMR
+	}
+	return chainID
+}
+
+func TestHandleV2EntriesByTime(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	chain := saveTimedChain(t, state.DB.(interfaces.DBOverlay)).String()
+
+	// Test directory blocks start at minute 1234, and are one minute apart
+	base := int64(1234 * 60)
+
+	req := EntriesByTimeRequest{ChainID: chain, Start: 0, End: base * 2}
+	resp, jErr := HandleV2EntriesByTime(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	all := resp.(*EntriesByTimeResponse).Entries
+	if len(all) == 0 {
+		t.Fatal("Expected entries for the whole chain")
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Timestamp < all[i-1].Timestamp {
+			t.Errorf("Entries are not in time order")
+		}
+	}
+
+	req = EntriesByTimeRequest{ChainID: chain, Start: all[len(all)-1].Timestamp, End: base * 2}
+	resp, jErr = HandleV2EntriesByTime(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	last := resp.(*EntriesByTimeResponse).Entries
+	if len(last) == 0 || len(last) >= len(all) {
+		t.Errorf("Expected a subset of the entries, found %d of %d", len(last), len(all))
+	}
+	for _, e := range last {
+		if e.Timestamp < req.Start || e.Timestamp > req.End {
+			t.Errorf("Entry %v is outside of the range", e.EntryHash)
+		}
+	}
+
+	// The end of the range bounds where the chain is walked from
+	req = EntriesByTimeRequest{ChainID: chain, Start: 0, End: all[0].Timestamp}
+	resp, jErr = HandleV2EntriesByTime(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	first := resp.(*EntriesByTimeResponse).Entries
+	if len(first) == 0 || len(first) >= len(all) {
+		t.Errorf("Expected a subset of the entries, found %d of %d", len(first), len(all))
+	}
+	for _, e := range first {
+		if e.Timestamp > req.End {
+			t.Errorf("Entry %v is outside of the range", e.EntryHash)
+		}
+	}
+
+	req = EntriesByTimeRequest{ChainID: chain, Start: 10, End: 5}
+	_, jErr = HandleV2EntriesByTime(state, req)
+	if jErr == nil {
+		t.Error("Expected an error for an inverted range")
+	}
+}
+
+func TestHandleV2ECRateAtHeight(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	// The test state loads its blocks without marking any past the genesis block saved
+	fblk, err := state.DB.FetchFBlockByHeight(0)
+	if err != nil || fblk == nil {
+		t.Fatalf("No factoid block at height 0: %v", err)
+	}
+	rate := fblk.GetExchRate()
+
+	resp, jErr := HandleV2ECRateAtHeight(state, ECRateAtHeightRequest{Height: 0, Factoshis: rate * 5, ECs: 7})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r := resp.(*ECRateAtHeightResponse)
+	if r.Rate != rate || r.Since != 0 {
+		t.Errorf("Got rate %d since %d, expected %d", r.Rate, r.Since, rate)
+	}
+	if r.FactoshisToECs != 5 || r.ECsToFactoshis != rate*7 {
+		t.Errorf("Bad conversions %d and %d", r.FactoshisToECs, r.ECsToFactoshis)
+	}
+
+	_, jErr = HandleV2ECRateAtHeight(state, ECRateAtHeightRequest{Height: int64(state.GetHighestSavedBlk()) + 1})
+	if jErr == nil {
+		t.Error("Expected an error for a height that isn't saved")
+	}
+	_, jErr = HandleV2ECRateAtHeight(state, ECRateAtHeightRequest{Height: -1})
+	if jErr == nil {
+		t.Error("Expected an error for a negative height")
+	}
+}
+
+func TestHandleV2ChainStats(t *testing.T) {
+	st := testHelper.CreateAndPopulateSavedTestState()
+	top := st.GetHighestSavedBlk()
+
+	bcs := new(state.BlockChainStats)
+	bcs.DBHeight = top
+	bcs.Chains = map[string]*interfaces.ChainStats{
+		"aa": {ChainID: "aa", Entries: 1, Commits: 1, ECs: 1},
+		"bb": {ChainID: "bb", Entries: 5, Commits: 5, ECs: 50},
+		"":   {Commits: 2, ECs: 2},
+	}
+	st.AddBlockChainStats(bcs)
+
+	resp, jErr := HandleV2ChainStats(st, nil)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r := resp.(*ChainStatsResponse)
+	if r.FromHeight != top || r.ToHeight != top || r.BlocksKept != 1 || r.TotalChains != 3 {
+		t.Errorf("Unexpected response %v", r)
+	}
+	if len(r.Chains) != 3 || r.Chains[0].ChainID != "bb" {
+		t.Errorf("Expected the chain that spent the most first, got %v", r.Chains)
+	}
+
+	resp, jErr = HandleV2ChainStats(st, ChainStatsRequest{Blocks: 3, Limit: 1})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r = resp.(*ChainStatsResponse)
+	if r.FromHeight+2 != top || r.TotalChains != 3 || len(r.Chains) != 1 {
+		t.Errorf("Unexpected response %v", r)
+	}
+
+	_, jErr = HandleV2ChainStats(st, ChainStatsRequest{Height: int64(top) + 1})
+	if jErr == nil {
+		t.Error("Expected an error for a height that isn't saved")
+	}
+	_, jErr = HandleV2ChainStats(st, ChainStatsRequest{Limit: MaxChainStatsLimit + 1})
+	if jErr == nil {
+		t.Error("Expected an error for a limit that is too large")
+	}
+}
+
+func TestHandleV2NodeStatus(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	resp, jErr := HandleV2NodeStatus(state, nil)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	status := resp.(*NodeStatusResponse)
+	if status.NodeName != state.GetFactomNodeName() {
+		t.Errorf("Wrong node name %v", status.NodeName)
+	}
+	if status.IdentityChainID != state.GetIdentityChainID().String() {
+		t.Errorf("Wrong identity chain id %v", status.IdentityChainID)
+	}
+	if status.DirectoryBlockHeight != int64(state.GetHighestSavedBlk()) {
+		t.Errorf("Wrong directory block height %v", status.DirectoryBlockHeight)
+	}
+	switch status.Role {
+	case "Federated", "Audit", "Follower":
+	default:
+		t.Errorf("Unknown role %v", status.Role)
+	}
+}
+
+func TestHandleV2AuthorityReport(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	req := AuthorityReportRequest{Start: 1, End: 3}
+	resp, jErr := HandleV2AuthorityReport(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	report := resp.(*AuthorityReportResponse)
+	if report.Identities == nil {
+		t.Error("Identities should be an empty list, not nil")
+	}
+	if len(report.Coinbase) != 1 {
+		t.Fatalf("Expected one coinbase address, found %d", len(report.Coinbase))
+	}
+	adr := primitives.ConvertFctAddressToUserStr(testHelper.NewFactoidAddress(0))
+	if report.Coinbase[0].Address != adr {
+		t.Errorf("Wrong coinbase address %v", report.Coinbase[0].Address)
+	}
+	if report.Coinbase[0].Amount != 3*int64(testHelper.DefaultCoinbaseAmount) {
+		t.Errorf("Wrong coinbase total %v", report.Coinbase[0].Amount)
+	}
+
+	req = AuthorityReportRequest{Start: 10, End: 5}
+	_, jErr = HandleV2AuthorityReport(state, req)
+	if jErr == nil {
+		t.Error("Expected an error for an inverted range")
+	}
+}
+
+func TestHandleV2CoinbasePreview(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	next := int64(state.GetHighestSavedBlk()) + 10
+	resp, jErr := HandleV2CoinbasePreview(state, HeightRequest{Height: next})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	preview := resp.(*CoinbasePreviewResponse)
+	if preview.Height != next {
+		t.Errorf("Wrong height %v", preview.Height)
+	}
+	if preview.Outputs == nil {
+		t.Error("Outputs should be an empty list, not nil")
+	}
+	var total int64
+	for _, out := range preview.Outputs {
+		total += out.Amount
+	}
+	if total != preview.Total {
+		t.Errorf("Total %v does not match the outputs %v", preview.Total, total)
+	}
+
+	_, jErr = HandleV2CoinbasePreview(state, HeightRequest{Height: int64(state.GetHighestSavedBlk())})
+	if jErr == nil {
+		t.Error("Expected an error for a height that is already saved")
+	}
+}
+
+func TestHandleV2ValidateDBState(t *testing.T) {
+	state := testHelper.CreateAndPopulateSavedTestState()
+	dbstates := testHelper.CreateTestDBStateList()
+
+	b, err := dbstates[1].MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	resp, jErr := HandleV2ValidateDBState(state, ValidateDBStateRequest{DBState: hex.EncodeToString(b)})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	report := resp.(*ValidateDBStateResponse)
+	if report.Height != 1 {
+		t.Errorf("Wrong height %v", report.Height)
+	}
+	if report.Valid {
+		t.Error("A block we already saved should not be valid to apply")
+	}
+	passed := map[string]bool{}
+	for _, r := range report.Rules {
+		passed[r.Rule] = r.Passed
+	}
+	for _, rule := range []string{"blocks", "contents", "saved-block"} {
+		if !passed[rule] {
+			t.Errorf("Rule %v should have passed", rule)
+		}
+	}
+	if passed["height"] {
+		t.Error("Rule height should have failed")
+	}
+
+	_, jErr = HandleV2ValidateDBState(state, ValidateDBStateRequest{DBState: "not hex"})
+	if jErr == nil {
+		t.Error("Expected an error for a bad DBState")
+	}
+}
+
+func TestHandleV2SweepTransactions(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+
+	req := SweepTransactionsRequest{Destination: testHelper.NewECAddressString(0)}
+	for i := uint64(101); i <= 103; i++ {
+		_, pub, _ := testHelper.NewFactoidAddressStrings(i)
+		s.FactoidBalancesP.Put(testHelper.NewFactoidAddress(i).Fixed(), 100000000)
+		req.Inputs = append(req.Inputs, SweepInput{PublicKey: pub})
+	}
+	_, pub, _ := testHelper.NewFactoidAddressStrings(104)
+	req.Inputs = append(req.Inputs, SweepInput{PublicKey: pub})
+
+	resp, jErr := HandleV2SweepTransactions(s, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	sweep := resp.(*SweepTransactionsResponse)
+	if len(sweep.Transactions) != 1 || len(sweep.Transactions[0].Inputs) != 3 {
+		t.Fatalf("Expected one transaction of 3 inputs, got %+v", sweep.Transactions)
+	}
+	if len(sweep.Skipped) != 1 || sweep.Skipped[0] != pub {
+		t.Errorf("The unfunded address should be skipped, got %v", sweep.Skipped)
+	}
+	tx := sweep.Transactions[0]
+	if sweep.Total != 300000000 || tx.Amount+tx.Fee != sweep.Total || tx.Credits != tx.Amount/sweep.Rate {
+		t.Errorf("Amounts don't add up: %+v of %d", tx, sweep.Total)
+	}
+	if tx.Transaction != "" {
+		t.Error("An unsigned transaction should not be returned")
+	}
+
+	data, err := hex.DecodeString(tx.SigData)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	req.Inputs = req.Inputs[:3]
+	for i := range req.Inputs {
+		sig := factoid.NewED25519Signature(testHelper.NewFullPrivKey(uint64(101+i)), data)
+		req.Inputs[i].Signature = hex.EncodeToString(sig.Signature[:])
+	}
+	if _, jErr = HandleV2SweepTransactions(s, req); jErr == nil {
+		t.Error("Expected an error for signatures without the timestamp")
+	}
+	req.Timestamp = sweep.Timestamp
+	resp, jErr = HandleV2SweepTransactions(s, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	signed := resp.(*SweepTransactionsResponse).Transactions[0]
+	if signed.TxID != tx.TxID || signed.Transaction == "" {
+		t.Fatalf("Expected the same transaction, signed, got %+v", signed)
+	}
+	raw, _ := hex.DecodeString(signed.Transaction)
+	ft := new(factoid.Transaction)
+	if err := ft.UnmarshalBinary(raw); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := ft.ValidateSignatures(); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	req.Timestamp++
+	if _, jErr = HandleV2SweepTransactions(s, req); jErr == nil {
+		t.Error("Expected an error for signatures of another transaction")
+	}
+}
+
+func TestHandleV2DeriveAddresses(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+
+	master, err := primitives.NewHDMasterKey([]byte("watch-only deposit addresses"))
+	for i := 0; err != nil; i++ {
+		master, err = primitives.NewHDMasterKey([]byte{byte(i)})
+	}
+	account, err := master.Derive(primitives.HDAccountPath(primitives.HDCoinFactoid, 0))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	xpub := account.Public().String()
+
+	deposit, _ := account.Derive([]uint32{0, 3})
+	adr, _ := factoid.NewRCD_1(deposit.Public().Key[:]).GetAddress()
+	s.FactoidBalancesP.Put(adr.Fixed(), 5000)
+
+	resp, jErr := HandleV2DeriveAddresses(s, DeriveAddressesRequest{XPub: xpub, Start: 2, Count: 3})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	addresses := resp.(*DeriveAddressesResponse).Addresses
+	if len(addresses) != 3 || addresses[1].Index != 3 {
+		t.Fatalf("Expected addresses 2 to 4, got %+v", addresses)
+	}
+	if addresses[1].Address != primitives.ConvertFctAddressToUserStr(adr) || addresses[1].Balance != 5000 {
+		t.Errorf("Address 3 is %+v, expected %s with 5000", addresses[1], primitives.ConvertFctAddressToUserStr(adr))
+	}
+
+	resp, jErr = HandleV2DeriveXPub(s, DeriveXPubRequest{XPub: xpub, Path: "0/3"})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if resp.(*DeriveXPubResponse).XPub != deposit.Public().String() {
+		t.Errorf("derive-xpub gave %s, expected %s", resp.(*DeriveXPubResponse).XPub, deposit.Public())
+	}
+
+	if _, jErr = HandleV2DeriveXPub(s, DeriveXPubRequest{XPub: xpub, Path: "0'/3"}); jErr == nil {
+		t.Error("Expected an error deriving a hardened key")
+	}
+	if _, jErr = HandleV2DeriveAddresses(s, DeriveAddressesRequest{XPub: "00"}); jErr == nil {
+		t.Error("Expected an error for a bad extended public key")
+	}
+}
+
+func TestHandleV2SignChallenge(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	key := primitives.RandomPrivateKey()
+	fa, _ := factoid.PublicKeyToFactoidAddress(key.Pub[:])
+	address := primitives.ConvertFctAddressToUserStr(fa)
+
+	resp, jErr := HandleV2SignChallenge(s, SignChallengeRequest{Address: address, Message: "For the vote"})
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	challenge := resp.(*SignChallengeResponse)
+	data, _ := hex.DecodeString(challenge.Data)
+	if string(data) != string(factoid.SignedMessageData(challenge.Challenge)) {
+		t.Errorf("Data %q is not what signs the challenge %q", data, challenge.Challenge)
+	}
+
+	req := VerifyAddressSignatureRequest{
+		Address:   address,
+		PublicKey: hex.EncodeToString(key.Pub[:]),
+		Message:   challenge.Challenge,
+		Signature: hex.EncodeToString(key.Sign(data).Bytes()),
+		Challenge: true,
+	}
+	resp, jErr = HandleV2VerifyAddressSignature(s, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if v := resp.(*VerifyAddressSignatureResponse); !v.Valid {
+		t.Errorf("The answer to a challenge should be valid: %s", v.Reason)
+	}
+
+	// A message that isn't a challenge is only valid when none is asked for
+	req.Message = "Not a challenge"
+	req.Signature = hex.EncodeToString(key.Sign(factoid.SignedMessageData(req.Message)).Bytes())
+	resp, _ = HandleV2VerifyAddressSignature(s, req)
+	if resp.(*VerifyAddressSignatureResponse).Valid {
+		t.Error("A message that isn't a challenge passed as one")
+	}
+	req.Challenge = false
+	resp, _ = HandleV2VerifyAddressSignature(s, req)
+	if v := resp.(*VerifyAddressSignatureResponse); !v.Valid {
+		t.Errorf("The signed message should be valid: %s", v.Reason)
+	}
+
+	if _, jErr = HandleV2SignChallenge(s, SignChallengeRequest{Address: "FA1"}); jErr == nil {
+		t.Error("Expected an error for a bad address")
+	}
+}